@@ -8,18 +8,24 @@
 
 //
 // Synopsis:
-//	boot [-v][-no-load][-no-exec]
+//	boot [-v][-no-load][-no-exec][-keyring FILE][-measure [-measure-log FILE]]
 //
 // Description:
 //	If returns to u-root shell, the code didn't found a local bootable option
 //
-//      -v prints messages
+//      -v prints debug messages, like uroot.verbose=boot:debug on the kernel command line
 //      -no-load prints the boot image paths it was going to load, but doesn't load + exec them
 //      -no-exec loads the boot image, but doesn't exec it
+//      -keyring only loads Linux kernels and initrds with valid detached
+//               OpenPGP signatures (e.g. vmlinuz.sig) by the keys of FILE
+//      -measure measures the kernel, initrd and command line of the booted
+//               image, and the arguments of boot, into the TPM PCRs
+//      -measure-log writes the TPM event log of -measure to FILE
 //
 // Notes:
-//	The code is looking for boot/grub/grub.cfg file as to identify the
-//	boot option.
+//	The code is looking for BootLoaderSpec entries in loader/entries or
+//	boot/loader/entries, and for a boot/grub/grub.cfg file as to identify
+//	the boot option.
 //	The first bootable device found in the block device tree is the one used
 //	Windows is not supported (that is a work in progress)
 //
@@ -36,11 +42,13 @@ import (
 	"github.com/u-root/u-root/pkg/boot"
 	"github.com/u-root/u-root/pkg/boot/bootcmd"
 	"github.com/u-root/u-root/pkg/boot/localboot"
+	"github.com/u-root/u-root/pkg/boot/measure"
 	"github.com/u-root/u-root/pkg/boot/menu"
 	"github.com/u-root/u-root/pkg/cmdline"
 	"github.com/u-root/u-root/pkg/mount"
 	"github.com/u-root/u-root/pkg/mount/block"
 	"github.com/u-root/u-root/pkg/ulog"
+	"github.com/u-root/u-root/pkg/vfile"
 )
 
 var (
@@ -52,6 +60,11 @@ var (
 	reuseCmdlineItem  = flag.String("reuse", "console", "comma separated list of kernel params value to reuse from current kernel (default to console)")
 	appendCmdline     = flag.String("append", "", "Additional kernel params")
 	blockList         = flag.String("block", "", "comma separated list of pci vendor and device ids to ignore (format vendor:device). E.g. 0x8086:0x1234,0x8086:0xabcd")
+	measureTPM        = flag.Bool("measure", false, "Measure the kernel, initramfs, command line and the arguments of boot into TPM PCRs before kexec")
+	measureLog        = flag.String("measure-log", "", "Write the TPM event log of -measure to this file")
+	keyring           = flag.String("keyring", "", "OpenPGP key ring to verify the .sig detached signatures of kernels and initrds with")
+
+	blog = ulog.Component("boot")
 )
 
 // updateBootCmdline get the kernel command line parameters and filter it:
@@ -64,9 +77,21 @@ func updateBootCmdline(cl string) string {
 
 func main() {
 	flag.Parse()
-
+	ulog.ConfigureFromCmdline()
 	if *verbose {
-		block.Debug = log.Printf
+		ulog.Default.SetComponentLevel("boot", ulog.LevelDebug)
+	}
+	debug := blog.Enabled(ulog.LevelDebug)
+	if debug {
+		block.Debug = blog.Debugf
+	}
+	var verifier boot.Verifier
+	if *keyring != "" {
+		ring, err := vfile.GetKeyRing(*keyring)
+		if err != nil {
+			log.Fatal(err)
+		}
+		verifier = boot.PGPVerifier{KeyRing: ring}
 	}
 	blockDevs, err := block.GetBlockDevices()
 	if err != nil {
@@ -84,14 +109,10 @@ func main() {
 		}
 	}
 
-	log.Printf("Booting from the following block devices: %v", blockDevs)
+	blog.Infof("Booting from the following block devices: %v", blockDevs)
 
-	var l ulog.Logger = ulog.Null
-	if *verbose {
-		l = ulog.Log
-	}
 	mountPool := &mount.Pool{}
-	images, err := localboot.Localboot(l, blockDevs, mountPool)
+	images, err := localboot.Localboot(blog.At(ulog.LevelDebug), blockDevs, mountPool)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -99,10 +120,23 @@ func main() {
 		// Make changes to the kernel command line based on our cmdline.
 		if li, ok := img.(*boot.LinuxImage); ok {
 			li.Cmdline = updateBootCmdline(li.Cmdline)
+			li.Verifier = verifier
+		}
+	}
+
+	if *measureTPM {
+		m, err := measure.New()
+		if err != nil {
+			log.Fatalf("Cannot measure: %v", err)
+		}
+		m.LogFile = *measureLog
+		if err := m.MeasureArgs(); err != nil {
+			log.Fatal(err)
 		}
+		m.Attach(images...)
 	}
 
-	menuEntries := menu.OSImages(*verbose, images...)
+	menuEntries := menu.OSImages(debug, images...)
 	menuEntries = append(menuEntries, menu.Reboot{})
 	menuEntries = append(menuEntries, menu.StartShell{})
 
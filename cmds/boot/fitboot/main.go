@@ -23,6 +23,7 @@ var (
 	kernel     = flag.String("k", "", "Kernel image node name.")
 	initramfs  = flag.String("i", "", "InitRAMFS node name -- default none")
 	rsdpLookup = flag.Bool("rsdp", false, "Derrive RSDP table pointer from environment")
+	keys       = flag.String("keys", "", "PEM file of RSA public keys one of which must have signed the kernel and initramfs")
 )
 
 var v = func(string, ...interface{}) {}
@@ -43,6 +44,11 @@ func main() {
 	}
 
 	f.Cmdline, f.Kernel, f.InitRAMFS, f.ConfigOverride = *cmdline, *kernel, *initramfs, *config
+	if *keys != "" {
+		if f.Keys, err = fit.ReadKeys(*keys); err != nil {
+			log.Fatal(err)
+		}
+	}
 
 	kn, in, err := f.LoadConfig()
 	if err == nil {
@@ -5,6 +5,7 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"log"
@@ -12,23 +13,29 @@ import (
 	"path"
 	"path/filepath"
 
+	"github.com/u-root/u-root/pkg/boot"
+	"github.com/u-root/u-root/pkg/boot/bls"
+	"github.com/u-root/u-root/pkg/boot/grub"
 	"github.com/u-root/u-root/pkg/boot/jsonboot"
+	"github.com/u-root/u-root/pkg/boot/measure"
 	"github.com/u-root/u-root/pkg/mount"
 	"github.com/u-root/u-root/pkg/mount/block"
+	"github.com/u-root/u-root/pkg/ulog"
 )
 
 // TODO backward compatibility for BIOS mode with partition type 0xee
-// TODO use a proper parser for grub config (see grub.go)
 
 var (
 	flagBaseMountPoint = flag.String("m", "/mnt", "Base mount point where to mount partitions")
 	flagDryRun         = flag.Bool("dryrun", false, "Do not actually kexec into the boot config")
 	flagDebug          = flag.Bool("d", false, "Print debug output")
-	flagConfigIdx      = flag.Int("config", -1, "Specify the index of the configuration to boot. The order is determined by the menu entries in the Grub config")
-	flagGrubMode       = flag.Bool("grub", false, "Use GRUB mode, i.e. look for valid Grub/Grub2 configuration in default locations to boot a kernel. GRUB mode ignores -kernel/-initramfs/-cmdline")
+	flagConfigIdx      = flag.Int("config", -1, "Specify the index of the configuration to boot. The order is determined by the BootLoaderSpec entries, then the menu entries in the Grub config")
+	flagGrubMode       = flag.Bool("grub", false, "Use GRUB mode, i.e. look for BootLoaderSpec entries and valid Grub/Grub2 configuration in default locations to boot a kernel. GRUB mode ignores -kernel/-initramfs/-cmdline")
 	flagKernelPath     = flag.String("kernel", "", "Specify the path of the kernel to execute. If using -grub, this argument is ignored")
 	flagInitramfsPath  = flag.String("initramfs", "", "Specify the path of the initramfs to load. If using -grub, this argument is ignored")
 	flagKernelCmdline  = flag.String("cmdline", "", "Specify the kernel command line. If using -grub, this argument is ignored")
+	flagMeasure        = flag.Bool("measure", false, "Measure the kernel, initramfs, command line and the arguments of localboot into TPM PCRs before kexec. Only in GRUB mode")
+	flagMeasureLog     = flag.String("measure-log", "", "Write the TPM event log of -measure to this file")
 	flagDeviceGUID     = flag.String("guid", "", "GUID of the device where the kernel (and optionally initramfs) are located. Ignored if -grub is set or if -kernel is not specified")
 )
 
@@ -59,9 +66,12 @@ func mountByGUID(devices block.BlockDevices, guid, baseMountpoint string) (*moun
 // * look for the partition with the specified GUID, and mount it
 // * if no GUID is specified, mount all of the specified devices
 // * try to mount the device(s) using any of the kernel-supported filesystems
-// * look for a GRUB configuration in various well-known locations
-// * build a list of valid boot configurations from the found GRUB configuration files
-// * try to boot every valid boot configuration until one succeeds
+// * look for BootLoaderSpec entries in /loader/entries or
+//   /boot/loader/entries, and parse them with pkg/boot/bls
+// * look for a GRUB configuration in various well-known locations, e.g.
+//   /boot/grub/grub.cfg, and parse it with pkg/boot/grub
+// * try to boot every menu entry found, the default one first, until one
+//   succeeds
 //
 // The first parameter, `devices` is a list of block.BlockDev . The function
 // will look for bootable configurations on these devices
@@ -73,7 +83,7 @@ func mountByGUID(devices block.BlockDevices, guid, baseMountpoint string) (*moun
 // The fourth parameter, `dryrun`, will not boot the found configurations if set
 // to true.
 func BootGrubMode(devices block.BlockDevices, baseMountpoint string, guid string, dryrun bool, configIdx int) error {
-	var mounted []*mount.MountPoint
+	mountPool := &mount.Pool{}
 	if guid == "" {
 		// try mounting all the available devices, with all the supported file
 		// systems
@@ -83,7 +93,7 @@ func BootGrubMode(devices block.BlockDevices, baseMountpoint string, guid string
 			if mountpoint, err := dev.Mount(mountpath, mount.MS_RDONLY); err != nil {
 				debug("Failed to mount %s on %s: %v", dev, mountpath, err)
 			} else {
-				mounted = append(mounted, mountpoint)
+				mountPool.Add(mountpoint)
 			}
 		}
 	} else {
@@ -91,62 +101,81 @@ func BootGrubMode(devices block.BlockDevices, baseMountpoint string, guid string
 		if err != nil {
 			return err
 		}
-		mounted = append(mounted, mount)
+		mountPool.Add(mount)
 	}
+	mounted := append([]*mount.MountPoint(nil), mountPool.MountPoints...)
 
 	log.Printf("mounted: %+v", mounted)
 	defer func() {
-		// clean up
-		for _, mountpoint := range mounted {
-			if err := mountpoint.Unmount(mount.MNT_DETACH); err != nil {
-				debug("Failed to unmount %v: %v", mountpoint, err)
-			}
+		// clean up, including the partitions mounted by search
+		// directives of the configurations.
+		if err := mountPool.UnmountAll(mount.MNT_DETACH); err != nil {
+			debug("Failed to unmount: %v", err)
 		}
 	}()
 
-	// search for a valid grub config and extracts the boot configuration
-	bootconfigs := make([]jsonboot.BootConfig, 0)
+	// search for BootLoaderSpec entries and valid grub configs, and parse
+	// their menu entries
+	var images []boot.OSImage
 	for _, mountpoint := range mounted {
-		bootconfigs = append(bootconfigs, ScanGrubConfigs(devices, mountpoint.Path)...)
+		imgs, err := bls.ScanBLSEntries(ulog.Log, mountpoint.Path)
+		if err != nil {
+			debug("No BootLoaderSpec entries on %s: %v", mountpoint.Path, err)
+		}
+		images = append(images, imgs...)
+
+		imgs, err = grub.ParseLocalConfig(context.Background(), mountpoint.Path, devices, mountPool)
+		if err != nil {
+			debug("No grub config on %s: %v", mountpoint.Path, err)
+			continue
+		}
+		images = append(images, imgs...)
 	}
-	if len(bootconfigs) == 0 {
+	if len(images) == 0 {
 		return fmt.Errorf("No boot configuration found")
 	}
-	log.Printf("Found %d boot configs", len(bootconfigs))
-	for _, cfg := range bootconfigs {
-		debug("%+v", cfg)
+	if *flagMeasure {
+		m, err := measure.New()
+		if err != nil {
+			return fmt.Errorf("cannot measure: %v", err)
+		}
+		m.LogFile = *flagMeasureLog
+		if err := m.MeasureArgs(); err != nil {
+			return err
+		}
+		m.Attach(images...)
 	}
-	for n, cfg := range bootconfigs {
-		log.Printf("  %d: %s\n", n, cfg.Name)
+	log.Printf("Found %d boot configs", len(images))
+	for n, img := range images {
+		log.Printf("  %d: %s\n", n, img.Label())
+		debug("%s", img)
 	}
 	if configIdx > -1 {
-		for n, cfg := range bootconfigs {
-			if configIdx == n {
-				if dryrun {
-					debug("Dry-run mode: will not boot the found configuration")
-					debug("Boot configuration: %+v", cfg)
-					return nil
-				}
-				if err := cfg.Boot(); err != nil {
-					log.Printf("Failed to boot kernel %s: %v", cfg.Kernel, err)
-				}
-			}
+		if configIdx >= len(images) {
+			log.Printf("Invalid arg -config %d: there are only %d bootconfigs available\n", configIdx, len(images))
+			return nil
 		}
-		log.Printf("Invalid arg -config %d: there are only %d bootconfigs available\n", configIdx, len(bootconfigs))
-		return nil
+		images = images[configIdx : configIdx+1]
 	}
 	if dryrun {
-		cfg := bootconfigs[0]
 		debug("Dry-run mode: will not boot the found configuration")
-		debug("Boot configuration: %+v", cfg)
+		debug("Boot configuration: %s", images[0])
 		return nil
 	}
 
 	// try to kexec into every boot config kernel until one succeeds
-	for _, cfg := range bootconfigs {
-		debug("Trying boot configuration %+v", cfg)
-		if err := cfg.Boot(); err != nil {
-			log.Printf("Failed to boot kernel %s: %v", cfg.Kernel, err)
+	for _, img := range images {
+		debug("Trying boot configuration %s", img)
+		if err := img.Load(*flagDebug); err != nil {
+			log.Printf("Failed to load %s: %v", img.Label(), err)
+			continue
+		}
+		// The image is in kernel memory now.
+		if err := mountPool.UnmountAll(mount.MNT_DETACH); err != nil {
+			debug("Failed to unmount: %v", err)
+		}
+		if err := boot.Execute(); err != nil {
+			log.Printf("Failed to boot %s: %v", img.Label(), err)
 		}
 	}
 	// if we reach this point, no boot configuration succeeded
@@ -9,25 +9,39 @@
 //
 // PXE-based booting requests a DHCP lease, and looks at the BootFileName and
 // ServerName options (which may be embedded in the original BOOTP message, or
-// as option codes) to find something to boot.
+// as option codes) to find something to boot. It identifies as a PXE client,
+// so the boot information may also come from a ProxyDHCP server.
 //
 // This BootFileName may point to
 //
-// - an iPXE script beginning with #!ipxe
+// - an iPXE script beginning with #!ipxe, whose kernel, initrd, imgargs, boot
+//   and chain commands are run
 //
 // - a pxelinux.0, in which case we will ignore the pxelinux and try to parse
 //   pxelinux.cfg/<files>
+//
+// Files are downloaded over TFTP, HTTP or HTTPS. HTTPS servers are trusted
+// if their certificate is signed by the system's roots, or by those of
+// -ca-certs if given.
+//
+// With -measure, the kernel, initramfs and command line of the image that is
+// booted, and the arguments of pxeboot, are measured into the PCRs of the
+// TPM before kexec.
 package main
 
 import (
 	"context"
+	"crypto/x509"
 	"flag"
 	"fmt"
 	"log"
+	"os"
+	"strings"
 	"time"
 
 	"github.com/u-root/u-root/pkg/boot"
 	"github.com/u-root/u-root/pkg/boot/bootcmd"
+	"github.com/u-root/u-root/pkg/boot/measure"
 	"github.com/u-root/u-root/pkg/boot/menu"
 	"github.com/u-root/u-root/pkg/boot/netboot"
 	"github.com/u-root/u-root/pkg/curl"
@@ -45,6 +59,11 @@ var (
 	ipv4        = flag.Bool("ipv4", true, "use IPV4")
 	ipv6        = flag.Bool("ipv6", true, "use IPV6")
 	cmdAppend   = flag.String("cmd", "", "Kernel command to append for each image")
+	measureTPM  = flag.Bool("measure", false, "Measure the kernel, initramfs, command line and the arguments of pxeboot into TPM PCRs before kexec")
+	measureLog  = flag.String("measure-log", "", "Write the TPM event log of -measure to this file")
+	caCerts     = flag.String("ca-certs", "", "Comma-separated PEM files of the CA certificates trusted for HTTPS, instead of the system's")
+
+	plog = ulog.Component("pxeboot")
 )
 
 const (
@@ -52,16 +71,58 @@ const (
 	dhcpTries   = 3
 )
 
+// schemes returns the schemes files are downloaded with: the default ones,
+// and HTTPS trusting the certificates of -ca-certs.
+func schemes() (curl.Schemes, error) {
+	s := make(curl.Schemes)
+	for name, fs := range curl.DefaultSchemes {
+		s.Register(name, fs)
+	}
+	var roots *x509.CertPool
+	if *caCerts != "" {
+		var err error
+		if roots, err = curl.CertPool(strings.Split(*caCerts, ",")...); err != nil {
+			return nil, err
+		}
+	}
+	s.Register("https", curl.NewHTTPSClient(roots))
+	return s.WithProgress(os.Stderr), nil
+}
+
 // NetbootImages requests DHCP on every ifaceNames interface, and parses
 // netboot images from the DHCP leases. Returns bootable OSes.
 func NetbootImages(ifaceNames string) ([]boot.OSImage, error) {
+	s, err := schemes()
+	if err != nil {
+		return nil, err
+	}
+
 	filteredIfs, err := dhclient.Interfaces(ifaceNames)
 	if err != nil {
 		return nil, err
 	}
 
 	if *skipBonded {
-		filteredIfs = dhclient.FilterBondedInterfaces(filteredIfs, *verbose)
+		filteredIfs = dhclient.FilterBondedInterfaces(filteredIfs, plog.Enabled(ulog.LevelDebug))
+	}
+
+	// A static configuration of the ip= kernel parameter replaces DHCP,
+	// and ip=dhcp may name the only interface to use.
+	ipc, err := dhclient.IPConfigFromCmdline()
+	if err != nil {
+		plog.Warningf("Ignoring ip= kernel parameter: %v", err)
+	} else if ipc != nil && !*noNetConfig {
+		var lease dhclient.Lease
+		filteredIfs, lease, err = ipc.Apply(filteredIfs, 30*time.Second)
+		if err != nil {
+			return nil, err
+		}
+		if lease != nil {
+			plog.Infof("Configured %s with %s from ip=", lease.Link().Attrs().Name, lease)
+		}
+		if len(filteredIfs) == 0 {
+			return nil, fmt.Errorf("no interface left by the ip= kernel parameter to get boot information by DHCP")
+		}
 	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), (1<<dhcpTries)*dhcpTimeout)
@@ -70,8 +131,10 @@ func NetbootImages(ifaceNames string) ([]boot.OSImage, error) {
 	c := dhclient.Config{
 		Timeout: dhcpTimeout,
 		Retries: dhcpTries,
+		Logger:  plog,
+		PXE:     true,
 	}
-	if *verbose {
+	if plog.Enabled(ulog.LevelDebug) {
 		c.LogLevel = dhclient.LogSummary
 	}
 	r := dhclient.SendRequests(ctx, filteredIfs, *ipv4, *ipv6, c, 30*time.Second)
@@ -87,24 +150,30 @@ func NetbootImages(ifaceNames string) ([]boot.OSImage, error) {
 			}
 			iname := result.Interface.Attrs().Name
 			if result.Err != nil {
-				log.Printf("Could not configure %s for %s: %v", iname, result.Protocol, result.Err)
+				plog.Errorf("Could not configure %s for %s: %v", iname, result.Protocol, result.Err)
 				continue
 			}
 
 			if *noNetConfig {
-				log.Printf("Skipping configuring %s with lease %s", iname, result.Lease)
+				plog.Infof("Skipping configuring %s with lease %s", iname, result.Lease)
 			} else if err := result.Lease.Configure(); err != nil {
-				log.Printf("Failed to configure lease %s: %v", result.Lease, err)
+				plog.Warningf("Failed to configure lease %s: %v", result.Lease, err)
 				// Boot further regardless of lease configuration result.
 				//
 				// If lease failed, fall back to use locally configured
 				// ip/ipv6 address.
 			}
 
+			if p4, ok := result.Lease.(*dhclient.Packet4); ok {
+				if info, err := p4.PXE(); err == nil {
+					plog.Infof("%s: %s", iname, info)
+				}
+			}
+
 			// Don't use the other context, as it's for the DHCP timeout.
-			imgs, err := netboot.BootImages(context.Background(), ulog.Log, curl.DefaultSchemes, result.Lease)
+			imgs, err := netboot.BootImages(context.Background(), plog, s, result.Lease)
 			if err != nil {
-				log.Printf("Failed to boot lease %v: %v", result.Lease, err)
+				plog.Errorf("Failed to boot lease %v: %v", result.Lease, err)
 				continue
 			}
 
@@ -115,6 +184,10 @@ func NetbootImages(ifaceNames string) ([]boot.OSImage, error) {
 
 func main() {
 	flag.Parse()
+	ulog.ConfigureFromCmdline()
+	if *verbose {
+		ulog.Default.SetComponentLevel("pxeboot", ulog.LevelDebug)
+	}
 	if len(flag.Args()) > 1 {
 		log.Fatalf("Only one regexp-style argument is allowed, e.g.: " + ifName)
 	}
@@ -124,7 +197,7 @@ func main() {
 
 	images, err := NetbootImages(ifName)
 	if err != nil {
-		log.Printf("Netboot failed: %v", err)
+		plog.Errorf("Netboot failed: %v", err)
 	}
 
 	for _, img := range images {
@@ -133,7 +206,19 @@ func main() {
 		})
 	}
 
-	menuEntries := menu.OSImages(*verbose, images...)
+	if *measureTPM {
+		m, err := measure.New()
+		if err != nil {
+			log.Fatalf("Cannot measure: %v", err)
+		}
+		m.LogFile = *measureLog
+		if err := m.MeasureArgs(); err != nil {
+			log.Fatal(err)
+		}
+		m.Attach(images...)
+	}
+
+	menuEntries := menu.OSImages(plog.Enabled(ulog.LevelDebug), images...)
 	menuEntries = append(menuEntries, menu.Reboot{})
 	menuEntries = append(menuEntries, menu.StartShell{})
 
@@ -3,29 +3,219 @@
 // license that can be found in the LICENSE file.
 
 // Blkid prints information about blocks.
+//
+// Synopsis:
+//     blkid [-o FORMAT] [-s TAG,...] [DEVICE...]
+//     blkid -L LABEL | -U UUID
+//
+// Description:
+//     blkid prints the file system type, label and UUID of block devices,
+//     and the partition label and UUID of partitions, as tags:
+//
+//         /dev/sda1: LABEL="EFI" UUID="1234-ABCD" TYPE="vfat" PARTLABEL="EFI System" PARTUUID="..."
+//
+//     Without DEVICE arguments, all block devices with tags are listed.
+//     DEVICE may also be an image.
+//
+//     -L and -U print the device with that file system label or UUID, to
+//     find a root device, e.g. mount $(blkid -L root) /root.
+//
+//     The exit status is 2 if no device has tags, or none matches -L or -U.
+//
+// Options:
+//     -o: full (default), value: only the values, one per line, or export:
+//         TAG=VALUE lines for shells, with an empty line after each device
+//     -s: only print these tags
+//     -L: print the device with this label
+//     -U: print the device with this UUID
 package main
 
 import (
+	"errors"
+	"flag"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 
-	"github.com/u-root/u-root/pkg/mount/block"
+	"github.com/u-root/u-root/pkg/blkid"
 )
 
-func main() {
-	devices, err := block.GetBlockDevices()
+var (
+	format    = flag.String("o", "full", "output format: full, value or export")
+	show      = flag.String("s", "", "comma-separated tags to print")
+	findLabel = flag.String("L", "", "print the device with this label")
+	findUUID  = flag.String("U", "", "print the device with this UUID")
+)
+
+// These are variables so tests can override them.
+var (
+	sysBlock = "/sys/class/block"
+	devDir   = "/dev"
+)
+
+// errNotFound is returned when there is nothing to print.
+var errNotFound = errors.New("not found")
+
+type tag struct {
+	name, value string
+}
+
+// partition returns the tags of the partition table entry of the device
+// name, if it is a partition.
+func partition(name string) []tag {
+	dir, err := filepath.EvalSymlinks(filepath.Join(sysBlock, name))
 	if err != nil {
-		log.Fatal(err)
+		return nil
+	}
+	b, err := ioutil.ReadFile(filepath.Join(dir, "partition"))
+	if err != nil {
+		return nil
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(string(b)))
+	if err != nil {
+		return nil
 	}
+	// Partitions are under their disk in sysfs.
+	f, err := os.Open(filepath.Join(devDir, filepath.Base(filepath.Dir(dir))))
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+	p, err := blkid.ProbePartition(f, n)
+	if err != nil {
+		return nil
+	}
+	return []tag{{"PARTLABEL", p.Label}, {"PARTUUID", p.UUID}}
+}
 
-	for _, device := range devices {
-		fmt.Print(device.DevicePath())
-		if device.FsUUID != "" {
-			fmt.Printf(` UUID="%s"`, device.FsUUID)
+// probe returns the non-empty tags of the device or image at path.
+func probe(path string) []tag {
+	var tags []tag
+	if i, err := blkid.ProbeFile(path); err == nil {
+		tags = append(tags, tag{"LABEL", i.Label}, tag{"UUID", i.UUID}, tag{"TYPE", i.Type})
+	}
+	name := filepath.Base(path)
+	if p, err := filepath.EvalSymlinks(path); err == nil {
+		name = filepath.Base(p)
+	}
+	tags = append(tags, partition(name)...)
+
+	var set []tag
+	for _, t := range tags {
+		if t.value != "" {
+			set = append(set, t)
 		}
-		if device.FSType != "" {
-			fmt.Printf(` TYPE="%s"`, device.FSType)
+	}
+	return set
+}
+
+// devices returns the paths of all block devices.
+func devices() ([]string, error) {
+	fis, err := ioutil.ReadDir(sysBlock)
+	if err != nil {
+		return nil, err
+	}
+	var devs []string
+	for _, fi := range fis {
+		devs = append(devs, filepath.Join(devDir, fi.Name()))
+	}
+	return devs, nil
+}
+
+// escape escapes what shells would interpret in v.
+func escape(v string) string {
+	var b strings.Builder
+	for _, c := range v {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || strings.ContainsRune("-_.:/,+@%", c)) {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(c)
+	}
+	return b.String()
+}
+
+func printTags(w io.Writer, dev string, tags []tag) {
+	switch *format {
+	case "value":
+		for _, t := range tags {
+			fmt.Fprintln(w, t.value)
 		}
-		fmt.Println()
+	case "export":
+		fmt.Fprintf(w, "DEVNAME=%s\n", escape(dev))
+		for _, t := range tags {
+			fmt.Fprintf(w, "%s=%s\n", t.name, escape(t.value))
+		}
+		fmt.Fprintln(w)
+	default:
+		fmt.Fprintf(w, "%s:", dev)
+		for _, t := range tags {
+			v := strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(t.value)
+			fmt.Fprintf(w, " %s=\"%s\"", t.name, v)
+		}
+		fmt.Fprintln(w)
+	}
+}
+
+func run(w io.Writer, args []string) error {
+	switch *format {
+	case "full", "value", "export":
+	default:
+		return fmt.Errorf("unknown output format %q", *format)
+	}
+	devs := args
+	if len(devs) == 0 {
+		var err error
+		if devs, err = devices(); err != nil {
+			return err
+		}
+	}
+
+	find := *findLabel != "" || *findUUID != ""
+	found := false
+	for _, d := range devs {
+		tags := probe(d)
+		if find {
+			for _, t := range tags {
+				if t.name == "LABEL" && t.value == *findLabel || t.name == "UUID" && t.value == *findUUID {
+					fmt.Fprintln(w, d)
+					return nil
+				}
+			}
+			continue
+		}
+		if *show != "" {
+			var shown []tag
+			for _, t := range tags {
+				for _, s := range strings.Split(*show, ",") {
+					if strings.EqualFold(s, t.name) {
+						shown = append(shown, t)
+					}
+				}
+			}
+			tags = shown
+		}
+		if len(tags) == 0 {
+			continue
+		}
+		found = true
+		printTags(w, d, tags)
+	}
+	if !found {
+		return errNotFound
+	}
+	return nil
+}
+
+func main() {
+	flag.Parse()
+	if err := run(os.Stdout, flag.Args()); err == errNotFound {
+		os.Exit(2)
+	} else if err != nil {
+		log.Fatal(err)
 	}
 }
@@ -0,0 +1,114 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/u-root/u-root/pkg/mkfs"
+	"github.com/u-root/u-root/pkg/mount/gpt"
+)
+
+// fakeSystem makes a disk sda with a GPT whose first partition, sda1, has
+// a FAT32, and an empty disk sdb.
+func fakeSystem(t *testing.T) (string, string) {
+	dir, err := ioutil.TempDir("", "blkid")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sysBlock = filepath.Join(dir, "sys/class/block")
+	devDir = filepath.Join(dir, "dev")
+	for _, d := range []string{sysBlock, devDir, filepath.Join(dir, "sys/devices/sda/sda1"), filepath.Join(dir, "sys/devices/sdb")} {
+		if err := os.MkdirAll(d, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, n := range []string{"sda", "sda/sda1", "sdb"} {
+		if err := os.Symlink(filepath.Join(dir, "sys/devices", n), filepath.Join(sysBlock, filepath.Base(n))); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "sys/devices/sda/sda1/partition"), []byte("1\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	const sectors = 1 << 18
+	disk, err := os.Create(filepath.Join(devDir, "sda"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer disk.Close()
+	if err := disk.Truncate(sectors * gpt.BlockSize); err != nil {
+		t.Fatal(err)
+	}
+	p, err := gpt.NewTable(sectors)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.Add(1, gpt.Types["efi"], 0, 0, "EFI System"); err != nil {
+		t.Fatal(err)
+	}
+	if err := gpt.Write(disk, p); err != nil {
+		t.Fatal(err)
+	}
+
+	part, err := os.Create(filepath.Join(devDir, "sda1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer part.Close()
+	if err := part.Truncate(64 << 20); err != nil {
+		t.Fatal(err)
+	}
+	if err := mkfs.FAT32(part, 64<<20, &mkfs.FATOptions{Label: "EFI", VolumeID: 0x1234abcd}); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(devDir, "sdb"), make([]byte, 4096), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return dir, p.Primary.Parts[0].UniqueGUID.String()
+}
+
+func TestRun(t *testing.T) {
+	dir, partUUID := fakeSystem(t)
+	defer os.RemoveAll(dir)
+	sda1 := filepath.Join(devDir, "sda1")
+
+	for _, tt := range []struct {
+		name   string
+		format string
+		show   string
+		label  string
+		args   []string
+		want   string
+	}{
+		{"all", "full", "", "", nil, sda1 + `: LABEL="EFI" UUID="1234-ABCD" TYPE="vfat" PARTLABEL="EFI System" PARTUUID="` + partUUID + "\"\n"},
+		{"export", "export", "", "", []string{sda1}, "DEVNAME=" + sda1 + "\nLABEL=EFI\nUUID=1234-ABCD\nTYPE=vfat\nPARTLABEL=EFI\\ System\nPARTUUID=" + partUUID + "\n\n"},
+		{"value", "value", "type,partuuid", "", nil, "vfat\n" + partUUID + "\n"},
+		{"label", "full", "", "EFI", nil, sda1 + "\n"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			*format, *show, *findLabel = tt.format, tt.show, tt.label
+			defer func() { *format, *show, *findLabel = "full", "", "" }()
+			var b bytes.Buffer
+			if err := run(&b, tt.args); err != nil {
+				t.Fatal(err)
+			}
+			if b.String() != tt.want {
+				t.Errorf("blkid = %q, want %q", b.String(), tt.want)
+			}
+		})
+	}
+
+	*findUUID = "no-such-uuid"
+	defer func() { *findUUID = "" }()
+	if err := run(ioutil.Discard, nil); err != errNotFound {
+		t.Errorf("blkid -U no-such-uuid = %v, want %v", err, errNotFound)
+	}
+}
@@ -5,7 +5,12 @@
 // cp copies files.
 //
 // Synopsis:
-//     cp [-rRfivwP] FROM... TO
+//     cp [-rRfivwPap] [--sparse=WHEN] [--reflink=WHEN] FROM... TO
+//
+// Description:
+//     Regular files are cloned where the file system supports it, and
+//     otherwise copied keeping their holes, unless --reflink or --sparse
+//     say otherwise.
 //
 // Options:
 //     -w n: number of worker goroutines
@@ -13,13 +18,21 @@
 //     -r: alias to -R recursive mode
 //     -i: prompt about overwriting file
 //     -f: force overwrite files
-//     -v: verbose copy mode
+//     -v: verbose copy mode, printing the progress of copying large files
 //     -P: don't follow symlinks
+//     -p: preserve mode, ownership and timestamps
+//     -a: archive mode, the same as -R -P -p
+//     --sparse=WHEN: auto or always keep the holes of files, never fills them
+//     --reflink=WHEN: auto clones files where the file system supports it,
+//          always fails where it does not, never copies them
+//
+// Ownership is only preserved when running as root.
 package main
 
 import (
 	"bufio"
 	"fmt"
+	"io"
 	"log"
 	"os"
 	"path/filepath"
@@ -27,6 +40,7 @@ import (
 
 	flag "github.com/spf13/pflag"
 	"github.com/u-root/u-root/pkg/cp"
+	"github.com/u-root/u-root/pkg/progress"
 )
 
 var (
@@ -36,6 +50,10 @@ var (
 		force            bool
 		verbose          bool
 		noFollowSymlinks bool
+		preserve         bool
+		archive          bool
+		sparse           string
+		reflink          string
 	}
 	input = bufio.NewReader(os.Stdin)
 )
@@ -43,7 +61,7 @@ var (
 func init() {
 	defUsage := flag.Usage
 	flag.Usage = func() {
-		os.Args[0] = "cp [-wRrifvP] file[s] ... dest"
+		os.Args[0] = "cp [-wRrifvPap] [--sparse=WHEN] [--reflink=WHEN] file[s] ... dest"
 		defUsage()
 	}
 	flag.BoolVarP(&flags.recursive, "RECURSIVE", "R", false, "copy file hierarchies")
@@ -52,6 +70,10 @@ func init() {
 	flag.BoolVarP(&flags.force, "force", "f", false, "force overwrite files")
 	flag.BoolVarP(&flags.verbose, "verbose", "v", false, "verbose copy mode")
 	flag.BoolVarP(&flags.noFollowSymlinks, "no-dereference", "P", false, "don't follow symlinks")
+	flag.BoolVarP(&flags.preserve, "preserve", "p", false, "preserve mode, ownership and timestamps")
+	flag.BoolVarP(&flags.archive, "archive", "a", false, "archive mode, the same as -R -P -p")
+	flag.StringVar(&flags.sparse, "sparse", "auto", "keep holes of files: auto, always or never")
+	flag.StringVar(&flags.reflink, "reflink", "auto", "clone files: auto, always or never")
 }
 
 // promptOverwrite ask if the user wants overwrite file
@@ -69,6 +91,67 @@ func promptOverwrite(dst string) (bool, error) {
 	return true, nil
 }
 
+// progressMin is the size of the files whose progress cp -v prints.
+const progressMin = 64 << 20
+
+// verboseProgress returns a cp.Options.Progress printing the progress of
+// copying large files to w, and a function stopping the meter of a copy
+// that ended before all of the file was copied, e.g. on an error.
+func verboseProgress(w io.Writer) (func(src, dst string, done, size int64), func()) {
+	var (
+		meter *progress.Meter
+		file  string
+	)
+	stop := func() {
+		if meter != nil {
+			meter.Stop()
+			meter = nil
+		}
+	}
+	return func(src, dst string, done, size int64) {
+		if size < progressMin {
+			return
+		}
+		if meter != nil && src != file {
+			stop()
+		}
+		if meter == nil {
+			meter, file = progress.New(w, src, size), src
+			meter.Start()
+		}
+		meter.Set(done)
+		if done >= size {
+			stop()
+		}
+	}, stop
+}
+
+// strategy returns how files are copied for --sparse and --reflink.
+func strategy(sparse, reflink string) (cp.Strategy, error) {
+	switch sparse {
+	case "auto", "always", "never":
+	default:
+		return 0, fmt.Errorf("invalid --sparse %q: want auto, always or never", sparse)
+	}
+	switch reflink {
+	case "always":
+		return cp.Reflink, nil
+	case "never":
+		if sparse == "never" {
+			return cp.ReadWrite, nil
+		}
+		return cp.Sparse, nil
+	case "auto":
+		// Clones have the same holes, so they are only avoided for
+		// --sparse=never.
+		if sparse == "never" {
+			return cp.ReadWrite, nil
+		}
+		return cp.Auto, nil
+	}
+	return 0, fmt.Errorf("invalid --reflink %q: want auto, always or never", reflink)
+}
+
 // cpArgs is a function whose eval the args
 // and make decisions for copyfiles
 func cpArgs(args []string) error {
@@ -82,8 +165,20 @@ func cpArgs(args []string) error {
 		log.Fatalf("is not a directory: %s\n", to)
 	}
 
+	recursive := flags.recursive || flags.archive
+	preserve := flags.preserve || flags.archive
+	st, err := strategy(flags.sparse, flags.reflink)
+	if err != nil {
+		log.Printf("cp: %v", err)
+		return err
+	}
 	opts := cp.Options{
-		NoFollowSymlinks: flags.noFollowSymlinks,
+		NoFollowSymlinks: flags.noFollowSymlinks || flags.archive,
+		PreserveMode:     preserve,
+		// Only root may give files away.
+		PreserveOwner: preserve && os.Geteuid() == 0,
+		PreserveTimes: preserve,
+		Strategy:      st,
 
 		// cp the command makes sure that
 		//
@@ -92,7 +187,7 @@ func cpArgs(args []string) error {
 		//     one is already there.
 		PreCallback: func(src, dst string, srcfi os.FileInfo) error {
 			// check if src is dir
-			if !flags.recursive && srcfi.IsDir() {
+			if !recursive && srcfi.IsDir() {
 				log.Printf("cp: -r not specified, omitting directory %s", src)
 				return cp.ErrSkip
 			}
@@ -130,6 +225,10 @@ func cpArgs(args []string) error {
 			}
 		},
 	}
+	stopProgress := func() {}
+	if flags.verbose {
+		opts.Progress, stopProgress = verboseProgress(os.Stderr)
+	}
 
 	var lastErr error
 	for _, file := range from {
@@ -137,11 +236,12 @@ func cpArgs(args []string) error {
 		if todir {
 			dst = filepath.Join(dst, filepath.Base(file))
 		}
-		if flags.recursive {
+		if recursive {
 			err = opts.CopyTree(file, dst)
 		} else {
 			err = opts.Copy(file, dst)
 		}
+		stopProgress()
 		if err != nil {
 			log.Printf("cp: %v\n", err)
 			lastErr = err
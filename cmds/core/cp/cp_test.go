@@ -16,6 +16,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/u-root/u-root/pkg/cp"
 	"github.com/u-root/u-root/pkg/cp/cmp"
@@ -34,6 +35,10 @@ func resetFlags() {
 	flags.force = false
 	flags.verbose = false
 	flags.noFollowSymlinks = false
+	flags.preserve = false
+	flags.archive = false
+	flags.sparse = "auto"
+	flags.reflink = "auto"
 }
 
 // randomFile create a random file with random content
@@ -293,3 +298,115 @@ func TestCpSymlink(t *testing.T) {
 		}
 	})
 }
+
+// TestCpArchive tests that -a copies trees with their modes, times and
+// symlinks.
+// cmd-line equivalent: $ cp -a src dst
+func TestCpArchive(t *testing.T) {
+	flags.archive = true
+	defer resetFlags()
+
+	tempDir, err := ioutil.TempDir("", "TestCpArchive")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	srcDir := filepath.Join(tempDir, "src")
+	if err := os.MkdirAll(filepath.Join(srcDir, "sub"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Join(srcDir, "sub", "file")
+	if err := ioutil.WriteFile(file, []byte("archived"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	// The umask does not apply with -a.
+	if err := os.Chmod(file, 0777); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink("sub/file", filepath.Join(srcDir, "link")); err != nil {
+		t.Fatal(err)
+	}
+	old := time.Date(2001, 2, 3, 4, 5, 6, 0, time.UTC)
+	for _, n := range []string{file, filepath.Join(srcDir, "sub"), srcDir} {
+		if err := os.Chtimes(n, old, old); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	dstDir := filepath.Join(tempDir, "dst")
+	if err := cpArgs([]string{srcDir, dstDir}); err != nil {
+		t.Fatalf("cp -a %q %q = %v, want nil", srcDir, dstDir, err)
+	}
+	if err := cmp.IsEqualTree(cp.NoFollowSymlinks, srcDir, dstDir); err != nil {
+		t.Fatalf("cp -a %q %q: file trees not equal: %v", srcDir, dstDir, err)
+	}
+	for _, n := range []string{"", "sub", "sub/file"} {
+		fi, err := os.Stat(filepath.Join(dstDir, n))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !fi.ModTime().Equal(old) {
+			t.Errorf("%q was modified at %v, want %v", n, fi.ModTime(), old)
+		}
+	}
+	if fi, err := os.Stat(filepath.Join(dstDir, "sub/file")); err != nil || fi.Mode().Perm() != 0777 {
+		t.Errorf("sub/file has mode %v, %v, want %v", fi.Mode(), err, os.FileMode(0777))
+	}
+	if fi, err := os.Lstat(filepath.Join(dstDir, "link")); err != nil || fi.Mode()&os.ModeSymlink == 0 {
+		t.Errorf("link was not copied as a symlink: %v", err)
+	}
+}
+
+func TestStrategy(t *testing.T) {
+	for _, tt := range []struct {
+		sparse, reflink string
+		want            cp.Strategy
+		err             bool
+	}{
+		{sparse: "auto", reflink: "auto", want: cp.Auto},
+		{sparse: "always", reflink: "auto", want: cp.Auto},
+		{sparse: "never", reflink: "auto", want: cp.ReadWrite},
+		{sparse: "auto", reflink: "always", want: cp.Reflink},
+		{sparse: "auto", reflink: "never", want: cp.Sparse},
+		{sparse: "never", reflink: "never", want: cp.ReadWrite},
+		{sparse: "sometimes", reflink: "auto", err: true},
+		{sparse: "auto", reflink: "maybe", err: true},
+	} {
+		got, err := strategy(tt.sparse, tt.reflink)
+		if (err != nil) != tt.err || got != tt.want {
+			t.Errorf("strategy(%q, %q) = %v, %v, want %v, error %v", tt.sparse, tt.reflink, got, err, tt.want, tt.err)
+		}
+	}
+}
+
+func TestVerboseProgress(t *testing.T) {
+	var b bytes.Buffer
+	p, stop := verboseProgress(&b)
+
+	// The copy of a fails half way and the copy of b succeeds.
+	p("a", "dst/a", progressMin/2, progressMin)
+	stop()
+	p("b", "dst/b", 0, progressMin)
+	p("b", "dst/b", progressMin, progressMin)
+	// c shrank while it was copied and d is too small to report.
+	p("c", "dst/c", progressMin/2, progressMin)
+	p("d", "dst/d", 10, progressMin-1)
+	stop()
+	stop()
+
+	// Each meter reports when it starts and when it stops.
+	var got []string
+	for _, line := range strings.Split(strings.TrimSpace(b.String()), "\n") {
+		f := strings.Fields(line)
+		got = append(got, f[0]+" "+f[6])
+	}
+	want := []string{
+		"a: (0%),", "a: (50%),",
+		"b: (0%),", "b: (100%),",
+		"c: (0%),", "c: (50%),",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("got reports\n%s\nwant\n%s", b.String(), strings.Join(want, "\n"))
+	}
+}
@@ -16,6 +16,11 @@
 //     i: output files from a stdin stream
 //     t: print table of contents
 //     -v: debug prints
+//     -progress: print the progress of reading or writing the archive
+//         to stderr
+//     -reproducible: in o mode, sort the records, drop their timestamps and
+//         owners, and hard link files of identical content, so the same
+//         files always give the same archive
 //
 // Bugs: in i mode, it can't use non-seekable stdin, i.e. a pipe. Yep, this sucks.
 // But if we implement seek on such things, we have to do it by reading, which
@@ -34,14 +39,30 @@ import (
 	"os"
 
 	"github.com/u-root/u-root/pkg/cpio"
+	"github.com/u-root/u-root/pkg/progress"
 )
 
 var (
 	debug  = func(string, ...interface{}) {}
 	d      = flag.Bool("v", false, "Debug prints")
 	format = flag.String("H", "newc", "format")
+	prog   = flag.Bool("progress", false, "Print the progress to stderr")
+	repro  = flag.Bool("reproducible", false, "Write a reproducible archive, with identical files hard linked")
 )
 
+// newReader returns a reader of the archive on stdin. With -progress, the
+// bytes read are counted in m, if stdin is seekable.
+func newReader(archiver cpio.RecordFormat, m *progress.Meter) (cpio.RecordReader, error) {
+	if m != nil {
+		if fi, err := os.Stdin.Stat(); err == nil && fi.Mode().IsRegular() {
+			m.Total = fi.Size()
+			m.Start()
+			return archiver.Reader(m.ReaderAt(os.Stdin)), nil
+		}
+	}
+	return archiver.NewFileReader(os.Stdin)
+}
+
 func usage() {
 	log.Fatalf("Usage: cpio")
 }
@@ -64,12 +85,18 @@ func main() {
 		log.Fatalf("Format %q not supported: %v", *format, err)
 	}
 
+	var meter *progress.Meter
+	if *prog {
+		meter = progress.New(os.Stderr, "cpio", 0)
+		defer meter.Stop()
+	}
+
 	switch op {
 	case "i":
 		var inums map[uint64]string
 		inums = make(map[uint64]string)
 
-		rr, err := archiver.NewFileReader(os.Stdin)
+		rr, err := newReader(archiver, meter)
 		if err != nil {
 			log.Fatal(err)
 		}
@@ -128,7 +155,16 @@ func main() {
 		}
 
 	case "o":
-		rw := archiver.Writer(os.Stdout)
+		var out io.Writer = os.Stdout
+		if meter != nil {
+			meter.Start()
+			out = meter.Writer(out)
+		}
+		rw := archiver.Writer(out)
+		if *repro {
+			// The records are written by the trailer.
+			rw = cpio.NewReproducibleWriter(rw)
+		}
 		cr := cpio.NewRecorder()
 		scanner := bufio.NewScanner(os.Stdin)
 
@@ -151,7 +187,7 @@ func main() {
 		}
 
 	case "t":
-		rr, err := archiver.NewFileReader(os.Stdin)
+		rr, err := newReader(archiver, meter)
 		if err != nil {
 			log.Fatal(err)
 		}
@@ -16,15 +16,28 @@
 //     -bs n:    input and output block size (default=0)
 //     -skip n:  skip n ibs-sized input blocks before reading (default=0)
 //     -seek n:  seek n obs-sized output blocks before writing (default=0)
-//     -conv s:  comma separated list of conversions (none|notrunc)
+//     -conv s:  comma separated list of conversions:
+//         none:    do not convert (default)
+//         notrunc: do not truncate the output file
+//         sync:    pad every input block with NULs to ibs
+//         fsync:   write the output file to storage before finishing
+//         sparse:  seek over output blocks of NULs, leaving holes
 //     -count n: copy only n ibs-sized input blocks
 //     -if:      defaults to stdin
 //     -of:      defaults to stdout
-//     -oflag:   comma separated list of out flags (none|sync|dsync)
+//     -iflag:   comma separated list of in flags (none|sync|dsync|direct|
+//               count_bytes|skip_bytes)
+//     -oflag:   comma separated list of out flags (none|sync|dsync|direct|
+//               seek_bytes)
+//     With count_bytes, skip_bytes and seek_bytes, count, skip and seek are
+//     in bytes rather than blocks. direct opens files with O_DIRECT, which
+//     needs block sizes the device supports; it is turned off for a short
+//     last output block.
 //     -status:  print transfer stats to stderr, can be one of:
 //         none:     do not display
 //         xfer:     print on completion (default)
 //         progress: print throughout transfer (GNU)
+//     The transfer stats are also printed on SIGUSR1, unless status is none.
 //
 // Notes:
 //     Because UTF-8 clashes with block-oriented copying, `conv=lcase` and
@@ -44,19 +57,22 @@ import (
 	"sync"
 	"sync/atomic"
 	"time"
+	"unsafe"
 
 	"github.com/rck/unit"
+	"github.com/u-root/u-root/pkg/progress"
 )
 
 var (
 	ibs, obs, bs *unit.Value
 	skip         = flag.Int64("skip", 0, "skip N ibs-sized blocks before reading")
 	seek         = flag.Int64("seek", 0, "seek N obs-sized blocks before writing")
-	conv         = flag.String("conv", "none", "comma separated list of conversions (none|notrunc)")
+	conv         = flag.String("conv", "none", "comma separated list of conversions (none|notrunc|sync|fsync|sparse)")
 	count        = flag.Int64("count", math.MaxInt64, "copy only N input blocks")
 	inName       = flag.String("if", "", "Input file")
 	outName      = flag.String("of", "", "Output file")
-	oFlag        = flag.String("oflag", "none", "comma separated list of out flags (none|sync|dsync)")
+	iFlag        = flag.String("iflag", "none", "comma separated list of in flags (none|sync|dsync|direct|count_bytes|skip_bytes)")
+	oFlag        = flag.String("oflag", "none", "comma separated list of out flags (none|sync|dsync|direct|seek_bytes)")
 	status       = flag.String("status", "xfer", "display status of transfer (none|xfer|progress)")
 
 	bytesWritten int64 // access atomically, must be global for correct alignedness
@@ -80,6 +96,39 @@ var flagMap = map[string]bitClearAndSet{
 
 var allowedFlags = os.O_TRUNC | os.O_SYNC
 
+// These are set by the conv, iflag and oflag values which are not open(2)
+// flags.
+var (
+	convSync   bool
+	convFsync  bool
+	convSparse bool
+	countBytes bool
+	skipBytes  bool
+	seekBytes  bool
+)
+
+// oDirect is O_DIRECT, where it is supported.
+var oDirect int
+
+var convOpts = map[string]*bool{
+	"sync":   &convSync,
+	"fsync":  &convFsync,
+	"sparse": &convSparse,
+}
+
+var iFlagOpts = map[string]*bool{
+	"count_bytes": &countBytes,
+	"skip_bytes":  &skipBytes,
+}
+
+var oFlagOpts = map[string]*bool{
+	"seek_bytes": &seekBytes,
+}
+
+// directAlign is the alignment of buffers and the size of blocks written
+// with O_DIRECT.
+const directAlign = 4096
+
 // intermediateBuffer is a buffer that one can write to and read from.
 type intermediateBuffer interface {
 	io.ReaderFrom
@@ -92,6 +141,9 @@ type chunkedBuffer struct {
 	length   int64
 	data     []byte
 	flags    int
+
+	// pad pads short input blocks with NULs, for conv=sync.
+	pad bool
 }
 
 func init() {
@@ -116,11 +168,20 @@ func newChunkedBuffer(inChunkSize int64, outChunkSize int64, flags int) intermed
 	return &chunkedBuffer{
 		outChunk: outChunkSize,
 		length:   0,
-		data:     make([]byte, inChunkSize),
+		data:     alignedBuffer(inChunkSize),
 		flags:    flags,
+		pad:      convSync,
 	}
 }
 
+// alignedBuffer returns a buffer of n bytes aligned to directAlign, as
+// O_DIRECT needs.
+func alignedBuffer(n int64) []byte {
+	b := make([]byte, n+directAlign)
+	off := directAlign - int(uintptr(unsafe.Pointer(&b[0]))%directAlign)
+	return b[off : int64(off)+n : int64(off)+n]
+}
+
 // ReadFrom reads an inChunkSize-sized chunk from r into the buffer.
 func (cb *chunkedBuffer) ReadFrom(r io.Reader) (int64, error) {
 	n, err := r.Read(cb.data)
@@ -130,7 +191,13 @@ func (cb *chunkedBuffer) ReadFrom(r io.Reader) (int64, error) {
 	if n == 0 && err == nil {
 		return 0, io.EOF
 	}
-	return int64(n), err
+	if cb.pad && n > 0 && n < len(cb.data) {
+		for i := n; i < len(cb.data); i++ {
+			cb.data[i] = 0
+		}
+		cb.length = int64(len(cb.data))
+	}
+	return cb.length, err
 }
 
 // WriteTo writes from the buffer to w in outChunkSize-sized chunks.
@@ -322,29 +389,26 @@ func (s *sectionReader) Read(p []byte) (int, error) {
 	return n, err
 }
 
-// inFile opens the input file and seeks to the right position.
-func inFile(name string, inputBytes int64, skip int64, count int64) (io.Reader, error) {
-	maxRead := int64(math.MaxInt64)
-	if count != math.MaxInt64 {
-		maxRead = count * inputBytes
-	}
-
+// inFile opens the input file and seeks to the right position, skip bytes
+// in, to read at most maxRead bytes.
+func inFile(name string, skip int64, maxRead int64, flags int) (io.Reader, error) {
 	if name == "" {
 		// os.Stdin is an io.ReaderAt, but you can't actually call
 		// pread(2) on it, so use the copying section reader.
-		return newStreamSectionReader(os.Stdin, inputBytes*skip, maxRead), nil
+		return newStreamSectionReader(os.Stdin, skip, maxRead), nil
 	}
 
-	in, err := os.Open(name)
+	in, err := os.OpenFile(name, os.O_RDONLY|(flags&allowedFlags&^os.O_TRUNC), 0)
 	if err != nil {
 		return nil, fmt.Errorf("error opening input file %q: %v", name, err)
 	}
-	return io.NewSectionReader(in, inputBytes*skip, maxRead), nil
+	return io.NewSectionReader(in, skip, maxRead), nil
 }
 
-// outFile opens the output file and seeks to the right position.
-func outFile(name string, outputBytes int64, seek int64, flags int) (io.Writer, error) {
-	var out io.WriteSeeker
+// outFile opens the output file and seeks to the right position, seek
+// bytes in.
+func outFile(name string, seek int64, flags int) (*os.File, error) {
+	var out *os.File
 	var err error
 	if name == "" {
 		out = os.Stdout
@@ -354,81 +418,104 @@ func outFile(name string, outputBytes int64, seek int64, flags int) (io.Writer,
 			return nil, fmt.Errorf("error opening output file %q: %v", name, err)
 		}
 	}
-	if seek*outputBytes != 0 {
-		if _, err := out.Seek(seek*outputBytes, io.SeekCurrent); err != nil {
+	if seek != 0 {
+		if _, err := out.Seek(seek, io.SeekCurrent); err != nil {
 			return nil, fmt.Errorf("error seeking output file: %v", err)
 		}
 	}
 	return out, nil
 }
 
-type progressData struct {
-	mode     string // one of: none, xfer, progress
-	start    time.Time
-	variable *int64 // must be aligned for atomic operations
-	quit     chan struct{}
-}
-
-func progressBegin(mode string, variable *int64) (ProgressData *progressData) {
-	p := &progressData{
-		mode:     mode,
-		start:    time.Now(),
-		variable: variable,
-	}
-	if p.mode == "progress" {
-		p.print()
-		// Print progress in a separate goroutine.
-		p.quit = make(chan struct{}, 1)
-		go func() {
-			ticker := time.NewTicker(1 * time.Second)
-			defer ticker.Stop()
-			for {
-				select {
-				case <-ticker.C:
-					p.print()
-				case <-p.quit:
-					return
-				}
-			}
-		}()
+// sparseWriter seeks over blocks of NULs instead of writing them, for
+// conv=sparse.
+type sparseWriter struct {
+	f *os.File
+	w io.Writer
+}
+
+func (s *sparseWriter) Write(p []byte) (int, error) {
+	for _, b := range p {
+		if b != 0 {
+			return s.w.Write(p)
+		}
+	}
+	if _, err := s.f.Seek(int64(len(p)), io.SeekCurrent); err != nil {
+		return 0, err
 	}
-	return p
+	return len(p), nil
 }
 
-func (p *progressData) end() {
-	if p.mode == "progress" {
-		// Properly synchronize goroutine.
-		p.quit <- struct{}{}
-		p.quit <- struct{}{}
+// finish extends the file over the blocks seeked over last.
+func (s *sparseWriter) finish() error {
+	off, err := s.f.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
 	}
-	if p.mode == "progress" || p.mode == "xfer" {
-		// Print grand total.
-		p.print()
-		fmt.Fprint(os.Stderr, "\n")
+	fi, err := s.f.Stat()
+	if err != nil {
+		return err
+	}
+	if fi.Size() < off {
+		return s.f.Truncate(off)
+	}
+	return nil
+}
+
+// directWriter writes to a file opened with O_DIRECT, which only takes
+// whole blocks. Like GNU dd, it turns O_DIRECT off for a short last block.
+type directWriter struct {
+	f *os.File
+}
+
+func (d directWriter) Write(p []byte) (int, error) {
+	if len(p)%directAlign != 0 {
+		if err := clearDirect(d.f); err != nil {
+			return 0, err
+		}
 	}
+	return d.f.Write(p)
 }
 
-// With "status=progress", this is called from 3 places:
-// - Once at the beginning to appear responsive
-// - Every 1s afterwards
-// - Once at the end so the final value is accurate
-func (p *progressData) print() {
-	elapse := time.Since(p.start)
-	n := atomic.LoadInt64(p.variable)
-	d := float64(n)
+// ddStats formats the statistics like GNU dd.
+func ddStats(s progress.Stats) string {
+	d := float64(s.Done)
 	const mib = 1024 * 1024
 	const mb = 1000 * 1000
-	// The ANSI escape may be undesirable to some eyes.
-	if p.mode == "progress" {
-		os.Stderr.Write([]byte("\033[2K\r"))
+	return fmt.Sprintf("%d bytes (%.3f MB, %.3f MiB) copied, %.3f s, %.3f MB/s",
+		s.Done, d/mb, d/mib, s.Elapsed.Seconds(), s.Rate()/mb)
+}
+
+// progressBegin starts reporting the transfer to stderr. With
+// "status=progress", the statistics are printed every second and, as in
+// every mode but "none", on SIGUSR1.
+func progressBegin(mode string, variable *int64) *progress.Meter {
+	m := progress.New(os.Stderr, "", 0)
+	m.Format = ddStats
+	m.Count = func() int64 { return atomic.LoadInt64(variable) }
+	switch mode {
+	case "progress":
+		m.Interval = time.Second
+		m.Start()
+	case "xfer":
+		m.Style = progress.Lines
+		m.Interval = -1
+		m.Start()
+	}
+	return m
+}
+
+// progressEnd prints the grand total, unless mode is "none".
+func progressEnd(m *progress.Meter, mode string) {
+	if mode != "none" {
+		m.Stop()
 	}
-	fmt.Fprintf(os.Stderr, "%d bytes (%.3f MB, %.3f MiB) copied, %.3f s, %.3f MB/s",
-		n, d/mb, d/mib, elapse.Seconds(), float64(d)/elapse.Seconds()/mb)
 }
 
 func usage() {
-	log.Fatal(`Usage: dd [if=file] [of=file] [conv=none|notrunc] [seek=#] [skip=#]
-			     [count=#] [bs=#] [ibs=#] [obs=#] [status=none|xfer|progress] [oflag=none|sync|dsync]
+	log.Fatal(`Usage: dd [if=file] [of=file] [conv=none|notrunc|sync|fsync|sparse] [seek=#] [skip=#]
+			     [count=#] [bs=#] [ibs=#] [obs=#] [status=none|xfer|progress]
+			     [iflag=none|sync|dsync|direct|count_bytes|skip_bytes]
+			     [oflag=none|sync|dsync|direct|seek_bytes]
 		options may also be invoked Go-style as -opt value or -opt=value
 		bs, if specified, overrides ibs and obs`)
 }
@@ -449,6 +536,25 @@ func convertArgs(osArgs []string) []string {
 	return args
 }
 
+// parseFlags applies the comma separated list of values s of the argument
+// arg to flags, with m for open(2) flags and opts for the others.
+func parseFlags(arg, s string, flags *int, m map[string]bitClearAndSet, opts map[string]*bool) {
+	if s == "none" {
+		return
+	}
+	for _, f := range strings.Split(s, ",") {
+		if v, ok := m[f]; ok {
+			*flags &= ^v.clear
+			*flags |= v.set
+		} else if b, ok := opts[f]; ok {
+			*b = true
+		} else {
+			log.Printf("unknown argument %s=%s", arg, f)
+			usage()
+		}
+	}
+}
+
 func main() {
 	// rather than, in essence, recreating all the apparatus of flag.xxxx
 	// with the if= bits, including dup checking, conversion, etc. we just
@@ -463,35 +569,17 @@ func main() {
 
 	// Convert conv argument to bit set.
 	flags := os.O_TRUNC
-	if *conv != "none" {
-		for _, c := range strings.Split(*conv, ",") {
-			if v, ok := convMap[c]; ok {
-				flags &= ^v.clear
-				flags |= v.set
-			} else {
-				log.Printf("unknown argument conv=%s", c)
-				usage()
-			}
-		}
-	}
+	parseFlags("conv", *conv, &flags, convMap, convOpts)
 
-	// Convert oflag argument to bit set.
-	if *oFlag != "none" {
-		for _, f := range strings.Split(*oFlag, ",") {
-			if v, ok := flagMap[f]; ok {
-				flags &= ^v.clear
-				flags |= v.set
-			} else {
-				log.Printf("unknown argument oflag=%s", f)
-				usage()
-			}
-		}
-	}
+	// Convert iflag and oflag arguments to bit sets.
+	var inFlags int
+	parseFlags("iflag", *iFlag, &inFlags, flagMap, iFlagOpts)
+	parseFlags("oflag", *oFlag, &flags, flagMap, oFlagOpts)
 
 	if *status != "none" && *status != "xfer" && *status != "progress" {
 		usage()
 	}
-	progress := progressBegin(*status, &bytesWritten)
+	meter := progressBegin(*status, &bytesWritten)
 
 	// bs = both 'ibs' and 'obs' (IEEE Std 1003.1 - 2013)
 	if bs.IsSet {
@@ -499,17 +587,50 @@ func main() {
 		obs = bs
 	}
 
-	in, err := inFile(*inName, ibs.Value, *skip, *count)
+	skipped, maxRead := *skip*ibs.Value, int64(math.MaxInt64)
+	if skipBytes {
+		skipped = *skip
+	}
+	if countBytes {
+		maxRead = *count
+	} else if *count != math.MaxInt64 {
+		maxRead = *count * ibs.Value
+	}
+	seeked := *seek * obs.Value
+	if seekBytes {
+		seeked = *seek
+	}
+
+	in, err := inFile(*inName, skipped, maxRead, inFlags)
 	if err != nil {
 		log.Fatal(err)
 	}
-	out, err := outFile(*outName, obs.Value, *seek, flags)
+	out, err := outFile(*outName, seeked, flags)
 	if err != nil {
 		log.Fatal(err)
 	}
-	if err := parallelChunkedCopy(in, out, ibs.Value, obs.Value, flags); err != nil {
+	var w io.Writer = out
+	if oDirect != 0 && flags&oDirect != 0 {
+		w = directWriter{out}
+	}
+	var sparse *sparseWriter
+	if fi, err := out.Stat(); err == nil && fi.Mode().IsRegular() && convSparse {
+		sparse = &sparseWriter{f: out, w: w}
+		w = sparse
+	}
+	if err := parallelChunkedCopy(in, w, ibs.Value, obs.Value, flags); err != nil {
 		log.Fatal(err)
 	}
+	if sparse != nil {
+		if err := sparse.finish(); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if convFsync {
+		if err := out.Sync(); err != nil {
+			log.Fatal(err)
+		}
+	}
 
-	progress.end()
+	progressEnd(meter, *status)
 }
@@ -0,0 +1,14 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build !linux
+
+package main
+
+import "os"
+
+// clearDirect does nothing: O_DIRECT is only supported on Linux.
+func clearDirect(f *os.File) error {
+	return nil
+}
@@ -235,6 +235,45 @@ func TestFiles(t *testing.T) {
 			inFile:   []byte("y: defaults"),
 			expected: []byte("y: defaults"),
 		},
+		{
+			name:     "pad input blocks",
+			flags:    []string{"bs=4", "conv=sync"},
+			inFile:   []byte("hello"),
+			expected: []byte("hello\x00\x00\x00"),
+		},
+		{
+			name:     "fsync",
+			flags:    []string{"conv=fsync"},
+			inFile:   []byte("z: defaults"),
+			expected: []byte("z: defaults"),
+		},
+		{
+			name:     "skip and count in bytes",
+			flags:    []string{"bs=4", "skip=6", "count=5", "iflag=skip_bytes,count_bytes"},
+			inFile:   []byte("hello world....."),
+			expected: []byte("world"),
+		},
+		{
+			name:     "seek in bytes",
+			flags:    []string{"bs=4", "seek=2", "oflag=seek_bytes", "conv=notrunc"},
+			inFile:   []byte("XY"),
+			outFile:  []byte("abcde"),
+			expected: []byte("abXYe"),
+		},
+		{
+			name:     "sparse",
+			flags:    []string{"bs=4", "conv=sparse"},
+			inFile:   []byte("ab\x00\x00\x00\x00\x00\x00\x00\x00cd\x00\x00\x00\x00"),
+			expected: []byte("ab\x00\x00\x00\x00\x00\x00\x00\x00cd\x00\x00\x00\x00"),
+		},
+		{
+			// Blocks of NULs are seeked over, not written.
+			name:     "sparse without truncating",
+			flags:    []string{"bs=4", "conv=sparse,notrunc"},
+			inFile:   []byte("\x00\x00\x00\x00XY\x00\x00"),
+			outFile:  []byte("abcdefgh"),
+			expected: []byte("abcdXY\x00\x00"),
+		},
 	}
 
 	for _, tt := range tests {
@@ -5,17 +5,23 @@
 // df reports details of mounted filesystems.
 //
 // Synopsis
-//  df [-k] [-m]
+//  df [-k] [-m] [-h] [-i] [-t TYPE]... [-x TYPE]... [-json]
 //
 // Description
 //  read mount information from /proc/mounts and
 //  statfs syscall and display summary information for all
 //  mount points that have a non-zero block count.
-//  Users can choose to see the diplay in KB or MB.
+//  Users can choose to see the diplay in KB or MB, or
+//  human readable, and to see inodes instead of blocks.
 //
 // Options
 //  -k: display values in KB (default)
 //  -m: dispaly values in MB
+//  -h: display values in powers of 1024, e.g. 1.5G
+//  -i: display inode usage instead of block usage
+//  -t: only display file systems of TYPE, may be repeated or comma separated
+//  -x: do not display file systems of TYPE, may be repeated or comma separated
+//  -json: print JSON, with values in bytes
 package main
 
 import (
@@ -25,15 +31,65 @@ import (
 	"io/ioutil"
 	"log"
 	"math"
+	"os"
+	"sort"
+	"strings"
 	"syscall"
+
+	"github.com/u-root/u-root/pkg/output"
 )
 
 var (
-	inKB  = flag.Bool("k", false, "Express the values in kilobytes (default)")
-	inMB  = flag.Bool("m", false, "Express the values in megabytes")
-	units uint64
+	inKB    = flag.Bool("k", false, "Express the values in kilobytes (default)")
+	inMB    = flag.Bool("m", false, "Express the values in megabytes")
+	human   = flag.Bool("h", false, "Express the values in powers of 1024, e.g. 1.5G")
+	inodes  = flag.Bool("i", false, "Show inode usage instead of block usage")
+	jsonOut = flag.Bool(output.FlagName, false, output.FlagUsage)
+	units   uint64
+
+	onlyTypes    fsTypes
+	excludeTypes fsTypes
 )
 
+func init() {
+	flag.Var(&onlyTypes, "t", "Only show file systems of `type`")
+	flag.Var(&excludeTypes, "x", "Do not show file systems of `type`")
+}
+
+// fsTypes is a set of file system types, given by repeated or comma
+// separated flags.
+type fsTypes map[string]bool
+
+func (f *fsTypes) String() string {
+	var l []string
+	for t := range *f {
+		l = append(l, t)
+	}
+	sort.Strings(l)
+	return strings.Join(l, ",")
+}
+
+func (f *fsTypes) Set(s string) error {
+	if *f == nil {
+		*f = fsTypes{}
+	}
+	for _, t := range strings.Split(s, ",") {
+		if t == "" {
+			return fmt.Errorf("empty file system type in %q", s)
+		}
+		(*f)[t] = true
+	}
+	return nil
+}
+
+// selected reports whether file systems of type t are shown.
+func selected(t string) bool {
+	if len(onlyTypes) > 0 && !onlyTypes[t] {
+		return false
+	}
+	return !excludeTypes[t]
+}
+
 const procmountsFile = "/proc/mounts"
 
 const (
@@ -57,6 +113,10 @@ type Mount struct {
 	Used           uint64
 	Avail          uint64
 	PCT            uint8
+	Inodes         uint64
+	IUsed          uint64
+	IFree          uint64
+	IPCT           uint8
 }
 
 type mountinfomap map[string]Mount
@@ -89,6 +149,9 @@ func mountinfoFromBytes(buf []byte) (mountinfomap, error) {
 		mnt.MountPoint = string(kv[1])
 		mnt.FileSystemType = string(kv[2])
 		mnt.Flags = string(kv[3])
+		if !selected(mnt.FileSystemType) {
+			continue
+		}
 		DiskUsage(&mnt)
 		if mnt.Blocks == 0 {
 			continue
@@ -114,15 +177,44 @@ func DiskUsage(mnt *Mount) {
 	mnt.Used = (fs.Blocks - fs.Bfree) * uint64(fs.Bsize) / units
 	pct := float64((fs.Blocks - fs.Bfree)) * 100 / float64(fs.Blocks)
 	mnt.PCT = uint8(math.Ceil(pct))
+	mnt.Inodes = fs.Files
+	mnt.IFree = fs.Ffree
+	mnt.IUsed = fs.Files - fs.Ffree
+	// Some file systems, e.g. vfat, have no inodes.
+	if fs.Files > 0 {
+		mnt.IPCT = uint8(math.Ceil(float64(mnt.IUsed) * 100 / float64(fs.Files)))
+	}
+}
+
+// humanSize returns n, in bytes, in the largest power of 1024 it is at least
+// one of, with one decimal below 10, e.g. 1.5G or 20M. It is rounded up, not
+// to show more space than there is.
+func humanSize(n uint64) string {
+	const suffixes = "KMGTPE"
+	if n < KB {
+		return fmt.Sprint(n)
+	}
+	v := float64(n)
+	i := -1
+	for v >= 1024 && i < len(suffixes)-1 {
+		v /= 1024
+		i++
+	}
+	if v < 10 {
+		return fmt.Sprintf("%.1f%c", math.Ceil(v*10)/10, suffixes[i])
+	}
+	return fmt.Sprintf("%.0f%c", math.Ceil(v), suffixes[i])
 }
 
 // SetUnits takes the command line flags and configures
 // the correct units used to calculate display values
 func SetUnits() {
-	if *inKB && *inMB {
-		log.Fatal("options -k and -m are mutually exclusive")
+	if (*inKB && *inMB) || (*human && (*inKB || *inMB)) {
+		log.Fatal("options -k, -m and -h are mutually exclusive")
 	}
-	if *inMB {
+	if *jsonOut || *human {
+		units = B
+	} else if *inMB {
 		units = MB
 	} else {
 		units = KB
@@ -132,12 +224,48 @@ func SetUnits() {
 func df() {
 	SetUnits()
 	mounts, _ := mountinfo()
+	var points []string
+	for p := range mounts {
+		points = append(points, p)
+	}
+	sort.Strings(points)
+	if *inodes {
+		printInodes(mounts, points)
+		return
+	}
+	if *jsonOut {
+		t := output.NewTable("Filesystem", "Type", "Size", "Used", "Available", "Use%", "Mounted on")
+		for _, p := range points {
+			mnt := mounts[p]
+			t.Add(mnt.Device, mnt.FileSystemType, mnt.Blocks, mnt.Used, mnt.Avail, mnt.PCT, mnt.MountPoint)
+		}
+		if err := t.Write(os.Stdout, true); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	if *human {
+		fmt.Printf("Filesystem           Type          Size   Used  Avail  Use%% Mounted on\n")
+		for _, p := range points {
+			mnt := mounts[p]
+			fmt.Printf("%-20v %-9v %9v %6v %6v %4v%% %-13v\n",
+				mnt.Device,
+				mnt.FileSystemType,
+				humanSize(mnt.Blocks),
+				humanSize(mnt.Used),
+				humanSize(mnt.Avail),
+				mnt.PCT,
+				mnt.MountPoint)
+		}
+		return
+	}
 	var blocksize = "1K"
 	if *inMB {
 		blocksize = "1M"
 	}
 	fmt.Printf("Filesystem           Type         %v-blocks       Used    Available  Use%% Mounted on\n", blocksize)
-	for _, mnt := range mounts {
+	for _, p := range points {
+		mnt := mounts[p]
 		fmt.Printf("%-20v %-9v %12v %10v %12v %4v%% %-13v\n",
 			mnt.Device,
 			mnt.FileSystemType,
@@ -149,6 +277,39 @@ func df() {
 	}
 }
 
+// printInodes prints the inode usage of the mounts at points.
+func printInodes(mounts mountinfomap, points []string) {
+	if *jsonOut {
+		t := output.NewTable("Filesystem", "Type", "Inodes", "IUsed", "IFree", "IUse%", "Mounted on")
+		for _, p := range points {
+			mnt := mounts[p]
+			t.Add(mnt.Device, mnt.FileSystemType, mnt.Inodes, mnt.IUsed, mnt.IFree, mnt.IPCT, mnt.MountPoint)
+		}
+		if err := t.Write(os.Stdout, true); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	num := func(n uint64) string {
+		if *human {
+			return humanSize(n)
+		}
+		return fmt.Sprint(n)
+	}
+	fmt.Printf("Filesystem           Type            Inodes      IUsed        IFree IUse%% Mounted on\n")
+	for _, p := range points {
+		mnt := mounts[p]
+		fmt.Printf("%-20v %-9v %12v %10v %12v %4v%% %-13v\n",
+			mnt.Device,
+			mnt.FileSystemType,
+			num(mnt.Inodes),
+			num(mnt.IUsed),
+			num(mnt.IFree),
+			mnt.IPCT,
+			mnt.MountPoint)
+	}
+}
+
 func main() {
 	flag.Parse()
 	df()
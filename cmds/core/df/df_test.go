@@ -0,0 +1,59 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+)
+
+func TestHumanSize(t *testing.T) {
+	for n, want := range map[uint64]string{
+		0:                    "0",
+		1023:                 "1023",
+		1024:                 "1.0K",
+		1536:                 "1.5K",
+		1537:                 "1.6K",
+		10 * MB:              "10M",
+		10*MB + 1:            "11M",
+		3 * 1024 * 1024 * MB: "3.0T",
+	} {
+		if got := humanSize(n); got != want {
+			t.Errorf("humanSize(%d) = %q, want %q", n, got, want)
+		}
+	}
+}
+
+func TestSelected(t *testing.T) {
+	defer func() { onlyTypes, excludeTypes = nil, nil }()
+	for _, tt := range []struct {
+		only, exclude string
+		want          map[string]bool
+	}{
+		{"", "", map[string]bool{"ext4": true, "tmpfs": true}},
+		{"ext4,vfat", "", map[string]bool{"ext4": true, "vfat": true, "tmpfs": false}},
+		{"", "tmpfs", map[string]bool{"ext4": true, "tmpfs": false}},
+		{"ext4", "ext4", map[string]bool{"ext4": false}},
+	} {
+		onlyTypes, excludeTypes = nil, nil
+		if tt.only != "" {
+			if err := onlyTypes.Set(tt.only); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if tt.exclude != "" {
+			if err := excludeTypes.Set(tt.exclude); err != nil {
+				t.Fatal(err)
+			}
+		}
+		for fs, want := range tt.want {
+			if got := selected(fs); got != want {
+				t.Errorf("-t %q -x %q: selected(%q) = %v, want %v", tt.only, tt.exclude, fs, got, want)
+			}
+		}
+	}
+	if err := onlyTypes.Set("ext4,"); err == nil {
+		t.Errorf("Set(ext4,) succeeded, want an error")
+	}
+}
@@ -9,10 +9,29 @@
 // Synopsis:
 //     dhclient [OPTIONS...]
 //
+// Description:
+//     dhclient gets DHCPv4 leases and stateful DHCPv6 leases (IA_NA
+//     addresses and DNS options) on all interfaces matching the regexp
+//     (default ^e.*) in parallel.
+//
+//     With -pxe, it identifies as a PXE client, so that ProxyDHCP servers
+//     answer too, and prints the boot information of the leases: next
+//     server, boot file and the vendor options of option 43.
+//
+//     The ip= kernel parameter is honored unless -cmdline=false: a static
+//     configuration, e.g. ip=10.0.0.2::10.0.0.1:255.255.255.0:host:eth0:off,
+//     is applied instead of DHCP, ip=off disables DHCP, and a device given
+//     with ip=:::::eth0:dhcp is the only one DHCP is done on.
+//
 // Options:
 //     -timeout:  lease timeout in seconds
 //     -renewals: number of DHCP renewals before exiting
-//     -verbose:  verbose output
+//     -pxe:      request and print PXE boot information
+//     -cmdline:  honor the ip= kernel parameter
+//     -v, -vv:   verbose output
+//
+// Messages go to the "dhclient" component of ulog.Default, so the uroot.verbose
+// and uroot.log kernel parameters apply.
 package main
 
 import (
@@ -25,6 +44,7 @@ import (
 	"github.com/insomniacslk/dhcp/dhcpv4"
 	"github.com/insomniacslk/dhcp/dhcpv6"
 	"github.com/u-root/u-root/pkg/dhclient"
+	"github.com/u-root/u-root/pkg/ulog"
 	"github.com/vishvananda/netlink"
 )
 
@@ -37,15 +57,23 @@ var (
 	vverbose = flag.Bool("vv", false, "Really verbose output (print all message options for each DHCP message sent/received)")
 	ipv4     = flag.Bool("ipv4", true, "use IPV4")
 	ipv6     = flag.Bool("ipv6", true, "use IPV6")
+	pxe      = flag.Bool("pxe", false, "Identify as a PXE client and print the PXE boot information of the leases")
+	useCmd   = flag.Bool("cmdline", true, "Honor the ip= kernel parameter")
 
 	v6Port   = flag.Int("v6-port", dhcpv6.DefaultServerPort, "DHCPv6 server port to send to")
 	v6Server = flag.String("v6-server", "ff02::1:2", "DHCPv6 server address to send to (multicast or unicast)")
 
 	v4Port = flag.Int("v4-port", dhcpv4.ServerPort, "DHCPv4 server port to send to")
+
+	dlog = ulog.Component("dhclient")
 )
 
 func main() {
 	flag.Parse()
+	ulog.ConfigureFromCmdline()
+	if *verbose || *vverbose {
+		ulog.Default.SetComponentLevel("dhclient", ulog.LevelDebug)
+	}
 	if len(flag.Args()) > 1 {
 		log.Fatalf("only one re")
 	}
@@ -59,9 +87,35 @@ func main() {
 		log.Fatal(err)
 	}
 
+	if *useCmd {
+		if filteredIfs, err = configureCmdline(filteredIfs); err != nil {
+			log.Fatal(err)
+		}
+	}
 	configureAll(filteredIfs)
 }
 
+// configureCmdline applies the static configuration of the ip= kernel
+// parameter, if any, and returns the interfaces left to configure by DHCP.
+func configureCmdline(ifs []netlink.Link) ([]netlink.Link, error) {
+	c, err := dhclient.IPConfigFromCmdline()
+	if err != nil || c == nil {
+		return ifs, err
+	}
+	if *dryRun && c.Static() {
+		dlog.Infof("Dry run: would have configured %s from ip=", c.IPNet())
+		return nil, nil
+	}
+	ifs, lease, err := c.Apply(ifs, 30*time.Second)
+	if err != nil {
+		return nil, err
+	}
+	if lease != nil {
+		dlog.Infof("Configured %s with %s from ip=", lease.Link().Attrs().Name, lease)
+	}
+	return ifs, nil
+}
+
 func configureAll(ifs []netlink.Link) {
 	packetTimeout := time.Duration(*timeout) * time.Second
 
@@ -76,25 +130,43 @@ func configureAll(ifs []netlink.Link) {
 			IP:   net.ParseIP(*v6Server),
 			Port: *v6Port,
 		},
+		PXE: *pxe,
 	}
-	if *verbose {
+	if *verbose || dlog.Enabled(ulog.LevelDebug) {
 		c.LogLevel = dhclient.LogSummary
 	}
 	if *vverbose {
 		c.LogLevel = dhclient.LogDebug
 	}
+	c.Logger = dlog
 	r := dhclient.SendRequests(context.Background(), ifs, *ipv4, *ipv6, c, 30*time.Second)
 
 	for result := range r {
 		if result.Err != nil {
-			log.Printf("Could not configure %s for %s: %v", result.Interface.Attrs().Name, result.Protocol, result.Err)
+			dlog.Errorf("Could not configure %s for %s: %v", result.Interface.Attrs().Name, result.Protocol, result.Err)
 		} else if *dryRun {
-			log.Printf("Dry run: would have configured %s with %s", result.Interface.Attrs().Name, result.Lease)
+			dlog.Infof("Dry run: would have configured %s with %s", result.Interface.Attrs().Name, result.Lease)
 		} else if err := result.Lease.Configure(); err != nil {
-			log.Printf("Could not configure %s for %s: %v", result.Interface.Attrs().Name, result.Protocol, err)
+			dlog.Errorf("Could not configure %s for %s: %v", result.Interface.Attrs().Name, result.Protocol, err)
 		} else {
-			log.Printf("Configured %s with %s", result.Interface.Attrs().Name, result.Lease)
+			dlog.Infof("Configured %s with %s", result.Interface.Attrs().Name, result.Lease)
+		}
+		if p4, ok := result.Lease.(*dhclient.Packet4); ok && *pxe {
+			printPXE(result.Interface.Attrs().Name, p4)
 		}
 	}
-	log.Printf("Finished trying to configure all interfaces.")
+	dlog.Infof("Finished trying to configure all interfaces.")
+}
+
+// printPXE logs the boot information of the DHCPv4 lease p.
+func printPXE(iface string, p *dhclient.Packet4) {
+	info, err := p.PXE()
+	if err != nil {
+		dlog.Errorf("Could not parse PXE information of %s: %v", iface, err)
+		return
+	}
+	dlog.Infof("%s: %s", iface, info)
+	for code, v := range info.VendorOptions {
+		dlog.Infof("%s: PXE vendor option %d: %#x", iface, code, v)
+	}
 }
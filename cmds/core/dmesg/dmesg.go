@@ -5,36 +5,288 @@
 // dmesg reads the system log.
 //
 // Synopsis:
-//     dmesg [-clear|-read-clear]
+//     dmesg [-C|-c] [-H|-T] [-l LEVEL,...] [-f FACILITY,...] [-w] [-json]
+//
+// Description:
+//     dmesg prints the messages of the kernel log buffer. With -w, it then
+//     waits for new messages of /dev/kmsg, and prints them as they come.
+//
+//     LEVEL is emerg, alert, crit, err, warn, notice, info or debug.
+//     FACILITY is kern, user, mail, daemon, auth, syslog, lpr, news, uucp,
+//     cron, authpriv, ftp or local0 to local7.
+//
+//     Times are in seconds since boot. -T prints them as dates, and -H as
+//     the date of the first message of each minute, and the time since the
+//     previous message otherwise. The dates are those of the boot time
+//     plus the time since boot, so they are off after a suspend.
 //
 // Options:
-//     -clear: clear the log
-//     -read-clear: clear the log after printing
+//     -clear, -C: clear the log
+//     -read-clear, -c: clear the log after printing
+//     -H: human readable times
+//     -T: dates instead of times since boot
+//     -l: only print messages of these levels
+//     -f: only print messages of these facilities
+//     -w: wait for new messages
+//     -json: print the messages as JSON
 package main
 
 import (
+	"bytes"
 	"flag"
+	"fmt"
+	"io"
 	"log"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/u-root/u-root/pkg/output"
 	"golang.org/x/sys/unix"
 )
 
 var (
-	clear     bool
-	readClear bool
+	clear      bool
+	readClear  bool
+	jsonOut    bool
+	human      = flag.Bool("H", false, "human readable times")
+	ctime      = flag.Bool("T", false, "print dates instead of times since boot")
+	levels     = flag.String("l", "", "only print messages of these comma-separated levels")
+	facilities = flag.String("f", "", "only print messages of these comma-separated facilities")
+	follow     = flag.Bool("w", false, "wait for new messages")
+)
+
+// kmsg is where the kernel streams its messages, one per read.
+const kmsg = "/dev/kmsg"
+
+var (
+	levelNames    = []string{"emerg", "alert", "crit", "err", "warn", "notice", "info", "debug"}
+	facilityNames = []string{
+		"kern", "user", "mail", "daemon", "auth", "syslog", "lpr", "news",
+		"uucp", "cron", "authpriv", "ftp", "", "", "", "",
+		"local0", "local1", "local2", "local3", "local4", "local5", "local6", "local7",
+	}
 )
 
+// record is a kernel log message.
+type record struct {
+	Facility int `json:"facility"`
+	Level    int `json:"level"`
+	// Time is the time since boot in seconds.
+	Time    float64 `json:"time"`
+	Message string  `json:"message"`
+}
+
+// parseLog parses log lines like "<6>[    1.234567] message". Lines
+// without a prefix continue the previous message.
+func parseLog(b []byte) []record {
+	var rs []record
+	for _, l := range bytes.Split(bytes.TrimRight(b, "\n"), []byte{'\n'}) {
+		var r record
+		prefixed := false
+		if len(l) > 0 && l[0] == '<' {
+			if i := bytes.IndexByte(l, '>'); i > 0 {
+				if pri, err := strconv.Atoi(string(l[1:i])); err == nil {
+					r.Facility, r.Level = pri>>3, pri&7
+					l, prefixed = l[i+1:], true
+				}
+			}
+		}
+		if len(l) > 0 && l[0] == '[' {
+			if i := bytes.IndexByte(l, ']'); i > 0 {
+				if t, err := strconv.ParseFloat(string(bytes.TrimSpace(l[1:i])), 64); err == nil {
+					r.Time = t
+					l, prefixed = bytes.TrimPrefix(l[i+1:], []byte{' '}), true
+				}
+			}
+		}
+		r.Message = string(l)
+		if len(rs) > 0 && !prefixed {
+			rs[len(rs)-1].Message += "\n" + r.Message
+			continue
+		}
+		rs = append(rs, r)
+	}
+	return rs
+}
+
+// parseKmsg parses a record of /dev/kmsg, like "6,339,5140900,-;message"
+// and lines of properties, such as " SUBSYSTEM=usb". Times are in
+// microseconds, and messages escape unprintable bytes as \xNN.
+func parseKmsg(b []byte) (record, error) {
+	var r record
+	i := bytes.IndexByte(b, ';')
+	if i < 0 {
+		return r, fmt.Errorf("invalid record %q", b)
+	}
+	f := strings.Split(string(b[:i]), ",")
+	if len(f) < 3 {
+		return r, fmt.Errorf("invalid record %q", b)
+	}
+	pri, err := strconv.Atoi(f[0])
+	if err != nil {
+		return r, fmt.Errorf("invalid priority in record %q", b)
+	}
+	usec, err := strconv.ParseUint(f[2], 10, 64)
+	if err != nil {
+		return r, fmt.Errorf("invalid time in record %q", b)
+	}
+	r.Facility, r.Level = pri>>3, pri&7
+	r.Time = float64(usec) / 1e6
+
+	msg := b[i+1:]
+	if j := bytes.IndexByte(msg, '\n'); j >= 0 {
+		msg = msg[:j]
+	}
+	var m []byte
+	for k := 0; k < len(msg); k++ {
+		if msg[k] == '\\' && k+3 < len(msg) && msg[k+1] == 'x' {
+			if c, err := strconv.ParseUint(string(msg[k+2:k+4]), 16, 8); err == nil {
+				m = append(m, byte(c))
+				k += 3
+				continue
+			}
+		}
+		m = append(m, msg[k])
+	}
+	r.Message = string(m)
+	return r, nil
+}
+
+// parseNames returns the indexes of the comma-separated names in s.
+func parseNames(s string, names []string) (map[int]bool, error) {
+	if s == "" {
+		return nil, nil
+	}
+	m := map[int]bool{}
+	for _, n := range strings.Split(s, ",") {
+		found := false
+		for i, name := range names {
+			if name != "" && n == name {
+				m[i], found = true, true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown name %q, want one of %s", n, strings.Join(names, ","))
+		}
+	}
+	return m, nil
+}
+
+// printer prints the records which match its filters.
+type printer struct {
+	w      io.Writer
+	json   bool
+	human  bool
+	ctime  bool
+	levels map[int]bool
+	facils map[int]bool
+	// boot is the boot time, for dates.
+	boot time.Time
+	// last is the time of the previously printed record, for -H.
+	last    time.Time
+	printed bool
+}
+
+func (p *printer) match(r record) bool {
+	return (p.levels == nil || p.levels[r.Level]) && (p.facils == nil || p.facils[r.Facility])
+}
+
+func (p *printer) print(r record) error {
+	if !p.match(r) {
+		return nil
+	}
+	if p.json {
+		return output.JSON(p.w, r)
+	}
+	t := p.boot.Add(time.Duration(r.Time * float64(time.Second)))
+	var stamp string
+	switch {
+	case p.ctime:
+		stamp = fmt.Sprintf("[%s] ", t.Format(time.ANSIC))
+	case p.human:
+		if !p.printed || t.Truncate(time.Minute) != p.last.Truncate(time.Minute) {
+			stamp = fmt.Sprintf("[%s] ", t.Format("Jan 2 15:04"))
+		} else {
+			stamp = fmt.Sprintf("[%+11.6f] ", t.Sub(p.last).Seconds())
+		}
+		p.last, p.printed = t, true
+	default:
+		stamp = fmt.Sprintf("<%d>[%12.6f] ", r.Facility<<3|r.Level, r.Time)
+	}
+	_, err := fmt.Fprintf(p.w, "%s%s\n", stamp, r.Message)
+	return err
+}
+
+// bootTime returns when the system booted.
+func bootTime() (time.Time, error) {
+	var ts unix.Timespec
+	if err := unix.ClockGettime(unix.CLOCK_BOOTTIME, &ts); err != nil {
+		return time.Time{}, err
+	}
+	return time.Now().Add(-time.Duration(ts.Nano())), nil
+}
+
+// followKmsg prints the messages of f from its end on, until it fails.
+func followKmsg(f *os.File, p *printer) error {
+	if _, err := f.Seek(0, io.SeekEnd); err != nil {
+		return err
+	}
+	b := make([]byte, 8192)
+	for {
+		n, err := f.Read(b)
+		// EPIPE means records were overwritten before they were read.
+		if err == unix.EPIPE {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		r, err := parseKmsg(b[:n])
+		if err != nil {
+			log.Print(err)
+			continue
+		}
+		if err := p.print(r); err != nil {
+			return err
+		}
+	}
+}
+
 func init() {
 	flag.BoolVar(&clear, "clear", false, "Clear the log")
+	flag.BoolVar(&clear, "C", false, "Clear the log")
 	flag.BoolVar(&readClear, "read-clear", false, "Clear the log after printing")
 	flag.BoolVar(&readClear, "c", false, "Clear the log after printing")
+	flag.BoolVar(&jsonOut, output.FlagName, false, output.FlagUsage)
 }
 
-func main() {
-	flag.Parse()
+func run(w io.Writer) error {
 	if clear && readClear {
-		log.Fatalf("cannot specify both -clear and -read-clear")
+		return fmt.Errorf("cannot specify both -clear and -read-clear")
+	}
+	p := &printer{w: w, json: jsonOut, human: *human, ctime: *ctime}
+	var err error
+	if p.levels, err = parseNames(*levels, levelNames); err != nil {
+		return fmt.Errorf("-l: %v", err)
+	}
+	if p.facils, err = parseNames(*facilities, facilityNames); err != nil {
+		return fmt.Errorf("-f: %v", err)
+	}
+	if p.human || p.ctime {
+		if p.boot, err = bootTime(); err != nil {
+			return err
+		}
+	}
+
+	var f *os.File
+	if *follow {
+		// Open it first, not to miss messages printed in between.
+		if f, err = os.Open(kmsg); err != nil {
+			return err
+		}
+		defer f.Close()
 	}
 
 	level := unix.SYSLOG_ACTION_READ_ALL
@@ -48,8 +300,39 @@ func main() {
 	b := make([]byte, 256*1024)
 	amt, err := unix.Klogctl(level, b)
 	if err != nil {
-		log.Fatalf("syslog failed: %v", err)
+		return fmt.Errorf("syslog failed: %v", err)
+	}
+
+	switch {
+	case jsonOut && !*follow:
+		rs := []record{}
+		for _, r := range parseLog(b[:amt]) {
+			if p.match(r) {
+				rs = append(rs, r)
+			}
+		}
+		return output.JSON(w, rs)
+	case !p.human && !p.ctime && p.levels == nil && p.facils == nil && !*follow:
+		if _, err := w.Write(b[:amt]); err != nil {
+			return err
+		}
+	default:
+		for _, r := range parseLog(b[:amt]) {
+			if err := p.print(r); err != nil {
+				return err
+			}
+		}
+	}
+
+	if f != nil {
+		return followKmsg(f, p)
 	}
+	return nil
+}
 
-	os.Stdout.Write(b[:amt])
+func main() {
+	flag.Parse()
+	if err := run(os.Stdout); err != nil {
+		log.Fatal(err)
+	}
 }
@@ -5,8 +5,11 @@
 package main
 
 import (
+	"bytes"
 	"os"
+	"reflect"
 	"testing"
+	"time"
 
 	"github.com/u-root/u-root/pkg/testutil"
 )
@@ -23,6 +26,97 @@ func TestDmesg(t *testing.T) {
 	}
 }
 
+func TestParseLog(t *testing.T) {
+	got := parseLog([]byte("<6>[    0.000000] Linux version 5.10\n<12>[   12.500000] init: started\n  continued\n"))
+	want := []record{
+		{Facility: 0, Level: 6, Time: 0, Message: "Linux version 5.10"},
+		{Facility: 1, Level: 4, Time: 12.5, Message: "init: started\n  continued"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseLog = %+v, want %+v", got, want)
+	}
+}
+
+func TestParseKmsg(t *testing.T) {
+	got, err := parseKmsg([]byte("3,339,5140900,-;usb 1-1: device descriptor read/64, error -71\\x0a\n SUBSYSTEM=usb\n DEVICE=c189:0\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := record{Facility: 0, Level: 3, Time: 5.1409, Message: "usb 1-1: device descriptor read/64, error -71\n"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseKmsg = %+v, want %+v", got, want)
+	}
+	for _, b := range []string{"", "3,339;no time", "x,339,0,-;bad priority"} {
+		if _, err := parseKmsg([]byte(b)); err == nil {
+			t.Errorf("parseKmsg(%q) succeeded", b)
+		}
+	}
+}
+
+func TestParseNames(t *testing.T) {
+	got, err := parseNames("err,warn", levelNames)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := map[int]bool{3: true, 4: true}; !reflect.DeepEqual(got, want) {
+		t.Errorf("parseNames = %v, want %v", got, want)
+	}
+	if _, err := parseNames("local1,kern", facilityNames); err != nil {
+		t.Error(err)
+	}
+	if _, err := parseNames("warning", levelNames); err == nil {
+		t.Errorf("parseNames(warning) succeeded")
+	}
+}
+
+func TestPrinter(t *testing.T) {
+	records := []record{
+		{Level: 6, Time: 0, Message: "Linux version 5.10"},
+		{Level: 3, Time: 1.5, Message: "ata1: failed"},
+		{Facility: 3, Level: 4, Time: 2.25, Message: "init: slow"},
+		{Level: 4, Time: 90, Message: "clocksource: unstable"},
+	}
+	boot := time.Date(2021, 3, 4, 10, 20, 0, 0, time.UTC)
+	for _, tt := range []struct {
+		name string
+		p    printer
+		want string
+	}{
+		{
+			name: "default",
+			want: "<6>[    0.000000] Linux version 5.10\n<3>[    1.500000] ata1: failed\n<28>[    2.250000] init: slow\n<4>[   90.000000] clocksource: unstable\n",
+		},
+		{
+			name: "levels",
+			p:    printer{levels: map[int]bool{3: true, 4: true}, facils: map[int]bool{0: true}},
+			want: "<3>[    1.500000] ata1: failed\n<4>[   90.000000] clocksource: unstable\n",
+		},
+		{
+			name: "ctime",
+			p:    printer{ctime: true, boot: boot, facils: map[int]bool{3: true}},
+			want: "[Thu Mar  4 10:20:02 2021] init: slow\n",
+		},
+		{
+			name: "human",
+			p:    printer{human: true, boot: boot},
+			want: "[Mar 4 10:20] Linux version 5.10\n[  +1.500000] ata1: failed\n[  +0.750000] init: slow\n[Mar 4 10:21] clocksource: unstable\n",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var b bytes.Buffer
+			tt.p.w = &b
+			for _, r := range records {
+				if err := tt.p.print(r); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if b.String() != tt.want {
+				t.Errorf("got\n%s\nwant\n%s", b.String(), tt.want)
+			}
+		})
+	}
+}
+
 func TestMain(m *testing.M) {
 	testutil.Run(m, main)
 }
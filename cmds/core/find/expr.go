@@ -0,0 +1,430 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/u-root/u-root/pkg/find"
+)
+
+// maxBatch is how many files an -exec ... + command gets at most.
+const maxBatch = 1024
+
+var fileTypes = map[string]os.FileMode{
+	"f":         0,
+	"file":      0,
+	"d":         os.ModeDir,
+	"directory": os.ModeDir,
+	"l":         os.ModeSymlink,
+	"p":         os.ModeNamedPipe,
+	"s":         os.ModeSocket,
+	"b":         os.ModeDevice,
+	"c":         os.ModeDevice | os.ModeCharDevice,
+}
+
+// sizeUnits are the suffixes of -size, as with GNU find.
+var sizeUnits = map[string]int64{
+	"":  512,
+	"b": 512,
+	"c": 1,
+	"w": 2,
+	"k": 1 << 10,
+	"M": 1 << 20,
+	"G": 1 << 30,
+}
+
+var sizeRE = regexp.MustCompile(`^([+-]?[0-9]+)([bcwkMG]?)$`)
+
+// expression reports whether a file matches.
+type expression func(f *find.File) bool
+
+// parser parses the paths and the expression of the command line.
+type parser struct {
+	args []string
+	w    io.Writer
+
+	paths    []string
+	minDepth int
+	maxDepth int
+	long     bool
+	debug    bool
+
+	// action is set if the expression prints or runs commands by
+	// itself, so the files it matches are not printed.
+	action bool
+
+	// batches are the -exec ... + commands, which run the files when
+	// enough are collected, and when the search is done.
+	batches []*batch
+}
+
+// parse parses args, writing the output of the expression to w.
+//
+// The expression is made of primaries which are and'ed, or combined with
+// ( ), ! and -o. Arguments which are not part of it are the paths to
+// search.
+func parse(args []string, w io.Writer) (*parser, expression, error) {
+	p := &parser{args: args, w: w, maxDepth: -1}
+	e, err := p.or()
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(p.args) > 0 {
+		return nil, nil, fmt.Errorf("unexpected %q", p.args[0])
+	}
+	if e == nil {
+		e = func(*find.File) bool { return true }
+	}
+	if !p.action {
+		e = and(e, p.print)
+	}
+	if len(p.paths) == 0 {
+		p.paths = []string{"."}
+	}
+	return p, e, nil
+}
+
+func and(l, r expression) expression {
+	if l == nil {
+		return r
+	}
+	return func(f *find.File) bool { return l(f) && r(f) }
+}
+
+func (p *parser) peek() string {
+	if len(p.args) == 0 {
+		return ""
+	}
+	return p.args[0]
+}
+
+func (p *parser) next() string {
+	a := p.args[0]
+	p.args = p.args[1:]
+	return a
+}
+
+// arg returns the argument of the primary.
+func (p *parser) arg(primary string) (string, error) {
+	if len(p.args) == 0 {
+		return "", fmt.Errorf("%s needs an argument", primary)
+	}
+	return p.next(), nil
+}
+
+// or parses and's separated by -o. It returns nil for no expression.
+func (p *parser) or() (expression, error) {
+	e, err := p.and()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek() == "-o" || p.peek() == "-or" {
+		p.next()
+		r, err := p.and()
+		if err != nil {
+			return nil, err
+		}
+		if e == nil || r == nil {
+			return nil, fmt.Errorf("-o needs an expression on both sides")
+		}
+		l := e
+		e = func(f *find.File) bool { return l(f) || r(f) }
+	}
+	return e, nil
+}
+
+// and parses nots, optionally separated by -a.
+func (p *parser) and() (expression, error) {
+	var e expression
+	for len(p.args) > 0 && p.peek() != ")" && p.peek() != "-o" && p.peek() != "-or" {
+		if p.peek() == "-a" || p.peek() == "-and" {
+			p.next()
+		}
+		r, err := p.not()
+		if err != nil {
+			return nil, err
+		}
+		if r != nil {
+			e = and(e, r)
+		}
+	}
+	return e, nil
+}
+
+// not parses a negated or parenthesized expression, or a primary.
+func (p *parser) not() (expression, error) {
+	switch p.peek() {
+	case "!", "-not":
+		p.next()
+		e, err := p.not()
+		if err != nil {
+			return nil, err
+		}
+		if e == nil {
+			return nil, fmt.Errorf("! needs an expression")
+		}
+		return func(f *find.File) bool { return !e(f) }, nil
+	case "(":
+		p.next()
+		e, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek() != ")" {
+			return nil, fmt.Errorf("missing )")
+		}
+		p.next()
+		if e == nil {
+			return nil, fmt.Errorf("( ) needs an expression")
+		}
+		return e, nil
+	case "":
+		return nil, fmt.Errorf("missing expression")
+	}
+	return p.primary()
+}
+
+// primary parses a test, an action or an option. It returns nil for
+// options, and paths.
+func (p *parser) primary() (expression, error) {
+	a := p.next()
+	if !strings.HasPrefix(a, "-") || a == "-" {
+		p.paths = append(p.paths, a)
+		return nil, nil
+	}
+	switch a {
+	case "-name", "-iname":
+		pattern, err := p.arg(a)
+		if err != nil {
+			return nil, err
+		}
+		fold := a == "-iname"
+		if fold {
+			pattern = strings.ToLower(pattern)
+		}
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("%s %q: %v", a, pattern, err)
+		}
+		return func(f *find.File) bool {
+			name := filepath.Base(f.Name)
+			if fold {
+				name = strings.ToLower(name)
+			}
+			m, _ := filepath.Match(pattern, name)
+			return m
+		}, nil
+
+	case "-type":
+		t, err := p.arg(a)
+		if err != nil {
+			return nil, err
+		}
+		mode, ok := fileTypes[t]
+		if !ok {
+			var keys []string
+			for key := range fileTypes {
+				keys = append(keys, key)
+			}
+			return nil, fmt.Errorf("%v is not a valid file type\n valid types are %v", t, strings.Join(keys, ","))
+		}
+		return func(f *find.File) bool { return f.Mode()&os.ModeType == mode }, nil
+
+	case "-mode":
+		s, err := p.arg(a)
+		if err != nil {
+			return nil, err
+		}
+		perm, err := strconv.ParseUint(s, 0, 32)
+		if err != nil {
+			return nil, fmt.Errorf("%s %q: %v", a, s, err)
+		}
+		return func(f *find.File) bool { return f.Mode()&os.ModePerm == os.FileMode(perm) }, nil
+
+	case "-size":
+		s, err := p.arg(a)
+		if err != nil {
+			return nil, err
+		}
+		m := sizeRE.FindStringSubmatch(s)
+		if m == nil {
+			return nil, fmt.Errorf("%s %q: want [+-]N[bcwkMG]", a, s)
+		}
+		cmp, err := compare(m[1])
+		if err != nil {
+			return nil, err
+		}
+		unit := sizeUnits[m[2]]
+		// Sizes are rounded up to units.
+		return func(f *find.File) bool { return cmp((f.Size() + unit - 1) / unit) }, nil
+
+	case "-mtime":
+		s, err := p.arg(a)
+		if err != nil {
+			return nil, err
+		}
+		cmp, err := compare(s)
+		if err != nil {
+			return nil, fmt.Errorf("%s %q: %v", a, s, err)
+		}
+		now := time.Now()
+		// The age is in whole days, rounded down.
+		return func(f *find.File) bool { return cmp(int64(now.Sub(f.ModTime()) / (24 * time.Hour))) }, nil
+
+	case "-maxdepth", "-mindepth":
+		s, err := p.arg(a)
+		if err != nil {
+			return nil, err
+		}
+		n, err := strconv.Atoi(s)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("%s %q: want a number of levels", a, s)
+		}
+		if a == "-maxdepth" {
+			p.maxDepth = n
+		} else {
+			p.minDepth = n
+		}
+		return nil, nil
+
+	case "-l":
+		p.long = true
+		return nil, nil
+
+	case "-d":
+		p.debug = true
+		return nil, nil
+
+	case "-print":
+		p.action = true
+		return p.print, nil
+
+	case "-exec":
+		p.action = true
+		return p.exec()
+	}
+	return nil, fmt.Errorf("unknown primary %q", a)
+}
+
+// compare returns a function which reports whether a number is more than
+// n for +n, less than n for -n, or n.
+func compare(s string) (func(int64) bool, error) {
+	n, err := strconv.ParseInt(strings.TrimPrefix(s, "+"), 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	switch s[0] {
+	case '+':
+		return func(v int64) bool { return v > n }, nil
+	case '-':
+		n = -n
+		return func(v int64) bool { return v < n }, nil
+	}
+	return func(v int64) bool { return v == n }, nil
+}
+
+func (p *parser) print(f *find.File) bool {
+	if p.long {
+		fmt.Fprintf(p.w, "%s\n", f)
+	} else {
+		fmt.Fprintf(p.w, "%s\n", f.Name)
+	}
+	return true
+}
+
+func (p *parser) command(args ...string) *exec.Cmd {
+	c := exec.Command(args[0], args[1:]...)
+	c.Stdin, c.Stdout, c.Stderr = os.Stdin, p.w, os.Stderr
+	return c
+}
+
+// exec parses -exec command ;, which runs command for each file, with {}
+// replaced by its name, and is true if it succeeds; and -exec command {} +,
+// which runs command with as many files as possible in place of {}, and is
+// always true.
+func (p *parser) exec() (expression, error) {
+	var args []string
+	for {
+		if len(p.args) == 0 {
+			return nil, fmt.Errorf("-exec needs a command terminated by ; or {} +")
+		}
+		a := p.next()
+		if a == ";" || (a == "+" && len(args) > 0 && args[len(args)-1] == "{}") {
+			if len(args) == 0 || (a == "+" && len(args) == 1) {
+				return nil, fmt.Errorf("-exec needs a command")
+			}
+			if a == "+" {
+				b := &batch{p: p, args: args[:len(args)-1]}
+				p.batches = append(p.batches, b)
+				return b.add, nil
+			}
+			break
+		}
+		args = append(args, a)
+	}
+	return func(f *find.File) bool {
+		c := make([]string, len(args))
+		for i, a := range args {
+			c[i] = strings.Replace(a, "{}", f.Name, -1)
+		}
+		if err := p.command(c...).Run(); err != nil {
+			if _, ok := err.(*exec.ExitError); !ok {
+				log.Print(err)
+			}
+			return false
+		}
+		return true
+	}, nil
+}
+
+// batch is an -exec ... + command.
+type batch struct {
+	p      *parser
+	args   []string
+	names  []string
+	failed bool
+}
+
+func (b *batch) add(f *find.File) bool {
+	b.names = append(b.names, f.Name)
+	if len(b.names) >= maxBatch {
+		b.run()
+	}
+	return true
+}
+
+// run runs the command with the files collected so far.
+func (b *batch) run() {
+	if len(b.names) == 0 {
+		return
+	}
+	if err := b.p.command(append(b.args, b.names...)...).Run(); err != nil {
+		if _, ok := err.(*exec.ExitError); !ok {
+			log.Print(err)
+		}
+		b.failed = true
+	}
+	b.names = nil
+}
+
+// flush runs the -exec ... + commands with the remaining files. It
+// reports whether all the commands succeeded.
+func (p *parser) flush() bool {
+	ok := true
+	for _, b := range p.batches {
+		b.run()
+		ok = ok && !b.failed
+	}
+	return ok
+}
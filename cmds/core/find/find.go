@@ -2,98 +2,92 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-// Find finds files. It is similar to the Unix command. It uses REs, not globs,
-// for matching.
+// Find finds files. It is similar to the Unix command.
 //
-// OPTIONS:
+// Synopsis:
+//     find [PATHS...] [EXPRESSION]
+//
+// Description:
+//     find walks each of the paths, "." by default, and evaluates the
+//     expression for each file. If it has no -print or -exec, the files
+//     it matches are printed.
+//
+//     The expression is made of primaries which must all be true, or
+//     combined with ( EXPR ), ! EXPR and EXPR -o EXPR. Numbers N can be
+//     given as +N for more than N, and -N for less than N.
+//
+// Options:
 //     -d: enable debugging in the find package
-//     -mode integer-arg: match against mode, e.g. -mode 0755
-//     -type: match against a file type, e.g. -type f will match files
-//     -name: glob to match against file
 //     -l: long listing. It's not very good, yet, but it's useful enough.
+//     -maxdepth N: do not descend more than N levels below the paths
+//     -mindepth N: only match files at least N levels below the paths
+//
+// Tests:
+//     -mode integer-arg: match against mode, e.g. -mode 0755
+//     -type: match against a file type, one of f, d, l, p, s, b or c,
+//            e.g. -type f will match files
+//     -name glob: match the file's base name, e.g. -name '*.go'
+//     -iname glob: like -name, ignoring case
+//     -size N[bcwkMG]: match the size, rounded up to units of 512 bytes by
+//            default, bytes, 2 bytes, KiB, MiB or GiB
+//     -mtime N: match the days since the file was modified
+//
+// Actions:
+//     -print: print the file
+//     -exec command ;: run command, with {} replaced by the file name, and
+//            match if it succeeds
+//     -exec command {} +: run command with as many file names as possible
+//            in place of {}
 package main
 
 import (
 	"context"
-	"flag"
 	"fmt"
 	"log"
 	"os"
-	"strings"
 
 	"github.com/u-root/u-root/pkg/find"
 )
 
-const cmd = "find [opts] starting-at-path"
-
-var (
-	perm      = flag.Int("mode", -1, "Permissions")
-	fileType  = flag.String("type", "", "File type")
-	name      = flag.String("name", "", "glob for name")
-	long      = flag.Bool("l", false, "long listing")
-	debug     = flag.Bool("d", false, "Enable debugging in the find package")
-	fileTypes = map[string]os.FileMode{
-		"f":         0,
-		"file":      0,
-		"d":         os.ModeDir,
-		"directory": os.ModeDir,
-	}
-)
+const cmd = "find [paths] [expression]"
 
-func init() {
-	defUsage := flag.Usage
-	flag.Usage = func() {
-		os.Args[0] = cmd
-		defUsage()
-		os.Exit(1)
-	}
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage: %s\n", cmd)
+	os.Exit(1)
 }
 
 func main() {
-	flag.Parse()
-	a := flag.Args()
-	if len(a) != 1 {
-		flag.Usage()
+	if len(os.Args) > 1 && (os.Args[1] == "-h" || os.Args[1] == "-help" || os.Args[1] == "--help") {
+		usage()
 	}
-	root := a[0]
-
-	var mask, mode os.FileMode
-	if *perm != -1 {
-		mask = os.ModePerm
-		mode = os.FileMode(*perm)
-	}
-	if *fileType != "" {
-		intType, ok := fileTypes[*fileType]
-		if !ok {
-			var keys []string
-			for key := range fileTypes {
-				keys = append(keys, key)
-			}
-			log.Fatalf("%v is not a valid file type\n valid types are %v", *fileType, strings.Join(keys, ","))
-		}
-		mode |= intType
-		mask |= os.ModeType
+	p, e, err := parse(os.Args[1:], os.Stdout)
+	if err != nil {
+		log.Fatal(err)
 	}
 
 	debugLog := func(string, ...interface{}) {}
-	if *debug {
+	if p.debug {
 		debugLog = log.Printf
 	}
-	names := find.Find(context.Background(),
-		find.WithRoot(root),
-		find.WithModeMatch(mode, mask),
-		find.WithFilenameMatch(*name),
-		find.WithDebugLog(debugLog),
-	)
-	for l := range names {
-		if l.Err != nil {
-			fmt.Fprintf(os.Stderr, "%v: %v\n", l.Name, l.Err)
-			continue
-		}
-		if *long {
-			fmt.Printf("%s\n", l)
-			continue
+	status := 0
+	for _, root := range p.paths {
+		names := find.Find(context.Background(),
+			find.WithRoot(root),
+			find.WithMinDepth(p.minDepth),
+			find.WithMaxDepth(p.maxDepth),
+			find.WithDebugLog(debugLog),
+		)
+		for l := range names {
+			if l.Err != nil {
+				fmt.Fprintf(os.Stderr, "%v: %v\n", l.Name, l.Err)
+				status = 1
+				continue
+			}
+			e(l)
 		}
-		fmt.Printf("%s\n", l.Name)
 	}
+	if !p.flush() {
+		status = 1
+	}
+	os.Exit(status)
 }
@@ -0,0 +1,106 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/u-root/u-root/pkg/testutil"
+)
+
+func TestFind(t *testing.T) {
+	d, err := ioutil.TempDir("", "find")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(d)
+
+	if err := os.MkdirAll(filepath.Join(d, "a/b"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	for n, size := range map[string]int{"a/x.txt": 10, "a/Y.TXT": 0, "a/b/old": 1, "big": 2000} {
+		if err := ioutil.WriteFile(filepath.Join(d, n), make([]byte, size), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	old := time.Now().Add(-10 * 24 * time.Hour)
+	if err := os.Chtimes(filepath.Join(d, "a/b/old"), old, old); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink("big", filepath.Join(d, "link")); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		args []string
+		want string
+	}{
+		{[]string{"a"}, "a\na/Y.TXT\na/b\na/b/old\na/x.txt\n"},
+		{[]string{"-name", "*.txt"}, "a/x.txt\n"},
+		{[]string{".", "-iname", "*.txt"}, "a/Y.TXT\na/x.txt\n"},
+		// Paths may come after the expression, too.
+		{[]string{"-name", "*.txt", "a"}, "a/x.txt\n"},
+		{[]string{"-type", "d"}, ".\na\na/b\n"},
+		{[]string{"-type", "l"}, "link\n"},
+		{[]string{"-type", "f", "-size", "+1k"}, "big\n"},
+		{[]string{"-type", "f", "-size", "-2c"}, "a/Y.TXT\na/b/old\n"},
+		{[]string{"-type", "f", "-size", "10c"}, "a/x.txt\n"},
+		{[]string{"-mtime", "+7"}, "a/b/old\n"},
+		{[]string{"-type", "f", "-mtime", "-1"}, "a/Y.TXT\na/x.txt\nbig\n"},
+		{[]string{"-maxdepth", "1"}, ".\na\nbig\nlink\n"},
+		{[]string{"-mindepth", "2", "-type", "f"}, "a/Y.TXT\na/b/old\na/x.txt\n"},
+		{[]string{"-name", "big", "-o", "-name", "link"}, "big\nlink\n"},
+		{[]string{"a", "!", "-type", "d"}, "a/Y.TXT\na/b/old\na/x.txt\n"},
+		{[]string{"a", "-not", "(", "-type", "d", "-o", "-name", "*.txt", ")"}, "a/Y.TXT\na/b/old\n"},
+		{[]string{"a", "-type", "d", "-a", "-name", "b"}, "a/b\n"},
+		{[]string{"a", "-name", "old", "-exec", "echo", "found", "{}", ";"}, "found a/b/old\n"},
+		{[]string{"a", "-type", "f", "-exec", "echo", "{}", "+"}, "a/Y.TXT a/b/old a/x.txt\n"},
+		// -exec ... ; is false if the command fails.
+		{[]string{"a", "-type", "f", "!", "-exec", "test", "-s", "{}", ";", "-print"}, "a/Y.TXT\n"},
+	} {
+		c := testutil.Command(t, tt.args...)
+		c.Dir = d
+		var stderr bytes.Buffer
+		c.Stderr = &stderr
+		out, err := c.Output()
+		if err != nil {
+			t.Errorf("find %q: %v, %s", tt.args, err, stderr.String())
+		}
+		if string(out) != tt.want {
+			t.Errorf("find %q = %q, want %q", tt.args, out, tt.want)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, args := range [][]string{
+		{"-name"},
+		{"-type", "x"},
+		{"-size", "10q"},
+		{"-mtime", "a"},
+		{"-maxdepth", "-1"},
+		{"-bogus"},
+		{"(", "-name", "x"},
+		{"-name", "x", ")"},
+		{"!"},
+		{"-o", "-name", "x"},
+		{"-exec", "echo", "{}"},
+		{"-exec", ";"},
+		{"-name", "["},
+	} {
+		if _, _, err := parse(args, ioutil.Discard); err == nil {
+			t.Errorf("parse(%q) succeeded, want an error", args)
+		}
+	}
+}
+
+func TestMain(m *testing.M) {
+	testutil.Run(m, main)
+}
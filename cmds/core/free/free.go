@@ -5,11 +5,13 @@
 // free reports usage information for physical memory and swap space.
 //
 // Synopsis:
-//     free [-k] [-m] [-g] [-t] [-h] [-json]
+//     free [-k] [-m] [-g] [-t] [-h] [-w] [-s SECONDS] [-c COUNT] [-json]
 //
 // Description:
 //     Read memory information from /proc/meminfo and display a summary for
 //     physical memory and swap space. The unit options use powers of 1024.
+//     With -s or -c, the summary is repeated, separated by empty lines, or
+//     by newlines with -json.
 //
 // Options:
 //     -k: display the values in kibibytes
@@ -17,14 +19,21 @@
 //     -g: display the values in gibibytes
 //     -t: display the values in tebibytes
 //     -h: display the values in human-readable form
+//     -w: display the buffers and the cache in separate columns
+//     -s: repeat every SECONDS, which may be fractional
+//     -c: repeat COUNT times, every second unless -s is given
 //     -json: use JSON output
 package main
 
 import (
-	"encoding/json"
 	"flag"
 	"fmt"
+	"io"
 	"log"
+	"os"
+	"time"
+
+	"github.com/u-root/u-root/pkg/output"
 )
 
 var (
@@ -34,7 +43,10 @@ var (
 	inMB        = flag.Bool("m", false, "Express the values in mebibytes")
 	inGB        = flag.Bool("g", false, "Express the values in gibibytes")
 	inTB        = flag.Bool("t", false, "Express the values in tebibytes")
-	toJSON      = flag.Bool("json", false, "Use JSON for output")
+	wide        = flag.Bool("w", false, "Show the buffers and the cache in separate columns")
+	interval    = flag.Float64("s", 0, "Repeat every `seconds`")
+	count       = flag.Int("c", 0, "Repeat `count` times")
+	toJSON      = flag.Bool(output.FlagName, false, "Use JSON for output")
 )
 
 type unit uint
@@ -59,6 +71,7 @@ type FreeConfig struct {
 	Unit        unit
 	HumanOutput bool
 	ToJSON      bool
+	Wide        bool
 }
 
 // the following types are used for JSON serialization
@@ -149,6 +162,11 @@ func Free(config *FreeConfig) error {
 	if err != nil {
 		log.Fatal(err)
 	}
+	return printMemInfo(os.Stdout, m, config)
+}
+
+// printMemInfo prints the memory information of the meminfo map m to w.
+func printMemInfo(w io.Writer, m meminfomap, config *FreeConfig) error {
 	mmi, err := getMainMemInfo(m, config)
 	if err != nil {
 		return err
@@ -159,14 +177,23 @@ func Free(config *FreeConfig) error {
 	}
 	mi := MemInfo{Mem: *mmi, Swap: *si}
 	if config.ToJSON {
-		jsonData, err := json.Marshal(mi)
-		if err != nil {
-			return err
-		}
-		fmt.Println(string(jsonData))
+		return output.JSON(w, mi)
+	}
+	if config.Wide {
+		fmt.Fprintf(w, "              total        used        free      shared     buffers       cache   available\n")
+		fmt.Fprintf(w, "%-7s %11v %11v %11v %11v %11v %11v %11v\n",
+			"Mem:",
+			formatValueByConfig(mmi.Total, config),
+			formatValueByConfig(mmi.Used, config),
+			formatValueByConfig(mmi.Free, config),
+			formatValueByConfig(mmi.Shared, config),
+			formatValueByConfig(mmi.Buffers, config),
+			formatValueByConfig(mmi.Cached, config),
+			formatValueByConfig(mmi.Available, config),
+		)
 	} else {
-		fmt.Printf("              total        used        free      shared  buff/cache   available\n")
-		fmt.Printf("%-7s %11v %11v %11v %11v %11v %11v\n",
+		fmt.Fprintf(w, "              total        used        free      shared  buff/cache   available\n")
+		fmt.Fprintf(w, "%-7s %11v %11v %11v %11v %11v %11v\n",
 			"Mem:",
 			formatValueByConfig(mmi.Total, config),
 			formatValueByConfig(mmi.Used, config),
@@ -175,14 +202,14 @@ func Free(config *FreeConfig) error {
 			formatValueByConfig(mmi.Buffers+mmi.Cached, config),
 			formatValueByConfig(mmi.Available, config),
 		)
-		fmt.Printf("%-7s %11v %11v %11v\n",
-			"Swap:",
-			formatValueByConfig(si.Total, config),
-			formatValueByConfig(si.Used, config),
-			formatValueByConfig(si.Free, config),
-		)
 	}
-	return nil
+	_, err = fmt.Fprintf(w, "%-7s %11v %11v %11v\n",
+		"Swap:",
+		formatValueByConfig(si.Total, config),
+		formatValueByConfig(si.Used, config),
+		formatValueByConfig(si.Free, config),
+	)
+	return err
 }
 
 // validateUnits checks that only one option of -b, -k, -m, -g, -t or -h has been
@@ -218,7 +245,10 @@ func main() {
 	if !validateUnits() {
 		log.Fatal("Options -k, -m, -g, -t and -h are mutually exclusive")
 	}
-	config := FreeConfig{ToJSON: *toJSON}
+	if *interval < 0 || *count < 0 {
+		log.Fatal("The interval and the count must not be negative")
+	}
+	config := FreeConfig{ToJSON: *toJSON, Wide: *wide}
 	if *humanOutput {
 		config.HumanOutput = true
 	} else {
@@ -236,7 +266,21 @@ func main() {
 		}
 	}
 
-	if err := Free(&config); err != nil {
-		log.Fatal(err)
+	// -c alone repeats every second.
+	delay := time.Duration(*interval * float64(time.Second))
+	if delay == 0 && *count > 0 {
+		delay = time.Second
+	}
+	for i := 1; ; i++ {
+		if err := Free(&config); err != nil {
+			log.Fatal(err)
+		}
+		if delay == 0 || i == *count {
+			break
+		}
+		if !config.ToJSON {
+			fmt.Println()
+		}
+		time.Sleep(delay)
 	}
 }
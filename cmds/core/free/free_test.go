@@ -5,6 +5,7 @@
 package main
 
 import (
+	"bytes"
 	"testing"
 )
 
@@ -150,3 +151,46 @@ SReclaimable:     179852 kB`)
 		t.Fatal("printMem: got no error when expecting one")
 	}
 }
+
+func TestPrintMemInfo(t *testing.T) {
+	input := []byte(`MemTotal:        8052976 kB
+MemFree:          721716 kB
+MemAvailable:    2774100 kB
+Buffers:          244880 kB
+Cached:          3462124 kB
+Shmem:           1617788 kB
+SwapTotal:       8265724 kB
+SwapFree:        8264956 kB
+SReclaimable:     179852 kB`)
+	m, err := meminfoFromBytes(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range []struct {
+		config FreeConfig
+		want   string
+	}{
+		{
+			config: FreeConfig{Unit: KB},
+			want: `              total        used        free      shared  buff/cache   available
+Mem:        8052976     3444404      721716     1617788     3886856     2774100
+Swap:       8265724         768     8264956
+`,
+		},
+		{
+			config: FreeConfig{Unit: KB, Wide: true},
+			want: `              total        used        free      shared     buffers       cache   available
+Mem:        8052976     3444404      721716     1617788      244880     3641976     2774100
+Swap:       8265724         768     8264956
+`,
+		},
+	} {
+		var b bytes.Buffer
+		if err := printMemInfo(&b, m, &tt.config); err != nil {
+			t.Fatal(err)
+		}
+		if b.String() != tt.want {
+			t.Errorf("printMemInfo(%+v): got\n%s\nwant\n%s", tt.config, b.String(), tt.want)
+		}
+	}
+}
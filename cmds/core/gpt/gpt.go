@@ -2,24 +2,52 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-// gpt reads and writes GPT headers.
+// gpt reads, writes and edits GPT headers.
 //
 // Synopsis:
 //     gpt [-w] file
+//     gpt [-r] [-o] [-e] [-n N:START:END] [-t N:TYPE] [-c N:NAME] [-d N] [-s N:END] [-p] file
 //
 // Description:
 //     For -w, it reads a JSON formatted GPT from stdin, and writes 'file'
 //     which is usually a device. It writes both primary and secondary headers.
 //
+//     The editing options, which may be repeated, are applied in order, as
+//     sgdisk does, and both headers and a protective MBR are written once
+//     they are all done. The kernel is then asked to read the partitions
+//     again.
+//
+//     START and END are block numbers. 0, or nothing, is the default: the
+//     first free block aligned to 1 MiB, and the end of the free space.
+//     END may also be +SIZE, with a K, M, G or T suffix, from START.
+//     TYPE is a GUID or one of efi, bios, linux, swap, home, lvm, raid and
+//     root.
+//
 //     Otherwise it just writes the headers to stdout in JSON format.
+//
+// Options:
+//     -w: write the JSON formatted GPT from stdin
+//     -r: repair: use the backup GPT if the primary one is damaged, and the
+//         other way around, and move the backup GPT to the end of the disk
+//     -o: start with a new, empty, GPT
+//     -e: move the backup GPT to the end of the disk, e.g. after it grew
+//     -n: add partition N, or the first unused one for 0
+//     -t: set the type of partition N
+//     -c: set the name of partition N
+//     -d: delete partition N
+//     -s: move the end of partition N; 0 grows it as far as it can
+//     -p: print the partitions
 package main
 
 import (
 	"encoding/json"
 	"flag"
 	"fmt"
+	"io"
 	"log"
 	"os"
+	"strconv"
+	"strings"
 
 	"github.com/u-root/u-root/pkg/mount/gpt"
 )
@@ -27,9 +55,33 @@ import (
 const cmd = "gpt [options] file"
 
 var (
-	write = flag.Bool("w", false, "Write GPT to file")
+	write  = flag.Bool("w", false, "Write GPT to file")
+	repair = flag.Bool("r", false, "Repair the GPT")
+	show   = flag.Bool("p", false, "Print the partitions")
 )
 
+// op is an editing option, as given on the command line.
+type op struct {
+	name, arg string
+}
+
+var ops []op
+
+// opFlag appends its option to ops, so they are applied in order.
+type opFlag struct {
+	name   string
+	isBool bool
+}
+
+func (o *opFlag) String() string { return "" }
+
+func (o *opFlag) Set(s string) error {
+	ops = append(ops, op{o.name, s})
+	return nil
+}
+
+func (o *opFlag) IsBoolFlag() bool { return o.isBool }
+
 func init() {
 	defUsage := flag.Usage
 	flag.Usage = func() {
@@ -37,6 +89,199 @@ func init() {
 		defUsage()
 		os.Exit(1)
 	}
+	flag.Var(&opFlag{name: "o", isBool: true}, "o", "Create a new GPT")
+	flag.Var(&opFlag{name: "e", isBool: true}, "e", "Move the backup GPT to the end of the disk")
+	flag.Var(&opFlag{name: "n"}, "n", "Add partition N:START:END")
+	flag.Var(&opFlag{name: "t"}, "t", "Set the type of partition N:TYPE")
+	flag.Var(&opFlag{name: "c"}, "c", "Set the name of partition N:NAME")
+	flag.Var(&opFlag{name: "d"}, "d", "Delete partition N")
+	flag.Var(&opFlag{name: "s"}, "s", "Resize partition N:END")
+}
+
+// parseSize parses a size with an optional K, M, G or T suffix, in blocks.
+func parseSize(s string) (uint64, error) {
+	var shift uint
+	if i := strings.LastIndexAny(s, "KMGTkmgt"); i > 0 && i == len(s)-1 {
+		shift = 10 * uint(strings.IndexByte("KMGT", strings.ToUpper(s[i:])[0])+1)
+		s = s[:i]
+	}
+	n, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid size %q", s)
+	}
+	return n << shift / gpt.BlockSize, nil
+}
+
+// parseBlock parses a block number; "" is 0.
+func parseBlock(s string) (uint64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	n, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid block %q", s)
+	}
+	return n, nil
+}
+
+// split splits N:REST into the partition number N and up to n more fields.
+func split(s string, n int) (int, []string, error) {
+	f := strings.SplitN(s, ":", n+1)
+	num, err := strconv.Atoi(f[0])
+	if err != nil {
+		return 0, nil, fmt.Errorf("invalid partition number %q", f[0])
+	}
+	for len(f) < n+1 {
+		f = append(f, "")
+	}
+	return num, f[1:], nil
+}
+
+func apply(p *gpt.PartitionTable, sectors uint64, o op) (*gpt.PartitionTable, error) {
+	switch o.name {
+	case "o":
+		return gpt.NewTable(sectors)
+	}
+	if p == nil {
+		return nil, fmt.Errorf("no GPT, use -o to create one")
+	}
+	switch o.name {
+	case "e":
+		return p, p.Relocate(sectors)
+	case "d":
+		n, _, err := split(o.arg, 0)
+		if err != nil {
+			return nil, err
+		}
+		return p, p.Delete(n)
+	case "t":
+		n, f, err := split(o.arg, 1)
+		if err != nil {
+			return nil, err
+		}
+		typ, err := gpt.ParseType(f[0])
+		if err != nil {
+			return nil, err
+		}
+		return p, p.SetType(n, typ)
+	case "c":
+		n, f, err := split(o.arg, 1)
+		if err != nil {
+			return nil, err
+		}
+		return p, p.SetName(n, f[0])
+	case "s":
+		n, f, err := split(o.arg, 1)
+		if err != nil {
+			return nil, err
+		}
+		last, err := parseBlock(f[0])
+		if err != nil {
+			return nil, err
+		}
+		return p, p.Resize(n, last)
+	case "n":
+		n, f, err := split(o.arg, 2)
+		if err != nil {
+			return nil, err
+		}
+		first, err := parseBlock(f[0])
+		if err != nil {
+			return nil, err
+		}
+		var last, size uint64
+		if strings.HasPrefix(f[1], "+") {
+			if size, err = parseSize(f[1][1:]); err != nil {
+				return nil, err
+			}
+			if size == 0 {
+				return nil, fmt.Errorf("partition size %q is less than a block", f[1])
+			}
+			if first != 0 {
+				last = first + size - 1
+			}
+		} else if last, err = parseBlock(f[1]); err != nil {
+			return nil, err
+		}
+		if n, err = p.Add(n, gpt.Types["linux"], first, last, ""); err != nil {
+			return nil, err
+		}
+		if size != 0 && first == 0 {
+			// The default start is only known once added.
+			return p, p.Resize(n, p.Primary.Parts[n-1].FirstLBA+size-1)
+		}
+		return p, nil
+	}
+	return nil, fmt.Errorf("unknown option -%s", o.name)
+}
+
+func typeName(g gpt.GUID) string {
+	for n, t := range gpt.Types {
+		if t == g {
+			return n
+		}
+	}
+	return g.String()
+}
+
+func list(w io.Writer, p *gpt.PartitionTable) {
+	g := p.Primary
+	fmt.Fprintf(w, "Disk GUID %s, usable blocks %d-%d\n", g.DiskGUID.String(), g.FirstLBA, g.LastLBA)
+	fmt.Fprintf(w, "%-6s %12s %12s %10s  %-36s  %s\n", "Number", "Start", "End", "Size", "Type", "Name")
+	for i, pt := range g.Parts {
+		if !pt.IsUsed() {
+			continue
+		}
+		size := (pt.LastLBA - pt.FirstLBA + 1) * gpt.BlockSize
+		fmt.Fprintf(w, "%-6d %12d %12d %10s  %-36s  %s\n", i+1, pt.FirstLBA, pt.LastLBA, human(size), typeName(pt.PartGUID), pt.Name.Text())
+	}
+}
+
+// human returns n bytes with a binary suffix.
+func human(n uint64) string {
+	const units = "KMGTPE"
+	if n < 1024 {
+		return fmt.Sprintf("%dB", n)
+	}
+	f, i := float64(n)/1024, 0
+	for ; f >= 1024 && i < len(units)-1; i++ {
+		f /= 1024
+	}
+	return fmt.Sprintf("%.1f%c", f, units[i])
+}
+
+func edit(f *os.File, n string) error {
+	end, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+	sectors := uint64(end) / gpt.BlockSize
+
+	var p *gpt.PartitionTable
+	if *repair {
+		if p, err = gpt.Recover(f, sectors); err != nil {
+			return err
+		}
+	} else if len(ops) == 0 || ops[0].name != "o" {
+		if p, err = gpt.New(f); err != nil {
+			return fmt.Errorf("reading %v: %v", n, err)
+		}
+	}
+	for _, o := range ops {
+		if p, err = apply(p, sectors, o); err != nil {
+			return fmt.Errorf("-%s %s: %v", o.name, o.arg, err)
+		}
+	}
+	if *repair || len(ops) > 0 {
+		if err := gpt.Write(f, p); err != nil {
+			return fmt.Errorf("writing %v: %v", n, err)
+		}
+		reread(f)
+	}
+	if *show {
+		list(os.Stdout, p)
+	}
+	return nil
 }
 
 func main() {
@@ -46,7 +291,7 @@ func main() {
 	}
 
 	m := os.O_RDONLY
-	if *write {
+	if *write || *repair || len(ops) > 0 {
 		m = os.O_RDWR
 	}
 
@@ -56,8 +301,8 @@ func main() {
 		log.Fatal(err)
 	}
 
-	switch *write {
-	case true:
+	switch {
+	case *write:
 		var p = &gpt.PartitionTable{}
 		if err := json.NewDecoder(os.Stdin).Decode(&p); err != nil {
 			log.Fatalf("Reading in JSON: %v", err)
@@ -65,6 +310,10 @@ func main() {
 		if err := gpt.Write(f, p); err != nil {
 			log.Fatalf("Writing %v: %v", n, err)
 		}
+	case *repair || *show || len(ops) > 0:
+		if err := edit(f, n); err != nil {
+			log.Fatal(err)
+		}
 	default:
 		// We might get one back, we might get both.
 		// In the event of an error, we show what we can
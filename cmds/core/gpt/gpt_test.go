@@ -0,0 +1,67 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/u-root/u-root/pkg/mount/gpt"
+)
+
+func TestParseSize(t *testing.T) {
+	for _, tt := range []struct {
+		s    string
+		want uint64
+		ok   bool
+	}{
+		{"1024", 2, true},
+		{"1M", 2048, true},
+		{"2g", 4 << 20, true},
+		{"M", 0, false},
+		{"", 0, false},
+		{"1X", 0, false},
+	} {
+		got, err := parseSize(tt.s)
+		if got != tt.want || (err == nil) != tt.ok {
+			t.Errorf("parseSize(%q) = %d, %v, want %d, ok %v", tt.s, got, err, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestApply(t *testing.T) {
+	const sectors = 1 << 17
+	var p *gpt.PartitionTable
+	var err error
+	for _, o := range []op{
+		{"o", "true"},
+		{"n", "1:0:+10M"},
+		{"t", "1:efi"},
+		{"c", "1:EFI"},
+		{"n", "0::"},
+		{"d", "2"},
+		{"n", "3:40960:"},
+		{"s", "1:0"},
+	} {
+		if p, err = apply(p, sectors, o); err != nil {
+			t.Fatalf("-%s %s: %v", o.name, o.arg, err)
+		}
+	}
+	parts := p.Primary.Parts
+	if pt := parts[0]; pt.FirstLBA != 2048 || pt.LastLBA != 40959 || pt.PartGUID != gpt.Types["efi"] || pt.Name.Text() != "EFI" {
+		t.Errorf("partition 1 = %d-%d %v %q, want 2048-40959 efi \"EFI\"", pt.FirstLBA, pt.LastLBA, pt.PartGUID, pt.Name.Text())
+	}
+	if parts[1].IsUsed() {
+		t.Errorf("partition 2 was not deleted")
+	}
+	if pt := parts[2]; pt.FirstLBA != 40960 || pt.LastLBA != p.Primary.LastLBA {
+		t.Errorf("partition 3 = %d-%d, want 40960-%d", pt.FirstLBA, pt.LastLBA, p.Primary.LastLBA)
+	}
+	if _, err := apply(p, sectors, op{"n", "4:2048:4095"}); err == nil {
+		t.Errorf("adding an overlapping partition succeeded")
+	}
+	if _, err := apply(nil, sectors, op{"d", "1"}); err == nil {
+		t.Errorf("editing without a GPT succeeded")
+	}
+}
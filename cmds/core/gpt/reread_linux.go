@@ -0,0 +1,17 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+// reread asks the kernel to read the partitions of f again. Files and disks
+// in use cannot be read again, which is fine.
+func reread(f *os.File) {
+	_ = unix.IoctlSetInt(int(f.Fd()), unix.BLKRRPART, 0)
+}
@@ -3,6 +3,10 @@
 // license that can be found in the LICENSE file.
 
 // gzip compresses files using gzip compression.
+//
+// Named gunzip, gzcat or zcat, it decompresses gzip files; named xz, unxz or
+// xzcat, it compresses or decompresses xz files; named zstd, unzstd or
+// zstdcat, zstd files. The cat names write to stdout.
 package main
 
 import (
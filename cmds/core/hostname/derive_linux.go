@@ -0,0 +1,90 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/insomniacslk/dhcp/dhcpv4"
+	"github.com/u-root/u-root/pkg/dhclient"
+	"github.com/u-root/u-root/pkg/smbios"
+	"github.com/vishvananda/netlink"
+)
+
+// fromDHCP asks a DHCPv4 server on ifname for a host name. The lease is
+// not configured.
+func fromDHCP(ifname string) (string, error) {
+	l, err := netlink.LinkByName(ifname)
+	if err != nil {
+		return "", err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	c := dhclient.Config{
+		Timeout:    5 * time.Second,
+		Retries:    3,
+		Modifiers4: []dhcpv4.Modifier{dhcpv4.WithRequestedOptions(dhcpv4.OptionHostName)},
+	}
+	for r := range dhclient.SendRequests(ctx, []netlink.Link{l}, true, false, c, 30*time.Second) {
+		if r.Err != nil {
+			return "", r.Err
+		}
+		m, _ := r.Lease.Message()
+		if n := m.HostName(); n != "" {
+			return n, nil
+		}
+	}
+	return "", errors.New("lease has no host name")
+}
+
+// fromReverseDNS returns the first label of the name the first global
+// unicast address resolves to.
+func fromReverseDNS() (string, error) {
+	addrs, err := net.InterfaceAddrs()
+	if err != nil {
+		return "", err
+	}
+	for _, a := range addrs {
+		ipn, ok := a.(*net.IPNet)
+		if !ok || !ipn.IP.IsGlobalUnicast() {
+			continue
+		}
+		names, err := net.LookupAddr(ipn.IP.String())
+		if err != nil || len(names) == 0 {
+			continue
+		}
+		return strings.SplitN(names[0], ".", 2)[0], nil
+	}
+	return "", errors.New("no address with a reverse DNS name")
+}
+
+func fromSMBIOS() (*smbiosFields, error) {
+	info, err := smbios.FromSysfs()
+	if err != nil {
+		return nil, err
+	}
+	si, err := info.GetSystemInfo()
+	if err != nil {
+		return nil, err
+	}
+	f := &smbiosFields{
+		Serial:       si.SerialNumber,
+		Manufacturer: si.Manufacturer,
+		Product:      si.ProductName,
+		SKU:          si.SKUNumber,
+		UUID:         si.UUID.String(),
+	}
+	if bb, err := info.GetBaseboardInfo(); err == nil && len(bb) > 0 {
+		f.BoardSerial = bb[0].SerialNumber
+	}
+	if ch, err := info.GetChassisInfo(); err == nil && len(ch) > 0 {
+		f.ChassisSerial = ch[0].SerialNumber
+	}
+	return f, nil
+}
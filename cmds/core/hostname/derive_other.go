@@ -0,0 +1,23 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build !linux
+
+package main
+
+import "errors"
+
+var errNotSupported = errors.New("not supported on this system")
+
+func fromDHCP(string) (string, error) {
+	return "", errNotSupported
+}
+
+func fromReverseDNS() (string, error) {
+	return "", errNotSupported
+}
+
+func fromSMBIOS() (*smbiosFields, error) {
+	return nil, errNotSupported
+}
@@ -5,32 +5,176 @@
 // hostname prints or changes the system's hostname.
 //
 // Synopsis:
-//     hostname [HOSTNAME]
+//     hostname [-dhcp IFACE] [-reverse] [-smbios TEMPLATE] [-persist FILE] [HOSTNAME]
+//
+// Description:
+//     Without arguments, hostname prints the hostname. Given HOSTNAME, or
+//     one of the derivation flags, it sets the hostname. Derivation
+//     sources are tried in the order DHCP, reverse DNS, SMBIOS, and the
+//     first one to produce a name wins. Derived names are lowercased and
+//     characters not allowed in hostnames are replaced by '-'.
+//
+// Options:
+//     -dhcp:    use the host name (option 12) offered by a DHCPv4 server on IFACE
+//     -reverse: use the reverse DNS name of the first global address
+//     -smbios:  expand TEMPLATE with SMBIOS system information, e.g.
+//               "node-{{.Serial}}"; fields are Serial, Manufacturer,
+//               Product, SKU, UUID, BoardSerial and ChassisSerial
+//     -persist: also write the hostname to FILE, e.g. /mnt/etc/hostname,
+//               for the OS that will be booted
 //
 // Author:
 //     Beletti <rhiguita@gmail.com>
 package main
 
 import (
+	"bytes"
+	"errors"
+	"flag"
 	"fmt"
+	"io/ioutil"
 	"log"
 	"os"
+	"strings"
+	"text/template"
 )
 
+var (
+	dhcpIface = flag.String("dhcp", "", "derive the hostname from DHCP option 12 on this interface")
+	reverse   = flag.Bool("reverse", false, "derive the hostname from a reverse DNS lookup")
+	smbiosTpl = flag.String("smbios", "", "derive the hostname from SMBIOS with this template")
+	persist   = flag.String("persist", "", "file to also write the hostname to")
+)
+
+// hostNameMax is HOST_NAME_MAX.
+const hostNameMax = 64
+
+// smbiosFields are the fields available to -smbios templates.
+type smbiosFields struct {
+	Serial        string
+	Manufacturer  string
+	Product       string
+	SKU           string
+	UUID          string
+	BoardSerial   string
+	ChassisSerial string
+}
+
+// expand renders the -smbios template.
+func expand(tpl string, f *smbiosFields) (string, error) {
+	t, err := template.New("hostname").Option("missingkey=error").Parse(tpl)
+	if err != nil {
+		return "", err
+	}
+	var b bytes.Buffer
+	if err := t.Execute(&b, f); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// sanitize turns s into a valid hostname: lowercase letters, digits, '-'
+// and '.', with no empty or dash-delimited labels.
+func sanitize(s string) string {
+	var labels []string
+	for _, l := range strings.Split(strings.ToLower(strings.TrimSpace(s)), ".") {
+		l = strings.Map(func(r rune) rune {
+			if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '-' {
+				return r
+			}
+			return '-'
+		}, l)
+		for strings.Contains(l, "--") {
+			l = strings.ReplaceAll(l, "--", "-")
+		}
+		if l = strings.Trim(l, "-"); l != "" {
+			labels = append(labels, l)
+		}
+	}
+	n := strings.Join(labels, ".")
+	if len(n) > hostNameMax {
+		n = strings.TrimRight(n[:hostNameMax], "-.")
+	}
+	return n
+}
+
+// derive tries each requested source in turn.
+func derive() (string, error) {
+	var errs []string
+	try := func(src string, f func() (string, error)) string {
+		n, err := f()
+		if err == nil {
+			if n = sanitize(n); n != "" {
+				return n
+			}
+			err = errors.New("no usable name")
+		}
+		errs = append(errs, fmt.Sprintf("%s: %v", src, err))
+		return ""
+	}
+	if *dhcpIface != "" {
+		if n := try("dhcp", func() (string, error) { return fromDHCP(*dhcpIface) }); n != "" {
+			return n, nil
+		}
+	}
+	if *reverse {
+		if n := try("reverse DNS", fromReverseDNS); n != "" {
+			return n, nil
+		}
+	}
+	if *smbiosTpl != "" {
+		n := try("smbios", func() (string, error) {
+			f, err := fromSMBIOS()
+			if err != nil {
+				return "", err
+			}
+			return expand(*smbiosTpl, f)
+		})
+		if n != "" {
+			return n, nil
+		}
+	}
+	return "", fmt.Errorf("could not derive hostname: %s", strings.Join(errs, "; "))
+}
+
 func main() {
-	a := os.Args
-	switch len(a) {
-	case 2:
-		if err := Sethostname(a[1]); err != nil {
-			log.Fatalf("could not set hostname: %v", err)
+	flag.Parse()
+	deriving := *dhcpIface != "" || *reverse || *smbiosTpl != ""
+
+	var name string
+	switch {
+	case flag.NArg() == 1:
+		name = flag.Arg(0)
+	case flag.NArg() == 0 && deriving:
+		n, err := derive()
+		if err != nil {
+			log.Fatal(err)
 		}
-	case 1:
+		name = n
+	case flag.NArg() == 0 && *persist == "":
 		hostname, err := os.Hostname()
 		if err != nil {
 			log.Fatalf("could not obtain hostname: %v", err)
 		}
 		fmt.Println(hostname)
+		return
+	case flag.NArg() == 0:
+		// Persist the current hostname.
+		n, err := os.Hostname()
+		if err != nil {
+			log.Fatalf("could not obtain hostname: %v", err)
+		}
+		name = n
 	default:
-		log.Fatalf("usage: hostname [HOSTNAME]")
+		log.Fatalf("usage: hostname [-dhcp IFACE] [-reverse] [-smbios TEMPLATE] [-persist FILE] [HOSTNAME]")
+	}
+
+	if err := Sethostname(name); err != nil {
+		log.Fatalf("could not set hostname: %v", err)
+	}
+	if *persist != "" {
+		if err := ioutil.WriteFile(*persist, []byte(name+"\n"), 0644); err != nil {
+			log.Fatalf("could not persist hostname: %v", err)
+		}
 	}
 }
@@ -0,0 +1,47 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSanitize(t *testing.T) {
+	for in, want := range map[string]string{
+		"node1":                   "node1",
+		"Node_1":                  "node-1",
+		" rack 4 / slot 2 ":       "rack-4-slot-2",
+		"-web-.example.com.":      "web.example.com",
+		"To Be Filled By O.E.M.":  "to-be-filled-by-o.e.m",
+		"__":                      "",
+		strings.Repeat("a", 70):   strings.Repeat("a", hostNameMax),
+		strings.Repeat("ab-", 30): strings.Repeat("ab-", 21) + "a",
+		"über":                    "ber",
+	} {
+		if got := sanitize(in); got != want {
+			t.Errorf("sanitize(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestExpand(t *testing.T) {
+	f := &smbiosFields{Serial: "ABC123", Manufacturer: "Acme", Product: "R1"}
+	for _, tt := range []struct {
+		tpl  string
+		want string
+		err  bool
+	}{
+		{tpl: "node-{{.Serial}}", want: "node-ABC123"},
+		{tpl: "{{.Manufacturer}}-{{.Product}}-{{.Serial}}", want: "Acme-R1-ABC123"},
+		{tpl: "{{.Nope}}", err: true},
+		{tpl: "{{", err: true},
+	} {
+		got, err := expand(tt.tpl, f)
+		if (err != nil) != tt.err || got != tt.want {
+			t.Errorf("expand(%q) = %q, %v, want %q, error %v", tt.tpl, got, err, tt.want, tt.err)
+		}
+	}
+}
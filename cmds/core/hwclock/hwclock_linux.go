@@ -2,17 +2,28 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-// hwclock reads or changes the hardware clock (RTC) in UTC format.
+// hwclock reads or changes the hardware clock (RTC).
 //
 // Synopsis:
-//     hwclock [-w]
+//     hwclock [-r|-w|-s|-a] [-u|-l] [-f DEVICE] [-adjfile FILE]
 //
 // Description:
-//     It prints the current hwclock time in UTC if called without any flags.
-//     It sets the hwclock to the system clock in UTC if called with -w.
+//     It prints the current hwclock time if called without any flags.
+//     The RTC is assumed to be in UTC unless -l is given or the adjtime
+//     file says LOCAL.
+//
+//     Each time the RTC is set with -w, the drift since the previous -w
+//     is recorded in the adjtime file, so -s and -a can correct for it.
 //
 // Options:
-//     -w: set hwclock to system clock in UTC
+//     -r: print the hwclock time (the default)
+//     -w: set hwclock from the system clock
+//     -s: set the system clock from hwclock
+//     -a: correct hwclock for its drift
+//     -u: hwclock is kept in UTC
+//     -l: hwclock is kept in local time; -w records the choice
+//     -f: RTC device (default: the first of /dev/rtc, /dev/rtc0)
+//     -adjfile: drift file (default: /etc/adjtime)
 package main
 
 import (
@@ -22,30 +33,112 @@ import (
 	"time"
 
 	"github.com/u-root/u-root/pkg/rtc"
+	"golang.org/x/sys/unix"
 )
 
-var write = flag.Bool("w", false, "Set hwclock from system clock in UTC")
+var (
+	show    = flag.Bool("r", false, "Print hwclock time")
+	write   = flag.Bool("w", false, "Set hwclock from system clock")
+	hctosys = flag.Bool("s", false, "Set system clock from hwclock")
+	adjust  = flag.Bool("a", false, "Correct hwclock for drift")
+	utc     = flag.Bool("u", false, "hwclock is in UTC")
+	local   = flag.Bool("l", false, "hwclock is in local time")
+	dev     = flag.String("f", "", "RTC device")
+	adjfile = flag.String("adjfile", rtc.AdjtimePath, "Drift file")
+)
 
-func main() {
-	flag.Parse()
+// setAtSecond sets r to t, waiting for t's next whole second first. The
+// RTC only counts whole seconds and restarts its second when set, so this
+// avoids losing up to a second on every write.
+func setAtSecond(r *rtc.RTC, loc *time.Location) (time.Time, error) {
+	now := time.Now()
+	next := now.Truncate(time.Second).Add(time.Second)
+	time.Sleep(next.Sub(now))
+	return next, r.SetIn(next, loc)
+}
+
+func run() error {
+	if *utc && *local {
+		return fmt.Errorf("-u and -l are mutually exclusive")
+	}
+	n := 0
+	for _, b := range []bool{*show, *write, *hctosys, *adjust} {
+		if b {
+			n++
+		}
+	}
+	if n > 1 {
+		return fmt.Errorf("only one of -r, -w, -s and -a may be given")
+	}
 
-	r, err := rtc.OpenRTC()
+	var (
+		r   *rtc.RTC
+		err error
+	)
+	if *dev != "" {
+		r, err = rtc.Open(*dev)
+	} else {
+		r, err = rtc.OpenRTC()
+	}
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
+	defer r.Close()
 
-	if *write {
-		tu := time.Now().UTC()
-		if err := r.Set(tu); err != nil {
-			log.Fatal(err)
-		}
+	adj, err := rtc.ReadAdjtime(*adjfile)
+	if err != nil {
+		return err
+	}
+	if *utc {
+		adj.Local = false
+	}
+	if *local {
+		adj.Local = true
 	}
+	loc := adj.Location()
 
-	t, err := r.Read()
+	hw, err := r.ReadIn(loc)
 	if err != nil {
-		log.Fatal(err)
+		return err
+	}
+
+	switch {
+	case *write:
+		t, err := setAtSecond(r, loc)
+		if err != nil {
+			return err
+		}
+		adj.Calibrate(hw, t)
+		return adj.Write(*adjfile)
+
+	case *hctosys:
+		t := adj.Correct(hw)
+		tv := unix.NsecToTimeval(t.UnixNano())
+		if err := unix.Settimeofday(&tv); err != nil {
+			return fmt.Errorf("unable to set system time: %v", err)
+		}
+		return nil
+
+	case *adjust:
+		if adj.LastAdjust.IsZero() || adj.Drift == 0 {
+			return nil
+		}
+		t := adj.Correct(hw)
+		if err := r.SetIn(t, loc); err != nil {
+			return err
+		}
+		adj.LastAdjust = t
+		return adj.Write(*adjfile)
 	}
 
 	// Print local time. Match the format of util-linux' hwclock.
-	fmt.Println(t.Local().Format("Mon 2 Jan 2006 15:04:05 AM MST"))
+	fmt.Println(adj.Correct(hw).Local().Format("Mon 2 Jan 2006 15:04:05 AM MST"))
+	return nil
+}
+
+func main() {
+	flag.Parse()
+	if err := run(); err != nil {
+		log.Fatal(err)
+	}
 }
@@ -6,7 +6,6 @@ package main
 
 import (
 	"io/ioutil"
-	"log"
 	"os"
 	"os/exec"
 	"runtime"
@@ -39,11 +38,11 @@ func worker(cmds chan string) {
 		args := []string{"--onlybuild", "--noforce", "--lowpri", cmdName}
 		cmd := exec.Command("installcommand", args...)
 		if err := cmd.Start(); err != nil {
-			log.Println("Cannot start:", err)
+			ilog.Errorf("Cannot start installcommand: %v", err)
 			continue
 		}
 		if err := cmd.Wait(); err != nil {
-			log.Println("installcommand error:", err)
+			ilog.Errorf("installcommand error: %v", err)
 		}
 	}
 }
@@ -60,7 +59,7 @@ func startBgBuild() {
 	// heuristic.
 	fis, err := ioutil.ReadDir("/buildbin")
 	if err != nil {
-		log.Print(err)
+		ilog.Errorf("%v", err)
 		return
 	}
 	sort.Sort(cmdSlice(fis))
@@ -8,33 +8,53 @@
 // init does some basic initialization (mount file systems, turn on loopback)
 // and then tries to execute, in order, /inito, a uinit (either in /bin, /bbin,
 // or /ubin), and then a shell (/bin/defaultsh and /bin/sh).
+//
+// If there is an /etc/inittab, or the file given by inittab=FILE in
+// uroot.initflags, init supervises the services in it instead, until it is
+// told to shut down by SIGTERM (reboot), SIGUSR1 (halt) or SIGUSR2 (power
+// off). See libinit.ParseInittab for its format.
+//
+// With watchdog=TIMEOUT in uroot.initflags, init also arms the hardware
+// watchdog and keeps it alive while it runs.
+//
+// init logs through ulog as the "init" component; uroot.verbose and uroot.log
+// on the kernel command line set its level and sinks.
 package main
 
 import (
 	"flag"
 	"fmt"
-	"log"
 	"os/exec"
 
 	"github.com/u-root/u-root/pkg/libinit"
+	"github.com/u-root/u-root/pkg/ulog"
 )
 
 // initCmds has all the bits needed to continue
 // the init process after some initial setup.
 type initCmds struct {
 	cmds []*exec.Cmd
+
+	// services, if there is an inittab, are supervised instead of
+	// running cmds.
+	services []libinit.Entry
 }
 
 var (
 	verbose = flag.Bool("v", false, "print all build commands")
 	test    = flag.Bool("test", false, "Test mode: don't try to set control tty")
-	debug   = func(string, ...interface{}) {}
+
+	ilog = ulog.Component("init")
 )
 
 func main() {
 	flag.Parse()
+	ulog.ConfigureFromCmdline()
+	if *verbose {
+		ulog.Default.SetComponentLevel("init", ulog.LevelDebug)
+	}
 
-	log.Printf("Welcome to u-root!")
+	ilog.Infof("Welcome to u-root!")
 	fmt.Println(`                              _`)
 	fmt.Println(`   _   _      _ __ ___   ___ | |_`)
 	fmt.Println(`  | | | |____| '__/ _ \ / _ \| __|`)
@@ -42,12 +62,6 @@ func main() {
 	fmt.Println(`   \__,_|    |_|  \___/ \___/ \__|`)
 	fmt.Println()
 
-	log.SetPrefix("init: ")
-
-	if *verbose {
-		debug = log.Printf
-	}
-
 	// Before entering an interactive shell, decrease the loglevel because
 	// spamming non-critical logs onto the shell frustrates users. The logs
 	// are still accessible through kernel logs buffers (on most kernels).
@@ -62,23 +76,38 @@ func main() {
 	// to be used in the rest of init.
 	ic := osInitGo()
 
+	// Arm the watchdog only once modules, which may include its driver,
+	// are loaded.
+	onPanic := startWatchdog()
+	defer func() {
+		if r := recover(); r != nil {
+			onPanic()
+			panic(r)
+		}
+	}()
+
 	// Start background build.
 	if isBgBuildEnabled() {
 		go startBgBuild()
 	}
 
-	cmdCount := libinit.RunCommands(debug, ic.cmds...)
+	if ic.services != nil {
+		supervise(ic.services)
+		return
+	}
+
+	cmdCount := libinit.RunCommands(ilog.Debugf, ic.cmds...)
 	if cmdCount == 0 {
-		log.Printf("No suitable executable found in %v", ic.cmds)
+		ilog.Errorf("No suitable executable found in %v", ic.cmds)
 	}
 
 	// We need to reap all children before exiting.
-	log.Printf("Waiting for orphaned children")
+	ilog.Infof("Waiting for orphaned children")
 	libinit.WaitOrphans()
-	log.Printf("All commands exited")
-	log.Printf("Syncing filesystems")
+	ilog.Infof("All commands exited")
+	ilog.Infof("Syncing filesystems")
 	if err := quiesce(); err != nil {
-		log.Printf("%v", err)
+		ilog.Errorf("%v", err)
 	}
-	log.Printf("Exiting...")
+	ilog.Infof("Exiting...")
 }
@@ -6,23 +6,27 @@ package main
 
 import (
 	"io/ioutil"
-	"log"
 	"os"
 	"os/exec"
 	"strconv"
 	"syscall"
+	"time"
 
 	"github.com/u-root/u-root/pkg/cmdline"
 	"github.com/u-root/u-root/pkg/libinit"
+	"github.com/u-root/u-root/pkg/shutdown"
 	"github.com/u-root/u-root/pkg/uflag"
 	"github.com/u-root/u-root/pkg/ulog"
+	"github.com/u-root/u-root/pkg/watchdog"
+	"github.com/u-root/u-root/pkg/watchdogd"
+	"golang.org/x/sys/unix"
 )
 
 func quiet() {
-	if !*verbose {
+	if !ilog.Enabled(ulog.LevelDebug) {
 		// Only messages more severe than "notice" are printed.
 		if err := ulog.KernelLog.SetConsoleLogLevel(ulog.KLogNotice); err != nil {
-			log.Printf("Could not set log level: %v", err)
+			ilog.Warningf("Could not set log level: %v", err)
 		}
 	}
 }
@@ -45,7 +49,7 @@ func osInitGo() *initCmds {
 	systemdEnabled, boolErr := strconv.ParseBool(systemd)
 	if present && boolErr == nil && systemdEnabled {
 		if err := syscall.Exec("/inito", []string{"/inito"}, os.Environ()); err != nil {
-			log.Printf("Lucky you, systemd failed: %v", err)
+			ilog.Errorf("Lucky you, systemd failed: %v", err)
 		}
 	}
 
@@ -61,7 +65,17 @@ func osInitGo() *initCmds {
 	}
 	uinitArgs := libinit.WithArguments(args...)
 
+	tab, ok := initFlags["inittab"]
+	if !ok {
+		tab = libinit.DefaultInittab
+	}
+	services, err := libinit.ReadInittab(tab)
+	if err != nil && !os.IsNotExist(err) {
+		ilog.Errorf("Not using %s: %v", tab, err)
+	}
+
 	return &initCmds{
+		services: services,
 		cmds: []*exec.Cmd{
 			// inito is (optionally) created by the u-root command when the
 			// u-root initramfs is merged with an existing initramfs that
@@ -80,3 +94,94 @@ func osInitGo() *initCmds {
 	}
 
 }
+
+// supervise supervises the services until init is told to shut down, and
+// then unmounts the file systems and reboots, halts or powers off.
+func supervise(services []libinit.Entry) {
+	ilog.Infof("Supervising %d services", len(services))
+	cmd := libinit.Supervise(services, ilog.Infof)
+	ilog.Infof("Syncing filesystems")
+	if err := quiesce(); err != nil {
+		ilog.Errorf("%v", err)
+	}
+	if os.Getpid() != 1 {
+		return
+	}
+	ilog.Infof("Unmounting filesystems")
+	if err := shutdown.UnmountAll(ilog.Infof); err != nil {
+		ilog.Errorf("%v", err)
+	}
+	if err := unix.Reboot(int(cmd)); err != nil {
+		ilog.Errorf("Reboot: %v", err)
+	}
+}
+
+// startWatchdog arms the hardware watchdog and pets it for as long as init
+// runs, if asked to by the init flags:
+//
+//     uroot.initflags="watchdog=30s watchdog_panic=disarm"
+//
+// watchdog is the timeout, or 1 to keep the driver's. watchdog_dev and
+// watchdog_keepalive override the device and the keepalive interval.
+// watchdog_panic says what to do if init panics: "reset" (the default)
+// stops petting so the machine is reset, "disarm" leaves it hung for
+// debugging.
+//
+// It returns the function to call when init panics.
+func startWatchdog() func() {
+	nop := func() {}
+	initFlags := cmdline.GetInitFlagMap()
+	v, ok := initFlags["watchdog"]
+	if !ok {
+		return nop
+	}
+	opts := &watchdogd.DaemonOpts{
+		Dev:       watchdog.Dev,
+		KeepAlive: 10 * time.Second,
+	}
+	if on, err := strconv.ParseBool(v); err == nil {
+		if !on {
+			return nop
+		}
+	} else if d, err := time.ParseDuration(v); err == nil {
+		opts.Timeout = &d
+	} else {
+		ilog.Warningf("Invalid watchdog timeout %q", v)
+		return nop
+	}
+	if dev, ok := initFlags["watchdog_dev"]; ok {
+		opts.Dev = dev
+	}
+	if ka, ok := initFlags["watchdog_keepalive"]; ok {
+		d, err := time.ParseDuration(ka)
+		if err != nil || d <= 0 {
+			ilog.Warningf("Invalid watchdog keepalive %q", ka)
+			return nop
+		}
+		opts.KeepAlive = d
+	}
+	disarm := false
+	switch p := initFlags["watchdog_panic"]; p {
+	case "", "reset":
+	case "disarm":
+		disarm = true
+	default:
+		ilog.Warningf("Invalid watchdog_panic %q, using reset", p)
+	}
+
+	k, err := watchdogd.Keep(opts)
+	if err != nil {
+		ilog.Errorf("%v", err)
+		return nop
+	}
+	return func() {
+		if disarm {
+			if err := k.Disarm(); err != nil {
+				ilog.Errorf("watchdog: Failed to disarm: %v", err)
+			}
+			return
+		}
+		ilog.Warningf("watchdog: Stopped keepalive, the machine will be reset")
+		k.Stop()
+	}
+}
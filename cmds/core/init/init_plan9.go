@@ -15,6 +15,13 @@ import (
 func quiet() {
 }
 
+func startWatchdog() func() {
+	return func() {}
+}
+
+func supervise([]libinit.Entry) {
+}
+
 func osInitGo() *initCmds {
 	// TOOD: get kernel command line.
 	uinitArgs := libinit.WithArguments()
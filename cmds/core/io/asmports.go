@@ -14,14 +14,14 @@ import (
 // must be run by root.
 func init() {
 	usageMsg += `io (xin{b,w,l} address)...
-io (xout{b,w,l} address value)...
+io (xout{b,w,l} address value[:mask])...
 `
-	addCmd(readCmds, "xinb", &cmd{xin, 16, 8})
-	addCmd(readCmds, "xinw", &cmd{xin, 16, 16})
-	addCmd(readCmds, "xinl", &cmd{xin, 16, 32})
-	addCmd(writeCmds, "xoutb", &cmd{xout, 16, 8})
-	addCmd(writeCmds, "xoutw", &cmd{xout, 16, 16})
-	addCmd(writeCmds, "xoutl", &cmd{xout, 16, 32})
+	addCmd(readCmds, "xinb", &cmd{xin, 16, 8, nil})
+	addCmd(readCmds, "xinw", &cmd{xin, 16, 16, nil})
+	addCmd(readCmds, "xinl", &cmd{xin, 16, 32, nil})
+	addCmd(writeCmds, "xoutb", &cmd{xout, 16, 8, xin})
+	addCmd(writeCmds, "xoutw", &cmd{xout, 16, 16, xin})
+	addCmd(writeCmds, "xoutl", &cmd{xout, 16, 32, xin})
 }
 
 func xin(addr int64, data memio.UintN) error {
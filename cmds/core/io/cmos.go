@@ -15,14 +15,14 @@ import (
 
 func init() {
 	usageMsg += `io (cr index)... # read from CMOS register index [14-127]
-io (cw index value)... # write value to CMOS register index [14-127]
+io (cw index value[:mask])... # write value to CMOS register index [14-127]
 io (rtcr index)... # read from RTC register index [0-13]
-io (rtcw index value)... # write value to RTC register index [0-13]
+io (rtcw index value[:mask])... # write value to RTC register index [0-13]
 `
-	addCmd(readCmds, "cr", &cmd{cmosRead, 7, 8})
-	addCmd(readCmds, "rtcr", &cmd{rtcRead, 7, 8})
-	addCmd(writeCmds, "cw", &cmd{cmosWrite, 7, 8})
-	addCmd(writeCmds, "rtcw", &cmd{rtcWrite, 7, 8})
+	addCmd(readCmds, "cr", &cmd{cmosRead, 7, 8, nil})
+	addCmd(readCmds, "rtcr", &cmd{rtcRead, 7, 8, nil})
+	addCmd(writeCmds, "cw", &cmd{cmosWrite, 7, 8, cmosRead})
+	addCmd(writeCmds, "rtcw", &cmd{rtcWrite, 7, 8, rtcRead})
 }
 
 func cmosRead(reg int64, data memio.UintN) error {
@@ -6,25 +6,43 @@
 //
 // Synopsis:
 //     io (r{b,w,l,q} address)...
-//     io (w{b,w,l,q} address value)...
+//     io (w{b,w,l,q} address value[:mask])...
 //     # x86 only:
 //     io (in{b,w,l} address)
-//     io (out{b,w,l} address value)
+//     io (out{b,w,l} address value[:mask])
 //     io (cr index}
-//     io {cw index value}...
+//     io {cw index value[:mask]}...
+//     io (rdmsr cpus msr)...
+//     io (wrmsr cpus msr value[:mask])...
 //
 // Description:
 //     io lets you read/write 1/2/4/8-bytes to memory with the {r,w}{b,w,l,q}
-//     commands respectively.
+//     commands respectively. The memory is mapped from /dev/mem, so these
+//     work for device registers (MMIO), which are accessed with a single
+//     load or store of that width. The address must be aligned to the
+//     width, and accesses which fault, e.g. because no device decodes the
+//     address, are reported as errors.
 //
 //     On x86 platforms, {in,out}{b,w,l} allow for port io.
 //
 //     Use cr / cw to write to cmos registers
+//
+//     rdmsr and wrmsr read and write a model-specific register, given by
+//     address or name, e.g. IA32_FEATURE_CONTROL, on each of cpus: a list
+//     like 0-3,5, or all. rdmsr prints the value of each CPU.
+//
+//     A value:mask only changes the bits of mask: the register is read,
+//     those bits are replaced by those of value, and it is written back.
+//     MSRs are only written back if that changes them.
 // Examples:
 //     # Read 8-bytes from address 0x10000 and 0x10000
 //     io rq 0x10000 rq 0x10008
 //     # Write to the serial port on x86
 //     io outb 0x3f8 50
+//     # Set bit 4 of a device register, leaving the others as they are
+//     io wl 0xfed40000 0x10:0x10
+//     # Read the feature control MSR of all CPUs
+//     io rdmsr all 0x3a
 package main
 
 import (
@@ -32,6 +50,7 @@ import (
 	"log"
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/u-root/u-root/pkg/memio"
 )
@@ -40,11 +59,21 @@ type cmdFunc func(addr int64, data memio.UintN) error
 type cmd struct {
 	f                 cmdFunc
 	addrBits, valBits int
+	// rd reads what a write command writes, for value:mask writes.
+	rd cmdFunc
+}
+
+// verb is a command which parses its own arguments, nargs of them, into
+// the function to queue.
+type verb struct {
+	nargs int
+	parse func(args []string) (func() error, error)
 }
 
 var (
 	readCmds  = map[string]*cmd{}
 	writeCmds = map[string]*cmd{}
+	verbs     = map[string]*verb{}
 	usageMsg  string
 )
 
@@ -55,6 +84,13 @@ func addCmd(cmds map[string]*cmd, n string, f *cmd) {
 	cmds[n] = f
 }
 
+func addVerb(n string, v *verb) {
+	if _, ok := verbs[n]; ok {
+		log.Fatalf("Command %q is defined twice", n)
+	}
+	verbs[n] = v
+}
+
 func usage() {
 	fmt.Print(usageMsg)
 	os.Exit(1)
@@ -80,6 +116,37 @@ func newInt(val uint64, bits int) memio.UintN {
 	}
 }
 
+// intValue returns the value of a UintN made by newInt.
+func intValue(data memio.UintN) uint64 {
+	switch v := data.(type) {
+	case *memio.Uint8:
+		return uint64(*v)
+	case *memio.Uint16:
+		return uint64(*v)
+	case *memio.Uint32:
+		return uint64(*v)
+	case *memio.Uint64:
+		return uint64(*v)
+	default:
+		panic(fmt.Sprintf("invalid value type %T", data))
+	}
+}
+
+// parseValue parses a value to write of bits bits, with an optional mask
+// of the bits to change: value:mask. The mask is all ones if there is none.
+func parseValue(s string, bits int) (value, mask uint64, hasMask bool, err error) {
+	if i := strings.IndexByte(s, ':'); i >= 0 {
+		if mask, err = strconv.ParseUint(s[i+1:], 0, bits); err != nil {
+			return 0, 0, false, err
+		}
+		s, hasMask = s[:i], true
+	} else {
+		mask = ^uint64(0) >> uint(64-bits)
+	}
+	value, err = strconv.ParseUint(s, 0, bits)
+	return value, mask, hasMask, err
+}
+
 func main() {
 	if len(os.Args) < 3 {
 		usage()
@@ -124,18 +191,44 @@ func main() {
 			if err != nil {
 				log.Fatal(err)
 			}
-			value, err := strconv.ParseUint(dataStr, 0, c.valBits)
+			value, mask, hasMask, err := parseValue(dataStr, c.valBits)
 			if err != nil {
 				log.Fatal(err)
 			}
+			if hasMask && c.rd == nil {
+				log.Fatalf("%s can not write a value with a mask", cmdStr)
+			}
 
 			queue = append(queue, func() {
+				if hasMask {
+					// Only change the bits of mask.
+					old := newInt(0, c.valBits)
+					if err := c.rd(int64(addr), old); err != nil {
+						log.Fatal(err)
+					}
+					value = intValue(old)&^mask | value&mask
+				}
 				// Write data to addr.
 				data := newInt(value, c.valBits)
 				if err := c.f(int64(addr), data); err != nil {
 					log.Fatal(err)
 				}
 			})
+		} else if v, ok := verbs[cmdStr]; ok {
+			if len(os.Args) < v.nargs {
+				usage()
+			}
+			f, err := v.parse(os.Args[:v.nargs])
+			if err != nil {
+				log.Fatal(err)
+			}
+			os.Args = os.Args[v.nargs:]
+
+			queue = append(queue, func() {
+				if err := f(); err != nil {
+					log.Fatal(err)
+				}
+			})
 		} else {
 			usage()
 		}
@@ -5,21 +5,34 @@
 package main
 
 import (
+	"fmt"
+
 	"github.com/u-root/u-root/pkg/memio"
 )
 
 func init() {
-	addCmd(readCmds, "rb", &cmd{memio.Read, 64, 8})
-	addCmd(readCmds, "rw", &cmd{memio.Read, 64, 16})
-	addCmd(readCmds, "rl", &cmd{memio.Read, 64, 32})
-	addCmd(readCmds, "rq", &cmd{memio.Read, 64, 64})
+	addCmd(readCmds, "rb", &cmd{aligned(memio.Read), 64, 8, nil})
+	addCmd(readCmds, "rw", &cmd{aligned(memio.Read), 64, 16, nil})
+	addCmd(readCmds, "rl", &cmd{aligned(memio.Read), 64, 32, nil})
+	addCmd(readCmds, "rq", &cmd{aligned(memio.Read), 64, 64, nil})
 
-	addCmd(writeCmds, "wb", &cmd{memio.Write, 64, 8})
-	addCmd(writeCmds, "ww", &cmd{memio.Write, 64, 16})
-	addCmd(writeCmds, "wl", &cmd{memio.Write, 64, 32})
-	addCmd(writeCmds, "wq", &cmd{memio.Write, 64, 64})
+	addCmd(writeCmds, "wb", &cmd{aligned(memio.Write), 64, 8, aligned(memio.Read)})
+	addCmd(writeCmds, "ww", &cmd{aligned(memio.Write), 64, 16, aligned(memio.Read)})
+	addCmd(writeCmds, "wl", &cmd{aligned(memio.Write), 64, 32, aligned(memio.Read)})
+	addCmd(writeCmds, "wq", &cmd{aligned(memio.Write), 64, 64, aligned(memio.Read)})
 
 	usageMsg += `io (r{b,w,l,q} address)...
-io (w{b,w,l,q} address value)...
+io (w{b,w,l,q} address value[:mask])...
 `
 }
+
+// aligned checks that memory accesses are aligned to their width, as
+// device registers need.
+func aligned(f cmdFunc) cmdFunc {
+	return func(addr int64, data memio.UintN) error {
+		if addr%data.Size() != 0 {
+			return fmt.Errorf("address %#x is not aligned to %d bytes", addr, data.Size())
+		}
+		return f(addr, data)
+	}
+}
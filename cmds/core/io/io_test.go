@@ -0,0 +1,31 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import "testing"
+
+func TestParseValue(t *testing.T) {
+	for _, tt := range []struct {
+		in          string
+		bits        int
+		value, mask uint64
+		hasMask     bool
+	}{
+		{"0x12", 8, 0x12, 0xff, false},
+		{"0x10:0x30", 32, 0x10, 0x30, true},
+		{"1", 64, 1, ^uint64(0), false},
+		{"0:0x8000", 16, 0, 0x8000, true},
+	} {
+		v, m, hm, err := parseValue(tt.in, tt.bits)
+		if err != nil || v != tt.value || m != tt.mask || hm != tt.hasMask {
+			t.Errorf("parseValue(%q, %d) = %#x, %#x, %v, %v, want %#x, %#x, %v, nil", tt.in, tt.bits, v, m, hm, err, tt.value, tt.mask, tt.hasMask)
+		}
+	}
+	for _, in := range []string{"0x100", "1:0x100", "x", "1:"} {
+		if _, _, _, err := parseValue(in, 8); err == nil {
+			t.Errorf("parseValue(%q, 8) succeeded", in)
+		}
+	}
+}
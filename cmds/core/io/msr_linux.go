@@ -0,0 +1,87 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build amd64 386
+
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/u-root/u-root/pkg/msr"
+)
+
+func init() {
+	usageMsg += `io (rdmsr cpus msr)... # read an MSR on cpus, e.g. 0-3,5 or all
+io (wrmsr cpus msr value[:mask])... # write an MSR on cpus
+`
+	addVerb("rdmsr", &verb{2, rdmsr})
+	addVerb("wrmsr", &verb{3, wrmsr})
+}
+
+// cpuErrors returns an error for the CPUs of c with errs.
+func cpuErrors(c msr.CPUs, errs []error) error {
+	var s []string
+	for i, err := range errs {
+		if err == nil {
+			continue
+		}
+		if len(errs) == len(c) {
+			s = append(s, fmt.Sprintf("cpu%d: %v", c[i], err))
+		} else {
+			s = append(s, err.Error())
+		}
+	}
+	return fmt.Errorf("%s", strings.Join(s, "; "))
+}
+
+func parseMSR(cpus, reg string) (msr.CPUs, msr.MSR, error) {
+	c, err := msr.ParseCPUs(cpus)
+	if err != nil {
+		return nil, 0, err
+	}
+	m, err := msr.ParseMSR(reg)
+	return c, m, err
+}
+
+func rdmsr(args []string) (func() error, error) {
+	c, m, err := parseMSR(args[0], args[1])
+	if err != nil {
+		return nil, err
+	}
+	return func() error {
+		vals, errs := m.Read(c)
+		if errs != nil {
+			return cpuErrors(c, errs)
+		}
+		for i, v := range vals {
+			fmt.Printf("cpu%d: %#016x\n", c[i], v)
+		}
+		return nil
+	}, nil
+}
+
+func wrmsr(args []string) (func() error, error) {
+	c, m, err := parseMSR(args[0], args[1])
+	if err != nil {
+		return nil, err
+	}
+	value, mask, hasMask, err := parseValue(args[2], 64)
+	if err != nil {
+		return nil, err
+	}
+	return func() error {
+		var errs []error
+		if hasMask {
+			errs = m.TestAndSet(c, mask, value&mask)
+		} else {
+			errs = m.Write(c, value)
+		}
+		if errs != nil {
+			return cpuErrors(c, errs)
+		}
+		return nil
+	}, nil
+}
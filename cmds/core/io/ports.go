@@ -12,14 +12,14 @@ import (
 
 func init() {
 	usageMsg += `io (in{b,w,l} address)...
-io (out{b,w,l} address value)...
+io (out{b,w,l} address value[:mask])...
 `
-	addCmd(readCmds, "inb", &cmd{in, 16, 8})
-	addCmd(readCmds, "inw", &cmd{in, 16, 16})
-	addCmd(readCmds, "inl", &cmd{in, 16, 32})
-	addCmd(writeCmds, "outb", &cmd{out, 16, 8})
-	addCmd(writeCmds, "outw", &cmd{out, 16, 16})
-	addCmd(writeCmds, "outl", &cmd{out, 16, 32})
+	addCmd(readCmds, "inb", &cmd{in, 16, 8, nil})
+	addCmd(readCmds, "inw", &cmd{in, 16, 16, nil})
+	addCmd(readCmds, "inl", &cmd{in, 16, 32, nil})
+	addCmd(writeCmds, "outb", &cmd{out, 16, 8, in})
+	addCmd(writeCmds, "outw", &cmd{out, 16, 16, in})
+	addCmd(writeCmds, "outl", &cmd{out, 16, 32, in})
 }
 
 func in(addr int64, data memio.UintN) error {
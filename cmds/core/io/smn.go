@@ -23,10 +23,10 @@ const pcibase = 0xe0000000
 
 func init() {
 	usageMsg += `io rs index # read from system management network on newer AMD CPUs.
-io ws index value # write value to system management network on newer AMD CPUs.
+io ws index value[:mask] # write value to system management network on newer AMD CPUs.
 `
-	addCmd(readCmds, "rs", &cmd{smnRead, 32, 32})
-	addCmd(writeCmds, "ws", &cmd{smnWrite, 32, 32})
+	addCmd(readCmds, "rs", &cmd{smnRead, 32, 32, nil})
+	addCmd(writeCmds, "ws", &cmd{smnWrite, 32, 32, smnRead})
 }
 
 func do(addr int64, data memio.UintN, op func(int64, memio.UintN) error) error {
@@ -3,22 +3,53 @@
 // license that can be found in the LICENSE file.
 
 // ip manipulates network addresses, interfaces, routing, and other config.
+//
+// Synopsis:
+//     ip [-6] [-j] addr [add|del CIDR dev DEV]
+//     ip link [show [dev] DEV]
+//     ip link set [dev] DEV [up|down] [address MAC] [mtu MTU] [name NAME] [master DEV|nomaster] [netns NS|PID]
+//     ip link add [link DEV] [name] NAME [mtu MTU] [address MAC] type TYPE [ARGS]
+//     ip link delete [dev] DEV
+//     ip route [show|list] [ROUTE]
+//     ip route add|del|replace ROUTE
+//     ip route get ADDR
+//     ip neigh [show [dev DEV]]
+//     ip neigh add|del|replace ADDR [lladdr MAC] dev DEV [nud STATE] [router]
+//     ip neigh flush dev DEV
+//     ip netns [list]
+//     ip netns add|delete NAME
+//     ip netns exec NAME COMMAND [ARGS]
+//     ip monitor
+//
+// Description:
+//     TYPE is bridge, dummy, vlan id ID [protocol 802.1q|802.1ad], which
+//     needs a link device, bond [mode MODE] or veth peer [name] NAME.
+//
+//     ROUTE is {default|PREFIX|ADDR} [via GW] [dev DEV] [src ADDR]
+//     [metric N] [proto PROTO] [scope SCOPE] [table TABLE] [mtu MTU].
+//     Shown routes are those of the main table which match the given
+//     fields; table all shows all tables.
 package main
 
 import (
-	"errors"
 	"fmt"
 	l "log"
 	"net"
 	"os"
+	"strconv"
 	"strings"
 
 	flag "github.com/spf13/pflag"
 
+	"github.com/u-root/u-root/pkg/output"
 	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netns"
 )
 
-var inet6 = flag.BoolP("6", "6", false, "use ipv6")
+var (
+	inet6   = flag.BoolP("6", "6", false, "use ipv6")
+	jsonOut = flag.BoolP(output.FlagName, "j", false, output.FlagUsage)
+)
 
 // The language implemented by the standard 'ip' is not super consistent
 // and has lots of convenience shortcuts.
@@ -143,20 +174,38 @@ func addrip() error {
 	return nil
 }
 
-func neigh() error {
-	if len(arg) != 1 {
-		return errors.New("neigh subcommands not supported yet")
+// more reports whether there are arguments left after the cursor.
+func more() bool {
+	return cursor+1 < len(arg)
+}
+
+// next moves to the next argument and returns it, wanting one of want.
+func next(want ...string) string {
+	cursor++
+	whatIWant = want
+	return arg[cursor]
+}
+
+// number returns the next argument as a number.
+func number(what string) (int, error) {
+	s := next(what)
+	n, err := strconv.ParseUint(s, 0, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q", what, s)
 	}
-	return showNeighbours(os.Stdout, true)
+	return int(n), nil
 }
 
 func linkshow() error {
-	cursor++
 	whatIWant = []string{"<nothing>", "<device name>"}
-	if len(arg[cursor:]) == 0 {
+	if !more() {
 		return showLinks(os.Stdout, false)
 	}
-	return nil
+	iface, err := dev()
+	if err != nil {
+		return err
+	}
+	return showLinks(os.Stdout, false, iface)
 }
 
 func setHardwareAddress(iface netlink.Link) error {
@@ -172,58 +221,192 @@ func setHardwareAddress(iface netlink.Link) error {
 	return nil
 }
 
+// setNetns moves iface to the namespace named by the next argument, or to
+// that of a process, given by PID.
+func setNetns(iface netlink.Link) error {
+	name := next("namespace name", "PID")
+	var ns netns.NsHandle
+	var err error
+	if pid, perr := strconv.Atoi(name); perr == nil {
+		ns, err = netns.GetFromPid(pid)
+	} else {
+		ns, err = netns.GetFromName(name)
+	}
+	if err != nil {
+		return err
+	}
+	defer ns.Close()
+	if err := netlink.LinkSetNsFd(iface, int(ns)); err != nil {
+		return fmt.Errorf("%v can't move to %s: %v", iface.Attrs().Name, name, err)
+	}
+	return nil
+}
+
 func linkset() error {
 	iface, err := dev()
 	if err != nil {
 		return err
 	}
 
-	cursor++
-	whatIWant = []string{"address", "up", "down", "master"}
-	switch one(arg[cursor], whatIWant) {
-	case "address":
-		return setHardwareAddress(iface)
-	case "up":
-		if err := netlink.LinkSetUp(iface); err != nil {
-			return fmt.Errorf("%v can't make it up: %v", iface.Attrs().Name, err)
-		}
-	case "down":
-		if err := netlink.LinkSetDown(iface); err != nil {
-			return fmt.Errorf("%v can't make it down: %v", iface.Attrs().Name, err)
+	// As many settings as given, in order.
+	for {
+		whatIWant = []string{"address", "up", "down", "master", "nomaster", "mtu", "name", "netns"}
+		switch one(next(whatIWant...), whatIWant) {
+		case "address":
+			err = setHardwareAddress(iface)
+		case "up":
+			if err := netlink.LinkSetUp(iface); err != nil {
+				return fmt.Errorf("%v can't make it up: %v", iface.Attrs().Name, err)
+			}
+		case "down":
+			if err := netlink.LinkSetDown(iface); err != nil {
+				return fmt.Errorf("%v can't make it down: %v", iface.Attrs().Name, err)
+			}
+		case "master":
+			var master netlink.Link
+			if master, err = netlink.LinkByName(next("device name")); err == nil {
+				err = netlink.LinkSetMaster(iface, master)
+			}
+		case "nomaster":
+			err = netlink.LinkSetNoMaster(iface)
+		case "mtu":
+			var mtu int
+			if mtu, err = number("MTU"); err == nil {
+				err = netlink.LinkSetMTU(iface, mtu)
+			}
+		case "name":
+			err = netlink.LinkSetName(iface, next("device name"))
+		case "netns":
+			err = setNetns(iface)
+		default:
+			return usage()
 		}
-	case "master":
-		cursor++
-		whatIWant = []string{"device name"}
-		master, err := netlink.LinkByName(arg[cursor])
-		if err != nil {
+		if err != nil || !more() {
 			return err
 		}
-		return netlink.LinkSetMaster(iface, master)
-	default:
-		return usage()
 	}
-	return nil
 }
 
-func linkadd() error {
-	name, err := maybename()
-	if err != nil {
-		return err
+// linkType returns the link of the type named by the next argument, and its
+// arguments, e.g. vlan id 10.
+func linkType(attrs netlink.LinkAttrs) (netlink.Link, error) {
+	whatIWant = []string{"bridge", "vlan", "bond", "dummy", "veth"}
+	switch next(whatIWant...) {
+	case "bridge":
+		return &netlink.Bridge{LinkAttrs: attrs}, nil
+	case "dummy":
+		return &netlink.Dummy{LinkAttrs: attrs}, nil
+	case "vlan":
+		if attrs.ParentIndex == 0 {
+			return nil, fmt.Errorf("vlan needs a link device")
+		}
+		v := &netlink.Vlan{LinkAttrs: attrs, VlanId: -1, VlanProtocol: netlink.VLAN_PROTOCOL_8021Q}
+		for more() {
+			var err error
+			switch next("id", "protocol") {
+			case "id":
+				v.VlanId, err = number("VLAN id")
+			case "protocol":
+				switch p := next("802.1q", "802.1ad"); strings.ToLower(p) {
+				case "802.1q":
+					v.VlanProtocol = netlink.VLAN_PROTOCOL_8021Q
+				case "802.1ad":
+					v.VlanProtocol = netlink.VLAN_PROTOCOL_8021AD
+				default:
+					return nil, fmt.Errorf("unknown VLAN protocol %q", p)
+				}
+			default:
+				return nil, usage()
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+		if v.VlanId < 0 || v.VlanId > 4094 {
+			return nil, fmt.Errorf("vlan needs an id between 0 and 4094")
+		}
+		return v, nil
+	case "bond":
+		b := netlink.NewLinkBond(attrs)
+		for more() {
+			switch next("mode") {
+			case "mode":
+				m := next("balance-rr", "active-backup", "balance-xor", "broadcast", "802.3ad", "balance-tlb", "balance-alb")
+				if b.Mode = netlink.StringToBondMode(m); b.Mode == netlink.BOND_MODE_UNKNOWN {
+					return nil, fmt.Errorf("unknown bond mode %q", m)
+				}
+			default:
+				return nil, usage()
+			}
+		}
+		return b, nil
+	case "veth":
+		v := &netlink.Veth{LinkAttrs: attrs}
+		for more() {
+			switch next("peer") {
+			case "peer":
+				if _, err := maybename(); err != nil {
+					return nil, err
+				}
+				v.PeerName = arg[cursor]
+			default:
+				return nil, usage()
+			}
+		}
+		if v.PeerName == "" {
+			return nil, fmt.Errorf("veth needs a peer name")
+		}
+		return v, nil
 	}
-	attrs := netlink.LinkAttrs{Name: name}
+	return nil, usage()
+}
 
-	cursor++
-	whatIWant = []string{"type"}
-	if arg[cursor] != "type" {
-		return usage()
+func linkadd() error {
+	var attrs netlink.LinkAttrs
+	// ip link add [link DEV] [name] NAME [mtu MTU] [address MAC] type TYPE [ARGS]
+	for {
+		switch a := next("link", "name", "mtu", "address", "type", "device name"); a {
+		case "link":
+			parent, err := netlink.LinkByName(next("device name"))
+			if err != nil {
+				return err
+			}
+			attrs.ParentIndex = parent.Attrs().Index
+		case "name":
+			attrs.Name = next("device name")
+		case "mtu":
+			mtu, err := number("MTU")
+			if err != nil {
+				return err
+			}
+			attrs.MTU = mtu
+		case "address":
+			mac, err := net.ParseMAC(next("MAC address"))
+			if err != nil {
+				return err
+			}
+			attrs.HardwareAddr = mac
+		case "type":
+			if attrs.Name == "" {
+				return fmt.Errorf("no name for the new device")
+			}
+			l, err := linkType(attrs)
+			if err != nil {
+				return err
+			}
+			return netlink.LinkAdd(l)
+		default:
+			attrs.Name = a
+		}
 	}
+}
 
-	cursor++
-	whatIWant = []string{"bridge"}
-	if arg[cursor] != "bridge" {
-		return usage()
+func linkdel() error {
+	iface, err := dev()
+	if err != nil {
+		return err
 	}
-	return netlink.LinkAdd(&netlink.Bridge{LinkAttrs: attrs})
+	return netlink.LinkDel(iface)
 }
 
 func link() error {
@@ -232,7 +415,7 @@ func link() error {
 	}
 
 	cursor++
-	whatIWant = []string{"show", "set", "add"}
+	whatIWant = []string{"show", "set", "add", "delete"}
 	cmd := arg[cursor]
 
 	switch one(cmd, whatIWant) {
@@ -242,118 +425,15 @@ func link() error {
 		return linkset()
 	case "add":
 		return linkadd()
-	}
-	return usage()
-}
-
-func routeshow() error {
-	return showRoutes(*inet6)
-}
-
-func nodespec() string {
-	cursor++
-	whatIWant = []string{"default", "CIDR"}
-	return arg[cursor]
-}
-
-func nexthop() (string, *netlink.Addr, error) {
-	cursor++
-	whatIWant = []string{"via"}
-	if arg[cursor] != "via" {
-		return "", nil, usage()
-	}
-	nh := arg[cursor]
-	cursor++
-	whatIWant = []string{"Gateway CIDR"}
-	addr, err := netlink.ParseAddr(arg[cursor])
-	if err != nil {
-		return "", nil, fmt.Errorf("failed to parse gateway CIDR: %v", err)
-	}
-	return nh, addr, nil
-}
-
-func routeadddefault() error {
-	nh, nhval, err := nexthop()
-	if err != nil {
-		return err
-	}
-	// TODO: NHFLAGS.
-	l, err := dev()
-	if err != nil {
-		return err
-	}
-	switch nh {
-	case "via":
-		log.Printf("Add default route %v via %v", nhval, l.Attrs().Name)
-		r := &netlink.Route{LinkIndex: l.Attrs().Index, Gw: nhval.IPNet.IP}
-		if err := netlink.RouteAdd(r); err != nil {
-			return fmt.Errorf("error adding default route to %v: %v", l.Attrs().Name, err)
-		}
-		return nil
-	}
-	return usage()
-}
-
-func routeadd() error {
-	ns := nodespec()
-	switch ns {
-	case "default":
-		return routeadddefault()
-	default:
-		addr, err := netlink.ParseAddr(arg[cursor])
-		if err != nil {
-			return usage()
-		}
-		d, err := dev()
-		if err != nil {
-			return usage()
-		}
-		r := &netlink.Route{LinkIndex: d.Attrs().Index, Dst: addr.IPNet}
-		if err := netlink.RouteAdd(r); err != nil {
-			return fmt.Errorf("error adding route %s -> %s: %v", addr, d.Attrs().Name, err)
-		}
-		return nil
-	}
-}
-
-func routedel() error {
-	cursor++
-	addr, err := netlink.ParseAddr(arg[cursor])
-	if err != nil {
-		return usage()
-	}
-	d, err := dev()
-	if err != nil {
-		return usage()
-	}
-	r := &netlink.Route{LinkIndex: d.Attrs().Index, Dst: addr.IPNet}
-	if err := netlink.RouteDel(r); err != nil {
-		return fmt.Errorf("error adding route %s -> %s: %v", addr, d.Attrs().Name, err)
-	}
-	return nil
-}
-
-func route() error {
-	cursor++
-	if len(arg[cursor:]) == 0 {
-		return routeshow()
-	}
-
-	whatIWant = []string{"show", "add", "del"}
-	switch one(arg[cursor], whatIWant) {
-	case "add":
-		return routeadd()
-	case "del":
-		return routedel()
-	case "show":
-		return routeshow()
+	case "delete":
+		return linkdel()
 	}
 	return usage()
 }
 
 func main() {
 	// When this is embedded in busybox we need to reinit some things.
-	whatIWant = []string{"addr", "route", "link", "neigh"}
+	whatIWant = []string{"addr", "route", "link", "neigh", "netns", "monitor"}
 	cursor = 0
 	flag.Parse()
 	arg = flag.Args()
@@ -385,6 +465,10 @@ func main() {
 		err = route()
 	case "neigh":
 		err = neigh()
+	case "netns":
+		err = netnsCmd()
+	case "monitor":
+		err = monitor()
 	default:
 		usage()
 	}
@@ -0,0 +1,184 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"strings"
+
+	"github.com/u-root/u-root/pkg/output"
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+// The JSON forms of links, addresses, routes and neighbours use the keys
+// of iproute2's ip -json.
+
+type jsonAddr struct {
+	Family            string `json:"family"`
+	Local             string `json:"local"`
+	PrefixLen         int    `json:"prefixlen"`
+	Broadcast         string `json:"broadcast,omitempty"`
+	Scope             string `json:"scope"`
+	Label             string `json:"label,omitempty"`
+	ValidLifeTime     uint32 `json:"valid_life_time"`
+	PreferredLifeTime uint32 `json:"preferred_life_time"`
+}
+
+type jsonLink struct {
+	Ifindex   int        `json:"ifindex"`
+	Ifname    string     `json:"ifname"`
+	Flags     []string   `json:"flags"`
+	MTU       int        `json:"mtu"`
+	Master    string     `json:"master,omitempty"`
+	OperState string     `json:"operstate"`
+	LinkType  string     `json:"link_type"`
+	Address   string     `json:"address,omitempty"`
+	AddrInfo  []jsonAddr `json:"addr_info,omitempty"`
+}
+
+type jsonRoute struct {
+	Dst      string `json:"dst"`
+	Gateway  string `json:"gateway,omitempty"`
+	Dev      string `json:"dev"`
+	Protocol string `json:"protocol"`
+	Scope    string `json:"scope"`
+	Src      string `json:"prefsrc,omitempty"`
+	Metric   int    `json:"metric"`
+	Table    string `json:"table,omitempty"`
+}
+
+type jsonNeigh struct {
+	Dst    string   `json:"dst"`
+	Dev    string   `json:"dev"`
+	Lladdr string   `json:"lladdr,omitempty"`
+	Router bool     `json:"router,omitempty"`
+	State  []string `json:"state"`
+}
+
+// linkFlags returns the flags of l as ip prints them.
+func linkFlags(l *netlink.LinkAttrs) []string {
+	var flags []string
+	for _, f := range strings.Split(l.Flags.String(), "|") {
+		if f != "" && f != "0" {
+			flags = append(flags, strings.ToUpper(f))
+		}
+	}
+	// The carrier is what flaps when a cable is pulled.
+	if l.RawFlags&unix.IFF_LOWER_UP != 0 {
+		flags = append(flags, "LOWER_UP")
+	} else if l.Flags&net.FlagUp != 0 {
+		flags = append(flags, "NO-CARRIER")
+	}
+	return flags
+}
+
+func jsonAddrs(link netlink.Link) ([]jsonAddr, error) {
+	addrs, err := netlink.AddrList(link, netlink.FAMILY_ALL)
+	if err != nil {
+		return nil, fmt.Errorf("can't enumerate addresses: %v", err)
+	}
+	var ja []jsonAddr
+	for _, a := range addrs {
+		ones, _ := a.Mask.Size()
+		j := jsonAddr{
+			Family:    "inet",
+			Local:     a.IP.String(),
+			PrefixLen: ones,
+			Scope:     addrScopes[netlink.Scope(a.Scope)],
+			Label:     a.Label,
+			// Forever is MaxUint32, like in iproute2.
+			ValidLifeTime:     uint32(a.ValidLft),
+			PreferredLifeTime: uint32(a.PreferedLft),
+		}
+		if a.IP.To4() == nil {
+			j.Family = "inet6"
+		}
+		if a.Broadcast != nil {
+			j.Broadcast = a.Broadcast.String()
+		}
+		ja = append(ja, j)
+	}
+	return ja, nil
+}
+
+func printJSONLinks(w io.Writer, withAddresses bool, ifaces []netlink.Link) error {
+	ifaces, err := listLinks(ifaces)
+	if err != nil {
+		return err
+	}
+	links := []jsonLink{}
+	for _, v := range ifaces {
+		l := v.Attrs()
+		j := jsonLink{
+			Ifindex:   l.Index,
+			Ifname:    l.Name,
+			Flags:     linkFlags(l),
+			MTU:       l.MTU,
+			OperState: strings.ToUpper(l.OperState.String()),
+			LinkType:  l.EncapType,
+			Address:   l.HardwareAddr.String(),
+		}
+		if l.MasterIndex != 0 {
+			m, err := netlink.LinkByIndex(l.MasterIndex)
+			if err != nil {
+				return fmt.Errorf("can't get link with index %d: %v", l.MasterIndex, err)
+			}
+			j.Master = m.Attrs().Name
+		}
+		if withAddresses {
+			if j.AddrInfo, err = jsonAddrs(v); err != nil {
+				return err
+			}
+		}
+		links = append(links, j)
+	}
+	return output.JSON(w, links)
+}
+
+func printJSONRoutes(w io.Writer, routes []netlink.Route) error {
+	jr := []jsonRoute{}
+	for _, r := range routes {
+		j := jsonRoute{
+			Dst:      "default",
+			Dev:      linkName(r.LinkIndex),
+			Protocol: rtProto[r.Protocol],
+			Scope:    addrScopes[r.Scope],
+			Metric:   r.Priority,
+		}
+		if r.Dst != nil {
+			j.Dst = r.Dst.String()
+		}
+		if r.Gw != nil {
+			j.Gateway = r.Gw.String()
+		}
+		if r.Src != nil {
+			j.Src = r.Src.String()
+		}
+		if r.Table != unix.RT_TABLE_MAIN {
+			j.Table = routeTables[r.Table]
+			if j.Table == "" {
+				j.Table = fmt.Sprint(r.Table)
+			}
+		}
+		jr = append(jr, j)
+	}
+	return output.JSON(w, jr)
+}
+
+func jsonNeighbour(n netlink.Neigh, dev string) jsonNeigh {
+	j := jsonNeigh{
+		Dst:    n.IP.String(),
+		Dev:    dev,
+		Router: n.Flags&netlink.NTF_ROUTER != 0,
+		State:  strings.Split(getState(n.State), ","),
+	}
+	if n.HardwareAddr != nil {
+		j.Lladdr = n.HardwareAddr.String()
+	}
+	return j
+}
@@ -0,0 +1,333 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+// event is a netlink notification, flattened for printing. Only the
+// fields of the event's object are set.
+type event struct {
+	Time    time.Time `json:"time"`
+	Object  string    `json:"object"`
+	Deleted bool      `json:"deleted,omitempty"`
+
+	Ifindex int    `json:"ifindex,omitempty"`
+	Ifname  string `json:"ifname,omitempty"`
+
+	// link
+	Flags     []string `json:"flags,omitempty"`
+	MTU       int      `json:"mtu,omitempty"`
+	Master    string   `json:"master,omitempty"`
+	OperState string   `json:"operstate,omitempty"`
+	LinkType  string   `json:"link_type,omitempty"`
+	Address   string   `json:"address,omitempty"`
+
+	// address
+	Family string `json:"family,omitempty"`
+	Local  string `json:"local,omitempty"`
+	Scope  string `json:"scope,omitempty"`
+
+	// route
+	Dst      string `json:"dst,omitempty"`
+	Gateway  string `json:"gateway,omitempty"`
+	Src      string `json:"prefsrc,omitempty"`
+	Protocol string `json:"protocol,omitempty"`
+	Metric   int    `json:"metric,omitempty"`
+	Table    int    `json:"table,omitempty"`
+
+	// neigh
+	IP     string   `json:"dst_ip,omitempty"`
+	Lladdr string   `json:"lladdr,omitempty"`
+	State  []string `json:"state,omitempty"`
+	Router bool     `json:"router,omitempty"`
+}
+
+var monitorObjects = []string{"all", "link", "address", "route", "neigh"}
+
+// monitor parses 'ip monitor [all|link|address|route|neigh]...' and prints
+// events until interrupted.
+func monitor() error {
+	objs := map[string]bool{}
+	for cursor++; cursor < len(arg); cursor++ {
+		whatIWant = monitorObjects
+		switch o := one(arg[cursor], whatIWant); o {
+		case "":
+			return usage()
+		case "all":
+			for _, o := range monitorObjects[1:] {
+				objs[o] = true
+			}
+		default:
+			objs[o] = true
+		}
+	}
+	if len(objs) == 0 {
+		for _, o := range monitorObjects[1:] {
+			objs[o] = true
+		}
+	}
+	return watch(os.Stdout, objs, *jsonOut, nil)
+}
+
+// watch subscribes to the netlink groups of objs and prints events to w
+// until done is closed or a subscription fails.
+func watch(w io.Writer, objs map[string]bool, asJSON bool, done <-chan struct{}) error {
+	quit := make(chan struct{})
+	defer close(quit)
+	errc := make(chan error, 4)
+	cberr := func(err error) {
+		select {
+		case errc <- err:
+		default:
+		}
+	}
+
+	var (
+		links  chan netlink.LinkUpdate
+		addrs  chan netlink.AddrUpdate
+		routes chan netlink.RouteUpdate
+		neighs chan netlink.NeighUpdate
+	)
+	if objs["link"] {
+		links = make(chan netlink.LinkUpdate)
+		if err := netlink.LinkSubscribeWithOptions(links, quit, netlink.LinkSubscribeOptions{ErrorCallback: cberr}); err != nil {
+			return fmt.Errorf("can't subscribe to link events: %v", err)
+		}
+	}
+	if objs["address"] {
+		addrs = make(chan netlink.AddrUpdate)
+		if err := netlink.AddrSubscribeWithOptions(addrs, quit, netlink.AddrSubscribeOptions{ErrorCallback: cberr}); err != nil {
+			return fmt.Errorf("can't subscribe to address events: %v", err)
+		}
+	}
+	if objs["route"] {
+		routes = make(chan netlink.RouteUpdate)
+		if err := netlink.RouteSubscribeWithOptions(routes, quit, netlink.RouteSubscribeOptions{ErrorCallback: cberr}); err != nil {
+			return fmt.Errorf("can't subscribe to route events: %v", err)
+		}
+	}
+	if objs["neigh"] {
+		neighs = make(chan netlink.NeighUpdate)
+		if err := netlink.NeighSubscribeWithOptions(neighs, quit, netlink.NeighSubscribeOptions{ErrorCallback: cberr}); err != nil {
+			return fmt.Errorf("can't subscribe to neighbour events: %v", err)
+		}
+	}
+
+	// Events carry interface indexes. Deleted links can no longer be
+	// looked up, so remember the names we have seen.
+	names := map[int]string{}
+	name := func(index int) string {
+		if n, ok := names[index]; ok {
+			return n
+		}
+		l, err := netlink.LinkByIndex(index)
+		if err != nil {
+			return fmt.Sprintf("if%d", index)
+		}
+		names[index] = l.Attrs().Name
+		return names[index]
+	}
+	enc := json.NewEncoder(w)
+	label := len(objs) > 1
+
+	for {
+		var e *event
+		var ok bool
+		select {
+		case <-done:
+			return nil
+		case err := <-errc:
+			return fmt.Errorf("monitor: %v", err)
+		case u, more := <-links:
+			if ok = more; ok {
+				names[u.Attrs().Index] = u.Attrs().Name
+				e = linkEvent(u, name)
+			}
+		case u, more := <-addrs:
+			if ok = more; ok {
+				e = addrEvent(u, name)
+			}
+		case u, more := <-routes:
+			if ok = more; ok {
+				e = routeEvent(u, name)
+			}
+		case u, more := <-neighs:
+			if ok = more; ok {
+				e = neighEvent(u, name)
+			}
+		}
+		if !ok {
+			select {
+			case err := <-errc:
+				return fmt.Errorf("monitor: %v", err)
+			default:
+				return fmt.Errorf("monitor: netlink subscription closed")
+			}
+		}
+		var err error
+		if asJSON {
+			err = enc.Encode(e)
+		} else {
+			err = printEvent(w, e, label)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func linkEvent(u netlink.LinkUpdate, name func(int) string) *event {
+	l := u.Attrs()
+	e := &event{
+		Time:      time.Now(),
+		Object:    "link",
+		Deleted:   u.Header.Type == unix.RTM_DELLINK,
+		Ifindex:   l.Index,
+		Ifname:    l.Name,
+		MTU:       l.MTU,
+		OperState: strings.ToUpper(l.OperState.String()),
+		LinkType:  l.EncapType,
+		Address:   l.HardwareAddr.String(),
+		Flags:     linkFlags(l),
+	}
+	if l.MasterIndex != 0 {
+		e.Master = name(l.MasterIndex)
+	}
+	return e
+}
+
+func addrEvent(u netlink.AddrUpdate, name func(int) string) *event {
+	e := &event{
+		Time:    time.Now(),
+		Object:  "address",
+		Deleted: !u.NewAddr,
+		Ifindex: u.LinkIndex,
+		Ifname:  name(u.LinkIndex),
+		Family:  "inet",
+		Local:   u.LinkAddress.String(),
+		Scope:   addrScopes[netlink.Scope(u.Scope)],
+	}
+	if u.LinkAddress.IP.To4() == nil {
+		e.Family = "inet6"
+	}
+	return e
+}
+
+func routeEvent(u netlink.RouteUpdate, name func(int) string) *event {
+	e := &event{
+		Time:     time.Now(),
+		Object:   "route",
+		Deleted:  u.Type == unix.RTM_DELROUTE,
+		Dst:      "default",
+		Protocol: rtProto[u.Protocol],
+		Metric:   u.Priority,
+	}
+	if u.LinkIndex != 0 {
+		e.Ifindex, e.Ifname = u.LinkIndex, name(u.LinkIndex)
+	}
+	if u.Dst != nil {
+		e.Dst = u.Dst.String()
+	}
+	if u.Gw != nil {
+		e.Gateway = u.Gw.String()
+	}
+	if u.Src != nil {
+		e.Src = u.Src.String()
+	}
+	if u.Table != unix.RT_TABLE_MAIN {
+		e.Table = u.Table
+	}
+	return e
+}
+
+func neighEvent(u netlink.NeighUpdate, name func(int) string) *event {
+	e := &event{
+		Time:    time.Now(),
+		Object:  "neigh",
+		Deleted: u.Type == unix.RTM_DELNEIGH,
+		Ifindex: u.LinkIndex,
+		Ifname:  name(u.LinkIndex),
+		Router:  u.Flags&netlink.NTF_ROUTER != 0,
+	}
+	if u.HardwareAddr != nil {
+		e.Lladdr = u.HardwareAddr.String()
+	}
+	if u.IP != nil {
+		e.IP = u.IP.String()
+	}
+	if !e.Deleted {
+		e.State = strings.Split(getState(u.State), ",")
+	}
+	return e
+}
+
+// printEvent prints e in the style of 'ip monitor', prefixed with the
+// object type if label is set.
+func printEvent(w io.Writer, e *event, label bool) error {
+	var b strings.Builder
+	if label {
+		fmt.Fprintf(&b, "[%s] ", strings.ToUpper(e.Object))
+	}
+	if e.Deleted {
+		b.WriteString("Deleted ")
+	}
+	switch e.Object {
+	case "link":
+		fmt.Fprintf(&b, "%d: %s: <%s> mtu %d ", e.Ifindex, e.Ifname, strings.Join(e.Flags, ","), e.MTU)
+		if e.Master != "" {
+			fmt.Fprintf(&b, "master %s ", e.Master)
+		}
+		fmt.Fprintf(&b, "state %s link/%s %s", e.OperState, e.LinkType, e.Address)
+	case "address":
+		fmt.Fprintf(&b, "%d: %s    %s %s scope %s", e.Ifindex, e.Ifname, e.Family, e.Local, e.Scope)
+	case "route":
+		b.WriteString(e.Dst)
+		if e.Gateway != "" {
+			fmt.Fprintf(&b, " via %s", e.Gateway)
+		}
+		if e.Ifname != "" {
+			fmt.Fprintf(&b, " dev %s", e.Ifname)
+		}
+		if e.Table != 0 {
+			fmt.Fprintf(&b, " table %d", e.Table)
+		}
+		if e.Protocol != "" {
+			fmt.Fprintf(&b, " proto %s", e.Protocol)
+		}
+		if e.Src != "" {
+			fmt.Fprintf(&b, " src %s", e.Src)
+		}
+		if e.Metric != 0 {
+			fmt.Fprintf(&b, " metric %d", e.Metric)
+		}
+	case "neigh":
+		if e.IP != "" {
+			fmt.Fprintf(&b, "%s ", e.IP)
+		}
+		fmt.Fprintf(&b, "dev %s", e.Ifname)
+		if e.Lladdr != "" {
+			fmt.Fprintf(&b, " lladdr %s", e.Lladdr)
+		}
+		if e.Router {
+			b.WriteString(" router")
+		}
+		if len(e.State) != 0 {
+			fmt.Fprintf(&b, " %s", strings.Join(e.State, ","))
+		}
+	}
+	b.WriteByte('\n')
+	_, err := io.WriteString(w, b.String())
+	return err
+}
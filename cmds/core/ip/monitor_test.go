@@ -0,0 +1,137 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+func TestMonitorEvents(t *testing.T) {
+	names := map[int]string{1: "lo", 2: "eth0", 3: "br0"}
+	name := func(index int) string { return names[index] }
+	mac, _ := net.ParseMAC("52:54:00:12:34:56")
+	_, dst, _ := net.ParseCIDR("10.0.2.0/24")
+	v6 := net.IPNet{IP: net.ParseIP("fe80::5054:ff:fe12:3456"), Mask: net.CIDRMask(64, 128)}
+	v4 := net.IPNet{IP: net.ParseIP("10.0.2.15"), Mask: net.CIDRMask(24, 32)}
+
+	for _, tt := range []struct {
+		name  string
+		e     *event
+		label bool
+		want  string
+	}{
+		{
+			name: "new link",
+			e: linkEvent(netlink.LinkUpdate{
+				Header: unix.NlMsghdr{Type: unix.RTM_NEWLINK},
+				Link: &netlink.Device{LinkAttrs: netlink.LinkAttrs{
+					Index: 2, Name: "eth0", MTU: 1500, MasterIndex: 3,
+					Flags:     net.FlagUp | net.FlagBroadcast | net.FlagMulticast,
+					RawFlags:  unix.IFF_UP | unix.IFF_BROADCAST | unix.IFF_MULTICAST | unix.IFF_LOWER_UP,
+					OperState: netlink.OperUp, EncapType: "ether", HardwareAddr: mac,
+				}},
+			}, name),
+			want: "2: eth0: <UP,BROADCAST,MULTICAST,LOWER_UP> mtu 1500 master br0 state UP link/ether 52:54:00:12:34:56\n",
+		},
+		{
+			name: "link lost its carrier",
+			e: linkEvent(netlink.LinkUpdate{
+				Header: unix.NlMsghdr{Type: unix.RTM_NEWLINK},
+				Link: &netlink.Device{LinkAttrs: netlink.LinkAttrs{
+					Index: 2, Name: "eth0", MTU: 1500,
+					Flags:     net.FlagUp,
+					RawFlags:  unix.IFF_UP,
+					OperState: netlink.OperDown, EncapType: "ether", HardwareAddr: mac,
+				}},
+			}, name),
+			label: true,
+			want:  "[LINK] 2: eth0: <UP,NO-CARRIER> mtu 1500 state DOWN link/ether 52:54:00:12:34:56\n",
+		},
+		{
+			name: "deleted link",
+			e: linkEvent(netlink.LinkUpdate{
+				Header: unix.NlMsghdr{Type: unix.RTM_DELLINK},
+				Link: &netlink.Device{LinkAttrs: netlink.LinkAttrs{
+					Index: 4, Name: "tap0", MTU: 1500, EncapType: "ether", HardwareAddr: mac,
+				}},
+			}, name),
+			want: "Deleted 4: tap0: <> mtu 1500 state UNKNOWN link/ether 52:54:00:12:34:56\n",
+		},
+		{
+			name:  "new address",
+			e:     addrEvent(netlink.AddrUpdate{LinkAddress: v4, LinkIndex: 2, NewAddr: true}, name),
+			label: true,
+			want:  "[ADDRESS] 2: eth0    inet 10.0.2.15/24 scope global\n",
+		},
+		{
+			name: "deleted link-local address",
+			e:    addrEvent(netlink.AddrUpdate{LinkAddress: v6, LinkIndex: 2, Scope: int(netlink.SCOPE_LINK)}, name),
+			want: "Deleted 2: eth0    inet6 fe80::5054:ff:fe12:3456/64 scope link\n",
+		},
+		{
+			name: "default route",
+			e: routeEvent(netlink.RouteUpdate{Type: unix.RTM_NEWROUTE, Route: netlink.Route{
+				LinkIndex: 2, Gw: net.ParseIP("10.0.2.2"), Protocol: unix.RTPROT_DHCP, Priority: 100, Table: unix.RT_TABLE_MAIN,
+			}}, name),
+			want: "default via 10.0.2.2 dev eth0 proto dhcp metric 100\n",
+		},
+		{
+			name: "deleted local route",
+			e: routeEvent(netlink.RouteUpdate{Type: unix.RTM_DELROUTE, Route: netlink.Route{
+				LinkIndex: 2, Dst: dst, Src: net.ParseIP("10.0.2.15"), Protocol: unix.RTPROT_KERNEL, Table: unix.RT_TABLE_LOCAL,
+			}}, name),
+			label: true,
+			want:  "[ROUTE] Deleted 10.0.2.0/24 dev eth0 table 255 proto kernel src 10.0.2.15\n",
+		},
+		{
+			name: "new neighbour",
+			e: neighEvent(netlink.NeighUpdate{Type: unix.RTM_NEWNEIGH, Neigh: netlink.Neigh{
+				LinkIndex: 2, IP: net.ParseIP("10.0.2.2"), HardwareAddr: mac, State: netlink.NUD_REACHABLE, Flags: netlink.NTF_ROUTER,
+			}}, name),
+			want: "10.0.2.2 dev eth0 lladdr 52:54:00:12:34:56 router REACHABLE\n",
+		},
+		{
+			name: "deleted neighbour",
+			e: neighEvent(netlink.NeighUpdate{Type: unix.RTM_DELNEIGH, Neigh: netlink.Neigh{
+				LinkIndex: 2, IP: net.ParseIP("10.0.2.3"), State: netlink.NUD_FAILED,
+			}}, name),
+			label: true,
+			want:  "[NEIGH] Deleted 10.0.2.3 dev eth0\n",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var b bytes.Buffer
+			if err := printEvent(&b, tt.e, tt.label); err != nil {
+				t.Fatal(err)
+			}
+			if got := b.String(); got != tt.want {
+				t.Errorf("printEvent() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMonitorJSON(t *testing.T) {
+	e := neighEvent(netlink.NeighUpdate{Type: unix.RTM_NEWNEIGH, Neigh: netlink.Neigh{
+		LinkIndex: 2, IP: net.ParseIP("10.0.2.2"), State: netlink.NUD_STALE,
+	}}, func(int) string { return "eth0" })
+	e.Time = time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+	var b strings.Builder
+	if err := json.NewEncoder(&b).Encode(e); err != nil {
+		t.Fatal(err)
+	}
+	want := `{"time":"2021-03-01T12:00:00Z","object":"neigh","ifindex":2,"ifname":"eth0","dst_ip":"10.0.2.2","state":["STALE"]}` + "\n"
+	if got := b.String(); got != want {
+		t.Errorf("json = %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strings"
+
+	"github.com/vishvananda/netlink"
+)
+
+// parseState parses a state name of neighStates, e.g. permanent.
+func parseState(s string) (int, error) {
+	for st, name := range neighStates {
+		if strings.EqualFold(name, s) {
+			return st, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown neighbour state %q", s)
+}
+
+// parseNeigh parses the rest of the arguments as a neighbour:
+// ADDR [lladdr MAC] dev DEV [nud STATE] [router]
+func parseNeigh() (*netlink.Neigh, error) {
+	s := next("address")
+	ip := net.ParseIP(s)
+	if ip == nil {
+		return nil, fmt.Errorf("invalid address %q", s)
+	}
+	n := &netlink.Neigh{IP: ip, State: netlink.NUD_PERMANENT, Family: netlink.FAMILY_V4}
+	if ip.To4() == nil {
+		n.Family = netlink.FAMILY_V6
+	}
+	for more() {
+		var err error
+		switch next("lladdr", "dev", "nud", "router") {
+		case "lladdr":
+			n.HardwareAddr, err = net.ParseMAC(next("MAC address"))
+		case "dev":
+			var l netlink.Link
+			if l, err = netlink.LinkByName(next("device name")); err == nil {
+				n.LinkIndex = l.Attrs().Index
+			}
+		case "nud":
+			n.State, err = parseState(next("permanent", "noarp", "reachable", "stale"))
+		case "router":
+			n.Flags |= netlink.NTF_ROUTER
+		default:
+			return nil, usage()
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if n.LinkIndex == 0 {
+		return nil, fmt.Errorf("neighbour %s needs a device", ip)
+	}
+	return n, nil
+}
+
+// neighDev returns the index of the device of dev DEV, or 0 if there are
+// no arguments left.
+func neighDev() (int, error) {
+	if !more() {
+		return 0, nil
+	}
+	l, err := dev()
+	if err != nil {
+		return 0, err
+	}
+	return l.Attrs().Index, nil
+}
+
+func neighshow() error {
+	index, err := neighDev()
+	if err != nil {
+		return err
+	}
+	return showNeighbours(os.Stdout, index)
+}
+
+// neighflush deletes the neighbours of a device which the kernel learned,
+// i.e. which are neither permanent nor noarp.
+func neighflush() error {
+	index, err := neighDev()
+	if err != nil {
+		return err
+	}
+	if index == 0 {
+		return fmt.Errorf("flush needs a device")
+	}
+	neighs, err := netlink.NeighList(index, 0)
+	if err != nil {
+		return fmt.Errorf("can't list neighbours: %v", err)
+	}
+	for _, n := range neighs {
+		if n.State&(netlink.NUD_PERMANENT|netlink.NUD_NOARP) != 0 {
+			continue
+		}
+		if err := netlink.NeighDel(&n); err != nil {
+			return fmt.Errorf("error deleting neighbour %s: %v", n.IP, err)
+		}
+	}
+	return nil
+}
+
+func neigh() error {
+	cursor++
+	if len(arg[cursor:]) == 0 {
+		cursor--
+		return neighshow()
+	}
+
+	whatIWant = []string{"show", "list", "add", "del", "replace", "change", "flush"}
+	switch c := one(arg[cursor], whatIWant); c {
+	case "show", "list":
+		return neighshow()
+	case "flush":
+		return neighflush()
+	case "add", "del", "replace", "change":
+		n, err := parseNeigh()
+		if err != nil {
+			return err
+		}
+		switch c {
+		case "add":
+			err = netlink.NeighAdd(n)
+		case "del":
+			err = netlink.NeighDel(n)
+		default:
+			err = netlink.NeighSet(n)
+		}
+		if err != nil {
+			return fmt.Errorf("neigh %s %s: %v", c, n.IP, err)
+		}
+		return nil
+	}
+	return usage()
+}
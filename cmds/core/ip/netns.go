@@ -0,0 +1,91 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"runtime"
+
+	"github.com/vishvananda/netns"
+	"golang.org/x/sys/unix"
+)
+
+// netnsDir is where named network namespaces are bind mounted, as by
+// iproute2.
+const netnsDir = "/var/run/netns"
+
+func netnslist() error {
+	fis, err := ioutil.ReadDir(netnsDir)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, fi := range fis {
+		fmt.Println(fi.Name())
+	}
+	return nil
+}
+
+// netnsadd creates a named namespace. Creating it moves the thread into
+// it, so the thread is moved back.
+func netnsadd(name string) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	orig, err := netns.Get()
+	if err != nil {
+		return err
+	}
+	defer orig.Close()
+	ns, err := netns.NewNamed(name)
+	if err != nil {
+		return fmt.Errorf("can't create namespace %s: %v", name, err)
+	}
+	ns.Close()
+	return netns.Set(orig)
+}
+
+// netnsexec runs a command in a named namespace. It replaces ip, so the
+// locked thread is never moved back.
+func netnsexec(name string, args []string) error {
+	runtime.LockOSThread()
+	ns, err := netns.GetFromName(name)
+	if err != nil {
+		return fmt.Errorf("can't open namespace %s: %v", name, err)
+	}
+	if err := netns.Set(ns); err != nil {
+		return fmt.Errorf("can't enter namespace %s: %v", name, err)
+	}
+	p, err := exec.LookPath(args[0])
+	if err != nil {
+		return err
+	}
+	return unix.Exec(p, args, os.Environ())
+}
+
+func netnsCmd() error {
+	cursor++
+	if len(arg[cursor:]) == 0 {
+		return netnslist()
+	}
+
+	whatIWant = []string{"list", "add", "delete", "exec"}
+	switch one(arg[cursor], whatIWant) {
+	case "list":
+		return netnslist()
+	case "add":
+		return netnsadd(next("namespace name"))
+	case "delete":
+		return netns.DeleteNamed(next("namespace name"))
+	case "exec":
+		name := next("namespace name")
+		next("command")
+		return netnsexec(name, arg[cursor:])
+	}
+	return usage()
+}
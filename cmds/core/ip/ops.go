@@ -9,16 +9,34 @@ import (
 	"io"
 	"math"
 	"net"
+	"strconv"
 	"strings"
 
+	"github.com/u-root/u-root/pkg/output"
 	"github.com/vishvananda/netlink"
 	"golang.org/x/sys/unix"
 )
 
-func showLinks(w io.Writer, withAddresses bool) error {
+// listLinks returns ifaces, or all links if there are none.
+func listLinks(ifaces []netlink.Link) ([]netlink.Link, error) {
+	if len(ifaces) != 0 {
+		return ifaces, nil
+	}
 	ifaces, err := netlink.LinkList()
 	if err != nil {
-		return fmt.Errorf("can't enumerate interfaces: %v", err)
+		return nil, fmt.Errorf("can't enumerate interfaces: %v", err)
+	}
+	return ifaces, nil
+}
+
+// showLinks shows ifaces, or all links if there are none.
+func showLinks(w io.Writer, withAddresses bool, ifaces ...netlink.Link) error {
+	if *jsonOut {
+		return printJSONLinks(w, withAddresses, ifaces)
+	}
+	ifaces, err := listLinks(ifaces)
+	if err != nil {
+		return err
 	}
 
 	for _, v := range ifaces {
@@ -111,12 +129,18 @@ func getState(state int) string {
 	return strings.Join(ret, ",")
 }
 
-func showNeighbours(w io.Writer, withAddresses bool) error {
+// showNeighbours shows the neighbours on the interface with index, or on
+// all interfaces if it is 0.
+func showNeighbours(w io.Writer, index int) error {
 	ifaces, err := net.Interfaces()
 	if err != nil {
 		return err
 	}
+	jn := []jsonNeigh{}
 	for _, iface := range ifaces {
+		if index != 0 && iface.Index != index {
+			continue
+		}
 		neighs, err := netlink.NeighList(iface.Index, 0)
 		if err != nil {
 			return fmt.Errorf("can't list neighbours: %v", err)
@@ -126,6 +150,10 @@ func showNeighbours(w io.Writer, withAddresses bool) error {
 			if v.State&netlink.NUD_NOARP != 0 {
 				continue
 			}
+			if *jsonOut {
+				jn = append(jn, jsonNeighbour(v, iface.Name))
+				continue
+			}
 			entry := fmt.Sprintf("%s dev %s", v.IP.String(), iface.Name)
 			if v.HardwareAddr != nil {
 				entry += fmt.Sprintf(" lladdr %s", v.HardwareAddr)
@@ -134,19 +162,15 @@ func showNeighbours(w io.Writer, withAddresses bool) error {
 				entry += " router"
 			}
 			entry += " " + getState(v.State)
-			fmt.Println(entry)
+			fmt.Fprintln(w, entry)
 		}
 	}
+	if *jsonOut {
+		return output.JSON(w, jn)
+	}
 	return nil
 }
 
-const (
-	defaultFmt   = "default via %v dev %s proto %s metric %d\n"
-	routeFmt     = "%v dev %s proto %s scope %s src %s metric %d\n"
-	route6Fmt    = "%s dev %s proto %s metric %d\n"
-	routeVia6Fmt = "%s via %s dev %s proto %s metric %d\n"
-)
-
 // routing protocol identifier
 // specified in Linux Kernel header: include/uapi/linux/rtnetlink.h
 // See man IP-ROUTE(8) and RTNETLINK(7)
@@ -174,56 +198,89 @@ var rtProto = map[int]string{
 	unix.RTPROT_ZEBRA:    "zebra",
 }
 
-func showRoutes(inet6 bool) error {
-	var f int
-	if inet6 {
-		f = netlink.FAMILY_V6
-	} else {
-		f = netlink.FAMILY_V4
+// routeTables are the names of the routing tables, as in
+// /etc/iproute2/rt_tables.
+var routeTables = map[int]string{
+	unix.RT_TABLE_DEFAULT: "default",
+	unix.RT_TABLE_MAIN:    "main",
+	unix.RT_TABLE_LOCAL:   "local",
+}
+
+// linkName returns the name of the link with index, or "" if there is
+// none, e.g. for unreachable routes.
+func linkName(index int) string {
+	if index == 0 {
+		return ""
+	}
+	l, err := netlink.LinkByIndex(index)
+	if err != nil {
+		return ""
 	}
+	return l.Attrs().Name
+}
 
-	routes, err := netlink.RouteList(nil, f)
+// showRoutes shows the routes of family f which match filter, for the
+// RT_FILTER_* fields of mask, and its metric if it is not 0. Without
+// RT_FILTER_TABLE, those of the main table are shown.
+func showRoutes(w io.Writer, f int, filter *netlink.Route, mask uint64) error {
+	all, err := netlink.RouteListFiltered(f, filter, mask)
 	if err != nil {
 		return err
 	}
-	for _, route := range routes {
-		link, err := netlink.LinkByIndex(route.LinkIndex)
-		if err != nil {
-			return err
-		}
-		if route.Dst == nil {
-			defaultRoute(route, link)
-		} else {
-			showRoute(route, link, f)
+	var routes []netlink.Route
+	for _, r := range all {
+		if filter.Priority == 0 || r.Priority == filter.Priority {
+			routes = append(routes, r)
 		}
 	}
+	if *jsonOut {
+		return printJSONRoutes(w, routes)
+	}
+	for _, r := range routes {
+		fmt.Fprintln(w, routeString(r))
+	}
 	return nil
 }
 
-func defaultRoute(r netlink.Route, l netlink.Link) {
-	gw := r.Gw
-	name := l.Attrs().Name
-	proto := rtProto[r.Protocol]
-	metric := r.Priority
-	fmt.Printf(defaultFmt, gw, name, proto, metric)
-}
-
-func showRoute(r netlink.Route, l netlink.Link, f int) {
-	dest := r.Dst
-	name := l.Attrs().Name
-	proto := rtProto[r.Protocol]
-	metric := r.Priority
-	switch f {
-	case netlink.FAMILY_V4:
-		scope := addrScopes[r.Scope]
-		src := r.Src
-		fmt.Printf(routeFmt, dest, name, proto, scope, src, metric)
-	case netlink.FAMILY_V6:
-		if r.Gw != nil {
-			gw := r.Gw
-			fmt.Printf(routeVia6Fmt, dest, gw, name, proto, metric)
-		} else {
-			fmt.Printf(route6Fmt, dest, name, proto, metric)
+// routeString formats a route as ip route show does, e.g.
+// default via 10.0.2.2 dev eth0 proto dhcp metric 100.
+func routeString(r netlink.Route) string {
+	s := []string{"default"}
+	if r.Dst != nil {
+		s[0] = r.Dst.String()
+		// Host routes are shown as addresses.
+		if ones, bits := r.Dst.Mask.Size(); ones == bits {
+			s[0] = r.Dst.IP.String()
 		}
 	}
+	if r.Gw != nil {
+		s = append(s, "via", r.Gw.String())
+	}
+	if name := linkName(r.LinkIndex); name != "" {
+		s = append(s, "dev", name)
+	}
+	if r.Table != unix.RT_TABLE_MAIN && r.Table != unix.RT_TABLE_UNSPEC {
+		t, ok := routeTables[r.Table]
+		if !ok {
+			t = strconv.Itoa(r.Table)
+		}
+		s = append(s, "table", t)
+	}
+	// Like iproute2, the protocol of routes added by ip is not shown.
+	if p, ok := rtProto[r.Protocol]; ok && r.Protocol != unix.RTPROT_BOOT && r.Protocol != unix.RTPROT_UNSPEC {
+		s = append(s, "proto", p)
+	}
+	if r.Scope != netlink.SCOPE_UNIVERSE {
+		s = append(s, "scope", addrScopes[r.Scope])
+	}
+	if r.Src != nil {
+		s = append(s, "src", r.Src.String())
+	}
+	if r.Priority != 0 {
+		s = append(s, "metric", strconv.Itoa(r.Priority))
+	}
+	if r.MTU != 0 {
+		s = append(s, "mtu", strconv.Itoa(r.MTU))
+	}
+	return strings.Join(s, " ")
 }
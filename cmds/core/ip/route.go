@@ -0,0 +1,240 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+// family returns the address family of -6.
+func family() int {
+	if *inet6 {
+		return netlink.FAMILY_V6
+	}
+	return netlink.FAMILY_V4
+}
+
+// parseIP parses an address or a prefix, e.g. a gateway given as 10.0.2.2/24.
+func parseIP(s string) (net.IP, error) {
+	if ip := net.ParseIP(s); ip != nil {
+		return ip, nil
+	}
+	ip, _, err := net.ParseCIDR(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address %q", s)
+	}
+	return ip, nil
+}
+
+// parsePrefix parses a destination: default, a prefix or an address,
+// which is a host route.
+func parsePrefix(s string) (*net.IPNet, error) {
+	if s == "default" {
+		return nil, nil
+	}
+	if ip := net.ParseIP(s); ip != nil {
+		bits := 8 * net.IPv6len
+		if ip4 := ip.To4(); ip4 != nil {
+			ip, bits = ip4, 8*net.IPv4len
+		}
+		return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, nil
+	}
+	_, n, err := net.ParseCIDR(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid prefix %q", s)
+	}
+	return n, nil
+}
+
+// parseTable parses a table name of routeTables or number. all is
+// RT_TABLE_UNSPEC, which only filters understand.
+func parseTable(s string) (int, error) {
+	if s == "all" {
+		return unix.RT_TABLE_UNSPEC, nil
+	}
+	for n, name := range routeTables {
+		if name == s {
+			return n, nil
+		}
+	}
+	n, err := strconv.ParseUint(s, 0, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid table %q", s)
+	}
+	return int(n), nil
+}
+
+// parseProto parses a routing protocol name of rtProto or number.
+func parseProto(s string) (int, error) {
+	for n, name := range rtProto {
+		if name == s {
+			return n, nil
+		}
+	}
+	n, err := strconv.ParseUint(s, 0, 8)
+	if err != nil {
+		return 0, fmt.Errorf("invalid protocol %q", s)
+	}
+	return int(n), nil
+}
+
+// parseScope parses a scope name of addrScopes or number.
+func parseScope(s string) (netlink.Scope, error) {
+	for n, name := range addrScopes {
+		if name == s {
+			return n, nil
+		}
+	}
+	n, err := strconv.ParseUint(s, 0, 8)
+	if err != nil {
+		return 0, fmt.Errorf("invalid scope %q", s)
+	}
+	return netlink.Scope(n), nil
+}
+
+// parseRoute parses the rest of the arguments as a route:
+// {default|PREFIX|ADDR} [via GW] [dev DEV] [src ADDR] [metric N]
+// [proto PROTO] [scope SCOPE] [table TABLE] [mtu MTU]
+// and returns the RT_FILTER_* fields which were given. The metric has
+// none, it filters if it is not 0.
+func parseRoute() (*netlink.Route, uint64, error) {
+	r := &netlink.Route{}
+	var mask uint64
+	var err error
+	for more() {
+		whatIWant = []string{"default", "PREFIX", "via", "dev", "src", "metric", "proto", "scope", "table", "mtu"}
+		switch a := next(whatIWant...); a {
+		case "via":
+			if r.Gw, err = parseIP(next("gateway address")); err != nil {
+				return nil, 0, err
+			}
+			mask |= netlink.RT_FILTER_GW
+		case "dev", "oif":
+			l, err := netlink.LinkByName(next("device name"))
+			if err != nil {
+				return nil, 0, err
+			}
+			r.LinkIndex = l.Attrs().Index
+			mask |= netlink.RT_FILTER_OIF
+		case "src":
+			if r.Src, err = parseIP(next("source address")); err != nil {
+				return nil, 0, err
+			}
+			mask |= netlink.RT_FILTER_SRC
+		case "metric", "priority", "preference":
+			if r.Priority, err = number("metric"); err != nil {
+				return nil, 0, err
+			}
+		case "proto", "protocol":
+			if r.Protocol, err = parseProto(next("protocol")); err != nil {
+				return nil, 0, err
+			}
+			mask |= netlink.RT_FILTER_PROTOCOL
+		case "scope":
+			if r.Scope, err = parseScope(next("scope")); err != nil {
+				return nil, 0, err
+			}
+			mask |= netlink.RT_FILTER_SCOPE
+		case "table":
+			if r.Table, err = parseTable(next("table")); err != nil {
+				return nil, 0, err
+			}
+			mask |= netlink.RT_FILTER_TABLE
+		case "mtu":
+			if r.MTU, err = number("MTU"); err != nil {
+				return nil, 0, err
+			}
+		default:
+			if r.Dst, err = parsePrefix(a); err != nil {
+				return nil, 0, err
+			}
+			mask |= netlink.RT_FILTER_DST
+		}
+	}
+	return r, mask, nil
+}
+
+func routeshow() error {
+	r, mask, err := parseRoute()
+	if err != nil {
+		return err
+	}
+	return showRoutes(os.Stdout, family(), r, mask)
+}
+
+// routeChange adds, deletes or replaces the route of the arguments.
+func routeChange(cmd string) error {
+	r, mask, err := parseRoute()
+	if err != nil {
+		return err
+	}
+	if mask&netlink.RT_FILTER_DST == 0 {
+		return fmt.Errorf("route %s needs a destination, or default", cmd)
+	}
+	if cmd == "del" {
+		if err := netlink.RouteDel(r); err != nil {
+			return fmt.Errorf("error deleting route %s: %v", routeString(*r), err)
+		}
+		return nil
+	}
+	if mask&netlink.RT_FILTER_PROTOCOL == 0 {
+		r.Protocol = unix.RTPROT_BOOT
+	}
+	if mask&netlink.RT_FILTER_SCOPE == 0 && r.Gw == nil {
+		r.Scope = netlink.SCOPE_LINK
+	}
+	change, doing := netlink.RouteAdd, "adding"
+	if cmd == "replace" {
+		change, doing = netlink.RouteReplace, "replacing"
+	}
+	if err := change(r); err != nil {
+		return fmt.Errorf("error %s route %s: %v", doing, routeString(*r), err)
+	}
+	return nil
+}
+
+// routeget shows the route the kernel would use to reach an address.
+func routeget() error {
+	ip, err := parseIP(next("address"))
+	if err != nil {
+		return err
+	}
+	routes, err := netlink.RouteGet(ip)
+	if err != nil {
+		return err
+	}
+	if *jsonOut {
+		return printJSONRoutes(os.Stdout, routes)
+	}
+	for _, r := range routes {
+		fmt.Println(routeString(r))
+	}
+	return nil
+}
+
+func route() error {
+	cursor++
+	if len(arg[cursor:]) == 0 {
+		cursor--
+		return routeshow()
+	}
+
+	whatIWant = []string{"show", "list", "add", "del", "replace", "get"}
+	switch c := one(arg[cursor], whatIWant); c {
+	case "show", "list":
+		return routeshow()
+	case "add", "del", "replace":
+		return routeChange(c)
+	case "get":
+		return routeget()
+	}
+	return usage()
+}
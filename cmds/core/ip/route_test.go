@@ -0,0 +1,62 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"net"
+	"testing"
+
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+func TestRouteString(t *testing.T) {
+	_, dst, _ := net.ParseCIDR("10.0.0.0/8")
+	host, err := parsePrefix("10.0.0.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range []struct {
+		r    netlink.Route
+		want string
+	}{
+		{
+			r:    netlink.Route{Gw: net.ParseIP("10.0.2.2"), Protocol: unix.RTPROT_DHCP, Priority: 100},
+			want: "default via 10.0.2.2 proto dhcp metric 100",
+		},
+		{
+			r:    netlink.Route{Dst: dst, Protocol: unix.RTPROT_KERNEL, Scope: netlink.SCOPE_LINK, Src: net.ParseIP("10.0.0.2")},
+			want: "10.0.0.0/8 proto kernel scope link src 10.0.0.2",
+		},
+		{
+			r:    netlink.Route{Dst: host, Table: unix.RT_TABLE_LOCAL, Protocol: unix.RTPROT_BOOT, Scope: netlink.SCOPE_HOST},
+			want: "10.0.0.1 table local scope host",
+		},
+		{
+			r:    netlink.Route{Dst: dst, Table: 100, MTU: 1400},
+			want: "10.0.0.0/8 table 100 mtu 1400",
+		},
+	} {
+		if got := routeString(tt.r); got != tt.want {
+			t.Errorf("routeString(%v) = %q, want %q", tt.r, got, tt.want)
+		}
+	}
+}
+
+func TestParseTable(t *testing.T) {
+	for s, want := range map[string]int{
+		"main":  unix.RT_TABLE_MAIN,
+		"local": unix.RT_TABLE_LOCAL,
+		"all":   unix.RT_TABLE_UNSPEC,
+		"100":   100,
+	} {
+		if got, err := parseTable(s); err != nil || got != want {
+			t.Errorf("parseTable(%q) = %d, %v, want %d", s, got, err, want)
+		}
+	}
+	if _, err := parseTable("nope"); err == nil {
+		t.Errorf("parseTable(nope) succeeded")
+	}
+}
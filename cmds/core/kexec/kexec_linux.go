@@ -5,17 +5,41 @@
 // kexec executes a new kernel over the running kernel (u-root).
 //
 // Synopsis:
-//     kexec [--initrd=FILE] [--command-line=STRING] [-l] [-e] [KERNELIMAGE]
+//     kexec [--initrd=FILE] [--cmdline=STRING | --reuse-cmdline] [--append=STRING]
+//           [--reuse=KEYS] [--remove=KEYS] [--keyring=FILE] [-l] [-e] [KERNELIMAGE]
+//     kexec -p [--initrd=FILE] [--cmdline=STRING] [KERNELIMAGE]
 //
 // Description:
 //		 Loads a kernel for later execution.
 //
 // Options:
 //     --cmdline=STRING or -c=STRING: Set the kernel command line
-//     --reuse-commandline:           Use the kernel command line from running system
+//     --reuse-cmdline:               Use the kernel command line from running system
+//     --append=STRING:               Append parameters to the kernel command line
+//     --reuse=KEYS:                  Append the parameters of the running
+//                                    system with the comma separated KEYS,
+//                                    e.g. console,ip
+//     --remove=KEYS:                 Remove the parameters with the comma
+//                                    separated KEYS from the command line
 //     --i=FILE or --initrd=FILE:     Use file as the kernel's initial ramdisk
 //     -l or --load:                  Load the new kernel into the current kernel
 //     -e or --exec:                  Execute a currently loaded kernel
+//     -p or --load-panic:            Load the new kernel as the crash kernel,
+//                                    executed on panic, into the memory
+//                                    reserved with crashkernel=
+//     --keyring=FILE:                Verify the kernel and initramfs against
+//                                    their detached OpenPGP signatures, e.g.
+//                                    KERNELIMAGE.sig, with the keys of FILE
+//
+// The command line is composed from --cmdline or --reuse-cmdline; without
+// the parameters of --remove; with those of --reuse, which keep their
+// quotes and all their values, e.g. of several console= parameters; and
+// then with --append. Parameters which are repeated with the same value are
+// left out.
+//
+// Linux kernels are loaded with kexec_file_load(2), which is the only way
+// to kexec on kernels in lockdown mode; those also check the signature of
+// the kernel themselves. Multiboot kernels are loaded with kexec_load(2).
 package main
 
 import (
@@ -30,32 +54,78 @@ import (
 	"github.com/u-root/u-root/pkg/boot/multiboot"
 	"github.com/u-root/u-root/pkg/cmdline"
 	"github.com/u-root/u-root/pkg/uio"
+	"github.com/u-root/u-root/pkg/vfile"
 )
 
 type options struct {
 	cmdline      string
+	appendCmd    string
 	reuseCmdline bool
+	reuse        []string
+	remove       []string
 	initramfs    string
 	load         bool
 	exec         bool
+	loadPanic    bool
 	debug        bool
 	modules      []string
+	keyring      string
 }
 
 func registerFlags() *options {
 	o := &options{}
-	flag.StringVarP(&o.cmdline, "cmdline", "c", "", "Append to the kernel command line")
-	flag.StringVar(&o.cmdline, "append", "", "Append to the kernel command line")
+	flag.StringVarP(&o.cmdline, "cmdline", "c", "", "Set the kernel command line")
+	flag.StringVar(&o.appendCmd, "append", "", "Append to the kernel command line")
 	flag.BoolVar(&o.reuseCmdline, "reuse-cmdline", false, "Use the kernel command line from running system")
+	flag.StringSliceVar(&o.reuse, "reuse", nil, "Append the parameters with these keys from the running system, e.g. console,ip")
+	flag.StringSliceVar(&o.remove, "remove", nil, "Remove the parameters with these keys from the kernel command line")
 	flag.StringVarP(&o.initramfs, "initrd", "i", "", "Use file as the kernel's initial ramdisk")
 	flag.StringVar(&o.initramfs, "initramfs", "", "Use file as the kernel's initial ramdisk")
 	flag.BoolVarP(&o.load, "load", "l", false, "Load the new kernel into the current kernel")
 	flag.BoolVarP(&o.exec, "exec", "e", false, "Execute a currently loaded kernel")
+	flag.BoolVarP(&o.loadPanic, "load-panic", "p", false, "Load the new kernel as the crash kernel, executed on panic")
 	flag.BoolVarP(&o.debug, "debug", "d", false, "Print debug info")
+	flag.StringVar(&o.keyring, "keyring", "", "Verify the kernel and initramfs against their .sig detached signatures with this OpenPGP key ring")
 	flag.StringArrayVar(&o.modules, "module", nil, `Load multiboot module with command line args (e.g --module="mod arg1")`)
 	return o
 }
 
+// linuxImage returns the Linux kernel at kernelpath, with the initramfs and
+// the key ring of opts.
+func linuxImage(opts *options, kernelpath, cmdline string) (*boot.LinuxImage, error) {
+	var i io.ReaderAt
+	if opts.initramfs != "" {
+		i = uio.NewLazyFile(opts.initramfs)
+	}
+	li := &boot.LinuxImage{
+		Kernel:  uio.NewLazyFile(kernelpath),
+		Initrd:  i,
+		Cmdline: cmdline,
+	}
+	if opts.keyring != "" {
+		ring, err := vfile.GetKeyRing(opts.keyring)
+		if err != nil {
+			return nil, err
+		}
+		li.Verifier = boot.PGPVerifier{KeyRing: ring}
+	}
+	return li, nil
+}
+
+// kernelCmdline composes the command line of the new kernel from opts and
+// running, the command line of the running kernel.
+func kernelCmdline(opts *options, running string) string {
+	base := opts.cmdline
+	if opts.reuseCmdline {
+		base = running
+	}
+	b := cmdline.NewBuilder(base)
+	b.Remove(opts.remove...)
+	b.Inherit(running, opts.reuse...)
+	b.Append(opts.appendCmd)
+	return b.String()
+}
+
 func main() {
 	opts := registerFlags()
 	flag.Parse()
@@ -67,21 +137,41 @@ func main() {
 
 	if opts.cmdline != "" && opts.reuseCmdline {
 		flag.PrintDefaults()
-		log.Fatalf("--reuse-cmdline and other command line options are mutually exclusive")
+		log.Fatalf("--reuse-cmdline and --cmdline are mutually exclusive")
+	}
+
+	if opts.loadPanic && (opts.load || opts.exec) {
+		flag.PrintDefaults()
+		log.Fatalf("--load-panic, --load and --exec are mutually exclusive")
 	}
 
-	if !opts.load && !opts.exec {
+	if !opts.load && !opts.exec && !opts.loadPanic {
 		opts.load = true
 		opts.exec = true
 	}
 
-	newCmdline := opts.cmdline
-	if opts.reuseCmdline {
+	var running string
+	if opts.reuseCmdline || len(opts.reuse) > 0 {
 		procCmdLine := cmdline.NewCmdLine()
 		if procCmdLine.Err != nil {
 			log.Fatal("Couldn't read /proc/cmdline")
-		} else {
-			newCmdline = procCmdLine.Raw
+		}
+		running = procCmdLine.Raw
+	}
+	newCmdline := kernelCmdline(opts, running)
+	if opts.debug {
+		log.Printf("Kernel command line: %q", newCmdline)
+	}
+
+	if opts.loadPanic {
+		// Crash kernels are Linux kernels, executed by the panicking
+		// kernel itself.
+		li, err := linuxImage(opts, flag.Arg(0), newCmdline)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := li.LoadPanic(opts.debug); err != nil {
+			log.Fatal(err)
 		}
 	}
 
@@ -100,14 +190,9 @@ func main() {
 				Cmdline: newCmdline,
 			}
 		} else {
-			var i io.ReaderAt
-			if opts.initramfs != "" {
-				i = uio.NewLazyFile(opts.initramfs)
-			}
-			image = &boot.LinuxImage{
-				Kernel:  uio.NewLazyFile(kernelpath),
-				Initrd:  i,
-				Cmdline: newCmdline,
+			image, err = linuxImage(opts, kernelpath, newCmdline)
+			if err != nil {
+				log.Fatal(err)
 			}
 		}
 		if err := image.Load(opts.debug); err != nil {
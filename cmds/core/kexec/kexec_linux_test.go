@@ -0,0 +1,48 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+)
+
+func TestKernelCmdline(t *testing.T) {
+	running := `BOOT_IMAGE=/vmlinuz console=tty0 console=ttyS0,115200 ip=dhcp quiet`
+	for _, tt := range []struct {
+		name string
+		opts options
+		want string
+	}{
+		{
+			name: "cmdline",
+			opts: options{cmdline: "root=/dev/sda1 ro"},
+			want: "root=/dev/sda1 ro",
+		},
+		{
+			name: "reuse cmdline and append",
+			opts: options{reuseCmdline: true, appendCmd: `panic=10 uroot.uinitargs="-v x"`},
+			want: `BOOT_IMAGE=/vmlinuz console=tty0 console=ttyS0,115200 ip=dhcp quiet panic=10 uroot.uinitargs="-v x"`,
+		},
+		{
+			name: "reuse cmdline and remove",
+			opts: options{reuseCmdline: true, remove: []string{"BOOT_IMAGE", "quiet"}},
+			want: "console=tty0 console=ttyS0,115200 ip=dhcp",
+		},
+		{
+			name: "reuse keys",
+			opts: options{cmdline: "root=/dev/sda1 console=ttyS1", remove: []string{"console"}, reuse: []string{"console", "ip"}, appendCmd: "ro"},
+			want: "root=/dev/sda1 console=tty0 console=ttyS0,115200 ip=dhcp ro",
+		},
+		{
+			name: "duplicates",
+			opts: options{cmdline: "console=tty0 ip=dhcp", reuse: []string{"console", "ip"}},
+			want: "console=tty0 ip=dhcp console=ttyS0,115200",
+		},
+	} {
+		if got := kernelCmdline(&tt.opts, running); got != tt.want {
+			t.Errorf("%s: kernelCmdline = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
@@ -5,65 +5,209 @@
 // losetup sets up and controls loop devices.
 //
 // Synopsis:
-//     losetup [-Ad] FILE
-//     losetup [-Ad] DEV FILE
+//     losetup [-r] [-P] [-o OFFSET] [-sizelimit SIZE] [-show] [-f] FILE
+//     losetup [-r] [-P] [-o OFFSET] [-sizelimit SIZE] DEV FILE
+//     losetup -f
+//     losetup -d DEV...
+//     losetup -a
+//     losetup DEV
+//
+// Description:
+//     With a FILE, losetup attaches it to the loop device DEV, or a free one.
+//     With only a loop device, it shows which file is attached to it. With
+//     only -f, it prints the first free loop device.
 //
 // Options:
-//     -A: pick any device
-//     -d: detach the device
+//     -a:         list all attached loop devices
+//     -d:         detach the devices
+//     -f:         attach FILE to a free device, or print one without FILE
+//     -show:      print the name of the device FILE was attached to
+//     -o:         offset of the device in FILE, in bytes
+//     -sizelimit: size of the device, in bytes, 0 for up to the end of FILE
+//     -r:         set up a read-only device
+//     -P:         make the kernel scan the device for partitions
 package main
 
 import (
+	"errors"
 	"flag"
+	"fmt"
 	"log"
-	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/u-root/u-root/pkg/mount/loop"
+	"golang.org/x/sys/unix"
 )
 
 var (
-	detach = flag.Bool("d", false, "Detach the device")
+	detach    = flag.Bool("d", false, "Detach the devices")
+	all       = flag.Bool("a", false, "List all attached loop devices")
+	free      = flag.Bool("f", false, "Attach the file to a free device, or print a free device")
+	show      = flag.Bool("show", false, "Print the name of the device the file was attached to")
+	offset    = flag.Uint64("o", 0, "Offset of the device in the file, in bytes")
+	sizeLimit = flag.Uint64("sizelimit", 0, "Size of the device in bytes, 0 for up to the end of the file")
+	readOnly  = flag.Bool("r", false, "Set up a read-only device")
+	partScan  = flag.Bool("P", false, "Scan the device for partitions")
 )
 
-func main() {
-	var (
-		filename, devicename string
-		err                  error
-	)
+// status returns a line describing the loop device, like
+//
+//     /dev/loop0: [0803]:1234 (/tmp/disk.img), offset 512
+func status(devicename string, info *unix.LoopInfo64) string {
+	name := string(info.File_name[:])
+	if i := strings.IndexByte(name, 0); i >= 0 {
+		name = name[:i]
+	}
+	s := fmt.Sprintf("%s: [%04x]:%d (%s)", devicename, info.Device, info.Inode, name)
+	if info.Offset != 0 {
+		s += fmt.Sprintf(", offset %d", info.Offset)
+	}
+	if info.Sizelimit != 0 {
+		s += fmt.Sprintf(", sizelimit %d", info.Sizelimit)
+	}
+	return s
+}
 
+// list prints the attached loop devices, in order.
+func list() error {
+	devices, err := filepath.Glob("/dev/loop[0-9]*")
+	if err != nil {
+		return err
+	}
+	number := func(d string) int {
+		n, _ := strconv.Atoi(strings.TrimPrefix(d, "/dev/loop"))
+		return n
+	}
+	sort.Slice(devices, func(i, j int) bool { return number(devices[i]) < number(devices[j]) })
+	for _, d := range devices {
+		info, err := loop.Status(d)
+		if err == unix.ENXIO {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %v", d, err)
+		}
+		fmt.Println(status(d, info))
+	}
+	return nil
+}
+
+// op is what losetup is asked to do.
+type op int
+
+const (
+	opAttach op = iota // attach FILE to DEV, or to a free device
+	opFind             // print a free device
+	opList             // list the attached devices
+	opDetach           // detach the devices
+	opStatus           // show the file attached to DEV
+)
+
+var errUsage = errors.New("Syntax Error")
+
+// operation returns what the flags and args ask for, or errUsage.
+func operation(args []string) (op, error) {
+	switch {
+	case *all:
+		if len(args) != 0 {
+			return 0, errUsage
+		}
+		return opList, nil
+	case *detach:
+		if len(args) == 0 {
+			return 0, errUsage
+		}
+		return opDetach, nil
+	case *free:
+		switch len(args) {
+		case 0:
+			return opFind, nil
+		case 1:
+			return opAttach, nil
+		}
+		return 0, errUsage
+	}
+	switch len(args) {
+	case 1:
+		if loop.IsDevice(args[0]) {
+			return opStatus, nil
+		}
+		return opAttach, nil
+	case 2:
+		return opAttach, nil
+	}
+	return 0, errUsage
+}
+
+func usage() {
+	flag.Usage()
+	log.Fatal(errUsage)
+}
+
+func main() {
 	flag.Parse()
 	args := flag.Args()
-	if *detach {
-		if len(args) == 1 {
-			if err := loop.ClearFile(args[0]); err != nil {
-				log.Fatal("Error clearing device: ", err)
+	o, err := operation(args)
+	if err != nil {
+		usage()
+	}
+	switch o {
+	case opList:
+		if err := list(); err != nil {
+			log.Fatal(err)
+		}
+		return
+
+	case opFind:
+		d, err := loop.FindDevice()
+		if err != nil {
+			log.Fatalf("Could not find a free loop device: %v", err)
+		}
+		fmt.Println(d)
+		return
+
+	case opDetach:
+		for _, d := range args {
+			if err := loop.ClearFile(d); err != nil {
+				log.Fatalf("Error clearing device %s: %v", d, err)
 			}
-			log.Println("Detached", args[0])
-			os.Exit(0)
+			log.Println("Detached", d)
 		}
-		flag.Usage()
-		log.Fatal("Syntax Error")
-	}
+		return
 
-	if len(args) == 1 {
-		devicename, err = loop.FindDevice()
+	case opStatus:
+		info, err := loop.Status(args[0])
 		if err != nil {
-			log.Fatalf("can't find a loop: %v", err)
-			os.Exit(1)
+			log.Fatalf("%s: %v", args[0], err)
 		}
+		fmt.Println(status(args[0], info))
+		return
+	}
+
+	opts := loop.Options{
+		Offset:    *offset,
+		SizeLimit: *sizeLimit,
+		ReadOnly:  *readOnly,
+		PartScan:  *partScan,
+	}
+	var devicename, filename string
+	if len(args) == 1 {
 		filename = args[0]
-	} else if len(args) == 2 {
-		devicename = args[0]
-		filename = args[1]
+		devicename, err = loop.Attach(filename, opts)
 	} else {
-		flag.Usage()
-		log.Fatal("Syntax Error")
+		devicename, filename = args[0], args[1]
+		err = loop.SetFileOptions(devicename, filename, opts)
 	}
-
-	if err := loop.SetFile(devicename, filename); err != nil {
-		log.Fatal("Could not set loop device:", err)
+	if err != nil {
+		log.Fatal("Could not set loop device: ", err)
 	}
 
+	if *show {
+		fmt.Println(devicename)
+		return
+	}
 	log.Printf("Attached %s to %s", devicename, filename)
-
 }
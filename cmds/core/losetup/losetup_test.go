@@ -0,0 +1,60 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"golang.org/x/sys/unix"
+)
+
+func TestStatus(t *testing.T) {
+	for _, tt := range []struct {
+		offset, sizeLimit uint64
+		want              string
+	}{
+		{want: "/dev/loop0: [0803]:1234 (/tmp/disk.img)"},
+		{offset: 512, want: "/dev/loop0: [0803]:1234 (/tmp/disk.img), offset 512"},
+		{sizeLimit: 1 << 20, want: "/dev/loop0: [0803]:1234 (/tmp/disk.img), sizelimit 1048576"},
+		{offset: 512, sizeLimit: 1 << 20, want: "/dev/loop0: [0803]:1234 (/tmp/disk.img), offset 512, sizelimit 1048576"},
+	} {
+		info := &unix.LoopInfo64{Device: 0x803, Inode: 1234, Offset: tt.offset, Sizelimit: tt.sizeLimit}
+		copy(info.File_name[:], "/tmp/disk.img")
+		if got := status("/dev/loop0", info); got != tt.want {
+			t.Errorf("status() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestOperation(t *testing.T) {
+	defer func() { *all, *detach, *free = false, false, false }()
+	for _, tt := range []struct {
+		name              string
+		all, detach, free bool
+		args              []string
+		want              op
+		err               error
+	}{
+		{name: "list", all: true, want: opList},
+		{name: "list with args", all: true, args: []string{"disk.img"}, err: errUsage},
+		{name: "detach", detach: true, args: []string{"/dev/loop0", "/dev/loop1"}, want: opDetach},
+		{name: "detach without devices", detach: true, err: errUsage},
+		{name: "find a free device", free: true, want: opFind},
+		{name: "attach to a free device", free: true, args: []string{"disk.img"}, want: opAttach},
+		{name: "free with two args", free: true, args: []string{"/dev/loop0", "disk.img"}, err: errUsage},
+		{name: "attach file", args: []string{"disk.img"}, want: opAttach},
+		{name: "attach to device", args: []string{"/dev/loop0", "disk.img"}, want: opAttach},
+		{name: "no args", err: errUsage},
+		{name: "three args", args: []string{"/dev/loop0", "disk.img", "more"}, err: errUsage},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			*all, *detach, *free = tt.all, tt.detach, tt.free
+			got, err := operation(tt.args)
+			if err != tt.err || (err == nil && got != tt.want) {
+				t.Errorf("operation(%q) = %v, %v, want %v, %v", tt.args, got, err, tt.want, tt.err)
+			}
+		})
+	}
+}
@@ -7,11 +7,24 @@
 // Synopsis:
 //     ls [OPTIONS] [DIRS]...
 //
+// Description:
+//     Entries are sorted by name, and listed in columns as wide as the
+//     terminal when writing to one, else one per line.
+//
 // Options:
+//     -a: show hidden files
+//     -d: list directories but not their contents
+//     -h: human readable sizes with -l
 //     -l: long form
 //     -Q: quoted
 //     -R: equivalent to findutil's find
 //     -F: append indicator (one of */=>@|) to entries
+//     -S: sort by size, largest first
+//     -t: sort by modification time, newest first
+//     -r: reverse the order of the sort
+//     -1: list one entry per line
+//     -C: list entries in columns, even when not writing to a terminal
+//     --json: print JSON
 //
 // Bugs:
 //     With the `-R` flag, directories are only ever printed once.
@@ -20,13 +33,19 @@ package main
 import (
 	"fmt"
 	"io"
+	"io/ioutil"
 	"log"
 	"os"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"text/tabwriter"
+	"unicode/utf8"
 
 	flag "github.com/spf13/pflag"
 	"github.com/u-root/u-root/pkg/ls"
+	"github.com/u-root/u-root/pkg/output"
+	"github.com/u-root/u-root/pkg/termios"
 )
 
 var (
@@ -37,58 +56,192 @@ var (
 	quoted    = flag.BoolP("quote-name", "Q", false, "quoted")
 	recurse   = flag.BoolP("recursive", "R", false, "equivalent to findutil's find")
 	classify  = flag.BoolP("classify", "F", false, "append indicator (one of */=>@|) to entries")
+	sortSize  = flag.BoolP("sort-size", "S", false, "sort by size, largest first")
+	sortTime  = flag.BoolP("sort-time", "t", false, "sort by modification time, newest first")
+	reverse   = flag.BoolP("reverse", "r", false, "reverse the order of the sort")
+	oneColumn = flag.BoolP("one-per-line", "1", false, "list one entry per line")
+	inColumns = flag.BoolP("columns", "C", false, "list entries in columns, even when not writing to a terminal")
+	jsonOut   = flag.Bool(output.FlagName, false, output.FlagUsage)
+
+	// jsonFiles collects the files listed with --json.
+	jsonFiles = []ls.FileInfo{}
 )
 
-func listName(stringer ls.Stringer, d string, w io.Writer, prefix bool) error {
-	return filepath.Walk(d, func(path string, osfi os.FileInfo, err error) error {
-		// Soft error. Useful when a permissions are insufficient to
-		// stat one of the files.
-		if err != nil {
-			log.Printf("%s: %v\n", path, err)
-			return nil
+// defaultWidth is the width of columns when it is not known from the
+// terminal or $COLUMNS.
+const defaultWidth = 80
+
+// lister prints the files of the names it lists.
+type lister struct {
+	stringer ls.Stringer
+	w        io.Writer
+
+	// width is the width entries are listed in columns in, or 0 to
+	// list one per line.
+	width int
+
+	// pending are the entries to list in columns.
+	pending []string
+}
+
+func (l *lister) printFile(fi ls.FileInfo) {
+	if *classify {
+		fi.Name = fi.Name + indicator(fi)
+	}
+	if *jsonOut {
+		jsonFiles = append(jsonFiles, fi)
+		return
+	}
+	if l.width > 0 {
+		l.pending = append(l.pending, l.stringer.FileString(fi))
+		return
+	}
+	fmt.Fprintln(l.w, l.stringer.FileString(fi))
+}
+
+// flush prints the pending entries in columns.
+func (l *lister) flush() {
+	columns(l.w, l.pending, l.width)
+	l.pending = nil
+}
+
+func (l *lister) listName(d string, prefix bool) error {
+	osfi, err := os.Lstat(d)
+	if err != nil {
+		return err
+	}
+	defer l.flush()
+
+	fi := ls.FromOSFileInfo(d, osfi)
+	if *directory || !osfi.IsDir() {
+		l.printFile(fi)
+		return nil
+	}
+
+	if *recurse {
+		// Mimic find command
+		fi.Name = d
+	} else {
+		// Starting directory is a dot when non-recursive
+		fi.Name = "."
+		if prefix && !*jsonOut {
+			if *quoted {
+				fmt.Fprintf(l.w, "%q:\n", d)
+			} else {
+				fmt.Fprintf(l.w, "%v:\n", d)
+			}
 		}
+	}
+	// Hide .files unless -a was given
+	if *all || fi.Name[0] != '.' {
+		l.printFile(fi)
+	}
+	l.listDir(d)
+	return nil
+}
 
-		fi := ls.FromOSFileInfo(path, osfi)
+// listDir prints the files in dir, sorted, and the files in its
+// subdirectories after each of them with -R.
+func (l *lister) listDir(dir string) {
+	osfis, err := ioutil.ReadDir(dir)
+	// Soft error. Useful when a permissions are insufficient to
+	// read one of the directories.
+	if err != nil {
+		log.Printf("%s: %v\n", dir, err)
+	}
 
+	var fis []ls.FileInfo
+	for _, osfi := range osfis {
+		if !*all && osfi.Name()[0] == '.' {
+			continue
+		}
+		path := filepath.Join(dir, osfi.Name())
+		fi := ls.FromOSFileInfo(path, osfi)
 		if *recurse {
-			// Mimic find command
 			fi.Name = path
-		} else if path == d {
-			if *directory {
-				fmt.Fprintln(w, stringer.FileString(fi))
-				return filepath.SkipDir
-			}
-
-			// Starting directory is a dot when non-recursive
-			if osfi.IsDir() {
-				fi.Name = "."
-				if prefix {
-					if *quoted {
-						fmt.Fprintf(w, "%q:\n", d)
-					} else {
-						fmt.Fprintf(w, "%v:\n", d)
-					}
-				}
-			}
 		}
+		fis = append(fis, fi)
+	}
+	sortFiles(fis)
 
-		// Hide .files unless -a was given
-		if *all || fi.Name[0] != '.' {
-			// Print the file in the proper format.
-			if *classify {
-				fi.Name = fi.Name + indicator(fi)
-			}
-			fmt.Fprintln(w, stringer.FileString(fi))
+	for _, fi := range fis {
+		l.printFile(fi)
+		if *recurse && fi.Mode.IsDir() {
+			l.listDir(fi.Name)
 		}
+	}
+}
+
+// less reports whether a is listed before b: by size or modification time
+// with -S or -t, else, or if they are the same, by name.
+func less(a, b ls.FileInfo) bool {
+	switch {
+	case *sortSize && a.Size != b.Size:
+		return a.Size > b.Size
+	case *sortTime && !a.MTime.Equal(b.MTime):
+		return a.MTime.After(b.MTime)
+	}
+	return a.Name < b.Name
+}
 
-		// Skip directories when non-recursive.
-		if path != d && fi.Mode.IsDir() && !*recurse {
-			return filepath.SkipDir
+func sortFiles(fis []ls.FileInfo) {
+	sort.Slice(fis, func(i, j int) bool {
+		if *reverse {
+			return less(fis[j], fis[i])
 		}
-		return nil
+		return less(fis[i], fis[j])
 	})
 }
 
+// columns prints names in as many columns as fit in width, sorted down the
+// columns and two spaces apart.
+func columns(w io.Writer, names []string, width int) {
+	const sep = 2
+	var rows int
+	var widths []int
+	for cols := len(names); cols > 0; cols-- {
+		rows = (len(names) + cols - 1) / cols
+		widths = make([]int, (len(names)+rows-1)/rows)
+		total := sep * (len(widths) - 1)
+		for i, n := range names {
+			if l := utf8.RuneCountInString(n); l > widths[i/rows] {
+				total += l - widths[i/rows]
+				widths[i/rows] = l
+			}
+		}
+		if total <= width {
+			break
+		}
+	}
+	for r := 0; r < rows; r++ {
+		for i := r; i < len(names); i += rows {
+			if i+rows < len(names) {
+				fmt.Fprintf(w, "%-*s", widths[i/rows]+sep, names[i])
+			} else {
+				fmt.Fprintln(w, names[i])
+			}
+		}
+	}
+}
+
+// columnWidth returns the width to list entries in columns in, or 0 if
+// they are listed one per line.
+func columnWidth() int {
+	if *long || *oneColumn || *jsonOut {
+		return 0
+	}
+	if ws, err := termios.GetWinSize(os.Stdout.Fd()); err == nil && ws.Col > 0 {
+		return int(ws.Col)
+	}
+	if !*inColumns {
+		return 0
+	}
+	if c, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && c > 0 {
+		return c
+	}
+	return defaultWidth
+}
+
 func indicator(fi ls.FileInfo) string {
 	if fi.Mode.IsRegular() && fi.Mode&0111 != 0 {
 		return "*"
@@ -123,6 +276,7 @@ func main() {
 	if *long {
 		s = ls.LongStringer{Human: *human, Name: s}
 	}
+	l := &lister{stringer: s, w: w, width: columnWidth()}
 
 	// Array of names to list.
 	names := flag.Args()
@@ -133,9 +287,14 @@ func main() {
 	// Is a name a directory? If so, list it in its own section.
 	prefix := len(names) > 1
 	for _, d := range names {
-		if err := listName(s, d, w, prefix); err != nil {
+		if err := l.listName(d, prefix); err != nil {
 			log.Printf("error while listing %#v: %v", d, err)
 		}
 		w.Flush()
 	}
+	if *jsonOut {
+		if err := output.JSON(os.Stdout, jsonFiles); err != nil {
+			log.Fatal(err)
+		}
+	}
 }
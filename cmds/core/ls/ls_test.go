@@ -5,10 +5,12 @@
 package main
 
 import (
+	"bytes"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/u-root/u-root/pkg/ls"
 	"github.com/u-root/u-root/pkg/testutil"
@@ -65,6 +67,24 @@ f3?line 2
 d1:
 f4
 f2
+`,
+	}, {
+		flags: []string{"-r"},
+		out: `f3?line 2
+f2
+f1
+d1
+`,
+	}, {
+		flags: []string{"-C"},
+		out: `d1  f1  f2  f3?line 2
+`,
+	}, {
+		flags: []string{"-C", "-1"},
+		out: `d1
+f1
+f2
+f3?line 2
 `,
 	}, {
 		flags: []string{"-d"},
@@ -133,6 +153,57 @@ func TestIndicator(t *testing.T) {
 	}
 }
 
+func TestSortFiles(t *testing.T) {
+	now := time.Now()
+	fis := []ls.FileInfo{
+		{Name: "a", Size: 1, MTime: now},
+		{Name: "b", Size: 3, MTime: now.Add(-time.Hour)},
+		{Name: "c", Size: 2, MTime: now.Add(time.Hour)},
+		{Name: "d", Size: 3, MTime: now},
+	}
+	for _, tt := range []struct {
+		size, time, reverse bool
+		want                string
+	}{
+		{want: "abcd"},
+		{reverse: true, want: "dcba"},
+		{size: true, want: "bdca"},
+		{size: true, reverse: true, want: "acdb"},
+		{time: true, want: "cadb"},
+		{time: true, reverse: true, want: "bdac"},
+	} {
+		*sortSize, *sortTime, *reverse = tt.size, tt.time, tt.reverse
+		sortFiles(fis)
+		var got string
+		for _, fi := range fis {
+			got += fi.Name
+		}
+		if got != tt.want {
+			t.Errorf("sortFiles(size=%v, time=%v, reverse=%v) = %q, want %q", tt.size, tt.time, tt.reverse, got, tt.want)
+		}
+	}
+	*sortSize, *sortTime, *reverse = false, false, false
+}
+
+func TestColumns(t *testing.T) {
+	names := []string{"a", "bbb", "cc", "d", "eeeee", "f", "g"}
+	for _, tt := range []struct {
+		width int
+		want  string
+	}{
+		{80, "a  bbb  cc  d  eeeee  f  g\n"},
+		{20, "a    cc  eeeee  g\nbbb  d   f\n"},
+		{10, "a    eeeee\nbbb  f\ncc   g\nd\n"},
+		{1, "a\nbbb\ncc\nd\neeeee\nf\ng\n"},
+	} {
+		var b bytes.Buffer
+		columns(&b, names, tt.width)
+		if b.String() != tt.want {
+			t.Errorf("columns(%v, %d) = %q, want %q", names, tt.width, b.String(), tt.want)
+		}
+	}
+}
+
 func TestMain(m *testing.M) {
 	testutil.Run(m, main)
 }
@@ -0,0 +1,562 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// lsblk lists block devices.
+//
+// Synopsis:
+//     lsblk [-abdfJln] [-o COLUMNS] [DEVICE...]
+//
+// Description:
+//     Devices are shown as a tree: partitions under their disk, and devices
+//     built on others, such as device mapper, md and loop devices, under
+//     each of the devices they use. Device mapper devices are shown by
+//     their mapped name.
+//
+//     Without DEVICE arguments, all devices which are not built on others
+//     are listed. Empty devices, such as unused loop devices, are skipped
+//     unless -a is given.
+//
+//     The FSTYPE, LABEL and UUID columns are read from the superblock of
+//     each device, which requires permission to read it.
+//
+// Options:
+//     -a: list empty devices too
+//     -b: print sizes in bytes
+//     -d: do not list partitions and holders
+//     -f: list file systems, same as -o NAME,FSTYPE,LABEL,UUID,MOUNTPOINTS
+//     -J: print JSON
+//     -l: print a list instead of a tree
+//     -n: do not print headings
+//     -o: comma-separated columns, from NAME, KNAME, MAJ:MIN, RM, SIZE,
+//         RO, TYPE, FSTYPE, LABEL, UUID and MOUNTPOINTS
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/u-root/u-root/pkg/blkid"
+)
+
+var (
+	all        = flag.Bool("a", false, "list empty devices too")
+	inBytes    = flag.Bool("b", false, "print sizes in bytes")
+	noDeps     = flag.Bool("d", false, "do not list partitions and holders")
+	fs         = flag.Bool("f", false, "list file systems")
+	asJSON     = flag.Bool("J", false, "print JSON")
+	list       = flag.Bool("l", false, "print a list instead of a tree")
+	noHeadings = flag.Bool("n", false, "do not print headings")
+	output     = flag.String("o", "", "comma-separated columns")
+)
+
+// These are variables so tests can override them.
+var (
+	sysBlock   = "/sys/block"
+	devDir     = "/dev"
+	mountinfo  = "/proc/self/mountinfo"
+	procSwaps  = "/proc/swaps"
+	defaultCol = "NAME,MAJ:MIN,RM,SIZE,RO,TYPE,MOUNTPOINTS"
+	fsCol      = "NAME,FSTYPE,LABEL,UUID,MOUNTPOINTS"
+)
+
+type device struct {
+	name        string
+	kname       string
+	majMin      string
+	removable   bool
+	readOnly    bool
+	size        uint64
+	typ         string
+	info        *blkid.Info
+	mountpoints []string
+	children    []*device
+}
+
+type column struct {
+	name string
+	// value is printed as JSON with -J. nil is printed as null in JSON
+	// and empty in tables.
+	value func(d *device) interface{}
+	// needsProbe is set for columns read from the superblock.
+	needsProbe bool
+}
+
+func orNil(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+var columns = []column{
+	{name: "NAME", value: func(d *device) interface{} { return d.name }},
+	{name: "KNAME", value: func(d *device) interface{} { return d.kname }},
+	{name: "MAJ:MIN", value: func(d *device) interface{} { return d.majMin }},
+	{name: "RM", value: func(d *device) interface{} { return d.removable }},
+	{name: "SIZE", value: func(d *device) interface{} {
+		if *inBytes {
+			return d.size
+		}
+		return humanSize(d.size)
+	}},
+	{name: "RO", value: func(d *device) interface{} { return d.readOnly }},
+	{name: "TYPE", value: func(d *device) interface{} { return d.typ }},
+	{name: "FSTYPE", needsProbe: true, value: func(d *device) interface{} {
+		if d.info == nil {
+			return nil
+		}
+		return orNil(d.info.Type)
+	}},
+	{name: "LABEL", needsProbe: true, value: func(d *device) interface{} {
+		if d.info == nil {
+			return nil
+		}
+		return orNil(d.info.Label)
+	}},
+	{name: "UUID", needsProbe: true, value: func(d *device) interface{} {
+		if d.info == nil {
+			return nil
+		}
+		return orNil(d.info.UUID)
+	}},
+	{name: "MOUNTPOINTS", value: func(d *device) interface{} {
+		if d.mountpoints == nil {
+			return []string{}
+		}
+		return d.mountpoints
+	}},
+}
+
+func parseColumns(s string) ([]column, error) {
+	var cols []column
+next:
+	for _, n := range strings.Split(s, ",") {
+		n = strings.ToUpper(strings.TrimSpace(n))
+		if n == "MOUNTPOINT" {
+			n = "MOUNTPOINTS"
+		}
+		for _, c := range columns {
+			if c.name == n {
+				cols = append(cols, c)
+				continue next
+			}
+		}
+		return nil, fmt.Errorf("unknown column %q", n)
+	}
+	return cols, nil
+}
+
+// humanSize formats n like util-linux: in the largest binary unit it
+// reaches, with at most one decimal.
+func humanSize(n uint64) string {
+	const units = "BKMGTPE"
+	u := 0
+	f := float64(n)
+	for f >= 1024 && u < len(units)-1 {
+		f /= 1024
+		u++
+	}
+	s := strconv.FormatFloat(f, 'f', 1, 64)
+	return strings.TrimSuffix(s, ".0") + units[u:u+1]
+}
+
+func readAttr(dir, name string) string {
+	b, err := ioutil.ReadFile(filepath.Join(dir, name))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(b))
+}
+
+func readLinks(dir string) []string {
+	fis, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+	var names []string
+	for _, fi := range fis {
+		names = append(names, fi.Name())
+	}
+	return names
+}
+
+// scanner builds devices from sysfs.
+type scanner struct {
+	probe  bool
+	mounts map[string][]string
+}
+
+// newScanner reads the mountpoints and swap devices of the running system.
+func newScanner(cols []column) (*scanner, error) {
+	s := &scanner{mounts: map[string][]string{}}
+	for _, c := range cols {
+		s.probe = s.probe || c.needsProbe
+	}
+	f, err := os.Open(mountinfo)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		// 36 35 98:0 /mnt1 /mnt2 rw,noatime master:1 - ext3 /dev/root rw
+		fields := strings.Fields(sc.Text())
+		if len(fields) < 5 {
+			continue
+		}
+		s.mounts[fields[2]] = append(s.mounts[fields[2]], unescape(fields[4]))
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+
+	// Swaps are listed by path, so they are matched by kernel name.
+	if b, err := ioutil.ReadFile(procSwaps); err == nil {
+		lines := strings.Split(string(b), "\n")
+		for _, l := range lines[1:] {
+			if fields := strings.Fields(l); len(fields) > 0 {
+				k := "swap:" + filepath.Base(unescape(fields[0]))
+				s.mounts[k] = append(s.mounts[k], "[SWAP]")
+			}
+		}
+	}
+	return s, nil
+}
+
+// unescape undoes the octal escapes of spaces and the like in mountinfo.
+func unescape(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+3 < len(s) {
+			if v, err := strconv.ParseUint(s[i+1:i+4], 8, 8); err == nil {
+				b.WriteByte(byte(v))
+				i += 3
+				continue
+			}
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+func devType(dir, name string) string {
+	if _, err := os.Stat(filepath.Join(dir, "partition")); err == nil {
+		return "part"
+	}
+	if uuid := readAttr(dir, "dm/uuid"); uuid != "" || readAttr(dir, "dm/name") != "" {
+		switch {
+		case strings.HasPrefix(uuid, "CRYPT-"):
+			return "crypt"
+		case strings.HasPrefix(uuid, "LVM-"):
+			return "lvm"
+		case strings.HasPrefix(uuid, "mpath-"):
+			return "mpath"
+		}
+		return "dm"
+	}
+	if level := readAttr(dir, "md/level"); level != "" {
+		return level
+	}
+	if strings.HasPrefix(name, "loop") {
+		return "loop"
+	}
+	// SCSI type 5 is a CD/DVD drive.
+	if readAttr(dir, "device/type") == "5" {
+		return "rom"
+	}
+	return "disk"
+}
+
+// device reads the device whose sysfs directory is dir. parent is the
+// removable and read-only state a partition inherits from its disk.
+func (s *scanner) device(dir string, parent *device) *device {
+	kname := filepath.Base(dir)
+	d := &device{
+		name:      kname,
+		kname:     kname,
+		majMin:    readAttr(dir, "dev"),
+		removable: readAttr(dir, "removable") == "1",
+		readOnly:  readAttr(dir, "ro") == "1",
+		typ:       devType(dir, kname),
+	}
+	if n := readAttr(dir, "dm/name"); n != "" {
+		d.name = n
+	}
+	if parent != nil {
+		d.removable = parent.removable
+	}
+	if sectors, err := strconv.ParseUint(readAttr(dir, "size"), 10, 64); err == nil {
+		// sysfs sizes are in 512 byte sectors, whatever the block size.
+		d.size = sectors * 512
+	}
+	d.mountpoints = append(append([]string(nil), s.mounts[d.majMin]...), s.mounts["swap:"+kname]...)
+	if s.probe && d.size > 0 {
+		if i, err := blkid.ProbeFile(filepath.Join(devDir, kname)); err == nil {
+			d.info = i
+		}
+	}
+	if *noDeps {
+		return d
+	}
+
+	// Partitions are subdirectories with a partition number.
+	type part struct {
+		n   int
+		dir string
+	}
+	var parts []part
+	for _, n := range readLinks(dir) {
+		p := filepath.Join(dir, n)
+		if num, err := strconv.Atoi(readAttr(p, "partition")); err == nil {
+			parts = append(parts, part{num, p})
+		}
+	}
+	sort.Slice(parts, func(i, j int) bool { return parts[i].n < parts[j].n })
+	for _, p := range parts {
+		d.children = append(d.children, s.device(p.dir, d))
+	}
+	for _, h := range readLinks(filepath.Join(dir, "holders")) {
+		if c := s.device(filepath.Join(sysBlock, h), nil); c.size > 0 || *all {
+			d.children = append(d.children, c)
+		}
+	}
+	return d
+}
+
+// findDir returns the sysfs directory of a device or partition given by
+// name or path.
+func findDir(arg string) (string, error) {
+	name := filepath.Base(arg)
+	// Resolve links such as /dev/disk/by-uuid/*.
+	if p, err := filepath.EvalSymlinks(arg); err == nil {
+		name = filepath.Base(p)
+	}
+	dir := filepath.Join(sysBlock, name)
+	if _, err := os.Stat(dir); err == nil {
+		return dir, nil
+	}
+	// A partition, which is under its disk.
+	fis, err := ioutil.ReadDir(sysBlock)
+	if err != nil {
+		return "", err
+	}
+	for _, fi := range fis {
+		p := filepath.Join(sysBlock, fi.Name(), name)
+		if _, err := os.Stat(filepath.Join(p, "partition")); err == nil {
+			return p, nil
+		}
+	}
+	return "", fmt.Errorf("%s: not a block device", arg)
+}
+
+// devices returns the devices given in args, or all top-level devices.
+func (s *scanner) devices(args []string) ([]*device, error) {
+	var devs []*device
+	if len(args) != 0 {
+		for _, a := range args {
+			dir, err := findDir(a)
+			if err != nil {
+				return nil, err
+			}
+			devs = append(devs, s.device(dir, nil))
+		}
+		return devs, nil
+	}
+	fis, err := ioutil.ReadDir(sysBlock)
+	if err != nil {
+		return nil, err
+	}
+	for _, fi := range fis {
+		dir := filepath.Join(sysBlock, fi.Name())
+		// Devices built on others are listed under them.
+		if len(readLinks(filepath.Join(dir, "slaves"))) != 0 {
+			continue
+		}
+		if d := s.device(dir, nil); d.size > 0 || *all {
+			devs = append(devs, d)
+		}
+	}
+	return devs, nil
+}
+
+func cell(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case bool:
+		if v {
+			return "1"
+		}
+		return "0"
+	case []string:
+		return strings.Join(v, ",")
+	}
+	return fmt.Sprint(v)
+}
+
+func printTable(w io.Writer, cols []column, devs []*device) error {
+	var b bytes.Buffer
+	tw := tabwriter.NewWriter(&b, 0, 8, 1, ' ', 0)
+	if !*noHeadings {
+		var h []string
+		for _, c := range cols {
+			h = append(h, c.name)
+		}
+		fmt.Fprintln(tw, strings.Join(h, "\t"))
+	}
+	var row func(d *device, prefix, branch string)
+	row = func(d *device, prefix, branch string) {
+		var cells []string
+		for _, c := range cols {
+			v := cell(c.value(d))
+			if c.name == "NAME" {
+				v = prefix + branch + v
+			}
+			cells = append(cells, v)
+		}
+		fmt.Fprintln(tw, strings.Join(cells, "\t"))
+		if branch == "├─" {
+			prefix += "│ "
+		} else if branch == "└─" {
+			prefix += "  "
+		}
+		for i, c := range d.children {
+			b := "├─"
+			if *list {
+				b = ""
+			} else if i == len(d.children)-1 {
+				b = "└─"
+			}
+			row(c, prefix, b)
+		}
+	}
+	for _, d := range devs {
+		row(d, "", "")
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	// Empty trailing columns leave padding behind.
+	for _, l := range strings.SplitAfter(b.String(), "\n") {
+		if l == "" {
+			continue
+		}
+		if _, err := io.WriteString(w, strings.TrimRight(l, " \n")+"\n"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// jsonDevice writes d as a JSON object with the keys in column order.
+func jsonDevice(b *bytes.Buffer, cols []column, d *device) error {
+	b.WriteByte('{')
+	for i, c := range cols {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		v, err := json.Marshal(c.value(d))
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(b, "%q:%s", strings.ToLower(c.name), v)
+	}
+	if len(d.children) != 0 {
+		b.WriteString(`,"children":[`)
+		for i, c := range d.children {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			if err := jsonDevice(b, cols, c); err != nil {
+				return err
+			}
+		}
+		b.WriteByte(']')
+	}
+	b.WriteByte('}')
+	return nil
+}
+
+func flatten(devs []*device) []*device {
+	var flat []*device
+	for _, d := range devs {
+		c := *d
+		c.children = nil
+		flat = append(flat, &c)
+		flat = append(flat, flatten(d.children)...)
+	}
+	return flat
+}
+
+func printJSON(w io.Writer, cols []column, devs []*device) error {
+	if *list {
+		devs = flatten(devs)
+	}
+	var b bytes.Buffer
+	b.WriteString(`{"blockdevices":[`)
+	for i, d := range devs {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		if err := jsonDevice(&b, cols, d); err != nil {
+			return err
+		}
+	}
+	b.WriteString("]}")
+	var out bytes.Buffer
+	if err := json.Indent(&out, b.Bytes(), "", "   "); err != nil {
+		return err
+	}
+	out.WriteByte('\n')
+	_, err := out.WriteTo(w)
+	return err
+}
+
+func run(w io.Writer, args []string) error {
+	spec := defaultCol
+	if *fs {
+		spec = fsCol
+	}
+	if *output != "" {
+		spec = *output
+	}
+	cols, err := parseColumns(spec)
+	if err != nil {
+		return err
+	}
+	s, err := newScanner(cols)
+	if err != nil {
+		return err
+	}
+	devs, err := s.devices(args)
+	if err != nil {
+		return err
+	}
+	if *asJSON {
+		return printJSON(w, cols, devs)
+	}
+	return printTable(w, cols, devs)
+}
+
+func main() {
+	flag.Parse()
+	if err := run(os.Stdout, flag.Args()); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,185 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func setup(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "lsblk")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sysBlock = filepath.Join(dir, "sys/block")
+	devDir = filepath.Join(dir, "dev")
+	mountinfo = filepath.Join(dir, "mountinfo")
+	procSwaps = filepath.Join(dir, "swaps")
+
+	files := map[string]string{
+		"sys/block/sda/dev":               "8:0",
+		"sys/block/sda/size":              "2097152",
+		"sys/block/sda/removable":         "0",
+		"sys/block/sda/ro":                "0",
+		"sys/block/sda/sda1/dev":          "8:1",
+		"sys/block/sda/sda1/partition":    "1",
+		"sys/block/sda/sda1/size":         "1024000",
+		"sys/block/sda/sda1/ro":           "0",
+		"sys/block/sda/sda2/dev":          "8:2",
+		"sys/block/sda/sda2/partition":    "2",
+		"sys/block/sda/sda2/size":         "1071104",
+		"sys/block/sda/sda2/ro":           "0",
+		"sys/block/sda/sda2/holders/dm-0": "",
+		"sys/block/dm-0/dev":              "253:0",
+		"sys/block/dm-0/size":             "1067008",
+		"sys/block/dm-0/removable":        "0",
+		"sys/block/dm-0/ro":               "0",
+		"sys/block/dm-0/dm/name":          "root",
+		"sys/block/dm-0/dm/uuid":          "CRYPT-LUKS2-0123-root",
+		"sys/block/dm-0/slaves/sda2":      "",
+		"sys/block/sr0/dev":               "11:0",
+		"sys/block/sr0/size":              "2048",
+		"sys/block/sr0/removable":         "1",
+		"sys/block/sr0/ro":                "1",
+		"sys/block/sr0/device/type":       "5",
+		"sys/block/loop0/dev":             "7:0",
+		"sys/block/loop0/size":            "0",
+		"mountinfo":                       "21 1 253:0 / / rw - ext4 /dev/mapper/root rw\n22 21 8:1 / /boot\\040efi rw - vfat /dev/sda1 rw\n",
+		"swaps":                           "Filename\tType\tSize\tUsed\tPriority\n",
+		"dev/dm-0":                        "",
+		"dev/sda2":                        "",
+		"dev/sr0":                         "",
+		"dev/sda":                         "",
+		"dev/sda1":                        "",
+	}
+	for n, v := range files {
+		p := filepath.Join(dir, n)
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(p, []byte(v), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// A FAT32 file system on sda1.
+	bs := make([]byte, 512)
+	binary.LittleEndian.PutUint32(bs[0x43:], 0x12345678)
+	copy(bs[0x47:], "BOOT       FAT32   ")
+	bs[510], bs[511] = 0x55, 0xaa
+	if err := ioutil.WriteFile(filepath.Join(devDir, "sda1"), bs, 0644); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestLsblk(t *testing.T) {
+	dir := setup(t)
+	defer os.RemoveAll(dir)
+
+	for _, tt := range []struct {
+		name  string
+		flags func()
+		args  []string
+		want  string
+	}{
+		{
+			name:  "default",
+			flags: func() {},
+			want: `NAME     MAJ:MIN RM SIZE RO TYPE  MOUNTPOINTS
+sda      8:0     0  1G   0  disk
+├─sda1   8:1     0  500M 0  part  /boot efi
+└─sda2   8:2     0  523M 0  part
+  └─root 253:0   0  521M 0  crypt /
+sr0      11:0    1  1M   1  rom
+`,
+		},
+		{
+			name:  "fs list",
+			flags: func() { *fs, *list, *noHeadings = true, true, true },
+			args:  []string{"sda"},
+			want: `sda
+sda1 vfat BOOT 1234-5678 /boot efi
+sda2
+root                     /
+`,
+		},
+		{
+			name:  "all nodeps",
+			flags: func() { *all, *noDeps, *output = true, true, "KNAME,SIZE" },
+			want: `KNAME SIZE
+loop0 0B
+sda   1G
+sr0   1M
+`,
+		},
+		{
+			name:  "json",
+			flags: func() { *asJSON, *inBytes, *output = true, true, "NAME,SIZE,RO,FSTYPE,MOUNTPOINTS" },
+			args:  []string{"/dev/sda1", "sr0"},
+			want: `{
+   "blockdevices": [
+      {
+         "name": "sda1",
+         "size": 524288000,
+         "ro": false,
+         "fstype": "vfat",
+         "mountpoints": [
+            "/boot efi"
+         ]
+      },
+      {
+         "name": "sr0",
+         "size": 1048576,
+         "ro": true,
+         "fstype": null,
+         "mountpoints": []
+      }
+   ]
+}
+`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			*all, *inBytes, *noDeps, *fs, *asJSON, *list, *noHeadings, *output = false, false, false, false, false, false, false, ""
+			tt.flags()
+			var out bytes.Buffer
+			if err := run(&out, tt.args); err != nil {
+				t.Fatal(err)
+			}
+			if out.String() != tt.want {
+				t.Errorf("got:\n%s\nwant:\n%s", out.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestHumanSize(t *testing.T) {
+	for n, want := range map[uint64]string{
+		0:             "0B",
+		1023:          "1023B",
+		1024:          "1K",
+		1536:          "1.5K",
+		500107862016:  "465.8G",
+		8 << 30:       "8G",
+		2000398934016: "1.8T",
+	} {
+		if got := humanSize(n); got != want {
+			t.Errorf("humanSize(%d) = %q, want %q", n, got, want)
+		}
+	}
+}
+
+func TestUnknownColumn(t *testing.T) {
+	if _, err := parseColumns("NAME,BOGUS"); err == nil || !strings.Contains(err.Error(), "BOGUS") {
+		t.Errorf("parseColumns(NAME,BOGUS) = %v, want error about BOGUS", err)
+	}
+}
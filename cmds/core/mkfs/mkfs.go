@@ -0,0 +1,150 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// mkfs creates ext4 and FAT32 file systems.
+//
+// Synopsis:
+//     mkfs [-t TYPE] [OPTIONS] DEVICE
+//     mkfs.ext4 [-L LABEL] [-U UUID] [-b BLOCKSIZE] [-i BYTES] [-m PERCENT] DEVICE
+//     mkfs.vfat [-n LABEL] [-i VOLID] [-s SECTORS] [-F 32] DEVICE
+//
+// Description:
+//     mkfs creates an empty file system on DEVICE, usually a partition, over
+//     all of it. DEVICE may also be an image, which must have its final
+//     size.
+//
+//     TYPE is ext4, the default, or vfat. Called as mkfs.ext4, mkfs.vfat
+//     or mkfs.fat, e.g. through a symlink, mkfs creates that type.
+//
+//     The ext4 file system has no journal, and the extents and sparse_super
+//     features.
+//
+// Options for ext4:
+//     -L: volume label, up to 16 bytes
+//     -U: UUID (default: random)
+//     -b: block size: 1024, 2048 or 4096 (default: 4096, 1024 below 512 MiB)
+//     -i: bytes per inode (default: 16384)
+//     -m: percentage of blocks reserved for root (default: 5)
+//
+// Options for vfat:
+//     -n: volume label, up to 11 characters
+//     -i: volume ID, in hex (default: random)
+//     -s: sectors per cluster (default: by size)
+//     -F: FAT size, only 32 is supported
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/u-root/u-root/pkg/mkfs"
+)
+
+// mkfunc creates a file system of size bytes on f.
+type mkfunc func(f *os.File, size int64) error
+
+func ext4Flags(fs *flag.FlagSet) func() (mkfunc, error) {
+	var (
+		label   = fs.String("L", "", "volume label")
+		uuid    = fs.String("U", "", "UUID")
+		bs      = fs.Int("b", 0, "block size")
+		ratio   = fs.Int("i", 0, "bytes per inode")
+		percent = fs.Int("m", 5, "percentage of blocks reserved for root")
+	)
+	return func() (mkfunc, error) {
+		o := &mkfs.Ext4Options{Label: *label, BlockSize: *bs, InodeRatio: *ratio, ReservedPercent: *percent}
+		if *uuid != "" {
+			u, err := mkfs.ParseUUID(*uuid)
+			if err != nil {
+				return nil, err
+			}
+			o.UUID = u
+		}
+		return func(f *os.File, size int64) error { return mkfs.Ext4(f, size, o) }, nil
+	}
+}
+
+func fatFlags(fs *flag.FlagSet) func() (mkfunc, error) {
+	var (
+		label = fs.String("n", "", "volume label")
+		id    = fs.String("i", "", "volume ID, in hex")
+		spc   = fs.Int("s", 0, "sectors per cluster")
+		bits  = fs.Int("F", 32, "FAT size")
+	)
+	return func() (mkfunc, error) {
+		if *bits != 32 {
+			return nil, fmt.Errorf("FAT%d is not supported, only FAT32", *bits)
+		}
+		o := &mkfs.FATOptions{Label: *label, SectorsPerCluster: *spc}
+		if *id != "" {
+			n, err := strconv.ParseUint(strings.Replace(*id, "-", "", 1), 16, 32)
+			if err != nil {
+				return nil, fmt.Errorf("invalid volume ID %q", *id)
+			}
+			o.VolumeID = uint32(n)
+		}
+		return func(f *os.File, size int64) error { return mkfs.FAT32(f, size, o) }, nil
+	}
+}
+
+var types = map[string]func(*flag.FlagSet) func() (mkfunc, error){
+	"ext4": ext4Flags,
+	"vfat": fatFlags,
+	"fat":  fatFlags,
+}
+
+// run runs mkfs called as name.
+func run(name string, args []string) error {
+	typ := "ext4"
+	if i := strings.IndexByte(name, '.'); i >= 0 {
+		typ = name[i+1:]
+	} else if len(args) >= 2 && args[0] == "-t" {
+		typ, args = args[1], args[2:]
+	}
+	flags, ok := types[typ]
+	if !ok {
+		return fmt.Errorf("unsupported file system type %q", typ)
+	}
+	fs := flag.NewFlagSet("mkfs."+typ, flag.ContinueOnError)
+	options := flags(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return fmt.Errorf("need a device")
+	}
+	mk, err := options()
+	if err != nil {
+		return err
+	}
+
+	dev := fs.Arg(0)
+	f, err := os.OpenFile(dev, os.O_RDWR, 0)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	// This is the size of both block devices and images.
+	size, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+	if err := mk(f, size); err != nil {
+		return fmt.Errorf("%s: %v", dev, err)
+	}
+	return f.Sync()
+}
+
+func main() {
+	if err := run(filepath.Base(os.Args[0]), os.Args[1:]); err != nil {
+		log.Fatal(err)
+	}
+}
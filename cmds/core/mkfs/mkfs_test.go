@@ -0,0 +1,62 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/u-root/u-root/pkg/blkid"
+)
+
+func TestRun(t *testing.T) {
+	f, err := ioutil.TempFile("", "mkfs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+	if err := f.Truncate(64 << 20); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		name string
+		args []string
+		want blkid.Info
+	}{
+		{"mkfs", []string{"-L", "root", "-U", "0fc63daf-8483-4772-8e79-3d69d8477de4", f.Name()}, blkid.Info{Type: "ext4", Label: "root", UUID: "0fc63daf-8483-4772-8e79-3d69d8477de4"}},
+		{"mkfs", []string{"-t", "vfat", "-n", "EFI", "-i", "1234ABCD", f.Name()}, blkid.Info{Type: "vfat", Label: "EFI", UUID: "1234-ABCD"}},
+		{"mkfs.ext4", []string{"-b", "4096", "-L", "data", "-U", "0fc63daf-8483-4772-8e79-3d69d8477de4", f.Name()}, blkid.Info{Type: "ext4", Label: "data", UUID: "0fc63daf-8483-4772-8e79-3d69d8477de4"}},
+		{"mkfs.fat", []string{"-F", "32", "-i", "dead-beef", f.Name()}, blkid.Info{Type: "vfat", UUID: "DEAD-BEEF"}},
+	} {
+		if err := run(tt.name, tt.args); err != nil {
+			t.Errorf("%s %q: %v", tt.name, tt.args, err)
+			continue
+		}
+		i, err := blkid.ProbeFile(f.Name())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if *i != tt.want {
+			t.Errorf("%s %q: blkid = %+v, want %+v", tt.name, tt.args, i, tt.want)
+		}
+	}
+
+	for _, tt := range []struct {
+		name string
+		args []string
+	}{
+		{"mkfs", []string{"-t", "xfs", f.Name()}},
+		{"mkfs.vfat", []string{"-F", "16", f.Name()}},
+		{"mkfs.ext4", []string{"-U", "nope", f.Name()}},
+		{"mkfs.ext4", nil},
+	} {
+		if err := run(tt.name, tt.args); err == nil {
+			t.Errorf("%s %q succeeded", tt.name, tt.args)
+		}
+	}
+}
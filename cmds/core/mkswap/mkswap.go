@@ -0,0 +1,84 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// mkswap makes a swap area.
+//
+// Synopsis:
+//     mkswap [-L LABEL] [-U UUID] [-p PAGESIZE] DEVICE
+//
+// Description:
+//     mkswap writes a version 1 swap area header on DEVICE, a partition, a
+//     zram device or a file, for swapon. A file must have its final size,
+//     and no holes.
+//
+// Options:
+//     -L: label, up to 16 bytes
+//     -U: UUID (default: random)
+//     -p: page size of the kernel which is to use the area (default: the
+//         running kernel's)
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/u-root/u-root/pkg/blkid"
+	"github.com/u-root/u-root/pkg/mkfs"
+)
+
+var (
+	label    = flag.String("L", "", "label")
+	uuid     = flag.String("U", "", "UUID")
+	pageSize = flag.Int("p", 0, "page size")
+)
+
+func run(w io.Writer, dev string) error {
+	o := &mkfs.SwapOptions{Label: *label, PageSize: *pageSize}
+	if *uuid != "" {
+		u, err := mkfs.ParseUUID(*uuid)
+		if err != nil {
+			return err
+		}
+		o.UUID = u
+	}
+	f, err := os.OpenFile(dev, os.O_RDWR, 0)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	size, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+	if err := mkfs.Swap(f, size, o); err != nil {
+		return fmt.Errorf("%s: %v", dev, err)
+	}
+	if err := f.Sync(); err != nil {
+		return err
+	}
+	i, err := blkid.Probe(f)
+	if err != nil {
+		return err
+	}
+	l := "no label"
+	if i.Label != "" {
+		l = "LABEL=" + i.Label
+	}
+	fmt.Fprintf(w, "Setting up swapspace version 1, size = %d KiB\n%s, UUID=%s\n", size>>10, l, i.UUID)
+	return nil
+}
+
+func main() {
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(1)
+	}
+	if err := run(os.Stdout, flag.Arg(0)); err != nil {
+		log.Fatal(err)
+	}
+}
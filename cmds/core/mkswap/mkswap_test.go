@@ -0,0 +1,39 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	f, err := ioutil.TempFile("", "mkswap")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+	if err := f.Truncate(1 << 20); err != nil {
+		t.Fatal(err)
+	}
+
+	*label, *uuid, *pageSize = "swap0", "0657fd6d-a4ab-43c4-84e5-0933c84b4f4f", 4096
+	var b bytes.Buffer
+	if err := run(&b, f.Name()); err != nil {
+		t.Fatal(err)
+	}
+	want := "Setting up swapspace version 1, size = 1024 KiB\nLABEL=swap0, UUID=0657fd6d-a4ab-43c4-84e5-0933c84b4f4f\n"
+	if b.String() != want {
+		t.Errorf("mkswap printed %q, want %q", b.String(), want)
+	}
+
+	*uuid = "not a UUID"
+	if err := run(&b, f.Name()); err == nil {
+		t.Errorf("mkswap -U %q succeeded", *uuid)
+	}
+}
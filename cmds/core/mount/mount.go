@@ -8,9 +8,47 @@
 //
 // Synopsis:
 //     mount [-r] [-o options] [-t FSTYPE] DEV PATH
+//     mount -a [-fstab FILE] [-r] [-o options]
+//     mount -bind|-rbind|-move [-r] OLDPATH PATH
+//     mount -o remount[,options] PATH
+//     mount -o shared|private|slave|unbindable|rshared|... PATH
+//     mount [-json]
+//
+// Description:
+//     Without arguments, mount lists the mounts of the namespace.
+//
+//     DEV may also be given as LABEL=label, UUID=uuid or PARTUUID=uuid, as
+//     in fstab.
+//
+//     Without -t, the file system type is detected from the superblock of
+//     DEV.
+//
+//     -o loop sets up a loop device for the file DEV, honoring the offset=
+//     and sizelimit= options.
+//
+//     For -t cifs, the credentials=FILE option is read, the password is
+//     asked for if there is a user but none, and the server is resolved, as
+//     mount.cifs does.
+//
+//     For -t overlay, the lowerdir, upperdir and workdir options are
+//     checked before mounting, and workdir is created if needed.
+//
+//     -o bind, rbind, move and remount are done as mount(8) does them, and
+//     the propagation options change the propagation of the mount at PATH,
+//     after mounting DEV if one is given.
+//
+//     With -a, mount mounts the file systems listed in /etc/fstab, mount
+//     points below others after them, skipping swap, noauto and already
+//     mounted entries. Entries with nofail do not fail the command.
 //
 // Options:
-//     -r: read only
+//     -r:     read only
+//     -a:     mount all file systems of the fstab file
+//     -fstab: the fstab file used by -a
+//     -bind:  same as -o bind
+//     -rbind: same as -o rbind
+//     -move:  same as -o move
+//     -json:  list mounts as JSON
 package main
 
 import (
@@ -19,11 +57,15 @@ import (
 	"io/ioutil"
 	"log"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/u-root/u-root/pkg/mount"
+	"github.com/u-root/u-root/pkg/mount/block"
 	"github.com/u-root/u-root/pkg/mount/loop"
+	"github.com/u-root/u-root/pkg/output"
 	"golang.org/x/sys/unix"
+	"golang.org/x/term"
 )
 
 type mountOptions []string
@@ -42,6 +84,12 @@ func (o *mountOptions) Set(value string) error {
 var (
 	ro      = flag.Bool("r", false, "Read only mount")
 	fsType  = flag.String("t", "", "File system type")
+	jsonOut = flag.Bool(output.FlagName, false, "List mounts as JSON")
+	all     = flag.Bool("a", false, "Mount all file systems of the fstab file, except noauto ones")
+	fstab   = flag.String("fstab", "/etc/fstab", "fstab file used by -a")
+	bind    = flag.Bool("bind", false, "Bind mount DEV at PATH, same as -o bind")
+	rbind   = flag.Bool("rbind", false, "Bind mount DEV and the mounts below it at PATH, same as -o rbind")
+	move    = flag.Bool("move", false, "Move the mount at DEV to PATH, same as -o move")
 	options mountOptions
 )
 
@@ -49,15 +97,23 @@ func init() {
 	flag.Var(&options, "o", "Comma separated list of mount options")
 }
 
-func loopSetup(filename string) (loopDevice string, err error) {
-	loopDevice, err = loop.FindDevice()
-	if err != nil {
-		return "", err
-	}
-	if err := loop.SetFile(loopDevice, filename); err != nil {
-		return "", err
+// loopOptions returns the parameters of the loop device of a loop mount
+// from the offset= and sizelimit= options.
+func loopOptions(options []string, ro bool) (loop.Options, error) {
+	o := loop.Options{ReadOnly: ro}
+	for _, option := range options {
+		var err error
+		switch {
+		case strings.HasPrefix(option, "offset="):
+			o.Offset, err = strconv.ParseUint(option[len("offset="):], 0, 64)
+		case strings.HasPrefix(option, "sizelimit="):
+			o.SizeLimit, err = strconv.ParseUint(option[len("sizelimit="):], 0, 64)
+		}
+		if err != nil {
+			return o, fmt.Errorf("invalid loop option %q", option)
+		}
 	}
-	return loopDevice, nil
+	return o, nil
 }
 
 // extended from boot.go
@@ -95,35 +151,69 @@ func informIfUnknownFS(originFS string) {
 	}
 }
 
-func main() {
-	if len(os.Args) == 1 {
-		n := []string{"/proc/self/mounts", "/proc/mounts", "/etc/mtab"}
-		for _, p := range n {
-			if b, err := ioutil.ReadFile(p); err == nil {
-				fmt.Print(string(b))
-				os.Exit(0)
+// listMounts prints the mounts of the namespace, as the kernel lists them or
+// as JSON.
+func listMounts() error {
+	n := []string{"/proc/self/mounts", "/proc/mounts", "/etc/mtab"}
+	for _, p := range n {
+		b, err := ioutil.ReadFile(p)
+		if err != nil {
+			continue
+		}
+		if !*jsonOut {
+			_, err := os.Stdout.Write(b)
+			return err
+		}
+		t := output.NewTable("Device", "Mount point", "Type", "Options")
+		for _, l := range strings.Split(string(b), "\n") {
+			f := strings.Fields(l)
+			if len(f) < 4 {
+				continue
 			}
+			t.Add(f[0], f[1], f[2], strings.Split(f[3], ","))
 		}
-		log.Fatalf("Could not read %s to get namespace", n)
-	}
-	flag.Parse()
-	if len(flag.Args()) < 2 {
-		flag.Usage()
-		os.Exit(1)
+		return t.Write(os.Stdout, true)
 	}
-	a := flag.Args()
-	dev := a[0]
-	path := a[1]
+	return fmt.Errorf("could not read %s to get namespace", n)
+}
+
+// ignoredOptions are fstab options that are not for the kernel.
+var ignoredOptions = map[string]bool{
+	"defaults": true,
+	"auto":     true,
+	"noauto":   true,
+	"nofail":   true,
+	"user":     true,
+	"nouser":   true,
+	"users":    true,
+	"_netdev":  true,
+}
+
+// isLoopOption returns whether option is for the loop device of a loop
+// mount rather than for the file system.
+func isLoopOption(option string) bool {
+	return strings.HasPrefix(option, "offset=") || strings.HasPrefix(option, "sizelimit=")
+}
+
+// mountOne mounts dev at path. An empty fsType tries all known file systems.
+func mountOne(dev, path, fsType string, options []string, ro bool) error {
 	var flags uintptr
 	var data []string
 	var err error
+	var isLoop bool
+	if dev, err = block.Resolve(dev); err != nil {
+		return err
+	}
 	for _, option := range options {
-		switch option {
-		case "loop":
-			dev, err = loopSetup(dev)
-			if err != nil {
-				log.Fatal("Error setting loop device:", err)
-			}
+		switch {
+		case option == "loop":
+			isLoop = true
+		case isLoopOption(option):
+		case option == "ro":
+			flags |= unix.MS_RDONLY
+		case option == "rw":
+			flags &^= unix.MS_RDONLY
+		case ignoredOptions[option], strings.HasPrefix(option, "x-"):
 		default:
 			if f, ok := opts[option]; ok {
 				flags |= f
@@ -132,18 +222,162 @@ func main() {
 			}
 		}
 	}
-	if *ro {
+	if ro {
 		flags |= unix.MS_RDONLY
 	}
-	if *fsType == "" {
-		if _, err := mount.TryMount(dev, path, strings.Join(data, ","), flags); err != nil {
-			log.Fatalf("%v", err)
+	if isLoop {
+		o, err := loopOptions(options, flags&unix.MS_RDONLY != 0)
+		if err != nil {
+			return err
 		}
-	} else {
-		if _, err := mount.Mount(dev, path, *fsType, strings.Join(data, ","), flags); err != nil {
-			log.Printf("%v", err)
-			informIfUnknownFS(*fsType)
-			os.Exit(1)
+		if dev, err = loop.Attach(dev, o); err != nil {
+			return fmt.Errorf("error setting loop device: %v", err)
 		}
 	}
+	// Propagation is changed by a mount call of its own, after the others.
+	prop := flags & mount.Propagation
+	if prop != 0 {
+		prop |= flags & mount.MS_REC
+		flags &^= mount.Propagation
+	}
+	switch {
+	case flags&mount.MS_MOVE != 0:
+		err = mount.MoveMount(dev, path)
+	case flags&mount.MS_REMOUNT != 0:
+		err = mount.Remount(path, strings.Join(data, ","), flags&^mount.MS_REMOUNT)
+	case flags&mount.MS_BIND != 0:
+		_, err = mount.BindMount(dev, path, flags&^mount.MS_BIND)
+	case dev == "" && prop != 0:
+	case dev == "":
+		return fmt.Errorf("no device to mount on %s", path)
+	case fsType == "cifs" || fsType == "smb3":
+		if data, err = mount.CIFSOptions(dev, data, askPassword); err == nil {
+			_, err = mount.Mount(dev, path, fsType, strings.Join(data, ","), flags)
+		}
+	case fsType == "overlay":
+		err = mountOverlay(path, data, flags)
+	case fsType == "":
+		_, err = mount.TryMount(dev, path, strings.Join(data, ","), flags)
+	default:
+		if _, err = mount.Mount(dev, path, fsType, strings.Join(data, ","), flags); err != nil {
+			informIfUnknownFS(fsType)
+		}
+	}
+	if err != nil && isLoop {
+		loop.Detach(dev) //nolint:errcheck
+	}
+	if err != nil || prop == 0 {
+		return err
+	}
+	return mount.SetPropagation(path, prop)
+}
+
+// askPassword asks for the password of user on the terminal.
+func askPassword(user string) (string, error) {
+	fmt.Fprintf(os.Stderr, "Password for %s: ", user)
+	defer fmt.Fprintln(os.Stderr)
+	p, err := term.ReadPassword(int(os.Stdin.Fd()))
+	return string(p), err
+}
+
+// mountOverlay mounts an overlay file system at path, after checking the
+// lowerdir, upperdir and workdir options. The workdir is created if needed.
+func mountOverlay(path string, options []string, flags uintptr) error {
+	o, rest, err := mount.ParseOverlayOptions(options)
+	if err != nil {
+		return err
+	}
+	o.CreateWork = true
+	_, err = o.MountData(path, strings.Join(rest, ","), flags)
+	return err
+}
+
+// mounted returns the set of mount points of the namespace.
+func mounted() map[string]bool {
+	m := map[string]bool{}
+	b, err := ioutil.ReadFile("/proc/self/mounts")
+	if err != nil {
+		return m
+	}
+	for _, l := range strings.Split(string(b), "\n") {
+		if f := strings.Fields(l); len(f) > 1 {
+			m[f[1]] = true
+		}
+	}
+	return m
+}
+
+// mountAll mounts the file systems of the fstab file, parents first, except
+// swap, noauto and already mounted ones. Failures of nofail entries are only
+// logged.
+func mountAll(fstab string) error {
+	entries, err := mount.ReadFstab(fstab)
+	if err != nil {
+		return err
+	}
+	mount.SortFstab(entries)
+	done := mounted()
+	var failed bool
+	for _, e := range entries {
+		if e.IsSwap() || e.HasOption("noauto") || done[e.File] {
+			continue
+		}
+		fsType := e.VfsType
+		if fsType == "auto" {
+			fsType = ""
+		}
+		o := append(e.Options, options...)
+		if err := mountOne(e.Spec, e.File, fsType, o, *ro); err != nil {
+			log.Printf("mounting %s on %s: %v", e.Spec, e.File, err)
+			if !e.HasOption("nofail") {
+				failed = true
+			}
+			continue
+		}
+		done[e.File] = true
+	}
+	if failed {
+		return fmt.Errorf("some file systems of %s could not be mounted", fstab)
+	}
+	return nil
+}
+
+func main() {
+	flag.Parse()
+	if *all {
+		if err := mountAll(*fstab); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	if flag.NArg() == 0 {
+		if err := listMounts(); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	if *bind {
+		options = append(options, "bind")
+	}
+	if *rbind {
+		options = append(options, "rbind")
+	}
+	if *move {
+		options = append(options, "move")
+	}
+	// The device may be left out of remounts and propagation changes.
+	a := flag.Args()
+	var dev, path string
+	switch len(a) {
+	case 1:
+		path = a[0]
+	case 2:
+		dev, path = a[0], a[1]
+	default:
+		flag.Usage()
+		os.Exit(1)
+	}
+	if err := mountOne(dev, path, *fsType, options, *ro); err != nil {
+		log.Fatal(err)
+	}
 }
@@ -31,10 +31,15 @@ var opts = map[string]uintptr{
 	//"nouser":       unix.MS_NOUSER,
 	"posixacl":    unix.MS_POSIXACL,
 	"private":     unix.MS_PRIVATE,
+	"rbind":       unix.MS_BIND | unix.MS_REC,
 	"rdonly":      unix.MS_RDONLY,
 	"rec":         unix.MS_REC,
 	"relatime":    unix.MS_RELATIME,
 	"remount":     unix.MS_REMOUNT,
+	"rprivate":    unix.MS_PRIVATE | unix.MS_REC,
+	"rshared":     unix.MS_SHARED | unix.MS_REC,
+	"rslave":      unix.MS_SLAVE | unix.MS_REC,
+	"runbindable": unix.MS_UNBINDABLE | unix.MS_REC,
 	"rmt_mask":    unix.MS_RMT_MASK,
 	"shared":      unix.MS_SHARED,
 	"silent":      unix.MS_SILENT,
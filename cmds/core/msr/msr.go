@@ -25,6 +25,11 @@
 //     As a special convenience, we have two useful cases:
 //     r glob register -- read the MSR 'register' from cores matching 'glob'
 //     w glob register value -- write the value to 'register' on all cores matching 'glob'
+//     d glob register -- read 'register' and decode its bit fields, core by core
+//     names -- list the MSRs known by name
+//
+//     Registers may be given by address or by the name of a known MSR,
+//     e.g. IA32_FEATURE_CONTROL or platform_info.
 //
 // Examples:
 //     Show the IA32 feature MSR on all cores
@@ -35,13 +40,20 @@
 //     Just see it one core 0 and 1
 //     sudo ./fio '[01]' msr 0x3a reg rd
 //     [[5 5]]
+//     Decode the platform info on core 0
+//     sudo msr d 0 platform_info
+//     cpu0: MSR_PLATFORM_INFO (0xce) = 0x80838f3012800
+//       [15:8] Maximum Non-Turbo Ratio: 0x28
+//       ...
 package main
 
 import (
 	"flag"
 	"fmt"
 	"log"
+	"os"
 	"strconv"
+	"text/tabwriter"
 
 	"github.com/u-root/u-root/pkg/forth"
 	"github.com/u-root/u-root/pkg/msr"
@@ -126,11 +138,11 @@ func cpus(f forth.Forth) {
 }
 
 func reg(f forth.Forth) {
-	n, err := strconv.ParseUint(f.Pop().(string), 0, 32)
+	n, err := msr.ParseMSR(f.Pop().(string))
 	if err != nil {
 		panic(fmt.Sprintf("%v", err))
 	}
-	f.Push(msr.MSR(n))
+	f.Push(n)
 }
 
 func u64(f forth.Forth) {
@@ -214,6 +226,43 @@ func or(f forth.Forth) {
 	f.Push(m)
 }
 
+// decode reads register r on the cores matching glob g and prints its
+// fields. Unknown MSRs are printed as a plain value.
+func decode(g, r string) error {
+	m, err := msr.ParseMSR(r)
+	if err != nil {
+		return err
+	}
+	c, errs := msr.GlobCPUs(g)
+	if errs != nil {
+		return fmt.Errorf("%v", errs)
+	}
+	if len(c) == 0 {
+		return fmt.Errorf("no CPUs match %q", g)
+	}
+	vals, errs := m.Read(c)
+	if errs != nil {
+		return fmt.Errorf("%v", errs)
+	}
+	reg, ok := msr.Lookup(m)
+	for i, v := range vals {
+		if ok {
+			fmt.Printf("cpu%d: %s", c[i], reg.Decode(v))
+		} else {
+			fmt.Printf("cpu%d: %v = %#x\n", c[i], m, v)
+		}
+	}
+	return nil
+}
+
+func names() {
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 1, ' ', 0)
+	for _, r := range msr.Registers {
+		fmt.Fprintf(w, "%v\t%s\n", r.Addr, r.Name)
+	}
+	w.Flush()
+}
+
 func main() {
 	flag.Parse()
 	if *debug {
@@ -238,7 +287,21 @@ func main() {
 	// If the first arg is r or w, we're going to assume they're not doing Forth.
 	// It is too confusing otherwise if they type a wrong r or w command and
 	// see the Forth stack and nothing else.
+	if len(a) == 0 {
+		log.Fatal("Usage: msr r|w|d|lock|names ... or msr forth-word ...")
+	}
 	switch a[0] {
+	case "d":
+		if len(a) != 3 {
+			log.Fatal("Usage for d: d <msr-glob> <register>")
+		}
+		if err := decode(a[1], a[2]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	case "names":
+		names()
+		return
 	case "r":
 		if len(a) != 3 {
 			log.Fatal("Usage for r: r <msr-glob> <register>")
@@ -3,72 +3,360 @@
 // license that can be found in the LICENSE file.
 
 // netcat creates arbitrary TCP and UDP connections and listens and sends arbitrary data.
+//
+// Synopsis:
+//     netcat [OPTIONS] ADDRESS
+//     netcat [OPTIONS] HOST PORT
+//     netcat -l [OPTIONS] ADDRESS
+//     netcat -z [-v] [-w SECONDS] HOST PORT[-PORT]
+//
+// Description:
+//     netcat connects to ADDRESS, e.g. 10.0.2.2:8080 or a Unix socket
+//     path, or with -l waits for a connection on it, e.g. :8080, and then
+//     copies stdin to the connection and the connection to stdout, until
+//     the peer closes it.
+//
+//     With -u, datagrams are sent and received. Listening, the first
+//     datagram chooses the peer.
+//
+//     With -e, the connection is the stdin and stdout of a command
+//     instead, e.g. netcat -l -k -e /bin/sh :2222 for a remote shell.
+//
+//     With -ssl, the connection uses TLS. Servers are not verified unless
+//     -ssl-verify or -ssl-trustfile is given. Listening, a self-signed
+//     certificate is generated unless -ssl-cert and -ssl-key are given,
+//     and with -ssl-trustfile, clients need a certificate signed by one
+//     of its CAs.
+//
+//     With -z, netcat only reports which TCP ports of HOST are open, and
+//     fails if none is.
+//
+// Options:
+//     -net:           network: tcp, tcp4, tcp6, udp, unix... (default: tcp)
+//     -u:             UDP, like -net udp
+//     -U:             Unix socket, like -net unix
+//     -l:             listen for a connection
+//     -k:             keep listening for connections after the first one
+//     -e:             run this command for connections
+//     -z:             scan ports
+//     -w:             timeout for connections and scans, in seconds
+//     -v:             verbose output
+//     -ssl:           use TLS
+//     -ssl-cert:      PEM certificate to present
+//     -ssl-key:       PEM private key of -ssl-cert
+//     -ssl-trustfile: PEM CA certificates to verify peers with
+//     -ssl-verify:    verify servers with the system's CA certificates
 package main
 
 import (
+	"crypto/tls"
 	"flag"
 	"fmt"
 	"io"
 	"log"
 	"net"
 	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/u-root/u-root/pkg/uroot/util"
+	"golang.org/x/term"
 )
 
-const usage = "netcat [go-style network address]"
+const usage = "netcat [-l] [-u|-U] [-k] [-e CMD] [-ssl] [-z] [-v] [-w SECONDS] {ADDRESS|HOST PORT}"
 
 var (
-	netType = flag.String("net", "tcp", "What net type to use, e.g. tcp, unix, etc.")
-	listen  = flag.Bool("l", false, "Listen for connections.")
-	verbose = flag.Bool("v", false, "Verbose output.")
+	netType   = flag.String("net", "tcp", "What net type to use, e.g. tcp, unix, etc.")
+	udp       = flag.Bool("u", false, "Use UDP.")
+	unix      = flag.Bool("U", false, "Use a Unix socket.")
+	listen    = flag.Bool("l", false, "Listen for connections.")
+	keep      = flag.Bool("k", false, "Keep listening for connections after the first one.")
+	execCmd   = flag.String("e", "", "Run this command for connections.")
+	scan      = flag.Bool("z", false, "Only report which ports are open.")
+	timeout   = flag.Int("w", 0, "Timeout for connections and scans, in seconds.")
+	verbose   = flag.Bool("v", false, "Verbose output.")
+	useTLS    = flag.Bool("ssl", false, "Use TLS.")
+	certFile  = flag.String("ssl-cert", "", "PEM certificate to present.")
+	keyFile   = flag.String("ssl-key", "", "PEM private key of -ssl-cert.")
+	trustFile = flag.String("ssl-trustfile", "", "PEM CA certificates to verify peers with.")
+	verify    = flag.Bool("ssl-verify", false, "Verify servers with the system's CA certificates.")
 )
 
 func init() {
 	util.Usage(usage)
 }
 
-func main() {
-	var c net.Conn
-	var err error
-	if flag.Parse(); len(flag.Args()) != 1 {
-		flag.Usage()
-		os.Exit(1)
+// network returns the network of -net, -u and -U.
+func network() (string, error) {
+	switch {
+	case *udp && *unix:
+		return "", fmt.Errorf("-u and -U are exclusive")
+	case *udp:
+		return "udp", nil
+	case *unix:
+		return "unix", nil
 	}
+	return *netType, nil
+}
 
-	addr := flag.Args()[0]
+// isPacket reports whether the network sends datagrams.
+func isPacket(network string) bool {
+	return strings.HasPrefix(network, "udp") || network == "unixgram" || strings.HasPrefix(network, "ip")
+}
 
-	if *listen {
-		ln, err := net.Listen(*netType, addr)
-		if err != nil {
-			log.Fatalln(err)
-		}
-		if *verbose {
-			fmt.Fprintln(os.Stderr, "Listening on", ln.Addr())
-		}
+// verbosef prints to stderr with -v.
+func verbosef(format string, v ...interface{}) {
+	if *verbose {
+		fmt.Fprintf(os.Stderr, format+"\n", v...)
+	}
+}
+
+// wait returns the duration of -w, or d if there is none.
+func wait(d time.Duration) time.Duration {
+	if *timeout > 0 {
+		return time.Duration(*timeout) * time.Second
+	}
+	return d
+}
+
+// parsePorts parses a port or an inclusive range of them, e.g. 20-25.
+func parsePorts(s string) (int, int, error) {
+	from, to := s, s
+	if i := strings.IndexByte(s, '-'); i >= 0 {
+		from, to = s[:i], s[i+1:]
+	}
+	lo, err := strconv.ParseUint(from, 10, 16)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid port %q", from)
+	}
+	hi, err := strconv.ParseUint(to, 10, 16)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid port %q", to)
+	}
+	if lo > hi {
+		return 0, 0, fmt.Errorf("invalid port range %q", s)
+	}
+	return int(lo), int(hi), nil
+}
 
-		c, err = ln.Accept()
+// scanPorts tries to connect to the ports of host and reports the open
+// ones, and fails if none is.
+func scanPorts(network, host, ports string) error {
+	if isPacket(network) || network == "unix" {
+		return fmt.Errorf("-z only scans TCP ports")
+	}
+	lo, hi, err := parsePorts(ports)
+	if err != nil {
+		return err
+	}
+	var open int
+	for p := lo; p <= hi; p++ {
+		addr := net.JoinHostPort(host, strconv.Itoa(p))
+		c, err := net.DialTimeout(network, addr, wait(time.Second))
 		if err != nil {
-			log.Fatalln(err)
+			verbosef("%s: %v", addr, err)
+			continue
 		}
-	} else {
-		if c, err = net.Dial(*netType, addr); err != nil {
-			log.Fatalln(err)
+		c.Close()
+		open++
+		verbosef("Connection to %s succeeded", addr)
+	}
+	if open == 0 {
+		return fmt.Errorf("no open port on %s in %s", host, ports)
+	}
+	return nil
+}
+
+// packetConn is the connection with the first peer which sent a datagram
+// to a listening socket.
+type packetConn struct {
+	net.PacketConn
+	peer  net.Addr
+	first []byte
+}
+
+func acceptPacket(pc net.PacketConn) (*packetConn, error) {
+	b := make([]byte, 65536)
+	n, peer, err := pc.ReadFrom(b)
+	if err != nil {
+		return nil, err
+	}
+	return &packetConn{PacketConn: pc, peer: peer, first: b[:n]}, nil
+}
+
+// Read reads the datagrams of the peer, and drops those of others.
+func (c *packetConn) Read(b []byte) (int, error) {
+	if c.first != nil {
+		n := copy(b, c.first)
+		c.first = nil
+		return n, nil
+	}
+	for {
+		n, addr, err := c.ReadFrom(b)
+		if err != nil || addr.String() == c.peer.String() {
+			return n, err
 		}
 	}
-	if *verbose {
-		fmt.Fprintln(os.Stderr, "Connected to", c.RemoteAddr())
+}
+
+func (c *packetConn) Write(b []byte) (int, error) {
+	return c.WriteTo(b, c.peer)
+}
+
+func (c *packetConn) RemoteAddr() net.Addr {
+	return c.peer
+}
+
+// conn is a connection of either mode.
+type conn interface {
+	io.ReadWriteCloser
+	RemoteAddr() net.Addr
+}
+
+// serve runs -e for c, or copies stdin to c and c to stdout.
+func serve(c conn) error {
+	defer c.Close()
+	verbosef("Connected to %s", c.RemoteAddr())
+	defer verbosef("Disconnected")
+
+	if *execCmd != "" {
+		args := strings.Fields(*execCmd)
+		cmd := exec.Command(args[0], args[1:]...)
+		cmd.Stdin, cmd.Stdout, cmd.Stderr = c, c, os.Stderr
+		return cmd.Run()
 	}
 
+	sent := make(chan struct{})
 	go func() {
+		defer close(sent)
 		if _, err := io.Copy(c, os.Stdin); err != nil {
 			fmt.Fprintln(os.Stderr, err)
 		}
+		// Tell the peer there is nothing more, and keep reading its
+		// reply.
+		if cw, ok := c.(interface{ CloseWrite() error }); ok {
+			cw.CloseWrite()
+		}
 	}()
-	if _, err = io.Copy(os.Stdout, c); err != nil {
-		fmt.Fprintln(os.Stderr, err)
+	_, err := io.Copy(os.Stdout, c)
+	// Piped input is sent in full even if the peer is done, but there
+	// is no waiting for more from terminals.
+	if !term.IsTerminal(int(os.Stdin.Fd())) {
+		<-sent
 	}
-	if *verbose {
-		fmt.Fprintln(os.Stderr, "Disconnected")
+	return err
+}
+
+func listenAndServe(network, addr string) error {
+	if isPacket(network) {
+		if *useTLS {
+			return fmt.Errorf("-ssl needs a stream network, not %s", network)
+		}
+		pc, err := net.ListenPacket(network, addr)
+		if err != nil {
+			return err
+		}
+		verbosef("Listening on %s", pc.LocalAddr())
+		c, err := acceptPacket(pc)
+		if err != nil {
+			return err
+		}
+		return serve(c)
+	}
+
+	ln, err := net.Listen(network, addr)
+	if err != nil {
+		return err
+	}
+	defer ln.Close()
+	if *useTLS {
+		config, err := serverConfig(*certFile, *keyFile, *trustFile)
+		if err != nil {
+			return err
+		}
+		ln = tls.NewListener(ln, config)
+	}
+	verbosef("Listening on %s", ln.Addr())
+	for {
+		c, err := ln.Accept()
+		if err != nil {
+			return err
+		}
+		if !*keep {
+			ln.Close()
+			return serve(c)
+		}
+		// Commands can serve connections at once; stdin only one.
+		if *execCmd != "" {
+			go func() {
+				if err := serve(c); err != nil {
+					log.Print(err)
+				}
+			}()
+		} else if err := serve(c); err != nil {
+			log.Print(err)
+		}
+	}
+}
+
+func dialAndServe(network, addr string) error {
+	d := &net.Dialer{Timeout: wait(0)}
+	var c net.Conn
+	var err error
+	if *useTLS {
+		if isPacket(network) {
+			return fmt.Errorf("-ssl needs a stream network, not %s", network)
+		}
+		var config *tls.Config
+		if config, err = clientConfig(addr, *certFile, *keyFile, *trustFile, *verify); err != nil {
+			return err
+		}
+		c, err = tls.DialWithDialer(d, network, addr, config)
+	} else {
+		c, err = d.Dial(network, addr)
+	}
+	if err != nil {
+		return err
+	}
+	return serve(c)
+}
+
+func run(args []string) error {
+	network, err := network()
+	if err != nil {
+		return err
+	}
+	var addr string
+	switch len(args) {
+	case 1:
+		addr = args[0]
+	case 2:
+		if *scan {
+			return scanPorts(network, args[0], args[1])
+		}
+		addr = net.JoinHostPort(args[0], args[1])
+	default:
+		flag.Usage()
+		os.Exit(1)
+	}
+	if *scan {
+		host, ports, err := net.SplitHostPort(addr)
+		if err != nil {
+			return err
+		}
+		return scanPorts(network, host, ports)
+	}
+	if *listen {
+		return listenAndServe(network, addr)
+	}
+	return dialAndServe(network, addr)
+}
+
+func main() {
+	flag.Parse()
+	if err := run(flag.Args()); err != nil {
+		log.Fatalln(err)
 	}
 }
@@ -0,0 +1,78 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"crypto/tls"
+	"io/ioutil"
+	"net"
+	"testing"
+)
+
+func TestParsePorts(t *testing.T) {
+	for _, tt := range []struct {
+		s      string
+		lo, hi int
+		ok     bool
+	}{
+		{"22", 22, 22, true},
+		{"20-25", 20, 25, true},
+		{"25-20", 0, 0, false},
+		{"ssh", 0, 0, false},
+		{"1-65536", 0, 0, false},
+	} {
+		lo, hi, err := parsePorts(tt.s)
+		if (err == nil) != tt.ok || lo != tt.lo || hi != tt.hi {
+			t.Errorf("parsePorts(%q) = %d, %d, %v, want %d, %d, ok %v", tt.s, lo, hi, err, tt.lo, tt.hi, tt.ok)
+		}
+	}
+}
+
+func TestTLS(t *testing.T) {
+	sc, err := serverConfig("", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ln, err := tls.Listen("tcp", "127.0.0.1:0", sc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	go func() {
+		for {
+			c, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			c.Write([]byte("hello"))
+			c.Close()
+		}
+	}()
+
+	addr := ln.Addr().String()
+	// The self-signed certificate is not trusted when verifying.
+	cc, err := clientConfig(addr, "", "", "", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c, err := tls.Dial("tcp", addr, cc); err == nil {
+		c.Close()
+		t.Fatalf("Dial(%s) with verification succeeded", addr)
+	}
+
+	cc, err = clientConfig(addr, "", "", "", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := tls.DialWithDialer(&net.Dialer{}, "tcp", addr, cc)
+	if err != nil {
+		t.Fatalf("Dial(%s) = %v", addr, err)
+	}
+	defer c.Close()
+	b, err := ioutil.ReadAll(c)
+	if err != nil || string(b) != "hello" {
+		t.Errorf("Read = %q, %v, want %q", b, err, "hello")
+	}
+}
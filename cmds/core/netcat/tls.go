@@ -0,0 +1,120 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"time"
+)
+
+// certificates returns the certificate of certFile and keyFile, if any.
+func certificates(certFile, keyFile string) ([]tls.Certificate, error) {
+	switch {
+	case certFile != "" && keyFile != "":
+		c, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, err
+		}
+		return []tls.Certificate{c}, nil
+	case certFile != "" || keyFile != "":
+		return nil, errors.New("-ssl-cert and -ssl-key must be given together")
+	}
+	return nil, nil
+}
+
+// certPool returns the PEM certificates of file.
+func certPool(file string) (*x509.CertPool, error) {
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	p := x509.NewCertPool()
+	if !p.AppendCertsFromPEM(b) {
+		return nil, fmt.Errorf("no PEM certificate in %s", file)
+	}
+	return p, nil
+}
+
+// selfSigned returns a certificate valid for a day from now.
+func selfSigned(now time.Time) (tls.Certificate, error) {
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: "netcat"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.AddDate(0, 0, 1),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &priv.PublicKey, priv)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: priv}, nil
+}
+
+// serverConfig returns the TLS configuration of listening: the
+// certificate of certFile and keyFile, or a self-signed one, and, with a
+// trustFile, only clients with certificates of its CAs.
+func serverConfig(certFile, keyFile, trustFile string) (*tls.Config, error) {
+	certs, err := certificates(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	if certs == nil {
+		c, err := selfSigned(time.Now())
+		if err != nil {
+			return nil, err
+		}
+		certs = []tls.Certificate{c}
+	}
+	config := &tls.Config{Certificates: certs}
+	if trustFile != "" {
+		if config.ClientCAs, err = certPool(trustFile); err != nil {
+			return nil, err
+		}
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return config, nil
+}
+
+// clientConfig returns the TLS configuration of connecting to addr: the
+// client certificate of certFile and keyFile, if any, and verifying the
+// server with the CAs of trustFile, the system's if verify, or not at
+// all.
+func clientConfig(addr, certFile, keyFile, trustFile string, verify bool) (*tls.Config, error) {
+	certs, err := certificates(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	config := &tls.Config{Certificates: certs, InsecureSkipVerify: !verify && trustFile == ""}
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		config.ServerName = host
+	}
+	if trustFile != "" {
+		if config.RootCAs, err = certPool(trustFile); err != nil {
+			return nil, err
+		}
+	}
+	return config, nil
+}
@@ -3,92 +3,95 @@
 // license that can be found in the LICENSE file.
 
 // ntpdate uses NTP to adjust the system clock.
+//
+// Synopsis:
+//
+//	ntpdate [-config FILE] [-rtc|--set-rtc] [-step DURATION]
+//	        [-timeout DURATION] [-verbose] [SERVER...]
+//
+// Description:
+//
+//	Every SERVER (default: the servers in the config file) is queried, all
+//	at once. Responses failing sanity checks are discarded and the median
+//	offset of the rest is applied. Offsets below the step threshold are
+//	slewed, larger ones step the clock.
+//
+// Options:
+//
+//	-config:  NTP config file (default: /etc/ntp.conf)
+//	-rtc:     also set the hardware clock; --set-rtc is the same
+//	-step:    step the clock for offsets of at least this much; negative
+//	          always steps (default: 128ms)
+//	-timeout: timeout for each query (default: 5s)
+//	-verbose: verbose output
 package main
 
 import (
-	"bufio"
 	"flag"
-	"fmt"
 	"log"
 	"os"
-	"strings"
-	"syscall"
-	"time"
 
-	"github.com/beevik/ntp"
+	"github.com/u-root/u-root/pkg/ntpdate"
 )
 
 var (
-	config  = flag.String("config", "/etc/ntp.conf", "NTP config file.")
+	config  = flag.String("config", ntpdate.DefaultConfig, "NTP config file.")
+	setRTC  = flag.Bool("rtc", false, "Also set the RTC")
+	step    = flag.Duration("step", ntpdate.DefaultStepThreshold, "Step the clock for offsets of at least this much, always if negative")
+	timeout = flag.Duration("timeout", ntpdate.DefaultTimeout, "Timeout for each query")
 	verbose = flag.Bool("verbose", false, "Verbose output")
 	debug   = func(string, ...interface{}) {}
 )
 
+func init() {
+	flag.BoolVar(setRTC, "set-rtc", false, "Also set the RTC, like -rtc")
+}
+
 const (
 	fallback = "time.google.com"
 )
 
-func parseServers(r *bufio.Reader) []string {
-	var uri []string
-	var l string
-	var err error
-
-	debug("Reading config file")
-	for err == nil {
-		// This handles the case where the last line doesn't end in \n
-		l, err = r.ReadString('\n')
-		debug("%v", l)
-		if w := strings.Fields(l); len(w) > 1 && w[0] == "server" {
-			// We look only for the server lines, we ignore options like iburst
-			// TODO(ganshun): figure out what options we want to support.
-			uri = append(uri, w[1])
-		}
+// servers returns the servers given on the command line, else those in
+// the config file, else the fallback.
+func servers(args []string, config string) []string {
+	if len(args) > 0 {
+		return args
 	}
-
-	return uri
-}
-
-func getTime(servers []string) (t time.Time, err error) {
-	for _, s := range servers {
-		debug("Getting time from %v", s)
-		if t, err = ntp.Time(s); err == nil {
-			// Right now we return on the first valid time.
-			// We can implement better heuristics here.
-			debug("Got time %v", t)
-			return
-		}
-		debug("Error getting time: %v", err)
+	debug("Reading NTP servers from config file: %v", config)
+	f, err := os.Open(config)
+	if err != nil {
+		log.Printf("Unable to open config file: %v\nFalling back to : %v", err, fallback)
+		return []string{fallback}
 	}
-
-	err = fmt.Errorf("unable to get any time from servers %v", servers)
-	return
+	defer f.Close()
+	s := ntpdate.ParseConfig(f)
+	debug("Found %v servers", len(s))
+	if len(s) == 0 {
+		log.Printf("No servers in %v, falling back to: %v", config, fallback)
+		return []string{fallback}
+	}
+	return s
 }
 
 func main() {
-	var servers []string
 	flag.Parse()
 	if *verbose {
 		debug = log.Printf
 	}
 
-	debug("Reading NTP servers from config file: %v", *config)
-	f, err := os.Open(*config)
-	if err == nil {
-		defer f.Close()
-		servers = parseServers(bufio.NewReader(f))
-		debug("Found %v servers", len(servers))
-	} else {
-		log.Printf("Unable to open config file: %v\nFalling back to : %v", err, fallback)
-		servers = []string{fallback}
-	}
-
-	t, err := getTime(servers)
+	r, err := ntpdate.SetTime(ntpdate.Options{
+		Servers:       servers(flag.Args(), *config),
+		Timeout:       *timeout,
+		StepThreshold: *step,
+		SetRTC:        *setRTC,
+		Debug:         debug,
+	})
 	if err != nil {
-		log.Fatalf("Unable to get time: %v", err)
+		log.Fatalf("Unable to set time: %v", err)
 	}
-
-	tv := syscall.NsecToTimeval(t.UnixNano())
-	if err = syscall.Settimeofday(&tv); err != nil {
-		log.Fatalf("Unable to set system time: %v", err)
+	how := "slewed"
+	if r.Stepped {
+		how = "stepped"
 	}
+	debug("%s clock by %v using %v", how, r.Offset, r.Server)
 }
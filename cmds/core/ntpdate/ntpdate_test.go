@@ -5,93 +5,40 @@
 package main
 
 import (
-	"bufio"
-	"strings"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
 	"testing"
-	"time"
 )
 
-var configFileTests = []struct {
-	config string
-	out    []string
-}{
-	{
-		config: "",
-		out:    []string{},
-	},
-	{
-		config: "server 127.0.0.1",
-		out:    []string{"127.0.0.1"},
-	},
-	{
-		config: "server 127.0.0.1\n",
-		out:    []string{"127.0.0.1"},
-	},
-	{
-		config: "servers 127.0.0.1",
-		out:    []string{},
-	},
-	{
-		config: "server time.google.com",
-		out:    []string{"time.google.com"},
-	},
-	{
-		config: "server 127.0.0.1\n" +
-			"server time.google.com",
-		out: []string{"127.0.0.1", "time.google.com"},
-	},
-	{
-		config: "servers 127.0.0.1\n" +
-			"server time.google.com",
-		out: []string{"time.google.com"},
-	},
-}
-
-func TestConfigParsing(t *testing.T) {
-	for _, tt := range configFileTests {
-		b := bufio.NewReader(strings.NewReader(tt.config))
-		out := parseServers(b)
-
-		if len(out) != len(tt.out) {
-			t.Errorf("Different lengths! Expected:\n%v\ngot:\n%v", tt.out, out)
-		}
-
-		for i := range out {
-			if out[i] != tt.out[i] {
-				t.Errorf("Element at index %d differs. expected:\n%v\ngot:\n%v", i, tt.out, out)
-			}
-		}
+func TestServers(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ntpdate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	conf := filepath.Join(dir, "ntp.conf")
+	if err := ioutil.WriteFile(conf, []byte("server 127.0.0.1 iburst\nserver time.google.com\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	empty := filepath.Join(dir, "empty.conf")
+	if err := ioutil.WriteFile(empty, nil, 0644); err != nil {
+		t.Fatal(err)
 	}
-}
-
-var getTimeTests = []struct {
-	servers []string
-	time    time.Time
-	err     string
-}{
-	{
-		servers: []string{},
-		err:     "unable to get any time from servers",
-	},
-	{
-		servers: []string{"nope.nothing.here"},
-		err:     "unable to get any time from servers",
-	},
-	{
-		servers: []string{"nope.nothing.here", "nope.nothing.here2"},
-		err:     "unable to get any time from servers",
-	},
-}
-
-func TestGetNoTime(t *testing.T) {
-	for _, tt := range getTimeTests {
-		_, err := getTime(tt.servers)
 
-		if err == nil {
-			t.Errorf("%v: got nil, want err", tt)
-		}
-		if !strings.HasPrefix(err.Error(), tt.err) {
-			t.Errorf("expected:\n%s\ngot:\n%s", tt.err, err.Error())
+	for _, tt := range []struct {
+		args   []string
+		config string
+		want   []string
+	}{
+		{args: []string{"10.0.0.1"}, config: conf, want: []string{"10.0.0.1"}},
+		{config: conf, want: []string{"127.0.0.1", "time.google.com"}},
+		{config: empty, want: []string{fallback}},
+		{config: filepath.Join(dir, "nope"), want: []string{fallback}},
+	} {
+		if got := servers(tt.args, tt.config); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("servers(%q, %q) = %q, want %q", tt.args, tt.config, got, tt.want)
 		}
 	}
 }
@@ -4,22 +4,41 @@
 
 // pci: show pci bus vendor ids and other info
 //
+// Synopsis:
+//     pci [OPTIONS] [REGISTER[=VALUE]...]
+//
 // Description:
 //     List the PCI bus, with names if possible.
 //
+//     REGISTER reads a register of the config space of the devices, and
+//     REGISTER=VALUE writes one, as setpci does. A REGISTER is
+//     [CAPABILITY+]OFFSET[.SIZE], where SIZE is b, w or l for 8, 16 or 32
+//     bits, the default, and CAPABILITY is the name of a capability whose
+//     offset OFFSET is relative to: CAP_PM, CAP_MSI, CAP_VNDR, CAP_EXP,
+//     CAP_MSIX, ECAP_AER, ECAP_DSN or ECAP_SRIOV. E.g. CAP_EXP+0x12.w is
+//     the PCI Express link status. Nothing is read or written unless all
+//     registers are valid.
+//
 // Options:
 //     -n: just show numbers
 //     -c: dump config space
-//     -s: specify glob for choosing devices.
+//     -v: decode capabilities (needs root to read past the header)
+//     -vv: also decode the header, and show the regions and IRQ
+//     -j, -json: print JSON
+//     -s: specify glob for choosing devices, or a slot as in lspci:
+//         [[[[domain]:]bus]:][device][.[function]]
+//     -d: only show devices with IDs [vendor]:[device][:class]
 package main
 
 import (
 	"flag"
 	"fmt"
 	"log"
+	"os"
 	"strconv"
 	"strings"
 
+	"github.com/u-root/u-root/pkg/output"
 	"github.com/u-root/u-root/pkg/pci"
 )
 
@@ -27,6 +46,10 @@ var (
 	numbers    = flag.Bool("n", false, "Show numeric IDs")
 	dumpConfig = flag.Bool("c", false, "Dump config space")
 	devs       = flag.String("s", "*", "Devices to match")
+	ids        = flag.String("d", "", "Only show devices with IDs vendor:device:class")
+	verbose    = flag.Bool("v", false, "Decode capabilities")
+	vverbose   = flag.Bool("vv", false, "Decode capabilities and the header, and show regions")
+	jsonOut    = flag.Bool("j", false, "Print JSON")
 	format     = map[int]string{
 		32: "%08x:%08x",
 		16: "%08x:%04x",
@@ -34,85 +57,152 @@ var (
 	}
 )
 
-// maybe we need a better syntax than the standard pcitools?
-func registers(d pci.Devices, cmds ...string) {
-	var justCheck bool
-	for _, c := range cmds {
-		// TODO: replace this nonsense with a state machine.
-		// Split into register and value
-		rv := strings.Split(c, "=")
-		if len(rv) != 1 && len(rv) != 2 {
-			log.Printf("%v: only one = allowed. Due to this error no more commands will be issued", c)
-			justCheck = true
-			continue
+func init() {
+	flag.BoolVar(jsonOut, output.FlagName, false, output.FlagUsage)
+}
+
+// register is a config space register of the command line, with the value
+// to write to it, if any.
+type register struct {
+	capability string
+	offset     uint64
+	size       int
+	value      uint64
+	write      bool
+}
+
+// parseRegister parses [CAPABILITY+]OFFSET[.SIZE][=VALUE].
+func parseRegister(s string) (register, error) {
+	r := register{size: 32}
+	reg, val := s, ""
+	if i := strings.IndexByte(s, '='); i >= 0 {
+		reg, val, r.write = s[:i], s[i+1:], true
+	}
+	if i := strings.IndexByte(reg, '+'); i >= 0 {
+		r.capability, reg = reg[:i], reg[i+1:]
+	}
+	if i := strings.IndexByte(reg, '.'); i >= 0 {
+		switch reg[i+1:] {
+		case "l":
+		case "w":
+			r.size = 16
+		case "b":
+			r.size = 8
+		default:
+			return r, fmt.Errorf("%s: bad size %q, want b, w or l", s, reg[i+1:])
 		}
+		reg = reg[:i]
+	}
+	off, err := strconv.ParseUint(reg, 0, 16)
+	if err != nil || off+uint64(r.size/8) > 4096 {
+		return r, fmt.Errorf("%s: bad offset %q", s, reg)
+	}
+	r.offset = off
+	if r.write {
+		if r.value, err = strconv.ParseUint(val, 0, r.size); err != nil {
+			return r, fmt.Errorf("%s: bad value %q for %d bits", s, val, r.size)
+		}
+	}
+	return r, nil
+}
 
-		// Split into register offset and size
-		rs := strings.Split(rv[0], ".")
-		if len(rs) != 1 && len(rs) != 2 {
-			log.Printf("%v: only one . allowed. Due to this error no more commands will be issued", rv[1])
-			justCheck = true
-			continue
+// registers reads and writes the registers of the devices. Values read are
+// added to their ExtraInfo.
+func registers(d pci.Devices, args ...string) error {
+	var regs []register
+	caps := false
+	for _, a := range args {
+		r, err := parseRegister(a)
+		if err != nil {
+			return err
+		}
+		regs = append(regs, r)
+		caps = caps || r.capability != ""
+	}
+	if caps {
+		if err := d.ReadCapabilities(); err != nil {
+			return err
 		}
-		s := 32
-		if len(rs) == 2 {
-			switch rs[1] {
-			default:
-				log.Printf("Bad size: %v. Due to this error no more commands will be issued", rs[1])
-				justCheck = true
+	}
+	for _, r := range regs {
+		for _, p := range d {
+			off := r.offset
+			if r.capability != "" {
+				base, err := p.CapabilityOffset(r.capability)
+				if err != nil {
+					return err
+				}
+				off += uint64(base)
+			}
+			if r.write {
+				if err := p.WriteConfigRegister(int64(off), int64(r.size), r.value); err != nil {
+					return err
+				}
 				continue
-			case "l":
-			case "w":
-				s = 16
-			case "b":
-				s = 8
 			}
+			v, err := p.ReadConfigRegister(int64(off), int64(r.size))
+			if err != nil {
+				return err
+			}
+			p.ExtraInfo = append(p.ExtraInfo, fmt.Sprintf(format[r.size], off, v))
 		}
-		if justCheck {
+	}
+	return nil
+}
+
+// filters returns the globs and filters selecting devices. Slots without
+// glob characters are lspci style slots, which may leave out the domain.
+func filters() ([]string, []pci.Filter, error) {
+	var globs []string
+	var fs []pci.Filter
+	for _, s := range strings.Split(*devs, ",") {
+		if strings.ContainsAny(s, "*?[") {
+			globs = append(globs, s)
 			continue
 		}
-		reg, err := strconv.ParseUint(rs[0], 0, 16)
+		f, err := pci.SlotFilter(s)
 		if err != nil {
-			log.Printf("%v:%v. Due to this error no more commands will be issued", rs[0], err)
-			justCheck = true
-			continue
+			return nil, nil, err
 		}
-		if len(rv) == 1 {
-			v, err := d.ReadConfigRegister(int64(reg), int64(s))
-			if err != nil {
-				log.Printf("%v:%v. Due to this error no more commands will be issued", rv[0], err)
-				justCheck = true
-				continue
-			}
-			// Should this go in the package somewhere? Not sure.
-			for i := range v {
-				d[i].ExtraInfo = append(d[i].ExtraInfo, fmt.Sprintf(format[s], reg, v[i]))
-			}
-		}
-		if len(rv) == 2 {
-			val, err := strconv.ParseUint(rv[1], 0, s)
-			if err != nil {
-				log.Printf("%v. Due to this error no more commands will be issued", err)
-				justCheck = true
-				continue
-			}
-			if err := d.WriteConfigRegister(int64(reg), int64(s), val); err != nil {
-				log.Printf("%v:%v. Due to this error no more commands will be issued", rv[1], err)
-				justCheck = true
-				continue
+		fs = append(fs, f)
+	}
+	if len(fs) > 1 {
+		// Any of the slots may match.
+		slots := fs
+		fs = []pci.Filter{func(p *pci.PCI) bool {
+			for _, f := range slots {
+				if f(p) {
+					return true
+				}
 			}
+			return false
+		}}
+	}
+	if len(globs) == 0 {
+		globs = []string{"*"}
+	}
+	if *ids != "" {
+		f, err := pci.IDFilter(*ids)
+		if err != nil {
+			return nil, nil, err
 		}
-
+		fs = append(fs, f)
 	}
+	return globs, fs, nil
 }
+
 func main() {
 	flag.Parse()
-	r, err := pci.NewBusReader(strings.Split(*devs, ",")...)
+	globs, fs, err := filters()
+	if err != nil {
+		log.Fatal(err)
+	}
+	r, err := pci.NewBusReader(globs...)
 	if err != nil {
 		log.Fatalf("%v", err)
 	}
 
-	d, err := r.Read()
+	d, err := r.Read(fs...)
 	if err != nil {
 		log.Fatalf("Read: %v", err)
 	}
@@ -121,7 +211,39 @@ func main() {
 		d.SetVendorDeviceName()
 	}
 	if len(flag.Args()) > 0 {
-		registers(d, flag.Args()...)
+		if err := registers(d, flag.Args()...); err != nil {
+			log.Fatal(err)
+		}
+	}
+	*verbose = *verbose || *vverbose
+	if *vverbose || *jsonOut {
+		if err := d.ReadHeader(); err != nil {
+			log.Fatalf("Reading header: %v", err)
+		}
+	}
+	if *verbose || *jsonOut {
+		if err := d.ReadCapabilities(); err != nil {
+			log.Fatalf("Reading capabilities: %v", err)
+		}
+	}
+	if *jsonOut {
+		if err := output.JSON(os.Stdout, d); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	if *verbose {
+		for _, p := range d {
+			for _, h := range p.Header {
+				p.ExtraInfo = append(p.ExtraInfo, "\t"+h)
+			}
+			for _, r := range p.Regions {
+				p.ExtraInfo = append(p.ExtraInfo, "\t"+r.String())
+			}
+			for _, c := range p.Capabilities {
+				p.ExtraInfo = append(p.ExtraInfo, "\t"+strings.Replace(c.String(), "\n", "\n\t", -1))
+			}
+		}
 	}
 	if *dumpConfig {
 		d.ReadConfig()
@@ -0,0 +1,30 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import "testing"
+
+func TestParseRegister(t *testing.T) {
+	for _, tt := range []struct {
+		in   string
+		want register
+	}{
+		{"0x10", register{offset: 0x10, size: 32}},
+		{"4.w=0x0406", register{offset: 4, size: 16, value: 0x406, write: true}},
+		{"CAP_EXP+0x12.w", register{capability: "CAP_EXP", offset: 0x12, size: 16}},
+		{"ECAP_AER+4=0xffffffff", register{capability: "ECAP_AER", offset: 4, size: 32, value: 0xffffffff, write: true}},
+		{"0x3c.b", register{offset: 0x3c, size: 8}},
+	} {
+		got, err := parseRegister(tt.in)
+		if err != nil || got != tt.want {
+			t.Errorf("parseRegister(%q) = %+v, %v, want %+v", tt.in, got, err, tt.want)
+		}
+	}
+	for _, bad := range []string{"0x10.q", "0x1000", "0xffe.l", "4.b=0x100", "zz", "4=x"} {
+		if _, err := parseRegister(bad); err == nil {
+			t.Errorf("parseRegister(%q) succeeded", bad)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// poweroff powers off the machine.
+//
+// Synopsis:
+//     poweroff [-f] [-v]
+//
+// Description:
+//     poweroff sends SIGTERM, then SIGKILL, to all processes, syncs and
+//     unmounts file systems, and powers off the machine.
+//
+// Options:
+//     -f:     only sync, without killing processes and unmounting
+//     -v:     verbose output
+package main
+
+import (
+	"flag"
+	"log"
+
+	"github.com/u-root/u-root/pkg/shutdown"
+	"golang.org/x/sys/unix"
+)
+
+var (
+	force = flag.Bool("f", false, "Only sync, without killing processes and unmounting file systems")
+	debug = flag.Bool("v", false, "Verbose output")
+)
+
+func main() {
+	flag.Parse()
+	o := shutdown.Options{Force: *force}
+	if *debug {
+		o.Debug = log.Printf
+	}
+	cmd := uint32(unix.LINUX_REBOOT_CMD_POWER_OFF)
+	if !*force {
+		shutdown.Wall("The system is going down for poweroff NOW!")
+	}
+	if err := shutdown.Shutdown(cmd, o); err != nil {
+		log.Fatal(err)
+	}
+}
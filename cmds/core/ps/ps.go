@@ -5,28 +5,47 @@
 // Print process information.
 //
 // Synopsis:
-//     ps [-Aaex] [aux]
+//     ps [-AaeTx] [-o FORMAT] [--sort KEYS] [--json] [aux]
 //
 // Description:
 //     ps reads the /proc filesystem and prints nice things about what it
 //     finds.  /proc in linux has grown by a process of Evilution, so it's
 //     messy.
 //
+//     The listing is written to standard output, errors to standard error.
+//
 // Options:
 //     -A: select all processes. Identical to -e.
 //     -e: select all processes. Identical to -A.
 //     -x: BSD-Like style, with STAT Column and long CommandLine
 //     -a: print all process except whose are session leaders or unlinked with terminal
+//     -T: print the threads of the processes, with their IDs as SPID
+//     -o FORMAT: print the columns of the comma separated FORMAT, each
+//         optionally followed by =HEADER, e.g. -o pid,rss,comm=NAME:
+//         pid, ppid, pgrp, sid, tid, spid, user, uid, tty, stat, ni,
+//         pri, nlwp, rss, vsz (in KiB), pcpu, etime (in seconds with
+//         --json), time, comm or args
+//     --sort KEYS: sort by the comma separated columns, descending for
+//         those prefixed with -, e.g. --sort -rss,pid. The default is pid.
+//     --json: print JSON, with keys made from the headers
 //    aux: see every process on the system using BSD syntax
 package main
 
 import (
 	"fmt"
-	flag "github.com/spf13/pflag"
 	"io"
 	"log"
 	"os"
+	"os/user"
 	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	flag "github.com/spf13/pflag"
+	"github.com/u-root/u-root/pkg/output"
+	"github.com/u-root/u-root/pkg/proc"
 )
 
 var (
@@ -35,8 +54,12 @@ var (
 		nSidTty bool
 		x       bool
 		aux     bool
+		threads bool
+		json    bool
+		format  []string
+		sort    string
 	}
-	cmd  = "ps [-Aaex] [aux]"
+	cmd  = "ps [-AaeTx] [-o FORMAT] [--sort KEYS] [aux]"
 	eUID = os.Geteuid()
 )
 
@@ -50,165 +73,334 @@ func init() {
 	flag.BoolVarP(&flags.all, "every", "e", false, "Select all processes.  Identical to -A.")
 	flag.BoolVarP(&flags.x, "bsd", "x", false, "BSD-Like style, with STAT Column and long CommandLine")
 	flag.BoolVarP(&flags.nSidTty, "nSIDTTY", "a", false, "Print all process except whose are session leaders or unlinked with terminal")
+	flag.BoolVarP(&flags.threads, "threads", "T", false, "Print the threads of the processes")
+	flag.StringArrayVarP(&flags.format, "format", "o", nil, "Comma separated columns to print, e.g. pid,rss,comm=NAME")
+	flag.StringVar(&flags.sort, "sort", "pid", "Comma separated columns to sort by, descending if prefixed with -")
+	flag.BoolVar(&flags.json, output.FlagName, false, output.FlagUsage)
 }
 
-// ProcessTable holds all the information needed for ps
-type ProcessTable struct {
-	table   []*Process
-	mProc   *Process
-	headers []string // each column to print
-	fields  []string // which fields of process to print, on order
-	fstring []string // formated strings
-}
+// process is a process or thread to print.
+type process struct {
+	*proc.Process
 
-// NewProcessTable creates an empty process table
-func NewProcessTable() *ProcessTable {
-	return &ProcessTable{}
+	// name is the PID, prefixed with the directory of the proc file
+	// system for all but the first, e.g. srv/1996.
+	name string
+
+	// dir is the proc file system the process was read from.
+	dir string
+
+	// uptime is the uptime of the system of dir, or 0 if unknown.
+	uptime time.Duration
 }
 
-// Len returns the number of processes in the ProcessTable.
-func (pT ProcessTable) Len() int {
-	return len(pT.table)
+// column is a column ps can print.
+type column struct {
+	header string
+
+	// right aligns the column to the right, as for numbers.
+	right bool
+
+	// value is the value for JSON and sorting: an int, a uint64, a
+	// float64 or a string.
+	value func(p *process) interface{}
+
+	// text is the text printed, if it is not the value.
+	text func(p *process) string
 }
 
-// to use on sort.Sort
-func (pT ProcessTable) Less(i, j int) bool {
-	return pT.table[i].Pidno < pT.table[j].Pidno
+func (c column) String(p *process) string {
+	if c.text != nil {
+		return c.text(p)
+	}
+	return fmt.Sprint(c.value(p))
 }
 
-// to use on sort.Sort
-func (pT ProcessTable) Swap(i, j int) {
-	pT.table[i], pT.table[j] = pT.table[j], pT.table[i]
+// clock formats d as hh:mm:ss.
+func clock(d time.Duration) string {
+	s := int64(d / time.Second)
+	return fmt.Sprintf("%02d:%02d:%02d", s/3600, s/60%60, s%60)
 }
 
-// Return the biggest value in a slice of ints.
-func max(slice []int) int {
-	max := slice[0]
-	for _, value := range slice {
-		if value > max {
-			max = value
-		}
+// elapsed formats d as [[dd-]hh:]mm:ss.
+func elapsed(d time.Duration) string {
+	s := int64(d / time.Second)
+	switch {
+	case s >= 24*3600:
+		return fmt.Sprintf("%d-%02d:%02d:%02d", s/(24*3600), s/3600%24, s/60%60, s%60)
+	case s >= 3600:
+		return fmt.Sprintf("%02d:%02d:%02d", s/3600, s/60%60, s%60)
 	}
-	return max
+	return fmt.Sprintf("%02d:%02d", s/60, s%60)
 }
 
-// MaxLength returns the longest string of a field of ProcessTable
-func (pT ProcessTable) MaxLength(field string) int {
-	slice := make([]int, 0)
-	for _, p := range pT.table {
-		slice = append(slice, len(p.Search(field)))
+// users caches user names.
+var users = map[int]string{}
+
+func userName(uid int) string {
+	if n, ok := users[uid]; ok {
+		return n
 	}
+	n := strconv.Itoa(uid)
+	if u, err := user.LookupId(n); err == nil {
+		n = u.Username
+	}
+	users[uid] = n
+	return n
+}
 
-	return max(slice)
+// printable replaces control characters, e.g. the newlines of command
+// lines, with ?.
+func printable(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return '?'
+		}
+		return r
+	}, s)
 }
 
-// PrintHeader prints the header for ps, with correct spacing.
-func (pT ProcessTable) PrintHeader(w io.Writer) {
-	var row string
-	for index, field := range pT.headers {
-		formated := pT.fstring[index]
-		row += fmt.Sprintf(formated, field)
+func args(p *process) string {
+	if len(p.Args) == 0 {
+		return "[" + p.Comm + "]"
 	}
-
-	fmt.Fprintf(w, "%v\n", row)
+	return printable(strings.Join(p.Args, " "))
 }
 
-// PrintProcess prints information about one process.
-func (pT ProcessTable) PrintProcess(index int, w io.Writer) {
-	var row string
-	p := pT.table[index]
-	for index, f := range pT.fields {
-		field := p.Search(f)
-		formated := pT.fstring[index]
-		row += fmt.Sprintf(formated, field)
+var columns = map[string]column{
+	"pid": {header: "PID", right: true,
+		value: func(p *process) interface{} { return p.PID },
+		text:  func(p *process) string { return p.name }},
+	"ppid": {header: "PPID", right: true, value: func(p *process) interface{} { return p.PPID }},
+	"pgrp": {header: "PGRP", right: true, value: func(p *process) interface{} { return p.PGRP }},
+	"sid":  {header: "SID", right: true, value: func(p *process) interface{} { return p.SID }},
+	"tid":  {header: "TID", right: true, value: func(p *process) interface{} { return p.TID }},
+	"spid": {header: "SPID", right: true, value: func(p *process) interface{} { return p.TID }},
+	"user": {header: "USER", value: func(p *process) interface{} { return userName(p.EUID) }},
+	"uid":  {header: "UID", right: true, value: func(p *process) interface{} { return p.EUID }},
+	"tty": {header: "TTY", value: func(p *process) interface{} {
+		if t := p.TTY(); t != "" {
+			return t
+		}
+		return "?"
+	}},
+	"stat": {header: "STAT", value: func(p *process) interface{} { return p.State }},
+	"ni":   {header: "NI", right: true, value: func(p *process) interface{} { return p.Nice }},
+	"pri":  {header: "PRI", right: true, value: func(p *process) interface{} { return p.Priority }},
+	"nlwp": {header: "NLWP", right: true, value: func(p *process) interface{} { return p.NumThreads }},
+	"rss":  {header: "RSS", right: true, value: func(p *process) interface{} { return p.RSS / 1024 }},
+	"vsz":  {header: "VSZ", right: true, value: func(p *process) interface{} { return p.VSize / 1024 }},
+	"pcpu": {header: "%CPU", right: true,
+		value: func(p *process) interface{} { return p.CPUPercent(p.uptime) },
+		text:  func(p *process) string { return fmt.Sprintf("%.1f", p.CPUPercent(p.uptime)) }},
+	"etime": {header: "ELAPSED", right: true,
+		value: func(p *process) interface{} { return int(p.Elapsed(p.uptime) / time.Second) },
+		text:  func(p *process) string { return elapsed(p.Elapsed(p.uptime)) }},
+	"time": {header: "TIME", right: true,
+		value: func(p *process) interface{} { return clock(p.CPUTime()) }},
+	"comm": {header: "COMMAND", value: func(p *process) interface{} { return printable(p.Comm) }},
+	"args": {header: "COMMAND", value: func(p *process) interface{} { return args(p) }},
+}
 
+// parseFormat returns the columns of the comma separated lists of column
+// names, each optionally followed by =HEADER.
+func parseFormat(formats []string) ([]column, error) {
+	var cols []column
+	for _, f := range formats {
+		for _, name := range strings.Split(f, ",") {
+			var header string
+			if i := strings.IndexByte(name, '='); i >= 0 {
+				name, header = name[:i], name[i+1:]
+			}
+			c, ok := columns[name]
+			if !ok {
+				return nil, fmt.Errorf("unknown column %q", name)
+			}
+			if header != "" {
+				c.header = header
+			}
+			cols = append(cols, c)
+		}
 	}
+	return cols, nil
+}
 
-	fmt.Fprintf(w, "%v\n", row)
+// sortKey is a column to sort by.
+type sortKey struct {
+	column
+	desc bool
 }
 
-// PrepareString figures out how to lay out a process table print
-func (pT *ProcessTable) PrepareString() {
-	var (
-		fstring  []string
-		formated string
-		PID      = pT.MaxLength("Pid")
-		TTY      = pT.MaxLength("Ctty")
-		STAT     = 4 | pT.MaxLength("State") // min : 4
-		TIME     = pT.MaxLength("Time")
-		CMD      = pT.MaxLength("Cmd")
-	)
-	for _, f := range pT.headers {
-		switch f {
-		case "PID":
-			formated = fmt.Sprintf("%%%dv ", PID)
-		case "TTY":
-			formated = fmt.Sprintf("%%-%dv    ", TTY)
-		case "STAT":
-			formated = fmt.Sprintf("%%-%dv    ", STAT)
-		case "TIME":
-			formated = fmt.Sprintf("%%%dv ", TIME)
-		case "CMD":
-			formated = fmt.Sprintf("%%-%dv ", CMD)
+// parseSort returns the keys of a comma separated list of column names,
+// each optionally prefixed by + or -.
+func parseSort(s string) ([]sortKey, error) {
+	var keys []sortKey
+	for _, name := range strings.Split(s, ",") {
+		var k sortKey
+		switch {
+		case strings.HasPrefix(name, "-"):
+			k.desc, name = true, name[1:]
+		case strings.HasPrefix(name, "+"):
+			name = name[1:]
+		}
+		c, ok := columns[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown sort key %q", name)
 		}
-		fstring = append(fstring, formated)
+		k.column = c
+		keys = append(keys, k)
 	}
+	return keys, nil
+}
 
-	pT.fstring = fstring
+// compare returns -1, 0 or 1 if a is less than, equal to or greater than b,
+// values of the same column.
+func compare(a, b interface{}) int {
+	var less, greater bool
+	switch a := a.(type) {
+	case int:
+		less, greater = a < b.(int), a > b.(int)
+	case uint64:
+		less, greater = a < b.(uint64), a > b.(uint64)
+	case float64:
+		less, greater = a < b.(float64), a > b.(float64)
+	case string:
+		less, greater = a < b.(string), a > b.(string)
+	}
+	switch {
+	case less:
+		return -1
+	case greater:
+		return 1
+	}
+	return 0
+}
+
+func sortProcesses(ps []*process, keys []sortKey) {
+	sort.SliceStable(ps, func(i, j int) bool {
+		for _, k := range keys {
+			c := compare(k.value(ps[i]), k.value(ps[j]))
+			if k.desc {
+				c = -c
+			}
+			if c != 0 {
+				return c < 0
+			}
+		}
+		return false
+	})
 }
 
-// For now, just read /proc/pid/stat and dump its brains.
-func ps(pT *ProcessTable, w io.Writer) error {
-	if len(pT.table) == 0 {
-		return nil
+// selected reports whether p is printed, given the ps process self.
+func selected(p, self *process) bool {
+	switch {
+	case flags.nSidTty:
+		// no session leaders and no unlinked terminals
+		return p.SID != p.PID && p.TTYNr != 0
+
+	case flags.x:
+		// print only process with same eUID of caller
+		return eUID == p.UID
+
+	case flags.all:
+		// pass, print all
+		return true
 	}
-	// sorting ProcessTable by PID
-	sort.Sort(pT)
+	// default for no flags only same session
+	// and same uid process
+	return p.SID == self.SID && eUID == p.UID
+}
 
+// format returns the columns for the flags.
+func format() ([]column, error) {
+	if len(flags.format) > 0 {
+		return parseFormat(flags.format)
+	}
+	var f string
 	switch {
 	case flags.aux:
-		pT.headers = []string{"PID", "PGRP", "SID", "TTY", "STAT", "TIME", "COMMAND"}
-		pT.fields = []string{"Pid", "Pgrp", "Sid", "Ctty", "State", "Time", "Cmd"}
+		f = "pid,pgrp,sid,tty,stat,time,comm"
 	case flags.x:
-		pT.headers = []string{"PID", "TTY", "STAT", "TIME", "COMMAND"}
-		pT.fields = []string{"Pid", "Ctty", "State", "Time", "Cmd"}
+		f = "pid,tty,stat,time,args"
 	default:
-		pT.headers = []string{"PID", "TTY", "TIME", "CMD"}
-		pT.fields = []string{"Pid", "Ctty", "Time", "Cmd"}
+		f = "pid,tty,time,comm=CMD"
+	}
+	if flags.threads {
+		f = strings.Replace(f, "pid,", "pid,spid,", 1)
 	}
+	return parseFormat([]string{f})
+}
 
-	pT.PrepareString()
-	pT.PrintHeader(w)
-	for index, p := range pT.table {
-		switch {
-		case flags.nSidTty:
-			// no session leaders and no unlinked terminals
-			if p.Sid == p.Pid || p.Ctty == "?" {
-				continue
-			}
+// ps prints the selected processes of ps, self being ps itself, with the
+// columns cols sorted by keys.
+func ps(all []*process, self *process, cols []column, keys []sortKey, w io.Writer) error {
+	var ps []*process
+	for _, p := range all {
+		if !selected(p, self) {
+			continue
+		}
+		if !flags.threads {
+			ps = append(ps, p)
+			continue
+		}
+		threads, err := proc.Threads(p.dir, p.PID)
+		if err != nil || len(threads) == 0 {
+			ps = append(ps, p)
+			continue
+		}
+		for _, t := range threads {
+			ps = append(ps, &process{Process: t, name: p.name, dir: p.dir, uptime: p.uptime})
+		}
+	}
+	sortProcesses(ps, keys)
 
-		case flags.x:
-			// print only process with same eUID of caller
-			if eUID != p.uid {
-				continue
+	if flags.json {
+		headers := make([]string, len(cols))
+		for i, c := range cols {
+			headers[i] = c.header
+		}
+		t := output.NewTable(headers...)
+		for _, p := range ps {
+			row := make([]interface{}, len(cols))
+			for i, c := range cols {
+				row[i] = c.value(p)
 			}
+			t.Add(row...)
+		}
+		return t.Write(w, true)
+	}
 
-		case flags.all:
-			// pass, print all
-
-		default:
-			// default for no flags only same session
-			// and same uid process
-			if p.Sid != pT.mProc.Sid || eUID != p.uid {
-				continue
+	rows := make([][]string, len(ps)+1)
+	widths := make([]int, len(cols))
+	for i, c := range cols {
+		rows[0] = append(rows[0], c.header)
+		widths[i] = len(c.header)
+	}
+	for i, p := range ps {
+		for j, c := range cols {
+			s := c.String(p)
+			rows[i+1] = append(rows[i+1], s)
+			if len(s) > widths[j] {
+				widths[j] = len(s)
 			}
 		}
-
-		pT.PrintProcess(index, w)
 	}
-
+	for _, r := range rows {
+		var line []string
+		for i, s := range r {
+			switch {
+			case cols[i].right:
+				s = fmt.Sprintf("%*s", widths[i], s)
+			case i < len(r)-1:
+				s = fmt.Sprintf("%-*s", widths[i], s)
+			}
+			line = append(line, s)
+		}
+		if _, err := fmt.Fprintln(w, strings.Join(line, " ")); err != nil {
+			return err
+		}
+	}
 	return nil
-
 }
 
 func usage() {
@@ -230,13 +422,19 @@ func main() {
 			usage()
 		}
 	}
-	pT := NewProcessTable()
-	if err := pT.LoadTable(); err != nil {
+	cols, err := format()
+	if err != nil {
 		log.Fatal(err)
 	}
-
-	err := ps(pT, os.Stderr)
+	keys, err := parseSort(flags.sort)
+	if err != nil {
+		log.Fatal(err)
+	}
+	all, self, err := load(procDirs())
 	if err != nil {
 		log.Fatal(err)
 	}
+	if err := ps(all, self, cols, keys, os.Stdout); err != nil {
+		log.Fatal(err)
+	}
 }
@@ -12,9 +12,11 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
-	"reflect"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/u-root/u-root/pkg/proc"
 	"github.com/u-root/u-root/pkg/testutil"
 )
 
@@ -32,9 +34,10 @@ func TestPsExecution(t *testing.T) {
 		pid   string
 		files map[string]string
 		o     string
-		err   error
+		err   bool
 	}{
-		{n: "missing files", pid: "1", files: map[string]string{"stat": "bad status file"}},
+		// Processes which cannot be read are left out.
+		{n: "missing files", pid: "1", files: map[string]string{"stat": "bad status file"}, err: true},
 		{n: "one process", pid: "1", files: map[string]string{"stat": "bad status file",
 			"status": `Name:	systemd
 TracerPid:	0
@@ -43,7 +46,7 @@ Gid:	0	0	0	0
 FDSize:	128
 `,
 			"cmdline": "/sbin/init"},
-			o: "PID PGRP SID TTY    STAT        TIME  COMMAND \n1     ?    file    00:00:00   status \n",
+			err: true,
 		},
 		// Fix things up
 		{n: "correct pid 1", pid: "1", files: map[string]string{"stat": "1 (systemd) S 0 1 1 0 -1 4194560 82923 51272244 88 3457 153 671 103226 39563 20 0 1 0 2 230821888 2325 18446744073709551615 1 1 0 0 0 0 671173123 4096 1260 0 0 0 17 1 0 0 69 0 0 0 0 0 0 0 0 0 0",
@@ -54,7 +57,7 @@ Gid:	0	0	0	0
 FDSize:	128
 `,
 			"cmdline": "/sbin/init"},
-			o: "PID PGRP SID TTY    STAT         TIME  COMMAND \n1 1 1 ?    S        00:00:08  systemd \n",
+			o: "PID PGRP SID TTY STAT     TIME COMMAND\n  1    1   1 ?   S    00:00:08 systemd\n",
 		},
 		{n: "second process", pid: "1996", files: map[string]string{"stat": "1996 (dnsmasq) S 1 1995 1995 0 -1 4194624 64 0 0 0 1 10 0 0 20 0 1 0 1208 51163136 91 18446744073709551615 1 1 0 0 0 0 0 4096 92675 0 0 0 17 2 0 0 0 0 0 0 0 0 0 0 0 0 0",
 
@@ -63,7 +66,7 @@ Umask:	0022
 Uid:	110	110	110	110
 `,
 			"cmdline": "/usr/sbin/dnsmasq\000--conf-file=/var/lib/libvirt/dnsmasq/default.conf\000--leasefile-ro\000--dhcp-script=/usr/lib/libvirt/libvirt_leaseshelper\000"},
-			o: " PID PGRP  SID TTY    STAT         TIME  COMMAND \n   1    1    1 ?    S        00:00:08  systemd \n1996 1995 1995 ?    S        00:00:00  dnsmasq \n",
+			o: " PID PGRP  SID TTY STAT     TIME COMMAND\n   1    1    1 ?   S    00:00:08 systemd\n1996 1995 1995 ?   S    00:00:00 dnsmasq\n",
 		},
 		{n: "nethost process", pid: "srv/1996", files: map[string]string{"stat": "1996 (dnsmasq) S 1 1995 1995 0 -1 4194624 64 0 0 0 1 10 0 0 20 0 1 0 1208 51163136 91 18446744073709551615 1 1 0 0 0 0 0 4096 92675 0 0 0 17 2 0 0 0 0 0 0 0 0 0 0 0 0 0",
 
@@ -72,7 +75,7 @@ Umask:	0022
 Uid:	110	110	110	110
 `,
 			"cmdline": "/usr/sbin/dnsmasq\000--conf-file=/var/lib/libvirt/dnsmasq/default.conf\000--leasefile-ro\000--dhcp-script=/usr/lib/libvirt/libvirt_leaseshelper\000"},
-			o: "     PID     PGRP      SID TTY    STAT         TIME  COMMAND \n       1        1        1 ?    S        00:00:08  systemd \n    1996     1995     1995 ?    S        00:00:00  dnsmasq \nsrv/1996     1995     1995 ?    S        00:00:00  dnsmasq \n",
+			o: "     PID PGRP  SID TTY STAT     TIME COMMAND\n       1    1    1 ?   S    00:00:08 systemd\n    1996 1995 1995 ?   S    00:00:00 dnsmasq\nsrv/1996 1995 1995 ?   S    00:00:00 dnsmasq\n",
 		},
 	}
 
@@ -94,121 +97,96 @@ Uid:	110	110	110	110
 		c.Env = append(c.Env, psp)
 		o, err := c.CombinedOutput()
 		t.Logf("%s: %s %v", tt.n, string(o), err)
-		if string(o) != tt.o {
-			t.Errorf("%v: got %q, want %q", tt.n, string(o), tt.o)
+		if (err != nil) != tt.err {
+			t.Errorf("%v: got %v, want error %v", tt.n, err, tt.err)
 		}
-		if !reflect.DeepEqual(err, tt.err) {
-			t.Errorf("%v: got %v, want %v", tt.n, err, tt.err)
+		if tt.err {
+			if !strings.Contains(string(o), "no processes found") {
+				t.Errorf("%v: got %q, want no processes found", tt.n, string(o))
+			}
+		} else if string(o) != tt.o {
+			t.Errorf("%v: got %q, want %q", tt.n, string(o), tt.o)
 		}
 	}
 
 }
 
-// Test Parsing of stat
-func TestParse(t *testing.T) {
-	var tests = []struct {
-		name string
-		p    *Process
-		out  string
-		err  error
+var (
+	pid1    = "1 (systemd) S 0 1 1 0 -1 4194560 45535 23809816 88 2870 76 378 35944 9972 20 0 1 0 2 230821888 2325 18446744073709551615 1 1 0 0 0 0 671173123 4096 1260 0 0 0 17 2 0 0 69 0 0 0 0 0 0 0 0 0 0"
+	pid1996 = "1996 (dns masq) S 1 1995 1995 34816 1995 4194624 64 0 0 0 1 10 0 0 20 0 3 0 1208 51163136 91 18446744073709551615 1 1 0 0 0 0 0 4096 92675 0 0 0 17 2 0 0 0 0 0 0 0 0 0 0 0 0 0"
+)
+
+// Test printing processes with various columns and orders.
+func TestPs(t *testing.T) {
+	var all []*process
+	for _, s := range []string{pid1996, pid1} {
+		p, err := proc.ParseStat(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		all = append(all, &process{Process: p, name: fmt.Sprint(p.PID), uptime: 1000 * time.Second})
+	}
+	all[0].Args = []string{"/usr/sbin/dnsmasq", "--conf-file=/var/lib/libvirt/dnsmasq/default.conf"}
+
+	flags.all = true
+	defer func() { flags.all = false }()
+	for _, tt := range []struct {
+		format []string
+		sort   string
+		out    string
 	}{
 		{
-			name: "no status file",
-			p: &Process{
-				stat: "1 (systemd) S 0 1 1 0 -1 4194560 45535 23809816 88 2870 76 378 35944 9972 20 0 1 0 2 230821888 2325 18446744073709551615 1 1 0 0 0 0 671173123 4096 1260 0 0 0 17 2 0 0 69 0 0 0 0 0 0 0 0 0 0",
-			},
-			err: fmt.Errorf("no Uid string in "),
+			format: []string{"pid,tty,time,comm=CMD"},
+			sort:   "pid",
+			out:    " PID TTY       TIME CMD\n   1 ?     00:00:04 systemd\n1996 pts/0 00:00:00 dns masq\n",
 		},
 		{
-			name: "Valid output",
-			out:  "PID TTY        TIME CMD     \n1 ?    00:00:04 systemd \n",
-			p: &Process{
-				stat: "1 (systemd) S 0 1 1 0 -1 4194560 45535 23809816 88 2870 76 378 35944 9972 20 0 1 0 2 230821888 2325 18446744073709551615 1 1 0 0 0 0 671173123 4096 1260 0 0 0 17 2 0 0 69 0 0 0 0 0 0 0 0 0 0",
-				status: `Name:	systemd
-Umask:	0000
-State:	S (sleeping)
-Tgid:	1
-Ngid:	0
-Pid:	1
-PPid:	0
-TracerPid:	0
-Uid:	0	0	0	0
-Gid:	0	0	0	0
-FDSize:	128
-Groups:	 
-NStgid:	1
-NSpid:	1
-NSpgid:	1
-NSsid:	1
-VmPeak:	  290768 kB
-VmSize:	  225412 kB
-VmLck:	       0 kB
-VmPin:	       0 kB
-VmHWM:	    9308 kB
-VmRSS:	    9300 kB
-RssAnon:	    2524 kB
-RssFile:	    6776 kB
-RssShmem:	       0 kB
-VmData:	   18696 kB
-VmStk:	     132 kB
-VmExe:	    1336 kB
-VmLib:	   10008 kB
-VmPTE:	     204 kB
-VmSwap:	       0 kB
-HugetlbPages:	       0 kB
-CoreDumping:	0
-Threads:	1
-SigQ:	0/31573
-SigPnd:	0000000000000000
-ShdPnd:	0000000000000000
-SigBlk:	7be3c0fe28014a03
-SigIgn:	0000000000001000
-SigCgt:	00000001800004ec
-CapInh:	0000000000000000
-CapPrm:	0000003fffffffff
-CapEff:	0000003fffffffff
-CapBnd:	0000003fffffffff
-CapAmb:	0000000000000000
-NoNewPrivs:	0
-Seccomp:	0
-Speculation_Store_Bypass:	thread vulnerable
-Cpus_allowed:	ffffffff,ffffffff,ffffffff,ffffffff
-Cpus_allowed_list:	0-127
-Mems_allowed:	00000000,00000000,00000000,00000000,00000000,00000000,00000000,00000000,00000000,00000000,00000000,00000000,00000000,00000000,00000000,00000000,00000000,00000000,00000000,00000000,00000000,00000000,00000000,00000000,00000000,00000000,00000000,00000000,00000000,00000000,00000000,00000001
-Mems_allowed_list:	0
-voluntary_ctxt_switches:	10168
-nonvoluntary_ctxt_switches:	3746
-`},
-			err: nil,
+			format: []string{"pid,ppid", "nlwp,rss,args"},
+			sort:   "-pid",
+			out:    " PID PPID NLWP  RSS COMMAND\n1996    1    3  364 /usr/sbin/dnsmasq --conf-file=/var/lib/libvirt/dnsmasq/default.conf\n   1    0    1 9300 [systemd]\n",
 		},
-	}
-
-	flags.all = true
-	for _, tt := range tests {
-		t.Logf("%v", tt.name)
-		err := tt.p.Parse()
-		if !reflect.DeepEqual(err, tt.err) {
-			t.Errorf("Check %v: got %v, want %v", tt.p.stat, err, tt.err)
-			continue
+		{
+			format: []string{"comm,etime,pcpu"},
+			sort:   "-etime",
+			out:    "COMMAND  ELAPSED %CPU\nsystemd    16:39  0.5\ndns masq   16:27  0.0\n",
+		},
+	} {
+		cols, err := parseFormat(tt.format)
+		if err != nil {
+			t.Fatal(err)
 		}
+		keys, err := parseSort(tt.sort)
 		if err != nil {
-			continue
+			t.Fatal(err)
 		}
-		pT := NewProcessTable()
-		pT.table = []*Process{tt.p}
-		pT.mProc = tt.p
 		var b bytes.Buffer
-		err = ps(pT, &b)
-		t.Logf("ps out is %s", b.String())
-		if !reflect.DeepEqual(err, tt.err) {
-			t.Errorf("%v: got %v, want %v", pT, err, tt.err)
-			continue
+		if err := ps(all, all[0], cols, keys, &b); err != nil {
+			t.Fatal(err)
 		}
 		if b.String() != tt.out {
-			t.Errorf("%s: got %q, want %q", tt.p.stat, b.String(), tt.out)
-			continue
+			t.Errorf("ps -o %s --sort %s: got %q, want %q", tt.format, tt.sort, b.String(), tt.out)
 		}
+	}
+}
 
+func TestPsErrors(t *testing.T) {
+	if _, err := parseFormat([]string{"pid,bogus"}); err == nil {
+		t.Errorf("parseFormat(pid,bogus) succeeded, want an error")
+	}
+	if _, err := parseSort("+pid,-bogus"); err == nil {
+		t.Errorf("parseSort(+pid,-bogus) succeeded, want an error")
+	}
+}
+
+func TestElapsed(t *testing.T) {
+	for d, want := range map[time.Duration]string{
+		5 * time.Second:  "00:05",
+		61 * time.Minute: "01:01:00",
+		49*time.Hour + 3*time.Minute + 4*time.Second: "2-01:03:04",
+	} {
+		if got := elapsed(d); got != want {
+			t.Errorf("elapsed(%v) = %q, want %q", d, got, want)
+		}
 	}
 }
 
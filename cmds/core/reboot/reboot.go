@@ -0,0 +1,53 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// reboot reboots the machine.
+//
+// Synopsis:
+//     reboot [-f] [-v] [-kexec]
+//
+// Description:
+//     reboot sends SIGTERM, then SIGKILL, to all processes, syncs and
+//     unmounts file systems, and reboots the machine.
+//
+//     With -kexec, it reboots into the kernel loaded with kexec -l, e.g.
+//     to boot a new kernel without going through the firmware.
+//
+// Options:
+//     -f:     only sync, without killing processes and unmounting
+//     -v:     verbose output
+//     -kexec: reboot into the kernel loaded with kexec -l
+package main
+
+import (
+	"flag"
+	"log"
+
+	"github.com/u-root/u-root/pkg/shutdown"
+	"golang.org/x/sys/unix"
+)
+
+var (
+	force = flag.Bool("f", false, "Only sync, without killing processes and unmounting file systems")
+	kexec = flag.Bool("kexec", false, "Reboot into the kernel loaded with kexec -l")
+	debug = flag.Bool("v", false, "Verbose output")
+)
+
+func main() {
+	flag.Parse()
+	o := shutdown.Options{Force: *force}
+	if *debug {
+		o.Debug = log.Printf
+	}
+	cmd := uint32(unix.LINUX_REBOOT_CMD_RESTART)
+	if *kexec {
+		cmd = unix.LINUX_REBOOT_CMD_KEXEC
+	}
+	if !*force {
+		shutdown.Wall("The system is going down for reboot NOW!")
+	}
+	if err := shutdown.Shutdown(cmd, o); err != nil {
+		log.Fatal(err)
+	}
+}
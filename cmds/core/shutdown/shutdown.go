@@ -5,17 +5,27 @@
 // shutdown halts, suspends, or reboots at a specified time, or immediately.
 //
 // Synopsis:
-//     shutdown [<-h|-r|-s|halt|reboot|suspend> [time [message...]]]
+//     shutdown [<-h|-r|-s|-k|halt|reboot|suspend|kexec> [time [message...]]]
+//     shutdown -c
 //
 // Description:
-//     current operations are reboot (-r), suspend, and halt [-h].
+//     current operations are reboot (-r), suspend, halt [-h], and kexec
+//     (-k), which reboots into a kernel loaded with kexec -l.
 //     If no operation is specified halt is assumed.
 //     If a time is given, an opcode is not optional.
+//     Before halting or rebooting, processes are sent SIGTERM, then
+//     SIGKILL, and file systems are synced and unmounted.
+//
+//     A delayed shutdown is announced on the console and all pseudo
+//     terminals when it is scheduled, 10, 5 and 1 minutes before, and
+//     when it happens. shutdown -c cancels it.
 //
 // Options:
 //     -r|reboot:	reboot the machine.
 //     -h|halt:		halt the machine.
 //     -s|suspend:	suspend the machine.
+//     -k|kexec:	reboot into the kernel loaded with kexec -l.
+//     -c:		cancel a delayed shutdown.
 //
 // Time is specified as "now", +minutes, or RFC3339 format.
 // All other arguments past time are printed as a message.
@@ -24,11 +34,16 @@ package main
 
 import (
 	"fmt"
+	"io/ioutil"
 	"log"
 	"os"
+	"os/signal"
+	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/u-root/u-root/pkg/shutdown"
 	"golang.org/x/sys/unix"
 )
 
@@ -40,17 +55,82 @@ var (
 		"-r":      unix.LINUX_REBOOT_CMD_RESTART,
 		"suspend": unix.LINUX_REBOOT_CMD_SW_SUSPEND,
 		"-s":      unix.LINUX_REBOOT_CMD_SW_SUSPEND,
+		"kexec":   unix.LINUX_REBOOT_CMD_KEXEC,
+		"-k":      unix.LINUX_REBOOT_CMD_KEXEC,
+	}
+	names = map[uint]string{
+		unix.LINUX_REBOOT_CMD_POWER_OFF:  "halt",
+		unix.LINUX_REBOOT_CMD_RESTART:    "reboot",
+		unix.LINUX_REBOOT_CMD_SW_SUSPEND: "suspend",
+		unix.LINUX_REBOOT_CMD_KEXEC:      "kexec",
 	}
-	reboot = unix.Reboot
-	delay  = time.Sleep
+	reboot   = unix.Reboot
+	goDown   = shutdown.Shutdown
+	wall     = shutdown.Wall
+	delay    = time.Sleep
+	pidFile  = "/run/shutdown.pid"
+	warnings = []time.Duration{10 * time.Minute, 5 * time.Minute, time.Minute}
 )
 
 func usage() {
-	log.Fatalf("shutdown [<-h|-r|-s|halt|reboot|suspend> [time [message...]]]")
+	log.Fatalf("shutdown [<-h|-r|-s|-k|halt|reboot|suspend|kexec> [time [message...]]] | -c")
+}
+
+// cancel stops the delayed shutdown which is waiting.
+func cancel() error {
+	b, err := ioutil.ReadFile(pidFile)
+	if os.IsNotExist(err) {
+		return fmt.Errorf("no shutdown is scheduled")
+	} else if err != nil {
+		return err
+	}
+	pid, err := strconv.Atoi(strings.TrimSpace(string(b)))
+	if err != nil {
+		return fmt.Errorf("%s: %v", pidFile, err)
+	}
+	return unix.Kill(pid, unix.SIGINT)
+}
+
+// wait waits for d, announcing the shutdown at the warnings, unless it is
+// cancelled.
+func wait(d time.Duration, what, msg string) {
+	os.MkdirAll(filepath.Dir(pidFile), 0755)
+	if err := ioutil.WriteFile(pidFile, []byte(strconv.Itoa(os.Getpid())), 0644); err != nil {
+		log.Printf("Cannot be cancelled: %v", err)
+	}
+	defer os.Remove(pidFile)
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, unix.SIGINT, unix.SIGTERM)
+	go func() {
+		<-c
+		os.Remove(pidFile)
+		wall("The system shutdown has been cancelled.")
+		os.Exit(0)
+	}()
+
+	announce := func(left time.Duration) {
+		wall(fmt.Sprintf("The system is going down for %s in %v!\n%s", what, left.Round(time.Second), msg))
+	}
+	announce(d)
+	for _, w := range warnings {
+		if d > w {
+			delay(d - w)
+			d = w
+			announce(d)
+		}
+	}
+	delay(d)
+	signal.Stop(c)
 }
 
 func main() {
 	a := os.Args
+	if len(a) == 2 && a[1] == "-c" {
+		if err := cancel(); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
 	if len(a) == 1 {
 		a = append(a, "halt")
 	}
@@ -78,11 +158,19 @@ func main() {
 		when = t
 	}
 
-	delay(when.Sub(time.Now()))
-	if len(a) > 2 {
-		fmt.Println(strings.Join(a[3:], " "))
+	msg := strings.Join(a[3:], " ")
+	if d := time.Until(when); d > 0 {
+		wait(d, names[op], msg)
+	}
+	fmt.Println(msg)
+	if op == unix.LINUX_REBOOT_CMD_SW_SUSPEND {
+		if err := reboot(int(op)); err != nil {
+			log.Fatal(err)
+		}
+		return
 	}
-	if err := reboot(int(op)); err != nil {
+	wall(fmt.Sprintf("The system is going down for %s NOW!\n%s", names[op], msg))
+	if err := goDown(uint32(op), shutdown.Options{Debug: log.Printf}); err != nil {
 		log.Fatal(err)
 	}
 }
@@ -5,12 +5,15 @@
 package main
 
 import (
+	"fmt"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"syscall"
 	"testing"
 	"time"
 
+	"github.com/u-root/u-root/pkg/shutdown"
 	"golang.org/x/sys/unix"
 )
 
@@ -26,6 +29,8 @@ var tests = []struct {
 	{[]string{"-r"}, 3},
 	{[]string{"suspend"}, 4},
 	{[]string{"-s"}, 4},
+	{[]string{"kexec"}, 5},
+	{[]string{"-k"}, 5},
 	// good times, bad times
 	{[]string{"halt", "police"}, 1},
 	// Yep, it's legal.
@@ -78,6 +83,8 @@ func TestHelperProcess(t *testing.T) {
 			xval = 3
 		case unix.LINUX_REBOOT_CMD_SW_SUSPEND:
 			xval = 4
+		case unix.LINUX_REBOOT_CMD_KEXEC:
+			xval = 5
 		}
 
 		t.Logf("Exit with %#x", i)
@@ -86,6 +93,9 @@ func TestHelperProcess(t *testing.T) {
 	}
 
 	delay = func(_ time.Duration) {}
+	goDown = func(cmd uint32, _ shutdown.Options) error { return reboot(int(cmd)) }
+	wall = func(string) {}
+	pidFile = filepath.Join(os.TempDir(), fmt.Sprintf("shutdown-test-%d.pid", os.Getpid()))
 	os.Args = append([]string{"shutdown"}, os.Args[3:]...)
 	main()
 }
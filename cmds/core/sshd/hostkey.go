@@ -0,0 +1,58 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// hostKey reads the host key at path. If there is none, it generates one
+// and saves it there, so that clients see the same key after reboots if
+// path is on a writable, persistent volume. If saving fails, the key is
+// only used until sshd exits.
+func hostKey(path string) (ssh.Signer, error) {
+	b, err := ioutil.ReadFile(path)
+	if err == nil {
+		return ssh.ParsePrivateKey(b)
+	}
+	if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	log.Printf("No host key at %s; generating one", path)
+	b, err = generateHostKey()
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		log.Printf("Not saving the host key: %v", err)
+	} else if err := ioutil.WriteFile(path, b, 0600); err != nil {
+		log.Printf("Not saving the host key: %v", err)
+	}
+	return ssh.ParsePrivateKey(b)
+}
+
+// generateHostKey returns a new ECDSA P-256 key, PEM encoded.
+func generateHostKey() ([]byte, error) {
+	k, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	der, err := x509.MarshalECPrivateKey(k)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}), nil
+}
@@ -0,0 +1,42 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestHostKey(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sshd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "etc", "ssh_host_key")
+	k, err := hostKey(path)
+	if err != nil {
+		t.Fatalf("hostKey(%s) = %v, want nil", path, err)
+	}
+	fi, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("Generated key not saved: %v", err)
+	}
+	if fi.Mode().Perm() != 0600 {
+		t.Errorf("Saved key mode = %v, want 0600", fi.Mode().Perm())
+	}
+
+	again, err := hostKey(path)
+	if err != nil {
+		t.Fatalf("hostKey(%s) of the saved key = %v, want nil", path, err)
+	}
+	if !bytes.Equal(k.PublicKey().Marshal(), again.PublicKey().Marshal()) {
+		t.Errorf("hostKey(%s) generated another key, want the saved one", path)
+	}
+}
@@ -2,6 +2,31 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
+// sshd serves shells, commands and sftp over SSH, e.g. for remote recovery.
+//
+// Synopsis:
+//     sshd [OPTIONS]
+//
+// Description:
+//     sshd accepts clients whose public keys are in the -keys file, and
+//     runs the shell for them, with a pty if they ask for one, or a command
+//     with the shell's -c. The sftp subsystem serves the files of the
+//     local file system, for sftp, scp and sshfs.
+//
+//     The host key is read from -privatekey. If there is none, an ECDSA key
+//     is generated and saved there, so it persists if that is a writable
+//     volume.
+//
+//     With -wait-net, sshd waits for -ip, or any global unicast address,
+//     to be configured before listening, e.g. by dhclient run by init.
+//
+// Options:
+//     -d:          enable debug prints
+//     -keys:       path to the authorized_keys file
+//     -privatekey: path of the host key
+//     -ip:         ip address to listen on
+//     -port:       port to listen on
+//     -wait-net:   how long to wait for the address to listen on
 package main
 
 import (
@@ -13,8 +38,11 @@ import (
 	"net"
 	"os"
 	"os/exec"
+	"time"
 
 	"github.com/u-root/u-root/pkg/pty"
+	"github.com/u-root/u-root/pkg/sftp"
+	"github.com/u-root/u-root/pkg/termios"
 	"golang.org/x/crypto/ssh"
 )
 
@@ -34,43 +62,81 @@ type (
 	exitStatusReq struct {
 		ExitStatus uint32
 	}
+	windowChangeReq struct {
+		Col    uint32
+		Row    uint32
+		Xpixel uint32
+		Ypixel uint32
+	}
+	envReq struct {
+		Name  string
+		Value string
+	}
+	subsystemReq struct {
+		Name string
+	}
 )
 
 var (
 	debug   = flag.Bool("d", false, "Enable debug prints")
 	keys    = flag.String("keys", "authorized_keys", "Path to the authorized_keys file")
-	privkey = flag.String("privatekey", "id_rsa", "Path of private key")
+	privkey = flag.String("privatekey", "id_rsa", "Path of the host key, which is generated if there is none")
 	ip      = flag.String("ip", "0.0.0.0", "ip address to listen on")
 	port    = flag.String("port", "2022", "port to listen on")
+	wait    = flag.Duration("wait-net", 0, "Wait this long for an address to listen on, e.g. from DHCP")
 	dprintf = func(string, ...interface{}) {}
 )
 
-// start a command
+// runCommand runs cmd with its stdio connected to c, through p if it is not
+// nil, and sends its exit status when it is done.
 // TODO: use /etc/passwd, but the Go support for that is incomplete
-func runCommand(c ssh.Channel, p *pty.Pty, cmd string, args ...string) error {
+func runCommand(c ssh.Channel, p *pty.Pty, env []string, cmd string, args ...string) error {
 	var ps *os.ProcessState
 	defer c.Close()
 
 	if p != nil {
 		log.Printf("Executing PTY command %s %v", cmd, args)
 		p.Command(cmd, args...)
+		p.C.Env = append(os.Environ(), env...)
 		if err := p.C.Start(); err != nil {
 			dprintf("Failed to execute: %v", err)
 			return err
 		}
-		defer p.C.Wait()
+		// Only the command has the pts open now, so reading the
+		// ptm fails once it and its children are gone.
+		p.Pts.Close()
+		p.TTY.Close()
 		go io.Copy(p.Ptm, c)
-		go io.Copy(c, p.Ptm)
-		ps, _ = p.C.Process.Wait()
+		done := make(chan struct{})
+		go func() {
+			io.Copy(c, p.Ptm)
+			close(done)
+		}()
+		p.C.Wait()
+		<-done
+		p.Ptm.Close()
+		ps = p.C.ProcessState
 	} else {
 		e := exec.Command(cmd, args...)
-		e.Stdin, e.Stdout, e.Stderr = c, c, c
+		e.Env = append(os.Environ(), env...)
+		e.Stdout, e.Stderr = c, c.Stderr()
+		// The command's stdin is a pipe, so that waiting for it
+		// does not wait for the client to close its input.
+		stdin, err := e.StdinPipe()
+		if err != nil {
+			return err
+		}
 		log.Printf("Executing non-PTY command %s %v", cmd, args)
 		if err := e.Start(); err != nil {
 			dprintf("Failed to execute: %v", err)
 			return err
 		}
-		ps, _ = e.Process.Wait()
+		go func() {
+			io.Copy(stdin, c)
+			stdin.Close()
+		}()
+		e.Wait()
+		ps = e.ProcessState
 	}
 
 	// TODO(bluecmd): If somebody wants we can send exit-signal to return
@@ -86,34 +152,45 @@ func runCommand(c ssh.Channel, p *pty.Pty, cmd string, args ...string) error {
 	return nil
 }
 
-func newPTY(b []byte) (*pty.Pty, error) {
+// runSubsystem serves a subsystem on c.
+func runSubsystem(c ssh.Channel, name string) {
+	defer c.Close()
+	log.Printf("Serving subsystem %s", name)
+	var code uint32
+	if err := sftp.Serve(c); err != nil {
+		log.Printf("%s: %v", name, err)
+		code = 1
+	}
+	c.SendRequest("exit-status", false, ssh.Marshal(exitStatusReq{code}))
+}
+
+// setWinSize sets the window size of p through its ptm, since the pts is
+// closed once the command runs.
+func setWinSize(p *pty.Pty, col, row, xpixel, ypixel uint32) error {
+	ws := &termios.Winsize{}
+	ws.Row = uint16(row)
+	ws.Col = uint16(col)
+	ws.Xpixel = uint16(xpixel)
+	ws.Ypixel = uint16(ypixel)
+	dprintf("Set winsizes to %v", ws)
+	return termios.SetWinSize(p.Ptm.Fd(), ws)
+}
+
+func newPTY(b []byte) (*pty.Pty, string, error) {
 	ptyReq := &ptyReq{}
 	err := ssh.Unmarshal(b, ptyReq)
 	dprintf("newPTY: %q", ptyReq)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	p, err := pty.New()
 	if err != nil {
-		return nil, err
-	}
-	ws, err := p.TTY.GetWinSize()
-	if err != nil {
-		return nil, err
-	}
-	ws.Row = uint16(ptyReq.Row)
-	ws.Ypixel = uint16(ptyReq.Ypixel)
-	ws.Col = uint16(ptyReq.Col)
-	ws.Xpixel = uint16(ptyReq.Xpixel)
-	dprintf("newPTY: Set winsizes to %v", ws)
-	if err := p.TTY.SetWinSize(ws); err != nil {
-		return nil, err
+		return nil, "", err
 	}
-	dprintf("newPTY: set TERM to %q", ptyReq.TERM)
-	if err := os.Setenv("TERM", ptyReq.TERM); err != nil {
-		return nil, err
+	if err := setWinSize(p, ptyReq.Col, ptyReq.Row, ptyReq.Xpixel, ptyReq.Ypixel); err != nil {
+		return nil, "", err
 	}
-	return p, nil
+	return p, ptyReq.TERM, nil
 }
 
 func init() {
@@ -124,8 +201,94 @@ func init() {
 	}
 }
 
+// sessionState is the state of a session channel, up to its shell, command or
+// subsystem.
+type sessionState struct {
+	c   ssh.Channel
+	p   *pty.Pty
+	env []string
+
+	// started is set once the session's program runs.
+	started bool
+}
+
+// request handles a request on the session, and reports whether it
+// succeeded.
+func (s *sessionState) request(req *ssh.Request) bool {
+	dprintf("Request %v", req.Type)
+	switch req.Type {
+	case "pty-req":
+		if s.p != nil || s.started {
+			return false
+		}
+		p, term, err := newPTY(req.Payload)
+		if err != nil {
+			log.Printf("sshd: %v", err)
+			return false
+		}
+		s.p = p
+		s.env = append(s.env, "TERM="+term)
+		return true
+
+	case "window-change":
+		w := &windowChangeReq{}
+		if s.p == nil || ssh.Unmarshal(req.Payload, w) != nil {
+			return false
+		}
+		if err := setWinSize(s.p, w.Col, w.Row, w.Xpixel, w.Ypixel); err != nil {
+			log.Printf("sshd: %v", err)
+		}
+		return true
+
+	case "env":
+		e := &envReq{}
+		if s.started || ssh.Unmarshal(req.Payload, e) != nil {
+			return false
+		}
+		s.env = append(s.env, e.Name+"="+e.Value)
+		return true
+
+	case "shell", "exec":
+		if s.started {
+			return false
+		}
+		args := []string{}
+		if req.Type == "exec" {
+			e := &execReq{}
+			if err := ssh.Unmarshal(req.Payload, e); err != nil {
+				log.Printf("sshd: %v", err)
+				return false
+			}
+			// Execute command using user's shell. This is what OpenSSH does
+			// so it's the least surprising to the user.
+			args = []string{"-c", e.Command}
+		}
+		s.started = true
+		go func() {
+			if err := runCommand(s.c, s.p, s.env, shell, args...); err != nil {
+				log.Printf("sshd: %v", err)
+			}
+		}()
+		return true
+
+	case "subsystem":
+		e := &subsystemReq{}
+		if s.started || ssh.Unmarshal(req.Payload, e) != nil {
+			return false
+		}
+		if e.Name != "sftp" {
+			log.Printf("Not serving subsystem %q", e.Name)
+			return false
+		}
+		s.started = true
+		go runSubsystem(s.c, e.Name)
+		return true
+	}
+	log.Printf("Not handling req %v %q", req, string(req.Payload))
+	return false
+}
+
 func session(chans <-chan ssh.NewChannel) {
-	var p *pty.Pty
 	// Service the incoming Channel channel.
 	for newChannel := range chans {
 		// Channels have a type, depending on the application level
@@ -143,35 +306,37 @@ func session(chans <-chan ssh.NewChannel) {
 		}
 
 		// Sessions have out-of-band requests such as "shell",
-		// "pty-req" and "env".  Here we handle only the
-		// "shell" request.
-		go func(in <-chan *ssh.Request) {
+		// "pty-req" and "env", which each have their own state.
+		go func(s *sessionState, in <-chan *ssh.Request) {
 			for req := range in {
-				dprintf("Request %v", req.Type)
-				switch req.Type {
-				case "shell":
-					err := runCommand(channel, p, shell)
-					req.Reply(true, []byte(fmt.Sprintf("%v", err)))
-				case "exec":
-					e := &execReq{}
-					if err := ssh.Unmarshal(req.Payload, e); err != nil {
-						log.Printf("sshd: %v", err)
-						break
-					}
-					// Execute command using user's shell. This is what OpenSSH does
-					// so it's the least surprising to the user.
-					err := runCommand(channel, p, shell, "-c", e.Command)
-					req.Reply(true, []byte(fmt.Sprintf("%v", err)))
-				case "pty-req":
-					p, err = newPTY(req.Payload)
-					req.Reply(err == nil, nil)
-				default:
-					log.Printf("Not handling req %v %q", req, string(req.Payload))
-					req.Reply(false, nil)
-				}
+				req.Reply(s.request(req), nil)
 			}
-		}(requests)
+		}(&sessionState{c: channel}, requests)
+	}
+}
 
+// waitNet waits up to d for the address to listen on to be configured,
+// e.g. by DHCP. If it is unspecified, any global unicast address will do.
+func waitNet(d time.Duration) error {
+	want := net.ParseIP(*ip)
+	for end := time.Now().Add(d); ; time.Sleep(100 * time.Millisecond) {
+		addrs, err := net.InterfaceAddrs()
+		if err != nil {
+			return err
+		}
+		for _, a := range addrs {
+			n, ok := a.(*net.IPNet)
+			if !ok {
+				continue
+			}
+			if n.IP.Equal(want) || (want.IsUnspecified() && n.IP.IsGlobalUnicast()) {
+				dprintf("Got address %v", n.IP)
+				return nil
+			}
+		}
+		if time.Now().After(end) {
+			return fmt.Errorf("no address to listen on after %v", d)
+		}
 	}
 }
 
@@ -216,18 +381,20 @@ func main() {
 		},
 	}
 
-	privateBytes, err := ioutil.ReadFile(*privkey)
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	private, err := ssh.ParsePrivateKey(privateBytes)
+	private, err := hostKey(*privkey)
 	if err != nil {
 		log.Fatal(err)
 	}
+	log.Printf("Host key fingerprint %s", ssh.FingerprintSHA256(private.PublicKey()))
 
 	config.AddHostKey(private)
 
+	if *wait > 0 {
+		if err := waitNet(*wait); err != nil {
+			log.Fatal(err)
+		}
+	}
+
 	// Once a ServerConfig has been configured, connections can be
 	// accepted.
 	listener, err := net.Listen("tcp", net.JoinHostPort(*ip, *port))
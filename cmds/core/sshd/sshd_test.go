@@ -0,0 +1,38 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/u-root/u-root/pkg/pty"
+	"github.com/u-root/u-root/pkg/termios"
+)
+
+func TestSetWinSize(t *testing.T) {
+	p, err := pty.New()
+	if err != nil {
+		t.Skipf("No pty: %v", err)
+	}
+	defer p.Ptm.Close()
+	defer p.TTY.Close()
+
+	if err := setWinSize(p, 80, 24, 0, 0); err != nil {
+		t.Fatalf("setWinSize(80x24) = %v, want nil", err)
+	}
+	// runCommand closes the pts once the command runs, and later
+	// window-change requests must still work.
+	p.Pts.Close()
+	if err := setWinSize(p, 132, 43, 1056, 688); err != nil {
+		t.Fatalf("setWinSize(132x43) after closing the pts = %v, want nil", err)
+	}
+	ws, err := termios.GetWinSize(p.Ptm.Fd())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ws.Col != 132 || ws.Row != 43 || ws.Xpixel != 1056 || ws.Ypixel != 688 {
+		t.Errorf("Window size is %+v, want 132x43 (1056x688 pixels)", ws)
+	}
+}
@@ -2,15 +2,29 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-// +build linux,amd64
+// +build linux
+// +build amd64 arm64
 
 // strace is a simple multi-process syscall & signal tracer.
 //
 // Synopsis:
-//     strace <command> [args...]
+//     strace [-o <outputfile>] [-e trace=<syscalls>] <command> [args...]
 //
 // Description:
-//	trace a single process given a command name.
+//	trace a process given a command name, and the processes it forks,
+//	clones and executes, with the PID of each in front of its events.
+//	Syscalls are printed with their decoded arguments, e.g. paths, open
+//	flags and socket addresses.
+//
+//	<syscalls> are comma-separated syscall names, e.g. openat,read, or
+//	classes: %file, %network, %process or %signal, or all or none. A
+//	leading ! traces all other syscalls. Signals, exits and new processes
+//	are always printed.
+//
+// Options:
+//	-e: trace=<syscalls>, or <syscalls>
+//	-f: children are always traced, for compatibility
+//	-o: write output to file (default: stdout)
 package main
 
 import (
@@ -21,12 +35,13 @@ import (
 	"log"
 	"os"
 	"os/exec"
+	"strings"
 
 	"github.com/u-root/u-root/pkg/strace"
 )
 
 const (
-	cmdUsage = "Usage: strace [-o <outputfile>] <command> [args...]"
+	cmdUsage = "Usage: strace [-o <outputfile>] [-e trace=<syscalls>] <command> [args...]"
 )
 
 func usage() {
@@ -35,6 +50,8 @@ func usage() {
 
 func main() {
 	o := flag.String("o", "", "write output to file (if empty, stdout)")
+	e := flag.String("e", "", "trace=<syscalls>: only print these syscalls")
+	_ = flag.Bool("f", true, "children are always traced")
 	flag.Parse()
 
 	a := flag.Args()
@@ -54,7 +71,22 @@ func main() {
 		defer f.Close()
 		out = f
 	}
-	if err := strace.Strace(c, out); err != nil {
+	printer := strace.PrintTraces(out)
+	if *e != "" {
+		expr := *e
+		if i := strings.IndexByte(expr, '='); i >= 0 {
+			if expr[:i] != "trace" {
+				log.Fatalf("-e %s is not supported, only trace=", expr[:i])
+			}
+			expr = expr[i+1:]
+		}
+		f, err := strace.ParseFilter(expr)
+		if err != nil {
+			log.Fatalf("-e: %v", err)
+		}
+		printer = strace.FilterTraces(f, printer)
+	}
+	if err := strace.Trace(c, printer); err != nil {
 		log.Printf("strace exited: %v", err)
 	}
 }
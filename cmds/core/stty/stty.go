@@ -19,19 +19,29 @@
 // people ever do anyway.
 
 // The command works like this:
-// stty [verb] [options]
+// stty [-F device] [verb] [options]
 // Verbs are:
+// -a -- print all settings, in the model of stty -a
 // dump -- dump the json of the struct to stdout
 // load -- read a json file from stdin and use it to set
 // raw -- convenience command to set raw
-// cooked -- convenience command to set cooked
+// cbreak -- convenience command to set cbreak: no line editing or echo, but signals
+// cooked -- convenience command to set cooked, undoing raw and cbreak
+// sane -- convenience command to reset to sane settings and control characters
+// size -- print the number of rows and columns
+// speed -- print the speed
 // In common stty usage, options may be specified without a verb.
 //
 // any other verb, with a ~ or without, is taken to mean standard stty args, e.g.
 // stty ~echo
-// turns off echo. Flags with arguments work too:
+// turns off echo; -echo works too. Flags with arguments work too:
 // stty intr 1
-// sets the interrupt character to ^A.
+// sets the interrupt character to ^A, as does stty intr ^A.
+// A bare number sets the speed, cs5 to cs8 the character size, and
+// parenb, parodd, cstopb, crtscts, ixon and ixoff the rest of the serial
+// line parameters:
+// stty 115200 cs8 ~parenb ~cstopb crtscts
+// rows and cols set the window size.
 //
 // The JSON encoding lets you do things like this:
 // stty dump | sed whatever > file
@@ -45,8 +55,9 @@
 // stty -g
 // 4500:5:bf:8a3b:3:1c:7f:15:4:0:1:0:11:13:1a:0:12:f:17:16:0:0:0:0:0:0:0:0:0:0:0:0:0:0:0:0
 //
-// We always do our operations on fd 0, as that is standard, and we always do an initial
-// termios.GTTY to ensure we have access to fd 0.
+// We do our operations on fd 0, as that is standard, unless -F names another
+// device, e.g. a serial console. We always do an initial termios.GTTY to
+// ensure we have access to it.
 package main
 
 import (
@@ -56,23 +67,81 @@ import (
 	"log"
 	"os"
 
+	"golang.org/x/sys/unix"
+
 	"github.com/u-root/u-root/pkg/termios"
 )
 
+var (
+	// cooked undoes raw and cbreak.
+	cooked = []string{"brkint", "ignpar", "icrnl", "ixon", "opost", "isig", "icanon", "echo", "eof", "^D", "eol", "undef"}
+	// sane resets the modes and control characters, like GNU stty sane.
+	sane = []string{
+		"cread", "~ignbrk", "brkint", "~inlcr", "~igncr", "icrnl", "~ixoff", "imaxbel",
+		"opost", "~ocrnl", "onlcr", "~onocr", "~onlret", "~ofill", "~ofdel",
+		"isig", "icanon", "iexten", "echo", "echoe", "echok", "~echonl", "~noflsh", "~tostop", "~echoprt", "echoctl", "echoke",
+		"intr", "^C", "quit", "^\\", "erase", "^?", "kill", "^U", "eof", "^D", "eol", "undef", "eol2", "undef",
+		"start", "^Q", "stop", "^S", "susp", "^Z", "werase", "^W", "lnext", "^V", "min", "1", "time", "0",
+	}
+)
+
+// set applies opts to fd and prints the result.
+func set(fd int, t *termios.TTY, opts []string) {
+	if err := t.SetOpts(opts); err != nil {
+		log.Fatalf("setting opts: %v", err)
+	}
+	n, err := t.STTY(fd)
+	if err != nil {
+		log.Fatalf("stty: %v", err)
+	}
+	fmt.Printf("%v\n", n.String())
+}
+
+// open opens the device for stty -F. It does not become the controlling
+// terminal, and opening does not wait for the carrier of serial lines.
+func open(dev string) (int, error) {
+	f, err := os.OpenFile(dev, os.O_RDWR|unix.O_NOCTTY|unix.O_NONBLOCK, 0)
+	if err != nil {
+		return -1, err
+	}
+	fd := int(f.Fd())
+	// Reads and writes through the fd should block as usual.
+	if err := unix.SetNonblock(fd, false); err != nil {
+		return -1, err
+	}
+	// The fd is used until stty exits.
+	return fd, nil
+}
+
 func main() {
-	t, err := termios.GTTY(0)
+	args := os.Args[1:]
+	fd := 0
+	if len(args) > 0 && (args[0] == "-F" || args[0] == "--file") {
+		if len(args) < 2 {
+			log.Fatalf("%s requires a device", args[0])
+		}
+		var err error
+		if fd, err = open(args[1]); err != nil {
+			log.Fatalf("stty: %v", err)
+		}
+		args = args[2:]
+	}
+
+	t, err := termios.GTTY(fd)
 
 	if err != nil {
 		log.Fatalf("termios.GTTY: %v", err)
 	}
 
-	if len(os.Args) == 1 {
-		os.Args = append(os.Args, "pretty")
+	if len(args) == 0 {
+		args = append(args, "pretty")
 	}
 
-	switch os.Args[1] {
+	switch args[0] {
 	case "pretty":
 		fmt.Printf("%v\n", t.String())
+	case "-a", "--all", "all":
+		fmt.Print(t.Settings())
 	case "dump":
 		b, err := json.MarshalIndent(t, "", "\t")
 
@@ -81,33 +150,42 @@ func main() {
 		}
 		fmt.Printf("%s\n", b)
 	case "load":
-		if len(os.Args) != 3 {
+		if len(args) != 2 {
 			log.Fatalf("arg count")
 		}
-		b, err := ioutil.ReadFile(os.Args[2])
+		b, err := ioutil.ReadFile(args[1])
 		if err != nil {
 			log.Fatalf("stty load: %v", err)
 		}
 		if err := json.Unmarshal(b, t); err != nil {
 			log.Fatalf("stty load: %v", err)
 		}
-		n, err := t.STTY(0)
+		n, err := t.STTY(fd)
 		if err != nil {
 			log.Fatalf("stty: %v", err)
 		}
 		fmt.Printf("%v\n", n.String())
 	case "raw":
-		if _, err := termios.Raw(0); err != nil {
+		if _, err := termios.Raw(fd); err != nil {
 			log.Fatalf("raw: %v", err)
 		}
-	default:
-		if err := t.SetOpts(os.Args[1:]); err != nil {
-			log.Fatalf("setting opts: %v", err)
-		}
-		n, err := t.STTY(0)
+	case "cbreak":
+		term, err := termios.GetTermios(uintptr(fd))
 		if err != nil {
-			log.Fatalf("stty: %v", err)
+			log.Fatalf("cbreak: %v", err)
 		}
-		fmt.Printf("%v\n", n.String())
+		if err := termios.SetTermios(uintptr(fd), termios.MakeCBreak(term)); err != nil {
+			log.Fatalf("cbreak: %v", err)
+		}
+	case "cooked":
+		set(fd, t, cooked)
+	case "sane":
+		set(fd, t, sane)
+	case "size":
+		fmt.Printf("%d %d\n", t.Row, t.Col)
+	case "speed":
+		fmt.Printf("%d\n", t.Ispeed)
+	default:
+		set(fd, t, args)
 	}
 }
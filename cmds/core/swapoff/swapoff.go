@@ -0,0 +1,85 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// swapoff stops swapping to devices and files.
+//
+// Synopsis:
+//     swapoff [-e] DEVICE...
+//     swapoff -a
+//
+// Description:
+//     DEVICE may also be given as LABEL=label or UUID=uuid. Pages swapped
+//     out are read back in first, so there must be enough memory.
+//
+// Options:
+//     -a: stop swapping to all swap areas in use
+//     -e: skip devices which do not exist
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/u-root/u-root/pkg/mount"
+	"github.com/u-root/u-root/pkg/mount/block"
+)
+
+var (
+	all      = flag.Bool("a", false, "stop swapping to all swap areas")
+	ifExists = flag.Bool("e", false, "skip devices which do not exist")
+)
+
+func swapoff(spec string) error {
+	dev, err := block.Resolve(spec)
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(dev); os.IsNotExist(err) && *ifExists {
+		return nil
+	}
+	return mount.Swapoff(dev)
+}
+
+// swapoffAll stops swapping to the swap areas in use.
+func swapoffAll() error {
+	swaps, err := mount.Swaps()
+	if err != nil {
+		return err
+	}
+	var failed bool
+	for _, s := range swaps {
+		if err := mount.Swapoff(s.Filename); err != nil {
+			log.Print(err)
+			failed = true
+		}
+	}
+	if failed {
+		return fmt.Errorf("some swap areas are still in use")
+	}
+	return nil
+}
+
+func run(args []string) error {
+	if *all {
+		return swapoffAll()
+	}
+	if len(args) == 0 {
+		return fmt.Errorf("need a device, or -a")
+	}
+	for _, a := range args {
+		if err := swapoff(a); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func main() {
+	flag.Parse()
+	if err := run(flag.Args()); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,226 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// swapon starts swapping to devices and files.
+//
+// Synopsis:
+//     swapon [-d[=POLICY]] [-p PRIORITY] [-e] DEVICE...
+//     swapon -a [-e] [-T FSTAB]
+//     swapon [-s]
+//
+// Description:
+//     DEVICE is a swap area made by mkswap: a partition, a zram device or a
+//     file. It may also be given as LABEL=label or UUID=uuid.
+//
+//     -a starts swapping to the swap entries of fstab, except noauto ones
+//     and those already in use. Their pri=PRIORITY and discard[=POLICY]
+//     options are used, and errors of nofail entries are only logged.
+//
+//     Without arguments, swapon lists the swap areas in use.
+//
+// Options:
+//     -a: swap to the swap areas of fstab
+//     -d: discard freed pages: POLICY is once, to discard the whole area
+//         when swapping starts, pages, to discard pages as they are freed,
+//         or both, the default
+//     -e: skip devices which do not exist
+//     -p: priority, from 0 to 32767; higher ones are used first (default:
+//         chosen by the kernel)
+//     -s: print /proc/swaps
+//     -T: fstab file (default: /etc/fstab)
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/u-root/u-root/pkg/mount"
+	"github.com/u-root/u-root/pkg/mount/block"
+)
+
+// discardFlag is -d, with an optional policy.
+type discardFlag string
+
+func (d *discardFlag) String() string { return string(*d) }
+
+func (d *discardFlag) Set(s string) error {
+	if s == "true" {
+		s = "both"
+	}
+	*d = discardFlag(s)
+	return nil
+}
+
+func (d *discardFlag) IsBoolFlag() bool { return true }
+
+var (
+	all      = flag.Bool("a", false, "swap to the swap areas of fstab")
+	discard  discardFlag
+	ifExists = flag.Bool("e", false, "skip devices which do not exist")
+	priority = flag.Int("p", -1, "priority")
+	summary  = flag.Bool("s", false, "print /proc/swaps")
+	fstab    = flag.String("T", "/etc/fstab", "fstab file")
+)
+
+func init() {
+	flag.Var(&discard, "d", "discard freed pages: once, pages or both")
+}
+
+// swapFlags returns the swapon(2) flags of a priority, -1 for the
+// kernel's, and a discard policy, "" for none.
+func swapFlags(prio int, policy string) (int, error) {
+	var flags int
+	if prio >= 0 {
+		if prio > mount.SWAP_FLAG_PRIO_MASK {
+			return 0, fmt.Errorf("priority %d is not within 0-%d", prio, mount.SWAP_FLAG_PRIO_MASK)
+		}
+		flags |= mount.SWAP_FLAG_PREFER | prio
+	}
+	switch policy {
+	case "":
+	case "both":
+		flags |= mount.SWAP_FLAG_DISCARD
+	case "once":
+		flags |= mount.SWAP_FLAG_DISCARD | mount.SWAP_FLAG_DISCARD_ONCE
+	case "pages":
+		flags |= mount.SWAP_FLAG_DISCARD | mount.SWAP_FLAG_DISCARD_PAGES
+	default:
+		return 0, fmt.Errorf("unknown discard policy %q", policy)
+	}
+	return flags, nil
+}
+
+// entryFlags returns the swapon(2) flags of the options of an fstab entry.
+func entryFlags(e mount.FstabEntry) (int, error) {
+	prio, policy := -1, ""
+	for _, o := range e.Options {
+		switch {
+		case strings.HasPrefix(o, "pri="):
+			p, err := strconv.Atoi(o[4:])
+			if err != nil {
+				return 0, fmt.Errorf("invalid priority %q", o)
+			}
+			prio = p
+		case o == "discard":
+			policy = "both"
+		case strings.HasPrefix(o, "discard="):
+			policy = o[8:]
+		}
+	}
+	return swapFlags(prio, policy)
+}
+
+// active returns the swap areas in use, by resolved path.
+func active() map[string]bool {
+	m := map[string]bool{}
+	swaps, err := mount.Swaps()
+	if err != nil {
+		return m
+	}
+	for _, s := range swaps {
+		if p, err := filepath.EvalSymlinks(s.Filename); err == nil {
+			m[p] = true
+		}
+	}
+	return m
+}
+
+func swapon(spec string, flags int) error {
+	dev, err := block.Resolve(spec)
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(dev); os.IsNotExist(err) && *ifExists {
+		return nil
+	}
+	return mount.Swapon(dev, flags)
+}
+
+func swaponAll(fstab string) error {
+	entries, err := mount.ReadFstab(fstab)
+	if err != nil {
+		return err
+	}
+	done := active()
+	var failed bool
+	for _, e := range entries {
+		if !e.IsSwap() || e.HasOption("noauto") {
+			continue
+		}
+		dev, err := block.Resolve(e.Spec)
+		if err == nil {
+			if p, err := filepath.EvalSymlinks(dev); err == nil && done[p] {
+				continue
+			}
+		}
+		flags, err := entryFlags(e)
+		if err == nil {
+			err = swapon(e.Spec, flags)
+		}
+		if err != nil {
+			log.Printf("%s: %v", e.Spec, err)
+			if !e.HasOption("nofail") {
+				failed = true
+			}
+		}
+	}
+	if failed {
+		return fmt.Errorf("some swap areas of %s could not be used", fstab)
+	}
+	return nil
+}
+
+func list(w io.Writer) error {
+	swaps, err := mount.Swaps()
+	if err != nil || len(swaps) == 0 {
+		return err
+	}
+	tw := tabwriter.NewWriter(w, 0, 8, 1, ' ', 0)
+	fmt.Fprintln(tw, "NAME\tTYPE\tSIZE\tUSED\tPRIO")
+	for _, s := range swaps {
+		fmt.Fprintf(tw, "%s\t%s\t%dK\t%dK\t%d\n", s.Filename, s.Type, s.Size, s.Used, s.Priority)
+	}
+	return tw.Flush()
+}
+
+func run(w io.Writer, args []string) error {
+	switch {
+	case *summary:
+		b, err := ioutil.ReadFile(mount.SwapsPath)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(b)
+		return err
+	case *all:
+		return swaponAll(*fstab)
+	case len(args) == 0:
+		return list(w)
+	}
+	flags, err := swapFlags(*priority, string(discard))
+	if err != nil {
+		return err
+	}
+	for _, a := range args {
+		if err := swapon(a, flags); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func main() {
+	flag.Parse()
+	if err := run(os.Stdout, flag.Args()); err != nil {
+		log.Fatal(err)
+	}
+}
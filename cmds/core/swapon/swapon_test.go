@@ -0,0 +1,58 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/u-root/u-root/pkg/mount"
+)
+
+func TestEntryFlags(t *testing.T) {
+	for _, tt := range []struct {
+		options []string
+		want    int
+		ok      bool
+	}{
+		{[]string{"defaults"}, 0, true},
+		{[]string{"pri=10"}, mount.SWAP_FLAG_PREFER | 10, true},
+		{[]string{"sw", "discard"}, mount.SWAP_FLAG_DISCARD, true},
+		{[]string{"discard=once", "pri=0"}, mount.SWAP_FLAG_PREFER | mount.SWAP_FLAG_DISCARD | mount.SWAP_FLAG_DISCARD_ONCE, true},
+		{[]string{"discard=pages"}, mount.SWAP_FLAG_DISCARD | mount.SWAP_FLAG_DISCARD_PAGES, true},
+		{[]string{"discard=sometimes"}, 0, false},
+		{[]string{"pri=high"}, 0, false},
+		{[]string{"pri=32768"}, 0, false},
+	} {
+		got, err := entryFlags(mount.FstabEntry{Options: tt.options})
+		if got != tt.want || (err == nil) != tt.ok {
+			t.Errorf("entryFlags(%q) = %#x, %v, want %#x, ok %v", tt.options, got, err, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestList(t *testing.T) {
+	d, err := ioutil.TempDir("", "swapon")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(d)
+	mount.SwapsPath = filepath.Join(d, "swaps")
+	defer func() { mount.SwapsPath = "/proc/swaps" }()
+	if err := ioutil.WriteFile(mount.SwapsPath, []byte("Filename Type Size Used Priority\n/dev/zram0 partition 1048572 0 100\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	var b bytes.Buffer
+	if err := run(&b, nil); err != nil {
+		t.Fatal(err)
+	}
+	want := "NAME       TYPE      SIZE     USED PRIO\n/dev/zram0 partition 1048572K 0K   100\n"
+	if b.String() != want {
+		t.Errorf("swapon = %q, want %q", b.String(), want)
+	}
+}
@@ -0,0 +1,372 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// sysctl reads and writes kernel parameters in /proc/sys.
+//
+// Synopsis:
+//     sysctl [-n] [-N] [-e] [-q] [-a] [KEY | PATTERN]...
+//     sysctl [-e] [-q] [-w] KEY=VALUE...
+//     sysctl [-e] [-q] -p [FILE]...
+//     sysctl [-e] [-q] -system
+//
+// Description:
+//     Keys are paths below /proc/sys with dots instead of slashes, e.g.
+//     kernel.panic_on_oops for /proc/sys/kernel/panic_on_oops. Dots in
+//     the path, e.g. of VLAN interfaces, are written as slashes.
+//
+//     A KEY prints its value, or the values of all the keys below it.
+//     With -a, or without arguments, all keys are printed, or those
+//     matching one of the shell PATTERNs, e.g. 'net.ipv4.conf.*.rp_filter'.
+//     Keys which cannot be read, e.g. write-only ones, are left out when
+//     listing.
+//
+//     KEY=VALUE writes VALUE to KEY, and prints the new value.
+//
+//     Files have a KEY = VALUE per line. Empty lines and lines starting
+//     with # or ; are ignored, as are errors for keys starting with -.
+//
+// Options:
+//     -a: print all keys, or those matching the PATTERNs
+//     -n: print only the values
+//     -N: print only the names
+//     -e: ignore unknown keys
+//     -q: do not print the values which are written
+//     -w: only allow KEY=VALUE arguments
+//     -p: load the FILEs, or /etc/sysctl.conf; - is stdin
+//     -system: load the .conf files of the sysctl.d directories, ordered
+//         by name, and then /etc/sysctl.conf. Files of the same name in
+//         earlier directories override later ones.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+var (
+	all     = flag.Bool("a", false, "Print all keys, or those matching the patterns")
+	values  = flag.Bool("n", false, "Print only the values")
+	names   = flag.Bool("N", false, "Print only the names")
+	ignore  = flag.Bool("e", false, "Ignore unknown keys")
+	quiet   = flag.Bool("q", false, "Do not print the values which are written")
+	write   = flag.Bool("w", false, "Only allow key=value arguments")
+	load    = flag.Bool("p", false, "Load the files, or /etc/sysctl.conf")
+	system  = flag.Bool("system", false, "Load the files of the sysctl.d directories and /etc/sysctl.conf")
+	procSys = "/proc/sys"
+)
+
+const defaultConf = "/etc/sysctl.conf"
+
+// confDirs are where -system looks for .conf files, in order of precedence.
+var confDirs = []string{
+	"/etc/sysctl.d",
+	"/run/sysctl.d",
+	"/usr/local/lib/sysctl.d",
+	"/usr/lib/sysctl.d",
+	"/lib/sysctl.d",
+}
+
+// sysctl reads and writes the keys below root, printing to w.
+type sysctl struct {
+	root string
+	w    io.Writer
+
+	// failed is set when an error was logged.
+	failed bool
+}
+
+// swap exchanges dots and slashes, to turn keys into paths and back.
+func swap(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '.':
+			return '/'
+		case '/':
+			return '.'
+		}
+		return r
+	}, s)
+}
+
+func (s *sysctl) path(key string) string {
+	return filepath.Join(s.root, swap(key))
+}
+
+func (s *sysctl) key(path string) string {
+	rel, err := filepath.Rel(s.root, path)
+	if err != nil {
+		return path
+	}
+	return swap(filepath.ToSlash(rel))
+}
+
+func (s *sysctl) errorf(format string, v ...interface{}) {
+	log.Printf(format, v...)
+	s.failed = true
+}
+
+// keys returns the keys at and below key, sorted.
+func (s *sysctl) keys(key string) ([]string, error) {
+	var keys []string
+	err := filepath.Walk(s.path(key), func(path string, fi os.FileInfo, err error) error {
+		if err != nil {
+			// Only the key itself must exist.
+			if path == s.path(key) {
+				return err
+			}
+			return nil
+		}
+		if fi.Mode().IsRegular() {
+			keys = append(keys, s.key(path))
+		}
+		return nil
+	})
+	sort.Strings(keys)
+	return keys, err
+}
+
+func (s *sysctl) read(key string) (string, error) {
+	b, err := ioutil.ReadFile(s.path(key))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(string(b), "\n"), nil
+}
+
+func (s *sysctl) print(key, value string) {
+	switch {
+	case *names:
+		fmt.Fprintln(s.w, key)
+	case *values:
+		fmt.Fprintln(s.w, value)
+	default:
+		fmt.Fprintf(s.w, "%s = %s\n", key, value)
+	}
+}
+
+// show prints key, or the keys below it. Keys which cannot be read are
+// only an error if they are asked for by name.
+func (s *sysctl) show(key string) {
+	keys, err := s.keys(key)
+	if err != nil {
+		if !(*ignore && os.IsNotExist(err)) {
+			s.errorf("%s: %v", key, err)
+		}
+		return
+	}
+	for _, k := range keys {
+		if *names {
+			s.print(k, "")
+			continue
+		}
+		v, err := s.read(k)
+		if err != nil {
+			if k == key {
+				s.errorf("%s: %v", key, err)
+			}
+			continue
+		}
+		s.print(k, v)
+	}
+}
+
+// list prints all keys, or those matching one of patterns.
+func (s *sysctl) list(patterns []string) {
+	keys, err := s.keys("")
+	if err != nil {
+		s.errorf("%v", err)
+		return
+	}
+	for _, k := range keys {
+		if len(patterns) > 0 && !match(patterns, k) {
+			continue
+		}
+		if *names {
+			s.print(k, "")
+			continue
+		}
+		if v, err := s.read(k); err == nil {
+			s.print(k, v)
+		}
+	}
+}
+
+// match reports whether key matches one of patterns, by themselves or as
+// the prefix of a subtree. They are matched as paths, so that * matches
+// one component.
+func match(patterns []string, key string) bool {
+	for _, p := range patterns {
+		for k := swap(key); ; {
+			if m, _ := filepath.Match(swap(p), k); m {
+				return true
+			}
+			i := strings.LastIndexByte(k, '/')
+			if i < 0 {
+				break
+			}
+			k = k[:i]
+		}
+	}
+	return false
+}
+
+// set writes value to key and prints it.
+func (s *sysctl) set(key, value string, ignoreErr bool) {
+	key = strings.TrimSpace(key)
+	value = strings.TrimSpace(value)
+	f, err := os.OpenFile(s.path(key), os.O_WRONLY, 0)
+	if err != nil {
+		if !ignoreErr && !(*ignore && os.IsNotExist(err)) {
+			s.errorf("%s: %v", key, err)
+		}
+		return
+	}
+	// The value must be written at once.
+	_, err = f.Write([]byte(value + "\n"))
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		if !ignoreErr {
+			s.errorf("%s = %s: %v", key, value, err)
+		}
+		return
+	}
+	if !*quiet {
+		s.print(key, value)
+	}
+}
+
+// setting is a KEY = VALUE line of a file.
+type setting struct {
+	key, value string
+
+	// ignoreErr is set for keys prefixed with -.
+	ignoreErr bool
+}
+
+// parseConf parses a sysctl.conf file.
+func parseConf(r io.Reader) ([]setting, error) {
+	var settings []setting
+	sc := bufio.NewScanner(r)
+	for n := 1; sc.Scan(); n++ {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || line[0] == '#' || line[0] == ';' {
+			continue
+		}
+		kv := strings.SplitN(line, "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("line %d: %q is not KEY = VALUE", n, line)
+		}
+		st := setting{key: strings.TrimSpace(kv[0]), value: strings.TrimSpace(kv[1])}
+		if strings.HasPrefix(st.key, "-") {
+			st.key = strings.TrimSpace(st.key[1:])
+			st.ignoreErr = true
+		}
+		if st.key == "" {
+			return nil, fmt.Errorf("line %d: no key", n)
+		}
+		settings = append(settings, st)
+	}
+	return settings, sc.Err()
+}
+
+// loadFile sets the keys of the file name, or of stdin for -.
+func (s *sysctl) loadFile(name string) {
+	f := os.Stdin
+	if name != "-" {
+		var err error
+		if f, err = os.Open(name); err != nil {
+			s.errorf("%v", err)
+			return
+		}
+		defer f.Close()
+	}
+	settings, err := parseConf(f)
+	if err != nil {
+		s.errorf("%s: %v", name, err)
+		return
+	}
+	for _, st := range settings {
+		s.set(st.key, st.value, st.ignoreErr)
+	}
+}
+
+// confFiles returns the .conf files of dirs, sorted by name. Of files with
+// the same name, the one in the first dir is used.
+func confFiles(dirs []string) []string {
+	files := map[string]string{}
+	var bases []string
+	for _, d := range dirs {
+		l, err := filepath.Glob(filepath.Join(d, "*.conf"))
+		if err != nil {
+			continue
+		}
+		for _, f := range l {
+			base := filepath.Base(f)
+			if _, ok := files[base]; !ok {
+				files[base] = f
+				bases = append(bases, base)
+			}
+		}
+	}
+	sort.Strings(bases)
+	var l []string
+	for _, b := range bases {
+		l = append(l, files[b])
+	}
+	return l
+}
+
+func (s *sysctl) run(args []string) {
+	switch {
+	case *system:
+		for _, f := range confFiles(confDirs) {
+			fmt.Fprintf(s.w, "* Applying %s ...\n", f)
+			s.loadFile(f)
+		}
+		if _, err := os.Stat(defaultConf); err == nil {
+			fmt.Fprintf(s.w, "* Applying %s ...\n", defaultConf)
+			s.loadFile(defaultConf)
+		}
+	case *load:
+		if len(args) == 0 {
+			args = []string{defaultConf}
+		}
+		for _, f := range args {
+			s.loadFile(f)
+		}
+	case *all || len(args) == 0:
+		s.list(args)
+	default:
+		for _, a := range args {
+			kv := strings.SplitN(a, "=", 2)
+			switch {
+			case len(kv) == 2:
+				s.set(kv[0], kv[1], false)
+			case *write:
+				s.errorf("%q must be of the form KEY=VALUE", a)
+			default:
+				s.show(a)
+			}
+		}
+	}
+}
+
+func main() {
+	flag.Parse()
+	s := &sysctl{root: procSys, w: os.Stdout}
+	s.run(flag.Args())
+	if s.failed {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,215 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// procSysDir makes a fake /proc/sys.
+func procSysDir(t *testing.T) string {
+	d, err := ioutil.TempDir("", "sysctl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for f, v := range map[string]string{
+		"kernel/ostype":                        "Linux\n",
+		"kernel/panic_on_oops":                 "0\n",
+		"kernel/printk":                        "4\t4\t1\t7\n",
+		"net/ipv4/ip_forward":                  "0\n",
+		"net/ipv4/conf/all/rp_filter":          "2\n",
+		"net/ipv4/conf/eth0.100/rp_filter":     "1\n",
+		"net/ipv4/conf/eth0.100/forwarding":    "0\n",
+		"net/ipv4/neigh/default/gc_thresh1":    "128\n",
+		"net/ipv4/neigh/default/gc_stale_time": "60\n",
+	} {
+		p := filepath.Join(d, f)
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(p, []byte(v), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return d
+}
+
+func resetFlags() {
+	*all, *values, *names, *ignore, *quiet, *write, *load, *system = false, false, false, false, false, false, false, false
+}
+
+func TestSysctl(t *testing.T) {
+	d := procSysDir(t)
+	defer os.RemoveAll(d)
+
+	for _, tt := range []struct {
+		name   string
+		flags  []*bool
+		args   []string
+		out    string
+		failed bool
+	}{
+		{
+			name: "key",
+			args: []string{"kernel.ostype", "kernel.printk"},
+			out:  "kernel.ostype = Linux\nkernel.printk = 4\t4\t1\t7\n",
+		},
+		{
+			name: "subtree",
+			args: []string{"net.ipv4.neigh"},
+			out:  "net.ipv4.neigh.default.gc_stale_time = 60\nnet.ipv4.neigh.default.gc_thresh1 = 128\n",
+		},
+		{
+			name:  "values",
+			flags: []*bool{values},
+			args:  []string{"kernel.panic_on_oops"},
+			out:   "0\n",
+		},
+		{
+			name:  "names",
+			flags: []*bool{names},
+			args:  []string{"net.ipv4.conf"},
+			out:   "net.ipv4.conf.all.rp_filter\nnet.ipv4.conf.eth0/100.forwarding\nnet.ipv4.conf.eth0/100.rp_filter\n",
+		},
+		{
+			name: "slash for dot",
+			args: []string{"net.ipv4.conf.eth0/100.rp_filter"},
+			out:  "net.ipv4.conf.eth0/100.rp_filter = 1\n",
+		},
+		{
+			name:  "pattern",
+			flags: []*bool{all},
+			args:  []string{"net.ipv4.conf.*.rp_filter", "kernel"},
+			out:   "kernel.ostype = Linux\nkernel.panic_on_oops = 0\nkernel.printk = 4\t4\t1\t7\nnet.ipv4.conf.all.rp_filter = 2\nnet.ipv4.conf.eth0/100.rp_filter = 1\n",
+		},
+		{
+			name:   "unknown",
+			args:   []string{"kernel.bogus", "kernel.ostype"},
+			out:    "kernel.ostype = Linux\n",
+			failed: true,
+		},
+		{
+			name:  "ignore unknown",
+			flags: []*bool{ignore},
+			args:  []string{"kernel.bogus", "kernel.bogus=1"},
+		},
+		{
+			name: "write",
+			args: []string{"net.ipv4.ip_forward=1", "kernel.panic_on_oops = 1"},
+			out:  "net.ipv4.ip_forward = 1\nkernel.panic_on_oops = 1\n",
+		},
+		{
+			name:  "write quietly",
+			flags: []*bool{quiet},
+			args:  []string{"net.ipv4.ip_forward=0"},
+		},
+		{
+			name:   "write only",
+			flags:  []*bool{write},
+			args:   []string{"kernel.ostype"},
+			failed: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			resetFlags()
+			defer resetFlags()
+			for _, f := range tt.flags {
+				*f = true
+			}
+			var b bytes.Buffer
+			s := &sysctl{root: d, w: &b}
+			s.run(tt.args)
+			if b.String() != tt.out {
+				t.Errorf("sysctl %q: got %q, want %q", tt.args, b.String(), tt.out)
+			}
+			if s.failed != tt.failed {
+				t.Errorf("sysctl %q: failed is %v, want %v", tt.args, s.failed, tt.failed)
+			}
+		})
+	}
+
+	b, err := ioutil.ReadFile(filepath.Join(d, "kernel/panic_on_oops"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != "1\n" {
+		t.Errorf("kernel.panic_on_oops is %q after writing, want %q", b, "1\n")
+	}
+}
+
+func TestLoad(t *testing.T) {
+	d := procSysDir(t)
+	defer os.RemoveAll(d)
+
+	conf := filepath.Join(d, "sysctl.conf")
+	if err := ioutil.WriteFile(conf, []byte(`# Early boot tuning.
+kernel.panic_on_oops = 1
+
+; forward
+net.ipv4.ip_forward=1
+-kernel.bogus = 1
+`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	resetFlags()
+	defer resetFlags()
+	*load = true
+	var b bytes.Buffer
+	s := &sysctl{root: d, w: &b}
+	s.run([]string{conf})
+	if want := "kernel.panic_on_oops = 1\nnet.ipv4.ip_forward = 1\n"; b.String() != want {
+		t.Errorf("sysctl -p: got %q, want %q", b.String(), want)
+	}
+	if s.failed {
+		t.Errorf("sysctl -p failed")
+	}
+}
+
+func TestParseConf(t *testing.T) {
+	settings, err := parseConf(strings.NewReader("a.b = 1 2\n  # comment\n-c.d=x\n\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []setting{{key: "a.b", value: "1 2"}, {key: "c.d", value: "x", ignoreErr: true}}
+	if !reflect.DeepEqual(settings, want) {
+		t.Errorf("parseConf: got %+v, want %+v", settings, want)
+	}
+
+	for _, s := range []string{"a.b\n", " = 1\n", "- = 1\n"} {
+		if _, err := parseConf(strings.NewReader(s)); err == nil {
+			t.Errorf("parseConf(%q) succeeded, want an error", s)
+		}
+	}
+}
+
+func TestConfFiles(t *testing.T) {
+	d, err := ioutil.TempDir("", "sysctl.d")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(d)
+
+	dirs := []string{filepath.Join(d, "etc"), filepath.Join(d, "run"), filepath.Join(d, "lib")}
+	for _, f := range []string{"etc/50-b.conf", "run/10-a.conf", "run/50-b.conf", "lib/99-c.conf", "lib/10-a.conf", "lib/README"} {
+		p := filepath.Join(d, f)
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(p, nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	got := confFiles(dirs)
+	want := []string{filepath.Join(d, "run/10-a.conf"), filepath.Join(d, "etc/50-b.conf"), filepath.Join(d, "lib/99-c.conf")}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("confFiles: got %q, want %q", got, want)
+	}
+}
@@ -11,8 +11,18 @@
 //     This command line can be used only in the following ways:
 //        tar -cvf x.tar directory/         # create
 //        tar -cvf x.tar file1 file2 ...    # create
+//        tar -czf x.tar.gz -C dir file...  # create in dir, gzipped
 //        tar -tvf x.tar                    # list
 //        tar -xvf x.tar directory/         # extract
+//        tar -xf x.tar.zst -C directory/   # extract
+//
+//     Archives being extracted or listed are decompressed if they are
+//     compressed with gzip, bzip2, xz or zstd, whatever the options.
+//
+//     Modes, modification times and symlinks are kept. Owners are kept
+//     when extracting as root, unless --no-same-owner is given.
+//
+//     The file name - is the standard input or output.
 //
 // Options:
 //     -c: create a new tar archive from the given directory
@@ -20,15 +30,23 @@
 //     -v: verbose, print each filename (optional)
 //     -f: tar filename (required)
 //     -t: list the contents of an archive
+//     -C: change to the directory before creating or extracting
+//     -z: compress the archive with gzip
+//     -J: compress the archive with xz
+//     --zstd: compress the archive with zstd
+//     -a: compress the archive as told by its suffix, e.g. .tar.gz
+//     --no-same-owner: do not keep the owners when extracting
 //
 // TODO: The arguments deviates slightly from gnu tar.
 package main
 
 import (
+	"io"
 	"log"
 	"os"
 
 	flag "github.com/spf13/pflag"
+	"github.com/u-root/u-root/pkg/compress"
 	"github.com/u-root/u-root/pkg/tarutil"
 )
 
@@ -39,8 +57,38 @@ var (
 	list        = flag.BoolP("list", "t", false, "list the contents of an archive")
 	noRecursion = flag.Bool("no-recursion", false, "do not automatically recurse into directories")
 	verbose     = flag.BoolP("verbose", "v", false, "print each filename")
+	directory   = flag.StringP("directory", "C", "", "change to the directory before creating or extracting")
+	gzip        = flag.BoolP("gzip", "z", false, "compress the archive with gzip")
+	xz          = flag.BoolP("xz", "J", false, "compress the archive with xz")
+	zstd        = flag.Bool("zstd", false, "compress the archive with zstd")
+	auto        = flag.BoolP("auto-compress", "a", false, "compress the archive as told by its suffix")
+	noSameOwner = flag.Bool("no-same-owner", false, "do not keep the owners of the files when extracting")
 )
 
+// compression returns the compression of the created archive, nil if it is
+// not compressed.
+func compression() *compress.Format {
+	switch {
+	case *gzip:
+		return compress.Gzip
+	case *xz:
+		return compress.Xz
+	case *zstd:
+		return compress.Zstd
+	case *auto:
+		return compress.ByExtension(*file)
+	}
+	return nil
+}
+
+// open opens the archive to read, - being stdin.
+func open() (io.ReadCloser, error) {
+	if *file == "-" {
+		return os.Stdin, nil
+	}
+	return os.Open(*file)
+}
+
 func main() {
 	flag.Parse()
 
@@ -58,6 +106,8 @@ func main() {
 
 	opts := &tarutil.Opts{
 		NoRecursion: *noRecursion,
+		Compression: compression(),
+		ChangeOwner: os.Geteuid() == 0 && !*noSameOwner,
 	}
 	if *verbose {
 		opts.Filters = []tarutil.Filter{tarutil.VerboseFilter}
@@ -65,9 +115,22 @@ func main() {
 
 	switch {
 	case *create:
-		f, err := os.Create(*file)
-		if err != nil {
-			log.Fatal(err)
+		// The archive is relative to the directory tar is run in, the
+		// files to -C.
+		f := os.Stdout
+		if *file != "-" {
+			var err error
+			if f, err = os.Create(*file); err != nil {
+				log.Fatal(err)
+			}
+		}
+		if *directory != "" {
+			if err := os.Chdir(*directory); err != nil {
+				log.Fatal(err)
+			}
+		}
+		if *verbose && f == os.Stdout {
+			opts.Filters = []tarutil.Filter{tarutil.VerboseLogFilter}
 		}
 		if err := tarutil.CreateTar(f, flag.Args(), opts); err != nil {
 			f.Close()
@@ -77,20 +140,26 @@ func main() {
 			log.Fatal(err)
 		}
 	case *extract:
-		if flag.NArg() != 1 {
+		dir := *directory
+		switch {
+		case flag.NArg() == 1 && dir == "":
+			dir = flag.Arg(0)
+		case flag.NArg() == 0 && dir == "":
+			dir = "."
+		case flag.NArg() != 0:
 			flag.Usage()
 			os.Exit(1)
 		}
-		f, err := os.Open(*file)
+		f, err := open()
 		if err != nil {
 			log.Fatal(err)
 		}
 		defer f.Close()
-		if err := tarutil.ExtractDir(f, flag.Arg(0), opts); err != nil {
+		if err := tarutil.ExtractDir(f, dir, opts); err != nil {
 			log.Fatal(err)
 		}
 	case *list:
-		f, err := os.Open(*file)
+		f, err := open()
 		if err != nil {
 			log.Fatal(err)
 		}
@@ -5,11 +5,17 @@
 // Unmount a filesystem at the specified path.
 //
 // Synopsis:
-//     umount [-f | -l] PATH
+//     umount [-f | -l] [-R] [-D] PATH
+//
+// Description:
+//     Loop devices of the unmounted file systems, like those set up by
+//     mount -o loop, are detached, unless they are still mounted elsewhere.
 //
 // Options:
 //     -f: force unmount
 //     -l: lazy unmount
+//     -R: recursively unmount the file systems mounted below PATH too
+//     -D: do not detach loop devices
 package main
 
 import "log"
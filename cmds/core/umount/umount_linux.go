@@ -7,21 +7,73 @@ package main
 import (
 	"errors"
 	"flag"
+	"fmt"
+	"path/filepath"
 
 	"github.com/u-root/u-root/pkg/mount"
+	"github.com/u-root/u-root/pkg/mount/loop"
 )
 
 var (
-	force = flag.Bool("f", false, "Force unmount")
-	lazy  = flag.Bool("l", false, "Lazy unmount")
+	force     = flag.Bool("f", false, "Force unmount")
+	lazy      = flag.Bool("l", false, "Lazy unmount")
+	recursive = flag.Bool("R", false, "Unmount the file systems below path too")
+	keepLoop  = flag.Bool("D", false, "Do not detach loop devices")
 )
 
 func umount() error {
 	flag.Parse()
 	a := flag.Args()
 	if len(a) != 1 {
-		return errors.New("usage: umount [-f | -l] path")
+		return errors.New("usage: umount [-f | -l] [-R] [-D] path")
 	}
-	path := a[0]
-	return mount.Unmount(path, *force, *lazy)
+	path := filepath.Clean(a[0])
+	mps, err := mount.MountsBelow(path)
+	if err != nil {
+		// Without the list of mounts, we can still unmount.
+		return mount.Unmount(path, *force, *lazy)
+	}
+	if !*recursive {
+		mps = lastMountedAt(mps, path)
+	}
+	if len(mps) == 0 {
+		return fmt.Errorf("umount %q: not mounted", path)
+	}
+	for _, mp := range mps {
+		if err := mount.Unmount(mp.Path, *force, *lazy); err != nil {
+			return err
+		}
+		if !*keepLoop && loop.IsDevice(mp.Device) {
+			if err := detachUnused(mp.Device); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// lastMountedAt returns the last file system of mps mounted at path, which
+// comes first, or nothing.
+func lastMountedAt(mps []*mount.MountPoint, path string) []*mount.MountPoint {
+	for _, mp := range mps {
+		if filepath.Clean(mp.Path) == path {
+			return []*mount.MountPoint{mp}
+		}
+	}
+	return nil
+}
+
+// detachUnused detaches the loop device dev if nothing is mounted from it
+// anymore.
+func detachUnused(dev string) error {
+	mps, err := mount.Mounts()
+	if err != nil {
+		return err
+	}
+	for _, mp := range mps {
+		if mp.Device == dev {
+			return nil
+		}
+	}
+	return loop.Detach(dev)
 }
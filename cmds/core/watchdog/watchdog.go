@@ -13,6 +13,16 @@
 //         Print the watchdog timeout or pretimeout
 //     watchdog [--dev=DEV] gettimeleft
 //         Print the amount of time left.
+//     watchdog [--dev=DEV] status
+//         Print the driver, its status and timeouts.
+//     watchdog [--dev=DEV] enable|disable
+//         Start or stop the watchdog timer.
+//     watchdog [--dev=DEV] disarm
+//         Disarm the watchdog with the magic close character.
+//
+// Description:
+//     Opening the device arms the watchdog, and all commands except disarm
+//     leave it armed.
 //
 // Options:
 //     --dev DEV: Device (default /dev/watchdog)
@@ -42,10 +52,48 @@ watchdog get[pre]timeout
 	Print the watchdog timeout or pretimeout.
 watchdog gettimeleft
 	Print the amount of time left.
+watchdog status
+	Print the driver, its status and timeouts.
+watchdog enable|disable
+	Start or stop the watchdog timer.
+watchdog disarm
+	Disarm the watchdog with the magic close character.
 `)
 	os.Exit(1)
 }
 
+// status prints what the driver reports. Drivers implement only some of
+// the ioctls, so missing values are skipped.
+func status(wd *watchdog.Watchdog) error {
+	id, err := wd.Identity()
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Identity:    %s\n", id)
+	if wi, err := wd.Support(); err == nil {
+		fmt.Printf("Options:     %v\n", watchdog.Status(wi.Options))
+	}
+	if s, err := wd.Status(); err == nil {
+		fmt.Printf("Status:      %v\n", s)
+	}
+	if s, err := wd.BootStatus(); err == nil {
+		fmt.Printf("Boot status: %v\n", s)
+	}
+	for _, v := range []struct {
+		name string
+		get  func() (time.Duration, error)
+	}{
+		{"Timeout", wd.Timeout},
+		{"Pretimeout", wd.PreTimeout},
+		{"Time left", wd.TimeLeft},
+	} {
+		if d, err := v.get(); err == nil {
+			fmt.Printf("%-12s %v\n", v.name+":", d)
+		}
+	}
+	return nil
+}
+
 func runCommand() error {
 	flag.Parse()
 	if flag.NArg() < 1 {
@@ -56,6 +104,12 @@ func runCommand() error {
 	if err != nil {
 		return err
 	}
+	if flag.Arg(0) == "disarm" {
+		if flag.NArg() != 1 {
+			usage()
+		}
+		return wd.MagicClose()
+	}
 	defer func() {
 		if err := wd.Close(); err != nil {
 			log.Printf("Failed to close watchdog: %v", err)
@@ -119,6 +173,21 @@ func runCommand() error {
 			return err
 		}
 		fmt.Println(i)
+	case "status":
+		if flag.NArg() != 1 {
+			usage()
+		}
+		return status(wd)
+	case "enable":
+		if flag.NArg() != 1 {
+			usage()
+		}
+		return wd.Enable()
+	case "disable":
+		if flag.NArg() != 1 {
+			usage()
+		}
+		return wd.Disable()
 	default:
 		return fmt.Errorf("unrecognized command: %q", flag.Arg(0))
 	}
@@ -24,6 +24,16 @@
 //     --pre_timeout: Duration for pretimeout (default -1)
 //     --keep_alive: Duration between issuing keepalive (default 10)
 //     --monitors: comma separated list of monitors, ex: oops
+//     --stale_file FILE: stop if FILE is not modified for --stale_age
+//     --stale_age: age at which --stale_file is stale (default 1m)
+//     --max_load LOAD: stop if the 1 minute load average exceeds LOAD
+//     --ping HOST: stop if HOST does not answer ICMP echo requests
+//     --ping_timeout: timeout of each echo request (default 1s)
+//
+// Health checks: --stale_file and --ping can be repeated, and all the
+// checks are done before each keepalive. If one fails, watchdogd stops
+// itself, so the machine is reset when the watchdog times out, unless the
+// watchdogd is continued.
 package main
 
 import (
@@ -41,6 +51,7 @@ import (
 
 func usage() {
 	fmt.Print(`watchdogd run [--dev DEV] [--timeout N] [--pre_timeout N] [--keep_alive N] [--monitors STRING]
+	[--stale_file FILE]... [--stale_age N] [--max_load LOAD] [--ping HOST]... [--ping_timeout N]
 	Run the watchdogd daemon in a child process (does not daemonize).
 watchdogd pid
 	Print the pid of the running watchdogd.
@@ -72,6 +83,11 @@ func runCommand() error {
 			preTimeout = fs.Duration("pre_timeout", -1, "duration for pretimeout")
 			keepAlive  = fs.Duration("keep_alive", 5*time.Second, "duration between issuing keepalive")
 			monitors   = fs.String("monitors", "", "comma seperated list of monitors, ex: oops")
+			staleFiles = fs.StringSlice("stale_file", nil, "stop if this file is not modified for stale_age")
+			staleAge   = fs.Duration("stale_age", time.Minute, "age at which stale_file is stale")
+			maxLoad    = fs.Float64("max_load", 0, "stop if the 1 minute load average exceeds this")
+			pings      = fs.StringSlice("ping", nil, "stop if this host does not answer ICMP echo requests")
+			pingWait   = fs.Duration("ping_timeout", time.Second, "timeout of each ICMP echo request")
 		)
 		fs.Parse(args)
 		if fs.NArg() != 0 {
@@ -86,12 +102,23 @@ func runCommand() error {
 
 		monitorFuncs := []func() error{}
 		for _, m := range strings.Split(*monitors, ",") {
-			if m == "oops" {
+			if m == "" {
+				continue
+			} else if m == "oops" {
 				monitorFuncs = append(monitorFuncs, watchdogd.MonitorOops)
 			} else {
 				return fmt.Errorf("unrecognized monitor: %v", m)
 			}
 		}
+		for _, f := range *staleFiles {
+			monitorFuncs = append(monitorFuncs, watchdogd.MonitorStaleFile(f, *staleAge))
+		}
+		if *maxLoad > 0 {
+			monitorFuncs = append(monitorFuncs, watchdogd.MonitorLoad(*maxLoad))
+		}
+		for _, h := range *pings {
+			monitorFuncs = append(monitorFuncs, watchdogd.MonitorPing(h, *pingWait))
+		}
 		return watchdogd.Run(context.Background(), &watchdogd.DaemonOpts{
 			Dev:        *dev,
 			Timeout:    timeout,
@@ -5,11 +5,31 @@
 // Wget reads one file from a url and writes to stdout.
 //
 // Synopsis:
-//     wget URL
+//     wget [-O FILE] [-q] [-c] [-t TRIES] [--ca-certificate FILE] [--no-check-certificate] [--no-proxy] URL
 //
 // Description:
 //     Returns a non-zero code on failure.
 //
+//     The progress of the download is printed to stderr unless -q is given.
+//
+//     Failed downloads are tried again after a second, and then after up
+//     to 10 seconds, continuing where they stopped, except if the server
+//     refused the connection or replied with an HTTP error.
+//
+//     The proxies of the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment
+//     variables are used, unless --no-proxy is given.
+//
+// Options:
+//     -O:                     output file, or - for stdout (default: the
+//                             last element of the URL's path)
+//     -q:                     do not print progress
+//     -c:                     continue the download of a partial file
+//     -t, --tries:            number of tries, 0 for unlimited (default: 20)
+//     --ca-certificate:       PEM file of the CA certificates trusted for
+//                             HTTPS, instead of the system's
+//     --no-check-certificate: do not verify the certificates of HTTPS servers
+//     --no-proxy:             do not use proxies
+//
 // Notes:
 //     There are a few differences with GNU wget:
 //     - Upon error, the return value is always 1.
@@ -21,27 +41,122 @@ package main
 
 import (
 	"context"
+	"crypto/x509"
+	"errors"
 	"flag"
 	"io"
 	"log"
+	"net"
 	"net/url"
 	"os"
 	"path"
+	"time"
 
+	"github.com/cenkalti/backoff/v4"
 	"github.com/u-root/u-root/pkg/curl"
-	"github.com/u-root/u-root/pkg/uio"
 )
 
 var (
-	outPath = flag.String("O", "", "output file")
+	outPath = flag.String("O", "", "output file, or - for stdout")
+	quiet   = flag.Bool("q", false, "do not print progress")
+	resume  = flag.Bool("c", false, "continue the download of a partial file")
+	tries   = flag.Int("t", 20, "number of tries, 0 for unlimited")
+	caCert  = flag.String("ca-certificate", "", "PEM file of the CA certificates trusted for HTTPS, instead of the system's")
+	noCheck = flag.Bool("no-check-certificate", false, "do not verify the certificates of HTTPS servers")
+	noProxy = flag.Bool("no-proxy", false, "do not use proxies")
 )
 
+func init() {
+	flag.IntVar(tries, "tries", 20, "number of tries, 0 for unlimited")
+}
+
 func usage() {
 	log.Printf("Usage: %s [ARGS] URL\n", os.Args[0])
 	flag.PrintDefaults()
 	os.Exit(2)
 }
 
+// retry returns true for errors which may go away, like timeouts and
+// broken connections, but not refused connections, unknown hosts and HTTP
+// errors, as GNU wget.
+func retry(u *url.URL, err error) bool {
+	var herr *curl.HTTPClientCodeError
+	if errors.As(err, &herr) || isRefused(err) {
+		return false
+	}
+	var derr *net.DNSError
+	if errors.As(err, &derr) {
+		return derr.IsTemporary
+	}
+	return true
+}
+
+// backOff returns the back off of -t tries.
+func backOff() backoff.BackOff {
+	b := backoff.NewExponentialBackOff()
+	b.InitialInterval = time.Second
+	b.MaxInterval = 10 * time.Second
+	b.MaxElapsedTime = 0
+	if *tries <= 0 {
+		return b
+	}
+	return backoff.WithMaxRetries(b, uint64(*tries-1))
+}
+
+// outFile is the output file, which is only opened once there is
+// something to write, so failed downloads leave existing files alone.
+type outFile struct {
+	name string
+	flag int
+	f    *os.File
+}
+
+func (o *outFile) open() error {
+	if o.f != nil {
+		return nil
+	}
+	f, err := os.OpenFile(o.name, o.flag, 0666)
+	if err != nil {
+		return err
+	}
+	o.f = f
+	return nil
+}
+
+func (o *outFile) Write(b []byte) (int, error) {
+	if err := o.open(); err != nil {
+		return 0, err
+	}
+	return o.f.Write(b)
+}
+
+// Close creates the file if nothing was written and closes it.
+func (o *outFile) Close() error {
+	if err := o.open(); err != nil {
+		return err
+	}
+	return o.f.Close()
+}
+
+// output returns the output file, and how much of it there is already if
+// a download is continued.
+func output(name string) (io.WriteCloser, int64, error) {
+	if name == "-" {
+		return os.Stdout, 0, nil
+	}
+	if !*resume {
+		return &outFile{name: name, flag: os.O_WRONLY | os.O_CREATE | os.O_TRUNC}, 0, nil
+	}
+	var off int64
+	fi, err := os.Stat(name)
+	if err == nil {
+		off = fi.Size()
+	} else if !os.IsNotExist(err) {
+		return nil, 0, err
+	}
+	return &outFile{name: name, flag: os.O_WRONLY | os.O_CREATE | os.O_APPEND}, off, nil
+}
+
 func main() {
 	log.SetPrefix("wget: ")
 
@@ -67,27 +182,35 @@ func main() {
 		}
 	}
 
+	o := curl.HTTPOptions{Insecure: *noCheck, NoProxy: *noProxy}
+	if *caCert != "" {
+		var roots *x509.CertPool
+		if roots, err = curl.CertPool(*caCert); err != nil {
+			log.Fatalln(err)
+		}
+		o.RootCAs = roots
+	}
+	http := curl.NewHTTPClientWithOptions(o)
 	schemes := curl.Schemes{
-		"tftp": curl.DefaultTFTPClient,
-		"http": curl.DefaultHTTPClient,
-
-		// curl.DefaultSchemes doesn't support HTTPS by default.
-		"https": curl.DefaultHTTPClient,
+		"tftp":  curl.DefaultTFTPClient,
+		"http":  http,
+		"https": http,
 		"file":  &curl.LocalFileClient{},
 	}
 
-	readerAt, err := schemes.Fetch(context.Background(), url)
+	w, off, err := output(*outPath)
 	if err != nil {
-		log.Fatalf("Failed to download %v: %v", argURL, err)
+		log.Fatalf("Failed to open output file %q: %v", *outPath, err)
 	}
 
-	w, err := os.Create(*outPath)
-	if err != nil {
-		log.Fatalf("Failed to create output file %q: %v", *outPath, err)
+	d := curl.DownloadOptions{BackOff: backOff(), DoRetry: retry}
+	if !*quiet {
+		d.Progress, d.ProgressName = os.Stderr, *outPath
 	}
-	defer w.Close()
-
-	if _, err := io.Copy(w, uio.Reader(readerAt)); err != nil {
-		log.Fatalf("Failed to read response data: %v", err)
+	if _, err := schemes.Download(context.Background(), url, w, off, d); err != nil {
+		log.Fatalf("Failed to download %v: %v", argURL, err)
+	}
+	if err := w.Close(); err != nil {
+		log.Fatalf("Failed to write output file %q: %v", *outPath, err)
 	}
 }
@@ -0,0 +1,18 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"errors"
+	"net"
+	"strings"
+)
+
+// isRefused reports whether err is due to a refused connection. Plan 9
+// has no errno, the network stack reports it as text.
+func isRefused(err error) bool {
+	var oerr *net.OpError
+	return errors.As(err, &oerr) && oerr.Op == "dial" && strings.Contains(oerr.Err.Error(), "refused")
+}
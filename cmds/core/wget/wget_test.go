@@ -8,14 +8,19 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"log"
 	"net"
 	"net/http"
+	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/u-root/u-root/pkg/curl"
 	"github.com/u-root/u-root/pkg/testutil"
 )
 
@@ -28,6 +33,8 @@ func (h handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	case "/200":
 		w.WriteHeader(200)
 		w.Write([]byte(content))
+	case "/range":
+		http.ServeContent(w, r, "", time.Time{}, strings.NewReader(content))
 	case "/302":
 		http.Redirect(w, r, "/200", http.StatusFound /* 302 */)
 	case "/500":
@@ -145,6 +152,66 @@ func TestWget(t *testing.T) {
 	}
 }
 
+// TestResume continues a partial download.
+func TestResume(t *testing.T) {
+	l, err := net.Listen("tcp", ":0")
+	if err != nil {
+		t.Fatalf("Cannot create free port: %v", err)
+	}
+	defer l.Close()
+	go http.Serve(l, handler{})
+
+	dir, err := ioutil.TempDir("", "wget")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	out := filepath.Join(dir, "range")
+	if err := ioutil.WriteFile(out, []byte(content[:5]), 0644); err != nil {
+		t.Fatal(err)
+	}
+	u := fmt.Sprintf("http://localhost:%d/range", l.Addr().(*net.TCPAddr).Port)
+	if output, err := testutil.Command(t, "-q", "-c", "-O", out, u).CombinedOutput(); err != nil {
+		t.Fatalf("wget -c %s = %v, output: %s", u, err, output)
+	}
+	got, err := ioutil.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != content {
+		t.Errorf("Continued download = %q, want %q", got, content)
+	}
+}
+
 func TestMain(m *testing.M) {
 	testutil.Run(m, main)
 }
+
+func TestRetry(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := l.Addr().String()
+	l.Close()
+	_, refused := net.Dial("tcp", addr)
+	if refused == nil {
+		t.Fatalf("dialing closed %s succeeded", addr)
+	}
+
+	for _, tt := range []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"refused", fmt.Errorf("get: %w", refused), false},
+		{"HTTP error", &curl.HTTPClientCodeError{Err: errors.New("not found"), HTTPCode: 404}, false},
+		{"unknown host", &net.DNSError{Err: "no such host", Name: "nope.invalid"}, false},
+		{"temporary DNS failure", &net.DNSError{Err: "timeout", Name: "example.com", IsTemporary: true}, true},
+		{"reset", &net.OpError{Op: "read", Net: "tcp", Err: errors.New("connection reset by peer")}, true},
+	} {
+		if got := retry(nil, tt.err); got != tt.want {
+			t.Errorf("retry(%s: %v) = %v, want %v", tt.name, tt.err, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,17 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build !plan9
+
+package main
+
+import (
+	"errors"
+	"syscall"
+)
+
+// isRefused reports whether err is due to a refused connection.
+func isRefused(err error) bool {
+	return errors.Is(err, syscall.ECONNREFUSED)
+}
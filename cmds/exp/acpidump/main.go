@@ -0,0 +1,128 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// acpidump dumps ACPI tables.
+//
+// Synopsis:
+//     acpidump [-s METHOD|-f FILE] [-n SIG,...] [-l|-x|-b] [-o DIR]
+//
+// Description:
+//     By default, the tables are printed as hex dumps in the format of
+//     ACPICA's acpidump, which acpixtract turns back into binary tables.
+//
+//     The tables are read with METHOD, "files" reads them from
+//     /sys/firmware/acpi/tables and "ebda" from physical memory via the
+//     RSDP. FILE is a blob of binary tables, as written by acpicat.
+//
+// Options:
+//     -s: source of the tables (default: files)
+//     -f: read binary tables from FILE instead
+//     -n: only dump the tables with these signatures
+//     -l: list the table headers, checksums and OEM IDs
+//     -x: decode the MADT, SRAT, MCFG and SPCR, print the header of others
+//     -b: write each table to a binary file, e.g. dsdt.dat or ssdt2.dat
+//     -o: directory for -b (default: .)
+//     -d: enable debug prints
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/u-root/u-root/pkg/acpi"
+)
+
+var (
+	source = flag.String("s", acpi.DefaultMethod, "source of the tables")
+	file   = flag.String("f", "", "read binary tables from this file")
+	sigs   = flag.String("n", "", "comma-separated signatures of the tables to dump")
+	list   = flag.Bool("l", false, "list table headers")
+	decode = flag.Bool("x", false, "decode known tables")
+	binary = flag.Bool("b", false, "write each table to a binary file")
+	outDir = flag.String("o", ".", "directory for -b")
+	debug  = flag.Bool("d", false, "Enable debug prints")
+)
+
+// filter returns the tables whose signature is in sigs, or all of them if
+// sigs is empty.
+func filter(tabs []acpi.Table, sigs string) ([]acpi.Table, error) {
+	if sigs == "" {
+		return tabs, nil
+	}
+	var res []acpi.Table
+	for _, s := range strings.Split(sigs, ",") {
+		n := len(res)
+		for _, t := range tabs {
+			if strings.EqualFold(t.Sig(), s) {
+				res = append(res, t)
+			}
+		}
+		if len(res) == n {
+			return nil, fmt.Errorf("no %s table", s)
+		}
+	}
+	return res, nil
+}
+
+func run(w io.Writer, tabs []acpi.Table) error {
+	switch {
+	case *list:
+		return acpi.WriteList(w, tabs...)
+	case *decode:
+		for _, t := range tabs {
+			d, err := acpi.Decode(t)
+			if errors.Is(err, acpi.ErrNoDecoder) {
+				fmt.Fprintf(w, "%s\n\n", acpi.HeaderString(t))
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(w, "%s\n\n", d)
+		}
+		return nil
+	case *binary:
+		for i, n := range acpi.DumpNames(tabs) {
+			p := filepath.Join(*outDir, n)
+			if err := ioutil.WriteFile(p, tabs[i].Data(), 0644); err != nil {
+				return err
+			}
+			fmt.Fprintf(w, "%s: %d bytes written to %s\n", tabs[i].Sig(), tabs[i].Len(), p)
+		}
+		return nil
+	}
+	return acpi.WriteDump(w, tabs...)
+}
+
+func main() {
+	flag.Parse()
+	if *debug {
+		acpi.Debug = log.Printf
+	}
+	var (
+		tabs []acpi.Table
+		err  error
+	)
+	if *file != "" {
+		tabs, err = acpi.RawFromName(*file)
+	} else {
+		tabs, err = acpi.ReadTables(*source)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	if tabs, err = filter(tabs, *sigs); err != nil {
+		log.Fatal(err)
+	}
+	if err := run(os.Stdout, tabs); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,210 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// acpitool inspects ACPI tables and prepares overrides for a kexec'd kernel.
+//
+// Synopsis:
+//     acpitool list [-f FILE] [SIG...]
+//     acpitool header [-f FILE] [SIG...]
+//     acpitool dump [-f FILE] [SIG...]
+//     acpitool decode [-f FILE] [SIG...]
+//     acpitool upgrade -o FILE TABLE...
+//     acpitool pack [-f FILE] [-r SIG,...] -base ADDR -o FILE [TABLE...]
+//
+// Description:
+//     list prints a line per table header, header disassembles the headers
+//     the way iasl does, dump prints hex dumps in the format of acpidump
+//     and decode decodes the MADT, SRAT, MCFG and SPCR. They read the
+//     tables of /sys/firmware/acpi/tables, or the blob of binary tables
+//     FILE, and only print the tables with the signatures SIG.
+//
+//     upgrade writes the binary tables TABLE, e.g. a compiled SSDT or a
+//     patched SRAT, to an uncompressed cpio archive of
+//     kernel/firmware/acpi. Put before the initramfs, e.g. with
+//     cat upgrade.cpio initramfs.cpio >initrd, the kernel uses them instead
+//     of the firmware tables with the same signature and, for SSDTs, OEM
+//     table ID, and adds the others. The kernel needs
+//     CONFIG_ACPI_TABLE_UPGRADE.
+//
+//     pack replaces the tables with TABLE, removes the tables with the
+//     signatures of -r, and lays them out with a new RSDP and XSDT in a
+//     blob to load at ADDR. The kernel booted with it is passed
+//     acpi_rsdp=ADDR.
+//
+// Options:
+//     -f: read binary tables from FILE instead of /sys
+//     -o: file to write the archive or the blob to
+//     -r: comma-separated signatures of the tables to remove
+//     -base: physical address the blob is loaded at
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/u-root/u-root/pkg/acpi"
+)
+
+func usage() {
+	fmt.Fprint(os.Stderr, `acpitool list|header|dump|decode [-f FILE] [SIG...]
+	Print the tables, or the tables with signatures SIG.
+acpitool upgrade -o FILE TABLE...
+	Write the tables to a cpio archive for the kernel's table upgrade.
+acpitool pack [-f FILE] [-r SIG,...] -base ADDR -o FILE [TABLE...]
+	Replace and remove tables, and lay them out in a blob loaded at ADDR.
+`)
+	os.Exit(1)
+}
+
+// readTables returns the tables of file, or of /sys if it is empty.
+func readTables(file string) ([]acpi.Table, error) {
+	if file != "" {
+		return acpi.RawFromName(file)
+	}
+	return acpi.RawTablesFromSys()
+}
+
+// filter returns the tables whose signature is in sigs, or all of them if
+// there are none.
+func filter(tabs []acpi.Table, sigs []string) ([]acpi.Table, error) {
+	if len(sigs) == 0 {
+		return tabs, nil
+	}
+	var res []acpi.Table
+	for _, s := range sigs {
+		n := len(res)
+		for _, t := range tabs {
+			if strings.EqualFold(t.Sig(), s) {
+				res = append(res, t)
+			}
+		}
+		if len(res) == n {
+			return nil, fmt.Errorf("no %s table", s)
+		}
+	}
+	return res, nil
+}
+
+// readFiles returns the tables of the binary table files names.
+func readFiles(names []string) ([]acpi.Table, error) {
+	var tabs []acpi.Table
+	for _, n := range names {
+		t, err := acpi.RawFromName(n)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", n, err)
+		}
+		tabs = append(tabs, t...)
+	}
+	return tabs, nil
+}
+
+func show(w io.Writer, cmd string, tabs []acpi.Table) error {
+	switch cmd {
+	case "list":
+		return acpi.WriteList(w, tabs...)
+	case "dump":
+		return acpi.WriteDump(w, tabs...)
+	}
+	for _, t := range tabs {
+		var err error
+		if cmd == "header" {
+			err = acpi.WriteHeader(w, t)
+		} else {
+			var d fmt.Stringer
+			d, err = acpi.Decode(t)
+			if errors.Is(err, acpi.ErrNoDecoder) {
+				fmt.Fprintf(w, "%s\n", acpi.HeaderString(t))
+				err = nil
+			} else if err == nil {
+				fmt.Fprintf(w, "%s\n", d)
+			}
+		}
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(w)
+	}
+	return nil
+}
+
+func run(w io.Writer, args []string) error {
+	if len(args) == 0 {
+		usage()
+	}
+	cmd, args := args[0], args[1:]
+	fs := flag.NewFlagSet("acpitool "+cmd, flag.ExitOnError)
+	fs.Usage = usage
+	var (
+		file   = fs.String("f", "", "read binary tables from this file")
+		out    = fs.String("o", "", "file to write to")
+		remove = fs.String("r", "", "comma-separated signatures of the tables to remove")
+		base   = fs.Uint64("base", 0, "physical address of the blob")
+	)
+	fs.Parse(args)
+
+	switch cmd {
+	case "list", "header", "dump", "decode":
+		tabs, err := readTables(*file)
+		if err != nil {
+			return err
+		}
+		if tabs, err = filter(tabs, fs.Args()); err != nil {
+			return err
+		}
+		return show(w, cmd, tabs)
+
+	case "upgrade":
+		if *out == "" || fs.NArg() == 0 {
+			usage()
+		}
+		tabs, err := readFiles(fs.Args())
+		if err != nil {
+			return err
+		}
+		var b bytes.Buffer
+		if err := acpi.WriteUpgrade(&b, tabs...); err != nil {
+			return err
+		}
+		return ioutil.WriteFile(*out, b.Bytes(), 0644)
+
+	case "pack":
+		if *out == "" || *base == 0 {
+			usage()
+		}
+		tabs, err := readTables(*file)
+		if err != nil {
+			return err
+		}
+		if *remove != "" {
+			tabs = acpi.Remove(tabs, strings.Split(*remove, ",")...)
+		}
+		news, err := readFiles(fs.Args())
+		if err != nil {
+			return err
+		}
+		b, err := acpi.Pack(int64(*base), acpi.Replace(tabs, news...)...)
+		if err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(*out, b, 0644); err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "%d bytes written to %s, boot with acpi_rsdp=%#x\n", len(b), *out, *base)
+		return nil
+	}
+	return fmt.Errorf("unknown command %q", cmd)
+}
+
+func main() {
+	if err := run(os.Stdout, os.Args[1:]); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// acpixtract extracts binary ACPI tables from acpidump output.
+//
+// Synopsis:
+//     acpixtract [-l] [-s SIG] [-o DIR] [FILE]
+//
+// Description:
+//     acpixtract reads the hex dumps written by acpidump, from FILE or
+//     stdin, and writes each table to a binary file named after its
+//     signature, e.g. dsdt.dat or ssdt2.dat. The files can be passed to
+//     iasl, acpigrep or a firmware build.
+//
+// Options:
+//     -l: only list the table headers
+//     -s: only extract the tables with this signature
+//     -o: directory to write the tables to (default: .)
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/u-root/u-root/pkg/acpi"
+)
+
+var (
+	list   = flag.Bool("l", false, "list table headers")
+	sig    = flag.String("s", "", "only extract tables with this signature")
+	outDir = flag.String("o", ".", "directory to write the tables to")
+)
+
+func run(w io.Writer, r io.Reader) error {
+	tabs, err := acpi.ReadDump(r)
+	if err != nil {
+		return err
+	}
+	if *list {
+		return acpi.WriteList(w, tabs...)
+	}
+	names := acpi.DumpNames(tabs)
+	n := 0
+	for i, t := range tabs {
+		if *sig != "" && !strings.EqualFold(t.Sig(), *sig) {
+			continue
+		}
+		p := filepath.Join(*outDir, names[i])
+		if err := ioutil.WriteFile(p, t.Data(), 0644); err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "%s: %d bytes written to %s\n", t.Sig(), t.Len(), p)
+		n++
+	}
+	if n == 0 {
+		return fmt.Errorf("no tables extracted")
+	}
+	return nil
+}
+
+func main() {
+	flag.Parse()
+	r := io.Reader(os.Stdin)
+	switch flag.NArg() {
+	case 0:
+	case 1:
+		f, err := os.Open(flag.Arg(0))
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer f.Close()
+		r = f
+	default:
+		flag.Usage()
+		os.Exit(1)
+	}
+	if err := run(os.Stdout, r); err != nil {
+		log.Fatal(err)
+	}
+}
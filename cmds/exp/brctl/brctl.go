@@ -0,0 +1,399 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// brctl manages software bridges.
+//
+// Synopsis:
+//     brctl addbr BRIDGE
+//     brctl delbr BRIDGE
+//     brctl addif BRIDGE IFACE...
+//     brctl delif BRIDGE IFACE...
+//     brctl show [BRIDGE...]
+//     brctl showmacs BRIDGE
+//     brctl fdb add|del MAC IFACE [VLAN]
+//     brctl vlanfiltering BRIDGE on|off
+//     brctl vlan show [IFACE...]
+//     brctl vlan add|del IFACE VID [pvid] [untagged]
+//
+// Description:
+//     addbr and delbr create and remove bridges, addif and delif attach
+//     and detach ports. show lists bridges and their ports, showmacs the
+//     forwarding database of a bridge. fdb adds or removes static
+//     forwarding entries. With VLAN filtering on, vlan manages the VLANs
+//     each port carries; pvid makes VID the port's native VLAN, untagged
+//     sends it without a tag.
+//
+// Example:
+//     brctl addbr br0
+//     brctl addif br0 eth0 tap0
+//     brctl vlanfiltering br0 on
+//     brctl vlan add tap0 100 pvid untagged
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netlink/nl"
+	"golang.org/x/sys/unix"
+)
+
+const usage = `usage:
+	brctl addbr BRIDGE
+	brctl delbr BRIDGE
+	brctl addif BRIDGE IFACE...
+	brctl delif BRIDGE IFACE...
+	brctl show [BRIDGE...]
+	brctl showmacs BRIDGE
+	brctl fdb add|del MAC IFACE [VLAN]
+	brctl vlanfiltering BRIDGE on|off
+	brctl vlan show [IFACE...]
+	brctl vlan add|del IFACE VID [pvid] [untagged]`
+
+var errUsage = errors.New(usage)
+
+// sysfs is where bridge attributes netlink does not report are read from.
+var sysfs = "/sys/class/net"
+
+func bridge(name string) (*netlink.Bridge, error) {
+	l, err := netlink.LinkByName(name)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", name, err)
+	}
+	br, ok := l.(*netlink.Bridge)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a bridge", name)
+	}
+	return br, nil
+}
+
+func addbr(name string) error {
+	return netlink.LinkAdd(&netlink.Bridge{LinkAttrs: netlink.LinkAttrs{Name: name}})
+}
+
+func delbr(name string) error {
+	br, err := bridge(name)
+	if err != nil {
+		return err
+	}
+	if br.Attrs().Flags&net.FlagUp != 0 {
+		return fmt.Errorf("bridge %s is still up; can't delete it", name)
+	}
+	return netlink.LinkDel(br)
+}
+
+func setPorts(name string, ifaces []string, add bool) error {
+	br, err := bridge(name)
+	if err != nil {
+		return err
+	}
+	for _, ifname := range ifaces {
+		l, err := netlink.LinkByName(ifname)
+		if err != nil {
+			return fmt.Errorf("%s: %v", ifname, err)
+		}
+		if add {
+			err = netlink.LinkSetMaster(l, br)
+		} else if l.Attrs().MasterIndex != br.Index {
+			err = fmt.Errorf("%s is not a port of %s", ifname, name)
+		} else {
+			err = netlink.LinkSetNoMaster(l)
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %v", ifname, err)
+		}
+	}
+	return nil
+}
+
+func readSysfs(br, attr string) string {
+	b, err := ioutil.ReadFile(filepath.Join(sysfs, br, "bridge", attr))
+	if err != nil {
+		return "?"
+	}
+	return strings.TrimSpace(string(b))
+}
+
+// ports returns the names of the ports of each bridge, sorted.
+func ports(links []netlink.Link) map[int][]string {
+	p := map[int][]string{}
+	for _, l := range links {
+		if m := l.Attrs().MasterIndex; m != 0 {
+			p[m] = append(p[m], l.Attrs().Name)
+		}
+	}
+	for _, names := range p {
+		sort.Strings(names)
+	}
+	return p
+}
+
+func show(w io.Writer, names []string) error {
+	links, err := netlink.LinkList()
+	if err != nil {
+		return err
+	}
+	want := map[string]bool{}
+	for _, n := range names {
+		want[n] = true
+	}
+	p := ports(links)
+	fmt.Fprintf(w, "bridge name\tbridge id\t\tSTP enabled\tinterfaces\n")
+	for _, l := range links {
+		br, ok := l.(*netlink.Bridge)
+		if !ok || (len(want) > 0 && !want[br.Name]) {
+			continue
+		}
+		delete(want, br.Name)
+		stp := "no"
+		if s := readSysfs(br.Name, "stp_state"); s != "0" && s != "?" {
+			stp = "yes"
+		}
+		ifs := p[br.Index]
+		first := ""
+		if len(ifs) > 0 {
+			first = ifs[0]
+		}
+		fmt.Fprintf(w, "%s\t\t%s\t%s\t\t%s\n", br.Name, readSysfs(br.Name, "bridge_id"), stp, first)
+		for _, i := range ifs[min(1, len(ifs)):] {
+			fmt.Fprintf(w, "\t\t\t\t\t\t\t%s\n", i)
+		}
+	}
+	for n := range want {
+		return fmt.Errorf("%s is not a bridge", n)
+	}
+	return nil
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func showmacs(w io.Writer, name string) error {
+	br, err := bridge(name)
+	if err != nil {
+		return err
+	}
+	links, err := netlink.LinkList()
+	if err != nil {
+		return err
+	}
+	names := map[int]string{}
+	for _, l := range links {
+		names[l.Attrs().Index] = l.Attrs().Name
+	}
+	fdb, err := netlink.NeighList(0, unix.AF_BRIDGE)
+	if err != nil {
+		return err
+	}
+	sort.SliceStable(fdb, func(i, j int) bool { return fdb[i].LinkIndex < fdb[j].LinkIndex })
+	fmt.Fprintf(w, "port\tmac addr\t\tvlan\tlocal?\tstatic?\n")
+	for _, n := range fdb {
+		if n.MasterIndex != br.Index && n.LinkIndex != br.Index {
+			continue
+		}
+		vlan := "-"
+		if n.Vlan != 0 {
+			vlan = strconv.Itoa(n.Vlan)
+		}
+		local, static := "no", "no"
+		if n.State&netlink.NUD_PERMANENT != 0 {
+			local = "yes"
+		}
+		if n.State&(netlink.NUD_PERMANENT|netlink.NUD_NOARP) != 0 {
+			static = "yes"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", names[n.LinkIndex], n.HardwareAddr, vlan, local, static)
+	}
+	return nil
+}
+
+func fdb(args []string) error {
+	if len(args) < 3 || len(args) > 4 {
+		return errUsage
+	}
+	mac, err := net.ParseMAC(args[1])
+	if err != nil {
+		return err
+	}
+	l, err := netlink.LinkByName(args[2])
+	if err != nil {
+		return fmt.Errorf("%s: %v", args[2], err)
+	}
+	n := &netlink.Neigh{
+		LinkIndex:    l.Attrs().Index,
+		Family:       unix.AF_BRIDGE,
+		State:        netlink.NUD_NOARP,
+		Flags:        netlink.NTF_MASTER,
+		HardwareAddr: mac,
+	}
+	if len(args) == 4 {
+		vid, err := parseVID(args[3])
+		if err != nil {
+			return err
+		}
+		n.Vlan = int(vid)
+	}
+	switch args[0] {
+	case "add":
+		return netlink.NeighSet(n)
+	case "del":
+		return netlink.NeighDel(n)
+	}
+	return errUsage
+}
+
+func parseVID(s string) (uint16, error) {
+	v, err := strconv.ParseUint(s, 10, 16)
+	if err != nil || v < 1 || v > 4094 {
+		return 0, fmt.Errorf("invalid VLAN ID %q", s)
+	}
+	return uint16(v), nil
+}
+
+func vlanfiltering(args []string) error {
+	if len(args) != 2 || (args[1] != "on" && args[1] != "off") {
+		return errUsage
+	}
+	br, err := bridge(args[0])
+	if err != nil {
+		return err
+	}
+	return netlink.BridgeSetVlanFiltering(br, args[1] == "on")
+}
+
+func vlanFlags(v *nl.BridgeVlanInfo) string {
+	var f []string
+	if v.PortVID() {
+		f = append(f, "PVID")
+	}
+	if v.EngressUntag() {
+		f = append(f, "Egress Untagged")
+	}
+	return strings.Join(f, " ")
+}
+
+func vlanShow(w io.Writer, ifaces []string) error {
+	vlans, err := netlink.BridgeVlanList()
+	if err != nil {
+		return err
+	}
+	want := map[int32]bool{}
+	for _, n := range ifaces {
+		l, err := netlink.LinkByName(n)
+		if err != nil {
+			return fmt.Errorf("%s: %v", n, err)
+		}
+		want[int32(l.Attrs().Index)] = true
+	}
+	var idx []int32
+	for i := range vlans {
+		if len(want) == 0 || want[i] {
+			idx = append(idx, i)
+		}
+	}
+	sort.Slice(idx, func(i, j int) bool { return idx[i] < idx[j] })
+	fmt.Fprintf(w, "port\tvlan ids\n")
+	for _, i := range idx {
+		name := strconv.Itoa(int(i))
+		if l, err := netlink.LinkByIndex(int(i)); err == nil {
+			name = l.Attrs().Name
+		}
+		for j, v := range vlans[i] {
+			if j > 0 {
+				name = ""
+			}
+			fmt.Fprintf(w, "%s\t%d %s\n", name, v.Vid, vlanFlags(v))
+		}
+	}
+	return nil
+}
+
+func vlan(w io.Writer, args []string) error {
+	if len(args) == 0 {
+		return errUsage
+	}
+	if args[0] == "show" {
+		return vlanShow(w, args[1:])
+	}
+	if len(args) < 3 {
+		return errUsage
+	}
+	l, err := netlink.LinkByName(args[1])
+	if err != nil {
+		return fmt.Errorf("%s: %v", args[1], err)
+	}
+	vid, err := parseVID(args[2])
+	if err != nil {
+		return err
+	}
+	var pvid, untagged bool
+	for _, a := range args[3:] {
+		switch a {
+		case "pvid":
+			pvid = true
+		case "untagged":
+			untagged = true
+		default:
+			return errUsage
+		}
+	}
+	// A bridge's own VLANs are set on the bridge itself ("self"),
+	// a port's through its master.
+	_, isBridge := l.(*netlink.Bridge)
+	switch args[0] {
+	case "add":
+		return netlink.BridgeVlanAdd(l, vid, pvid, untagged, isBridge, !isBridge)
+	case "del":
+		return netlink.BridgeVlanDel(l, vid, pvid, untagged, isBridge, !isBridge)
+	}
+	return errUsage
+}
+
+func run(w io.Writer, args []string) error {
+	if len(args) == 0 {
+		return errUsage
+	}
+	cmd, args := args[0], args[1:]
+	switch {
+	case cmd == "addbr" && len(args) == 1:
+		return addbr(args[0])
+	case cmd == "delbr" && len(args) == 1:
+		return delbr(args[0])
+	case cmd == "addif" && len(args) >= 2:
+		return setPorts(args[0], args[1:], true)
+	case cmd == "delif" && len(args) >= 2:
+		return setPorts(args[0], args[1:], false)
+	case cmd == "show":
+		return show(w, args)
+	case cmd == "showmacs" && len(args) == 1:
+		return showmacs(w, args[0])
+	case cmd == "fdb":
+		return fdb(args)
+	case cmd == "vlanfiltering":
+		return vlanfiltering(args)
+	case cmd == "vlan":
+		return vlan(w, args)
+	}
+	return errUsage
+}
+
+func main() {
+	if err := run(os.Stdout, os.Args[1:]); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"io/ioutil"
+	"testing"
+)
+
+func TestParseVID(t *testing.T) {
+	for in, want := range map[string]uint16{"1": 1, "100": 100, "4094": 4094} {
+		if got, err := parseVID(in); err != nil || got != want {
+			t.Errorf("parseVID(%q) = %d, %v, want %d", in, got, err, want)
+		}
+	}
+	for _, in := range []string{"0", "4095", "-1", "x", ""} {
+		if _, err := parseVID(in); err == nil {
+			t.Errorf("parseVID(%q) succeeded, want error", in)
+		}
+	}
+}
+
+func TestUsage(t *testing.T) {
+	for _, args := range [][]string{
+		nil,
+		{"bogus"},
+		{"addbr"},
+		{"addif", "br0"},
+		{"showmacs"},
+		{"fdb", "add", "02:00:00:00:00:01"},
+		{"vlanfiltering", "br0", "maybe"},
+		{"vlan"},
+		{"vlan", "add", "eth0"},
+	} {
+		if err := run(ioutil.Discard, args); err != errUsage {
+			t.Errorf("run(%q) = %v, want usage error", args, err)
+		}
+	}
+}
@@ -0,0 +1,216 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// bwtest measures network throughput between two machines.
+//
+// Synopsis:
+//     bwtest -s [-p PORT]
+//     bwtest -c HOST [OPTIONS]
+//
+// Description:
+//     One side runs a server with -s, the other connects to it with -c
+//     and sends data for the test's duration, over one or more parallel
+//     TCP streams or paced UDP streams. Both sides measure what they
+//     moved; the client prints the throughput of each interval and the
+//     results of both ends. UDP results include loss, reordering and
+//     jitter as seen by the receiver.
+//
+//     The protocol is bwtest's own: it does not interoperate with iperf.
+//
+// Options:
+//     -s:   run as a server
+//     -c:   run as a client of server HOST
+//     -p:   port for TCP and UDP (default: 5201)
+//     -t:   test duration (default: 10s)
+//     -i:   interval between reports, 0 for none (default: 1s)
+//     -P:   number of parallel streams (default: 1)
+//     -u:   use UDP instead of TCP
+//     -b:   target bitrate per stream, with an optional K, M or G suffix
+//           (default: unlimited for TCP, 1M for UDP)
+//     -l:   length of each write or datagram (default: 128K for TCP, 1400
+//           for UDP)
+//     -R:   reverse mode: the server sends and the client receives
+//     -J:   print results as JSON
+//     -min: exit with an error if the received bitrate is below this
+//
+// Example:
+//     bwtest -c 10.0.0.1 -P 4 -t 30s -min 9G -J > burnin.json
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	serve    = flag.Bool("s", false, "run as a server")
+	host     = flag.String("c", "", "run as a client of this server")
+	port     = flag.String("p", defaultPort, "port for TCP and UDP")
+	duration = flag.Duration("t", 10*time.Second, "test duration")
+	every    = flag.Duration("i", time.Second, "interval between reports, 0 for none")
+	streams  = flag.Int("P", 1, "number of parallel streams")
+	udp      = flag.Bool("u", false, "use UDP instead of TCP")
+	bitrate  = flag.String("b", "", "target bitrate per stream (K, M, G suffixes)")
+	length   = flag.Int("l", 0, "length of each write or datagram")
+	reverse  = flag.Bool("R", false, "the server sends, the client receives")
+	jsonOut  = flag.Bool("J", false, "print results as JSON")
+	minRate  = flag.String("min", "", "fail if the received bitrate is below this")
+)
+
+// parseRate parses a bit rate such as 100M.
+func parseRate(s string) (int64, error) {
+	mult := int64(1)
+	switch strings.ToUpper(s[len(s)-1:]) {
+	case "K":
+		mult = 1000
+	case "M":
+		mult = 1000 * 1000
+	case "G":
+		mult = 1000 * 1000 * 1000
+	}
+	if mult != 1 {
+		s = s[:len(s)-1]
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || f < 0 {
+		return 0, fmt.Errorf("invalid bitrate %q", s)
+	}
+	return int64(f * float64(mult)), nil
+}
+
+// bytesString formats n as iperf does, in binary units.
+func bytesString(n int64) string {
+	f := float64(n)
+	for _, u := range []string{"Bytes", "KBytes", "MBytes", "GBytes"} {
+		if f < 1024 || u == "GBytes" {
+			return fmt.Sprintf("%.2f %s", f, u)
+		}
+		f /= 1024
+	}
+	return ""
+}
+
+// rateString formats a bit rate in decimal units.
+func rateString(bps float64) string {
+	for _, u := range []string{"bits/sec", "Kbits/sec", "Mbits/sec", "Gbits/sec"} {
+		if bps < 1000 || u == "Gbits/sec" {
+			return fmt.Sprintf("%.2f %s", bps, u)
+		}
+		bps /= 1000
+	}
+	return ""
+}
+
+func printInterval(w io.Writer, iv interval) {
+	fmt.Fprintf(w, "[SUM] %6.2f-%-6.2f sec  %12s  %14s\n", iv.Start, iv.End, bytesString(iv.Bytes), rateString(iv.BitsPerSecond))
+}
+
+func printResults(w io.Writer, side string, rs []streamResult) {
+	show := func(id string, r streamResult) {
+		fmt.Fprintf(w, "[%3s] %6.2f sec  %12s  %14s  %s", id, r.Seconds, bytesString(r.Bytes), rateString(r.BitsPerSecond), side)
+		if r.Packets != 0 || r.Lost != 0 {
+			pct := 0.0
+			if total := r.Packets + r.Lost; total > 0 {
+				pct = 100 * float64(r.Lost) / float64(total)
+			}
+			fmt.Fprintf(w, "  %.3f ms  %d/%d (%.2g%%)", r.JitterMs, r.Lost, r.Packets+r.Lost, pct)
+			if r.OutOfOrder != 0 {
+				fmt.Fprintf(w, "  %d out of order", r.OutOfOrder)
+			}
+		}
+		fmt.Fprintln(w)
+	}
+	for _, r := range rs {
+		show(strconv.Itoa(r.ID), r)
+	}
+	if len(rs) > 1 {
+		show("SUM", sum(rs))
+	}
+}
+
+func run(stdout io.Writer) error {
+	if *serve {
+		return runServer(net.JoinHostPort("", *port))
+	}
+	if *host == "" {
+		return errors.New("one of -s or -c HOST is required")
+	}
+	p := params{Protocol: "tcp", Streams: *streams, Duration: *duration, Reverse: *reverse, Length: *length}
+	if *udp {
+		p.Protocol = "udp"
+	}
+	if p.Length == 0 {
+		p.Length = defaultTCPLen
+		if *udp {
+			p.Length = defaultUDPLen
+		}
+	}
+	if *bitrate != "" {
+		r, err := parseRate(*bitrate)
+		if err != nil {
+			return err
+		}
+		p.Bitrate = r
+	}
+	var min int64
+	if *minRate != "" {
+		r, err := parseRate(*minRate)
+		if err != nil {
+			return err
+		}
+		min = r
+	}
+	if err := p.validate(); err != nil {
+		return err
+	}
+
+	c := &client{addr: net.JoinHostPort(*host, *port), p: p, interval: *every}
+	if c.interval <= 0 {
+		c.interval = p.Duration + time.Hour
+	} else if !*jsonOut {
+		c.report = func(iv interval) { printInterval(stdout, iv) }
+	}
+	if !*jsonOut {
+		fmt.Fprintf(stdout, "Connecting to %s, %d %s stream(s) for %v\n", c.addr, p.Streams, strings.ToUpper(p.Protocol), p.Duration)
+	}
+	res, err := c.run()
+	if err != nil {
+		return err
+	}
+	if *jsonOut {
+		enc := json.NewEncoder(stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(res); err != nil {
+			return err
+		}
+	} else {
+		fmt.Fprintln(stdout, "- - - - - - - - - - - - - - - - - - - - - - - - -")
+		printResults(stdout, "sender", res.Sent)
+		printResults(stdout, "receiver", res.Received)
+	}
+	if got := sum(res.Received).BitsPerSecond; min > 0 && got < float64(min) {
+		return fmt.Errorf("received %s, below the minimum of %s", rateString(got), rateString(float64(min)))
+	}
+	return nil
+}
+
+func main() {
+	flag.Parse()
+	if flag.NArg() != 0 {
+		flag.Usage()
+		os.Exit(1)
+	}
+	if err := run(os.Stdout); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"log"
+	"net"
+	"testing"
+	"time"
+)
+
+func startServer(t *testing.T) (string, func()) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, err := net.ListenPacket("udp", l.Addr().String())
+	if err != nil {
+		l.Close()
+		t.Skipf("cannot listen on UDP %v: %v", l.Addr(), err)
+	}
+	// The server outlives the test briefly, so it must not use t.Logf.
+	go newServer(log.Printf).serve(l, u)
+	return l.Addr().String(), func() {
+		l.Close()
+		u.Close()
+	}
+}
+
+func TestThroughput(t *testing.T) {
+	addr, stop := startServer(t)
+	defer stop()
+
+	for _, tt := range []struct {
+		name string
+		p    params
+	}{
+		{"tcp", params{Protocol: "tcp", Streams: 1, Length: defaultTCPLen}},
+		{"parallel", params{Protocol: "tcp", Streams: 3, Length: 4096}},
+		{"reverse", params{Protocol: "tcp", Streams: 2, Length: defaultTCPLen, Reverse: true}},
+		{"paced", params{Protocol: "tcp", Streams: 1, Length: 1000, Bitrate: 800000}},
+		{"udp", params{Protocol: "udp", Streams: 2, Length: defaultUDPLen, Bitrate: 10000000}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.p.Duration = 300 * time.Millisecond
+			var intervals int
+			c := &client{addr: addr, p: tt.p, interval: 100 * time.Millisecond, report: func(interval) { intervals++ }}
+			res, err := c.run()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(res.Sent) != tt.p.Streams || len(res.Received) != tt.p.Streams {
+				t.Fatalf("got %d sent and %d received results, want %d", len(res.Sent), len(res.Received), tt.p.Streams)
+			}
+			if intervals == 0 || intervals != len(res.Intervals) {
+				t.Errorf("reported %d intervals, recorded %d", intervals, len(res.Intervals))
+			}
+			sent, recv := sum(res.Sent), sum(res.Received)
+			if sent.Bytes == 0 || recv.Bytes == 0 {
+				t.Fatalf("sent %d, received %d bytes", sent.Bytes, recv.Bytes)
+			}
+			if tt.p.Protocol == "tcp" && sent.Bytes != recv.Bytes {
+				t.Errorf("TCP sent %d bytes but received %d", sent.Bytes, recv.Bytes)
+			}
+			if tt.p.Protocol == "udp" && recv.Packets == 0 {
+				t.Errorf("no UDP packets counted: %+v", recv)
+			}
+			if tt.p.Bitrate != 0 {
+				// 0.3s at 800 kbit/s is 30000 bytes; allow for the
+				// pacer's initial burst.
+				if max := tt.p.Bitrate / 8 * int64(tt.p.Streams) / 2; sent.Bytes > max {
+					t.Errorf("sent %d bytes, more than %d at %d bit/s", sent.Bytes, max, tt.p.Bitrate)
+				}
+			}
+		})
+	}
+}
+
+func TestBadParams(t *testing.T) {
+	addr, stop := startServer(t)
+	defer stop()
+
+	// The client validates too; skip it to check the server does.
+	c := &client{addr: addr, p: params{Protocol: "udp", Streams: 1, Duration: time.Second, Length: 1400, Reverse: true}, interval: time.Second}
+	if _, err := c.run(); err == nil {
+		t.Errorf("server accepted UDP reverse test")
+	}
+}
+
+func TestParseRate(t *testing.T) {
+	for in, want := range map[string]int64{
+		"100":  100,
+		"10K":  10000,
+		"1.5M": 1500000,
+		"10g":  10000000000,
+	} {
+		got, err := parseRate(in)
+		if err != nil || got != want {
+			t.Errorf("parseRate(%q) = %d, %v, want %d", in, got, err, want)
+		}
+	}
+	for _, in := range []string{"fast", "-1M", "M"} {
+		if _, err := parseRate(in); err == nil {
+			t.Errorf("parseRate(%q) succeeded", in)
+		}
+	}
+}
+
+func TestFormat(t *testing.T) {
+	if got := bytesString(3 << 20); got != "3.00 MBytes" {
+		t.Errorf("bytesString = %q", got)
+	}
+	if got := rateString(9.41e9); got != "9.41 Gbits/sec" {
+		t.Errorf("rateString = %q", got)
+	}
+}
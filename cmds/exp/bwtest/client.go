@@ -0,0 +1,241 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// counter counts bytes moved by a stream; it is read concurrently for
+// interval reports.
+type counter struct {
+	n int64
+}
+
+func (c *counter) add(n int) {
+	atomic.AddInt64(&c.n, int64(n))
+}
+
+func (c *counter) load() int64 {
+	return atomic.LoadInt64(&c.n)
+}
+
+// interval is the sum over all streams of the bytes the client moved in
+// one reporting interval.
+type interval struct {
+	Start         float64 `json:"start"`
+	End           float64 `json:"end"`
+	Bytes         int64   `json:"bytes"`
+	BitsPerSecond float64 `json:"bits_per_second"`
+}
+
+// result is the outcome of a test.
+type result struct {
+	Server    string         `json:"server"`
+	Params    params         `json:"params"`
+	Intervals []interval     `json:"intervals"`
+	Sent      []streamResult `json:"sent"`
+	Received  []streamResult `json:"received"`
+}
+
+func sum(rs []streamResult) streamResult {
+	s := streamResult{ID: -1}
+	for _, r := range rs {
+		s.Bytes += r.Bytes
+		s.Packets += r.Packets
+		s.Lost += r.Lost
+		s.OutOfOrder += r.OutOfOrder
+		s.BitsPerSecond += r.BitsPerSecond
+		if r.Seconds > s.Seconds {
+			s.Seconds = r.Seconds
+		}
+		if r.JitterMs > s.JitterMs {
+			s.JitterMs = r.JitterMs
+		}
+	}
+	return s
+}
+
+type client struct {
+	addr     string
+	p        params
+	interval time.Duration
+	// report, if set, is called at the end of every interval.
+	report func(interval)
+}
+
+func (c *client) run() (*result, error) {
+	ck, err := newCookie()
+	if err != nil {
+		return nil, err
+	}
+	ctl, err := net.DialTimeout("tcp", c.addr, setupTimeout)
+	if err != nil {
+		return nil, err
+	}
+	defer ctl.Close()
+	if err := writePreamble(ctl, kindControl, ck, 0); err != nil {
+		return nil, err
+	}
+	dec, enc := json.NewDecoder(ctl), json.NewEncoder(ctl)
+	if err := enc.Encode(&message{Params: &c.p}); err != nil {
+		return nil, err
+	}
+	ctl.SetReadDeadline(time.Now().Add(setupTimeout))
+	var m message
+	if err := dec.Decode(&m); err != nil {
+		return nil, err
+	}
+	if m.Error != "" {
+		return nil, fmt.Errorf("server: %s", m.Error)
+	}
+
+	var conns []net.Conn
+	defer func() {
+		for _, c := range conns {
+			c.Close()
+		}
+	}()
+	for i := 0; i < c.p.Streams; i++ {
+		d, err := net.DialTimeout(c.p.Protocol, c.addr, setupTimeout)
+		if err != nil {
+			return nil, err
+		}
+		conns = append(conns, d)
+		if c.p.Protocol == "tcp" {
+			if err := writePreamble(d, kindData, ck, uint32(i)); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := dec.Decode(&m); err != nil {
+		return nil, err
+	}
+	if m.Error != "" {
+		return nil, fmt.Errorf("server: %s", m.Error)
+	}
+	if !m.Start {
+		return nil, errors.New("server did not start the test")
+	}
+
+	begin := time.Now()
+	deadline := begin.Add(c.p.Duration)
+	counters := make([]counter, len(conns))
+	local := make([]streamResult, len(conns))
+	var wg sync.WaitGroup
+	for i, d := range conns {
+		wg.Add(1)
+		go func(i int, d net.Conn) {
+			defer wg.Done()
+			var n int64
+			switch {
+			case c.p.Protocol == "udp":
+				n = sendUDP(d, c.p, ck, uint32(i), deadline, &counters[i])
+			case c.p.Reverse:
+				n = receive(d, &counters[i])
+			default:
+				n = send(d, c.p, deadline, &counters[i])
+				d.(*net.TCPConn).CloseWrite()
+			}
+			el := time.Since(begin).Seconds()
+			local[i] = streamResult{ID: i, Bytes: n, Seconds: el, BitsPerSecond: float64(n*8) / el}
+		}(i, d)
+	}
+
+	res := &result{Server: c.addr, Params: c.p}
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	tick := time.NewTicker(c.interval)
+	defer tick.Stop()
+	var last int64
+	lastT := begin
+loop:
+	for {
+		select {
+		case <-done:
+			break loop
+		case now := <-tick.C:
+			var total int64
+			for i := range counters {
+				total += counters[i].load()
+			}
+			iv := interval{
+				Start: lastT.Sub(begin).Seconds(),
+				End:   now.Sub(begin).Seconds(),
+				Bytes: total - last,
+			}
+			if d := now.Sub(lastT).Seconds(); d > 0 {
+				iv.BitsPerSecond = float64(iv.Bytes*8) / d
+			}
+			res.Intervals = append(res.Intervals, iv)
+			if c.report != nil {
+				c.report(iv)
+			}
+			last, lastT = total, now
+		}
+	}
+
+	if err := enc.Encode(&message{Done: true}); err != nil {
+		return nil, err
+	}
+	ctl.SetReadDeadline(time.Now().Add(2 * setupTimeout))
+	if err := dec.Decode(&m); err != nil {
+		return nil, err
+	}
+	if m.Error != "" {
+		return nil, fmt.Errorf("server: %s", m.Error)
+	}
+	if c.p.Reverse {
+		res.Sent, res.Received = m.Results, local
+	} else {
+		res.Sent, res.Received = local, m.Results
+	}
+	return res, nil
+}
+
+func receive(r io.Reader, count *counter) int64 {
+	buf := make([]byte, 128<<10)
+	var n int64
+	for {
+		m, err := r.Read(buf)
+		n += int64(m)
+		count.add(m)
+		if err != nil {
+			return n
+		}
+	}
+}
+
+func sendUDP(w io.Writer, p params, ck cookie, stream uint32, deadline time.Time, count *counter) int64 {
+	buf := make([]byte, p.Length)
+	rate := p.Bitrate
+	if rate == 0 {
+		rate = defaultUDPRate
+	}
+	pc := &pacer{start: time.Now(), bitrate: rate}
+	var n int64
+	for seq := uint64(1); time.Now().Before(deadline); seq++ {
+		pc.wait(len(buf))
+		putUDPHeader(buf, ck, stream, seq, time.Now())
+		m, err := w.Write(buf)
+		if err != nil {
+			// ENOBUFS and friends: the datagram is lost.
+			continue
+		}
+		n += int64(m)
+		count.add(m)
+	}
+	return n
+}
@@ -0,0 +1,218 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// A test starts with a control connection, over which the client sends
+// its parameters as JSON and the two sides later exchange results. Every
+// TCP connection starts with a preamble: a kind byte, the test's cookie
+// and, for data connections, the stream number. UDP datagrams carry the
+// cookie, stream, sequence number and send time in a header.
+
+const (
+	kindControl = 'C'
+	kindData    = 'D'
+
+	cookieLen = 16
+	// udpHeaderLen is cookie, stream (4), sequence (8), nanoseconds (8).
+	udpHeaderLen = cookieLen + 4 + 8 + 8
+
+	defaultPort   = "5201"
+	defaultTCPLen = 128 << 10
+	defaultUDPLen = 1400
+	// defaultUDPRate is per stream, as in iperf.
+	defaultUDPRate = 1000000
+)
+
+type cookie [cookieLen]byte
+
+func newCookie() (cookie, error) {
+	var c cookie
+	_, err := rand.Read(c[:])
+	return c, err
+}
+
+// params describe a test.
+type params struct {
+	Protocol string
+	Streams  int
+	Duration time.Duration
+	Reverse  bool
+	Length   int
+	// Bitrate is the target rate of each stream in bits per second; 0
+	// means as fast as possible.
+	Bitrate int64
+}
+
+func (p *params) validate() error {
+	switch {
+	case p.Protocol != "tcp" && p.Protocol != "udp":
+		return fmt.Errorf("unknown protocol %q", p.Protocol)
+	case p.Streams < 1 || p.Streams > 128:
+		return fmt.Errorf("number of streams must be between 1 and 128")
+	case p.Duration <= 0 || p.Duration > 24*time.Hour:
+		return fmt.Errorf("invalid duration %v", p.Duration)
+	case p.Protocol == "udp" && (p.Length < udpHeaderLen || p.Length > 65507):
+		return fmt.Errorf("UDP length must be between %d and 65507", udpHeaderLen)
+	case p.Length < 1 || p.Length > 16<<20:
+		return fmt.Errorf("invalid length %d", p.Length)
+	case p.Protocol == "udp" && p.Reverse:
+		return errors.New("reverse mode is only supported for TCP")
+	case p.Bitrate < 0:
+		return errors.New("negative bitrate")
+	}
+	return nil
+}
+
+// streamResult is one side's measurement of a stream.
+type streamResult struct {
+	ID            int     `json:"id"`
+	Bytes         int64   `json:"bytes"`
+	Seconds       float64 `json:"seconds"`
+	BitsPerSecond float64 `json:"bits_per_second"`
+	Packets       int64   `json:"packets,omitempty"`
+	Lost          int64   `json:"lost_packets,omitempty"`
+	OutOfOrder    int64   `json:"out_of_order,omitempty"`
+	JitterMs      float64 `json:"jitter_ms,omitempty"`
+}
+
+// message is sent over the control connection. Each step of the exchange
+// uses some of the fields.
+type message struct {
+	Params  *params        `json:",omitempty"`
+	Error   string         `json:",omitempty"`
+	Start   bool           `json:",omitempty"`
+	Done    bool           `json:",omitempty"`
+	Results []streamResult `json:",omitempty"`
+}
+
+func writePreamble(w io.Writer, kind byte, c cookie, stream uint32) error {
+	b := make([]byte, 1+cookieLen, 1+cookieLen+4)
+	b[0] = kind
+	copy(b[1:], c[:])
+	if kind == kindData {
+		b = b[:cap(b)]
+		binary.BigEndian.PutUint32(b[1+cookieLen:], stream)
+	}
+	_, err := w.Write(b)
+	return err
+}
+
+func readPreamble(r io.Reader) (kind byte, c cookie, stream uint32, err error) {
+	b := make([]byte, 1+cookieLen)
+	if _, err = io.ReadFull(r, b); err != nil {
+		return
+	}
+	kind = b[0]
+	copy(c[:], b[1:])
+	switch kind {
+	case kindControl:
+	case kindData:
+		var s [4]byte
+		if _, err = io.ReadFull(r, s[:]); err != nil {
+			return
+		}
+		stream = binary.BigEndian.Uint32(s[:])
+	default:
+		err = fmt.Errorf("unknown connection kind %q", kind)
+	}
+	return
+}
+
+func putUDPHeader(b []byte, c cookie, stream uint32, seq uint64, t time.Time) {
+	copy(b, c[:])
+	binary.BigEndian.PutUint32(b[cookieLen:], stream)
+	binary.BigEndian.PutUint64(b[cookieLen+4:], seq)
+	binary.BigEndian.PutUint64(b[cookieLen+12:], uint64(t.UnixNano()))
+}
+
+func parseUDPHeader(b []byte) (c cookie, stream uint32, seq uint64, sent time.Time, ok bool) {
+	if len(b) < udpHeaderLen {
+		return
+	}
+	copy(c[:], b)
+	stream = binary.BigEndian.Uint32(b[cookieLen:])
+	seq = binary.BigEndian.Uint64(b[cookieLen+4:])
+	sent = time.Unix(0, int64(binary.BigEndian.Uint64(b[cookieLen+12:])))
+	return c, stream, seq, sent, true
+}
+
+// udpStats accumulates what a receiver sees of a UDP stream.
+type udpStats struct {
+	bytes, packets, outOfOrder int64
+	maxSeq                     uint64
+	// jitter is the RFC 3550 interarrival jitter estimate.
+	jitter      float64
+	lastTransit time.Duration
+	first, last time.Time
+}
+
+func (u *udpStats) add(n int, seq uint64, sent, now time.Time) {
+	if u.packets == 0 {
+		u.first = now
+	} else {
+		d := float64(now.Sub(sent) - u.lastTransit)
+		if d < 0 {
+			d = -d
+		}
+		u.jitter += (d - u.jitter) / 16
+	}
+	u.lastTransit = now.Sub(sent)
+	u.last = now
+	u.packets++
+	u.bytes += int64(n)
+	if seq < u.maxSeq {
+		u.outOfOrder++
+	} else {
+		u.maxSeq = seq
+	}
+}
+
+func (u *udpStats) result(id int, d time.Duration) streamResult {
+	r := streamResult{
+		ID:         id,
+		Bytes:      u.bytes,
+		Seconds:    d.Seconds(),
+		Packets:    u.packets,
+		OutOfOrder: u.outOfOrder,
+		JitterMs:   u.jitter / float64(time.Millisecond),
+	}
+	// Sequence numbers start at 1, so maxSeq packets were sent up to the
+	// last one seen.
+	if lost := int64(u.maxSeq) - u.packets; lost > 0 {
+		r.Lost = lost
+	}
+	if r.Seconds > 0 {
+		r.BitsPerSecond = float64(r.Bytes*8) / r.Seconds
+	}
+	return r
+}
+
+// pacer spaces out writes to achieve a bitrate.
+type pacer struct {
+	start   time.Time
+	bitrate int64
+	sent    int64
+}
+
+// wait blocks until n more bytes may be sent.
+func (p *pacer) wait(n int) {
+	if p.bitrate <= 0 {
+		return
+	}
+	due := p.start.Add(time.Duration(float64(p.sent*8) / float64(p.bitrate) * float64(time.Second)))
+	if d := time.Until(due); d > 0 {
+		time.Sleep(d)
+	}
+	p.sent += int64(n)
+}
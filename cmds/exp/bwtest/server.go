@@ -0,0 +1,297 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net"
+	"sync"
+	"time"
+)
+
+// setupTimeout bounds how long the server waits for a client's data
+// connections and for the end of a test.
+const setupTimeout = 10 * time.Second
+
+// session is a test in progress on the server.
+type session struct {
+	p params
+
+	mu      sync.Mutex
+	conns   []net.Conn
+	results []streamResult
+	udp     map[uint32]*udpStats
+	ready   chan struct{}
+	start   chan struct{}
+	started sync.Once
+	wg      sync.WaitGroup
+}
+
+// begin releases the streams. Streams of a test that failed to start
+// find their connections closed.
+func (ss *session) begin() {
+	ss.started.Do(func() { close(ss.start) })
+}
+
+type server struct {
+	mu       sync.Mutex
+	sessions map[cookie]*session
+	logf     func(string, ...interface{})
+}
+
+func newServer(logf func(string, ...interface{})) *server {
+	return &server{sessions: map[cookie]*session{}, logf: logf}
+}
+
+func (s *server) session(c cookie) *session {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.sessions[c]
+}
+
+// serve accepts tests on l and, for UDP tests, datagrams on u.
+func (s *server) serve(l net.Listener, u net.PacketConn) error {
+	if u != nil {
+		go s.serveUDP(u)
+	}
+	for {
+		c, err := l.Accept()
+		if err != nil {
+			return err
+		}
+		go s.handle(c)
+	}
+}
+
+func (s *server) handle(c net.Conn) {
+	c.SetReadDeadline(time.Now().Add(setupTimeout))
+	kind, ck, stream, err := readPreamble(c)
+	if err != nil {
+		s.logf("%v: %v", c.RemoteAddr(), err)
+		c.Close()
+		return
+	}
+	c.SetReadDeadline(time.Time{})
+	if kind == kindControl {
+		defer c.Close()
+		if err := s.control(c, ck); err != nil {
+			s.logf("%v: %v", c.RemoteAddr(), err)
+			json.NewEncoder(c).Encode(&message{Error: err.Error()})
+		}
+		return
+	}
+	ss := s.session(ck)
+	if ss == nil {
+		c.Close()
+		return
+	}
+	ss.addStream(c, int(stream))
+}
+
+func (ss *session) addStream(c net.Conn, id int) {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	if id < 0 || id >= ss.p.Streams || ss.conns[id] != nil {
+		c.Close()
+		return
+	}
+	ss.conns[id] = c
+	n := 0
+	for _, c := range ss.conns {
+		if c != nil {
+			n++
+		}
+	}
+	ss.wg.Add(1)
+	go ss.runStream(c, id)
+	if n == ss.p.Streams {
+		close(ss.ready)
+	}
+}
+
+// runStream receives on c until the client closes it, or in reverse mode
+// sends for the test's duration.
+func (ss *session) runStream(c net.Conn, id int) {
+	defer ss.wg.Done()
+	defer c.Close()
+	<-ss.start
+	begin := time.Now()
+	var n int64
+	if ss.p.Reverse {
+		n = send(c, ss.p, begin.Add(ss.p.Duration), nil)
+	} else {
+		n, _ = io.Copy(ioutil.Discard, c)
+	}
+	d := time.Since(begin)
+	r := streamResult{ID: id, Bytes: n, Seconds: d.Seconds()}
+	if d > 0 {
+		r.BitsPerSecond = float64(n*8) / d.Seconds()
+	}
+	ss.mu.Lock()
+	ss.results[id] = r
+	ss.mu.Unlock()
+}
+
+// send writes to w until deadline, pacing to p.Bitrate, and returns the
+// number of bytes written. count, if set, is updated as bytes are sent.
+func send(w io.Writer, p params, deadline time.Time, count *counter) int64 {
+	buf := make([]byte, p.Length)
+	pc := &pacer{start: time.Now(), bitrate: p.Bitrate}
+	var n int64
+	for time.Now().Before(deadline) {
+		pc.wait(len(buf))
+		m, err := w.Write(buf)
+		n += int64(m)
+		if count != nil {
+			count.add(m)
+		}
+		if err != nil {
+			break
+		}
+	}
+	return n
+}
+
+func (s *server) control(c net.Conn, ck cookie) error {
+	dec, enc := json.NewDecoder(c), json.NewEncoder(c)
+	var m message
+	if err := dec.Decode(&m); err != nil {
+		return err
+	}
+	if m.Params == nil {
+		return errors.New("no test parameters")
+	}
+	p := *m.Params
+	if err := p.validate(); err != nil {
+		return err
+	}
+	ss := &session{
+		p:       p,
+		conns:   make([]net.Conn, p.Streams),
+		results: make([]streamResult, p.Streams),
+		udp:     map[uint32]*udpStats{},
+		ready:   make(chan struct{}),
+		start:   make(chan struct{}),
+	}
+	s.mu.Lock()
+	if _, ok := s.sessions[ck]; ok {
+		s.mu.Unlock()
+		return errors.New("duplicate test cookie")
+	}
+	s.sessions[ck] = ss
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		delete(s.sessions, ck)
+		s.mu.Unlock()
+		ss.begin()
+		ss.mu.Lock()
+		for _, c := range ss.conns {
+			if c != nil {
+				c.Close()
+			}
+		}
+		ss.mu.Unlock()
+	}()
+	s.logf("%v: %s test, %d streams, %v%s", c.RemoteAddr(), p.Protocol, p.Streams, p.Duration, map[bool]string{true: ", reverse"}[p.Reverse])
+
+	// Acknowledge the parameters, then wait for the data connections.
+	if err := enc.Encode(&message{}); err != nil {
+		return err
+	}
+	if p.Protocol == "tcp" {
+		select {
+		case <-ss.ready:
+		case <-time.After(setupTimeout):
+			return errors.New("timed out waiting for data connections")
+		}
+	}
+	begin := time.Now()
+	ss.begin()
+	if err := enc.Encode(&message{Start: true}); err != nil {
+		return err
+	}
+
+	c.SetReadDeadline(time.Now().Add(p.Duration + setupTimeout))
+	if err := dec.Decode(&m); err != nil {
+		return err
+	}
+	if !m.Done {
+		return errors.New("expected end of test")
+	}
+	var results []streamResult
+	if p.Protocol == "tcp" {
+		done := make(chan struct{})
+		go func() {
+			ss.wg.Wait()
+			close(done)
+		}()
+		select {
+		case <-done:
+		case <-time.After(setupTimeout):
+			return errors.New("timed out waiting for streams to finish")
+		}
+		results = ss.results
+	} else {
+		// Let datagrams in flight arrive.
+		time.Sleep(100 * time.Millisecond)
+		ss.mu.Lock()
+		for id := 0; id < p.Streams; id++ {
+			u, ok := ss.udp[uint32(id)]
+			if !ok {
+				u = &udpStats{}
+			}
+			results = append(results, u.result(id, time.Since(begin)))
+		}
+		ss.mu.Unlock()
+	}
+	return enc.Encode(&message{Results: results})
+}
+
+func (s *server) serveUDP(u net.PacketConn) {
+	buf := make([]byte, 65536)
+	for {
+		n, _, err := u.ReadFrom(buf)
+		if err != nil {
+			s.logf("UDP: %v", err)
+			return
+		}
+		now := time.Now()
+		ck, stream, seq, sent, ok := parseUDPHeader(buf[:n])
+		if !ok {
+			continue
+		}
+		ss := s.session(ck)
+		if ss == nil || int(stream) >= ss.p.Streams {
+			continue
+		}
+		ss.mu.Lock()
+		st, ok := ss.udp[stream]
+		if !ok {
+			st = &udpStats{}
+			ss.udp[stream] = st
+		}
+		st.add(n, seq, sent, now)
+		ss.mu.Unlock()
+	}
+}
+
+func runServer(addr string) error {
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	u, err := net.ListenPacket("udp", addr)
+	if err != nil {
+		return fmt.Errorf("UDP: %v", err)
+	}
+	log.Printf("Server listening on %v", l.Addr())
+	return newServer(log.Printf).serve(l, u)
+}
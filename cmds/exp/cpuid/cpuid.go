@@ -0,0 +1,122 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// cpuid decodes the CPUID leaves of the CPU.
+//
+// Synopsis:
+//     cpuid [-r] [-j] [-c CPU]
+//
+// Description:
+//     cpuid prints the vendor, brand, family, model and stepping, the
+//     feature flags, the cache topology and the hypervisor of a CPU.
+//
+// Options:
+//     -r: dump the raw leaves and subleaves instead
+//     -j: print JSON
+//     -c: run on this CPU (default: any)
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"runtime"
+	"strings"
+
+	"github.com/u-root/u-root/pkg/cpuid"
+	"golang.org/x/sys/unix"
+)
+
+var (
+	raw     = flag.Bool("r", false, "dump the raw leaves")
+	jsonOut = flag.Bool("j", false, "print JSON")
+	cpu     = flag.Int("c", -1, "CPU to run on")
+)
+
+// pin locks the calling goroutine to CPU c.
+func pin(c int) error {
+	runtime.LockOSThread()
+	var set unix.CPUSet
+	set.Set(c)
+	if err := unix.SchedSetaffinity(0, &set); err != nil {
+		return fmt.Errorf("cannot run on CPU %d: %v", c, err)
+	}
+	return nil
+}
+
+func printRaw(w io.Writer, leaves []cpuid.Leaf) {
+	for _, l := range leaves {
+		fmt.Fprintf(w, "   0x%08x 0x%02x: eax=0x%08x ebx=0x%08x ecx=0x%08x edx=0x%08x\n",
+			l.Leaf, l.Subleaf, l.EAX, l.EBX, l.ECX, l.EDX)
+	}
+}
+
+func printInfo(w io.Writer, i *cpuid.Info) {
+	fmt.Fprintf(w, "vendor:        %s\n", i.Vendor)
+	if i.Brand != "" {
+		fmt.Fprintf(w, "brand:         %s\n", i.Brand)
+	}
+	fmt.Fprintf(w, "family:        %d (%#x)\n", i.Family, i.Family)
+	fmt.Fprintf(w, "model:         %d (%#x)\n", i.Model, i.Model)
+	fmt.Fprintf(w, "stepping:      %d\n", i.Stepping)
+	fmt.Fprintf(w, "apic id:       %d\n", i.APICID)
+	fmt.Fprintf(w, "max leaves:    %#x, %#x\n", i.MaxLeaf, i.MaxExtLeaf)
+	if i.PhysBits != 0 {
+		fmt.Fprintf(w, "address sizes: %d bits physical, %d bits virtual\n", i.PhysBits, i.VirtBits)
+	}
+	if h := i.Hypervisor; h != nil {
+		fmt.Fprintf(w, "hypervisor:    %s (max leaf %#x)\n", h.Vendor, h.MaxLeaf)
+	}
+	if len(i.Caches) > 0 {
+		fmt.Fprintf(w, "caches:\n")
+		for _, c := range i.Caches {
+			fmt.Fprintf(w, "    %v\n", c)
+		}
+	}
+	fmt.Fprintf(w, "flags:         %s\n", strings.Join(i.Features, " "))
+}
+
+func run(w io.Writer, f cpuid.Func) error {
+	if f == nil {
+		return errors.New("CPUID is not supported on " + runtime.GOARCH)
+	}
+	if *cpu >= 0 {
+		if err := pin(*cpu); err != nil {
+			return err
+		}
+	}
+	var v interface{}
+	if *raw {
+		v = cpuid.Dump(f)
+	} else {
+		v = cpuid.Decode(f)
+	}
+	if *jsonOut {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	}
+	switch v := v.(type) {
+	case []cpuid.Leaf:
+		printRaw(w, v)
+	case *cpuid.Info:
+		printInfo(w, v)
+	}
+	return nil
+}
+
+func main() {
+	flag.Parse()
+	if flag.NArg() != 0 {
+		flag.Usage()
+		os.Exit(1)
+	}
+	if err := run(os.Stdout, cpuid.Native); err != nil {
+		log.Fatal(err)
+	}
+}
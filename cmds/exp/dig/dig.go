@@ -0,0 +1,230 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// dig queries DNS servers.
+//
+// Synopsis:
+//
+//	dig [-4] [-6] [-p PORT] [-t TYPE] [-x ADDR] [@SERVER] [NAME] [TYPE] [+OPTION...]
+//
+// Description:
+//
+//	dig sends a single query to SERVER (default: the first nameserver in
+//	/etc/resolv.conf) and prints the response. Responses truncated over
+//	UDP are retried over TCP.
+//
+// Options:
+//
+//	-4:        only use IPv4
+//	-6:        only use IPv6
+//	-p:        server port (default: 53)
+//	-t:        record type (default: A, or PTR with -x)
+//	-x:        reverse lookup of ADDR
+//	+short:    only print the answer data
+//	+tcp:      query over TCP
+//	+dnssec:   request DNSSEC records (sets the EDNS DO bit)
+//	+edns:     send an EDNS(0) OPT record (+bufsize=N sets its size)
+//	+adflag:   set the AD bit in the query
+//	+cdflag:   set the CD bit in the query, disabling validation
+//	+norecurse: clear the RD bit
+//	+time=N:   timeout in seconds (default: 5)
+//	+tries=N:  number of UDP attempts (default: 3)
+//
+// Example:
+//
+//	dig @10.0.2.3 pxe.example.com AAAA +short
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+var (
+	port      = flag.String("p", "53", "server port")
+	typ       = flag.String("t", "", "record type")
+	reverse   = flag.String("x", "", "reverse lookup of this address")
+	forceIPv4 = flag.Bool("4", false, "only use IPv4")
+	forceIPv6 = flag.Bool("6", false, "only use IPv6")
+)
+
+// resolvConf is a variable so tests can override it.
+var resolvConf = "/etc/resolv.conf"
+
+// config is a fully parsed command line.
+type config struct {
+	server string
+	name   string
+	typ    dnsmessage.Type
+	short  bool
+	opts   queryOpts
+}
+
+// parseArgs handles dig's positional syntax: @server, a name, a type, and
+// +options, in any order.
+func parseArgs(args []string) (*config, error) {
+	c := &config{
+		opts: queryOpts{
+			recurse:   true,
+			timeout:   5 * time.Second,
+			tries:     3,
+			forceIPv4: *forceIPv4,
+			forceIPv6: *forceIPv6,
+		},
+	}
+	typeSet := false
+	if *typ != "" {
+		t, ok := parseType(*typ)
+		if !ok {
+			return nil, fmt.Errorf("unknown record type %q", *typ)
+		}
+		c.typ, typeSet = t, true
+	}
+	if *reverse != "" {
+		n, err := reverseName(*reverse)
+		if err != nil {
+			return nil, err
+		}
+		c.name = n
+		if !typeSet {
+			c.typ, typeSet = dnsmessage.TypePTR, true
+		}
+	}
+
+	for _, a := range args {
+		switch {
+		case strings.HasPrefix(a, "@"):
+			c.server = a[1:]
+		case strings.HasPrefix(a, "+"):
+			if err := c.parseOption(a[1:]); err != nil {
+				return nil, err
+			}
+		default:
+			if c.name == "" {
+				c.name = a
+				continue
+			}
+			t, ok := parseType(a)
+			if !ok || typeSet {
+				return nil, fmt.Errorf("unexpected argument %q", a)
+			}
+			c.typ, typeSet = t, true
+		}
+	}
+	if c.name == "" {
+		// Like dig, query the root servers by default.
+		c.name = "."
+		if !typeSet {
+			c.typ = dnsmessage.TypeNS
+		}
+	} else if !typeSet {
+		c.typ = dnsmessage.TypeA
+	}
+	if c.server == "" {
+		c.server = defaultServer(resolvConf)
+	}
+	return c, nil
+}
+
+func (c *config) parseOption(o string) error {
+	name, val := o, ""
+	if i := strings.IndexByte(o, '='); i >= 0 {
+		name, val = o[:i], o[i+1:]
+	}
+	on := true
+	if strings.HasPrefix(name, "no") {
+		on, name = false, name[2:]
+	}
+	number := func() (int, error) {
+		n, err := strconv.Atoi(val)
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("invalid value for +%s: %q", name, val)
+		}
+		return n, nil
+	}
+	switch name {
+	case "short":
+		c.short = on
+	case "tcp", "vc":
+		c.opts.tcp = on
+	case "dnssec":
+		c.opts.dnssec = on
+	case "edns":
+		c.opts.edns = on
+	case "adflag":
+		c.opts.adflag = on
+	case "cdflag":
+		c.opts.cdflag = on
+	case "recurse":
+		c.opts.recurse = on
+	case "bufsize":
+		n, err := number()
+		if err != nil {
+			return err
+		}
+		if n > 65535 {
+			return fmt.Errorf("+bufsize must be at most 65535")
+		}
+		c.opts.edns, c.opts.ednsSize = true, uint16(n)
+	case "time":
+		n, err := number()
+		if err != nil {
+			return err
+		}
+		c.opts.timeout = time.Duration(n) * time.Second
+	case "tries", "retry":
+		n, err := number()
+		if err != nil {
+			return err
+		}
+		if name == "retry" {
+			n++
+		}
+		c.opts.tries = n
+	default:
+		return fmt.Errorf("unknown option +%s", o)
+	}
+	return nil
+}
+
+func run(args []string, stdout io.Writer) error {
+	c, err := parseArgs(args)
+	if err != nil {
+		return err
+	}
+	server := net.JoinHostPort(c.server, *port)
+	q, id, err := buildQuery(c.name, c.typ, c.opts)
+	if err != nil {
+		return err
+	}
+	if !c.short {
+		fmt.Fprintf(stdout, "; <<>> u-root dig <<>> %s %s\n", c.name, typeString(c.typ))
+	}
+	r, err := exchange(server, q, id, c.opts)
+	if err != nil {
+		return fmt.Errorf("communications error to %s: %v", server, err)
+	}
+	if c.short {
+		printShort(stdout, r)
+		return nil
+	}
+	printFull(stdout, r, c.server, *port)
+	return nil
+}
+
+func main() {
+	flag.Parse()
+	if err := run(flag.Args(), os.Stdout); err != nil {
+		log.Fatal(err)
+	}
+}
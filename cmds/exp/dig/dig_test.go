@@ -0,0 +1,215 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// answer builds a response to query with a single A record. If truncate
+// is set, the answer is omitted and the TC bit set.
+func answer(t *testing.T, query []byte, truncate bool) []byte {
+	var q dnsmessage.Message
+	if err := q.Unpack(query); err != nil {
+		t.Errorf("server: bad query: %v", err)
+		return nil
+	}
+	h := dnsmessage.Header{ID: q.Header.ID, Response: true, RecursionDesired: q.Header.RecursionDesired, RecursionAvailable: true, Truncated: truncate}
+	b := dnsmessage.NewBuilder(nil, h)
+	b.StartQuestions()
+	b.Question(q.Questions[0])
+	if !truncate {
+		b.StartAnswers()
+		b.AResource(dnsmessage.ResourceHeader{Name: q.Questions[0].Name, Class: dnsmessage.ClassINET, TTL: 60}, dnsmessage.AResource{A: [4]byte{10, 0, 0, 42}})
+	}
+	msg, err := b.Finish()
+	if err != nil {
+		t.Errorf("server: %v", err)
+	}
+	// Mark the answer as validated.
+	msg[3] |= flagAD
+	return msg
+}
+
+// fakeServer answers over UDP with a truncated response and over TCP with
+// the full response, on the same port.
+func fakeServer(t *testing.T) (string, func()) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	pc, err := net.ListenPacket("udp", l.Addr().String())
+	if err != nil {
+		l.Close()
+		t.Skipf("cannot listen on UDP %v: %v", l.Addr(), err)
+	}
+	go func() {
+		buf := make([]byte, 512)
+		for {
+			n, addr, err := pc.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			pc.WriteTo(answer(t, buf[:n], true), addr)
+		}
+	}()
+	go func() {
+		for {
+			c, err := l.Accept()
+			if err != nil {
+				return
+			}
+			var lb [2]byte
+			if _, err := io.ReadFull(c, lb[:]); err != nil {
+				c.Close()
+				continue
+			}
+			q := make([]byte, binary.BigEndian.Uint16(lb[:]))
+			io.ReadFull(c, q)
+			r := answer(t, q, false)
+			binary.BigEndian.PutUint16(lb[:], uint16(len(r)))
+			c.Write(append(lb[:], r...))
+			c.Close()
+		}
+	}()
+	return l.Addr().String(), func() {
+		l.Close()
+		pc.Close()
+	}
+}
+
+func TestExchangeTCPFallback(t *testing.T) {
+	addr, stop := fakeServer(t)
+	defer stop()
+
+	o := queryOpts{recurse: true, timeout: 2 * time.Second, tries: 1}
+	q, id, err := buildQuery("boot.example.com", dnsmessage.TypeA, o)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := exchange(addr, q, id, o)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !r.fellBack || r.proto != "TCP" {
+		t.Errorf("fellBack = %v, proto = %q, want fallback to TCP", r.fellBack, r.proto)
+	}
+	if len(r.answers) != 1 || rdata(r.answers[0]) != "10.0.0.42" {
+		t.Fatalf("answers = %+v, want one A record for 10.0.0.42", r.answers)
+	}
+	if !r.ad {
+		t.Errorf("AD flag not reported")
+	}
+
+	var out bytes.Buffer
+	printFull(&out, r, "127.0.0.1", "53")
+	for _, want := range []string{
+		"status: NOERROR",
+		"flags: qr rd ra ad;",
+		"boot.example.com.\t\t60\tIN\tA\t10.0.0.42",
+		"Truncated over UDP, retried over TCP",
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("output does not contain %q:\n%s", want, out.String())
+		}
+	}
+	out.Reset()
+	printShort(&out, r)
+	if got := out.String(); got != "10.0.0.42\n" {
+		t.Errorf("short output = %q, want %q", got, "10.0.0.42\n")
+	}
+}
+
+func TestBuildQuery(t *testing.T) {
+	q, id, err := buildQuery("example.com", dnsmessage.TypeMX, queryOpts{dnssec: true, cdflag: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var m dnsmessage.Message
+	if err := m.Unpack(q); err != nil {
+		t.Fatal(err)
+	}
+	if m.Header.ID != id || m.Header.RecursionDesired {
+		t.Errorf("header = %+v", m.Header)
+	}
+	if q[3]&flagCD == 0 {
+		t.Errorf("CD bit not set")
+	}
+	if len(m.Additionals) != 1 || m.Additionals[0].Header.Type != dnsmessage.TypeOPT {
+		t.Fatalf("additionals = %+v, want one OPT record", m.Additionals)
+	}
+	if !m.Additionals[0].Header.DNSSECAllowed() {
+		t.Errorf("DO bit not set")
+	}
+	if got := m.Questions[0].Name.String(); got != "example.com." {
+		t.Errorf("question name = %q", got)
+	}
+}
+
+func TestParseArgs(t *testing.T) {
+	dir, err := ioutil.TempDir("", "dig")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	resolvConf = filepath.Join(dir, "resolv.conf")
+	if err := ioutil.WriteFile(resolvConf, []byte("# comment\nsearch example.com\nnameserver 192.0.2.53\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		args   []string
+		server string
+		name   string
+		typ    dnsmessage.Type
+		err    bool
+	}{
+		{args: []string{"example.com"}, server: "192.0.2.53", name: "example.com", typ: dnsmessage.TypeA},
+		{args: []string{"@10.0.0.1", "example.com", "mx", "+short"}, server: "10.0.0.1", name: "example.com", typ: dnsmessage.TypeMX},
+		{args: []string{"example.com", "TYPE46"}, server: "192.0.2.53", name: "example.com", typ: 46},
+		{args: nil, server: "192.0.2.53", name: ".", typ: dnsmessage.TypeNS},
+		{args: []string{"example.com", "bogus"}, err: true},
+		{args: []string{"example.com", "+frob"}, err: true},
+		{args: []string{"example.com", "+time=x"}, err: true},
+	} {
+		c, err := parseArgs(tt.args)
+		if (err != nil) != tt.err {
+			t.Errorf("parseArgs(%q) err = %v, want error %v", tt.args, err, tt.err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if c.server != tt.server || c.name != tt.name || c.typ != tt.typ {
+			t.Errorf("parseArgs(%q) = server %q name %q type %v, want %q %q %v", tt.args, c.server, c.name, c.typ, tt.server, tt.name, tt.typ)
+		}
+	}
+}
+
+func TestReverseName(t *testing.T) {
+	for in, want := range map[string]string{
+		"192.0.2.1":   "1.2.0.192.in-addr.arpa.",
+		"2001:db8::1": "1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa.",
+	} {
+		got, err := reverseName(in)
+		if err != nil || got != want {
+			t.Errorf("reverseName(%q) = %q, %v, want %q", in, got, err, want)
+		}
+	}
+	if _, err := reverseName("nope"); err == nil {
+		t.Errorf("reverseName(nope) succeeded")
+	}
+}
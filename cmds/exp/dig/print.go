@@ -0,0 +1,227 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// typeNames maps record type names to types, including the DNSSEC types
+// dnsmessage does not define.
+var typeNames = map[string]dnsmessage.Type{
+	"A":      dnsmessage.TypeA,
+	"NS":     dnsmessage.TypeNS,
+	"CNAME":  dnsmessage.TypeCNAME,
+	"SOA":    dnsmessage.TypeSOA,
+	"PTR":    dnsmessage.TypePTR,
+	"MX":     dnsmessage.TypeMX,
+	"TXT":    dnsmessage.TypeTXT,
+	"AAAA":   dnsmessage.TypeAAAA,
+	"SRV":    dnsmessage.TypeSRV,
+	"OPT":    dnsmessage.TypeOPT,
+	"HINFO":  dnsmessage.TypeHINFO,
+	"DS":     43,
+	"RRSIG":  46,
+	"NSEC":   47,
+	"DNSKEY": 48,
+	"NSEC3":  50,
+	"TLSA":   52,
+	"CAA":    257,
+	"ANY":    dnsmessage.TypeALL,
+}
+
+// parseType accepts a type name or the TYPEnnn form of RFC 3597.
+func parseType(s string) (dnsmessage.Type, bool) {
+	s = strings.ToUpper(s)
+	if t, ok := typeNames[s]; ok {
+		return t, true
+	}
+	if strings.HasPrefix(s, "TYPE") {
+		if n, err := strconv.ParseUint(s[4:], 10, 16); err == nil {
+			return dnsmessage.Type(n), true
+		}
+	}
+	return 0, false
+}
+
+func typeString(t dnsmessage.Type) string {
+	for n, tt := range typeNames {
+		if tt == t {
+			return n
+		}
+	}
+	return fmt.Sprintf("TYPE%d", t)
+}
+
+func classString(c dnsmessage.Class) string {
+	switch c {
+	case dnsmessage.ClassINET:
+		return "IN"
+	case dnsmessage.ClassCHAOS:
+		return "CH"
+	case dnsmessage.ClassHESIOD:
+		return "HS"
+	}
+	return fmt.Sprintf("CLASS%d", c)
+}
+
+func rcodeString(r dnsmessage.RCode) string {
+	switch r {
+	case dnsmessage.RCodeSuccess:
+		return "NOERROR"
+	case dnsmessage.RCodeFormatError:
+		return "FORMERR"
+	case dnsmessage.RCodeServerFailure:
+		return "SERVFAIL"
+	case dnsmessage.RCodeNameError:
+		return "NXDOMAIN"
+	case dnsmessage.RCodeNotImplemented:
+		return "NOTIMP"
+	case dnsmessage.RCodeRefused:
+		return "REFUSED"
+	}
+	return fmt.Sprintf("RCODE%d", r)
+}
+
+func opcodeString(o dnsmessage.OpCode) string {
+	switch o {
+	case 0:
+		return "QUERY"
+	case 1:
+		return "IQUERY"
+	case 2:
+		return "STATUS"
+	case 4:
+		return "NOTIFY"
+	case 5:
+		return "UPDATE"
+	}
+	return fmt.Sprintf("OPCODE%d", o)
+}
+
+// rdata formats the data of a record in zone file syntax.
+func rdata(r record) string {
+	switch b := r.body.(type) {
+	case *dnsmessage.AResource:
+		return net.IP(b.A[:]).String()
+	case *dnsmessage.AAAAResource:
+		return net.IP(b.AAAA[:]).String()
+	case *dnsmessage.CNAMEResource:
+		return b.CNAME.String()
+	case *dnsmessage.NSResource:
+		return b.NS.String()
+	case *dnsmessage.PTRResource:
+		return b.PTR.String()
+	case *dnsmessage.MXResource:
+		return fmt.Sprintf("%d %s", b.Pref, b.MX)
+	case *dnsmessage.SRVResource:
+		return fmt.Sprintf("%d %d %d %s", b.Priority, b.Weight, b.Port, b.Target)
+	case *dnsmessage.SOAResource:
+		return fmt.Sprintf("%s %s %d %d %d %d %d", b.NS, b.MBox, b.Serial, b.Refresh, b.Retry, b.Expire, b.MinTTL)
+	case *dnsmessage.TXTResource:
+		q := make([]string, len(b.TXT))
+		for i, t := range b.TXT {
+			q[i] = strconv.Quote(t)
+		}
+		return strings.Join(q, " ")
+	case nil:
+		// dnsmessage cannot decode this type; show its length as in
+		// RFC 3597's generic encoding.
+		return fmt.Sprintf("\\# %d", r.hdr.Length)
+	}
+	return fmt.Sprintf("%v", r.body)
+}
+
+func printRecords(w io.Writer, title string, rs []record) {
+	var shown []record
+	for _, r := range rs {
+		if r.hdr.Type != dnsmessage.TypeOPT {
+			shown = append(shown, r)
+		}
+	}
+	if len(shown) == 0 {
+		return
+	}
+	fmt.Fprintf(w, "\n;; %s SECTION:\n", title)
+	for _, r := range shown {
+		fmt.Fprintf(w, "%s\t\t%d\t%s\t%s\t%s\n", r.hdr.Name, r.hdr.TTL, classString(r.hdr.Class), typeString(r.hdr.Type), rdata(r))
+	}
+}
+
+func flagString(r *response) string {
+	var f []string
+	h := r.hdr
+	for _, fl := range []struct {
+		set  bool
+		name string
+	}{
+		{h.Response, "qr"},
+		{h.Authoritative, "aa"},
+		{h.Truncated, "tc"},
+		{h.RecursionDesired, "rd"},
+		{h.RecursionAvailable, "ra"},
+		{r.ad, "ad"},
+		{r.cd, "cd"},
+	} {
+		if fl.set {
+			f = append(f, fl.name)
+		}
+	}
+	return strings.Join(f, " ")
+}
+
+// printShort prints only the data of the answer section, one record per
+// line, as dig +short does.
+func printShort(w io.Writer, r *response) {
+	for _, rr := range r.answers {
+		fmt.Fprintln(w, rdata(rr))
+	}
+}
+
+// printFull prints a response in dig's default format.
+func printFull(w io.Writer, r *response, server, port string) {
+	rcode := r.hdr.RCode
+	for _, rr := range r.additional {
+		if rr.hdr.Type == dnsmessage.TypeOPT {
+			rcode = rr.hdr.ExtendedRCode(rcode)
+		}
+	}
+	fmt.Fprintf(w, ";; ->>HEADER<<- opcode: %s, status: %s, id: %d\n", opcodeString(r.hdr.OpCode), rcodeString(rcode), r.hdr.ID)
+	fmt.Fprintf(w, ";; flags: %s; QUERY: %d, ANSWER: %d, AUTHORITY: %d, ADDITIONAL: %d\n",
+		flagString(r), len(r.questions), len(r.answers), len(r.authority), len(r.additional))
+
+	for _, rr := range r.additional {
+		if rr.hdr.Type != dnsmessage.TypeOPT {
+			continue
+		}
+		flags := ""
+		if rr.hdr.DNSSECAllowed() {
+			flags = " do"
+		}
+		fmt.Fprintf(w, "\n;; OPT PSEUDOSECTION:\n; EDNS: version: %d, flags:%s; udp: %d\n",
+			(rr.hdr.TTL>>16)&0xff, flags, uint16(rr.hdr.Class))
+	}
+
+	fmt.Fprintf(w, "\n;; QUESTION SECTION:\n")
+	for _, q := range r.questions {
+		fmt.Fprintf(w, ";%s\t\t\t%s\t%s\n", q.Name, classString(q.Class), typeString(q.Type))
+	}
+	printRecords(w, "ANSWER", r.answers)
+	printRecords(w, "AUTHORITY", r.authority)
+	printRecords(w, "ADDITIONAL", r.additional)
+
+	fmt.Fprintf(w, "\n;; Query time: %d msec\n", r.rtt.Milliseconds())
+	fmt.Fprintf(w, ";; SERVER: %s#%s(%s)\n", server, port, r.proto)
+	if r.fellBack {
+		fmt.Fprintf(w, ";; Truncated over UDP, retried over TCP\n")
+	}
+	fmt.Fprintf(w, ";; MSG SIZE  rcvd: %d\n", r.size)
+}
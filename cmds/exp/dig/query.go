@@ -0,0 +1,331 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// ednsUDPSize is the UDP payload size advertised with EDNS(0), as
+// recommended by DNS flag day 2020.
+const ednsUDPSize = 1232
+
+// Header flag bits which dnsmessage.Header does not expose, in the second
+// flags byte of the wire format.
+const (
+	flagAD = 0x20
+	flagCD = 0x10
+)
+
+// queryOpts controls how a query is built and sent.
+type queryOpts struct {
+	tcp       bool
+	recurse   bool
+	dnssec    bool
+	edns      bool
+	adflag    bool
+	cdflag    bool
+	timeout   time.Duration
+	tries     int
+	ednsSize  uint16
+	forceIPv4 bool
+	forceIPv6 bool
+}
+
+// record is a resource record. body is nil for types dnsmessage cannot
+// decode, such as the DNSSEC types.
+type record struct {
+	hdr  dnsmessage.ResourceHeader
+	body dnsmessage.ResourceBody
+}
+
+// response is a parsed reply together with the details dig reports about
+// the exchange.
+type response struct {
+	hdr        dnsmessage.Header
+	questions  []dnsmessage.Question
+	answers    []record
+	authority  []record
+	additional []record
+
+	ad, cd   bool
+	size     int
+	proto    string
+	rtt      time.Duration
+	fellBack bool
+}
+
+// buildQuery packs a query for name and type t.
+func buildQuery(name string, t dnsmessage.Type, o queryOpts) ([]byte, uint16, error) {
+	if !strings.HasSuffix(name, ".") {
+		name += "."
+	}
+	n, err := dnsmessage.NewName(name)
+	if err != nil {
+		return nil, 0, err
+	}
+	id := uint16(rand.Uint32())
+	b := dnsmessage.NewBuilder(nil, dnsmessage.Header{ID: id, RecursionDesired: o.recurse})
+	b.EnableCompression()
+	if err := b.StartQuestions(); err != nil {
+		return nil, 0, err
+	}
+	if err := b.Question(dnsmessage.Question{Name: n, Type: t, Class: dnsmessage.ClassINET}); err != nil {
+		return nil, 0, err
+	}
+	if o.edns || o.dnssec {
+		if err := b.StartAdditionals(); err != nil {
+			return nil, 0, err
+		}
+		var rh dnsmessage.ResourceHeader
+		size := o.ednsSize
+		if size == 0 {
+			size = ednsUDPSize
+		}
+		if err := rh.SetEDNS0(int(size), dnsmessage.RCodeSuccess, o.dnssec); err != nil {
+			return nil, 0, err
+		}
+		if err := b.OPTResource(rh, dnsmessage.OPTResource{}); err != nil {
+			return nil, 0, err
+		}
+	}
+	msg, err := b.Finish()
+	if err != nil {
+		return nil, 0, err
+	}
+	if o.adflag {
+		msg[3] |= flagAD
+	}
+	if o.cdflag {
+		msg[3] |= flagCD
+	}
+	return msg, id, nil
+}
+
+// exchange sends query to server, retrying over TCP if the UDP response
+// was truncated.
+func exchange(server string, query []byte, id uint16, o queryOpts) (*response, error) {
+	tries := o.tries
+	if tries < 1 {
+		tries = 1
+	}
+	var (
+		r   *response
+		err error
+	)
+	for i := 0; i < tries; i++ {
+		if o.tcp {
+			r, err = exchangeTCP(server, query, id, o)
+		} else {
+			r, err = exchangeUDP(server, query, id, o)
+			if err == nil && r.hdr.Truncated {
+				r, err = exchangeTCP(server, query, id, o)
+				if err == nil {
+					r.fellBack = true
+				}
+			}
+		}
+		var nerr net.Error
+		if err == nil || !(errors.As(err, &nerr) && nerr.Timeout()) {
+			break
+		}
+	}
+	return r, err
+}
+
+func network(base string, o queryOpts) string {
+	switch {
+	case o.forceIPv4:
+		return base + "4"
+	case o.forceIPv6:
+		return base + "6"
+	}
+	return base
+}
+
+func exchangeUDP(server string, query []byte, id uint16, o queryOpts) (*response, error) {
+	c, err := net.DialTimeout(network("udp", o), server, o.timeout)
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+	start := time.Now()
+	if err := c.SetDeadline(start.Add(o.timeout)); err != nil {
+		return nil, err
+	}
+	if _, err := c.Write(query); err != nil {
+		return nil, err
+	}
+	buf := make([]byte, 65535)
+	for {
+		n, err := c.Read(buf)
+		if err != nil {
+			return nil, err
+		}
+		r, err := parseResponse(buf[:n], id)
+		if err == errWrongID {
+			// Stray or spoofed reply; keep waiting.
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		r.proto, r.rtt = "UDP", time.Since(start)
+		return r, nil
+	}
+}
+
+func exchangeTCP(server string, query []byte, id uint16, o queryOpts) (*response, error) {
+	start := time.Now()
+	c, err := net.DialTimeout(network("tcp", o), server, o.timeout)
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+	if err := c.SetDeadline(start.Add(o.timeout)); err != nil {
+		return nil, err
+	}
+	b := make([]byte, 2+len(query))
+	binary.BigEndian.PutUint16(b, uint16(len(query)))
+	copy(b[2:], query)
+	if _, err := c.Write(b); err != nil {
+		return nil, err
+	}
+	var l [2]byte
+	if _, err := io.ReadFull(c, l[:]); err != nil {
+		return nil, err
+	}
+	buf := make([]byte, binary.BigEndian.Uint16(l[:]))
+	if _, err := io.ReadFull(c, buf); err != nil {
+		return nil, err
+	}
+	r, err := parseResponse(buf, id)
+	if err != nil {
+		return nil, err
+	}
+	r.proto, r.rtt = "TCP", time.Since(start)
+	return r, nil
+}
+
+var errWrongID = errors.New("response ID does not match query")
+
+func parseResponse(b []byte, id uint16) (*response, error) {
+	r := &response{size: len(b)}
+	var p dnsmessage.Parser
+	var err error
+	if r.hdr, err = p.Start(b); err != nil {
+		return nil, fmt.Errorf("malformed response: %v", err)
+	}
+	if r.hdr.ID != id {
+		return nil, errWrongID
+	}
+	if !r.hdr.Response {
+		return nil, fmt.Errorf("reply is not a response")
+	}
+	if r.questions, err = p.AllQuestions(); err != nil {
+		return nil, fmt.Errorf("malformed question section: %v", err)
+	}
+	if r.answers, err = parseSection(p.AnswerHeader, p.Answer, p.SkipAnswer); err != nil {
+		return nil, fmt.Errorf("malformed answer section: %v", err)
+	}
+	if r.authority, err = parseSection(p.AuthorityHeader, p.Authority, p.SkipAuthority); err != nil {
+		return nil, fmt.Errorf("malformed authority section: %v", err)
+	}
+	if r.additional, err = parseSection(p.AdditionalHeader, p.Additional, p.SkipAdditional); err != nil {
+		return nil, fmt.Errorf("malformed additional section: %v", err)
+	}
+	r.ad = b[3]&flagAD != 0
+	r.cd = b[3]&flagCD != 0
+	return r, nil
+}
+
+// knownTypes are the types dnsmessage can unpack.
+var knownTypes = map[dnsmessage.Type]bool{
+	dnsmessage.TypeA:     true,
+	dnsmessage.TypeNS:    true,
+	dnsmessage.TypeCNAME: true,
+	dnsmessage.TypeSOA:   true,
+	dnsmessage.TypePTR:   true,
+	dnsmessage.TypeMX:    true,
+	dnsmessage.TypeTXT:   true,
+	dnsmessage.TypeAAAA:  true,
+	dnsmessage.TypeSRV:   true,
+	dnsmessage.TypeOPT:   true,
+}
+
+func parseSection(header func() (dnsmessage.ResourceHeader, error), resource func() (dnsmessage.Resource, error), skip func() error) ([]record, error) {
+	var rs []record
+	for {
+		h, err := header()
+		if err == dnsmessage.ErrSectionDone {
+			return rs, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !knownTypes[h.Type] {
+			if err := skip(); err != nil {
+				return nil, err
+			}
+			rs = append(rs, record{hdr: h})
+			continue
+		}
+		res, err := resource()
+		if err != nil {
+			return nil, err
+		}
+		rs = append(rs, record{hdr: res.Header, body: res.Body})
+	}
+}
+
+// defaultServer returns the first nameserver from resolv.conf, or the
+// loopback address if there is none.
+func defaultServer(resolvConf string) string {
+	f, err := os.Open(resolvConf)
+	if err != nil {
+		return "127.0.0.1"
+	}
+	defer f.Close()
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		fields := strings.Fields(s.Text())
+		if len(fields) >= 2 && fields[0] == "nameserver" {
+			return fields[1]
+		}
+	}
+	return "127.0.0.1"
+}
+
+// reverseName returns the in-addr.arpa or ip6.arpa name for addr.
+func reverseName(addr string) (string, error) {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return "", fmt.Errorf("invalid address %q", addr)
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		return fmt.Sprintf("%d.%d.%d.%d.in-addr.arpa.", ip4[3], ip4[2], ip4[1], ip4[0]), nil
+	}
+	const hex = "0123456789abcdef"
+	var sb strings.Builder
+	for i := len(ip) - 1; i >= 0; i-- {
+		sb.WriteByte(hex[ip[i]&0xf])
+		sb.WriteByte('.')
+		sb.WriteByte(hex[ip[i]>>4])
+		sb.WriteByte('.')
+	}
+	sb.WriteString("ip6.arpa.")
+	return sb.String(), nil
+}
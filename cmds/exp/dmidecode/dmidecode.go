@@ -7,7 +7,9 @@ package main
 import (
 	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -19,6 +21,8 @@ import (
 var (
 	flagDumpBin  = flag.String("dump-bin", "", `Do not decode the entries, instead dump the DMI data to a file in binary form. The generated file is suitable to pass to --from-dump later.`)
 	flagFromDump = flag.String("from-dump", "", `Read the DMI data from a binary file previously generated using --dump-bin.`)
+	flagJSON     = flag.BoolP("json", "j", false, `Print the decoded entries as JSON. Each entry has its handle, type and name, and its fields as dmidecode(8) would print them.`)
+	flagString   = flag.StringP("string", "s", "", `Only display the value of the DMI string identified by KEYWORD. KEYWORD must be a keyword from the following list: `+strings.Join(stringKeywordNames(), ", ")+`. Each keyword corresponds to a given DMI type and a given offset within this entry type. If KEYWORD is not provided or not valid, a list of all valid keywords is printed and dmidecode exits with an error.`)
 	flagType     = flag.StringSliceP("type", "t", nil, `Only  display  the  entries of type TYPE. TYPE can be either a DMI type number, or a comma-separated list of type numbers, or a keyword from the following list: bios, system, baseboard, chassis, processor, memory, cache, connector, slot. If this option is used more than once, the set of displayed entries will be the union of all the given types. If TYPE is not provided or not valid, a list of all valid keywords is printed and dmidecode exits with an error.`)
 	// NB: When adding flags, update resetFlags in dmidecode_test.
 )
@@ -37,6 +41,15 @@ var (
 	}
 )
 
+func typeGroupNames() []string {
+	var names []string
+	for k := range typeGroups {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	return names
+}
+
 type dmiDecodeError struct {
 	error
 	code int
@@ -100,10 +113,23 @@ func dumpBin(textOut io.Writer, entryData, tableData []byte, fileName string) *d
 func dmiDecode(textOut io.Writer) *dmiDecodeError {
 	typeFilter, err := parseTypeFilter(*flagType)
 	if err != nil {
-		return &dmiDecodeError{code: 2, error: fmt.Errorf("invalid --type: %v", err)}
+		return &dmiDecodeError{code: 2, error: fmt.Errorf("invalid --type: %v, valid keywords are:\n  %s", err, strings.Join(typeGroupNames(), "\n  "))}
+	}
+	var keyword *stringKeyword
+	if *flagString != "" {
+		k, ok := stringKeywords[*flagString]
+		if !ok {
+			return &dmiDecodeError{code: 2, error: fmt.Errorf("invalid --string %q, valid keywords are:\n  %s", *flagString, strings.Join(stringKeywordNames(), "\n  "))}
+		}
+		keyword = &k
+	}
+	// Only the requested values go to textOut with --string or --json.
+	progress := textOut
+	if keyword != nil || *flagJSON {
+		progress = ioutil.Discard
 	}
-	fmt.Fprintf(textOut, "# dmidecode-go\n") // TODO: version.
-	entryData, tableData, err := getData(textOut, *flagFromDump, "/sys/firmware/dmi/tables")
+	fmt.Fprintf(progress, "# dmidecode-go\n") // TODO: version.
+	entryData, tableData, err := getData(progress, *flagFromDump, "/sys/firmware/dmi/tables")
 	if err != nil {
 		return &dmiDecodeError{code: 1, error: fmt.Errorf("error parsing loading data: %v", err)}
 	}
@@ -114,6 +140,22 @@ func dmiDecode(textOut io.Writer) *dmiDecodeError {
 	if err != nil {
 		return &dmiDecodeError{code: 1, error: fmt.Errorf("error parsing data: %v", err)}
 	}
+	if keyword != nil {
+		vals, err := keyword.get(si)
+		if err != nil {
+			return &dmiDecodeError{code: 1, error: fmt.Errorf("%s: %v", *flagString, err)}
+		}
+		for _, v := range vals {
+			fmt.Fprintln(textOut, v)
+		}
+		return nil
+	}
+	if *flagJSON {
+		if err := printJSON(textOut, si, typeFilter); err != nil {
+			return &dmiDecodeError{code: 1, error: err}
+		}
+		return nil
+	}
 	if si.Entry64 != nil {
 		fmt.Fprintf(textOut, "SMBIOS %d.%d.%d present.\n", si.MajorVersion(), si.MinorVersion(), si.DocRev())
 	} else {
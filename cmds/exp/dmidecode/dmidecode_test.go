@@ -7,6 +7,7 @@ package main
 import (
 	"bytes"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -24,6 +25,8 @@ const (
 
 func resetFlags() {
 	*flagFromDump = ""
+	*flagJSON = false
+	*flagString = ""
 	*flagType = nil
 }
 
@@ -67,6 +70,67 @@ func TestDMIDecode(t *testing.T) {
 func TestDMIDecodeTypeFilters(t *testing.T) {
 	testOutput(t, "testdata/Asus-UX307LA.bin", []string{"-t", "system"}, "testdata/Asus-UX307LA.system.txt")
 	testOutput(t, "testdata/Asus-UX307LA.bin", []string{"-t", "1,131"}, "testdata/Asus-UX307LA.1_131.txt")
+	testOutput(t, "testdata/Gigabyte-GA-MA74GMT-S2.bin", []string{"-t", "memory"}, "testdata/Gigabyte-GA-MA74GMT-S2.memory.txt")
+}
+
+func TestDMIDecodeString(t *testing.T) {
+	for _, tt := range []struct {
+		keyword string
+		want    string
+	}{
+		{"system-product-name", "UX305LA\n"},
+		{"bios-vendor", "American Megatrends Inc.\n"},
+		{"chassis-type", "Notebook\n"},
+	} {
+		os.Args = []string{os.Args[0], "--from-dump", "testdata/Asus-UX307LA.bin", "-s", tt.keyword}
+		flag.Parse()
+		out := &bytes.Buffer{}
+		if err := dmiDecode(out); err != nil {
+			t.Errorf("-s %s: %v", tt.keyword, err)
+		} else if out.String() != tt.want {
+			t.Errorf("-s %s: got %q, want %q", tt.keyword, out.String(), tt.want)
+		}
+		resetFlags()
+	}
+
+	os.Args = []string{os.Args[0], "--from-dump", "testdata/Asus-UX307LA.bin", "-s", "bogus"}
+	flag.Parse()
+	defer resetFlags()
+	if err := dmiDecode(&bytes.Buffer{}); err == nil || err.code != 2 {
+		t.Errorf("-s bogus: got %v, want an error with code 2", err)
+	}
+}
+
+func TestDMIDecodeJSON(t *testing.T) {
+	os.Args = []string{os.Args[0], "--from-dump", "testdata/Lenovo-ThinkPad-T480.bin", "--json", "-t", "baseboard"}
+	flag.Parse()
+	defer resetFlags()
+	out := &bytes.Buffer{}
+	if err := dmiDecode(out); err != nil {
+		t.Fatal(err)
+	}
+	var got jsonOutput
+	if err := json.Unmarshal(out.Bytes(), &got); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, out)
+	}
+	if len(got.Tables) == 0 {
+		t.Fatalf("no tables in output:\n%s", out)
+	}
+	bb := got.Tables[0]
+	if bb.Type != 2 || bb.Name != "Base Board Information" {
+		t.Errorf("first table is type %d %q, want the base board", bb.Type, bb.Name)
+	}
+	if v := bb.Fields["Manufacturer"]; v != "LENOVO" {
+		t.Errorf("Manufacturer = %v, want LENOVO", v)
+	}
+	if v, ok := bb.Fields["Features"].([]interface{}); !ok || len(v) != 2 {
+		t.Errorf("Features = %v, want a list of two", bb.Fields["Features"])
+	}
+	for _, tb := range got.Tables {
+		if tb.Type != 2 && tb.Type != 10 && tb.Type != 41 {
+			t.Errorf("table of type %d does not match -t baseboard", tb.Type)
+		}
+	}
 }
 
 func testDumpBin(t *testing.T, entryData, expectedOutData []byte) {
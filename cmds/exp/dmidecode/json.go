@@ -0,0 +1,97 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/u-root/u-root/pkg/smbios"
+)
+
+// jsonOutput is the document printed by --json.
+type jsonOutput struct {
+	Version string      `json:"smbios_version"`
+	Tables  []jsonTable `json:"tables"`
+}
+
+// jsonTable is one decoded structure. Fields holds the values dmidecode(8)
+// prints for it: a string for "Key: Value" lines and a list of strings
+// for keys followed by indented lines.
+type jsonTable struct {
+	Handle uint16                 `json:"handle"`
+	Type   uint8                  `json:"type"`
+	Length uint8                  `json:"length"`
+	Name   string                 `json:"name"`
+	Fields map[string]interface{} `json:"fields,omitempty"`
+}
+
+// tableFields splits the text form of a table into its name and fields.
+// The first line is the header, the second the name of the type and the
+// rest fields, indented by one tab; list items are indented by two.
+func tableFields(s string) (string, map[string]interface{}) {
+	lines := strings.Split(s, "\n")
+	if len(lines) < 2 {
+		return "", nil
+	}
+	fields := map[string]interface{}{}
+	var key string
+	for _, l := range lines[2:] {
+		if strings.HasPrefix(l, "\t\t") {
+			if key == "" {
+				continue
+			}
+			list, _ := fields[key].([]string)
+			fields[key] = append(list, strings.TrimLeft(l, "\t"))
+			continue
+		}
+		l = strings.TrimPrefix(l, "\t")
+		i := strings.Index(l, ":")
+		if i < 0 {
+			key = ""
+			continue
+		}
+		key = l[:i]
+		if v := strings.TrimSpace(l[i+1:]); v != "" {
+			fields[key] = v
+		} else {
+			fields[key] = []string{}
+		}
+	}
+	if len(fields) == 0 {
+		fields = nil
+	}
+	return lines[1], fields
+}
+
+func printJSON(w io.Writer, si *smbios.Info, typeFilter map[smbios.TableType]bool) error {
+	out := jsonOutput{Version: fmt.Sprintf("%d.%d", si.MajorVersion(), si.MinorVersion()), Tables: []jsonTable{}}
+	for _, t := range si.Tables {
+		if len(typeFilter) != 0 && !typeFilter[t.Type] {
+			continue
+		}
+		pt, err := smbios.ParseTypedTable(t)
+		if err != nil {
+			if err != smbios.ErrUnsupportedTableType {
+				fmt.Fprintf(os.Stderr, "%s\n", err)
+			}
+			pt = t
+		}
+		name, fields := tableFields(pt.String())
+		out.Tables = append(out.Tables, jsonTable{
+			Handle: t.Handle,
+			Type:   uint8(t.Type),
+			Length: t.Length,
+			Name:   name,
+			Fields: fields,
+		})
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
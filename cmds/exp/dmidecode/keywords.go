@@ -0,0 +1,125 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/u-root/u-root/pkg/smbios"
+)
+
+// stringKeyword is a value that --string can print. Keywords of types
+// that can occur more than once print a value for every table.
+type stringKeyword struct {
+	get func(si *smbios.Info) ([]string, error)
+}
+
+func bios(f func(*smbios.BIOSInfo) string) stringKeyword {
+	return stringKeyword{func(si *smbios.Info) ([]string, error) {
+		bi, err := si.GetBIOSInfo()
+		if err != nil {
+			return nil, err
+		}
+		return []string{f(bi)}, nil
+	}}
+}
+
+func system(f func(*smbios.SystemInfo) string) stringKeyword {
+	return stringKeyword{func(si *smbios.Info) ([]string, error) {
+		s, err := si.GetSystemInfo()
+		if err != nil {
+			return nil, err
+		}
+		return []string{f(s)}, nil
+	}}
+}
+
+func baseboard(f func(*smbios.BaseboardInfo) string) stringKeyword {
+	return stringKeyword{func(si *smbios.Info) ([]string, error) {
+		bs, err := si.GetBaseboardInfo()
+		if err != nil {
+			return nil, err
+		}
+		var res []string
+		for _, b := range bs {
+			res = append(res, f(b))
+		}
+		return res, nil
+	}}
+}
+
+func chassis(f func(*smbios.ChassisInfo) string) stringKeyword {
+	return stringKeyword{func(si *smbios.Info) ([]string, error) {
+		cs, err := si.GetChassisInfo()
+		if err != nil {
+			return nil, err
+		}
+		var res []string
+		for _, c := range cs {
+			res = append(res, f(c))
+		}
+		return res, nil
+	}}
+}
+
+func processor(f func(*smbios.ProcessorInfo) string) stringKeyword {
+	return stringKeyword{func(si *smbios.Info) ([]string, error) {
+		ps, err := si.GetProcessorInfo()
+		if err != nil {
+			return nil, err
+		}
+		var res []string
+		for _, p := range ps {
+			res = append(res, f(p))
+		}
+		return res, nil
+	}}
+}
+
+// stringKeywords are the keywords of dmidecode(8).
+var stringKeywords = map[string]stringKeyword{
+	"bios-vendor":       bios(func(bi *smbios.BIOSInfo) string { return bi.Vendor }),
+	"bios-version":      bios(func(bi *smbios.BIOSInfo) string { return bi.Version }),
+	"bios-release-date": bios(func(bi *smbios.BIOSInfo) string { return bi.ReleaseDate }),
+	"bios-revision": bios(func(bi *smbios.BIOSInfo) string {
+		return fmt.Sprintf("%d.%d", bi.SystemBIOSMajorRelease, bi.SystemBIOSMinorRelease)
+	}),
+	"system-manufacturer":     system(func(s *smbios.SystemInfo) string { return s.Manufacturer }),
+	"system-product-name":     system(func(s *smbios.SystemInfo) string { return s.ProductName }),
+	"system-version":          system(func(s *smbios.SystemInfo) string { return s.Version }),
+	"system-serial-number":    system(func(s *smbios.SystemInfo) string { return s.SerialNumber }),
+	"system-uuid":             system(func(s *smbios.SystemInfo) string { return s.UUID.String() }),
+	"system-sku-number":       system(func(s *smbios.SystemInfo) string { return s.SKUNumber }),
+	"system-family":           system(func(s *smbios.SystemInfo) string { return s.Family }),
+	"baseboard-manufacturer":  baseboard(func(b *smbios.BaseboardInfo) string { return b.Manufacturer }),
+	"baseboard-product-name":  baseboard(func(b *smbios.BaseboardInfo) string { return b.Product }),
+	"baseboard-version":       baseboard(func(b *smbios.BaseboardInfo) string { return b.Version }),
+	"baseboard-serial-number": baseboard(func(b *smbios.BaseboardInfo) string { return b.SerialNumber }),
+	"baseboard-asset-tag":     baseboard(func(b *smbios.BaseboardInfo) string { return b.AssetTag }),
+	"chassis-manufacturer":    chassis(func(c *smbios.ChassisInfo) string { return c.Manufacturer }),
+	"chassis-type":            chassis(func(c *smbios.ChassisInfo) string { return c.Type.String() }),
+	"chassis-version":         chassis(func(c *smbios.ChassisInfo) string { return c.Version }),
+	"chassis-serial-number":   chassis(func(c *smbios.ChassisInfo) string { return c.SerialNumber }),
+	"chassis-asset-tag":       chassis(func(c *smbios.ChassisInfo) string { return c.AssetTagNumber }),
+	"processor-family":        processor(func(p *smbios.ProcessorInfo) string { return p.GetFamily().String() }),
+	"processor-manufacturer":  processor(func(p *smbios.ProcessorInfo) string { return p.Manufacturer }),
+	"processor-version":       processor(func(p *smbios.ProcessorInfo) string { return p.Version }),
+	"processor-frequency": processor(func(p *smbios.ProcessorInfo) string {
+		if p.CurrentSpeed == 0 {
+			return "Unknown"
+		}
+		return fmt.Sprintf("%d MHz", p.CurrentSpeed)
+	}),
+}
+
+func stringKeywordNames() []string {
+	var names []string
+	for k := range stringKeywords {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,303 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// efibootmgr lists and changes the boot entries of the UEFI boot manager.
+//
+// Synopsis:
+//     efibootmgr [-v]
+//     efibootmgr -c -d DISK [-p PART] -l LOADER -L LABEL [-u ARGS] [-b XXXX]
+//     efibootmgr -b XXXX (-B | -a | -A)
+//     efibootmgr -o XXXX,YYYY,...
+//     efibootmgr -n XXXX | -N
+//     efibootmgr -t SECONDS | -T
+//
+// Description:
+//     Without options, efibootmgr prints BootCurrent, BootNext, Timeout,
+//     BootOrder and the boot entries. Active entries are marked with a *.
+//
+//     -c creates a boot entry for LOADER, e.g. \EFI\Linux\linux.efi, on
+//     partition PART of the GPT disk DISK, and puts it first in BootOrder.
+//     ARGS are passed to the loader, as UTF-16, which Linux' EFI stub and
+//     shells expect.
+//
+//     The variables are changed through efivarfs, which must be mounted on
+//     /sys/firmware/efi/efivars. Most of them are immutable; efibootmgr
+//     clears the flag to change them.
+//
+// Options:
+//     -v: also print the device paths and arguments of the entries
+//     -c: create a boot entry
+//     -d: disk of the loader (default: /dev/sda)
+//     -p: partition of the loader (default: 1)
+//     -l: loader path on the partition
+//     -L: label of the entry
+//     -u: arguments of the loader
+//     -b: number of the entry to create or change, in hex (default: the
+//         lowest free one for -c)
+//     -B: delete the entry, and remove it from BootOrder and BootNext
+//     -a: mark the entry active
+//     -A: mark the entry inactive
+//     -o: set BootOrder
+//     -n: set BootNext, the entry to boot next time only
+//     -N: delete BootNext
+//     -t: set the boot manager timeout
+//     -T: delete the timeout
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/u-root/u-root/pkg/efivarfs"
+)
+
+type options struct {
+	verbose               bool
+	create                bool
+	disk                  string
+	part                  uint
+	loader, label, args   string
+	bootNum               string
+	del, active, inactive bool
+	order, next           string
+	delNext               bool
+	timeout               int
+	delTimeout            bool
+}
+
+func parseFlags(args []string) (*options, error) {
+	o := &options{}
+	fs := flag.NewFlagSet("efibootmgr", flag.ContinueOnError)
+	fs.BoolVar(&o.verbose, "v", false, "print device paths and arguments")
+	fs.BoolVar(&o.create, "c", false, "create a boot entry")
+	fs.StringVar(&o.disk, "d", "/dev/sda", "disk of the loader")
+	fs.UintVar(&o.part, "p", 1, "partition of the loader")
+	fs.StringVar(&o.loader, "l", "", "loader path on the partition")
+	fs.StringVar(&o.label, "L", "", "label of the entry")
+	fs.StringVar(&o.args, "u", "", "arguments of the loader")
+	fs.StringVar(&o.bootNum, "b", "", "number of the entry, in hex")
+	fs.BoolVar(&o.del, "B", false, "delete the entry")
+	fs.BoolVar(&o.active, "a", false, "mark the entry active")
+	fs.BoolVar(&o.inactive, "A", false, "mark the entry inactive")
+	fs.StringVar(&o.order, "o", "", "comma-separated BootOrder, in hex")
+	fs.StringVar(&o.next, "n", "", "BootNext, in hex")
+	fs.BoolVar(&o.delNext, "N", false, "delete BootNext")
+	fs.IntVar(&o.timeout, "t", -1, "boot manager timeout in seconds")
+	fs.BoolVar(&o.delTimeout, "T", false, "delete the timeout")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if fs.NArg() != 0 {
+		return nil, fmt.Errorf("unexpected arguments %q", fs.Args())
+	}
+	return o, nil
+}
+
+func parseNum(s string) (uint16, error) {
+	n, err := strconv.ParseUint(strings.TrimPrefix(strings.ToUpper(s), "BOOT"), 16, 16)
+	if err != nil {
+		return 0, fmt.Errorf("invalid boot number %q", s)
+	}
+	return uint16(n), nil
+}
+
+func parseOrder(s string) ([]uint16, error) {
+	var order []uint16
+	for _, f := range strings.Split(s, ",") {
+		n, err := parseNum(f)
+		if err != nil {
+			return nil, err
+		}
+		order = append(order, n)
+	}
+	return order, nil
+}
+
+func join(n []uint16) string {
+	var s []string
+	for _, v := range n {
+		s = append(s, fmt.Sprintf("%04X", v))
+	}
+	return strings.Join(s, ",")
+}
+
+func list(w io.Writer, verbose bool) error {
+	if n, err := efivarfs.ReadUint16s(efivarfs.BootCurrent); err == nil && len(n) == 1 {
+		fmt.Fprintf(w, "BootCurrent: %04X\n", n[0])
+	}
+	if n, err := efivarfs.ReadUint16s(efivarfs.BootNext); err == nil && len(n) == 1 {
+		fmt.Fprintf(w, "BootNext: %04X\n", n[0])
+	}
+	if n, err := efivarfs.ReadUint16s(efivarfs.Timeout); err == nil && len(n) == 1 {
+		fmt.Fprintf(w, "Timeout: %d seconds\n", n[0])
+	}
+	order, err := efivarfs.ReadBootOrder()
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "BootOrder: %s\n", join(order))
+	entries, err := efivarfs.BootEntries()
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		active := " "
+		if e.Active() {
+			active = "*"
+		}
+		s := e.Description
+		if verbose {
+			s = e.LoadOption.String()
+		}
+		fmt.Fprintf(w, "Boot%04X%s %s\n", e.Number, active, s)
+	}
+	return nil
+}
+
+// sysBlock is where the block devices are in sysfs.
+var sysBlock = "/sys/class/block"
+
+// partition returns the device of partition part of disk, e.g. /dev/sda1
+// or /dev/nvme0n1p1.
+func partition(disk string, part uint) (string, error) {
+	dir := filepath.Join(sysBlock, filepath.Base(disk))
+	fis, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return "", fmt.Errorf("%s: not a disk: %v", disk, err)
+	}
+	for _, fi := range fis {
+		b, err := ioutil.ReadFile(filepath.Join(dir, fi.Name(), "partition"))
+		if err == nil && strings.TrimSpace(string(b)) == strconv.FormatUint(uint64(part), 10) {
+			return filepath.Join(filepath.Dir(disk), fi.Name()), nil
+		}
+	}
+	return "", fmt.Errorf("%s: no partition %d", disk, part)
+}
+
+func create(o *options) (uint16, error) {
+	if o.loader == "" || o.label == "" {
+		return 0, fmt.Errorf("-c needs -l and -L")
+	}
+	var (
+		n   uint16
+		err error
+	)
+	if o.bootNum != "" {
+		n, err = parseNum(o.bootNum)
+	} else {
+		n, err = efivarfs.FreeBootNumber()
+	}
+	if err != nil {
+		return 0, err
+	}
+	dev, err := partition(o.disk, o.part)
+	if err != nil {
+		return 0, err
+	}
+	p, err := efivarfs.PartitionDevicePath(dev, o.loader)
+	if err != nil {
+		return 0, err
+	}
+	lo := &efivarfs.LoadOption{
+		Attributes:  efivarfs.LoadOptionActive,
+		Description: o.label,
+		FilePath:    p,
+	}
+	if o.args != "" {
+		// Without the NUL, as efibootmgr does.
+		u := efivarfs.EncodeUTF16(o.args)
+		lo.OptionalData = u[:len(u)-2]
+	}
+	if err := efivarfs.WriteBootEntry(n, lo); err != nil {
+		return 0, err
+	}
+	order, err := efivarfs.ReadBootOrder()
+	if err != nil {
+		return 0, err
+	}
+	return n, efivarfs.WriteBootOrder(append([]uint16{n}, order...))
+}
+
+func setActive(n uint16, active bool) error {
+	e, err := efivarfs.ReadBootEntry(n)
+	if err != nil {
+		return err
+	}
+	if active {
+		e.Attributes |= efivarfs.LoadOptionActive
+	} else {
+		e.Attributes &^= efivarfs.LoadOptionActive
+	}
+	return efivarfs.WriteBootEntry(n, e.LoadOption)
+}
+
+func run(w io.Writer, o *options) error {
+	var err error
+	switch {
+	case o.create:
+		var n uint16
+		if n, err = create(o); err == nil {
+			fmt.Fprintf(w, "Created Boot%04X\n", n)
+		}
+	case o.del || o.active || o.inactive:
+		if o.bootNum == "" {
+			return fmt.Errorf("-B, -a and -A need -b")
+		}
+		var n uint16
+		if n, err = parseNum(o.bootNum); err != nil {
+			return err
+		}
+		switch {
+		case o.del:
+			err = efivarfs.DeleteBootEntry(n)
+		default:
+			err = setActive(n, o.active)
+		}
+	case o.order != "":
+		var order []uint16
+		if order, err = parseOrder(o.order); err == nil {
+			err = efivarfs.WriteBootOrder(order)
+		}
+	case o.next != "":
+		var n uint16
+		if n, err = parseNum(o.next); err == nil {
+			err = efivarfs.WriteUint16s(efivarfs.BootNext, []uint16{n})
+		}
+	case o.delNext:
+		if err = efivarfs.Delete(efivarfs.BootNext); errors.Is(err, efivarfs.ErrNotFound) {
+			err = nil
+		}
+	case o.timeout >= 0:
+		if o.timeout > 0xffff {
+			return fmt.Errorf("timeout %d is too large", o.timeout)
+		}
+		err = efivarfs.WriteUint16s(efivarfs.Timeout, []uint16{uint16(o.timeout)})
+	case o.delTimeout:
+		if err = efivarfs.Delete(efivarfs.Timeout); errors.Is(err, efivarfs.ErrNotFound) {
+			err = nil
+		}
+	}
+	if err != nil {
+		return err
+	}
+	return list(w, o.verbose)
+}
+
+func main() {
+	o, err := parseFlags(os.Args[1:])
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := run(os.Stdout, o); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/u-root/u-root/pkg/efivarfs"
+)
+
+func TestEfibootmgr(t *testing.T) {
+	dir, err := ioutil.TempDir("", "efibootmgr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	efivarfs.Path = dir
+
+	for i, label := range []string{"Linux", "Shell"} {
+		lo := &efivarfs.LoadOption{
+			Attributes:  efivarfs.LoadOptionActive,
+			Description: label,
+			FilePath:    efivarfs.DevicePath{efivarfs.FilePathNode(`\` + label + ".efi")},
+		}
+		if err := efivarfs.WriteBootEntry(uint16(i), lo); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, tt := range []struct {
+		args []string
+		want string
+	}{
+		{
+			args: []string{"-o", "1,0"},
+			want: "BootOrder: 0001,0000\nBoot0000* Linux\nBoot0001* Shell\n",
+		},
+		{
+			args: []string{"-n", "Boot0001"},
+			want: "BootNext: 0001\nBootOrder: 0001,0000\nBoot0000* Linux\nBoot0001* Shell\n",
+		},
+		{
+			args: []string{"-b", "0", "-A", "-v"},
+			want: "BootNext: 0001\nBootOrder: 0001,0000\nBoot0000  Linux\tFile(\\Linux.efi)\nBoot0001* Shell\tFile(\\Shell.efi)\n",
+		},
+		{
+			args: []string{"-t", "5"},
+			want: "BootNext: 0001\nTimeout: 5 seconds\nBootOrder: 0001,0000\nBoot0000  Linux\nBoot0001* Shell\n",
+		},
+		{
+			args: []string{"-b", "1", "-B"},
+			want: "Timeout: 5 seconds\nBootOrder: 0000\nBoot0000  Linux\n",
+		},
+		{
+			args: []string{"-T"},
+			want: "BootOrder: 0000\nBoot0000  Linux\n",
+		},
+	} {
+		o, err := parseFlags(tt.args)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var out bytes.Buffer
+		if err := run(&out, o); err != nil {
+			t.Fatalf("efibootmgr %q: %v", tt.args, err)
+		}
+		if out.String() != tt.want {
+			t.Errorf("efibootmgr %q = %q, want %q", tt.args, out.String(), tt.want)
+		}
+	}
+
+	for _, args := range [][]string{{"-B"}, {"-o", "1,X"}, {"-c", "-l", `\a.efi`}, {"extra"}} {
+		o, err := parseFlags(args)
+		if err == nil {
+			err = run(ioutil.Discard, o)
+		}
+		if err == nil {
+			t.Errorf("efibootmgr %q succeeded", args)
+		}
+	}
+}
+
+func TestPartition(t *testing.T) {
+	dir, err := ioutil.TempDir("", "efibootmgr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	sysBlock = dir
+	for _, p := range []string{"nvme0n1p1", "nvme0n1p2"} {
+		if err := os.MkdirAll(filepath.Join(dir, "nvme0n1", p), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(dir, "nvme0n1", p, "partition"), []byte(p[len(p)-1:]+"\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got, err := partition("/dev/nvme0n1", 2); err != nil || got != "/dev/nvme0n1p2" {
+		t.Errorf("partition(/dev/nvme0n1, 2) = %q, %v, want /dev/nvme0n1p2", got, err)
+	}
+	if _, err := partition("/dev/nvme0n1", 3); err == nil {
+		t.Errorf("partition(/dev/nvme0n1, 3) succeeded")
+	}
+}
@@ -0,0 +1,246 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// efivar lists, prints and changes UEFI variables.
+//
+// Synopsis:
+//     efivar -l
+//     efivar -p -n NAME
+//     efivar -w -n NAME (-f FILE | -x HEX) [-A ATTRS] [-a]
+//     efivar -D -n NAME
+//
+// Description:
+//     NAME is Name-GUID or GUID-Name. A name without a GUID is one of the
+//     global variables, such as BootOrder.
+//
+//     -p decodes boot entries, BootOrder and other well known variables.
+//
+//     Most variables are immutable in efivarfs, since deleting some of them
+//     bricks some machines. -w and -D clear the flag, so use them with care.
+//
+// Options:
+//     -l: list variables and their attributes
+//     -p: print a variable
+//     -w: write a variable
+//     -D: delete a variable
+//     -n: the variable
+//     -f: file with the data to write, - for stdin
+//     -x: data to write, in hex
+//     -A: attributes, such as NV,BS,RT (default: those of the existing
+//         variable, or NV,BS,RT)
+//     -a: append to the variable
+//
+// Example:
+//     efivar -w -n BootOrder -x 01000000
+package main
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/u-root/u-root/pkg/efivarfs"
+	"github.com/u-root/u-root/pkg/uefivars"
+	"github.com/u-root/u-root/pkg/uefivars/boot"
+)
+
+var (
+	list   = flag.Bool("l", false, "list variables")
+	show   = flag.Bool("p", false, "print a variable")
+	write  = flag.Bool("w", false, "write a variable")
+	del    = flag.Bool("D", false, "delete a variable")
+	name   = flag.String("n", "", "variable name")
+	file   = flag.String("f", "", "file with the data to write, - for stdin")
+	hexVal = flag.String("x", "", "data to write, in hex")
+	attrs  = flag.String("A", "", "attributes, such as NV,BS,RT")
+	appnd  = flag.Bool("a", false, "append to the variable")
+)
+
+func listVars(w io.Writer) error {
+	vars, err := efivarfs.List()
+	if err != nil {
+		return err
+	}
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	for _, v := range vars {
+		a, _, err := efivarfs.Read(v)
+		if err != nil {
+			// Some variables cannot be read by design.
+			fmt.Fprintf(tw, "%s-%s\t?\n", v.GUID, v.Name)
+			continue
+		}
+		fmt.Fprintf(tw, "%s-%s\t%v\n", v.GUID, v.Name, a)
+	}
+	return tw.Flush()
+}
+
+func u16s(d []byte) []uint16 {
+	n := make([]uint16, len(d)/2)
+	for i := range n {
+		n[i] = binary.LittleEndian.Uint16(d[2*i:])
+	}
+	return n
+}
+
+// validLoadOption checks the lengths in an EFI_LOAD_OPTION, which
+// boot.BootVar trusts.
+func validLoadOption(d []byte) bool {
+	if len(d) < 8 {
+		return false
+	}
+	fpl := int(binary.LittleEndian.Uint16(d[4:]))
+	for i := 6; i+1 < len(d); i += 2 {
+		if d[i] == 0 && d[i+1] == 0 {
+			return i+2+fpl <= len(d)
+		}
+	}
+	return false
+}
+
+// decode returns a readable form of well known variables, or "".
+func decode(v efivarfs.Var, d []byte) string {
+	if v.GUID != efivarfs.GlobalGUID {
+		return ""
+	}
+	switch {
+	case v.Name == "BootOrder" || v.Name == "DriverOrder":
+		var s []string
+		for _, n := range u16s(d) {
+			s = append(s, fmt.Sprintf("%04X", n))
+		}
+		return strings.Join(s, ",")
+	case v.Name == "BootNext" || v.Name == "BootCurrent":
+		if len(d) == 2 {
+			return fmt.Sprintf("Boot%04X", u16s(d)[0])
+		}
+	case v.Name == "Timeout":
+		if len(d) == 2 {
+			return fmt.Sprintf("%d seconds", u16s(d)[0])
+		}
+	case boot.IsBootEntry(uefivars.EfiVar{UUID: boot.BootUUID, Name: v.Name}):
+		if validLoadOption(d) {
+			return boot.BootVar(uefivars.EfiVar{UUID: v.GUID, Name: v.Name, Data: d}).String()
+		}
+	}
+	// Language codes and the like are ASCII strings.
+	s := strings.TrimRight(string(d), "\x00")
+	if s == "" {
+		return ""
+	}
+	for _, c := range s {
+		if c < ' ' || c > '~' {
+			return ""
+		}
+	}
+	return fmt.Sprintf("%q", s)
+}
+
+func printVar(w io.Writer, v efivarfs.Var) error {
+	a, d, err := efivarfs.Read(v)
+	if err != nil {
+		return err
+	}
+	guid := v.GUID
+	if n, ok := efivarfs.KnownGUIDs[guid]; ok {
+		guid += " (" + n + ")"
+	}
+	fmt.Fprintf(w, "GUID: %s\nName: %q\nAttributes:\n", guid, v.Name)
+	for _, n := range a.Names() {
+		fmt.Fprintf(w, "\t%s\n", n)
+	}
+	if imm, err := efivarfs.IsImmutable(v); err == nil && imm {
+		fmt.Fprintf(w, "Immutable: yes\n")
+	}
+	fmt.Fprintf(w, "Value:\n%s", hex.Dump(d))
+	if s := decode(v, d); s != "" {
+		fmt.Fprintf(w, "Decoded: %s\n", s)
+	}
+	return nil
+}
+
+func writeVar(v efivarfs.Var, stdin io.Reader) error {
+	var (
+		data []byte
+		err  error
+	)
+	switch {
+	case *file != "" && *hexVal != "":
+		return fmt.Errorf("only one of -f and -x may be given")
+	case *file == "-":
+		data, err = ioutil.ReadAll(stdin)
+	case *file != "":
+		data, err = ioutil.ReadFile(*file)
+	case *hexVal != "":
+		data, err = hex.DecodeString(strings.Join(strings.Fields(*hexVal), ""))
+	default:
+		return fmt.Errorf("-w needs -f or -x")
+	}
+	if err != nil {
+		return err
+	}
+
+	var a efivarfs.Attributes
+	if *attrs != "" {
+		if a, err = efivarfs.ParseAttributes(*attrs); err != nil {
+			return err
+		}
+	} else if a, _, err = efivarfs.Read(v); errors.Is(err, efivarfs.ErrNotFound) {
+		a = efivarfs.DefaultAttributes
+	} else if err != nil {
+		return err
+	}
+	if *appnd {
+		a |= efivarfs.AttributeAppendWrite
+	}
+	return efivarfs.Write(v, a, data)
+}
+
+func run(w io.Writer, stdin io.Reader) error {
+	n := 0
+	for _, b := range []bool{*list, *show, *write, *del} {
+		if b {
+			n++
+		}
+	}
+	if n != 1 {
+		return fmt.Errorf("exactly one of -l, -p, -w and -D must be given")
+	}
+	if *list {
+		return listVars(w)
+	}
+	if *name == "" {
+		return fmt.Errorf("-n is required")
+	}
+	v, err := efivarfs.ParseVar(*name)
+	if err != nil {
+		return err
+	}
+	switch {
+	case *show:
+		return printVar(w, v)
+	case *write:
+		return writeVar(v, stdin)
+	default:
+		return efivarfs.Delete(v)
+	}
+}
+
+func main() {
+	flag.Parse()
+	if flag.NArg() != 0 {
+		flag.Usage()
+		os.Exit(1)
+	}
+	if err := run(os.Stdout, os.Stdin); err != nil {
+		log.Fatal(err)
+	}
+}
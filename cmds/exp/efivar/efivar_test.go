@@ -0,0 +1,93 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/u-root/u-root/pkg/efivarfs"
+)
+
+func setFlags(l, p, w, d bool, n, x string) {
+	*list, *show, *write, *del = l, p, w, d
+	*name, *hexVal, *file, *attrs, *appnd = n, x, "", "", false
+}
+
+func TestEfivar(t *testing.T) {
+	dir, err := ioutil.TempDir("", "efivar")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	efivarfs.Path = dir
+	lang := filepath.Join(dir, "PlatformLang-"+efivarfs.GlobalGUID)
+	if err := ioutil.WriteFile(lang, []byte("\x07\x00\x00\x00en-US\x00"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	setFlags(false, false, true, false, "BootOrder", "0100 0000")
+	if err := run(nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	setFlags(true, false, false, false, "", "")
+	if err := run(&out, nil); err != nil {
+		t.Fatal(err)
+	}
+	want := efivarfs.GlobalGUID + "-BootOrder     NV,BS,RT\n" +
+		efivarfs.GlobalGUID + "-PlatformLang  NV,BS,RT\n"
+	if out.String() != want {
+		t.Errorf("list = %q, want %q", out.String(), want)
+	}
+
+	for n, want := range map[string]string{
+		"BootOrder":    "Decoded: 0001,0000\n",
+		"PlatformLang": "Decoded: \"en-US\"\n",
+	} {
+		out.Reset()
+		setFlags(false, true, false, false, n, "")
+		if err := run(&out, nil); err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(out.String(), "(EFI Global Variable)") || !strings.HasSuffix(out.String(), want) {
+			t.Errorf("print %s = %q, want suffix %q", n, out.String(), want)
+		}
+	}
+
+	setFlags(false, false, false, true, "BootOrder-"+efivarfs.GlobalGUID, "")
+	if err := run(nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "BootOrder-"+efivarfs.GlobalGUID)); !os.IsNotExist(err) {
+		t.Errorf("BootOrder still exists after -D: %v", err)
+	}
+
+	setFlags(true, true, false, false, "", "")
+	if err := run(nil, nil); err == nil {
+		t.Errorf("-l -p succeeded")
+	}
+}
+
+func TestValidLoadOption(t *testing.T) {
+	for _, tt := range []struct {
+		d    []byte
+		want bool
+	}{
+		{[]byte{1, 0, 0, 0, 4, 0, 'a', 0, 0, 0, 0x7f, 0xff, 4, 0}, true},
+		{[]byte{1, 0, 0, 0, 8, 0, 'a', 0, 0, 0, 0x7f, 0xff, 4, 0}, false},
+		{[]byte{1, 0, 0, 0, 0, 0, 'a', 0}, false},
+		{nil, false},
+	} {
+		if got := validLoadOption(tt.d); got != tt.want {
+			t.Errorf("validLoadOption(%x) = %v, want %v", tt.d, got, tt.want)
+		}
+	}
+}
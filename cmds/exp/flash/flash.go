@@ -0,0 +1,244 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// flash reads, writes and erases flash chips.
+//
+// Synopsis:
+//     flash [-p PROGRAMMER] [-l LAYOUT [-i REGION]...] (-r FILE | -w FILE | -v FILE | -E | -wp | -L)
+//
+// Description:
+//     flash is a small flashrom. It works on whole chip images; with a
+//     layout only the named regions are read back, written or verified,
+//     the rest of the image is ignored. Writes skip blocks which already
+//     hold the right data and are verified.
+//
+//     PROGRAMMER selects the chip:
+//       mtd[:DEV]             a Linux MTD device (default: the first one).
+//                             This covers the Intel PCH SPI controller when
+//                             the intel-spi driver is loaded.
+//       spidev:DEV[,speed=HZ] a SPI NOR chip behind a spidev device
+//
+// Options:
+//     -p:  programmer (default: mtd)
+//     -r:  read the chip to FILE
+//     -w:  write FILE to the chip
+//     -v:  verify the chip against FILE
+//     -E:  erase the chip, or the regions
+//     -wp: print the write protection status
+//     -L:  list the MTD devices
+//     -l:  flashrom layout file, with lines like "00000000:00000fff RO_VPD"
+//     -i:  only work on this region of the layout; may be repeated
+//
+// Example:
+//     flash -p spidev:/dev/spidev0.0,speed=10000000 -l layout.txt -i RW_VPD -w image.bin
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/u-root/u-root/pkg/flash"
+)
+
+// multiFlag collects the values of a repeated flag.
+type multiFlag []string
+
+func (m *multiFlag) String() string {
+	return strings.Join(*m, ",")
+}
+
+func (m *multiFlag) Set(s string) error {
+	*m = append(*m, s)
+	return nil
+}
+
+var (
+	programmer = flag.String("p", "mtd", "programmer: mtd[:DEV] or spidev:DEV[,speed=HZ]")
+	readFile   = flag.String("r", "", "read the chip to this file")
+	writeFile  = flag.String("w", "", "write this file to the chip")
+	verifyFile = flag.String("v", "", "verify the chip against this file")
+	erase      = flag.Bool("E", false, "erase the chip")
+	wpStatus   = flag.Bool("wp", false, "print the write protection status")
+	listMTD    = flag.Bool("L", false, "list the MTD devices")
+	layoutFile = flag.String("l", "", "flashrom layout file")
+	regions    multiFlag
+)
+
+func init() {
+	flag.Var(&regions, "i", "region of the layout to work on; may be repeated")
+}
+
+func open(p string) (flash.Chip, error) {
+	name, arg := p, ""
+	if i := strings.Index(p, ":"); i >= 0 {
+		name, arg = p[:i], p[i+1:]
+	}
+	switch name {
+	case "mtd":
+		if arg == "" {
+			devs, err := flash.MTDDevices()
+			if err != nil {
+				return nil, err
+			}
+			var names []string
+			for d := range devs {
+				names = append(names, d)
+			}
+			if len(names) == 0 {
+				return nil, fmt.Errorf("no MTD devices")
+			}
+			sort.Strings(names)
+			arg = names[0]
+		}
+		return flash.OpenMTD(arg)
+	case "spidev":
+		opts := strings.Split(arg, ",")
+		var speed uint64
+		for _, o := range opts[1:] {
+			if !strings.HasPrefix(o, "speed=") {
+				return nil, fmt.Errorf("unknown spidev option %q", o)
+			}
+			var err error
+			if speed, err = strconv.ParseUint(strings.TrimPrefix(o, "speed="), 0, 32); err != nil {
+				return nil, fmt.Errorf("bad speed %q", o)
+			}
+		}
+		if opts[0] == "" {
+			return nil, fmt.Errorf("spidev needs a device, e.g. spidev:/dev/spidev0.0")
+		}
+		t, err := flash.OpenSpidev(opts[0], uint32(speed))
+		if err != nil {
+			return nil, err
+		}
+		n, err := flash.NewNOR(t)
+		if err != nil {
+			t.Close()
+			return nil, err
+		}
+		return n, nil
+	}
+	return nil, fmt.Errorf("unknown programmer %q, want mtd or spidev", name)
+}
+
+func selectRegions() ([]flash.Region, error) {
+	if *layoutFile == "" {
+		if len(regions) != 0 {
+			return nil, fmt.Errorf("-i needs a layout file")
+		}
+		return nil, nil
+	}
+	f, err := os.Open(*layoutFile)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	l, err := flash.ParseLayout(f)
+	if err != nil {
+		return nil, err
+	}
+	if len(regions) == 0 {
+		return l, nil
+	}
+	return flash.Select(l, regions...)
+}
+
+func run(w io.Writer, c flash.Chip, rs []flash.Region) error {
+	switch {
+	case *wpStatus:
+		wp, desc, err := c.WriteProtected()
+		if err != nil {
+			return err
+		}
+		state := "disabled"
+		if wp {
+			state = "enabled"
+		}
+		fmt.Fprintf(w, "write protection %s: %s\n", state, desc)
+	case *readFile != "":
+		b, err := flash.Read(c)
+		if err != nil {
+			return err
+		}
+		// Like flashrom, regions not asked for read as erased.
+		if len(rs) != 0 {
+			img := make([]byte, len(b))
+			for i := range img {
+				img[i] = 0xff
+			}
+			for _, r := range rs {
+				copy(img[r.Start:r.End+1], b[r.Start:r.End+1])
+			}
+			b = img
+		}
+		if err := ioutil.WriteFile(*readFile, b, 0644); err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "read %d bytes to %s\n", len(b), *readFile)
+	case *writeFile != "":
+		img, err := ioutil.ReadFile(*writeFile)
+		if err != nil {
+			return err
+		}
+		st, err := flash.Write(c, img, rs...)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "%d blocks compared, %d erased, %d bytes written, verified\n", st.Blocks, st.Erased, st.Written)
+	case *verifyFile != "":
+		img, err := ioutil.ReadFile(*verifyFile)
+		if err != nil {
+			return err
+		}
+		if err := flash.Verify(c, img, rs...); err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "verified\n")
+	case *erase:
+		if err := flash.Erase(c, rs...); err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "erased\n")
+	default:
+		return fmt.Errorf("nothing to do, use one of -r, -w, -v, -E or -wp")
+	}
+	return nil
+}
+
+func main() {
+	flag.Parse()
+	if *listMTD {
+		devs, err := flash.MTDDevices()
+		if err != nil {
+			log.Fatal(err)
+		}
+		var names []string
+		for d := range devs {
+			names = append(names, d)
+		}
+		sort.Strings(names)
+		for _, d := range names {
+			fmt.Printf("%s\t%s\n", d, devs[d])
+		}
+		return
+	}
+	rs, err := selectRegions()
+	if err != nil {
+		log.Fatal(err)
+	}
+	c, err := open(*programmer)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer c.Close()
+	if err := run(os.Stdout, c, rs); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/u-root/u-root/pkg/uefivars"
+)
+
+// fmpCapsuleGUID is EFI_FIRMWARE_MANAGEMENT_CAPSULE_ID_GUID. Capsules with
+// this GUID carry payloads for Firmware Management Protocol instances, and
+// the ESRT classes are those of the payloads.
+const fmpCapsuleGUID = "6dcbd5ed-e82d-4c44-bda1-7194199ad92a"
+
+// Capsule flags.
+const (
+	flagPersistAcrossReset  = 0x10000
+	flagPopulateSystemTable = 0x20000
+	flagInitiateReset       = 0x40000
+)
+
+// capsule is a parsed EFI_CAPSULE_HEADER, with the image types of the
+// payloads of FMP capsules.
+type capsule struct {
+	GUID       string
+	HeaderSize uint32
+	Flags      uint32
+	ImageSize  uint32
+	// Classes are the ESRT classes this capsule updates.
+	Classes []string
+}
+
+func guid(b []byte) string {
+	var m uefivars.MixedGUID
+	copy(m[:], b)
+	return m.String()
+}
+
+func parseCapsule(b []byte) (*capsule, error) {
+	// typedef struct {
+	//   EFI_GUID CapsuleGuid;
+	//   UINT32   HeaderSize;
+	//   UINT32   Flags;
+	//   UINT32   CapsuleImageSize;
+	// } EFI_CAPSULE_HEADER;
+	if len(b) < 28 {
+		return nil, fmt.Errorf("capsule is only %d bytes", len(b))
+	}
+	le := binary.LittleEndian
+	c := &capsule{
+		GUID:       guid(b[:16]),
+		HeaderSize: le.Uint32(b[16:]),
+		Flags:      le.Uint32(b[20:]),
+		ImageSize:  le.Uint32(b[24:]),
+	}
+	if int64(c.ImageSize) != int64(len(b)) {
+		return nil, fmt.Errorf("capsule header says %d bytes, but it is %d; not a capsule, or truncated", c.ImageSize, len(b))
+	}
+	if c.HeaderSize < 28 || c.HeaderSize > c.ImageSize {
+		return nil, fmt.Errorf("invalid capsule header size %d", c.HeaderSize)
+	}
+	if c.GUID != fmpCapsuleGUID {
+		c.Classes = []string{c.GUID}
+		return c, nil
+	}
+
+	// typedef struct {
+	//   UINT32 Version;
+	//   UINT16 EmbeddedDriverCount;
+	//   UINT16 PayloadItemCount;
+	//   UINT64 ItemOffsetList[];
+	// } EFI_FIRMWARE_MANAGEMENT_CAPSULE_HEADER;
+	fmp := b[c.HeaderSize:]
+	if len(fmp) < 8 {
+		return nil, fmt.Errorf("FMP capsule header truncated")
+	}
+	drivers, payloads := int(le.Uint16(fmp[4:])), int(le.Uint16(fmp[6:]))
+	if len(fmp) < 8+8*(drivers+payloads) {
+		return nil, fmt.Errorf("FMP capsule item list truncated")
+	}
+	for i := drivers; i < drivers+payloads; i++ {
+		off := le.Uint64(fmp[8+8*i:])
+		// typedef struct {
+		//   UINT32   Version;
+		//   EFI_GUID UpdateImageTypeId;
+		//   ...
+		// } EFI_FIRMWARE_MANAGEMENT_CAPSULE_IMAGE_HEADER;
+		if off+20 > uint64(len(fmp)) {
+			return nil, fmt.Errorf("FMP payload %d at offset %#x is out of bounds", i-drivers, off)
+		}
+		c.Classes = append(c.Classes, guid(fmp[off+4:off+20]))
+	}
+	return c, nil
+}
+
+func (c *capsule) flagNames() string {
+	var s string
+	for _, f := range []struct {
+		bit  uint32
+		name string
+	}{
+		{flagPersistAcrossReset, " persist-across-reset"},
+		{flagPopulateSystemTable, " populate-system-table"},
+		{flagInitiateReset, " initiate-reset"},
+	} {
+		if c.Flags&f.bit != 0 {
+			s += f.name
+		}
+	}
+	return fmt.Sprintf("%#x%s", c.Flags, s)
+}
@@ -0,0 +1,111 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// esrtPath is where the kernel exports the EFI System Resource Table. It is
+// a variable so tests can override it.
+var esrtPath = "/sys/firmware/efi/esrt/entries"
+
+var fwTypes = []string{"unknown", "system firmware", "device firmware", "UEFI driver"}
+
+// Values of last_attempt_status, from the UEFI specification.
+var attemptStatus = []string{
+	"success",
+	"unsuccessful",
+	"insufficient resources",
+	"incorrect version",
+	"invalid image format",
+	"authentication error",
+	"AC power not connected",
+	"insufficient battery",
+	"unsatisfied dependencies",
+}
+
+// esrtEntry is one firmware resource the firmware can update.
+type esrtEntry struct {
+	Name               string
+	Class              string
+	Type               uint32
+	Version            uint32
+	LowestVersion      uint32
+	CapsuleFlags       uint32
+	LastAttemptVersion uint32
+	LastAttemptStatus  uint32
+}
+
+func (e *esrtEntry) typeName() string {
+	if int(e.Type) < len(fwTypes) {
+		return fwTypes[e.Type]
+	}
+	return fmt.Sprintf("type %d", e.Type)
+}
+
+func (e *esrtEntry) statusName() string {
+	s := e.LastAttemptStatus
+	switch {
+	case int(s) < len(attemptStatus):
+		return attemptStatus[s]
+	case s >= 0x1000 && s <= 0x4000:
+		return fmt.Sprintf("vendor error %#x", s)
+	}
+	return fmt.Sprintf("status %d", s)
+}
+
+func readESRT() ([]*esrtEntry, error) {
+	dirs, err := filepath.Glob(filepath.Join(esrtPath, "entry*"))
+	if err != nil {
+		return nil, err
+	}
+	if len(dirs) == 0 {
+		return nil, fmt.Errorf("no ESRT entries in %s; is the firmware UEFI with ESRT support?", esrtPath)
+	}
+	var entries []*esrtEntry
+	for _, d := range dirs {
+		e := &esrtEntry{Name: filepath.Base(d)}
+		b, err := ioutil.ReadFile(filepath.Join(d, "fw_class"))
+		if err != nil {
+			return nil, err
+		}
+		e.Class = strings.ToLower(strings.TrimSpace(string(b)))
+		for _, f := range []struct {
+			name string
+			v    *uint32
+		}{
+			{"fw_type", &e.Type},
+			{"fw_version", &e.Version},
+			{"lowest_supported_fw_version", &e.LowestVersion},
+			{"capsule_flags", &e.CapsuleFlags},
+			{"last_attempt_version", &e.LastAttemptVersion},
+			{"last_attempt_status", &e.LastAttemptStatus},
+		} {
+			b, err := ioutil.ReadFile(filepath.Join(d, f.name))
+			if err != nil {
+				return nil, err
+			}
+			// capsule_flags is in hex, the rest in decimal.
+			n, err := strconv.ParseUint(strings.TrimSpace(string(b)), 0, 32)
+			if err != nil {
+				return nil, fmt.Errorf("%s/%s: %v", e.Name, f.name, err)
+			}
+			*f.v = uint32(n)
+		}
+		entries = append(entries, e)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		a, _ := strconv.Atoi(strings.TrimPrefix(entries[i].Name, "entry"))
+		b, _ := strconv.Atoi(strings.TrimPrefix(entries[j].Name, "entry"))
+		return a < b
+	})
+	return entries, nil
+}
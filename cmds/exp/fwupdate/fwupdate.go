@@ -0,0 +1,250 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// fwupdate updates UEFI firmware with capsules.
+//
+// Synopsis:
+//     fwupdate list
+//         List the firmware resources in the ESRT and their versions.
+//     fwupdate status
+//         Print the result of the last update of each resource.
+//     fwupdate info FILE
+//         Print the header of a capsule.
+//     fwupdate [-force] [-esp DIR] apply FILE
+//         Submit a capsule, to be applied on the next reboot.
+//
+// Description:
+//     Capsules are submitted through the kernel's capsule loader,
+//     /dev/efi_capsule_loader (CONFIG_EFI_CAPSULE_LOADER), which passes
+//     them to the firmware's UpdateCapsule service. With -esp, the capsule
+//     is instead copied to EFI/UpdateCapsule on the mounted EFI system
+//     partition and the firmware told to look for it, for firmware which
+//     only supports capsules on disk.
+//
+//     The firmware applies the update while rebooting. fwupdate status then
+//     shows whether it succeeded.
+//
+// Options:
+//     -force: apply capsules which match no ESRT entry
+//     -esp:   mount point of the EFI system partition
+package main
+
+import (
+	"encoding/binary"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"text/tabwriter"
+
+	"github.com/u-root/u-root/pkg/efivarfs"
+)
+
+var (
+	force = flag.Bool("force", false, "apply capsules which match no ESRT entry")
+	esp   = flag.String("esp", "", "mount point of the EFI system partition, for capsules on disk")
+)
+
+// capsuleLoader is a variable so tests can override it.
+var capsuleLoader = "/dev/efi_capsule_loader"
+
+// osIndicationsFileCapsuleDelivery is
+// EFI_OS_INDICATIONS_FILE_CAPSULE_DELIVERY_SUPPORTED.
+const osIndicationsFileCapsuleDelivery = 0x4
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage: fwupdate list|status|info FILE|[-force] [-esp DIR] apply FILE\n")
+	flag.PrintDefaults()
+	os.Exit(1)
+}
+
+func list(w io.Writer) error {
+	entries, err := readESRT()
+	if err != nil {
+		return err
+	}
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(tw, "ENTRY\tCLASS\tTYPE\tVERSION\tLOWEST\tFLAGS\n")
+	for _, e := range entries {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%d\t%d\t%#x\n", e.Name, e.Class, e.typeName(), e.Version, e.LowestVersion, e.CapsuleFlags)
+	}
+	return tw.Flush()
+}
+
+func status(w io.Writer) error {
+	entries, err := readESRT()
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		switch {
+		case e.LastAttemptVersion == 0:
+			fmt.Fprintf(w, "%s %s: never updated, version %d\n", e.Name, e.Class, e.Version)
+		case e.LastAttemptStatus == 0:
+			fmt.Fprintf(w, "%s %s: update to version %d succeeded, now version %d\n", e.Name, e.Class, e.LastAttemptVersion, e.Version)
+		default:
+			fmt.Fprintf(w, "%s %s: update to version %d failed: %s, still version %d\n", e.Name, e.Class, e.LastAttemptVersion, e.statusName(), e.Version)
+		}
+	}
+	return nil
+}
+
+func info(w io.Writer, path string) error {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	c, err := parseCapsule(b)
+	if err != nil {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+	fmt.Fprintf(w, "GUID:        %s\n", c.GUID)
+	fmt.Fprintf(w, "Header size: %d\n", c.HeaderSize)
+	fmt.Fprintf(w, "Flags:       %s\n", c.flagNames())
+	fmt.Fprintf(w, "Image size:  %d\n", c.ImageSize)
+	entries, _ := readESRT()
+	for _, class := range c.Classes {
+		e := match(entries, class)
+		if e == nil {
+			fmt.Fprintf(w, "Updates:     %s (no ESRT entry)\n", class)
+			continue
+		}
+		fmt.Fprintf(w, "Updates:     %s (%s, %s, version %d)\n", class, e.Name, e.typeName(), e.Version)
+	}
+	return nil
+}
+
+func match(entries []*esrtEntry, class string) *esrtEntry {
+	for _, e := range entries {
+		if e.Class == class {
+			return e
+		}
+	}
+	return nil
+}
+
+func apply(w io.Writer, path string) error {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	c, err := parseCapsule(b)
+	if err != nil {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+	entries, err := readESRT()
+	if err != nil && !*force {
+		return err
+	}
+	for _, class := range c.Classes {
+		if match(entries, class) == nil && !*force {
+			return fmt.Errorf("capsule updates %s, which is not in the ESRT; use -force to apply it anyway", class)
+		}
+	}
+
+	if *esp != "" {
+		if err := applyOnDisk(path, b); err != nil {
+			return err
+		}
+	} else if err := submit(b); err != nil {
+		return err
+	}
+	if c.Flags&flagInitiateReset == 0 {
+		fmt.Fprintf(w, "Capsule staged. Reboot to apply the update.\n")
+	}
+	return nil
+}
+
+// submit passes a capsule to UpdateCapsule. The kernel submits it once the
+// whole capsule has been written, and reports errors from that write.
+func submit(b []byte) error {
+	f, err := os.OpenFile(capsuleLoader, os.O_WRONLY, 0)
+	if os.IsNotExist(err) {
+		return fmt.Errorf("%s does not exist; the kernel needs CONFIG_EFI_CAPSULE_LOADER, or use -esp", capsuleLoader)
+	}
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		return fmt.Errorf("firmware rejected capsule: %v", err)
+	}
+	return f.Close()
+}
+
+// applyOnDisk delivers a capsule on the EFI system partition, as described
+// in section 8.5.5 of the UEFI specification.
+func applyOnDisk(path string, b []byte) error {
+	v := efivarfs.Var{Name: "OsIndicationsSupported", GUID: efivarfs.GlobalGUID}
+	_, d, err := efivarfs.Read(v)
+	if err != nil {
+		return err
+	}
+	if len(d) < 8 || binary.LittleEndian.Uint64(d)&osIndicationsFileCapsuleDelivery == 0 {
+		return fmt.Errorf("firmware does not support capsules on disk")
+	}
+
+	dir := filepath.Join(*esp, "EFI", "UpdateCapsule")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, filepath.Base(path)), b, 0644); err != nil {
+		return err
+	}
+
+	v.Name = "OsIndications"
+	attrs, d, err := efivarfs.Read(v)
+	var ind uint64
+	switch {
+	case errors.Is(err, efivarfs.ErrNotFound):
+		attrs = efivarfs.DefaultAttributes
+	case err != nil:
+		return err
+	case len(d) >= 8:
+		ind = binary.LittleEndian.Uint64(d)
+	}
+	d = make([]byte, 8)
+	binary.LittleEndian.PutUint64(d, ind|osIndicationsFileCapsuleDelivery)
+	return efivarfs.Write(v, attrs, d)
+}
+
+func run(w io.Writer, args []string) error {
+	if len(args) == 0 {
+		usage()
+	}
+	switch args[0] {
+	case "list":
+		if len(args) != 1 {
+			usage()
+		}
+		return list(w)
+	case "status":
+		if len(args) != 1 {
+			usage()
+		}
+		return status(w)
+	case "info":
+		if len(args) != 2 {
+			usage()
+		}
+		return info(w, args[1])
+	case "apply":
+		if len(args) != 2 {
+			usage()
+		}
+		return apply(w, args[1])
+	}
+	return fmt.Errorf("unrecognized command %q", args[0])
+}
+
+func main() {
+	flag.Parse()
+	if err := run(os.Stdout, flag.Args()); err != nil {
+		log.Fatal(err)
+	}
+}
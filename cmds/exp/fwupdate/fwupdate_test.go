@@ -0,0 +1,193 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/u-root/u-root/pkg/efivarfs"
+	"github.com/u-root/u-root/pkg/uefivars"
+)
+
+const (
+	sysClass = "ddc0ee61-e7f0-4e7d-acc5-c070a398838e"
+	devClass = "0ed0ee36-a1a1-4b3a-9a9c-e1daa6f2b7e5"
+)
+
+func mixed(t *testing.T, g string) []byte {
+	b, err := hex.DecodeString(strings.Replace(g, "-", "", -1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var u uefivars.UUID
+	copy(u[:], b)
+	m := u.ToMixedGUID()
+	return m[:]
+}
+
+func u32(v uint32) []byte {
+	b := make([]byte, 4)
+	binary.LittleEndian.PutUint32(b, v)
+	return b
+}
+
+// fmpCapsule builds an FMP capsule with one payload for class.
+func fmpCapsule(t *testing.T, class string, flags uint32) []byte {
+	var b []byte
+	b = append(b, mixed(t, fmpCapsuleGUID)...)
+	b = append(b, u32(32)...)
+	b = append(b, u32(flags)...)
+	b = append(b, u32(0)...)
+	b = append(b, 0, 0, 0, 0)
+	// FMP header with no drivers and one payload, right after it.
+	b = append(b, u32(1)...)
+	b = append(b, 0, 0, 1, 0)
+	b = append(b, 16, 0, 0, 0, 0, 0, 0, 0)
+	b = append(b, u32(2)...)
+	b = append(b, mixed(t, class)...)
+	b = append(b, make([]byte, 32)...)
+	binary.LittleEndian.PutUint32(b[24:], uint32(len(b)))
+	return b
+}
+
+func setup(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "fwupdate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	esrtPath = filepath.Join(dir, "esrt")
+	for _, e := range []struct {
+		name  string
+		files map[string]string
+	}{
+		{"entry0", map[string]string{
+			"fw_class":                    strings.ToUpper(sysClass),
+			"fw_type":                     "1",
+			"fw_version":                  "66",
+			"lowest_supported_fw_version": "60",
+			"capsule_flags":               "0x8010",
+			"last_attempt_version":        "66",
+			"last_attempt_status":         "0",
+		}},
+		{"entry1", map[string]string{
+			"fw_class":                    devClass,
+			"fw_type":                     "2",
+			"fw_version":                  "3",
+			"lowest_supported_fw_version": "0",
+			"capsule_flags":               "0x0",
+			"last_attempt_version":        "4",
+			"last_attempt_status":         "5",
+		}},
+	} {
+		d := filepath.Join(esrtPath, e.name)
+		if err := os.MkdirAll(d, 0755); err != nil {
+			t.Fatal(err)
+		}
+		for n, v := range e.files {
+			if err := ioutil.WriteFile(filepath.Join(d, n), []byte(v+"\n"), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	return dir
+}
+
+func TestListStatus(t *testing.T) {
+	dir := setup(t)
+	defer os.RemoveAll(dir)
+
+	var out bytes.Buffer
+	if err := run(&out, []string{"list"}); err != nil {
+		t.Fatal(err)
+	}
+	want := "ENTRY   CLASS                                 TYPE             VERSION  LOWEST  FLAGS\n" +
+		"entry0  " + sysClass + "  system firmware  66       60      0x8010\n" +
+		"entry1  " + devClass + "  device firmware  3        0       0x0\n"
+	if out.String() != want {
+		t.Errorf("list:\n%s\nwant:\n%s", out.String(), want)
+	}
+
+	out.Reset()
+	if err := run(&out, []string{"status"}); err != nil {
+		t.Fatal(err)
+	}
+	want = "entry0 " + sysClass + ": update to version 66 succeeded, now version 66\n" +
+		"entry1 " + devClass + ": update to version 4 failed: authentication error, still version 3\n"
+	if out.String() != want {
+		t.Errorf("status:\n%s\nwant:\n%s", out.String(), want)
+	}
+}
+
+func TestApply(t *testing.T) {
+	dir := setup(t)
+	defer os.RemoveAll(dir)
+	capsuleLoader = filepath.Join(dir, "loader")
+	if err := ioutil.WriteFile(capsuleLoader, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	good := filepath.Join(dir, "good.cap")
+	capsule := fmpCapsule(t, devClass, flagPersistAcrossReset)
+	if err := ioutil.WriteFile(good, capsule, 0644); err != nil {
+		t.Fatal(err)
+	}
+	var out bytes.Buffer
+	if err := run(&out, []string{"info", good}); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), "Updates:     "+devClass+" (entry1, device firmware, version 3)") {
+		t.Errorf("info:\n%s", out.String())
+	}
+
+	out.Reset()
+	if err := run(&out, []string{"apply", good}); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := ioutil.ReadFile(capsuleLoader); !bytes.Equal(got, capsule) {
+		t.Errorf("capsule loader got %d bytes, want %d", len(got), len(capsule))
+	}
+
+	unknown := filepath.Join(dir, "unknown.cap")
+	if err := ioutil.WriteFile(unknown, fmpCapsule(t, "11111111-2222-3333-4444-555555555555", 0), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := run(&out, []string{"apply", unknown}); err == nil {
+		t.Errorf("applying capsule for unknown class succeeded")
+	}
+	empty := filepath.Join(dir, "empty.cap")
+	if err := ioutil.WriteFile(empty, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := run(&out, []string{"apply", empty}); err == nil {
+		t.Errorf("applying empty file succeeded")
+	}
+
+	// On disk.
+	efivarfs.Path = filepath.Join(dir, "efivars")
+	*esp = filepath.Join(dir, "esp")
+	defer func() { *esp = "" }()
+	os.Mkdir(efivarfs.Path, 0755)
+	sup := efivarfs.Var{Name: "OsIndicationsSupported", GUID: efivarfs.GlobalGUID}
+	if err := efivarfs.Write(sup, efivarfs.AttributeBootserviceAccess|efivarfs.AttributeRuntimeAccess, []byte{5, 0, 0, 0, 0, 0, 0, 0}); err != nil {
+		t.Fatal(err)
+	}
+	if err := run(&out, []string{"apply", good}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(*esp, "EFI/UpdateCapsule/good.cap")); err != nil {
+		t.Error(err)
+	}
+	_, d, err := efivarfs.Read(efivarfs.Var{Name: "OsIndications", GUID: efivarfs.GlobalGUID})
+	if err != nil || !bytes.Equal(d, []byte{4, 0, 0, 0, 0, 0, 0, 0}) {
+		t.Errorf("OsIndications = %x, %v", d, err)
+	}
+}
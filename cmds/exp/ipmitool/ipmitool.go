@@ -0,0 +1,357 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Synopsis:
+//     ipmitool [-d N] COMMAND [ARGS...]
+//
+// Description:
+//     ipmitool talks to the local BMC through /dev/ipmiN. It understands a
+//     subset of the commands of the ipmitool of the same name:
+//
+//     mc info                           print the BMC device ID
+//     sensor | sdr list                 print sensor readings
+//     sel info | list | clear           print or clear the system event log
+//     chassis status                    print the chassis status
+//     chassis power status|on|off|cycle|reset|soft
+//                                       control system power
+//     lan print [CHANNEL]               print the LAN configuration
+//     lan set CHANNEL ipaddr|netmask|defgw ipaddr ADDR
+//     lan set CHANNEL ipsrc static|dhcp set the LAN configuration
+//     raw NETFN CMD [DATA...]           send a raw command
+//
+// Options:
+//     -d: IPMI device number (default 0)
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"os"
+	"strconv"
+
+	"github.com/u-root/u-root/pkg/ipmi"
+)
+
+var devnum = flag.Int("d", 0, "IPMI device number")
+
+var errUsage = errors.New("usage: ipmitool [-d N] mc|sensor|sdr|sel|chassis|lan|raw ...")
+
+func main() {
+	flag.Parse()
+	if flag.NArg() == 0 {
+		log.Fatal(errUsage)
+	}
+	i, err := ipmi.Open(*devnum)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer i.Close()
+	if err := run(os.Stdout, i, flag.Args()); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func arg(args []string, n int) string {
+	if n < len(args) {
+		return args[n]
+	}
+	return ""
+}
+
+func run(w io.Writer, i *ipmi.IPMI, args []string) error {
+	switch args[0] {
+	case "mc":
+		if arg(args, 1) != "info" {
+			return errUsage
+		}
+		return mcInfo(w, i)
+	case "sensor", "sdr":
+		if a := arg(args, 1); a != "" && a != "list" {
+			return errUsage
+		}
+		return sensors(w, i)
+	case "sel":
+		switch arg(args, 1) {
+		case "", "info":
+			return selInfo(w, i)
+		case "list", "elist":
+			return selList(w, i)
+		case "clear":
+			if err := i.ClearSEL(); err != nil {
+				return err
+			}
+			fmt.Fprintln(w, "Clearing SEL.")
+			return nil
+		}
+	case "chassis":
+		switch arg(args, 1) {
+		case "status":
+			return chassisStatus(w, i)
+		case "power":
+			return power(w, i, arg(args, 2))
+		}
+	case "lan":
+		switch arg(args, 1) {
+		case "print":
+			ch, err := channel(arg(args, 2))
+			if err != nil {
+				return err
+			}
+			return lanPrint(w, i, ch)
+		case "set":
+			if len(args) < 5 {
+				return errUsage
+			}
+			ch, err := channel(args[2])
+			if err != nil {
+				return err
+			}
+			return lanSet(i, ch, args[3:])
+		}
+	case "raw":
+		return raw(w, i, args[1:])
+	}
+	return errUsage
+}
+
+func channel(s string) (byte, error) {
+	if s == "" {
+		return 1, nil
+	}
+	c, err := strconv.ParseUint(s, 0, 4)
+	if err != nil {
+		return 0, fmt.Errorf("invalid channel %q", s)
+	}
+	return byte(c), nil
+}
+
+func mcInfo(w io.Writer, i *ipmi.IPMI) error {
+	id, err := i.GetDeviceID()
+	if err != nil {
+		return err
+	}
+	mid := uint32(id.ManufacturerID[2])<<16 | uint32(id.ManufacturerID[1])<<8 | uint32(id.ManufacturerID[0])
+	pid := uint16(id.ProductID[1])<<8 | uint16(id.ProductID[0])
+	fmt.Fprintf(w, "%-26s: %d\n", "Device ID", id.DeviceID)
+	fmt.Fprintf(w, "%-26s: %d\n", "Device Revision", id.DeviceRevision&0x0f)
+	fmt.Fprintf(w, "%-26s: %d.%02x\n", "Firmware Revision", id.FwRev1&0x3f, id.FwRev2)
+	fmt.Fprintf(w, "%-26s: %x.%x\n", "IPMI Version", id.IpmiVersion&0x0f, id.IpmiVersion>>4)
+	fmt.Fprintf(w, "%-26s: %d (0x%04X)\n", "Manufacturer ID", mid, mid)
+	fmt.Fprintf(w, "%-26s: %d (0x%04X)\n", "Product ID", pid, pid)
+	return nil
+}
+
+func sensors(w io.Writer, i *ipmi.IPMI) error {
+	sdrs, err := i.GetSDRs()
+	if err != nil {
+		return err
+	}
+	for _, s := range sdrs {
+		value, unit := "na", ""
+		r, err := i.GetSensorReading(s)
+		switch {
+		case err != nil:
+			// Sensors which are not present fail the reading.
+		case r.Unavailable:
+		case s.Threshold():
+			value, unit = fmt.Sprintf("%.3f", s.Convert(r.Raw)), s.Unit()
+		default:
+			value = fmt.Sprintf("0x%04x", r.States)
+		}
+		status := "na"
+		if err == nil {
+			status = r.Status(s)
+		}
+		fmt.Fprintf(w, "%-16s | %-10s | %-10s | %s\n", s.Name, value, unit, status)
+	}
+	return nil
+}
+
+func selInfo(w io.Writer, i *ipmi.IPMI) error {
+	info, err := i.GetSELInfo()
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "Version        : %d.%d\n", info.Version&0x0f, info.Version>>4)
+	fmt.Fprintf(w, "Entries        : %d\n", info.Entries)
+	fmt.Fprintf(w, "Free Space     : %d bytes\n", info.FreeSpace)
+	fmt.Fprintf(w, "Overflow       : %t\n", info.OpSupport&0x80 != 0)
+	return nil
+}
+
+func selList(w io.Writer, i *ipmi.IPMI) error {
+	events, err := i.GetSELEntries()
+	if err != nil {
+		return err
+	}
+	for _, e := range events {
+		ts := "Pre-Init"
+		if t := e.Time(); !t.IsZero() {
+			ts = t.Format("01/02/2006 | 15:04:05")
+		}
+		if e.RecordType != 0x02 {
+			fmt.Fprintf(w, "%4x | %s | OEM record %02x\n", e.RecordID, ts, e.RecordType)
+			continue
+		}
+		dir := "Asserted"
+		if !e.Asserted() {
+			dir = "Deasserted"
+		}
+		fmt.Fprintf(w, "%4x | %s | %s #0x%02x | %s | %s\n", e.RecordID, ts,
+			ipmi.SensorType(e.SensorType), e.SensorNum, e.Description(), dir)
+	}
+	return nil
+}
+
+func chassisStatus(w io.Writer, i *ipmi.IPMI) error {
+	s, err := i.GetChassisStatus()
+	if err != nil {
+		return err
+	}
+	policy := []string{"always-off", "previous", "always-on", "unknown"}
+	fmt.Fprintf(w, "System Power         : %s\n", onOff(s.CurrentPowerState&0x01 != 0))
+	fmt.Fprintf(w, "Power Overload       : %t\n", s.CurrentPowerState&0x02 != 0)
+	fmt.Fprintf(w, "Power Interlock      : %s\n", active(s.CurrentPowerState&0x04 != 0))
+	fmt.Fprintf(w, "Main Power Fault     : %t\n", s.CurrentPowerState&0x08 != 0)
+	fmt.Fprintf(w, "Power Control Fault  : %t\n", s.CurrentPowerState&0x10 != 0)
+	fmt.Fprintf(w, "Power Restore Policy : %s\n", policy[(s.CurrentPowerState>>5)&0x03])
+	fmt.Fprintf(w, "Chassis Intrusion    : %s\n", active(s.MiscChassisState&0x01 != 0))
+	fmt.Fprintf(w, "Front-Panel Lockout  : %s\n", active(s.MiscChassisState&0x02 != 0))
+	fmt.Fprintf(w, "Drive Fault          : %t\n", s.MiscChassisState&0x04 != 0)
+	fmt.Fprintf(w, "Cooling/Fan Fault    : %t\n", s.MiscChassisState&0x08 != 0)
+	return nil
+}
+
+func onOff(b bool) string {
+	if b {
+		return "on"
+	}
+	return "off"
+}
+
+func active(b bool) string {
+	if b {
+		return "active"
+	}
+	return "inactive"
+}
+
+var powerActions = map[string]ipmi.ChassisControl{
+	"off":   ipmi.ChassisPowerDown,
+	"on":    ipmi.ChassisPowerUp,
+	"cycle": ipmi.ChassisPowerCycle,
+	"reset": ipmi.ChassisHardReset,
+	"diag":  ipmi.ChassisPulseDiag,
+	"soft":  ipmi.ChassisSoftShutdown,
+}
+
+func power(w io.Writer, i *ipmi.IPMI, action string) error {
+	if action == "status" {
+		s, err := i.GetChassisStatus()
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "Chassis Power is %s\n", onOff(s.CurrentPowerState&0x01 != 0))
+		return nil
+	}
+	c, ok := powerActions[action]
+	if !ok {
+		return fmt.Errorf("chassis power: unknown action %q", action)
+	}
+	if err := i.ChassisControl(c); err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "Chassis Power Control: %s\n", action)
+	return nil
+}
+
+func lanPrint(w io.Writer, i *ipmi.IPMI, ch byte) error {
+	c, err := i.GetLanConfigs(ch)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "IP Address Source       : %v\n", c.IPSource)
+	fmt.Fprintf(w, "IP Address              : %v\n", c.IP)
+	if c.Netmask != nil {
+		fmt.Fprintf(w, "Subnet Mask             : %v\n", net.IP(c.Netmask))
+	}
+	if c.MAC != nil {
+		fmt.Fprintf(w, "MAC Address             : %v\n", c.MAC)
+	}
+	if c.Gateway != nil {
+		fmt.Fprintf(w, "Default Gateway IP      : %v\n", c.Gateway)
+	}
+	if c.GatewayMAC != nil {
+		fmt.Fprintf(w, "Default Gateway MAC     : %v\n", c.GatewayMAC)
+	}
+	if c.VLAN != 0 {
+		fmt.Fprintf(w, "802.1q VLAN ID          : %d\n", c.VLAN)
+	} else {
+		fmt.Fprintf(w, "802.1q VLAN ID          : Disabled\n")
+	}
+	return nil
+}
+
+func lanSet(i *ipmi.IPMI, ch byte, args []string) error {
+	switch args[0] {
+	case "ipsrc":
+		switch args[1] {
+		case "static":
+			return i.SetLanIPSource(ch, ipmi.IPSourceStatic)
+		case "dhcp":
+			return i.SetLanIPSource(ch, ipmi.IPSourceDHCP)
+		}
+		return fmt.Errorf("lan set: ipsrc must be static or dhcp, not %q", args[1])
+	case "ipaddr", "netmask":
+		ip := net.ParseIP(args[1])
+		if ip == nil {
+			return fmt.Errorf("lan set: invalid address %q", args[1])
+		}
+		if args[0] == "ipaddr" {
+			return i.SetLanIP(ch, ip)
+		}
+		return i.SetLanNetmask(ch, ip)
+	case "defgw":
+		if len(args) < 3 || args[1] != "ipaddr" {
+			return errUsage
+		}
+		ip := net.ParseIP(args[2])
+		if ip == nil {
+			return fmt.Errorf("lan set: invalid address %q", args[2])
+		}
+		return i.SetLanGateway(ch, ip)
+	}
+	return fmt.Errorf("lan set: unknown parameter %q", args[0])
+}
+
+func raw(w io.Writer, i *ipmi.IPMI, args []string) error {
+	if len(args) < 2 {
+		return errUsage
+	}
+	data := make([]byte, len(args))
+	for n, a := range args {
+		v, err := strconv.ParseUint(a, 0, 8)
+		if err != nil {
+			return fmt.Errorf("raw: invalid byte %q", a)
+		}
+		data[n] = byte(v)
+	}
+	buf, err := i.RawCmd(data)
+	if err != nil {
+		return err
+	}
+	// Like ipmitool, print the data without the completion code.
+	for n, b := range buf[1:] {
+		if n > 0 && n%16 == 0 {
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintf(w, " %02x", b)
+	}
+	fmt.Fprintln(w)
+	return nil
+}
@@ -0,0 +1,330 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// lscpu prints the CPU architecture and topology.
+//
+// Synopsis:
+//     lscpu [-J]
+//
+// Description:
+//     lscpu prints the number of CPUs, sockets, cores and threads, the NUMA
+//     nodes, the vendor and model, frequency range, caches, vulnerability
+//     mitigations and flags of the CPUs, from sysfs, /proc/cpuinfo and, on
+//     x86, CPUID.
+//
+//     The JSON output has the format of util-linux' lscpu -J.
+//
+// Options:
+//     -J: print JSON
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/u-root/u-root/pkg/cpuid"
+	"golang.org/x/sys/unix"
+)
+
+var asJSON = flag.Bool("J", false, "print JSON")
+
+// These are variables so tests can override them.
+var (
+	sysCPU      = "/sys/devices/system/cpu"
+	sysNode     = "/sys/devices/system/node"
+	procCPUInfo = "/proc/cpuinfo"
+	cpuidFunc   = cpuid.Native
+	machine     = func() string {
+		var u unix.Utsname
+		if err := unix.Uname(&u); err != nil {
+			return ""
+		}
+		return unix.ByteSliceToString(u.Machine[:])
+	}
+)
+
+// field is one line of output.
+type field struct {
+	Field string `json:"field"`
+	Data  string `json:"data"`
+}
+
+type fields []field
+
+func (f *fields) add(name, format string, args ...interface{}) {
+	*f = append(*f, field{Field: name + ":", Data: fmt.Sprintf(format, args...)})
+}
+
+func readAttr(path ...string) string {
+	b, err := ioutil.ReadFile(filepath.Join(path...))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(b))
+}
+
+// parseList parses a CPU list such as "0-3,8,10-11".
+func parseList(s string) ([]int, error) {
+	var l []int
+	if s == "" {
+		return nil, nil
+	}
+	for _, r := range strings.Split(s, ",") {
+		lo, hi := r, r
+		if i := strings.IndexByte(r, '-'); i >= 0 {
+			lo, hi = r[:i], r[i+1:]
+		}
+		a, err := strconv.Atoi(lo)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CPU list %q", s)
+		}
+		b, err := strconv.Atoi(hi)
+		if err != nil || b < a {
+			return nil, fmt.Errorf("invalid CPU list %q", s)
+		}
+		for c := a; c <= b; c++ {
+			l = append(l, c)
+		}
+	}
+	return l, nil
+}
+
+// cpuInfo returns the fields of the first processor in /proc/cpuinfo.
+func cpuInfo() map[string]string {
+	m := map[string]string{}
+	f, err := os.Open(procCPUInfo)
+	if err != nil {
+		return m
+	}
+	defer f.Close()
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		kv := strings.SplitN(s.Text(), ":", 2)
+		if len(kv) != 2 {
+			if len(m) != 0 && strings.TrimSpace(s.Text()) == "" {
+				break
+			}
+			continue
+		}
+		k := strings.TrimSpace(kv[0])
+		if _, ok := m[k]; !ok {
+			m[k] = strings.TrimSpace(kv[1])
+		}
+	}
+	return m
+}
+
+// topology counts the sockets and cores of the online CPUs.
+func topology(online []int) (sockets, cores int) {
+	pkgs := map[string]bool{}
+	cs := map[string]bool{}
+	for _, c := range online {
+		t := filepath.Join(sysCPU, fmt.Sprintf("cpu%d", c), "topology")
+		p := readAttr(t, "physical_package_id")
+		pkgs[p] = true
+		cs[p+"/"+readAttr(t, "core_id")] = true
+	}
+	return len(pkgs), len(cs)
+}
+
+// frequencies returns the lowest and highest frequency of the CPUs, in MHz.
+func frequencies(online []int) (min, max float64) {
+	for _, c := range online {
+		d := filepath.Join(sysCPU, fmt.Sprintf("cpu%d", c), "cpufreq")
+		if v, err := strconv.ParseFloat(readAttr(d, "cpuinfo_min_freq"), 64); err == nil && (min == 0 || v/1000 < min) {
+			min = v / 1000
+		}
+		if v, err := strconv.ParseFloat(readAttr(d, "cpuinfo_max_freq"), 64); err == nil && v/1000 > max {
+			max = v / 1000
+		}
+	}
+	return min, max
+}
+
+type numaNode struct {
+	n    int
+	cpus string
+}
+
+func numaNodes() []numaNode {
+	fis, err := ioutil.ReadDir(sysNode)
+	if err != nil {
+		return nil
+	}
+	var nodes []numaNode
+	for _, fi := range fis {
+		n, err := strconv.Atoi(strings.TrimPrefix(fi.Name(), "node"))
+		if err != nil || !strings.HasPrefix(fi.Name(), "node") {
+			continue
+		}
+		nodes = append(nodes, numaNode{n, readAttr(sysNode, fi.Name(), "cpulist")})
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].n < nodes[j].n })
+	return nodes
+}
+
+// caches lists the caches of CPU 0, like "L1d cache: 32K".
+func caches(f *fields) {
+	dir := filepath.Join(sysCPU, "cpu0", "cache")
+	fis, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return
+	}
+	for _, fi := range fis {
+		if !strings.HasPrefix(fi.Name(), "index") {
+			continue
+		}
+		d := filepath.Join(dir, fi.Name())
+		suffix := map[string]string{"Data": "d", "Instruction": "i"}[readAttr(d, "type")]
+		f.add(fmt.Sprintf("L%s%s cache", readAttr(d, "level"), suffix), "%s", readAttr(d, "size"))
+	}
+}
+
+// vulnerabilities lists the kernel's view of CPU vulnerabilities, with
+// names like util-linux prints them.
+func vulnerabilities(f *fields) {
+	dir := filepath.Join(sysCPU, "vulnerabilities")
+	fis, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return
+	}
+	for _, fi := range fis {
+		n := strings.Replace(fi.Name(), "_", " ", -1)
+		n = strings.ToUpper(n[:1]) + n[1:]
+		f.add("Vulnerability "+n, "%s", readAttr(dir, fi.Name()))
+	}
+}
+
+func collect() (fields, error) {
+	var f fields
+	if m := machine(); m != "" {
+		f.add("Architecture", "%s", m)
+	}
+	online, err := parseList(readAttr(sysCPU, "online"))
+	if err != nil {
+		return nil, err
+	}
+	present, err := parseList(readAttr(sysCPU, "present"))
+	if err != nil {
+		return nil, err
+	}
+	if len(present) == 0 {
+		present = online
+	}
+	f.add("CPU(s)", "%d", len(present))
+	f.add("On-line CPU(s) list", "%s", readAttr(sysCPU, "online"))
+	if off := readAttr(sysCPU, "offline"); off != "" {
+		f.add("Off-line CPU(s) list", "%s", off)
+	}
+	if sockets, cores := topology(online); cores > 0 {
+		f.add("Thread(s) per core", "%d", len(online)/cores)
+		f.add("Core(s) per socket", "%d", cores/sockets)
+		f.add("Socket(s)", "%d", sockets)
+	}
+	nodes := numaNodes()
+	if len(nodes) > 0 {
+		f.add("NUMA node(s)", "%d", len(nodes))
+	}
+
+	ci := cpuInfo()
+	var id *cpuid.Info
+	if cpuidFunc != nil {
+		id = cpuid.Decode(cpuidFunc)
+	}
+	switch {
+	case id != nil:
+		f.add("Vendor ID", "%s", id.Vendor)
+		f.add("CPU family", "%d", id.Family)
+		f.add("Model", "%d", id.Model)
+		if id.Brand != "" {
+			f.add("Model name", "%s", id.Brand)
+		}
+		f.add("Stepping", "%d", id.Stepping)
+		if id.Hypervisor != nil {
+			f.add("Hypervisor vendor", "%s", id.Hypervisor.Vendor)
+		}
+	case ci["CPU implementer"] != "":
+		// ARM
+		f.add("Vendor ID", "%s", ci["CPU implementer"])
+		f.add("Model", "%s", ci["CPU part"])
+		f.add("Variant", "%s", ci["CPU variant"])
+		f.add("Revision", "%s", ci["CPU revision"])
+	default:
+		// POWER has "cpu", most others the x86 names.
+		for _, k := range []struct{ key, name string }{
+			{"vendor_id", "Vendor ID"},
+			{"model name", "Model name"},
+			{"cpu", "Model name"},
+		} {
+			if v := ci[k.key]; v != "" {
+				f.add(k.name, "%s", v)
+			}
+		}
+	}
+
+	if min, max := frequencies(online); max > 0 {
+		f.add("CPU max MHz", "%.4f", max)
+		f.add("CPU min MHz", "%.4f", min)
+	}
+	caches(&f)
+	for _, n := range nodes {
+		f.add(fmt.Sprintf("NUMA node%d CPU(s)", n.n), "%s", n.cpus)
+	}
+	vulnerabilities(&f)
+
+	// The kernel knows which features it enabled, CPUID only what the CPU
+	// has.
+	flags := ci["flags"]
+	if flags == "" {
+		flags = ci["Features"]
+	}
+	if flags == "" && id != nil {
+		flags = strings.Join(id.Features, " ")
+	}
+	if flags != "" {
+		f.add("Flags", "%s", flags)
+	}
+	return f, nil
+}
+
+func run(w io.Writer) error {
+	f, err := collect()
+	if err != nil {
+		return err
+	}
+	if *asJSON {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "   ")
+		return enc.Encode(struct {
+			Lscpu fields `json:"lscpu"`
+		}{f})
+	}
+	tw := tabwriter.NewWriter(w, 0, 8, 1, ' ', 0)
+	for _, l := range f {
+		fmt.Fprintf(tw, "%s\t%s\n", l.Field, l.Data)
+	}
+	return tw.Flush()
+}
+
+func main() {
+	flag.Parse()
+	if flag.NArg() != 0 {
+		flag.Usage()
+		os.Exit(1)
+	}
+	if err := run(os.Stdout); err != nil {
+		log.Fatal(err)
+	}
+}
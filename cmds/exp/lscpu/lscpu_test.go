@@ -0,0 +1,140 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	for n, v := range files {
+		p := filepath.Join(dir, n)
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(p, []byte(v+"\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// setup fakes 2 sockets with 2 cores of 2 threads each, one NUMA node per
+// socket.
+func setup(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "lscpu")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sysCPU = filepath.Join(dir, "cpu")
+	sysNode = filepath.Join(dir, "node")
+	procCPUInfo = filepath.Join(dir, "cpuinfo")
+	cpuidFunc = nil
+	machine = func() string { return "x86_64" }
+
+	files := map[string]string{
+		"cpu/online":                        "0-7",
+		"cpu/present":                       "0-7",
+		"cpu/vulnerabilities/spectre_v1":    "Mitigation; usercopy/swapgs barriers and __user pointer sanitization",
+		"cpu/vulnerabilities/meltdown":      "Not affected",
+		"cpu/cpu0/cache/index0/level":       "1",
+		"cpu/cpu0/cache/index0/type":        "Data",
+		"cpu/cpu0/cache/index0/size":        "32K",
+		"cpu/cpu0/cache/index1/level":       "1",
+		"cpu/cpu0/cache/index1/type":        "Instruction",
+		"cpu/cpu0/cache/index1/size":        "32K",
+		"cpu/cpu0/cache/index2/level":       "2",
+		"cpu/cpu0/cache/index2/type":        "Unified",
+		"cpu/cpu0/cache/index2/size":        "1024K",
+		"node/node0/cpulist":                "0-3",
+		"node/node1/cpulist":                "4-7",
+		"node/possible":                     "0-1",
+		"cpuinfo":                           "processor\t: 0\nvendor_id\t: GenuineIntel\nmodel name\t: Test CPU\nflags\t\t: fpu vme de\n\nprocessor\t: 1\nvendor_id\t: GenuineIntel\n",
+		"cpu/cpu0/cpufreq/cpuinfo_min_freq": "800000",
+		"cpu/cpu0/cpufreq/cpuinfo_max_freq": "3500000",
+		"cpu/cpu4/cpufreq/cpuinfo_min_freq": "1200000",
+		"cpu/cpu4/cpufreq/cpuinfo_max_freq": "3900000",
+	}
+	for c := 0; c < 8; c++ {
+		files[fmt.Sprintf("cpu/cpu%d/topology/physical_package_id", c)] = fmt.Sprint(c / 4)
+		files[fmt.Sprintf("cpu/cpu%d/topology/core_id", c)] = fmt.Sprint(c % 2)
+	}
+	writeFiles(t, dir, files)
+	return dir
+}
+
+func TestLscpu(t *testing.T) {
+	dir := setup(t)
+	defer os.RemoveAll(dir)
+
+	var out bytes.Buffer
+	if err := run(&out); err != nil {
+		t.Fatal(err)
+	}
+	want := `Architecture:             x86_64
+CPU(s):                   8
+On-line CPU(s) list:      0-7
+Thread(s) per core:       2
+Core(s) per socket:       2
+Socket(s):                2
+NUMA node(s):             2
+Vendor ID:                GenuineIntel
+Model name:               Test CPU
+CPU max MHz:              3900.0000
+CPU min MHz:              800.0000
+L1d cache:                32K
+L1i cache:                32K
+L2 cache:                 1024K
+NUMA node0 CPU(s):        0-3
+NUMA node1 CPU(s):        4-7
+Vulnerability Meltdown:   Not affected
+Vulnerability Spectre v1: Mitigation; usercopy/swapgs barriers and __user pointer sanitization
+Flags:                    fpu vme de
+`
+	if out.String() != want {
+		t.Errorf("got:\n%s\nwant:\n%s", out.String(), want)
+	}
+
+	*asJSON = true
+	defer func() { *asJSON = false }()
+	out.Reset()
+	if err := run(&out); err != nil {
+		t.Fatal(err)
+	}
+	var j struct {
+		Lscpu []field `json:"lscpu"`
+	}
+	if err := json.Unmarshal(out.Bytes(), &j); err != nil {
+		t.Fatal(err)
+	}
+	if len(j.Lscpu) != 19 || j.Lscpu[5] != (field{"Socket(s):", "2"}) {
+		t.Errorf("JSON = %+v", j.Lscpu)
+	}
+}
+
+func TestParseList(t *testing.T) {
+	for _, tt := range []struct {
+		in   string
+		want []int
+		err  bool
+	}{
+		{in: ""},
+		{in: "0", want: []int{0}},
+		{in: "0-3,8,10-11", want: []int{0, 1, 2, 3, 8, 10, 11}},
+		{in: "3-1", err: true},
+		{in: "a", err: true},
+	} {
+		got, err := parseList(tt.in)
+		if (err != nil) != tt.err || !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("parseList(%q) = %v, %v, want %v, error %t", tt.in, got, err, tt.want, tt.err)
+		}
+	}
+}
@@ -0,0 +1,169 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// lsusb lists USB devices.
+//
+// Synopsis:
+//     lsusb [-t] [-v] [-s [[BUS]:][DEVNUM]] [-d [VENDOR]:[PRODUCT]]
+//
+// Description:
+//     lsusb lists the USB devices in /sys/bus/usb with their IDs and
+//     names.
+//
+// Options:
+//     -t: print the topology as a tree, with interfaces and drivers
+//     -v: print the descriptors of each device
+//     -s: only show devices on BUS and/or with number DEVNUM
+//     -d: only show devices with these IDs, in hex
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/u-root/u-root/pkg/usb"
+)
+
+var (
+	tree    = flag.Bool("t", false, "print the topology as a tree")
+	verbose = flag.Bool("v", false, "print descriptors")
+	slot    = flag.String("s", "", "only show devices at [[bus]:][devnum]")
+	ids     = flag.String("d", "", "only show devices with IDs [vendor]:[product]")
+)
+
+type filter func(*usb.Device) bool
+
+// pair parses "a:b" where each number may be left out; -1 means any.
+func pair(s string, base int) (int, int, error) {
+	a, b := s, ""
+	if i := strings.IndexByte(s, ':'); i >= 0 {
+		a, b = s[:i], s[i+1:]
+	} else if base == 10 {
+		// -s DEVNUM
+		a, b = "", s
+	}
+	n := []int{-1, -1}
+	for i, v := range []string{a, b} {
+		if v == "" {
+			continue
+		}
+		x, err := strconv.ParseUint(v, base, 16)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid number %q", v)
+		}
+		n[i] = int(x)
+	}
+	return n[0], n[1], nil
+}
+
+func filters() ([]filter, error) {
+	var fs []filter
+	if *slot != "" {
+		bus, dev, err := pair(*slot, 10)
+		if err != nil {
+			return nil, err
+		}
+		fs = append(fs, func(d *usb.Device) bool {
+			return (bus < 0 || d.Bus == bus) && (dev < 0 || d.Dev == dev)
+		})
+	}
+	if *ids != "" {
+		if !strings.Contains(*ids, ":") {
+			return nil, fmt.Errorf("-d wants [vendor]:[product]")
+		}
+		v, p, err := pair(*ids, 16)
+		if err != nil {
+			return nil, err
+		}
+		fs = append(fs, func(d *usb.Device) bool {
+			return (v < 0 || int(d.Vendor) == v) && (p < 0 || int(d.Product) == p)
+		})
+	}
+	return fs, nil
+}
+
+func speed(d *usb.Device) string {
+	return d.Speed + "M"
+}
+
+func driver(name string, d *usb.Device) string {
+	if name == "" {
+		name = "[none]"
+	}
+	if d.MaxChild > 0 {
+		name += fmt.Sprintf("/%dp", d.MaxChild)
+	}
+	return name
+}
+
+func printTree(w io.Writer, d *usb.Device, depth int) {
+	if d.Parent == nil {
+		fmt.Fprintf(w, "/:  Bus %02d.Port 1: Dev %d, Class=root_hub, Driver=%s, %s\n", d.Bus, d.Dev, driver(d.Driver, d), speed(d))
+	} else {
+		pad := strings.Repeat("    ", depth)
+		if len(d.Interfaces) == 0 {
+			fmt.Fprintf(w, "%s|__ Port %d: Dev %d, Class=%s, %s\n", pad, d.Port(), d.Dev, usb.ClassName(d.Class), speed(d))
+		}
+		for _, i := range d.Interfaces {
+			fmt.Fprintf(w, "%s|__ Port %d: Dev %d, If %d, Class=%s, Driver=%s, %s\n",
+				pad, d.Port(), d.Dev, i.Number, usb.ClassName(i.Class), driver(i.Driver, d), speed(d))
+		}
+	}
+	for _, c := range d.Children {
+		printTree(w, c, depth+1)
+	}
+}
+
+func run(w io.Writer) error {
+	fs, err := filters()
+	if err != nil {
+		return err
+	}
+	devs, err := usb.Devices()
+	if err != nil {
+		return err
+	}
+	if *tree {
+		for _, d := range devs {
+			if d.Parent == nil {
+				printTree(w, d, 0)
+			}
+		}
+		return nil
+	}
+next:
+	for _, d := range devs {
+		for _, f := range fs {
+			if !f(d) {
+				continue next
+			}
+		}
+		fmt.Fprintln(w, d)
+		if !*verbose {
+			continue
+		}
+		fmt.Fprintf(w, "Speed: %sM, Serial: %s\n", d.Speed, d.Serial)
+		if err := usb.WriteDescriptors(w, d.Descriptors); err != nil {
+			log.Printf("%s: %v", d.Name, err)
+		}
+		fmt.Fprintln(w)
+	}
+	return nil
+}
+
+func main() {
+	flag.Parse()
+	if flag.NArg() != 0 {
+		flag.Usage()
+		os.Exit(1)
+	}
+	if err := run(os.Stdout); err != nil {
+		log.Fatal(err)
+	}
+}
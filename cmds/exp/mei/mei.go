@@ -0,0 +1,183 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// mei queries the Intel Management Engine (ME/CSME) via MEI.
+//
+// Synopsis:
+//     mei [-d DEV] [info]
+//         Print the firmware version, status and HMRFPO state.
+//     mei [-d DEV] version
+//         Print the firmware version.
+//     mei [-d DEV] status
+//         Print the decoded firmware status registers.
+//     mei [-d DEV] hmrfpo [enable]
+//         Print the Host ME Region Flash Protection Override state, or
+//         enable it so the ME region can be flashed until the next reset.
+//     mei [-d DEV] check
+//         Fail unless the ME is in a state fit to ship: not in
+//         manufacturing mode, running normally and with HMRFPO not enabled.
+//
+// Description:
+//     The mei_me kernel module must be loaded.
+//
+// Options:
+//     -d: MEI device (default /dev/mei0)
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/u-root/u-root/pkg/mei"
+)
+
+var dev = flag.String("d", mei.DefaultMEIDevicePath, "MEI device")
+
+var hmrfpoStates = map[uint8]string{
+	mei.HMRFPODisabled: "disabled",
+	mei.HMRFPOLocked:   "locked",
+	mei.HMRFPOEnabled:  "enabled",
+}
+
+func hmrfpoName(s uint8) string {
+	if n, ok := hmrfpoStates[s]; ok {
+		return n
+	}
+	return fmt.Sprintf("unknown (%d)", s)
+}
+
+func version(w io.Writer) error {
+	m, err := mei.OpenMKHI(*dev)
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+	v, err := m.GetFWVersion()
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "Firmware version:   %v\n", v.Code)
+	fmt.Fprintf(w, "Recovery version:   %v\n", v.Recovery)
+	if v.FITC != (mei.Version{}) {
+		fmt.Fprintf(w, "FITC version:       %v\n", v.FITC)
+	}
+	return nil
+}
+
+func status(w io.Writer) error {
+	s, err := mei.ReadFWStatus(*dev)
+	if err != nil {
+		return err
+	}
+	fmt.Fprint(w, s)
+	return nil
+}
+
+func hmrfpoStatus() (uint8, error) {
+	m, err := mei.OpenMKHI(*dev)
+	if err != nil {
+		return 0, err
+	}
+	defer m.Close()
+	return m.HMRFPOStatus()
+}
+
+func hmrfpo(w io.Writer, args []string) error {
+	switch {
+	case len(args) == 0:
+		s, err := hmrfpoStatus()
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "HMRFPO:             %s\n", hmrfpoName(s))
+		return nil
+	case len(args) == 1 && args[0] == "enable":
+		m, err := mei.OpenMKHI(*dev)
+		if err != nil {
+			return err
+		}
+		defer m.Close()
+		return m.EnableHMRFPO()
+	}
+	return fmt.Errorf("usage: mei hmrfpo [enable]")
+}
+
+// check returns the reasons the ME is not fit to ship.
+func check() ([]string, error) {
+	s, err := mei.ReadFWStatus(*dev)
+	if err != nil {
+		return nil, err
+	}
+	var problems []string
+	if s.ManufacturingMode() {
+		problems = append(problems, "ME is in manufacturing mode")
+	}
+	if s.FPTBad() {
+		problems = append(problems, "flash partition table is bad")
+	}
+	if s.WorkingState() != mei.WorkingStateNormal {
+		problems = append(problems, fmt.Sprintf("working state is %d, want 5 (Normal)", s.WorkingState()))
+	}
+	if s.OperationMode() != mei.OperationModeNormal {
+		problems = append(problems, fmt.Sprintf("operation mode is %d, want 0 (Normal)", s.OperationMode()))
+	}
+	if s.ErrorCode() != 0 {
+		problems = append(problems, fmt.Sprintf("error code is %d", s.ErrorCode()))
+	}
+	h, err := hmrfpoStatus()
+	if err != nil {
+		return nil, err
+	}
+	if h == mei.HMRFPOEnabled {
+		problems = append(problems, "HMRFPO is enabled, the ME region is writable")
+	}
+	return problems, nil
+}
+
+func run(w io.Writer, args []string) error {
+	cmd := "info"
+	if len(args) > 0 {
+		cmd, args = args[0], args[1:]
+	}
+	if cmd != "hmrfpo" && len(args) != 0 {
+		return fmt.Errorf("%s takes no arguments", cmd)
+	}
+	switch cmd {
+	case "info":
+		for _, f := range []func(io.Writer) error{version, status} {
+			if err := f(w); err != nil {
+				return err
+			}
+		}
+		return hmrfpo(w, nil)
+	case "version":
+		return version(w)
+	case "status":
+		return status(w)
+	case "hmrfpo":
+		return hmrfpo(w, args)
+	case "check":
+		p, err := check()
+		if err != nil {
+			return err
+		}
+		if len(p) != 0 {
+			return fmt.Errorf("ME check failed:\n\t%s", strings.Join(p, "\n\t"))
+		}
+		fmt.Fprintln(w, "ME check passed")
+		return nil
+	}
+	return fmt.Errorf("unrecognized command %q", cmd)
+}
+
+func main() {
+	flag.Parse()
+	if err := run(os.Stdout, flag.Args()); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -5,14 +5,28 @@
 // modprobe - Add and remove modules from the Linux Kernel
 //
 // Synopsis:
-//     modprobe [-n] modulename [parameters...]
-//     modprobe [-n] -a modulename...
+//     modprobe [-n] [-q] modulename [parameters...]
+//     modprobe [-n] [-q] -a modulename...
+//     modprobe [-n] [-q] -r modulename...
+//
+// Description:
+//     modprobe loads a module of /lib/modules/$(uname -r), and the modules
+//     it needs first, as modules.dep or modules.dep.bin of depmod lists
+//     them. Compressed modules, e.g. .ko.xz, .ko.zst or .ko.gz, are
+//     uncompressed to load them. modulename may also be an alias of
+//     modules.alias, e.g. the modalias of a device, which loads all modules
+//     with that alias. The soft dependencies of modules.softdep are loaded
+//     before and after the module if they can be.
+//
+//     -r unloads modules, and then their dependencies no other module
+//     uses. Modules in use are not unloaded.
 //
 // Author:
 //     Roland Kammerer <dev.rck@gmail.com>
 package main
 
 import (
+	"errors"
 	"flag"
 	"log"
 	"os"
@@ -21,12 +35,14 @@ import (
 	"github.com/u-root/u-root/pkg/kmodule"
 )
 
-const cmd = "modprobe [-an] modulename[s] [parameters...]"
+const cmd = "modprobe [-anqr] modulename[s] [parameters...]"
 
 var (
 	dryRun     = flag.Bool("n", false, "Dry run")
 	all        = flag.Bool("a", false, "Insert all module names on the command line.")
 	verboseAll = flag.Bool("va", false, "Insert all module names on the command line.")
+	remove     = flag.Bool("r", false, "Remove modules and their unused dependencies.")
+	quiet      = flag.Bool("q", false, "Do not print errors about modules which cannot be found.")
 	rootDir    = flag.String("d", "/", "Root directory for modules")
 	kernelVer  = flag.String("S", "", "Set kernel version instead of using uname")
 )
@@ -39,6 +55,14 @@ func init() {
 	}
 }
 
+// report logs an error, unless -q is set and the module does not exist.
+func report(format, modName string, err error) {
+	if *quiet && errors.Is(err, kmodule.ErrNotFound) {
+		return
+	}
+	log.Printf(format, modName, err)
+}
+
 func main() {
 	flag.Parse()
 
@@ -53,19 +77,37 @@ func main() {
 		KVer:    *kernelVer,
 	}
 	if *dryRun {
-		log.Println("Unique dependencies in load order, already loaded ones get skipped:")
+		if *remove {
+			log.Println("Modules in unload order:")
+		} else {
+			log.Println("Unique dependencies in load order, already loaded ones get skipped:")
+		}
 		opts.DryRunCB = func(modPath string) {
 			log.Println(modPath)
 		}
 	}
 
+	if *remove {
+		failed := false
+		for _, modName := range flag.Args() {
+			if err := kmodule.RemoveOptions(modName, opts); err != nil {
+				report("modprobe: Could not remove module %q: %v", modName, err)
+				failed = true
+			}
+		}
+		if failed {
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
 	// -va is just an alias for -a
 	*all = *all || *verboseAll
 	if *all {
 		modNames := flag.Args()
 		for _, modName := range modNames {
 			if err := kmodule.ProbeOptions(modName, "", opts); err != nil {
-				log.Printf("modprobe: Could not load module %q: %v", modName, err)
+				report("modprobe: Could not load module %q: %v", modName, err)
 			}
 		}
 		os.Exit(0)
@@ -75,6 +117,7 @@ func main() {
 	modOptions := strings.Join(flag.Args()[1:], " ")
 
 	if err := kmodule.ProbeOptions(modName, modOptions, opts); err != nil {
-		log.Fatalf("modprobe: Could not load module %q: %v", modName, err)
+		report("modprobe: Could not load module %q: %v", modName, err)
+		os.Exit(1)
 	}
 }
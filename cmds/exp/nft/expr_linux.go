@@ -0,0 +1,253 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"golang.org/x/sys/unix"
+)
+
+// Netfilter verdicts, from linux/netfilter.h.
+const (
+	nfDrop   = 0
+	nfAccept = 1
+)
+
+// Conntrack state bits as seen by the ct expression.
+const (
+	ctStateInvalid     = 1 << 0
+	ctStateEstablished = 1 << 1
+	ctStateRelated     = 1 << 2
+	ctStateNew         = 1 << 3
+)
+
+// IPv4 header and transport header offsets used by payload expressions.
+const (
+	ipv4SaddrOffset = 12
+	ipv4DaddrOffset = 16
+	l4DportOffset   = 2
+)
+
+// expr is a single nf_tables expression within a rule.
+type expr struct {
+	name  string
+	attrs []attr
+}
+
+func (e expr) attr() attr {
+	return attrNested(unix.NFTA_LIST_ELEM,
+		attrString(unix.NFTA_EXPR_NAME, e.name),
+		attrNested(unix.NFTA_EXPR_DATA, e.attrs...),
+	)
+}
+
+func metaLoad(key, reg uint32) expr {
+	return expr{name: "meta", attrs: []attr{
+		attrU32(unix.NFTA_META_KEY, key),
+		attrU32(unix.NFTA_META_DREG, reg),
+	}}
+}
+
+func payloadLoad(base, offset, length, reg uint32) expr {
+	return expr{name: "payload", attrs: []attr{
+		attrU32(unix.NFTA_PAYLOAD_DREG, reg),
+		attrU32(unix.NFTA_PAYLOAD_BASE, base),
+		attrU32(unix.NFTA_PAYLOAD_OFFSET, offset),
+		attrU32(unix.NFTA_PAYLOAD_LEN, length),
+	}}
+}
+
+func ctLoad(key, reg uint32) expr {
+	return expr{name: "ct", attrs: []attr{
+		attrU32(unix.NFTA_CT_KEY, key),
+		attrU32(unix.NFTA_CT_DREG, reg),
+	}}
+}
+
+func cmp(op, reg uint32, data []byte) expr {
+	return expr{name: "cmp", attrs: []attr{
+		attrU32(unix.NFTA_CMP_SREG, reg),
+		attrU32(unix.NFTA_CMP_OP, op),
+		attrNested(unix.NFTA_CMP_DATA, attr{typ: unix.NFTA_DATA_VALUE, data: data}),
+	}}
+}
+
+// bitwise computes reg = (reg & mask) ^ xor.
+func bitwise(reg uint32, mask, xor []byte) expr {
+	return expr{name: "bitwise", attrs: []attr{
+		attrU32(unix.NFTA_BITWISE_SREG, reg),
+		attrU32(unix.NFTA_BITWISE_DREG, reg),
+		attrU32(unix.NFTA_BITWISE_LEN, uint32(len(mask))),
+		attrNested(unix.NFTA_BITWISE_MASK, attr{typ: unix.NFTA_DATA_VALUE, data: mask}),
+		attrNested(unix.NFTA_BITWISE_XOR, attr{typ: unix.NFTA_DATA_VALUE, data: xor}),
+	}}
+}
+
+func immediate(reg uint32, data []byte) expr {
+	return expr{name: "immediate", attrs: []attr{
+		attrU32(unix.NFTA_IMMEDIATE_DREG, reg),
+		attrNested(unix.NFTA_IMMEDIATE_DATA, attr{typ: unix.NFTA_DATA_VALUE, data: data}),
+	}}
+}
+
+func verdict(code uint32) expr {
+	return expr{name: "immediate", attrs: []attr{
+		attrU32(unix.NFTA_IMMEDIATE_DREG, unix.NFT_REG_VERDICT),
+		attrNested(unix.NFTA_IMMEDIATE_DATA,
+			attrNested(unix.NFTA_DATA_VERDICT, attrU32(unix.NFTA_VERDICT_CODE, code)),
+		),
+	}}
+}
+
+func masquerade() expr {
+	return expr{name: "masq"}
+}
+
+// nat translates to the address in NFT_REG_1 and, if withPort is set, the
+// port in NFT_REG_2.
+func nat(typ uint32, withPort bool) expr {
+	attrs := []attr{
+		attrU32(unix.NFTA_NAT_TYPE, typ),
+		attrU32(unix.NFTA_NAT_FAMILY, unix.NFPROTO_IPV4),
+		attrU32(unix.NFTA_NAT_REG_ADDR_MIN, unix.NFT_REG_1),
+	}
+	if withPort {
+		attrs = append(attrs, attrU32(unix.NFTA_NAT_REG_PROTO_MIN, unix.NFT_REG_2))
+	}
+	return expr{name: "nat", attrs: attrs}
+}
+
+// ifname pads an interface name the way the kernel stores it.
+func ifname(name string) []byte {
+	b := make([]byte, unix.IFNAMSIZ)
+	copy(b, name)
+	return b
+}
+
+func matchIifname(name string) []expr {
+	return []expr{
+		metaLoad(unix.NFT_META_IIFNAME, unix.NFT_REG_1),
+		cmp(unix.NFT_CMP_EQ, unix.NFT_REG_1, ifname(name)),
+	}
+}
+
+func matchOifname(name string) []expr {
+	return []expr{
+		metaLoad(unix.NFT_META_OIFNAME, unix.NFT_REG_1),
+		cmp(unix.NFT_CMP_EQ, unix.NFT_REG_1, ifname(name)),
+	}
+}
+
+// matchNet matches the IPv4 source or destination address against n.
+func matchNet(offset uint32, n *net.IPNet) []expr {
+	e := []expr{payloadLoad(unix.NFT_PAYLOAD_NETWORK_HEADER, offset, 4, unix.NFT_REG_1)}
+	mask := []byte(n.Mask)
+	if ones, _ := n.Mask.Size(); ones != 32 {
+		e = append(e, bitwise(unix.NFT_REG_1, mask, make([]byte, 4)))
+	}
+	return append(e, cmp(unix.NFT_CMP_EQ, unix.NFT_REG_1, n.IP.To4().Mask(n.Mask)))
+}
+
+// matchDport matches the transport protocol and destination port.
+func matchDport(proto uint8, port uint16) []expr {
+	p := make([]byte, 2)
+	binary.BigEndian.PutUint16(p, port)
+	return []expr{
+		metaLoad(unix.NFT_META_L4PROTO, unix.NFT_REG_1),
+		cmp(unix.NFT_CMP_EQ, unix.NFT_REG_1, []byte{proto}),
+		payloadLoad(unix.NFT_PAYLOAD_TRANSPORT_HEADER, l4DportOffset, 2, unix.NFT_REG_1),
+		cmp(unix.NFT_CMP_EQ, unix.NFT_REG_1, p),
+	}
+}
+
+// matchCtState matches if any of the conntrack state bits in state are set.
+func matchCtState(state uint32) []expr {
+	mask := make([]byte, 4)
+	nativeEndian.PutUint32(mask, state)
+	return []expr{
+		ctLoad(unix.NFT_CT_STATE, unix.NFT_REG_1),
+		bitwise(unix.NFT_REG_1, mask, make([]byte, 4)),
+		cmp(unix.NFT_CMP_NEQ, unix.NFT_REG_1, make([]byte, 4)),
+	}
+}
+
+// parseProto maps a protocol name to its IP protocol number.
+func parseProto(s string) (uint8, error) {
+	switch strings.ToLower(s) {
+	case "tcp":
+		return unix.IPPROTO_TCP, nil
+	case "udp":
+		return unix.IPPROTO_UDP, nil
+	}
+	return 0, fmt.Errorf("unsupported protocol %q, want tcp or udp", s)
+}
+
+// parseProtoPort parses allow specifications of the form proto:port.
+func parseProtoPort(s string) (uint8, uint16, error) {
+	i := strings.IndexByte(s, ':')
+	if i < 0 {
+		return 0, 0, fmt.Errorf("%q: want proto:port", s)
+	}
+	proto, err := parseProto(s[:i])
+	if err != nil {
+		return 0, 0, err
+	}
+	port, err := parsePort(s[i+1:])
+	if err != nil {
+		return 0, 0, err
+	}
+	return proto, port, nil
+}
+
+func parsePort(s string) (uint16, error) {
+	p, err := strconv.ParseUint(s, 10, 16)
+	if err != nil || p == 0 {
+		return 0, fmt.Errorf("invalid port %q", s)
+	}
+	return uint16(p), nil
+}
+
+// parseIPv4Net parses either a bare IPv4 address or a CIDR.
+func parseIPv4Net(s string) (*net.IPNet, error) {
+	if !strings.Contains(s, "/") {
+		s += "/32"
+	}
+	ip, n, err := net.ParseCIDR(s)
+	if err != nil {
+		return nil, err
+	}
+	if ip.To4() == nil {
+		return nil, fmt.Errorf("%q is not an IPv4 network", s)
+	}
+	return n, nil
+}
+
+// parseTarget parses a NAT target of the form ADDR or ADDR:PORT.
+func parseTarget(s string) (net.IP, uint16, error) {
+	host, port := s, ""
+	if strings.Contains(s, ":") {
+		var err error
+		if host, port, err = net.SplitHostPort(s); err != nil {
+			return nil, 0, err
+		}
+	}
+	ip := net.ParseIP(host).To4()
+	if ip == nil {
+		return nil, 0, fmt.Errorf("invalid IPv4 address %q", host)
+	}
+	if port == "" {
+		return ip, 0, nil
+	}
+	p, err := parsePort(port)
+	if err != nil {
+		return nil, 0, err
+	}
+	return ip, p, nil
+}
@@ -0,0 +1,115 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"net"
+	"testing"
+
+	"golang.org/x/sys/unix"
+)
+
+func TestAttrRoundTrip(t *testing.T) {
+	b := encodeAttrs([]attr{
+		attrString(unix.NFTA_TABLE_NAME, "uroot"),
+		attrU32(unix.NFTA_TABLE_FLAGS, 0x01020304),
+		attrNested(unix.NFTA_CHAIN_HOOK, attrU32(unix.NFTA_HOOK_HOOKNUM, 4)),
+	})
+	if len(b)%unix.NLA_ALIGNTO != 0 {
+		t.Errorf("encoded length %d is not aligned", len(b))
+	}
+	m, err := decodeAttrs(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := cString(m[unix.NFTA_TABLE_NAME]); got != "uroot" {
+		t.Errorf("table name = %q, want %q", got, "uroot")
+	}
+	if got := m[unix.NFTA_TABLE_FLAGS]; !bytes.Equal(got, []byte{1, 2, 3, 4}) {
+		t.Errorf("flags = %v, want big endian 0x01020304", got)
+	}
+	hook, err := decodeAttrs(m[unix.NFTA_CHAIN_HOOK])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := hook[unix.NFTA_HOOK_HOOKNUM]; !bytes.Equal(got, []byte{0, 0, 0, 4}) {
+		t.Errorf("hooknum = %v, want 4", got)
+	}
+}
+
+func TestMessageEncode(t *testing.T) {
+	b := newTable("t").encode(7)
+	msgs, err := parseNetlinkMessages(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(msgs) != 1 {
+		t.Fatalf("got %d messages, want 1", len(msgs))
+	}
+	h := msgs[0].Header
+	if h.Type != unix.NFNL_SUBSYS_NFTABLES<<8|unix.NFT_MSG_NEWTABLE || h.Seq != 7 || int(h.Len) != len(b) {
+		t.Errorf("header = %+v", h)
+	}
+	if msgs[0].Data[0] != unix.NFPROTO_IPV4 {
+		t.Errorf("family = %d, want %d", msgs[0].Data[0], unix.NFPROTO_IPV4)
+	}
+}
+
+func TestMatchNet(t *testing.T) {
+	for _, tt := range []struct {
+		in    string
+		exprs int
+	}{
+		{"10.0.0.1", 2},
+		{"10.0.0.0/24", 3},
+	} {
+		n, err := parseIPv4Net(tt.in)
+		if err != nil {
+			t.Fatalf("parseIPv4Net(%q): %v", tt.in, err)
+		}
+		if e := matchNet(ipv4SaddrOffset, n); len(e) != tt.exprs {
+			t.Errorf("matchNet(%q) has %d expressions, want %d", tt.in, len(e), tt.exprs)
+		}
+	}
+	if _, err := parseIPv4Net("fe80::/64"); err == nil {
+		t.Errorf("parseIPv4Net accepted an IPv6 network")
+	}
+}
+
+func TestParseTarget(t *testing.T) {
+	for _, tt := range []struct {
+		in   string
+		ip   net.IP
+		port uint16
+		err  bool
+	}{
+		{in: "10.0.0.2", ip: net.IPv4(10, 0, 0, 2)},
+		{in: "10.0.0.2:22", ip: net.IPv4(10, 0, 0, 2), port: 22},
+		{in: "10.0.0.2:0", err: true},
+		{in: "host:22", err: true},
+	} {
+		ip, port, err := parseTarget(tt.in)
+		if (err != nil) != tt.err {
+			t.Errorf("parseTarget(%q) err = %v, want error %v", tt.in, err, tt.err)
+			continue
+		}
+		if err == nil && (!ip.Equal(tt.ip) || port != tt.port) {
+			t.Errorf("parseTarget(%q) = %v, %d, want %v, %d", tt.in, ip, port, tt.ip, tt.port)
+		}
+	}
+}
+
+func TestParseProtoPort(t *testing.T) {
+	proto, port, err := parseProtoPort("udp:69")
+	if err != nil || proto != unix.IPPROTO_UDP || port != 69 {
+		t.Errorf("parseProtoPort(udp:69) = %d, %d, %v", proto, port, err)
+	}
+	for _, bad := range []string{"udp", "sctp:1", "tcp:x"} {
+		if _, _, err := parseProtoPort(bad); err == nil {
+			t.Errorf("parseProtoPort(%q) succeeded, want error", bad)
+		}
+	}
+}
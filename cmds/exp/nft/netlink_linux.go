@@ -0,0 +1,306 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// nfgenmsgLen is the size of struct nfgenmsg, which follows every
+// nfnetlink message header.
+const nfgenmsgLen = 4
+
+var nativeEndian binary.ByteOrder
+
+func init() {
+	i := uint16(1)
+	if *(*byte)(unsafe.Pointer(&i)) == 1 {
+		nativeEndian = binary.LittleEndian
+	} else {
+		nativeEndian = binary.BigEndian
+	}
+}
+
+// attr is a netlink attribute. If nested is non-empty, data is ignored and
+// the attribute is encoded as a nested attribute.
+type attr struct {
+	typ    uint16
+	data   []byte
+	nested []attr
+}
+
+func align(n int) int {
+	return (n + unix.NLA_ALIGNTO - 1) &^ (unix.NLA_ALIGNTO - 1)
+}
+
+func (a attr) encode() []byte {
+	data := a.data
+	typ := a.typ
+	if a.nested != nil {
+		data = encodeAttrs(a.nested)
+		typ |= unix.NLA_F_NESTED
+	}
+	l := unix.SizeofNlAttr + len(data)
+	b := make([]byte, align(l))
+	nativeEndian.PutUint16(b[0:2], uint16(l))
+	nativeEndian.PutUint16(b[2:4], typ)
+	copy(b[unix.SizeofNlAttr:], data)
+	return b
+}
+
+func encodeAttrs(attrs []attr) []byte {
+	var b []byte
+	for _, a := range attrs {
+		b = append(b, a.encode()...)
+	}
+	return b
+}
+
+// decodeAttrs splits b into a map of attribute types to payloads. Nested
+// attributes are returned undecoded.
+func decodeAttrs(b []byte) (map[uint16][]byte, error) {
+	m := make(map[uint16][]byte)
+	for len(b) >= unix.SizeofNlAttr {
+		l := int(nativeEndian.Uint16(b[0:2]))
+		typ := nativeEndian.Uint16(b[2:4]) &^ (unix.NLA_F_NESTED | unix.NLA_F_NET_BYTEORDER)
+		if l < unix.SizeofNlAttr || l > len(b) {
+			return nil, fmt.Errorf("malformed netlink attribute of length %d", l)
+		}
+		m[typ] = b[unix.SizeofNlAttr:l]
+		if align(l) >= len(b) {
+			break
+		}
+		b = b[align(l):]
+	}
+	return m, nil
+}
+
+func attrString(typ uint16, s string) attr {
+	return attr{typ: typ, data: append([]byte(s), 0)}
+}
+
+func attrU32(typ uint16, v uint32) attr {
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint32(b, v)
+	return attr{typ: typ, data: b}
+}
+
+func attrNested(typ uint16, attrs ...attr) attr {
+	return attr{typ: typ, nested: attrs}
+}
+
+func cString(b []byte) string {
+	for i, c := range b {
+		if c == 0 {
+			return string(b[:i])
+		}
+	}
+	return string(b)
+}
+
+// message is a single nfnetlink message destined for the nf_tables
+// subsystem.
+type message struct {
+	typ    uint16
+	flags  uint16
+	family uint8
+	attrs  []attr
+}
+
+func (m message) encode(seq uint32) []byte {
+	payload := encodeAttrs(m.attrs)
+	l := unix.SizeofNlMsghdr + nfgenmsgLen + len(payload)
+	b := make([]byte, l)
+	nativeEndian.PutUint32(b[0:4], uint32(l))
+	nativeEndian.PutUint16(b[4:6], m.typ)
+	nativeEndian.PutUint16(b[6:8], m.flags)
+	nativeEndian.PutUint32(b[8:12], seq)
+	b[unix.SizeofNlMsghdr] = m.family
+	b[unix.SizeofNlMsghdr+1] = unix.NFNETLINK_V0
+	if m.typ == unix.NFNL_MSG_BATCH_BEGIN || m.typ == unix.NFNL_MSG_BATCH_END {
+		binary.BigEndian.PutUint16(b[unix.SizeofNlMsghdr+2:], unix.NFNL_SUBSYS_NFTABLES)
+	}
+	copy(b[unix.SizeofNlMsghdr+nfgenmsgLen:], payload)
+	return b
+}
+
+func nftMsgType(t uint16) uint16 {
+	return unix.NFNL_SUBSYS_NFTABLES<<8 | t
+}
+
+// conn is a netlink socket bound to the netfilter subsystem.
+type conn struct {
+	fd  int
+	seq uint32
+}
+
+func dial() (*conn, error) {
+	fd, err := unix.Socket(unix.AF_NETLINK, unix.SOCK_RAW|unix.SOCK_CLOEXEC, unix.NETLINK_NETFILTER)
+	if err != nil {
+		return nil, fmt.Errorf("opening netfilter netlink socket: %v", err)
+	}
+	tv := unix.NsecToTimeval(int64(5 * time.Second))
+	if err := unix.SetsockoptTimeval(fd, unix.SOL_SOCKET, unix.SO_RCVTIMEO, &tv); err != nil {
+		unix.Close(fd)
+		return nil, err
+	}
+	if err := unix.Bind(fd, &unix.SockaddrNetlink{Family: unix.AF_NETLINK}); err != nil {
+		unix.Close(fd)
+		return nil, err
+	}
+	return &conn{fd: fd, seq: uint32(time.Now().Unix())}, nil
+}
+
+func (c *conn) Close() error {
+	return unix.Close(c.fd)
+}
+
+// batch sends msgs as a single nf_tables transaction. Either all of them
+// are applied or none are.
+func (c *conn) batch(msgs []message) error {
+	var b []byte
+	c.seq++
+	b = append(b, message{typ: unix.NFNL_MSG_BATCH_BEGIN, flags: unix.NLM_F_REQUEST}.encode(c.seq)...)
+	first := c.seq + 1
+	for _, m := range msgs {
+		c.seq++
+		m.flags |= unix.NLM_F_REQUEST | unix.NLM_F_ACK
+		b = append(b, m.encode(c.seq)...)
+	}
+	last := c.seq
+	c.seq++
+	b = append(b, message{typ: unix.NFNL_MSG_BATCH_END, flags: unix.NLM_F_REQUEST}.encode(c.seq)...)
+
+	if err := unix.Sendto(c.fd, b, 0, &unix.SockaddrNetlink{Family: unix.AF_NETLINK}); err != nil {
+		return err
+	}
+
+	acked := 0
+	for acked < len(msgs) {
+		replies, err := c.receive()
+		if err != nil {
+			return err
+		}
+		for _, r := range replies {
+			if r.Header.Seq < first || r.Header.Seq > last {
+				continue
+			}
+			if r.Header.Type != unix.NLMSG_ERROR {
+				continue
+			}
+			if err := ackError(r.Data); err != nil {
+				return fmt.Errorf("%v: %v", msgName(msgs[r.Header.Seq-first].typ), err)
+			}
+			acked++
+		}
+	}
+	return nil
+}
+
+// dump issues a NLM_F_DUMP request and returns the attributes of every
+// message in the response.
+func (c *conn) dump(m message) ([]map[uint16][]byte, error) {
+	c.seq++
+	m.flags |= unix.NLM_F_REQUEST | unix.NLM_F_DUMP
+	if err := unix.Sendto(c.fd, m.encode(c.seq), 0, &unix.SockaddrNetlink{Family: unix.AF_NETLINK}); err != nil {
+		return nil, err
+	}
+	var res []map[uint16][]byte
+	for {
+		replies, err := c.receive()
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range replies {
+			if r.Header.Seq != c.seq {
+				continue
+			}
+			switch r.Header.Type {
+			case unix.NLMSG_DONE:
+				return res, nil
+			case unix.NLMSG_ERROR:
+				if err := ackError(r.Data); err != nil {
+					return nil, err
+				}
+				return res, nil
+			}
+			if len(r.Data) < nfgenmsgLen {
+				return nil, fmt.Errorf("short nfnetlink message")
+			}
+			a, err := decodeAttrs(r.Data[nfgenmsgLen:])
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, a)
+		}
+	}
+}
+
+func (c *conn) receive() ([]nlMessage, error) {
+	b := make([]byte, 1<<16)
+	n, _, err := unix.Recvfrom(c.fd, b, 0)
+	if err != nil {
+		return nil, fmt.Errorf("reading netlink reply: %v", err)
+	}
+	return parseNetlinkMessages(b[:n])
+}
+
+// nlMessage is a raw netlink message as read from the socket.
+type nlMessage struct {
+	Header unix.NlMsghdr
+	Data   []byte
+}
+
+func parseNetlinkMessages(b []byte) ([]nlMessage, error) {
+	var msgs []nlMessage
+	for len(b) >= unix.SizeofNlMsghdr {
+		h := unix.NlMsghdr{
+			Len:   nativeEndian.Uint32(b[0:4]),
+			Type:  nativeEndian.Uint16(b[4:6]),
+			Flags: nativeEndian.Uint16(b[6:8]),
+			Seq:   nativeEndian.Uint32(b[8:12]),
+			Pid:   nativeEndian.Uint32(b[12:16]),
+		}
+		if int(h.Len) < unix.SizeofNlMsghdr || int(h.Len) > len(b) {
+			return nil, fmt.Errorf("malformed netlink message of length %d", h.Len)
+		}
+		msgs = append(msgs, nlMessage{Header: h, Data: b[unix.SizeofNlMsghdr:h.Len]})
+		l := align(int(h.Len))
+		if l >= len(b) {
+			break
+		}
+		b = b[l:]
+	}
+	return msgs, nil
+}
+
+func ackError(data []byte) error {
+	if len(data) < 4 {
+		return fmt.Errorf("short netlink error message")
+	}
+	if errno := int32(nativeEndian.Uint32(data[0:4])); errno != 0 {
+		return unix.Errno(-errno)
+	}
+	return nil
+}
+
+func msgName(t uint16) string {
+	switch t & 0xff {
+	case unix.NFT_MSG_NEWTABLE:
+		return "creating table"
+	case unix.NFT_MSG_DELTABLE:
+		return "deleting table"
+	case unix.NFT_MSG_NEWCHAIN:
+		return "creating chain"
+	case unix.NFT_MSG_NEWRULE:
+		return "adding rule"
+	}
+	return fmt.Sprintf("message %d", t&0xff)
+}
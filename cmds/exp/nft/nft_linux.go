@@ -0,0 +1,384 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// nft is a minimal nftables frontend for NAT and stateful filtering.
+//
+// Synopsis:
+//
+//	nft [-table NAME] masquerade -o IFACE [-s CIDR]
+//	nft [-table NAME] snat -o IFACE -to ADDR [-s CIDR]
+//	nft [-table NAME] forward -i IFACE -p tcp|udp -dport PORT -to ADDR[:PORT]
+//	nft [-table NAME] filter [-policy accept|drop] [-i IFACE] [-allow PROTO:PORT,...]
+//	nft [-table NAME] flush
+//	nft list
+//
+// Description:
+//
+//	All rules are created in a single IPv4 table (default "uroot"), with
+//	base chains created on demand: "postrouting" and "prerouting" for
+//	NAT and "input" for filtering. flush deletes the whole table.
+//
+//	filter installs a stateful input chain which accepts loopback
+//	traffic, established and related connections, and the listed
+//	ports, and applies the policy to everything else. If -i is given,
+//	only traffic arriving on that interface is filtered.
+//
+//	Forwarding between interfaces also requires
+//	/proc/sys/net/ipv4/ip_forward to be set to 1.
+//
+// Examples:
+//
+//	Share eth0's uplink with hosts on 10.0.0.0/24:
+//	    nft masquerade -o eth0 -s 10.0.0.0/24
+//	Forward port 2222 on eth0 to a BMC's ssh port:
+//	    nft forward -i eth0 -p tcp -dport 2222 -to 10.0.0.2:22
+//	Only allow ssh and http into this machine:
+//	    nft filter -policy drop -allow tcp:22,tcp:80
+package main
+
+import (
+	"encoding/binary"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"golang.org/x/sys/unix"
+)
+
+var table = flag.String("table", "uroot", "name of the nftables table to operate on")
+
+const usage = `usage: nft [-table NAME] COMMAND [ARGS]
+commands:
+  masquerade -o IFACE [-s CIDR]
+  snat -o IFACE -to ADDR [-s CIDR]
+  forward -i IFACE -p tcp|udp -dport PORT -to ADDR[:PORT]
+  filter [-policy accept|drop] [-i IFACE] [-allow PROTO:PORT,...]
+  flush
+  list`
+
+// chainSpec describes a base chain.
+type chainSpec struct {
+	name     string
+	typ      string
+	hook     uint32
+	priority int32
+	policy   uint32
+}
+
+var (
+	postrouting = chainSpec{name: "postrouting", typ: "nat", hook: unix.NF_INET_POST_ROUTING, priority: 100, policy: nfAccept}
+	prerouting  = chainSpec{name: "prerouting", typ: "nat", hook: unix.NF_INET_PRE_ROUTING, priority: -100, policy: nfAccept}
+	input       = chainSpec{name: "input", typ: "filter", hook: unix.NF_INET_LOCAL_IN, priority: 0, policy: nfAccept}
+)
+
+func newTable(name string) message {
+	return message{
+		typ:    nftMsgType(unix.NFT_MSG_NEWTABLE),
+		flags:  unix.NLM_F_CREATE,
+		family: unix.NFPROTO_IPV4,
+		attrs:  []attr{attrString(unix.NFTA_TABLE_NAME, name)},
+	}
+}
+
+func delTable(name string) message {
+	return message{
+		typ:    nftMsgType(unix.NFT_MSG_DELTABLE),
+		family: unix.NFPROTO_IPV4,
+		attrs:  []attr{attrString(unix.NFTA_TABLE_NAME, name)},
+	}
+}
+
+func newChain(tbl string, c chainSpec) message {
+	return message{
+		typ:    nftMsgType(unix.NFT_MSG_NEWCHAIN),
+		flags:  unix.NLM_F_CREATE,
+		family: unix.NFPROTO_IPV4,
+		attrs: []attr{
+			attrString(unix.NFTA_CHAIN_TABLE, tbl),
+			attrString(unix.NFTA_CHAIN_NAME, c.name),
+			attrNested(unix.NFTA_CHAIN_HOOK,
+				attrU32(unix.NFTA_HOOK_HOOKNUM, c.hook),
+				attrU32(unix.NFTA_HOOK_PRIORITY, uint32(c.priority)),
+			),
+			attrString(unix.NFTA_CHAIN_TYPE, c.typ),
+			attrU32(unix.NFTA_CHAIN_POLICY, c.policy),
+		},
+	}
+}
+
+func newRule(tbl, chain string, exprs ...[]expr) message {
+	var list []attr
+	for _, es := range exprs {
+		for _, e := range es {
+			list = append(list, e.attr())
+		}
+	}
+	return message{
+		typ:    nftMsgType(unix.NFT_MSG_NEWRULE),
+		flags:  unix.NLM_F_CREATE | unix.NLM_F_APPEND,
+		family: unix.NFPROTO_IPV4,
+		attrs: []attr{
+			attrString(unix.NFTA_RULE_TABLE, tbl),
+			attrString(unix.NFTA_RULE_CHAIN, chain),
+			attrNested(unix.NFTA_RULE_EXPRESSIONS, list...),
+		},
+	}
+}
+
+func masqueradeCmd(args []string) ([]message, error) {
+	fs := flag.NewFlagSet("masquerade", flag.ExitOnError)
+	oif := fs.String("o", "", "outgoing interface")
+	src := fs.String("s", "", "only masquerade traffic from this source network")
+	fs.Parse(args)
+	if *oif == "" {
+		return nil, fmt.Errorf("masquerade: -o is required")
+	}
+	m := [][]expr{matchOifname(*oif)}
+	if *src != "" {
+		n, err := parseIPv4Net(*src)
+		if err != nil {
+			return nil, err
+		}
+		m = append(m, matchNet(ipv4SaddrOffset, n))
+	}
+	m = append(m, []expr{masquerade()})
+	return []message{
+		newTable(*table),
+		newChain(*table, postrouting),
+		newRule(*table, postrouting.name, m...),
+	}, nil
+}
+
+func snatCmd(args []string) ([]message, error) {
+	fs := flag.NewFlagSet("snat", flag.ExitOnError)
+	oif := fs.String("o", "", "outgoing interface")
+	src := fs.String("s", "", "only translate traffic from this source network")
+	to := fs.String("to", "", "source address to translate to")
+	fs.Parse(args)
+	if *oif == "" || *to == "" {
+		return nil, fmt.Errorf("snat: -o and -to are required")
+	}
+	ip, _, err := parseTarget(*to)
+	if err != nil {
+		return nil, err
+	}
+	m := [][]expr{matchOifname(*oif)}
+	if *src != "" {
+		n, err := parseIPv4Net(*src)
+		if err != nil {
+			return nil, err
+		}
+		m = append(m, matchNet(ipv4SaddrOffset, n))
+	}
+	m = append(m, []expr{immediate(unix.NFT_REG_1, ip), nat(unix.NFT_NAT_SNAT, false)})
+	return []message{
+		newTable(*table),
+		newChain(*table, postrouting),
+		newRule(*table, postrouting.name, m...),
+	}, nil
+}
+
+func forwardCmd(args []string) ([]message, error) {
+	fs := flag.NewFlagSet("forward", flag.ExitOnError)
+	iif := fs.String("i", "", "incoming interface")
+	proto := fs.String("p", "tcp", "protocol (tcp or udp)")
+	dport := fs.String("dport", "", "destination port to forward")
+	to := fs.String("to", "", "address and optional port to forward to")
+	fs.Parse(args)
+	if *iif == "" || *dport == "" || *to == "" {
+		return nil, fmt.Errorf("forward: -i, -dport and -to are required")
+	}
+	p, err := parseProto(*proto)
+	if err != nil {
+		return nil, err
+	}
+	port, err := parsePort(*dport)
+	if err != nil {
+		return nil, err
+	}
+	ip, toPort, err := parseTarget(*to)
+	if err != nil {
+		return nil, err
+	}
+	if toPort == 0 {
+		toPort = port
+	}
+	tp := make([]byte, 2)
+	binary.BigEndian.PutUint16(tp, toPort)
+	return []message{
+		newTable(*table),
+		newChain(*table, prerouting),
+		newRule(*table, prerouting.name,
+			matchIifname(*iif),
+			matchDport(p, port),
+			[]expr{
+				immediate(unix.NFT_REG_1, ip),
+				immediate(unix.NFT_REG_2, tp),
+				nat(unix.NFT_NAT_DNAT, true),
+			},
+		),
+	}, nil
+}
+
+func filterCmd(args []string) ([]message, error) {
+	fs := flag.NewFlagSet("filter", flag.ExitOnError)
+	policy := fs.String("policy", "drop", "verdict for traffic not otherwise allowed (accept or drop)")
+	iif := fs.String("i", "", "only filter traffic arriving on this interface")
+	allow := fs.String("allow", "", "comma-separated list of PROTO:PORT to accept")
+	fs.Parse(args)
+
+	c := input
+	var final []expr
+	switch *policy {
+	case "accept":
+	case "drop":
+		// With -i, other interfaces must stay reachable, so the policy is
+		// applied by a trailing rule rather than by the chain.
+		if *iif == "" {
+			c.policy = nfDrop
+		} else {
+			final = []expr{verdict(nfDrop)}
+		}
+	default:
+		return nil, fmt.Errorf("filter: invalid policy %q", *policy)
+	}
+
+	var match []expr
+	if *iif != "" {
+		match = matchIifname(*iif)
+	}
+	msgs := []message{
+		newTable(*table),
+		newChain(*table, c),
+		newRule(*table, c.name, matchIifname("lo"), []expr{verdict(nfAccept)}),
+		newRule(*table, c.name, match, matchCtState(ctStateEstablished|ctStateRelated), []expr{verdict(nfAccept)}),
+		newRule(*table, c.name, match, matchCtState(ctStateInvalid), []expr{verdict(nfDrop)}),
+	}
+	if *allow != "" {
+		for _, a := range strings.Split(*allow, ",") {
+			proto, port, err := parseProtoPort(a)
+			if err != nil {
+				return nil, err
+			}
+			msgs = append(msgs, newRule(*table, c.name, match, matchDport(proto, port), []expr{verdict(nfAccept)}))
+		}
+	}
+	if final != nil {
+		msgs = append(msgs, newRule(*table, c.name, match, final))
+	}
+	return msgs, nil
+}
+
+func list(c *conn) error {
+	tables, err := c.dump(message{typ: nftMsgType(unix.NFT_MSG_GETTABLE), family: unix.NFPROTO_UNSPEC})
+	if err != nil {
+		return fmt.Errorf("listing tables: %v", err)
+	}
+	chains, err := c.dump(message{typ: nftMsgType(unix.NFT_MSG_GETCHAIN), family: unix.NFPROTO_UNSPEC})
+	if err != nil {
+		return fmt.Errorf("listing chains: %v", err)
+	}
+	rules, err := c.dump(message{typ: nftMsgType(unix.NFT_MSG_GETRULE), family: unix.NFPROTO_UNSPEC})
+	if err != nil {
+		return fmt.Errorf("listing rules: %v", err)
+	}
+	nrules := make(map[[2]string]int)
+	for _, r := range rules {
+		nrules[[2]string{cString(r[unix.NFTA_RULE_TABLE]), cString(r[unix.NFTA_RULE_CHAIN])}]++
+	}
+	for _, t := range tables {
+		name := cString(t[unix.NFTA_TABLE_NAME])
+		fmt.Printf("table %s\n", name)
+		for _, ch := range chains {
+			if cString(ch[unix.NFTA_CHAIN_TABLE]) != name {
+				continue
+			}
+			chain := cString(ch[unix.NFTA_CHAIN_NAME])
+			fmt.Printf("\tchain %s", chain)
+			if typ, ok := ch[unix.NFTA_CHAIN_TYPE]; ok {
+				fmt.Printf(" type %s", cString(typ))
+			}
+			if hook, ok := ch[unix.NFTA_CHAIN_HOOK]; ok {
+				h, err := decodeAttrs(hook)
+				if err == nil && len(h[unix.NFTA_HOOK_HOOKNUM]) == 4 && len(h[unix.NFTA_HOOK_PRIORITY]) == 4 {
+					fmt.Printf(" hook %s priority %d",
+						hookName(binary.BigEndian.Uint32(h[unix.NFTA_HOOK_HOOKNUM])),
+						int32(binary.BigEndian.Uint32(h[unix.NFTA_HOOK_PRIORITY])))
+				}
+			}
+			if p, ok := ch[unix.NFTA_CHAIN_POLICY]; ok && len(p) == 4 {
+				if binary.BigEndian.Uint32(p) == nfDrop {
+					fmt.Printf(" policy drop")
+				} else {
+					fmt.Printf(" policy accept")
+				}
+			}
+			fmt.Printf(" (%d rules)\n", nrules[[2]string{name, chain}])
+		}
+	}
+	return nil
+}
+
+func hookName(h uint32) string {
+	switch h {
+	case unix.NF_INET_PRE_ROUTING:
+		return "prerouting"
+	case unix.NF_INET_LOCAL_IN:
+		return "input"
+	case unix.NF_INET_FORWARD:
+		return "forward"
+	case unix.NF_INET_LOCAL_OUT:
+		return "output"
+	case unix.NF_INET_POST_ROUTING:
+		return "postrouting"
+	}
+	return fmt.Sprintf("%d", h)
+}
+
+func run(args []string) error {
+	if len(args) == 0 {
+		return errors.New(usage)
+	}
+	c, err := dial()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	var msgs []message
+	switch args[0] {
+	case "masquerade":
+		msgs, err = masqueradeCmd(args[1:])
+	case "snat":
+		msgs, err = snatCmd(args[1:])
+	case "forward":
+		msgs, err = forwardCmd(args[1:])
+	case "filter":
+		msgs, err = filterCmd(args[1:])
+	case "flush":
+		msgs = []message{delTable(*table)}
+	case "list":
+		return list(c)
+	default:
+		return fmt.Errorf("unknown command %q\n%s", args[0], usage)
+	}
+	if err != nil {
+		return err
+	}
+	return c.batch(msgs)
+}
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if err := run(flag.Args()); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -87,7 +87,6 @@ package main
 import (
 	"errors"
 	"fmt"
-	"io"
 	"io/ioutil"
 	"log"
 	"os"
@@ -170,35 +169,13 @@ func poxCreate(bin ...string) error {
 	if err != nil {
 		return err
 	}
-	// We don't use defer() here to close files as
-	// that can cause open failures with a large enough number.
 	for _, f := range names {
 		v("Adding %q", f)
-		fi, err := os.Stat(f)
-		if err != nil {
-			return err
-		}
-		in, err := os.Open(f)
-		if err != nil {
-			return err
-		}
-		f = strings.TrimPrefix(f, pwd)
-		dfile := filepath.Join(dir, f)
-		d := filepath.Dir(dfile)
-		if err := os.MkdirAll(d, 0755); err != nil {
-			in.Close()
+		dfile := filepath.Join(dir, strings.TrimPrefix(f, pwd))
+		if err := os.MkdirAll(filepath.Dir(dfile), 0755); err != nil {
 			return err
 		}
-		out, err := os.OpenFile(dfile, os.O_WRONLY|os.O_CREATE,
-			fi.Mode().Perm())
-		if err != nil {
-			in.Close()
-			return err
-		}
-		_, err = io.Copy(out, in)
-		in.Close()
-		out.Close()
-		if err != nil {
+		if err := cp.Copy(f, dfile); err != nil {
 			return err
 		}
 	}
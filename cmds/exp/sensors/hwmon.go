@@ -0,0 +1,278 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// hwmonPath is a variable so tests can override it.
+var hwmonPath = "/sys/class/hwmon"
+
+// sensorTypes are the hwmon sensor types, in the order they are printed,
+// with their scale and unit.
+var sensorTypes = []struct {
+	name  string
+	scale float64
+	unit  string
+}{
+	{"in", 1000, "V"},
+	{"fan", 1, "RPM"},
+	{"temp", 1000, "°C"},
+	{"power", 1e6, "W"},
+	{"energy", 1e6, "J"},
+	{"curr", 1000, "A"},
+	{"humidity", 1000, "%RH"},
+}
+
+var attrRE = regexp.MustCompile(`^(in|fan|temp|power|energy|curr|humidity)(\d+)_([a-z_]+)$`)
+
+// limits are the limit attributes printed after the input, with their
+// lm-sensors names.
+var limits = []struct {
+	attr, name string
+}{
+	{"min", "min"},
+	{"max", "max"},
+	{"lcrit", "crit low"},
+	{"crit", "crit"},
+	{"emergency", "emerg"},
+}
+
+// sensor is one input of a chip, e.g. temp1.
+type sensor struct {
+	Type  string
+	Index int
+	Label string
+	// Values are the scaled numeric attributes, keyed by attribute name,
+	// e.g. "input", "max", "crit_alarm".
+	Values map[string]float64
+}
+
+func (s *sensor) attr(a string) string {
+	return fmt.Sprintf("%s%d_%s", s.Type, s.Index, a)
+}
+
+func (s *sensor) value(a string) (float64, bool) {
+	v, ok := s.Values[a]
+	return v, ok
+}
+
+// alarm reports whether the chip flags an alarm or the input is out of
+// its limits.
+func (s *sensor) alarm() bool {
+	for a, v := range s.Values {
+		if (a == "alarm" || strings.HasSuffix(a, "_alarm")) && v != 0 {
+			return true
+		}
+	}
+	in, ok := s.input()
+	if !ok {
+		return false
+	}
+	for _, l := range []string{"max", "crit", "emergency"} {
+		if v, ok := s.value(l); ok && v != 0 && in >= v {
+			return true
+		}
+	}
+	for _, l := range []string{"min", "lcrit"} {
+		if v, ok := s.value(l); ok && in < v {
+			return true
+		}
+	}
+	return false
+}
+
+// input returns the reading, which for power sensors may be an average.
+func (s *sensor) input() (float64, bool) {
+	if v, ok := s.value("input"); ok {
+		return v, true
+	}
+	return s.value("average")
+}
+
+type chip struct {
+	ID      string
+	Adapter string
+	Sensors []*sensor
+}
+
+func readString(path string) string {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(b))
+}
+
+// chipID names a chip like libsensors does, e.g. coretemp-isa-0000 or
+// lm75-i2c-1-48, and returns its adapter.
+func chipID(dir, name string) (string, string) {
+	dev, err := filepath.EvalSymlinks(filepath.Join(dir, "device"))
+	if err != nil {
+		return name + "-virtual-0", "Virtual device"
+	}
+	sub, _ := filepath.EvalSymlinks(filepath.Join(dev, "subsystem"))
+	base := filepath.Base(dev)
+	switch filepath.Base(sub) {
+	case "i2c":
+		var bus, addr int
+		if _, err := fmt.Sscanf(base, "%d-%x", &bus, &addr); err == nil {
+			return fmt.Sprintf("%s-i2c-%d-%02x", name, bus, addr), "I2C adapter"
+		}
+	case "pci":
+		var dom, bus, slot, fn int
+		if _, err := fmt.Sscanf(base, "%x:%x:%x.%x", &dom, &bus, &slot, &fn); err == nil {
+			return fmt.Sprintf("%s-pci-%04x", name, bus<<8|slot<<3|fn), "PCI adapter"
+		}
+	case "acpi":
+		return name + "-acpi-0", "ACPI interface"
+	case "platform":
+		var n int
+		if i := strings.LastIndexByte(base, '.'); i >= 0 {
+			n, _ = strconv.Atoi(base[i+1:])
+		}
+		return fmt.Sprintf("%s-isa-%04x", name, n), "ISA adapter"
+	}
+	return name + "-virtual-0", "Virtual device"
+}
+
+func readChip(dir string) (*chip, error) {
+	// Old drivers keep their attributes in the device directory.
+	name := readString(filepath.Join(dir, "name"))
+	attrDir := dir
+	if name == "" {
+		attrDir = filepath.Join(dir, "device")
+		name = readString(filepath.Join(attrDir, "name"))
+	}
+	if name == "" {
+		return nil, fmt.Errorf("%s: no name", dir)
+	}
+	files, err := ioutil.ReadDir(attrDir)
+	if err != nil {
+		return nil, err
+	}
+	c := &chip{}
+	c.ID, c.Adapter = chipID(dir, name)
+	byName := map[string]*sensor{}
+	for _, f := range files {
+		m := attrRE.FindStringSubmatch(f.Name())
+		if m == nil {
+			continue
+		}
+		key := m[1] + m[2]
+		s, ok := byName[key]
+		if !ok {
+			n, _ := strconv.Atoi(m[2])
+			s = &sensor{Type: m[1], Index: n, Label: key, Values: map[string]float64{}}
+			byName[key] = s
+			c.Sensors = append(c.Sensors, s)
+		}
+		if m[3] == "label" {
+			if l := readString(filepath.Join(attrDir, f.Name())); l != "" {
+				s.Label = l
+			}
+			continue
+		}
+		raw := readString(filepath.Join(attrDir, f.Name()))
+		v, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			// Write-only or unreadable attributes, or a failed read.
+			continue
+		}
+		if !strings.HasSuffix(m[3], "alarm") && m[3] != "fault" && m[3] != "beep" && m[3] != "enable" {
+			v /= scale(m[1])
+		}
+		s.Values[m[3]] = v
+	}
+	// Sensors without an input are only limits or controls.
+	sensors := c.Sensors[:0]
+	for _, s := range c.Sensors {
+		if _, ok := s.input(); ok {
+			sensors = append(sensors, s)
+		}
+	}
+	c.Sensors = sensors
+	order := map[string]int{}
+	for i, t := range sensorTypes {
+		order[t.name] = i
+	}
+	sort.Slice(c.Sensors, func(i, j int) bool {
+		a, b := c.Sensors[i], c.Sensors[j]
+		if a.Type != b.Type {
+			return order[a.Type] < order[b.Type]
+		}
+		return a.Index < b.Index
+	})
+	return c, nil
+}
+
+func scale(t string) float64 {
+	for _, s := range sensorTypes {
+		if s.name == t {
+			return s.scale
+		}
+	}
+	return 1
+}
+
+func unit(t string) string {
+	for _, s := range sensorTypes {
+		if s.name == t {
+			return s.unit
+		}
+	}
+	return ""
+}
+
+// chips reads all the hwmon chips whose ID matches one of the patterns,
+// or all of them without patterns.
+func chips(patterns []string) ([]*chip, error) {
+	dirs, err := filepath.Glob(filepath.Join(hwmonPath, "hwmon*"))
+	if err != nil {
+		return nil, err
+	}
+	if len(dirs) == 0 {
+		if _, err := os.Stat(hwmonPath); err != nil {
+			return nil, err
+		}
+	}
+	// hwmon10 sorts before hwmon2.
+	sort.Slice(dirs, func(i, j int) bool {
+		a, _ := strconv.Atoi(strings.TrimPrefix(filepath.Base(dirs[i]), "hwmon"))
+		b, _ := strconv.Atoi(strings.TrimPrefix(filepath.Base(dirs[j]), "hwmon"))
+		return a < b
+	})
+	var cs []*chip
+	for _, d := range dirs {
+		c, err := readChip(d)
+		if err != nil {
+			return nil, err
+		}
+		if matches(c.ID, patterns) {
+			cs = append(cs, c)
+		}
+	}
+	return cs, nil
+}
+
+func matches(id string, patterns []string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, p := range patterns {
+		if ok, _ := filepath.Match(p, id); ok || strings.HasPrefix(id, p+"-") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,127 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// sensors prints the readings of hardware monitoring chips.
+//
+// Synopsis:
+//     sensors [-j] [-f] [CHIP...]
+//
+// Description:
+//     sensors reads the temperature, fan, voltage, power and current
+//     inputs of the chips in /sys/class/hwmon, with their labels and
+//     limits. Inputs whose alarm is set, or which are beyond their
+//     limits, are flagged with ALARM.
+//
+//     CHIP selects chips by name, e.g. coretemp or coretemp-isa-*.
+//
+// Options:
+//     -j: print JSON in the format of lm-sensors
+//     -f: show temperatures in degrees Fahrenheit
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	jsonOut    = flag.Bool("j", false, "print JSON")
+	fahrenheit = flag.Bool("f", false, "show temperatures in degrees Fahrenheit")
+)
+
+func format(s *sensor, v float64) string {
+	switch s.Type {
+	case "temp":
+		u := "°C"
+		if *fahrenheit {
+			v, u = v*9/5+32, "°F"
+		}
+		return fmt.Sprintf("%+.1f%s", v, u)
+	case "fan":
+		return fmt.Sprintf("%.0f RPM", v)
+	}
+	return fmt.Sprintf("%.2f %s", v, unit(s.Type))
+}
+
+func printChip(w io.Writer, c *chip) {
+	fmt.Fprintf(w, "%s\nAdapter: %s\n", c.ID, c.Adapter)
+	width := 0
+	for _, s := range c.Sensors {
+		if len(s.Label) > width {
+			width = len(s.Label)
+		}
+	}
+	for _, s := range c.Sensors {
+		in, _ := s.input()
+		// Pad by runes, not bytes: ° is two bytes.
+		v := format(s, in)
+		line := fmt.Sprintf("%-*s %s%s", width+1, s.Label+":", strings.Repeat(" ", 12-utf8.RuneCountInString(v)), v)
+		var lim []string
+		for _, l := range limits {
+			if v, ok := s.value(l.attr); ok {
+				lim = append(lim, fmt.Sprintf("%s = %s", l.name, format(s, v)))
+			}
+		}
+		if len(lim) > 0 {
+			line += "  (" + strings.Join(lim, ", ") + ")"
+		}
+		if f, ok := s.value("fault"); ok && f != 0 {
+			line += "  FAULT"
+		} else if s.alarm() {
+			line += "  ALARM"
+		}
+		fmt.Fprintln(w, line)
+	}
+	fmt.Fprintln(w)
+}
+
+// jsonChips formats chips like sensors -j of lm-sensors:
+// {"chip": {"Adapter": "...", "label": {"temp1_input": 45.0, ...}}}.
+func jsonChips(cs []*chip) map[string]map[string]interface{} {
+	out := map[string]map[string]interface{}{}
+	for _, c := range cs {
+		m := map[string]interface{}{"Adapter": c.Adapter}
+		for _, s := range c.Sensors {
+			vals := map[string]float64{}
+			for a, v := range s.Values {
+				vals[s.attr(a)] = v
+			}
+			m[s.Label] = vals
+		}
+		out[c.ID] = m
+	}
+	return out
+}
+
+func run(w io.Writer, args []string) error {
+	cs, err := chips(args)
+	if err != nil {
+		return err
+	}
+	if len(cs) == 0 {
+		return fmt.Errorf("no sensors found")
+	}
+	if *jsonOut {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(jsonChips(cs))
+	}
+	for _, c := range cs {
+		printChip(w, c)
+	}
+	return nil
+}
+
+func main() {
+	flag.Parse()
+	if err := run(os.Stdout, flag.Args()); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func fakeHwmon(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "hwmon")
+	if err != nil {
+		t.Fatal(err)
+	}
+	write := func(path string, attrs map[string]string) {
+		d := filepath.Join(dir, path)
+		if err := os.MkdirAll(d, 0755); err != nil {
+			t.Fatal(err)
+		}
+		for k, v := range attrs {
+			if err := ioutil.WriteFile(filepath.Join(d, k), []byte(v+"\n"), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	link := func(target, name string) {
+		if err := os.Symlink(filepath.Join(dir, target), filepath.Join(dir, name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("bus/platform", nil)
+	write("devices/coretemp.0", nil)
+	link("bus/platform", "devices/coretemp.0/subsystem")
+
+	write("class/hwmon/hwmon0", map[string]string{
+		"name":             "coretemp",
+		"temp1_label":      "Package id 0",
+		"temp1_input":      "45000",
+		"temp1_max":        "80000",
+		"temp1_crit":       "100000",
+		"temp1_crit_alarm": "0",
+		"temp2_label":      "Core 0",
+		"temp2_input":      "101000",
+		"temp2_max":        "80000",
+		"temp2_crit":       "100000",
+	})
+	link("devices/coretemp.0", "class/hwmon/hwmon0/device")
+	write("class/hwmon/hwmon1", map[string]string{
+		"name":       "it8728",
+		"in0_input":  "1200",
+		"in0_min":    "1100",
+		"in0_max":    "1300",
+		"fan1_input": "1250",
+		"fan1_min":   "300",
+		"fan1_alarm": "1",
+		"pwm1":       "128",
+	})
+	return filepath.Join(dir, "class/hwmon")
+}
+
+func TestSensors(t *testing.T) {
+	hwmonPath = fakeHwmon(t)
+	defer os.RemoveAll(filepath.Dir(filepath.Dir(hwmonPath)))
+
+	var b bytes.Buffer
+	if err := run(&b, nil); err != nil {
+		t.Fatal(err)
+	}
+	want := `coretemp-isa-0000
+Adapter: ISA adapter
+Package id 0:      +45.0°C  (max = +80.0°C, crit = +100.0°C)
+Core 0:           +101.0°C  (max = +80.0°C, crit = +100.0°C)  ALARM
+
+it8728-virtual-0
+Adapter: Virtual device
+in0:        1.20 V  (min = 1.10 V, max = 1.30 V)
+fan1:     1250 RPM  (min = 300 RPM)  ALARM
+
+`
+	if b.String() != want {
+		t.Errorf("got:\n%s\nwant:\n%s", b.String(), want)
+	}
+
+	b.Reset()
+	if err := run(&b, []string{"coretemp"}); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(b.String(), "it8728") {
+		t.Errorf("chip filter did not filter:\n%s", b.String())
+	}
+	if err := run(&b, []string{"nonesuch"}); err == nil {
+		t.Errorf("no chips matched, got nil, want error")
+	}
+}
+
+func TestSensorsJSON(t *testing.T) {
+	hwmonPath = fakeHwmon(t)
+	defer os.RemoveAll(filepath.Dir(filepath.Dir(hwmonPath)))
+	*jsonOut = true
+	defer func() { *jsonOut = false }()
+
+	var b bytes.Buffer
+	if err := run(&b, nil); err != nil {
+		t.Fatal(err)
+	}
+	var got map[string]map[string]interface{}
+	if err := json.Unmarshal(b.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	pkg, ok := got["coretemp-isa-0000"]["Package id 0"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("no Package id 0 in %s", b.String())
+	}
+	if pkg["temp1_input"] != 45.0 || pkg["temp1_crit"] != 100.0 || pkg["temp1_crit_alarm"] != 0.0 {
+		t.Errorf("Package id 0 = %v", pkg)
+	}
+	if got["it8728-virtual-0"]["Adapter"] != "Virtual device" {
+		t.Errorf("it8728 = %v", got["it8728-virtual-0"])
+	}
+}
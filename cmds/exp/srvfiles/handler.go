@@ -0,0 +1,176 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"html/template"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+var indexTemplate = template.Must(template.New("index").Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>Index of {{.Path}}</title></head>
+<body>
+<h1>Index of {{.Path}}</h1>
+<table>
+<tr><th align="left">Name</th><th align="right">Size</th><th align="left">Modified</th></tr>
+{{- if ne .Path "/"}}
+<tr><td><a href="../">../</a></td><td></td><td></td></tr>
+{{- end}}
+{{- range .Entries}}
+<tr><td><a href="{{.URL}}">{{.Name}}</a></td><td align="right">{{.Size}}</td><td>{{.ModTime}}</td></tr>
+{{- end}}
+</table>
+</body>
+</html>
+`))
+
+type indexEntry struct {
+	Name    string
+	URL     string
+	Size    string
+	ModTime string
+}
+
+// fileServer is http.FileServer with a more useful directory listing.
+func fileServer(root http.FileSystem) http.Handler {
+	fs := http.FileServer(root)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		p := path.Clean("/" + r.URL.Path)
+		// Let http.FileServer handle files, redirects and directories
+		// with an index.html.
+		if !strings.HasSuffix(r.URL.Path, "/") || hasIndex(root, p) {
+			fs.ServeHTTP(w, r)
+			return
+		}
+		f, err := root.Open(p)
+		if err != nil {
+			fs.ServeHTTP(w, r)
+			return
+		}
+		defer f.Close()
+		fi, err := f.Stat()
+		if err != nil || !fi.IsDir() {
+			fs.ServeHTTP(w, r)
+			return
+		}
+		list, err := f.Readdir(-1)
+		if err != nil {
+			http.Error(w, "error reading directory", http.StatusInternalServerError)
+			return
+		}
+		serveIndex(w, r, p, list)
+	})
+}
+
+func hasIndex(root http.FileSystem, dir string) bool {
+	f, err := root.Open(path.Join(dir, "index.html"))
+	if err != nil {
+		return false
+	}
+	f.Close()
+	return true
+}
+
+func serveIndex(w http.ResponseWriter, r *http.Request, p string, list []os.FileInfo) {
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].IsDir() != list[j].IsDir() {
+			return list[i].IsDir()
+		}
+		return list[i].Name() < list[j].Name()
+	})
+	entries := make([]indexEntry, 0, len(list))
+	for _, fi := range list {
+		e := indexEntry{
+			Name:    fi.Name(),
+			URL:     (&url.URL{Path: fi.Name()}).String(),
+			ModTime: fi.ModTime().UTC().Format("2006-01-02 15:04:05"),
+		}
+		if fi.IsDir() {
+			e.Name += "/"
+			e.URL += "/"
+			e.Size = "-"
+		} else {
+			e.Size = fmt.Sprint(fi.Size())
+		}
+		entries = append(entries, e)
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if r.Method == http.MethodHead {
+		return
+	}
+	indexTemplate.Execute(w, struct {
+		Path    string
+		Entries []indexEntry
+	}{p, entries})
+}
+
+// basicAuth requires the given credentials on every request.
+func basicAuth(h http.Handler, user, password string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		u, p, ok := r.BasicAuth()
+		if !ok ||
+			subtle.ConstantTimeCompare([]byte(u), []byte(user)) != 1 ||
+			subtle.ConstantTimeCompare([]byte(p), []byte(password)) != 1 {
+			w.Header().Set("WWW-Authenticate", `Basic realm="srvfiles"`)
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+// logWriter records the status and size of a response.
+type logWriter struct {
+	http.ResponseWriter
+	status int
+	size   int64
+}
+
+func (l *logWriter) WriteHeader(status int) {
+	l.status = status
+	l.ResponseWriter.WriteHeader(status)
+}
+
+func (l *logWriter) Write(b []byte) (int, error) {
+	if l.status == 0 {
+		l.status = http.StatusOK
+	}
+	n, err := l.ResponseWriter.Write(b)
+	l.size += int64(n)
+	return n, err
+}
+
+// accessLog writes a Common Log Format line to w for every request.
+func accessLog(h http.Handler, w io.Writer) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		lw := &logWriter{ResponseWriter: rw}
+		h.ServeHTTP(lw, r)
+		if lw.status == 0 {
+			lw.status = http.StatusOK
+		}
+		host, _, err := net.SplitHostPort(r.RemoteAddr)
+		if err != nil {
+			host = r.RemoteAddr
+		}
+		user := "-"
+		if u, _, ok := r.BasicAuth(); ok && u != "" {
+			user = u
+		}
+		fmt.Fprintf(w, "%s - %s [%s] %q %d %d\n", host, user,
+			time.Now().Format("02/Jan/2006:15:04:05 -0700"),
+			r.Method+" "+r.RequestURI+" "+r.Proto, lw.status, lw.size)
+	})
+}
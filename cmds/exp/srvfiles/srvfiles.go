@@ -5,32 +5,78 @@
 // Serve files on the network.
 //
 // Synopsis:
-//     srvfiles [--h=HOST] [--p=PORT] [--d=DIR]
+//     srvfiles [--h=HOST] [--p=PORT] [--d=DIR] [--l=ADDR:PORT]... [--root=PREFIX=DIR]...
+//              [--tls [--cert=FILE --key=FILE]] [--auth=USER:PASSWORD] [--log=FILE]
+//
+// Description:
+//     srvfiles serves DIR over HTTP, or HTTPS with --tls. Range requests
+//     are supported, so interrupted downloads can be resumed, and
+//     directories without an index.html are listed with sizes and
+//     modification times.
 //
 // Options:
-//     --h: hostname (default: 127.0.0.1)
-//     --p: port number (default: 8080)
-//     --d: directory to serve (default: .)
+//     --h:    hostname (default: 127.0.0.1)
+//     --p:    port number (default: 8080)
+//     --d:    directory to serve (default: .)
+//     --l:    listen on ADDR:PORT instead of HOST:PORT; may be repeated
+//     --root: also serve DIR under the URL path PREFIX; may be repeated
+//     --tls:  serve HTTPS. Without --cert and --key, a self-signed
+//             certificate is generated at startup and its fingerprint logged
+//     --cert: PEM certificate file
+//     --key:  PEM private key file
+//     --auth: require HTTP basic authentication with these credentials
+//     --log:  write an access log in Common Log Format to FILE, - for stderr
 package main
 
 import (
+	"crypto/tls"
 	"flag"
+	"fmt"
+	"io"
 	"log"
 	"net"
 	"net/http"
+	"os"
+	"strings"
 )
 
+type listFlag []string
+
+func (l *listFlag) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *listFlag) Set(value string) error {
+	*l = append(*l, value)
+	return nil
+}
+
 var (
-	host = flag.String("h", "127.0.0.1", "hostname")
-	port = flag.String("p", "8080", "port number")
-	dir  = flag.String("d", ".", "directory to serve")
+	host    = flag.String("h", "127.0.0.1", "hostname")
+	port    = flag.String("p", "8080", "port number")
+	dir     = flag.String("d", ".", "directory to serve")
+	useTLS  = flag.Bool("tls", false, "serve HTTPS")
+	cert    = flag.String("cert", "", "PEM certificate file")
+	key     = flag.String("key", "", "PEM private key file")
+	auth    = flag.String("auth", "", "require basic authentication as USER:PASSWORD")
+	logFile = flag.String("log", "", "access log file, - for stderr")
+
+	listen listFlag
+	roots  listFlag
 )
 
+func init() {
+	flag.Var(&listen, "l", "listen address ADDR:PORT, may be repeated")
+	flag.Var(&roots, "root", "serve PREFIX=DIR, may be repeated")
+}
+
+// cacheHeaders are removed from requests so clients always get the
+// current file. If-Range is kept: without it, resuming the download of a
+// file that changed would splice two versions together.
 var cacheHeaders = []string{
 	"ETag",
 	"If-Modified-Since",
 	"If-None-Match",
-	"If-Range",
 	"If-Unmodified-Since",
 }
 
@@ -47,8 +93,74 @@ func maxAgeHandler(h http.Handler) http.Handler {
 	})
 }
 
+// newMux serves dir at / and each PREFIX=DIR of roots under PREFIX.
+func newMux(dir string, roots []string) (*http.ServeMux, error) {
+	mux := http.NewServeMux()
+	mux.Handle("/", maxAgeHandler(fileServer(http.Dir(dir))))
+	for _, r := range roots {
+		i := strings.IndexByte(r, '=')
+		if i < 0 {
+			return nil, fmt.Errorf("root %q: want PREFIX=DIR", r)
+		}
+		prefix := "/" + strings.Trim(r[:i], "/")
+		if prefix == "/" {
+			return nil, fmt.Errorf("root %q: use -d to set the directory served at /", r)
+		}
+		mux.Handle(prefix+"/", http.StripPrefix(prefix, maxAgeHandler(fileServer(http.Dir(r[i+1:])))))
+	}
+	return mux, nil
+}
+
 func main() {
 	flag.Parse()
-	http.Handle("/", maxAgeHandler(http.FileServer(http.Dir(*dir))))
-	log.Fatal(http.ListenAndServe(net.JoinHostPort(*host, *port), nil))
+
+	mux, err := newMux(*dir, roots)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var h http.Handler = mux
+	if *auth != "" {
+		i := strings.IndexByte(*auth, ':')
+		if i < 0 {
+			log.Fatalf("-auth must be USER:PASSWORD")
+		}
+		h = basicAuth(h, (*auth)[:i], (*auth)[i+1:])
+	}
+	if *logFile != "" {
+		var w io.Writer = os.Stderr
+		if *logFile != "-" {
+			f, err := os.OpenFile(*logFile, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+			if err != nil {
+				log.Fatal(err)
+			}
+			defer f.Close()
+			w = f
+		}
+		h = accessLog(h, w)
+	}
+
+	var tlsConfig *tls.Config
+	if *useTLS {
+		c, err := loadOrGenerateCert(*cert, *key, *host)
+		if err != nil {
+			log.Fatal(err)
+		}
+		tlsConfig = &tls.Config{Certificates: []tls.Certificate{c}}
+	}
+
+	if len(listen) == 0 {
+		listen = listFlag{net.JoinHostPort(*host, *port)}
+	}
+	errs := make(chan error, len(listen))
+	for _, addr := range listen {
+		s := &http.Server{Addr: addr, Handler: h, TLSConfig: tlsConfig}
+		go func() {
+			if tlsConfig != nil {
+				errs <- s.ListenAndServeTLS("", "")
+			} else {
+				errs <- s.ListenAndServe()
+			}
+		}()
+	}
+	log.Fatal(<-errs)
 }
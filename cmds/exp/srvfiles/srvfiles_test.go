@@ -0,0 +1,164 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"crypto/x509"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func setup(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "srvfiles")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range map[string]string{
+		"disk.img":           "0123456789",
+		"sub/a.txt":          "a",
+		"www/index.html":     "hello",
+		"images/initramfs.c": "cpio",
+	} {
+		p := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(p, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir, func() { os.RemoveAll(dir) }
+}
+
+func get(t *testing.T, h http.Handler, path string, hdr map[string]string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(http.MethodGet, path, nil)
+	for k, v := range hdr {
+		r.Header.Set(k, v)
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	return w
+}
+
+func TestServe(t *testing.T) {
+	dir, cleanup := setup(t)
+	defer cleanup()
+	mux, err := newMux(dir, []string{"/boot=" + filepath.Join(dir, "images")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		path   string
+		hdr    map[string]string
+		status int
+		body   string
+	}{
+		{path: "/disk.img", status: http.StatusOK, body: "0123456789"},
+		{path: "/disk.img", hdr: map[string]string{"Range": "bytes=4-"}, status: http.StatusPartialContent, body: "456789"},
+		{path: "/disk.img", hdr: map[string]string{"Range": "bytes=2-3"}, status: http.StatusPartialContent, body: "23"},
+		{path: "/disk.img", hdr: map[string]string{"If-Modified-Since": time.Now().Add(time.Hour).UTC().Format(http.TimeFormat)}, status: http.StatusOK, body: "0123456789"},
+		{path: "/www/", status: http.StatusOK, body: "hello"},
+		{path: "/boot/initramfs.c", status: http.StatusOK, body: "cpio"},
+		{path: "/sub", status: http.StatusMovedPermanently},
+		{path: "/nope", status: http.StatusNotFound},
+	} {
+		w := get(t, mux, tt.path, tt.hdr)
+		if w.Code != tt.status {
+			t.Errorf("GET %s %v: status %d, want %d", tt.path, tt.hdr, w.Code, tt.status)
+			continue
+		}
+		if tt.body != "" && w.Body.String() != tt.body {
+			t.Errorf("GET %s %v: body %q, want %q", tt.path, tt.hdr, w.Body.String(), tt.body)
+		}
+	}
+
+	w := get(t, mux, "/", nil)
+	body := w.Body.String()
+	for _, want := range []string{`<a href="images/">images/</a>`, `<a href="disk.img">disk.img</a></td><td align="right">10</td>`} {
+		if !strings.Contains(body, want) {
+			t.Errorf("index does not contain %q:\n%s", want, body)
+		}
+	}
+	if strings.Index(body, "sub/") > strings.Index(body, "disk.img") {
+		t.Errorf("directories are not listed first:\n%s", body)
+	}
+
+	if _, err := newMux(dir, []string{"boot"}); err == nil {
+		t.Errorf("newMux accepted a root without a directory")
+	}
+}
+
+func TestAuthAndLog(t *testing.T) {
+	dir, cleanup := setup(t)
+	defer cleanup()
+	mux, err := newMux(dir, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var log bytes.Buffer
+	h := accessLog(basicAuth(mux, "admin", "s3cret"), &log)
+
+	if w := get(t, h, "/disk.img", nil); w.Code != http.StatusUnauthorized || w.Header().Get("WWW-Authenticate") == "" {
+		t.Errorf("unauthenticated request: status %d, headers %v", w.Code, w.Header())
+	}
+	r := httptest.NewRequest(http.MethodGet, "/disk.img", nil)
+	r.SetBasicAuth("admin", "wrong")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("wrong password: status %d", w.Code)
+	}
+	r.SetBasicAuth("admin", "s3cret")
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	if w.Code != http.StatusOK {
+		t.Errorf("right password: status %d", w.Code)
+	}
+
+	lines := strings.Split(strings.TrimSpace(log.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("got %d log lines, want 3:\n%s", len(lines), log.String())
+	}
+	for i, want := range []string{`- - [`, `- admin [`, `- admin [`} {
+		if !strings.Contains(lines[i], want) {
+			t.Errorf("log line %q does not contain %q", lines[i], want)
+		}
+	}
+	if !strings.HasSuffix(lines[2], `"GET /disk.img HTTP/1.1" 200 10`) {
+		t.Errorf("log line %q does not end in the request, status and size", lines[2])
+	}
+}
+
+func TestSelfSigned(t *testing.T) {
+	now := time.Now()
+	c, err := selfSigned("10.0.0.1", now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	x, err := x509.ParseCertificate(c.Certificate[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := x.VerifyHostname("10.0.0.1"); err != nil {
+		t.Error(err)
+	}
+	if err := x.VerifyHostname("localhost"); err != nil {
+		t.Error(err)
+	}
+	if x.NotAfter.Before(now.AddDate(0, 11, 0)) {
+		t.Errorf("certificate expires too soon: %v", x.NotAfter)
+	}
+	if _, err := loadOrGenerateCert("cert.pem", "", ""); err == nil {
+		t.Errorf("loadOrGenerateCert with only a certificate succeeded")
+	}
+}
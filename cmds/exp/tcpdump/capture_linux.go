@@ -0,0 +1,106 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"golang.org/x/net/bpf"
+	"golang.org/x/sys/unix"
+)
+
+func htons(v uint16) uint16 {
+	return v<<8 | v>>8
+}
+
+// capture is an AF_PACKET socket with a BPF filter attached.
+type capture struct {
+	fd    int
+	buf   []byte
+	close sync.Once
+}
+
+// openCapture opens a capture on iface, or all interfaces if iface is
+// empty. The filter is attached before the socket is bound so no
+// unfiltered packets are queued.
+func openCapture(iface string, prog []bpf.Instruction, snaplen int, promisc bool) (*capture, error) {
+	raw, err := bpf.Assemble(prog)
+	if err != nil {
+		return nil, err
+	}
+	filter := make([]unix.SockFilter, len(raw))
+	for i, r := range raw {
+		filter[i] = unix.SockFilter{Code: r.Op, Jt: r.Jt, Jf: r.Jf, K: r.K}
+	}
+
+	fd, err := unix.Socket(unix.AF_PACKET, unix.SOCK_RAW|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		return nil, fmt.Errorf("opening packet socket: %v", err)
+	}
+	c := &capture{fd: fd, buf: make([]byte, snaplen)}
+	fprog := unix.SockFprog{Len: uint16(len(filter)), Filter: &filter[0]}
+	if err := unix.SetsockoptSockFprog(fd, unix.SOL_SOCKET, unix.SO_ATTACH_FILTER, &fprog); err != nil {
+		c.Close()
+		return nil, fmt.Errorf("attaching filter: %v", err)
+	}
+
+	var ifindex int
+	if iface != "" {
+		ifc, err := net.InterfaceByName(iface)
+		if err != nil {
+			c.Close()
+			return nil, err
+		}
+		ifindex = ifc.Index
+	}
+	sa := &unix.SockaddrLinklayer{Protocol: htons(unix.ETH_P_ALL), Ifindex: ifindex}
+	if err := unix.Bind(fd, sa); err != nil {
+		c.Close()
+		return nil, fmt.Errorf("binding to %q: %v", iface, err)
+	}
+	if promisc && ifindex != 0 {
+		mreq := &unix.PacketMreq{Ifindex: int32(ifindex), Type: unix.PACKET_MR_PROMISC}
+		if err := unix.SetsockoptPacketMreq(fd, unix.SOL_PACKET, unix.PACKET_ADD_MEMBERSHIP, mreq); err != nil {
+			c.Close()
+			return nil, fmt.Errorf("enabling promiscuous mode: %v", err)
+		}
+	}
+	return c, nil
+}
+
+// Next blocks until the next packet arrives and returns its capture time,
+// its (possibly truncated) contents and its length on the wire. The
+// returned slice is only valid until the next call.
+func (c *capture) Next() (time.Time, []byte, int, error) {
+	for {
+		n, from, err := unix.Recvfrom(c.fd, c.buf, unix.MSG_TRUNC)
+		if err == unix.EINTR {
+			continue
+		}
+		if err != nil {
+			return time.Time{}, nil, 0, err
+		}
+		// Loopback packets are seen both on the way out and on the
+		// way back in; only report them once.
+		if ll, ok := from.(*unix.SockaddrLinklayer); ok && ll.Hatype == unix.ARPHRD_LOOPBACK && ll.Pkttype == unix.PACKET_OUTGOING {
+			continue
+		}
+		l := n
+		if l > len(c.buf) {
+			l = len(c.buf)
+		}
+		return time.Now(), c.buf[:l], n, nil
+	}
+}
+
+// Close closes the socket. It is safe to call more than once.
+func (c *capture) Close() error {
+	var err error
+	c.close.Do(func() { err = unix.Close(c.fd) })
+	return err
+}
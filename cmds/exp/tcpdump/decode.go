@@ -0,0 +1,258 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/insomniacslk/dhcp/dhcpv4"
+	"github.com/insomniacslk/dhcp/dhcpv6"
+)
+
+// decode returns a one-line summary of an Ethernet frame.
+func decode(b []byte, showEther bool) string {
+	if len(b) < ethHdrLen {
+		return fmt.Sprintf("truncated frame, length %d", len(b))
+	}
+	typ := binary.BigEndian.Uint16(b[ethTypeOff:])
+	var s string
+	payload := b[ethHdrLen:]
+	switch typ {
+	case etherTypeARP:
+		s = decodeARP(payload)
+	case etherTypeIPv4:
+		s = decodeIPv4(payload)
+	case etherTypeIPv6:
+		s = decodeIPv6(payload)
+	default:
+		s = fmt.Sprintf("ethertype 0x%04x, length %d", typ, len(b))
+	}
+	if showEther {
+		return fmt.Sprintf("%v > %v, %s", net.HardwareAddr(b[6:12]), net.HardwareAddr(b[0:6]), s)
+	}
+	return s
+}
+
+func decodeARP(b []byte) string {
+	// Only Ethernet/IPv4 ARP is decoded.
+	if len(b) < 28 || binary.BigEndian.Uint16(b[0:]) != 1 || binary.BigEndian.Uint16(b[2:]) != etherTypeIPv4 {
+		return fmt.Sprintf("ARP, length %d", len(b))
+	}
+	sha, spa, tpa := net.HardwareAddr(b[8:14]), net.IP(b[14:18]), net.IP(b[24:28])
+	switch op := binary.BigEndian.Uint16(b[6:]); op {
+	case 1:
+		return fmt.Sprintf("ARP, Request who-has %v tell %v, length %d", tpa, spa, len(b))
+	case 2:
+		return fmt.Sprintf("ARP, Reply %v is-at %v, length %d", spa, sha, len(b))
+	default:
+		return fmt.Sprintf("ARP, op %d, length %d", op, len(b))
+	}
+}
+
+func decodeIPv4(b []byte) string {
+	if len(b) < 20 {
+		return fmt.Sprintf("IP truncated, length %d", len(b))
+	}
+	ihl := int(b[0]&0xf) * 4
+	total := int(binary.BigEndian.Uint16(b[2:]))
+	if ihl < 20 || len(b) < ihl {
+		return fmt.Sprintf("IP bad header length %d", ihl)
+	}
+	if total >= ihl && total < len(b) {
+		// Strip Ethernet padding.
+		b = b[:total]
+	}
+	src, dst := net.IP(b[12:16]), net.IP(b[16:20])
+	if frag := binary.BigEndian.Uint16(b[6:]) & 0x1fff; frag != 0 {
+		return fmt.Sprintf("IP %v > %v: fragment offset %d, length %d", src, dst, frag*8, len(b)-ihl)
+	}
+	return "IP " + decodeTransport(b[9], src, dst, b[ihl:])
+}
+
+func decodeIPv6(b []byte) string {
+	if len(b) < ip6HdrLen {
+		return fmt.Sprintf("IP6 truncated, length %d", len(b))
+	}
+	src, dst := net.IP(b[8:24]), net.IP(b[24:40])
+	return "IP6 " + decodeTransport(b[6], src, dst, b[ip6HdrLen:])
+}
+
+func decodeTransport(proto byte, src, dst net.IP, b []byte) string {
+	switch proto {
+	case protoTCP:
+		return decodeTCP(src, dst, b)
+	case protoUDP:
+		return decodeUDP(src, dst, b)
+	case protoICMP, protoICMPv6:
+		if len(b) < 4 {
+			return fmt.Sprintf("%v > %v: ICMP truncated", src, dst)
+		}
+		return fmt.Sprintf("%v > %v: %s, length %d", src, dst, icmpType(proto, b[0], b[1]), len(b))
+	}
+	return fmt.Sprintf("%v > %v: ip-proto-%d, length %d", src, dst, proto, len(b))
+}
+
+func hostPort(ip net.IP, port uint16) string {
+	return fmt.Sprintf("%v.%d", ip, port)
+}
+
+var tcpFlags = []struct {
+	bit  byte
+	name string
+}{
+	{0x02, "S"},
+	{0x01, "F"},
+	{0x04, "R"},
+	{0x08, "P"},
+	{0x20, "U"},
+	{0x10, "."},
+}
+
+func decodeTCP(src, dst net.IP, b []byte) string {
+	if len(b) < 20 {
+		return fmt.Sprintf("%v > %v: TCP truncated", src, dst)
+	}
+	sport, dport := binary.BigEndian.Uint16(b[0:]), binary.BigEndian.Uint16(b[2:])
+	seq, ack := binary.BigEndian.Uint32(b[4:]), binary.BigEndian.Uint32(b[8:])
+	off := int(b[12]>>4) * 4
+	var flags strings.Builder
+	for _, f := range tcpFlags {
+		if b[13]&f.bit != 0 {
+			flags.WriteString(f.name)
+		}
+	}
+	win := binary.BigEndian.Uint16(b[14:])
+	length := len(b) - off
+	if length < 0 {
+		length = 0
+	}
+	s := fmt.Sprintf("%s > %s: Flags [%s], seq %d", hostPort(src, sport), hostPort(dst, dport), flags.String(), seq)
+	if b[13]&0x10 != 0 {
+		s += fmt.Sprintf(", ack %d", ack)
+	}
+	return s + fmt.Sprintf(", win %d, length %d", win, length)
+}
+
+func decodeUDP(src, dst net.IP, b []byte) string {
+	if len(b) < 8 {
+		return fmt.Sprintf("%v > %v: UDP truncated", src, dst)
+	}
+	sport, dport := binary.BigEndian.Uint16(b[0:]), binary.BigEndian.Uint16(b[2:])
+	payload := b[8:]
+	s := fmt.Sprintf("%s > %s: ", hostPort(src, sport), hostPort(dst, dport))
+	switch {
+	case (sport == 67 || sport == 68) && (dport == 67 || dport == 68):
+		return s + decodeDHCPv4(payload)
+	case (sport == 546 || sport == 547) && (dport == 546 || dport == 547):
+		return s + decodeDHCPv6(payload)
+	case sport == 69 || dport == 69:
+		return s + decodeTFTP(payload)
+	}
+	return s + fmt.Sprintf("UDP, length %d", len(payload))
+}
+
+func decodeDHCPv4(b []byte) string {
+	m, err := dhcpv4.FromBytes(b)
+	if err != nil {
+		return fmt.Sprintf("BOOTP/DHCP, malformed: %v", err)
+	}
+	s := fmt.Sprintf("BOOTP/DHCP, %s", m.OpCode)
+	if mt := m.MessageType(); mt != dhcpv4.MessageTypeNone {
+		s += " " + mt.String()
+	}
+	s += fmt.Sprintf(" from %v, xid %s", m.ClientHWAddr, m.TransactionID)
+	if ip := m.YourIPAddr; ip != nil && !ip.IsUnspecified() {
+		s += fmt.Sprintf(", yiaddr %v", ip)
+	}
+	if ip := m.ServerIPAddr; ip != nil && !ip.IsUnspecified() {
+		s += fmt.Sprintf(", siaddr %v", ip)
+	}
+	if f := m.BootFileName; f != "" {
+		s += fmt.Sprintf(", file %q", f)
+	} else if f := m.BootFileNameOption(); f != "" {
+		s += fmt.Sprintf(", file %q", f)
+	}
+	return s + fmt.Sprintf(", length %d", len(b))
+}
+
+func decodeDHCPv6(b []byte) string {
+	m, err := dhcpv6.FromBytes(b)
+	if err != nil {
+		return fmt.Sprintf("DHCPv6, malformed: %v", err)
+	}
+	if msg, ok := m.(*dhcpv6.Message); ok {
+		return fmt.Sprintf("DHCPv6 %s, xid %s, length %d", msg.MessageType, msg.TransactionID, len(b))
+	}
+	return fmt.Sprintf("DHCPv6 %s, length %d", m.Type(), len(b))
+}
+
+func decodeTFTP(b []byte) string {
+	if len(b) < 2 {
+		return "TFTP truncated"
+	}
+	op := binary.BigEndian.Uint16(b)
+	switch op {
+	case 1, 2:
+		name := b[2:]
+		if i := strings.IndexByte(string(name), 0); i >= 0 {
+			name = name[:i]
+		}
+		req := "RRQ"
+		if op == 2 {
+			req = "WRQ"
+		}
+		return fmt.Sprintf("TFTP, %s %q, length %d", req, name, len(b))
+	case 3, 4:
+		if len(b) < 4 {
+			return "TFTP truncated"
+		}
+		kind := "DATA"
+		if op == 4 {
+			kind = "ACK"
+		}
+		return fmt.Sprintf("TFTP, %s block %d, length %d", kind, binary.BigEndian.Uint16(b[2:]), len(b))
+	case 5:
+		return fmt.Sprintf("TFTP, ERROR %q, length %d", strings.TrimRight(string(b[4:]), "\x00"), len(b))
+	case 6:
+		return fmt.Sprintf("TFTP, OACK, length %d", len(b))
+	}
+	return fmt.Sprintf("TFTP, opcode %d, length %d", op, len(b))
+}
+
+func icmpType(proto, typ, code byte) string {
+	if proto == protoICMP {
+		switch typ {
+		case 0:
+			return "ICMP echo reply"
+		case 3:
+			return fmt.Sprintf("ICMP unreachable, code %d", code)
+		case 8:
+			return "ICMP echo request"
+		case 11:
+			return "ICMP time exceeded"
+		}
+		return fmt.Sprintf("ICMP type %d, code %d", typ, code)
+	}
+	switch typ {
+	case 1:
+		return fmt.Sprintf("ICMP6 unreachable, code %d", code)
+	case 128:
+		return "ICMP6 echo request"
+	case 129:
+		return "ICMP6 echo reply"
+	case 133:
+		return "ICMP6 router solicitation"
+	case 134:
+		return "ICMP6 router advertisement"
+	case 135:
+		return "ICMP6 neighbor solicitation"
+	case 136:
+		return "ICMP6 neighbor advertisement"
+	}
+	return fmt.Sprintf("ICMP6 type %d, code %d", typ, code)
+}
@@ -0,0 +1,502 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/bpf"
+)
+
+// The filter language is a small subset of pcap-filter(7):
+//
+//	expr    := term { ("or" | "||") term }
+//	term    := factor { ["and" | "&&"] factor }
+//	factor  := ("not" | "!") factor | "(" expr ")" | primitive
+//	primitive :=
+//		"ether" | "arp" | "ip" | "ip6" | "tcp" | "udp" | "icmp" | "icmp6" |
+//		[dir] "host" ADDR | [dir] "net" CIDR | [dir] "port" PORT |
+//		[dir] ADDR | [dir] PORT
+//	dir := "src" | "dst"
+//
+// Adjacent factors without an operator are implicitly and-ed, so
+// "udp port 67" means "udp and port 67". Link-layer framing is assumed to
+// be Ethernet without VLAN tags. Transport protocols are matched over both
+// IPv4 and IPv6; IPv6 extension headers are not followed.
+
+// Frame offsets, relative to the start of the Ethernet header.
+const (
+	ethTypeOff = 12
+	ethHdrLen  = 14
+
+	ip4ProtoOff = ethHdrLen + 9
+	ip4FragOff  = ethHdrLen + 6
+	ip4SrcOff   = ethHdrLen + 12
+	ip4DstOff   = ethHdrLen + 16
+
+	ip6NextOff = ethHdrLen + 6
+	ip6SrcOff  = ethHdrLen + 8
+	ip6DstOff  = ethHdrLen + 24
+	ip6HdrLen  = 40
+)
+
+// Ethertypes and IP protocol numbers.
+const (
+	etherTypeIPv4 = 0x0800
+	etherTypeARP  = 0x0806
+	etherTypeIPv6 = 0x86dd
+
+	protoICMP   = 1
+	protoTCP    = 6
+	protoUDP    = 17
+	protoICMPv6 = 58
+)
+
+// direction qualifies host, net and port primitives.
+type direction int
+
+const (
+	dirAny direction = iota
+	dirSrc
+	dirDst
+)
+
+// node is a parsed filter expression.
+type node interface{}
+
+type (
+	andNode struct{ l, r node }
+	orNode  struct{ l, r node }
+	notNode struct{ n node }
+
+	// trueNode matches every packet.
+	trueNode struct{}
+
+	// loadNode compares the value of the given size at an absolute
+	// offset, optionally masked, with val.
+	loadNode struct {
+		off, size int
+		mask, val uint32
+	}
+
+	// fragNode matches IPv4 packets which are not the first fragment.
+	fragNode struct{}
+
+	// port4Node compares the 16 bit value at off into the IPv4 payload.
+	port4Node struct {
+		off int
+		val uint32
+	}
+)
+
+func and(l, r node) node { return andNode{l, r} }
+func or(l, r node) node  { return orNode{l, r} }
+
+func etherType(t uint32) node {
+	return loadNode{off: ethTypeOff, size: 2, val: t}
+}
+
+func ipProto(p uint32) node {
+	return or(
+		and(etherType(etherTypeIPv4), loadNode{off: ip4ProtoOff, size: 1, val: p}),
+		and(etherType(etherTypeIPv6), loadNode{off: ip6NextOff, size: 1, val: p}),
+	)
+}
+
+func hostNode(dir direction, ip net.IP) node {
+	if ip4 := ip.To4(); ip4 != nil {
+		m := net.CIDRMask(32, 32)
+		return netNode(dir, &net.IPNet{IP: ip4, Mask: m})
+	}
+	match := func(off int) node {
+		var n node = etherType(etherTypeIPv6)
+		for i := 0; i < 16; i += 4 {
+			w := uint32(ip[i])<<24 | uint32(ip[i+1])<<16 | uint32(ip[i+2])<<8 | uint32(ip[i+3])
+			n = and(n, loadNode{off: off + i, size: 4, val: w})
+		}
+		return n
+	}
+	return directed(dir, match(ip6SrcOff), match(ip6DstOff))
+}
+
+func netNode(dir direction, n *net.IPNet) node {
+	ip, mask := n.IP.To4(), net.IP(n.Mask).To4()
+	if ip == nil || mask == nil {
+		// TODO: IPv6 networks.
+		return nil
+	}
+	m := uint32(mask[0])<<24 | uint32(mask[1])<<16 | uint32(mask[2])<<8 | uint32(mask[3])
+	v := uint32(ip[0])<<24 | uint32(ip[1])<<16 | uint32(ip[2])<<8 | uint32(ip[3])
+	match := func(off int) node {
+		return and(etherType(etherTypeIPv4), loadNode{off: off, size: 4, mask: m, val: v & m})
+	}
+	return directed(dir, match(ip4SrcOff), match(ip4DstOff))
+}
+
+func portNode(dir direction, port uint32) node {
+	v4 := and(etherType(etherTypeIPv4),
+		and(or(loadNode{off: ip4ProtoOff, size: 1, val: protoTCP}, loadNode{off: ip4ProtoOff, size: 1, val: protoUDP}),
+			and(notNode{fragNode{}}, directed(dir, port4Node{off: 0, val: port}, port4Node{off: 2, val: port}))))
+	l4 := ethHdrLen + ip6HdrLen
+	v6 := and(etherType(etherTypeIPv6),
+		and(or(loadNode{off: ip6NextOff, size: 1, val: protoTCP}, loadNode{off: ip6NextOff, size: 1, val: protoUDP}),
+			directed(dir, loadNode{off: l4, size: 2, val: port}, loadNode{off: l4 + 2, size: 2, val: port})))
+	return or(v4, v6)
+}
+
+func directed(dir direction, src, dst node) node {
+	switch dir {
+	case dirSrc:
+		return src
+	case dirDst:
+		return dst
+	}
+	return or(src, dst)
+}
+
+// tokenize splits a filter expression into tokens. Parentheses and "!"
+// need not be separated by spaces.
+func tokenize(s string) []string {
+	var toks []string
+	for _, f := range strings.Fields(s) {
+		for f != "" {
+			i := strings.IndexAny(f, "()!")
+			switch {
+			case i < 0:
+				toks = append(toks, f)
+				f = ""
+			case i > 0:
+				toks = append(toks, f[:i])
+				f = f[i:]
+			default:
+				toks = append(toks, f[:1])
+				f = f[1:]
+			}
+		}
+	}
+	return toks
+}
+
+type parser struct {
+	toks []string
+	pos  int
+}
+
+func (p *parser) peek() string {
+	if p.pos < len(p.toks) {
+		return p.toks[p.pos]
+	}
+	return ""
+}
+
+func (p *parser) next() string {
+	t := p.peek()
+	if t != "" {
+		p.pos++
+	}
+	return t
+}
+
+// parseFilter parses a filter expression. An empty expression matches
+// every packet.
+func parseFilter(expr string) (node, error) {
+	p := &parser{toks: tokenize(expr)}
+	if len(p.toks) == 0 {
+		return trueNode{}, nil
+	}
+	n, err := p.expr()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t != "" {
+		return nil, fmt.Errorf("unexpected %q in filter", t)
+	}
+	return n, nil
+}
+
+func (p *parser) expr() (node, error) {
+	n, err := p.term()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek() == "or" || p.peek() == "||" {
+		p.next()
+		r, err := p.term()
+		if err != nil {
+			return nil, err
+		}
+		n = or(n, r)
+	}
+	return n, nil
+}
+
+func (p *parser) term() (node, error) {
+	n, err := p.factor()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		switch t := p.peek(); t {
+		case "", "or", "||", ")":
+			return n, nil
+		case "and", "&&":
+			p.next()
+		}
+		r, err := p.factor()
+		if err != nil {
+			return nil, err
+		}
+		n = and(n, r)
+	}
+}
+
+func (p *parser) factor() (node, error) {
+	switch t := p.next(); t {
+	case "":
+		return nil, fmt.Errorf("unexpected end of filter")
+	case "not", "!":
+		n, err := p.factor()
+		if err != nil {
+			return nil, err
+		}
+		return notNode{n}, nil
+	case "(":
+		n, err := p.expr()
+		if err != nil {
+			return nil, err
+		}
+		if p.next() != ")" {
+			return nil, fmt.Errorf("missing ) in filter")
+		}
+		return n, nil
+	default:
+		return p.primitive(t)
+	}
+}
+
+func (p *parser) primitive(t string) (node, error) {
+	switch t {
+	case "ether":
+		return trueNode{}, nil
+	case "arp":
+		return etherType(etherTypeARP), nil
+	case "ip":
+		return etherType(etherTypeIPv4), nil
+	case "ip6":
+		return etherType(etherTypeIPv6), nil
+	case "tcp":
+		return ipProto(protoTCP), nil
+	case "udp":
+		return ipProto(protoUDP), nil
+	case "icmp":
+		return and(etherType(etherTypeIPv4), loadNode{off: ip4ProtoOff, size: 1, val: protoICMP}), nil
+	case "icmp6":
+		return and(etherType(etherTypeIPv6), loadNode{off: ip6NextOff, size: 1, val: protoICMPv6}), nil
+	}
+
+	dir := dirAny
+	switch t {
+	case "src":
+		dir = dirSrc
+		t = p.next()
+	case "dst":
+		dir = dirDst
+		t = p.next()
+	}
+
+	kind := ""
+	switch t {
+	case "host", "net", "port":
+		kind = t
+		t = p.next()
+	}
+	if t == "" {
+		return nil, fmt.Errorf("unexpected end of filter")
+	}
+
+	switch kind {
+	case "host":
+		ip := net.ParseIP(t)
+		if ip == nil {
+			return nil, fmt.Errorf("invalid host %q", t)
+		}
+		return hostNode(dir, ip), nil
+	case "net":
+		_, n, err := net.ParseCIDR(t)
+		if err != nil {
+			return nil, fmt.Errorf("invalid net %q: %v", t, err)
+		}
+		nn := netNode(dir, n)
+		if nn == nil {
+			return nil, fmt.Errorf("only IPv4 networks are supported, got %q", t)
+		}
+		return nn, nil
+	case "port":
+		port, err := strconv.ParseUint(t, 10, 16)
+		if err != nil {
+			return nil, fmt.Errorf("invalid port %q", t)
+		}
+		return portNode(dir, uint32(port)), nil
+	}
+
+	// Bare addresses and ports, as in "src 10.0.0.1" or "dst 80".
+	if ip := net.ParseIP(t); ip != nil {
+		return hostNode(dir, ip), nil
+	}
+	if port, err := strconv.ParseUint(t, 10, 16); err == nil {
+		return portNode(dir, uint32(port)), nil
+	}
+	return nil, fmt.Errorf("unknown filter primitive %q", t)
+}
+
+// Labels in generated code. The two final labels are fixed.
+const (
+	labelAccept = -1
+	labelReject = -2
+)
+
+// insn is an instruction whose jump targets are still symbolic labels.
+type insn struct {
+	bpf.Instruction
+	// For conditional jumps.
+	jt, jf int
+	// For unconditional jumps.
+	ja int
+	// label marks a position rather than an instruction.
+	label   int
+	isLabel bool
+}
+
+type compiler struct {
+	prog   []insn
+	labels int
+}
+
+func (c *compiler) newLabel() int {
+	c.labels++
+	return c.labels
+}
+
+func (c *compiler) emit(i bpf.Instruction) {
+	c.prog = append(c.prog, insn{Instruction: i})
+}
+
+func (c *compiler) jumpIf(j bpf.JumpIf, t, f int) {
+	c.prog = append(c.prog, insn{Instruction: j, jt: t, jf: f})
+}
+
+func (c *compiler) jump(l int) {
+	c.prog = append(c.prog, insn{Instruction: bpf.Jump{}, ja: l})
+}
+
+func (c *compiler) place(l int) {
+	c.prog = append(c.prog, insn{label: l, isLabel: true})
+}
+
+// gen emits code that continues at label t if n matches, or f otherwise.
+func (c *compiler) gen(n node, t, f int) {
+	switch n := n.(type) {
+	case trueNode:
+		c.jump(t)
+	case andNode:
+		mid := c.newLabel()
+		c.gen(n.l, mid, f)
+		c.place(mid)
+		c.gen(n.r, t, f)
+	case orNode:
+		mid := c.newLabel()
+		c.gen(n.l, t, mid)
+		c.place(mid)
+		c.gen(n.r, t, f)
+	case notNode:
+		c.gen(n.n, f, t)
+	case loadNode:
+		c.emit(bpf.LoadAbsolute{Off: uint32(n.off), Size: n.size})
+		if n.mask != 0 {
+			c.emit(bpf.ALUOpConstant{Op: bpf.ALUOpAnd, Val: n.mask})
+		}
+		c.jumpIf(bpf.JumpIf{Cond: bpf.JumpEqual, Val: n.val}, t, f)
+	case fragNode:
+		c.emit(bpf.LoadAbsolute{Off: ip4FragOff, Size: 2})
+		c.jumpIf(bpf.JumpIf{Cond: bpf.JumpBitsSet, Val: 0x1fff}, t, f)
+	case port4Node:
+		c.emit(bpf.LoadMemShift{Off: ethHdrLen})
+		c.emit(bpf.LoadIndirect{Off: uint32(ethHdrLen + n.off), Size: 2})
+		c.jumpIf(bpf.JumpIf{Cond: bpf.JumpEqual, Val: n.val}, t, f)
+	default:
+		panic(fmt.Sprintf("unknown filter node %T", n))
+	}
+}
+
+// compileFilter compiles a filter expression into a classic BPF program
+// which accepts up to snaplen bytes of matching packets.
+func compileFilter(expr string, snaplen uint32) ([]bpf.Instruction, error) {
+	n, err := parseFilter(expr)
+	if err != nil {
+		return nil, err
+	}
+	c := &compiler{}
+	c.gen(n, labelAccept, labelReject)
+	c.place(labelAccept)
+	c.emit(bpf.RetConstant{Val: snaplen})
+	c.place(labelReject)
+	c.emit(bpf.RetConstant{Val: 0})
+	return c.link()
+}
+
+// link resolves labels into relative jump offsets.
+func (c *compiler) link() ([]bpf.Instruction, error) {
+	pos := make(map[int]int)
+	n := 0
+	for _, i := range c.prog {
+		if i.isLabel {
+			pos[i.label] = n
+		} else {
+			n++
+		}
+	}
+	var prog []bpf.Instruction
+	for _, i := range c.prog {
+		if i.isLabel {
+			continue
+		}
+		pc := len(prog)
+		skip := func(l int) (uint32, error) {
+			s := pos[l] - pc - 1
+			if s < 0 {
+				return 0, fmt.Errorf("internal error: backward jump")
+			}
+			return uint32(s), nil
+		}
+		switch in := i.Instruction.(type) {
+		case bpf.JumpIf:
+			st, err := skip(i.jt)
+			if err != nil {
+				return nil, err
+			}
+			sf, err := skip(i.jf)
+			if err != nil {
+				return nil, err
+			}
+			if st > 255 || sf > 255 {
+				return nil, fmt.Errorf("filter expression is too complex")
+			}
+			in.SkipTrue, in.SkipFalse = uint8(st), uint8(sf)
+			prog = append(prog, in)
+		case bpf.Jump:
+			s, err := skip(i.ja)
+			if err != nil {
+				return nil, err
+			}
+			prog = append(prog, bpf.Jump{Skip: s})
+		default:
+			prog = append(prog, in)
+		}
+	}
+	return prog, nil
+}
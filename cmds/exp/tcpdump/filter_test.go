@@ -0,0 +1,159 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/binary"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/insomniacslk/dhcp/dhcpv4"
+	"golang.org/x/net/bpf"
+)
+
+// udp4 builds an Ethernet/IPv4/UDP frame.
+func udp4(src, dst string, sport, dport uint16, payload []byte) []byte {
+	b := make([]byte, ethHdrLen+20+8+len(payload))
+	binary.BigEndian.PutUint16(b[ethTypeOff:], etherTypeIPv4)
+	ip := b[ethHdrLen:]
+	ip[0] = 0x45
+	binary.BigEndian.PutUint16(ip[2:], uint16(20+8+len(payload)))
+	ip[9] = protoUDP
+	copy(ip[12:], net.ParseIP(src).To4())
+	copy(ip[16:], net.ParseIP(dst).To4())
+	udp := ip[20:]
+	binary.BigEndian.PutUint16(udp[0:], sport)
+	binary.BigEndian.PutUint16(udp[2:], dport)
+	binary.BigEndian.PutUint16(udp[4:], uint16(8+len(payload)))
+	copy(udp[8:], payload)
+	return b
+}
+
+// tcp6 builds an Ethernet/IPv6/TCP SYN frame.
+func tcp6(src, dst string, sport, dport uint16) []byte {
+	b := make([]byte, ethHdrLen+ip6HdrLen+20)
+	binary.BigEndian.PutUint16(b[ethTypeOff:], etherTypeIPv6)
+	ip := b[ethHdrLen:]
+	ip[0] = 0x60
+	binary.BigEndian.PutUint16(ip[4:], 20)
+	ip[6] = protoTCP
+	copy(ip[8:], net.ParseIP(src))
+	copy(ip[24:], net.ParseIP(dst))
+	tcp := ip[ip6HdrLen:]
+	binary.BigEndian.PutUint16(tcp[0:], sport)
+	binary.BigEndian.PutUint16(tcp[2:], dport)
+	tcp[12] = 5 << 4
+	tcp[13] = 0x02
+	return b
+}
+
+func arp() []byte {
+	b := make([]byte, ethHdrLen+28)
+	binary.BigEndian.PutUint16(b[ethTypeOff:], etherTypeARP)
+	a := b[ethHdrLen:]
+	binary.BigEndian.PutUint16(a[0:], 1)
+	binary.BigEndian.PutUint16(a[2:], etherTypeIPv4)
+	a[4], a[5] = 6, 4
+	binary.BigEndian.PutUint16(a[6:], 1)
+	copy(a[14:], net.IPv4(10, 0, 0, 1).To4())
+	copy(a[24:], net.IPv4(10, 0, 0, 2).To4())
+	return b
+}
+
+func TestFilter(t *testing.T) {
+	dhcp := udp4("0.0.0.0", "255.255.255.255", 68, 67, nil)
+	dns := udp4("10.0.0.5", "10.0.0.1", 5353, 53, nil)
+	ssh6 := tcp6("fe80::1", "fe80::2", 40000, 22)
+	arpReq := arp()
+
+	for _, tt := range []struct {
+		expr  string
+		match []bool // dhcp, dns, ssh6, arp
+	}{
+		{"", []bool{true, true, true, true}},
+		{"udp", []bool{true, true, false, false}},
+		{"tcp", []bool{false, false, true, false}},
+		{"arp", []bool{false, false, false, true}},
+		{"ip6", []bool{false, false, true, false}},
+		{"not ip6", []bool{true, true, false, true}},
+		{"port 67", []bool{true, false, false, false}},
+		{"udp port 67 or port 68", []bool{true, false, false, false}},
+		{"src port 67", []bool{false, false, false, false}},
+		{"dst port 67", []bool{true, false, false, false}},
+		{"port 22", []bool{false, false, true, false}},
+		{"host 10.0.0.1", []bool{false, true, false, false}},
+		{"src host 10.0.0.1", []bool{false, false, false, false}},
+		{"dst 10.0.0.1", []bool{false, true, false, false}},
+		{"net 10.0.0.0/24", []bool{false, true, false, false}},
+		{"host fe80::2", []bool{false, false, true, false}},
+		{"src host fe80::2", []bool{false, false, false, false}},
+		{"(udp and !port 53) || arp", []bool{true, false, false, true}},
+		{"not (tcp or arp) and not port 53", []bool{true, false, false, false}},
+	} {
+		prog, err := compileFilter(tt.expr, 65535)
+		if err != nil {
+			t.Errorf("compileFilter(%q): %v", tt.expr, err)
+			continue
+		}
+		vm, err := bpf.NewVM(prog)
+		if err != nil {
+			t.Errorf("compileFilter(%q) produced an invalid program: %v", tt.expr, err)
+			continue
+		}
+		for i, pkt := range [][]byte{dhcp, dns, ssh6, arpReq} {
+			n, err := vm.Run(pkt)
+			if err != nil {
+				t.Errorf("running %q on packet %d: %v", tt.expr, i, err)
+				continue
+			}
+			if got := n > 0; got != tt.match[i] {
+				t.Errorf("filter %q on packet %d: match = %v, want %v", tt.expr, i, got, tt.match[i])
+			}
+		}
+	}
+}
+
+func TestFilterErrors(t *testing.T) {
+	for _, expr := range []string{
+		"port",
+		"port http",
+		"host foo",
+		"(udp",
+		"udp)",
+		"udp or",
+		"net fe80::/64",
+		"frobnicate",
+	} {
+		if _, err := compileFilter(expr, 65535); err == nil {
+			t.Errorf("compileFilter(%q) succeeded, want error", expr)
+		}
+	}
+}
+
+func TestDecode(t *testing.T) {
+	mac := net.HardwareAddr{0x52, 0x54, 0, 0x12, 0x34, 0x56}
+	discover, err := dhcpv4.NewDiscovery(mac)
+	if err != nil {
+		t.Fatal(err)
+	}
+	discover.TransactionID = dhcpv4.TransactionID{0xde, 0xad, 0xbe, 0xef}
+
+	for _, tt := range []struct {
+		pkt  []byte
+		want string
+	}{
+		{arp(), "ARP, Request who-has 10.0.0.2 tell 10.0.0.1"},
+		{udp4("10.0.0.5", "10.0.0.1", 5353, 53, []byte("x")), "IP 10.0.0.5.5353 > 10.0.0.1.53: UDP, length 1"},
+		{udp4("10.0.0.5", "10.0.0.1", 1024, 69, []byte("\x00\x01pxelinux.0\x00octet\x00")), `TFTP, RRQ "pxelinux.0"`},
+		{tcp6("fe80::1", "fe80::2", 40000, 22), "IP6 fe80::1.40000 > fe80::2.22: Flags [S], seq 0, win 0, length 0"},
+		{udp4("0.0.0.0", "255.255.255.255", 68, 67, discover.ToBytes()), "BOOTP/DHCP, BootRequest DISCOVER from 52:54:00:12:34:56, xid 0xdeadbeef"},
+		{[]byte{1, 2, 3}, "truncated frame"},
+	} {
+		if got := decode(tt.pkt, false); !strings.Contains(got, tt.want) {
+			t.Errorf("decode() = %q, want it to contain %q", got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"time"
+)
+
+// pcap file format constants, see
+// https://wiki.wireshark.org/Development/LibpcapFileFormat.
+const (
+	pcapMagic      = 0xa1b2c3d4
+	pcapMagicNsec  = 0xa1b23c4d
+	pcapVersionMaj = 2
+	pcapVersionMin = 4
+	linkTypeEther  = 1
+)
+
+type pcapHeader struct {
+	Magic        uint32
+	VersionMajor uint16
+	VersionMinor uint16
+	ThisZone     int32
+	SigFigs      uint32
+	SnapLen      uint32
+	LinkType     uint32
+}
+
+type pcapRecord struct {
+	Sec     uint32
+	Usec    uint32
+	InclLen uint32
+	OrigLen uint32
+}
+
+// pcapWriter writes packets in the classic libpcap format.
+type pcapWriter struct {
+	w io.Writer
+}
+
+func newPcapWriter(w io.Writer, snaplen uint32) (*pcapWriter, error) {
+	h := pcapHeader{
+		Magic:        pcapMagic,
+		VersionMajor: pcapVersionMaj,
+		VersionMinor: pcapVersionMin,
+		SnapLen:      snaplen,
+		LinkType:     linkTypeEther,
+	}
+	if err := binary.Write(w, binary.LittleEndian, &h); err != nil {
+		return nil, err
+	}
+	return &pcapWriter{w: w}, nil
+}
+
+// WritePacket writes one captured packet. origLen is the length of the
+// packet on the wire, which may exceed len(data) if it was truncated.
+func (p *pcapWriter) WritePacket(t time.Time, data []byte, origLen int) error {
+	r := pcapRecord{
+		Sec:     uint32(t.Unix()),
+		Usec:    uint32(t.Nanosecond() / 1000),
+		InclLen: uint32(len(data)),
+		OrigLen: uint32(origLen),
+	}
+	if err := binary.Write(p.w, binary.LittleEndian, &r); err != nil {
+		return err
+	}
+	_, err := p.w.Write(data)
+	return err
+}
+
+// pcapReader reads packets from a libpcap file of either byte order.
+type pcapReader struct {
+	r     io.Reader
+	order binary.ByteOrder
+	nsec  bool
+	hdr   pcapHeader
+}
+
+func newPcapReader(r io.Reader) (*pcapReader, error) {
+	var magic [4]byte
+	if _, err := io.ReadFull(r, magic[:]); err != nil {
+		return nil, fmt.Errorf("reading pcap header: %v", err)
+	}
+	p := &pcapReader{r: r}
+	for _, o := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+		switch o.Uint32(magic[:]) {
+		case pcapMagic:
+			p.order = o
+		case pcapMagicNsec:
+			p.order, p.nsec = o, true
+		}
+		if p.order != nil {
+			break
+		}
+	}
+	if p.order == nil {
+		return nil, fmt.Errorf("not a pcap file (magic %x)", magic)
+	}
+	p.hdr.Magic = p.order.Uint32(magic[:])
+	var rest [20]byte
+	if _, err := io.ReadFull(r, rest[:]); err != nil {
+		return nil, fmt.Errorf("reading pcap header: %v", err)
+	}
+	p.hdr.VersionMajor = p.order.Uint16(rest[0:])
+	p.hdr.VersionMinor = p.order.Uint16(rest[2:])
+	p.hdr.ThisZone = int32(p.order.Uint32(rest[4:]))
+	p.hdr.SigFigs = p.order.Uint32(rest[8:])
+	p.hdr.SnapLen = p.order.Uint32(rest[12:])
+	p.hdr.LinkType = p.order.Uint32(rest[16:])
+	if p.hdr.LinkType != linkTypeEther {
+		return nil, fmt.Errorf("unsupported pcap link type %d", p.hdr.LinkType)
+	}
+	return p, nil
+}
+
+// ReadPacket returns the next packet, or io.EOF at the end of the file.
+func (p *pcapReader) ReadPacket() (time.Time, []byte, int, error) {
+	var r pcapRecord
+	if err := binary.Read(p.r, p.order, &r); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			err = fmt.Errorf("truncated pcap record")
+		}
+		return time.Time{}, nil, 0, err
+	}
+	if r.InclLen > 1<<24 {
+		return time.Time{}, nil, 0, fmt.Errorf("pcap record too large (%d bytes)", r.InclLen)
+	}
+	data := make([]byte, r.InclLen)
+	if _, err := io.ReadFull(p.r, data); err != nil {
+		return time.Time{}, nil, 0, fmt.Errorf("truncated pcap record: %v", err)
+	}
+	frac := int64(r.Usec) * 1000
+	if p.nsec {
+		frac = int64(r.Usec)
+	}
+	return time.Unix(int64(r.Sec), frac), data, int(r.OrigLen), nil
+}
@@ -0,0 +1,56 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"time"
+)
+
+func TestPcapRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := newPcapWriter(&buf, 1500)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkts := [][]byte{arp(), udp4("10.0.0.1", "10.0.0.2", 1, 2, []byte("hello"))}
+	now := time.Unix(1600000000, 123456000)
+	for i, p := range pkts {
+		if err := w.WritePacket(now.Add(time.Duration(i)*time.Second), p, len(p)+i); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	r, err := newPcapReader(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.hdr.SnapLen != 1500 {
+		t.Errorf("snaplen = %d, want 1500", r.hdr.SnapLen)
+	}
+	for i, p := range pkts {
+		ts, data, orig, err := r.ReadPacket()
+		if err != nil {
+			t.Fatalf("packet %d: %v", i, err)
+		}
+		if want := now.Add(time.Duration(i) * time.Second); !ts.Equal(want) {
+			t.Errorf("packet %d: time = %v, want %v", i, ts, want)
+		}
+		if !bytes.Equal(data, p) || orig != len(p)+i {
+			t.Errorf("packet %d: got %x (orig %d), want %x (orig %d)", i, data, orig, p, len(p)+i)
+		}
+	}
+	if _, _, _, err := r.ReadPacket(); err != io.EOF {
+		t.Errorf("ReadPacket at end = %v, want io.EOF", err)
+	}
+}
+
+func TestPcapBadMagic(t *testing.T) {
+	if _, err := newPcapReader(bytes.NewReader(make([]byte, 24))); err == nil {
+		t.Errorf("newPcapReader accepted a file with a bad magic number")
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// tcpdump captures and decodes network packets.
+//
+// Synopsis:
+//
+//	tcpdump [-i IFACE] [-c COUNT] [-s SNAPLEN] [-w FILE] [-e] [-p] [-d] [EXPRESSION]
+//	tcpdump -r FILE [-c COUNT] [-e] [EXPRESSION]
+//
+// Description:
+//
+//	tcpdump prints a one line summary of every packet matching
+//	EXPRESSION, with decoding for Ethernet, ARP, IPv4, IPv6, ICMP, TCP,
+//	UDP, DHCP, DHCPv6 and TFTP. The expression is compiled to a BPF
+//	program and evaluated in the kernel.
+//
+//	EXPRESSION is a subset of pcap-filter(7): the protocols ether, arp,
+//	ip, ip6, tcp, udp, icmp and icmp6; [src|dst] host ADDR,
+//	[src|dst] net CIDR and [src|dst] port PORT; combined with and, or,
+//	not and parentheses.
+//
+// Options:
+//
+//	-i: interface to capture on (default: all interfaces)
+//	-c: exit after COUNT packets
+//	-s: bytes of each packet to capture (default: 262144)
+//	-w: write raw packets to a pcap file instead of printing them
+//	-r: read packets from a pcap file instead of capturing
+//	-e: print link-level addresses
+//	-p: do not put the interface into promiscuous mode
+//	-d: print the compiled BPF program and exit
+//
+// Example:
+//
+//	Watch a PXE boot:
+//	    tcpdump -i eth0 port 67 or port 68 or port 69
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"golang.org/x/net/bpf"
+)
+
+var (
+	iface     = flag.String("i", "", "interface to capture on")
+	count     = flag.Int("c", 0, "exit after this many packets")
+	snaplen   = flag.Int("s", 262144, "bytes of each packet to capture")
+	writeFile = flag.String("w", "", "write packets to this pcap file")
+	readFile  = flag.String("r", "", "read packets from this pcap file")
+	showEther = flag.Bool("e", false, "print link-level addresses")
+	noPromisc = flag.Bool("p", false, "do not enable promiscuous mode")
+	dump      = flag.Bool("d", false, "print the compiled filter and exit")
+)
+
+// source yields packets from a live capture or a file.
+type source interface {
+	Next() (time.Time, []byte, int, error)
+}
+
+// filteredReader applies a BPF program in userspace to packets read from
+// a pcap file.
+type filteredReader struct {
+	r  *pcapReader
+	vm *bpf.VM
+}
+
+func (f *filteredReader) Next() (time.Time, []byte, int, error) {
+	for {
+		t, data, orig, err := f.r.ReadPacket()
+		if err != nil {
+			return t, data, orig, err
+		}
+		n, err := f.vm.Run(data)
+		if err != nil {
+			return t, data, orig, err
+		}
+		if n == 0 {
+			continue
+		}
+		if n < len(data) {
+			data = data[:n]
+		}
+		return t, data, orig, nil
+	}
+}
+
+func run(expr string, stdout io.Writer, stopc <-chan os.Signal) error {
+	if *snaplen <= 0 {
+		return fmt.Errorf("invalid snaplen %d", *snaplen)
+	}
+	prog, err := compileFilter(expr, uint32(*snaplen))
+	if err != nil {
+		return err
+	}
+	if *dump {
+		for i, in := range prog {
+			fmt.Fprintf(stdout, "(%03d) %v\n", i, in)
+		}
+		return nil
+	}
+
+	var src source
+	stopped := make(chan struct{})
+	if *readFile != "" {
+		f, err := os.Open(*readFile)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r, err := newPcapReader(f)
+		if err != nil {
+			return err
+		}
+		vm, err := bpf.NewVM(prog)
+		if err != nil {
+			return err
+		}
+		src = &filteredReader{r: r, vm: vm}
+	} else {
+		c, err := openCapture(*iface, prog, *snaplen, !*noPromisc)
+		if err != nil {
+			return err
+		}
+		defer c.Close()
+		src = c
+		// Closing the socket unblocks Next on interrupt.
+		go func() {
+			<-stopc
+			close(stopped)
+			c.Close()
+		}()
+		on := *iface
+		if on == "" {
+			on = "all interfaces"
+		}
+		fmt.Fprintf(os.Stderr, "listening on %s, capture size %d bytes\n", on, *snaplen)
+	}
+
+	var pw *pcapWriter
+	if *writeFile != "" {
+		f, err := os.Create(*writeFile)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		if pw, err = newPcapWriter(f, uint32(*snaplen)); err != nil {
+			return err
+		}
+	}
+
+	n := 0
+loop:
+	for *count == 0 || n < *count {
+		t, data, orig, err := src.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			select {
+			case <-stopped:
+				break loop
+			default:
+			}
+			return err
+		}
+		n++
+		if pw != nil {
+			if err := pw.WritePacket(t, data, orig); err != nil {
+				return err
+			}
+			continue
+		}
+		fmt.Fprintf(stdout, "%s %s\n", t.Format("15:04:05.000000"), decode(data, *showEther))
+	}
+	if *readFile == "" {
+		fmt.Fprintf(os.Stderr, "%d packets captured\n", n)
+	}
+	return nil
+}
+
+func main() {
+	flag.Parse()
+	stopc := make(chan os.Signal, 1)
+	signal.Notify(stopc, os.Interrupt)
+	if err := run(strings.Join(flag.Args(), " "), os.Stdout, stopc); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,194 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// tftp transfers files to and from TFTP servers.
+//
+// Synopsis:
+//     tftp [OPTIONS] get HOST[:PORT] REMOTE [LOCAL]
+//     tftp [OPTIONS] put HOST[:PORT] LOCAL [REMOTE]
+//
+// Description:
+//     get fetches REMOTE into LOCAL (default: the base name of REMOTE), put
+//     sends LOCAL to REMOTE (default: the base name of LOCAL). A LOCAL of -
+//     is standard output or input. Besides RFC 1350, the blksize, tsize,
+//     timeout and windowsize options are negotiated.
+//
+// Options:
+//     -b:        block size (default: 512)
+//     -t:        retransmission timeout in seconds (default: 1)
+//     -w:        window size in blocks (default: 1)
+//     -r:        retransmissions per block before giving up (default: 10)
+//     -tsize:    negotiate the transfer size (default: true)
+//     -netascii: use netascii instead of octet mode
+//     -v:        print the number of bytes transferred
+//
+// Example:
+//     tftp -b 1468 get 10.0.0.1 bmc/firmware.bin fw.bin
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"pack.ag/tftp"
+)
+
+var (
+	blksize  = flag.Int("b", 512, "block size")
+	timeout  = flag.Int("t", 1, "retransmission timeout in seconds")
+	window   = flag.Int("w", 1, "window size in blocks")
+	retries  = flag.Int("r", 10, "retransmissions per block")
+	tsize    = flag.Bool("tsize", true, "negotiate transfer size")
+	netascii = flag.Bool("netascii", false, "use netascii mode")
+	verbose  = flag.Bool("v", false, "print the number of bytes transferred")
+)
+
+const usage = "usage: tftp [OPTIONS] get HOST[:PORT] REMOTE [LOCAL] | put HOST[:PORT] LOCAL [REMOTE]"
+
+// tftpURL returns the URL pack.ag/tftp expects for file on host. Bare IPv6
+// addresses are bracketed.
+func tftpURL(host, file string) string {
+	if ip := net.ParseIP(host); ip != nil && ip.To4() == nil {
+		host = "[" + host + "]"
+	}
+	return "tftp://" + host + "/" + strings.TrimPrefix(file, "/")
+}
+
+func client() (*tftp.Client, error) {
+	mode := tftp.ModeOctet
+	if *netascii {
+		mode = tftp.ModeNetASCII
+	}
+	opts := []tftp.ClientOpt{
+		tftp.ClientMode(mode),
+		tftp.ClientTransferSize(*tsize),
+		tftp.ClientRetransmit(*retries),
+	}
+	// Only request options that differ from RFC 1350 so servers without
+	// option support keep working.
+	if *blksize != 512 {
+		opts = append(opts, tftp.ClientBlocksize(*blksize))
+	}
+	if *timeout != 1 {
+		opts = append(opts, tftp.ClientTimeout(*timeout))
+	}
+	if *window != 1 {
+		opts = append(opts, tftp.ClientWindowsize(*window))
+	}
+	return tftp.NewClient(opts...)
+}
+
+func get(c *tftp.Client, host, remote, local string) (int64, error) {
+	r, err := c.Get(tftpURL(host, remote))
+	if err != nil {
+		return 0, err
+	}
+	if local == "-" {
+		return receive(os.Stdout, r)
+	}
+	f, err := os.Create(local)
+	if err != nil {
+		return 0, err
+	}
+	n, err := receive(f, r)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	return n, err
+}
+
+// receive copies a response to w and checks its size against the
+// negotiated tsize.
+func receive(w io.Writer, r *tftp.Response) (int64, error) {
+	n, err := io.Copy(w, r)
+	if err != nil {
+		return n, err
+	}
+	// netascii conversion changes the size.
+	if size, err := r.Size(); err == nil && size != n && !*netascii {
+		return n, fmt.Errorf("received %d bytes, server announced %d", n, size)
+	}
+	return n, nil
+}
+
+func put(c *tftp.Client, host, local, remote string) (int64, error) {
+	var (
+		r    io.Reader = os.Stdin
+		size int64
+	)
+	if local != "-" {
+		f, err := os.Open(local)
+		if err != nil {
+			return 0, err
+		}
+		defer f.Close()
+		fi, err := f.Stat()
+		if err != nil {
+			return 0, err
+		}
+		r, size = f, fi.Size()
+	}
+	cr := &countingReader{r: r}
+	err := c.Put(tftpURL(host, remote), cr, size)
+	return cr.n, err
+}
+
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+func run(args []string) error {
+	if len(args) < 3 || len(args) > 4 {
+		return errors.New(usage)
+	}
+	cmd, host, src := args[0], args[1], args[2]
+	dst := filepath.Base(src)
+	if len(args) == 4 {
+		dst = args[3]
+	}
+	c, err := client()
+	if err != nil {
+		return err
+	}
+	var n int64
+	switch cmd {
+	case "get":
+		n, err = get(c, host, src, dst)
+	case "put":
+		if src == "-" && len(args) == 3 {
+			return errors.New("put from standard input needs a REMOTE name")
+		}
+		n, err = put(c, host, src, dst)
+	default:
+		return errors.New(usage)
+	}
+	if err != nil {
+		return err
+	}
+	if *verbose {
+		log.Printf("%s %s: %d bytes", cmd, src, n)
+	}
+	return nil
+}
+
+func main() {
+	flag.Parse()
+	if err := run(flag.Args()); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"pack.ag/tftp"
+)
+
+func TestTFTPURL(t *testing.T) {
+	for _, tt := range []struct {
+		host, file, want string
+	}{
+		{"10.0.0.1", "pxelinux.0", "tftp://10.0.0.1/pxelinux.0"},
+		{"10.0.0.1:6969", "/boot/kernel", "tftp://10.0.0.1:6969/boot/kernel"},
+		{"fd00::1", "fw.bin", "tftp://[fd00::1]/fw.bin"},
+		{"[fd00::1]:69", "fw.bin", "tftp://[fd00::1]:69/fw.bin"},
+		{"bmc.example.com", "fw.bin", "tftp://bmc.example.com/fw.bin"},
+	} {
+		if got := tftpURL(tt.host, tt.file); got != tt.want {
+			t.Errorf("tftpURL(%q, %q) = %q, want %q", tt.host, tt.file, got, tt.want)
+		}
+	}
+}
+
+func TestGetPut(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tftp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	srv := filepath.Join(dir, "srv")
+	if err := os.Mkdir(srv, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	conn, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Skipf("cannot listen on UDP: %v", err)
+	}
+	s, err := tftp.NewServer("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fs := tftp.FileServer(srv)
+	s.ReadHandler(fs)
+	s.WriteHandler(fs)
+	go s.Serve(conn)
+	defer s.Close()
+	host := conn.LocalAddr().String()
+
+	*blksize, *window = 1024, 4
+	c, err := client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	content := bytes.Repeat([]byte{0, 1, 2, 3, 0xff}, 3000)
+	local := filepath.Join(dir, "image")
+	if err := ioutil.WriteFile(local, content, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := put(c, host, local, "image"); err != nil || n != int64(len(content)) {
+		t.Fatalf("put = %d, %v, want %d bytes", n, err, len(content))
+	}
+	back := filepath.Join(dir, "back")
+	if n, err := get(c, host, "image", back); err != nil || n != int64(len(content)) {
+		t.Fatalf("get = %d, %v, want %d bytes", n, err, len(content))
+	}
+	got, err := ioutil.ReadFile(back)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, content) {
+		t.Errorf("round trip changed the file")
+	}
+	if _, err := get(c, host, "nope", filepath.Join(dir, "nope")); err == nil {
+		t.Errorf("get of a missing file succeeded")
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// tftpd serves a directory read-only over TFTP.
+//
+// Synopsis:
+//     tftpd [-addr ADDR] [-root DIR] [-v]
+//
+// Description:
+//     Requests are confined to DIR: names are resolved as if DIR were the
+//     root, so ".." cannot escape it, symbolic links are only followed
+//     if they stay within DIR, and only regular files are served.
+//     Write requests are refused. The blksize, tsize, timeout and
+//     windowsize options are supported.
+//
+// Options:
+//     -addr: address to listen on (default: :69)
+//     -root: directory to serve (default: .)
+//     -v:    log every request
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"pack.ag/tftp"
+)
+
+var (
+	addr    = flag.String("addr", ":69", "address to listen on")
+	root    = flag.String("root", ".", "directory to serve")
+	verbose = flag.Bool("v", false, "log every request")
+)
+
+// readOnly serves regular files below root.
+type readOnly struct {
+	root    string
+	verbose bool
+}
+
+// errOutside is returned for names that resolve outside of the served
+// directory.
+var errOutside = errors.New("outside of the served directory")
+
+// path maps a requested name into the served directory and resolves
+// symbolic links. It fails with errOutside if the result is not below
+// root.
+func (s *readOnly) path(name string) (string, error) {
+	root, err := filepath.EvalSymlinks(s.root)
+	if err != nil {
+		return "", err
+	}
+	p, err := filepath.EvalSymlinks(filepath.Join(root, filepath.Clean("/"+name)))
+	if err != nil {
+		return "", err
+	}
+	rel, err := filepath.Rel(root, p)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", errOutside
+	}
+	return p, nil
+}
+
+// ServeTFTP implements tftp.ReadHandler.
+func (s *readOnly) ServeTFTP(r tftp.ReadRequest) {
+	p, err := s.path(r.Name())
+	if err == errOutside {
+		s.logf("%v: %q: %v", r.Addr(), r.Name(), err)
+		r.WriteError(tftp.ErrCodeAccessViolation, fmt.Sprintf("%q is outside of the served directory", r.Name()))
+		return
+	}
+	var f *os.File
+	if err == nil {
+		f, err = os.Open(p)
+	}
+	if err != nil {
+		s.logf("%v: %q: %v", r.Addr(), r.Name(), err)
+		r.WriteError(tftp.ErrCodeFileNotFound, fmt.Sprintf("File %q does not exist", r.Name()))
+		return
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil || !fi.Mode().IsRegular() {
+		s.logf("%v: %q: not a regular file", r.Addr(), r.Name())
+		r.WriteError(tftp.ErrCodeAccessViolation, fmt.Sprintf("%q is not a regular file", r.Name()))
+		return
+	}
+	r.WriteSize(fi.Size())
+	n, err := io.Copy(r, f)
+	if err != nil {
+		s.logf("%v: %q: %v after %d bytes", r.Addr(), r.Name(), err, n)
+		return
+	}
+	s.logf("%v: %q: sent %d bytes", r.Addr(), r.Name(), n)
+}
+
+func (s *readOnly) logf(format string, v ...interface{}) {
+	if s.verbose {
+		log.Printf(format, v...)
+	}
+}
+
+func main() {
+	flag.Parse()
+	if flag.NArg() != 0 {
+		log.Fatal("usage: tftpd [-addr ADDR] [-root DIR] [-v]")
+	}
+	s, err := tftp.NewServer(*addr)
+	if err != nil {
+		log.Fatal(err)
+	}
+	s.ReadHandler(&readOnly{root: *root, verbose: *verbose})
+	log.Fatal(s.ListenAndServe())
+}
@@ -0,0 +1,96 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"pack.ag/tftp"
+)
+
+func TestServe(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tftpd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	root := filepath.Join(dir, "root")
+	if err := os.MkdirAll(filepath.Join(root, "sub"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	content := bytes.Repeat([]byte("firmware"), 1000)
+	if err := ioutil.WriteFile(filepath.Join(root, "sub", "fw.bin"), content, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "secret"), []byte("secret"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	for link, target := range map[string]string{
+		"fw.bin": "sub/fw.bin",
+		"secret": filepath.Join(dir, "secret"),
+		"up":     "..",
+	} {
+		if err := os.Symlink(target, filepath.Join(root, link)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	conn, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Skipf("cannot listen on UDP: %v", err)
+	}
+	s, err := tftp.NewServer("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.ReadHandler(&readOnly{root: root})
+	go s.Serve(conn)
+	defer s.Close()
+	host := conn.LocalAddr().String()
+
+	c, err := tftp.NewClient(tftp.ClientBlocksize(1400), tftp.ClientTransferSize(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"sub/fw.bin", "fw.bin", "up/root/fw.bin"} {
+		r, err := c.Get("tftp://" + host + "/" + name)
+		if err != nil {
+			t.Fatalf("Get(%q) = %v", name, err)
+		}
+		got, err := ioutil.ReadAll(r)
+		if err != nil {
+			t.Fatalf("Get(%q) = %v", name, err)
+		}
+		if !bytes.Equal(got, content) {
+			t.Errorf("Get(%q): got %d bytes, want %d", name, len(got), len(content))
+		}
+		if size, err := r.Size(); err != nil || size != int64(len(content)) {
+			t.Errorf("Get(%q): tsize = %d, %v, want %d", name, size, err, len(content))
+		}
+	}
+
+	for _, name := range []string{"../secret", "/../../secret", "secret", "up/secret", "sub", "nope"} {
+		r, err := c.Get("tftp://" + host + "/" + name)
+		if err == nil {
+			_, err = ioutil.ReadAll(r)
+		}
+		if err == nil {
+			t.Errorf("Get(%q) succeeded, want error", name)
+		}
+	}
+
+	if err := c.Put("tftp://"+host+"/new", strings.NewReader("data"), 4); err == nil {
+		t.Errorf("Put succeeded on a read-only server")
+	}
+	if _, err := os.Stat(filepath.Join(root, "new")); err == nil {
+		t.Errorf("Put created a file")
+	}
+}
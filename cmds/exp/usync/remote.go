@@ -0,0 +1,206 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"io"
+	"net/rpc"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// The remote end of a synchronization is a usync -server process started
+// over ssh. It serves its tree with net/rpc on its standard input and
+// output.
+
+// Server exports a localTree over net/rpc.
+type Server struct {
+	t *localTree
+}
+
+// SumsArgs are the arguments of Server.Sums.
+type SumsArgs struct {
+	Path      string
+	BlockSize int
+}
+
+// ReadArgs are the arguments of Server.Read.
+type ReadArgs struct {
+	Path string
+	Off  int64
+	N    int
+}
+
+// WriteArgs are the arguments of Server.Write.
+type WriteArgs struct {
+	Path string
+	Off  int64
+	Data []byte
+}
+
+// LinkArgs are the arguments of Server.Symlink.
+type LinkArgs struct {
+	Target, Path string
+}
+
+// MkdirArgs are the arguments of Server.Mkdir.
+type MkdirArgs struct {
+	Path string
+	Mode os.FileMode
+}
+
+// Entries is the reply of Server.List.
+type Entries []Entry
+
+func (s *Server) List(checksum bool, reply *Entries) error {
+	es, err := s.t.List(checksum)
+	*reply = es
+	return err
+}
+
+func (s *Server) Sums(a SumsArgs, reply *[][]byte) error {
+	sums, err := s.t.Sums(a.Path, a.BlockSize)
+	*reply = sums
+	return err
+}
+
+func (s *Server) Read(a ReadArgs, reply *[]byte) error {
+	b, err := s.t.Read(a.Path, a.Off, a.N)
+	*reply = b
+	return err
+}
+
+func (s *Server) Write(a WriteArgs, _ *struct{}) error {
+	return s.t.Write(a.Path, a.Off, a.Data)
+}
+
+func (s *Server) Finish(e Entry, _ *struct{}) error {
+	return s.t.Finish(e)
+}
+
+func (s *Server) Mkdir(a MkdirArgs, _ *struct{}) error {
+	return s.t.Mkdir(a.Path, a.Mode)
+}
+
+func (s *Server) Symlink(a LinkArgs, _ *struct{}) error {
+	return s.t.Symlink(a.Target, a.Path)
+}
+
+func (s *Server) Remove(path string, _ *struct{}) error {
+	return s.t.Remove(path)
+}
+
+// stdio joins a reader and a writer into a connection.
+type stdio struct {
+	io.ReadCloser
+	w io.WriteCloser
+}
+
+func (s stdio) Write(b []byte) (int, error) {
+	return s.w.Write(b)
+}
+
+func (s stdio) Close() error {
+	s.w.Close()
+	return s.ReadCloser.Close()
+}
+
+// serve serves root on conn until the client hangs up.
+func serve(root string, conn io.ReadWriteCloser) error {
+	t := newLocalTree(root)
+	defer t.Close()
+	s := rpc.NewServer()
+	if err := s.RegisterName("Tree", &Server{t: t}); err != nil {
+		return err
+	}
+	s.ServeConn(conn)
+	return nil
+}
+
+// remoteTree is a tree served by a remote usync.
+type remoteTree struct {
+	c      *rpc.Client
+	cmd    *exec.Cmd
+	closed bool
+}
+
+// quote quotes s for a POSIX shell.
+func quote(s string) string {
+	return "'" + strings.Replace(s, "'", `'\''`, -1) + "'"
+}
+
+// dialRemote starts usync -server for path on host using the ssh command
+// rsh.
+func dialRemote(rsh, host, remoteCmd, path string) (*remoteTree, error) {
+	args := strings.Fields(rsh)
+	args = append(args, host, remoteCmd+" -server "+quote(path))
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Stderr = os.Stderr
+	w, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	r, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+	return &remoteTree{c: rpc.NewClient(stdio{r, w}), cmd: cmd}, nil
+}
+
+func (t *remoteTree) List(checksum bool) ([]Entry, error) {
+	var es Entries
+	err := t.c.Call("Tree.List", checksum, &es)
+	return es, err
+}
+
+func (t *remoteTree) Sums(path string, blockSize int) ([][]byte, error) {
+	var sums [][]byte
+	err := t.c.Call("Tree.Sums", SumsArgs{path, blockSize}, &sums)
+	return sums, err
+}
+
+func (t *remoteTree) Read(path string, off int64, n int) ([]byte, error) {
+	var b []byte
+	err := t.c.Call("Tree.Read", ReadArgs{path, off, n}, &b)
+	return b, err
+}
+
+func (t *remoteTree) Write(path string, off int64, data []byte) error {
+	return t.c.Call("Tree.Write", WriteArgs{path, off, data}, &struct{}{})
+}
+
+func (t *remoteTree) Finish(e Entry) error {
+	return t.c.Call("Tree.Finish", e, &struct{}{})
+}
+
+func (t *remoteTree) Mkdir(path string, mode os.FileMode) error {
+	return t.c.Call("Tree.Mkdir", MkdirArgs{path, mode}, &struct{}{})
+}
+
+func (t *remoteTree) Symlink(target, path string) error {
+	return t.c.Call("Tree.Symlink", LinkArgs{target, path}, &struct{}{})
+}
+
+func (t *remoteTree) Remove(path string) error {
+	return t.c.Call("Tree.Remove", path, &struct{}{})
+}
+
+func (t *remoteTree) Close() error {
+	if t.closed {
+		return nil
+	}
+	t.closed = true
+	err := t.c.Close()
+	if t.cmd != nil {
+		if werr := t.cmd.Wait(); err == nil {
+			err = werr
+		}
+	}
+	return err
+}
@@ -0,0 +1,229 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"crypto/sha256"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Entry describes one file of a tree. Path is slash separated and relative
+// to the root of the tree, which itself is ".".
+type Entry struct {
+	Path    string
+	Mode    os.FileMode
+	Size    int64
+	ModTime time.Time
+	Link    string
+	Sum     []byte
+}
+
+// tree is one side of a synchronization, either local or at the other end
+// of an ssh connection.
+type tree interface {
+	// List walks the tree, parents before children. If checksum is set,
+	// regular files carry the SHA-256 of their content. A missing root is
+	// an empty tree.
+	List(checksum bool) ([]Entry, error)
+	// Sums returns the SHA-256 of each blockSize block of a file.
+	Sums(path string, blockSize int) ([][]byte, error)
+	// Read reads up to n bytes at off.
+	Read(path string, off int64, n int) ([]byte, error)
+	// Write writes data at off, creating the file if needed.
+	Write(path string, off int64, data []byte) error
+	// Finish creates the file or directory if needed and sets its size
+	// (for regular files), mode and modification time to match e.
+	Finish(e Entry) error
+	Mkdir(path string, mode os.FileMode) error
+	Symlink(target, path string) error
+	// Remove removes path and anything below it.
+	Remove(path string) error
+	Close() error
+}
+
+// localTree is a tree in the local file system.
+type localTree struct {
+	root string
+
+	// The file being read or written, kept open between calls.
+	rpath string
+	r     *os.File
+	wpath string
+	w     *os.File
+}
+
+func newLocalTree(root string) *localTree {
+	return &localTree{root: filepath.Clean(root)}
+}
+
+func (t *localTree) path(p string) string {
+	return filepath.Join(t.root, filepath.FromSlash(p))
+}
+
+func fileSum(p string) ([]byte, error) {
+	f, err := os.Open(p)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return nil, err
+	}
+	return h.Sum(nil), nil
+}
+
+func (t *localTree) List(checksum bool) ([]Entry, error) {
+	if _, err := os.Lstat(t.root); os.IsNotExist(err) {
+		return nil, nil
+	}
+	var es []Entry
+	err := filepath.Walk(t.root, func(p string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(t.root, p)
+		if err != nil {
+			return err
+		}
+		e := Entry{
+			Path:    filepath.ToSlash(rel),
+			Mode:    fi.Mode(),
+			Size:    fi.Size(),
+			ModTime: fi.ModTime(),
+		}
+		switch {
+		case fi.Mode()&os.ModeSymlink != 0:
+			if e.Link, err = os.Readlink(p); err != nil {
+				return err
+			}
+		case fi.Mode().IsRegular() && checksum:
+			if e.Sum, err = fileSum(p); err != nil {
+				return err
+			}
+		}
+		es = append(es, e)
+		return nil
+	})
+	return es, err
+}
+
+func (t *localTree) Sums(path string, blockSize int) ([][]byte, error) {
+	f, err := os.Open(t.path(path))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var sums [][]byte
+	buf := make([]byte, blockSize)
+	for {
+		n, err := io.ReadFull(f, buf)
+		if n > 0 {
+			s := sha256.Sum256(buf[:n])
+			sums = append(sums, s[:])
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return sums, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (t *localTree) Read(path string, off int64, n int) ([]byte, error) {
+	if t.r == nil || t.rpath != path {
+		if t.r != nil {
+			t.r.Close()
+		}
+		f, err := os.Open(t.path(path))
+		if err != nil {
+			t.r = nil
+			return nil, err
+		}
+		t.r, t.rpath = f, path
+	}
+	b := make([]byte, n)
+	m, err := t.r.ReadAt(b, off)
+	if err == io.EOF {
+		err = nil
+	}
+	return b[:m], err
+}
+
+func (t *localTree) Write(path string, off int64, data []byte) error {
+	if t.w == nil || t.wpath != path {
+		if err := t.closeWriter(); err != nil {
+			return err
+		}
+		f, err := os.OpenFile(t.path(path), os.O_WRONLY|os.O_CREATE, 0600)
+		if err != nil {
+			return err
+		}
+		t.w, t.wpath = f, path
+	}
+	_, err := t.w.WriteAt(data, off)
+	return err
+}
+
+func (t *localTree) closeWriter() error {
+	if t.w == nil {
+		return nil
+	}
+	err := t.w.Close()
+	t.w = nil
+	return err
+}
+
+func (t *localTree) Finish(e Entry) error {
+	p := t.path(e.Path)
+	if e.Mode.IsRegular() {
+		if t.wpath != e.Path {
+			if err := t.closeWriter(); err != nil {
+				return err
+			}
+		}
+		if t.w == nil {
+			f, err := os.OpenFile(p, os.O_WRONLY|os.O_CREATE, 0600)
+			if err != nil {
+				return err
+			}
+			t.w, t.wpath = f, e.Path
+		}
+		if err := t.w.Truncate(e.Size); err != nil {
+			return err
+		}
+		if err := t.closeWriter(); err != nil {
+			return err
+		}
+	}
+	if err := os.Chmod(p, e.Mode.Perm()|e.Mode&(os.ModeSetuid|os.ModeSetgid|os.ModeSticky)); err != nil {
+		return err
+	}
+	return os.Chtimes(p, e.ModTime, e.ModTime)
+}
+
+func (t *localTree) Mkdir(path string, mode os.FileMode) error {
+	return os.MkdirAll(t.path(path), mode.Perm()|0700)
+}
+
+func (t *localTree) Symlink(target, path string) error {
+	return os.Symlink(target, t.path(path))
+}
+
+func (t *localTree) Remove(path string) error {
+	return os.RemoveAll(t.path(path))
+}
+
+func (t *localTree) Close() error {
+	if t.r != nil {
+		t.r.Close()
+		t.r = nil
+	}
+	return t.closeWriter()
+}
@@ -0,0 +1,329 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// usync synchronizes a file tree with another, locally or over ssh.
+//
+// Synopsis:
+//     usync [-r] [-c] [-delete] [-n] [-v] [-e RSH] [-remote-cmd CMD] SRC DST
+//
+// Description:
+//     usync makes DST a copy of SRC. Either, but not both, may be remote,
+//     written [USER@]HOST:PATH, in which case usync runs "usync -server"
+//     on HOST through ssh. SRC and DST name the roots of the trees: with
+//     -r, the contents of directory SRC are synchronized into directory
+//     DST, otherwise SRC must be a file and DST is its new name.
+//
+//     Files are skipped if their size and modification time (or, with -c,
+//     their SHA-256) match. Over ssh, changed files are updated in place
+//     by sending only the 64 KiB blocks whose checksums differ. Regular
+//     files, directories and symlinks are copied with their permissions
+//     and modification times; other files are skipped.
+//
+// Options:
+//     -r:          recurse into directories
+//     -c:          compare files by checksum instead of size and mtime
+//     -delete:     delete files in DST that are not in SRC
+//     -n:          dry run, only print what would be done
+//     -v:          print each file as it is transferred
+//     -e:          ssh command (default: ssh)
+//     -remote-cmd: usync command on the remote host (default: usync)
+//
+// Example:
+//     usync -r -delete golden/ root@10.0.0.5:/mnt/root
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+)
+
+var (
+	recursive = flag.Bool("r", false, "recurse into directories")
+	checksum  = flag.Bool("c", false, "compare files by checksum instead of size and mtime")
+	del       = flag.Bool("delete", false, "delete extraneous files from DST")
+	dryRun    = flag.Bool("n", false, "dry run")
+	verbose   = flag.Bool("v", false, "print each file transferred")
+	rsh       = flag.String("e", "ssh", "ssh command")
+	remoteCmd = flag.String("remote-cmd", "usync", "usync command on the remote host")
+	server    = flag.Bool("server", false, "serve the tree at the given path on stdin and stdout")
+)
+
+// blockSize is the granularity of delta transfers and of reads and writes.
+const blockSize = 64 << 10
+
+type options struct {
+	recursive bool
+	checksum  bool
+	delete    bool
+	dryRun    bool
+	verbose   bool
+	// delta enables block checksum comparison of changed files. It only
+	// pays off when the files are not both local.
+	delta bool
+}
+
+type stats struct {
+	files, deleted int
+	bytes          int64
+}
+
+type syncer struct {
+	src, dst tree
+	opts     options
+	out      io.Writer
+	stats    stats
+}
+
+// unchanged reports whether regular file dst already matches src.
+func (s *syncer) unchanged(src, dst Entry) bool {
+	if src.Size != dst.Size {
+		return false
+	}
+	if s.opts.checksum {
+		return bytes.Equal(src.Sum, dst.Sum)
+	}
+	return src.ModTime.Unix() == dst.ModTime.Unix()
+}
+
+func (s *syncer) logf(format string, v ...interface{}) {
+	if s.opts.verbose || s.opts.dryRun {
+		fmt.Fprintf(s.out, format+"\n", v...)
+	}
+}
+
+func (s *syncer) run() error {
+	srcList, err := s.src.List(s.opts.checksum)
+	if err != nil {
+		return fmt.Errorf("listing source: %v", err)
+	}
+	if len(srcList) == 0 {
+		return errors.New("source does not exist")
+	}
+	if srcList[0].Mode.IsDir() && !s.opts.recursive {
+		return errors.New("source is a directory, use -r")
+	}
+	dstList, err := s.dst.List(s.opts.checksum)
+	if err != nil {
+		return fmt.Errorf("listing destination: %v", err)
+	}
+	if len(dstList) > 0 && dstList[0].Mode.IsDir() != srcList[0].Mode.IsDir() {
+		return errors.New("source and destination are not both directories or both files")
+	}
+	dst := make(map[string]Entry, len(dstList))
+	for _, e := range dstList {
+		dst[e.Path] = e
+	}
+	inSrc := make(map[string]bool, len(srcList))
+	for _, e := range srcList {
+		inSrc[e.Path] = true
+	}
+
+	if s.opts.delete {
+		// Children come after their parents, so walk backwards.
+		for i := len(dstList) - 1; i >= 0; i-- {
+			if p := dstList[i].Path; !inSrc[p] {
+				if err := s.remove(p); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	var dirs []Entry
+	for _, e := range srcList {
+		d, exists := dst[e.Path]
+		if exists && d.Mode&os.ModeType != e.Mode&os.ModeType {
+			if err := s.remove(e.Path); err != nil {
+				return err
+			}
+			exists = false
+		}
+		switch {
+		case e.Mode.IsDir():
+			if !exists {
+				s.logf("%s/", e.Path)
+				if !s.opts.dryRun {
+					if err := s.dst.Mkdir(e.Path, e.Mode); err != nil {
+						return err
+					}
+				}
+			}
+			dirs = append(dirs, e)
+		case e.Mode&os.ModeSymlink != 0:
+			if exists && d.Link == e.Link {
+				continue
+			}
+			s.logf("%s -> %s", e.Path, e.Link)
+			if s.opts.dryRun {
+				continue
+			}
+			if exists {
+				if err := s.dst.Remove(e.Path); err != nil {
+					return err
+				}
+			}
+			if err := s.dst.Symlink(e.Link, e.Path); err != nil {
+				return err
+			}
+		case e.Mode.IsRegular():
+			if exists && s.unchanged(e, d) {
+				if d.Mode != e.Mode && !s.opts.dryRun {
+					if err := s.dst.Finish(e); err != nil {
+						return err
+					}
+				}
+				continue
+			}
+			if err := s.transfer(e, exists); err != nil {
+				return fmt.Errorf("%s: %v", e.Path, err)
+			}
+		default:
+			log.Printf("skipping special file %s", e.Path)
+		}
+	}
+
+	// Set directory times last, since filling them in changes them.
+	if !s.opts.dryRun {
+		for i := len(dirs) - 1; i >= 0; i-- {
+			if err := s.dst.Finish(dirs[i]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *syncer) remove(p string) error {
+	s.logf("deleting %s", p)
+	s.stats.deleted++
+	if s.opts.dryRun {
+		return nil
+	}
+	return s.dst.Remove(p)
+}
+
+// transfer copies regular file e. If the destination exists and delta is
+// enabled, only blocks that differ are sent.
+func (s *syncer) transfer(e Entry, exists bool) error {
+	s.logf("%s", e.Path)
+	s.stats.files++
+	if s.opts.dryRun {
+		return nil
+	}
+	var srcSums, dstSums [][]byte
+	if exists && s.opts.delta {
+		var err error
+		if dstSums, err = s.dst.Sums(e.Path, blockSize); err != nil {
+			return err
+		}
+		if srcSums, err = s.src.Sums(e.Path, blockSize); err != nil {
+			return err
+		}
+	}
+	for i, off := 0, int64(0); off < e.Size; i, off = i+1, off+blockSize {
+		if i < len(dstSums) && i < len(srcSums) && bytes.Equal(srcSums[i], dstSums[i]) {
+			continue
+		}
+		b, err := s.src.Read(e.Path, off, blockSize)
+		if err != nil {
+			return err
+		}
+		if len(b) == 0 {
+			// The file shrank while we were copying it.
+			break
+		}
+		if err := s.dst.Write(e.Path, off, b); err != nil {
+			return err
+		}
+		s.stats.bytes += int64(len(b))
+	}
+	return s.dst.Finish(e)
+}
+
+// splitRemote splits [USER@]HOST:PATH. A colon after a slash, as in
+// ./a:b, does not make a path remote.
+func splitRemote(arg string) (host, path string, remote bool) {
+	i := strings.IndexByte(arg, ':')
+	if i <= 0 || strings.ContainsRune(arg[:i], '/') {
+		return "", arg, false
+	}
+	return arg[:i], arg[i+1:], true
+}
+
+func openTree(arg string) (tree, bool, error) {
+	host, path, remote := splitRemote(arg)
+	if !remote {
+		return newLocalTree(path), false, nil
+	}
+	if path == "" {
+		path = "."
+	}
+	t, err := dialRemote(*rsh, host, *remoteCmd, path)
+	return t, true, err
+}
+
+func run(args []string, out io.Writer) error {
+	if len(args) != 2 {
+		return errors.New("usage: usync [-r] [-c] [-delete] [-n] [-v] [-e RSH] SRC DST")
+	}
+	src, srcRemote, err := openTree(args[0])
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	dst, dstRemote, err := openTree(args[1])
+	if err != nil {
+		return err
+	}
+	defer dst.Close()
+	if srcRemote && dstRemote {
+		return errors.New("source and destination cannot both be remote")
+	}
+
+	s := &syncer{
+		src: src,
+		dst: dst,
+		out: out,
+		opts: options{
+			recursive: *recursive,
+			checksum:  *checksum,
+			delete:    *del,
+			dryRun:    *dryRun,
+			verbose:   *verbose,
+			delta:     srcRemote || dstRemote,
+		},
+	}
+	if err := s.run(); err != nil {
+		return err
+	}
+	if err := dst.Close(); err != nil {
+		return err
+	}
+	if s.opts.verbose {
+		fmt.Fprintf(out, "%d files transferred (%d bytes), %d deleted\n", s.stats.files, s.stats.bytes, s.stats.deleted)
+	}
+	return nil
+}
+
+func main() {
+	flag.Parse()
+	if *server {
+		if flag.NArg() != 1 {
+			log.Fatal("usage: usync -server PATH")
+		}
+		if err := serve(flag.Arg(0), stdio{os.Stdin, os.Stdout}); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	if err := run(flag.Args(), os.Stdout); err != nil {
+		log.Fatal(err)
+	}
+}
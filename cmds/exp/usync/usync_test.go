@@ -0,0 +1,277 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net"
+	"net/rpc"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+var mtime = time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+
+// write creates the files in tree below dir. Names ending in / are
+// directories and values starting with -> are symlinks.
+func write(t *testing.T, dir string, tree map[string]string) {
+	t.Helper()
+	for name, content := range tree {
+		p := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+		switch {
+		case name[len(name)-1] == '/':
+			if err := os.MkdirAll(p, 0755); err != nil {
+				t.Fatal(err)
+			}
+		case len(content) > 2 && content[:2] == "->":
+			if err := os.Symlink(content[2:], p); err != nil {
+				t.Fatal(err)
+			}
+		default:
+			if err := ioutil.WriteFile(p, []byte(content), 0644); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.Chtimes(p, mtime, mtime); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+}
+
+// read returns the tree below dir in the format write takes.
+func read(t *testing.T, dir string) map[string]string {
+	t.Helper()
+	tree := map[string]string{}
+	err := filepath.Walk(dir, func(p string, fi os.FileInfo, err error) error {
+		if err != nil || p == dir {
+			return err
+		}
+		rel, _ := filepath.Rel(dir, p)
+		switch {
+		case fi.IsDir():
+			tree[rel+"/"] = ""
+		case fi.Mode()&os.ModeSymlink != 0:
+			l, err := os.Readlink(p)
+			if err != nil {
+				return err
+			}
+			tree[rel] = "->" + l
+		default:
+			b, err := ioutil.ReadFile(p)
+			if err != nil {
+				return err
+			}
+			tree[rel] = string(b)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tree
+}
+
+// remote serves dir over an in-memory connection, as usync -server does
+// over ssh.
+func remote(t *testing.T, dir string) tree {
+	c, s := net.Pipe()
+	go serve(dir, s)
+	return &remoteTree{c: rpc.NewClient(c)}
+}
+
+func TestSync(t *testing.T) {
+	big := bytes.Repeat([]byte("0123456789abcdef"), 3*blockSize/16+100)
+	changed := append([]byte(nil), big...)
+	changed[blockSize+1] = 'X'
+
+	for _, tt := range []struct {
+		name      string
+		opts      options
+		remoteSrc bool
+		remoteDst bool
+		src, dst  map[string]string
+		want      map[string]string
+		files     int
+		bytes     int64
+	}{
+		{
+			name:  "fresh",
+			opts:  options{recursive: true},
+			src:   map[string]string{"a": "a", "d/b": "b", "d/e/": "", "l": "->d/b"},
+			want:  map[string]string{"a": "a", "d/": "", "d/b": "b", "d/e/": "", "l": "->d/b"},
+			files: 2,
+			bytes: 2,
+		},
+		{
+			name:  "skip unchanged, keep extraneous",
+			opts:  options{recursive: true},
+			src:   map[string]string{"a": "a", "b": "newer"},
+			dst:   map[string]string{"a": "a", "b": "old", "x": "x"},
+			want:  map[string]string{"a": "a", "b": "newer", "x": "x"},
+			files: 1,
+			bytes: 5,
+		},
+		{
+			name:  "checksum catches same size and mtime",
+			opts:  options{recursive: true, checksum: true},
+			src:   map[string]string{"a": "one"},
+			dst:   map[string]string{"a": "two"},
+			want:  map[string]string{"a": "one"},
+			files: 1,
+			bytes: 3,
+		},
+		{
+			name:  "same size and mtime is skipped without checksum",
+			opts:  options{recursive: true},
+			src:   map[string]string{"a": "one"},
+			dst:   map[string]string{"a": "two"},
+			want:  map[string]string{"a": "two"},
+			files: 0,
+		},
+		{
+			name:  "delete",
+			opts:  options{recursive: true, delete: true},
+			src:   map[string]string{"a": "a", "l": "->a"},
+			dst:   map[string]string{"x/y/z": "z", "l/": "", "w": "w"},
+			want:  map[string]string{"a": "a", "l": "->a"},
+			files: 1,
+			bytes: 1,
+		},
+		{
+			name:  "dry run",
+			opts:  options{recursive: true, delete: true, dryRun: true},
+			src:   map[string]string{"a": "a"},
+			dst:   map[string]string{"x": "x"},
+			want:  map[string]string{"x": "x"},
+			files: 1,
+		},
+		{
+			name:      "remote destination sends changed blocks only",
+			opts:      options{recursive: true, checksum: true, delta: true},
+			remoteDst: true,
+			src:       map[string]string{"img": string(changed), "new": "n"},
+			dst:       map[string]string{"img": string(big)},
+			want:      map[string]string{"img": string(changed), "new": "n"},
+			files:     2,
+			bytes:     blockSize + 1,
+		},
+		{
+			name:      "remote source, file shrinks",
+			opts:      options{recursive: true, delta: true, delete: true},
+			remoteSrc: true,
+			src:       map[string]string{"img": string(big[:10])},
+			dst:       map[string]string{"img": string(big), "gone/": ""},
+			want:      map[string]string{"img": string(big[:10])},
+			files:     1,
+			bytes:     10,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "usync")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dir)
+			srcDir, dstDir := filepath.Join(dir, "src"), filepath.Join(dir, "dst")
+			write(t, srcDir, tt.src)
+			if tt.dst != nil {
+				write(t, dstDir, tt.dst)
+			}
+			var src, dst tree = newLocalTree(srcDir), newLocalTree(dstDir)
+			if tt.remoteSrc {
+				src = remote(t, srcDir)
+			}
+			if tt.remoteDst {
+				dst = remote(t, dstDir)
+			}
+			s := &syncer{src: src, dst: dst, opts: tt.opts, out: ioutil.Discard}
+			if err := s.run(); err != nil {
+				t.Fatal(err)
+			}
+			src.Close()
+			dst.Close()
+			if got := read(t, dstDir); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("destination = %v, want %v", keys(got), keys(tt.want))
+			}
+			if s.stats.files != tt.files || s.stats.bytes != tt.bytes {
+				t.Errorf("transferred %d files, %d bytes, want %d, %d", s.stats.files, s.stats.bytes, tt.files, tt.bytes)
+			}
+			if tt.opts.dryRun {
+				return
+			}
+			for name := range tt.src {
+				fi, err := os.Lstat(filepath.Join(dstDir, name))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if fi.Mode().IsRegular() && !fi.ModTime().Equal(mtime) {
+					t.Errorf("%s: mtime %v, want %v", name, fi.ModTime(), mtime)
+				}
+			}
+		})
+	}
+}
+
+func keys(m map[string]string) []string {
+	var k []string
+	for n := range m {
+		k = append(k, n)
+	}
+	sort.Strings(k)
+	return k
+}
+
+func TestSyncErrors(t *testing.T) {
+	dir, err := ioutil.TempDir("", "usync")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	write(t, dir, map[string]string{"d/a": "a", "f": "f"})
+
+	for _, tt := range []struct {
+		name     string
+		src, dst string
+		opts     options
+	}{
+		{"missing source", "nope", "x", options{recursive: true}},
+		{"directory without -r", "d", "x", options{}},
+		{"file onto directory", "f", "d", options{delete: true}},
+	} {
+		s := &syncer{src: newLocalTree(filepath.Join(dir, tt.src)), dst: newLocalTree(filepath.Join(dir, tt.dst)), opts: tt.opts, out: ioutil.Discard}
+		if err := s.run(); err == nil {
+			t.Errorf("%s: sync succeeded, want error", tt.name)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, "d", "a")); err != nil {
+		t.Errorf("failed sync damaged the destination: %v", err)
+	}
+}
+
+func TestSplitRemote(t *testing.T) {
+	for _, tt := range []struct {
+		arg, host, path string
+		remote          bool
+	}{
+		{"root@10.0.0.5:/mnt", "root@10.0.0.5", "/mnt", true},
+		{"host:", "host", "", true},
+		{"/a/b", "", "/a/b", false},
+		{"./a:b", "", "./a:b", false},
+		{":x", "", ":x", false},
+	} {
+		host, path, remote := splitRemote(tt.arg)
+		if host != tt.host || path != tt.path || remote != tt.remote {
+			t.Errorf("splitRemote(%q) = %q, %q, %v, want %q, %q, %v", tt.arg, host, path, remote, tt.host, tt.path, tt.remote)
+		}
+	}
+}
@@ -0,0 +1,202 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// vpd reads and writes Vital Product Data.
+//
+// Synopsis:
+//     vpd [-rw] [-b] [-l | -g KEY]
+//     vpd -f FILE [-b] [-l | -g KEY | -s KEY=VALUE... | -d KEY... | -O]
+//
+// Description:
+//     Without -f, vpd reads the RO or RW VPD the kernel exposes in
+//     /sys/firmware/vpd. That interface is read-only.
+//
+//     With -f, vpd works on FILE, a copy of the RO_VPD or RW_VPD flash
+//     region in the VPD 2.0 format, e.g. read with
+//     "flashrom -r -i RO_VPD:ro_vpd.bin". Changes rewrite the whole file,
+//     regenerating its header and keeping its size, and the result can be
+//     written back to the region.
+//
+//     Values which are not printable text are listed in base64. With -b,
+//     the values of -g and -s are base64, so binary data such as keys or
+//     calibration blobs can be read and written.
+//
+// Options:
+//     -f: VPD partition image to work on
+//     -rw: read the RW instead of the RO VPD from sysfs
+//     -l: list all keys and values (the default)
+//     -g: print the value of KEY
+//     -s: set KEY to VALUE; may be repeated
+//     -d: delete KEY; may be repeated
+//     -O: remove all keys before applying -s
+//     -b: -g prints and -s takes base64 values
+//
+// Example:
+//     vpd -f ro_vpd.bin -s serial_number=SN1234 -s ethernet_mac0=00:11:22:33:44:55
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/u-root/u-root/pkg/vpd"
+)
+
+// multiFlag collects the values of a repeated flag.
+type multiFlag []string
+
+func (m *multiFlag) String() string {
+	return strings.Join(*m, ",")
+}
+
+func (m *multiFlag) Set(s string) error {
+	*m = append(*m, s)
+	return nil
+}
+
+var (
+	file      = flag.String("f", "", "VPD partition image to work on")
+	rw        = flag.Bool("rw", false, "read the RW instead of the RO VPD from sysfs")
+	list      = flag.Bool("l", false, "list all keys and values")
+	get       = flag.String("g", "", "print the value of this key")
+	overwrite = flag.Bool("O", false, "remove all keys before applying -s")
+	b64       = flag.Bool("b", false, "-g prints and -s takes base64 values")
+	sets      multiFlag
+	deletes   multiFlag
+)
+
+func init() {
+	flag.Var(&sets, "s", "set KEY=VALUE")
+	flag.Var(&deletes, "d", "delete KEY")
+}
+
+// printable returns true if v is text that can be listed as is.
+func printable(v []byte) bool {
+	for _, r := range string(v) {
+		if r == unicode.ReplacementChar || !unicode.IsPrint(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func listEntries(w io.Writer, entries []vpd.Entry) {
+	for _, e := range entries {
+		if printable(e.Value) {
+			fmt.Fprintf(w, "%q=%q\n", e.Key, e.Value)
+		} else {
+			fmt.Fprintf(w, "%q=base64:%q\n", e.Key, base64.StdEncoding.EncodeToString(e.Value))
+		}
+	}
+}
+
+func printValue(w io.Writer, v []byte) {
+	if *b64 {
+		fmt.Fprintln(w, base64.StdEncoding.EncodeToString(v))
+		return
+	}
+	w.Write(v)
+}
+
+// sysfs reads the VPD the kernel exposes.
+func sysfs(w io.Writer) error {
+	if len(sets) != 0 || len(deletes) != 0 || *overwrite {
+		return fmt.Errorf("the sysfs VPD is read-only, use -f with an image of the VPD region")
+	}
+	if *get != "" {
+		v, err := vpd.Get(*get, !*rw)
+		if err != nil {
+			return err
+		}
+		printValue(w, v)
+		return nil
+	}
+	all, err := vpd.GetAll(!*rw)
+	if err != nil {
+		return err
+	}
+	var entries []vpd.Entry
+	for k, v := range all {
+		entries = append(entries, vpd.Entry{Key: k, Value: bytes.TrimSuffix(v, []byte("\n"))})
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Key < entries[j].Key })
+	listEntries(w, entries)
+	return nil
+}
+
+// image reads, and if asked to changes, a VPD partition image.
+func image(w io.Writer, name string) error {
+	b, err := ioutil.ReadFile(name)
+	if err != nil {
+		return err
+	}
+	p, err := vpd.ParsePartition(b)
+	if err != nil {
+		return fmt.Errorf("%s: %v", name, err)
+	}
+	if *get != "" {
+		v, ok := p.Get(*get)
+		if !ok {
+			return fmt.Errorf("%s: no key %q", name, *get)
+		}
+		printValue(w, v)
+		return nil
+	}
+	if len(sets) == 0 && len(deletes) == 0 && !*overwrite {
+		listEntries(w, p.Entries)
+		return nil
+	}
+
+	if *overwrite {
+		p.Entries = nil
+	}
+	for _, k := range deletes {
+		if !p.Delete(k) {
+			return fmt.Errorf("%s: no key %q", name, k)
+		}
+	}
+	for _, s := range sets {
+		i := strings.Index(s, "=")
+		if i <= 0 {
+			return fmt.Errorf("-s %q: want KEY=VALUE", s)
+		}
+		v := []byte(s[i+1:])
+		if *b64 {
+			if v, err = base64.StdEncoding.DecodeString(s[i+1:]); err != nil {
+				return fmt.Errorf("-s %q: %v", s, err)
+			}
+		}
+		p.Set(s[:i], v)
+	}
+	if b, err = p.Marshal(len(b)); err != nil {
+		return fmt.Errorf("%s: %v", name, err)
+	}
+	return ioutil.WriteFile(name, b, 0644)
+}
+
+func main() {
+	flag.Parse()
+	if flag.NArg() != 0 {
+		flag.Usage()
+		os.Exit(1)
+	}
+	var err error
+	if *file != "" {
+		err = image(os.Stdout, *file)
+	} else {
+		err = sysfs(os.Stdout)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+}
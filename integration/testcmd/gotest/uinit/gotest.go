@@ -14,6 +14,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/u-root/u-root/pkg/cmdline"
 	"github.com/u-root/u-root/pkg/mount"
 	"golang.org/x/sys/unix"
 )
@@ -54,6 +55,10 @@ func main() {
 	}
 	defer mp.Unmount(0) //nolint:errcheck
 
+	// GolangTest asks for coverage profiles when it built the tests
+	// with -cover.
+	cover := cmdline.ContainsFlag("uroot.gotest.cover")
+
 	walkTests("/testdata/tests", func(path, pkgName string) {
 		ctx, cancel := context.WithTimeout(context.Background(), 25000*time.Millisecond)
 		defer cancel()
@@ -64,7 +69,11 @@ func main() {
 			return
 		}
 
-		cmd := exec.CommandContext(ctx, path, "-test.v")
+		args := []string{"-test.v"}
+		if cover {
+			args = append(args, "-test.coverprofile", filepath.Join(filepath.Dir(path), "coverage.out"))
+		}
+		cmd := exec.CommandContext(ctx, path, args...)
 		cmd.Stdin, cmd.Stderr = os.Stdin, os.Stderr
 
 		// Write to stdout for humans, write to w for the JSON converter.
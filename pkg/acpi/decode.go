@@ -0,0 +1,145 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package acpi
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ErrNoDecoder is returned by Decode for tables it does not know.
+var ErrNoDecoder = errors.New("no decoder for table")
+
+// decoders maps a table signature to the function decoding it.
+var decoders = map[string]func(Table) (fmt.Stringer, error){
+	"APIC": func(t Table) (fmt.Stringer, error) { return NewMADT(t) },
+	"SRAT": func(t Table) (fmt.Stringer, error) { return NewSRAT(t) },
+	"MCFG": func(t Table) (fmt.Stringer, error) { return NewMCFG(t) },
+	"SPCR": func(t Table) (fmt.Stringer, error) { return NewSPCR(t) },
+}
+
+// Decode decodes the tables this package knows the layout of: the MADT,
+// SRAT, MCFG and SPCR. The result prints the decoded fields.
+func Decode(t Table) (fmt.Stringer, error) {
+	d, ok := decoders[t.Sig()]
+	if !ok {
+		return nil, fmt.Errorf("%s: %w", t.Sig(), ErrNoDecoder)
+	}
+	return d(t)
+}
+
+// ChecksumValid returns true if the bytes of t sum to zero, as they must
+// for all tables but the FACS, which has no checksum.
+func ChecksumValid(t Table) bool {
+	return gencsum(t.Data()) == 0
+}
+
+// HeaderString returns the fields of the standard header of t.
+func HeaderString(t Table) string {
+	d := t.Data()
+	csum := "valid"
+	if !ChecksumValid(t) {
+		csum = fmt.Sprintf("invalid, should be %#02x", uint8(t.CheckSum()+gencsum(d)))
+	}
+	return fmt.Sprintf("%s: length %d, revision %d, checksum %#02x (%s)\n"+
+		"  OEM ID %q, OEM table ID %q, OEM revision %#08x\n"+
+		"  creator ID %q, creator revision %#08x",
+		t.Sig(), t.Len(), t.Revision(), t.CheckSum(), csum,
+		idString(d[10:16]), idString(d[16:24]), t.OEMRevision(),
+		idString(d[28:32]), t.CreatorRevision())
+}
+
+// WriteHeader writes the standard header of t the way iasl disassembles
+// it, each field with its offset and length:
+//
+//	[000h 0000   4]                    Signature : "APIC"
+//	[004h 0004   4]                 Table Length : 00000078
+func WriteHeader(w io.Writer, t Table) error {
+	d := t.Data()
+	fields := []struct {
+		name string
+		off  int
+		len  int
+		str  bool
+	}{
+		{"Signature", 0, 4, true},
+		{"Table Length", 4, 4, false},
+		{"Revision", 8, 1, false},
+		{"Checksum", 9, 1, false},
+		{"Oem ID", 10, 6, true},
+		{"Oem Table ID", 16, 8, true},
+		{"Oem Revision", 24, 4, false},
+		{"Asl Compiler ID", 28, 4, true},
+		{"Asl Compiler Revision", 32, 4, false},
+	}
+	// The FACS only has a signature and a length.
+	if t.Sig() == "FACS" {
+		fields = fields[:2]
+	}
+	for _, f := range fields {
+		if f.off+f.len > len(d) {
+			return fmt.Errorf("%s: table is %d bytes, too short for %s", t.Sig(), len(d), f.name)
+		}
+		var v string
+		b := d[f.off : f.off+f.len]
+		switch {
+		case f.str:
+			v = fmt.Sprintf("%q", b)
+		case f.len == 1:
+			v = fmt.Sprintf("%02X", b[0])
+		default:
+			v = fmt.Sprintf("%08X", binary.LittleEndian.Uint32(b))
+		}
+		if _, err := fmt.Fprintf(w, "[%03Xh %04d %3d] %28s : %s\n", f.off, f.off, f.len, f.name, v); err != nil {
+			return err
+		}
+	}
+	if t.Sig() != "FACS" && !ChecksumValid(t) {
+		_, err := fmt.Fprintf(w, "**** Incorrect checksum, should be %02X\n", uint8(t.CheckSum()+gencsum(d)))
+		return err
+	}
+	return nil
+}
+
+// idString returns an OEM or creator ID without its padding.
+func idString(b []byte) string {
+	return string(bytes.TrimRight(b, " \x00"))
+}
+
+// subtable is one of the type/length entries following the fixed part of
+// the MADT and SRAT.
+type subtable struct {
+	typ  uint8
+	data []byte
+}
+
+// subtables splits b into its type/length entries.
+func subtables(sig string, b []byte) ([]subtable, error) {
+	var s []subtable
+	for len(b) > 0 {
+		if len(b) < 2 {
+			return nil, fmt.Errorf("%s: %d trailing bytes", sig, len(b))
+		}
+		l := int(b[1])
+		if l < 2 || l > len(b) {
+			return nil, fmt.Errorf("%s: subtable type %d has bad length %d, %d bytes left", sig, b[0], l, len(b))
+		}
+		s = append(s, subtable{typ: b[0], data: b[:l]})
+		b = b[l:]
+	}
+	return s, nil
+}
+
+// fixed returns the table data of t after its header, if it has at least
+// n bytes.
+func fixed(t Table, n int) ([]byte, error) {
+	if len(t.Data()) < headerLength+n {
+		return nil, fmt.Errorf("%s: table is %d bytes, want at least %d", t.Sig(), len(t.Data()), headerLength+n)
+	}
+	return t.TableData(), nil
+}
@@ -0,0 +1,221 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package acpi
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// mkTable returns a table with a valid header and checksum around body.
+func mkTable(t *testing.T, sig string, body ...[]byte) Table {
+	b := make([]byte, headerLength)
+	copy(b, sig)
+	b[8] = 1
+	copy(b[10:], "UROOT ")
+	copy(b[16:], "TESTTABL")
+	copy(b[28:], "GO  ")
+	for _, bb := range body {
+		b = append(b, bb...)
+	}
+	binary.LittleEndian.PutUint32(b[lengthOffset:], uint32(len(b)))
+	b[cSUMOffset] = gencsum(b)
+	tabs, err := NewRaw(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tabs[0]
+}
+
+func le32(v uint32) []byte {
+	var b [4]byte
+	binary.LittleEndian.PutUint32(b[:], v)
+	return b[:]
+}
+
+func le64(v uint64) []byte {
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], v)
+	return b[:]
+}
+
+func cat(b ...[]byte) []byte {
+	return bytes.Join(b, nil)
+}
+
+func TestMADT(t *testing.T) {
+	tab := mkTable(t, "APIC",
+		le32(0xfee00000), le32(1),
+		[]byte{0, 8, 0, 0}, le32(1),
+		[]byte{0, 8, 1, 2}, le32(0),
+		[]byte{1, 12, 8, 0}, le32(0xfec00000), le32(0),
+		[]byte{2, 10, 0, 0}, le32(2), []byte{0, 0},
+		cat([]byte{9, 16, 0, 0}, le32(300), le32(1), le32(7)),
+		[]byte{4, 6, 0xff, 0, 0, 1},
+	)
+	if !ChecksumValid(tab) {
+		t.Fatalf("checksum of test table is invalid")
+	}
+	m, err := NewMADT(tab)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.LocalAPICAddress != 0xfee00000 || m.Flags != 1 {
+		t.Errorf("address %#x, flags %#x, want 0xfee00000, 1", m.LocalAPICAddress, m.Flags)
+	}
+	want := []LocalAPIC{{0, 0, 1}, {1, 2, 0}}
+	if !reflect.DeepEqual(m.LocalAPICs, want) {
+		t.Errorf("LocalAPICs = %+v, want %+v", m.LocalAPICs, want)
+	}
+	if len(m.LocalX2APICs) != 1 || m.LocalX2APICs[0].APICID != 300 || m.LocalX2APICs[0].ProcessorUID != 7 || !m.LocalX2APICs[0].Enabled() {
+		t.Errorf("LocalX2APICs = %+v", m.LocalX2APICs)
+	}
+	if !reflect.DeepEqual(m.IOAPICs, []IOAPIC{{8, 0xfec00000, 0}}) {
+		t.Errorf("IOAPICs = %+v", m.IOAPICs)
+	}
+	if !reflect.DeepEqual(m.Overrides, []InterruptOverride{{0, 0, 2, 0}}) {
+		t.Errorf("Overrides = %+v", m.Overrides)
+	}
+	if len(m.Other) != 1 || m.Other[0].Type != 4 {
+		t.Errorf("Other = %+v, want the local APIC NMI", m.Other)
+	}
+	for _, s := range []string{"local APIC: processor UID 1, APIC ID 2, enabled false", "I/O APIC: ID 8, address 0xfec00000", "IRQ 0 -> GSI 2"} {
+		if !strings.Contains(m.String(), s) {
+			t.Errorf("String() does not contain %q:\n%s", s, m)
+		}
+	}
+
+	bad := mkTable(t, "APIC", le32(0), le32(0), []byte{0, 20, 0, 0})
+	if _, err := NewMADT(bad); err == nil {
+		t.Errorf("NewMADT with a truncated entry succeeded")
+	}
+}
+
+func TestSRAT(t *testing.T) {
+	tab := mkTable(t, "SRAT",
+		le32(1), le64(0),
+		cat([]byte{0, 16, 1, 4}, le32(1), []byte{0, 0, 0, 0}, le32(0)),
+		cat([]byte{1, 40}, le32(1), []byte{0, 0}, le64(0x100000000), le64(0x80000000), le32(0), le32(3), le64(0)),
+		cat([]byte{2, 24, 0, 0}, le32(2), le32(500), le32(1), le32(0), le32(0)),
+	)
+	s, err := NewSRAT(tab)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []CPUAffinity{
+		{ProximityDomain: 1, APICID: 4, Flags: 1},
+		{ProximityDomain: 2, APICID: 500, X2APIC: true, Flags: 1},
+	}
+	if !reflect.DeepEqual(s.CPUs, want) {
+		t.Errorf("CPUs = %+v, want %+v", s.CPUs, want)
+	}
+	if len(s.Memory) != 1 {
+		t.Fatalf("Memory = %+v, want 1 entry", s.Memory)
+	}
+	if m := s.Memory[0]; m.Base != 0x100000000 || m.Length != 0x80000000 || m.ProximityDomain != 1 || !m.Enabled() || !m.HotPluggable() {
+		t.Errorf("Memory[0] = %+v", m)
+	}
+}
+
+func TestMCFG(t *testing.T) {
+	tab := mkTable(t, "MCFG", le64(0), le64(0xe0000000), []byte{0, 0, 0, 0xff}, le32(0))
+	m, err := NewMCFG(tab)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []MCFGSegment{{Base: 0xe0000000, Segment: 0, StartBus: 0, EndBus: 0xff}}
+	if !reflect.DeepEqual(m.Segments, want) {
+		t.Errorf("Segments = %+v, want %+v", m.Segments, want)
+	}
+	if _, err := NewMCFG(mkTable(t, "MCFG", le64(0), le64(0))); err == nil {
+		t.Errorf("NewMCFG with a partial entry succeeded")
+	}
+}
+
+func TestSPCR(t *testing.T) {
+	tab := mkTable(t, "SPCR",
+		[]byte{0, 0, 0, 0},
+		[]byte{1, 8, 0, 1}, le64(0x3f8),
+		[]byte{1, 4}, le32(0), []byte{7, 0, 1, 0, 0, 0},
+		make([]byte, 16),
+	)
+	s, err := NewSPCR(tab)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Interface() != "16550" || s.BaseAddress != 0x3f8 || s.IRQ != 4 || s.Baud != 115200 {
+		t.Errorf("SPCR = %+v", s)
+	}
+	if c, want := s.Console(), "uart8250,io,0x3f8,115200"; c != want {
+		t.Errorf("Console() = %q, want %q", c, want)
+	}
+}
+
+func TestDecode(t *testing.T) {
+	if _, err := Decode(mkTable(t, "MCFG", le64(0))); err != nil {
+		t.Errorf("Decode(MCFG): %v", err)
+	}
+	if _, err := Decode(mkTable(t, "SSDT")); !errors.Is(err, ErrNoDecoder) {
+		t.Errorf("Decode(SSDT): got %v, want ErrNoDecoder", err)
+	}
+	if _, err := NewMCFG(mkTable(t, "MCFG")); err == nil {
+		t.Errorf("NewMCFG of a bare header succeeded")
+	}
+	if _, err := NewSPCR(mkTable(t, "MCFG", make([]byte, 80))); err == nil {
+		t.Errorf("NewSPCR of an MCFG succeeded")
+	}
+}
+
+func TestChecksum(t *testing.T) {
+	tab := mkTable(t, "SSDT", []byte{1, 2, 3})
+	if !ChecksumValid(tab) {
+		t.Errorf("valid checksum reported as invalid")
+	}
+	if !strings.Contains(HeaderString(tab), `OEM ID "UROOT"`) {
+		t.Errorf("HeaderString() = %q, want the trimmed OEM ID", HeaderString(tab))
+	}
+	tab.Data()[headerLength]++
+	if ChecksumValid(tab) {
+		t.Errorf("invalid checksum reported as valid")
+	}
+	if s := HeaderString(tab); !strings.Contains(s, "invalid, should be") {
+		t.Errorf("HeaderString() = %q, want the checksum reported invalid", s)
+	}
+}
+
+func TestWriteHeader(t *testing.T) {
+	tab := mkTable(t, "SSDT", []byte{1, 2, 3})
+	var b bytes.Buffer
+	if err := WriteHeader(&b, tab); err != nil {
+		t.Fatal(err)
+	}
+	want := fmt.Sprintf(`[000h 0000   4]                    Signature : "SSDT"
+[004h 0004   4]                 Table Length : 00000027
+[008h 0008   1]                     Revision : 01
+[009h 0009   1]                     Checksum : %02X
+[00Ah 0010   6]                       Oem ID : "UROOT "
+[010h 0016   8]                 Oem Table ID : "TESTTABL"
+[018h 0024   4]                 Oem Revision : 00000000
+[01Ch 0028   4]              Asl Compiler ID : "GO  "
+[020h 0032   4]        Asl Compiler Revision : 00000000
+`, tab.CheckSum())
+	if b.String() != want {
+		t.Errorf("WriteHeader() = \n%s, want\n%s", b.String(), want)
+	}
+
+	tab.Data()[headerLength]++
+	b.Reset()
+	if err := WriteHeader(&b, tab); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(b.String(), "Incorrect checksum") {
+		t.Errorf("WriteHeader() = %q, want the checksum reported invalid", b.String())
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package acpi
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+// WriteDump writes tables as hex dumps in the format of ACPICA's
+// acpidump, which acpixtract and iasl users expect:
+//
+//	DSDT @ 0x00000000bff7d040
+//	    0000: 44 53 44 54 c5 1d 00 00 01 3c 42 4f 43 48 53 20  DSDT.....<BOCHS
+func WriteDump(w io.Writer, tabs ...Table) error {
+	bw := bufio.NewWriter(w)
+	for _, t := range tabs {
+		fmt.Fprintf(bw, "%s @ %#016x\n", t.Sig(), t.Address())
+		d := t.Data()
+		for off := 0; off < len(d); off += 16 {
+			line := d[off:]
+			if len(line) > 16 {
+				line = line[:16]
+			}
+			fmt.Fprintf(bw, "    %04X:", off)
+			for i := 0; i < 16; i++ {
+				if i < len(line) {
+					fmt.Fprintf(bw, " %02X", line[i])
+				} else {
+					bw.WriteString("   ")
+				}
+			}
+			bw.WriteString("  ")
+			for _, c := range line {
+				if c < ' ' || c > '~' {
+					c = '.'
+				}
+				bw.WriteByte(c)
+			}
+			bw.WriteByte('\n')
+		}
+		bw.WriteByte('\n')
+	}
+	return bw.Flush()
+}
+
+// ReadDump parses hex dumps in the format written by WriteDump and
+// acpidump. The table lengths are checked against their headers.
+func ReadDump(r io.Reader) ([]Table, error) {
+	var (
+		tabs []Table
+		cur  *Raw
+		sig  string
+	)
+	finish := func() error {
+		if cur == nil {
+			return nil
+		}
+		if len(cur.data) < minTableLength {
+			return fmt.Errorf("%s: only %d bytes", sig, len(cur.data))
+		}
+		// The RSDP keeps its length elsewhere, if at all.
+		if l := binary.LittleEndian.Uint32(cur.data[lengthOffset:]); sig != "RSDP" && int(l) != len(cur.data) {
+			return fmt.Errorf("%s: %d bytes of data, header says %d", sig, len(cur.data), l)
+		}
+		tabs = append(tabs, cur)
+		cur = nil
+		return nil
+	}
+	s := bufio.NewScanner(r)
+	for n := 1; s.Scan(); n++ {
+		line := strings.TrimSpace(s.Text())
+		if line == "" {
+			continue
+		}
+		// Data lines start with a hex offset; anything else must be
+		// the "SIG @ address" line starting a table.
+		c := strings.Index(line, ":")
+		var (
+			off uint64
+			err error
+		)
+		if c > 0 {
+			off, err = strconv.ParseUint(line[:c], 16, 32)
+		}
+		if c <= 0 || err != nil {
+			i := strings.Index(line, " @ ")
+			if i <= 0 {
+				return nil, fmt.Errorf("line %d: unexpected %q", n, line)
+			}
+			if err := finish(); err != nil {
+				return nil, err
+			}
+			a, err := strconv.ParseUint(strings.TrimSpace(line[i+3:]), 0, 64)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: bad address in %q", n, line)
+			}
+			sig = line[:i]
+			cur = &Raw{addr: int64(a)}
+			continue
+		}
+		if cur == nil || int(off) != len(cur.data) {
+			return nil, fmt.Errorf("line %d: bad offset in %q", n, line)
+		}
+		// The hex bytes are followed by two spaces and the ASCII form.
+		rest := line[c+1:]
+		if i := strings.Index(rest[1:], "  "); i >= 0 {
+			rest = rest[:i+1]
+		}
+		for _, f := range strings.Fields(rest) {
+			b, err := hex.DecodeString(f)
+			if err != nil || len(b) != 1 {
+				return nil, fmt.Errorf("line %d: bad byte %q", n, f)
+			}
+			cur.data = append(cur.data, b[0])
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	if err := finish(); err != nil {
+		return nil, err
+	}
+	return tabs, nil
+}
+
+// DumpNames returns the file names acpidump -b and acpixtract write tabs
+// to: the signature in lower case, numbered from 1 if it occurs more than
+// once, as in "ssdt1.dat".
+func DumpNames(tabs []Table) []string {
+	count := map[string]int{}
+	for _, t := range tabs {
+		count[t.Sig()]++
+	}
+	seen := map[string]int{}
+	names := make([]string, len(tabs))
+	for i, t := range tabs {
+		n := strings.ToLower(strings.TrimSpace(t.Sig()))
+		if count[t.Sig()] > 1 {
+			seen[t.Sig()]++
+			n += strconv.Itoa(seen[t.Sig()])
+		}
+		names[i] = n + ".dat"
+	}
+	return names
+}
+
+// WriteList writes a table of the headers of tabs, one line per table.
+func WriteList(w io.Writer, tabs ...Table) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(tw, "SIG\tADDRESS\tLENGTH\tREV\tCHECKSUM\tOEM ID\tOEM TABLE ID\tOEM REV\tCREATOR\tCREATOR REV\n")
+	for _, t := range tabs {
+		csum := "ok"
+		if !ChecksumValid(t) {
+			csum = "BAD"
+		}
+		d := t.Data()
+		fmt.Fprintf(tw, "%s\t%#x\t%d\t%d\t%#02x %s\t%s\t%s\t%#x\t%s\t%#x\n",
+			t.Sig(), t.Address(), t.Len(), t.Revision(), t.CheckSum(), csum,
+			idString(d[10:16]), idString(d[16:24]), t.OEMRevision(), idString(d[28:32]), t.CreatorRevision())
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,56 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package acpi
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDump(t *testing.T) {
+	tabs := []Table{
+		mkTable(t, "APIC", le32(0xfee00000), le32(1)),
+		mkTable(t, "SSDT", bytes.Repeat([]byte("  @ :"), 10)),
+	}
+	var b bytes.Buffer
+	if err := WriteDump(&b, tabs...); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(b.String(), "APIC @ 0x0000000000000000\n    0000: 41 50 49 43 2C 00 00 00") {
+		t.Errorf("dump starts with %q", b.String()[:60])
+	}
+	got, err := ReadDump(&b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(tabs) {
+		t.Fatalf("read %d tables, want %d", len(got), len(tabs))
+	}
+	for i := range got {
+		if !bytes.Equal(got[i].Data(), tabs[i].Data()) {
+			t.Errorf("table %d: got % x, want % x", i, got[i].Data(), tabs[i].Data())
+		}
+	}
+
+	for _, bad := range []string{
+		"    0000: 41 50",
+		"APIC @ 0x0\n    0010: 41 50 49 43 2C 00 00 00",
+		"APIC @ 0x0\n    0000: 41 50 49 43 2C 00 00 00 01 02 03 04",
+	} {
+		if _, err := ReadDump(strings.NewReader(bad)); err == nil {
+			t.Errorf("ReadDump(%q) succeeded", bad)
+		}
+	}
+}
+
+func TestDumpNames(t *testing.T) {
+	tabs := []Table{mkTable(t, "DSDT"), mkTable(t, "SSDT"), mkTable(t, "APIC"), mkTable(t, "SSDT")}
+	want := []string{"dsdt.dat", "ssdt1.dat", "apic.dat", "ssdt2.dat"}
+	if got := DumpNames(tabs); !reflect.DeepEqual(got, want) {
+		t.Errorf("DumpNames() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package acpi
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+)
+
+// MADT entry types.
+const (
+	madtLocalAPIC         = 0
+	madtIOAPIC            = 1
+	madtInterruptOverride = 2
+	madtLocalAPICNMI      = 4
+	madtLocalX2APIC       = 9
+)
+
+// MADT is the Multiple APIC Description Table, signature "APIC". It lists
+// the interrupt controllers and, through them, the processors.
+type MADT struct {
+	Table
+
+	// LocalAPICAddress is the physical address of the local APICs.
+	LocalAPICAddress uint32
+	// Flags has bit 0 set if the system also has 8259 PICs.
+	Flags uint32
+
+	LocalAPICs   []LocalAPIC
+	IOAPICs      []IOAPIC
+	Overrides    []InterruptOverride
+	LocalX2APICs []LocalAPIC
+	// Other holds the entries of other types, undecoded.
+	Other []MADTEntry
+}
+
+// LocalAPIC is a processor's local APIC or x2APIC.
+type LocalAPIC struct {
+	ProcessorUID uint32
+	APICID       uint32
+	Flags        uint32
+}
+
+// Enabled returns true if the processor is usable.
+func (l LocalAPIC) Enabled() bool {
+	return l.Flags&1 != 0
+}
+
+// IOAPIC is an I/O APIC, handling the interrupts from GSIBase on.
+type IOAPIC struct {
+	ID      uint8
+	Address uint32
+	GSIBase uint32
+}
+
+// InterruptOverride maps an ISA interrupt to a global system interrupt.
+type InterruptOverride struct {
+	Bus    uint8
+	Source uint8
+	GSI    uint32
+	Flags  uint16
+}
+
+// MADTEntry is an MADT entry of a type not decoded by this package.
+type MADTEntry struct {
+	Type uint8
+	Data []byte
+}
+
+// NewMADT decodes the MADT t.
+func NewMADT(t Table) (*MADT, error) {
+	if t.Sig() != "APIC" {
+		return nil, fmt.Errorf("table %s is not an MADT", t.Sig())
+	}
+	b, err := fixed(t, 8)
+	if err != nil {
+		return nil, err
+	}
+	m := &MADT{
+		Table:            t,
+		LocalAPICAddress: binary.LittleEndian.Uint32(b[0:]),
+		Flags:            binary.LittleEndian.Uint32(b[4:]),
+	}
+	st, err := subtables("MADT", b[8:])
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range st {
+		d := s.data
+		switch {
+		case s.typ == madtLocalAPIC && len(d) >= 8:
+			m.LocalAPICs = append(m.LocalAPICs, LocalAPIC{
+				ProcessorUID: uint32(d[2]),
+				APICID:       uint32(d[3]),
+				Flags:        binary.LittleEndian.Uint32(d[4:]),
+			})
+		case s.typ == madtIOAPIC && len(d) >= 12:
+			m.IOAPICs = append(m.IOAPICs, IOAPIC{
+				ID:      d[2],
+				Address: binary.LittleEndian.Uint32(d[4:]),
+				GSIBase: binary.LittleEndian.Uint32(d[8:]),
+			})
+		case s.typ == madtInterruptOverride && len(d) >= 10:
+			m.Overrides = append(m.Overrides, InterruptOverride{
+				Bus:    d[2],
+				Source: d[3],
+				GSI:    binary.LittleEndian.Uint32(d[4:]),
+				Flags:  binary.LittleEndian.Uint16(d[8:]),
+			})
+		case s.typ == madtLocalX2APIC && len(d) >= 16:
+			m.LocalX2APICs = append(m.LocalX2APICs, LocalAPIC{
+				APICID:       binary.LittleEndian.Uint32(d[4:]),
+				Flags:        binary.LittleEndian.Uint32(d[8:]),
+				ProcessorUID: binary.LittleEndian.Uint32(d[12:]),
+			})
+		default:
+			m.Other = append(m.Other, MADTEntry{Type: s.typ, Data: s.data})
+		}
+	}
+	return m, nil
+}
+
+// String implements fmt.Stringer.
+func (m *MADT) String() string {
+	s := []string{
+		HeaderString(m),
+		fmt.Sprintf("  local APIC address %#08x, flags %#x", m.LocalAPICAddress, m.Flags),
+	}
+	for _, l := range m.LocalAPICs {
+		s = append(s, fmt.Sprintf("  local APIC: processor UID %d, APIC ID %d, enabled %t", l.ProcessorUID, l.APICID, l.Enabled()))
+	}
+	for _, l := range m.LocalX2APICs {
+		s = append(s, fmt.Sprintf("  local x2APIC: processor UID %d, x2APIC ID %d, enabled %t", l.ProcessorUID, l.APICID, l.Enabled()))
+	}
+	for _, i := range m.IOAPICs {
+		s = append(s, fmt.Sprintf("  I/O APIC: ID %d, address %#08x, GSI base %d", i.ID, i.Address, i.GSIBase))
+	}
+	for _, o := range m.Overrides {
+		s = append(s, fmt.Sprintf("  interrupt override: bus %d, IRQ %d -> GSI %d, flags %#04x", o.Bus, o.Source, o.GSI, o.Flags))
+	}
+	for _, o := range m.Other {
+		s = append(s, fmt.Sprintf("  entry type %d: % x", o.Type, o.Data[2:]))
+	}
+	return strings.Join(s, "\n")
+}
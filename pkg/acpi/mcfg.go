@@ -0,0 +1,65 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package acpi
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+)
+
+// mcfgEntryLength is the length of an MCFG configuration space entry.
+const mcfgEntryLength = 16
+
+// MCFG is the PCI Express memory mapped configuration space table.
+type MCFG struct {
+	Table
+
+	Segments []MCFGSegment
+}
+
+// MCFGSegment is the ECAM area of the buses StartBus to EndBus of a PCI
+// segment group.
+type MCFGSegment struct {
+	Base     uint64
+	Segment  uint16
+	StartBus uint8
+	EndBus   uint8
+}
+
+// NewMCFG decodes the MCFG t.
+func NewMCFG(t Table) (*MCFG, error) {
+	if t.Sig() != "MCFG" {
+		return nil, fmt.Errorf("table %s is not an MCFG", t.Sig())
+	}
+	// 8 reserved bytes precede the entries.
+	b, err := fixed(t, 8)
+	if err != nil {
+		return nil, err
+	}
+	b = b[8:]
+	if len(b)%mcfgEntryLength != 0 {
+		return nil, fmt.Errorf("MCFG: %d bytes of entries is not a multiple of %d", len(b), mcfgEntryLength)
+	}
+	m := &MCFG{Table: t}
+	for ; len(b) > 0; b = b[mcfgEntryLength:] {
+		m.Segments = append(m.Segments, MCFGSegment{
+			Base:     binary.LittleEndian.Uint64(b[0:]),
+			Segment:  binary.LittleEndian.Uint16(b[8:]),
+			StartBus: b[10],
+			EndBus:   b[11],
+		})
+	}
+	return m, nil
+}
+
+// String implements fmt.Stringer.
+func (m *MCFG) String() string {
+	l := []string{HeaderString(m)}
+	for _, s := range m.Segments {
+		l = append(l, fmt.Sprintf("  segment %d, buses %d-%d at %#x", s.Segment, s.StartBus, s.EndBus, s.Base))
+	}
+	return strings.Join(l, "\n")
+}
@@ -0,0 +1,239 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package acpi
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"path"
+
+	"github.com/u-root/u-root/pkg/cpio"
+)
+
+// UpgradeDir is where the kernel looks for tables in the initramfs, when it
+// is built with CONFIG_ACPI_TABLE_UPGRADE. See
+// Documentation/admin-guide/acpi/initrd_table_override.rst.
+const UpgradeDir = "kernel/firmware/acpi"
+
+// creatorID is the creator ID of the tables made by NewTable.
+var creatorID = []byte("UROT")
+
+// NewTable returns a table made of a standard header and body. The length
+// and checksum are computed, the creator is u-root.
+func NewTable(sig string, revision uint8, oemID, oemTableID string, oemRevision uint32, body []byte) (Table, error) {
+	if len(sig) != 4 || len(oemID) > 6 || len(oemTableID) > 8 {
+		return nil, fmt.Errorf("bad header: signature %q, OEM ID %q, OEM table ID %q", sig, oemID, oemTableID)
+	}
+	b := make([]byte, headerLength, headerLength+len(body))
+	copy(b, sig)
+	b[8] = revision
+	copy(b[10:16], oemID)
+	copy(b[16:24], oemTableID)
+	binary.LittleEndian.PutUint32(b[24:], oemRevision)
+	copy(b[28:32], creatorID)
+	return Fix(append(b, body...))
+}
+
+// Fix returns the table in b, with its length and checksum set, e.g. after
+// it was patched or truncated. b is used by the table.
+func Fix(b []byte) (Table, error) {
+	if len(b) < headerLength {
+		return nil, fmt.Errorf("table is %d bytes, want at least %d", len(b), headerLength)
+	}
+	binary.LittleEndian.PutUint32(b[lengthOffset:], uint32(len(b)))
+	// The FACS has neither checksum nor standard header.
+	if string(b[:4]) != "FACS" {
+		b[cSUMOffset] = 0
+		b[cSUMOffset] = gencsum(b)
+	}
+	return &Raw{data: b}, nil
+}
+
+// Same returns whether a and b are the same table, as far as overriding is
+// concerned: they have the same signature and, as there can be many SSDTs,
+// the same OEM table ID. This is how the kernel matches upgraded tables.
+func Same(a, b Table) bool {
+	if a.Sig() != b.Sig() {
+		return false
+	}
+	return a.Sig() != "SSDT" || bytes.Equal(a.Data()[16:24], b.Data()[16:24])
+}
+
+// Replace returns tabs with each of news replacing the tables it is the
+// Same as. The new tables matching none are appended, e.g. an SSDT to
+// inject.
+func Replace(tabs []Table, news ...Table) []Table {
+	res := append([]Table(nil), tabs...)
+	for _, n := range news {
+		found := false
+		for i, t := range res {
+			if Same(t, n) {
+				res[i] = n
+				found = true
+			}
+		}
+		if !found {
+			res = append(res, n)
+		}
+	}
+	return res
+}
+
+// Remove returns tabs without the tables with the signatures sigs.
+func Remove(tabs []Table, sigs ...string) []Table {
+	var res []Table
+	for _, t := range tabs {
+		keep := true
+		for _, s := range sigs {
+			if t.Sig() == s {
+				keep = false
+			}
+		}
+		if keep {
+			res = append(res, t)
+		}
+	}
+	return res
+}
+
+// WriteUpgrade writes tabs as an uncompressed newc cpio archive of
+// UpgradeDir, which is put before the initramfs of the kexec'd kernel for it
+// to use them instead of, or in addition to, the firmware's tables.
+func WriteUpgrade(w io.Writer, tabs ...Table) error {
+	rw := cpio.Newc.Writer(w)
+	var recs []cpio.Record
+	for d := UpgradeDir; d != "."; d = path.Dir(d) {
+		recs = append([]cpio.Record{cpio.Directory(d, 0755)}, recs...)
+	}
+	for i, n := range DumpNames(tabs) {
+		n = n[:len(n)-len(".dat")] + ".aml"
+		recs = append(recs, cpio.StaticRecord(tabs[i].Data(), cpio.Info{
+			Name: path.Join(UpgradeDir, n),
+			Mode: cpio.S_IFREG | 0644,
+		}))
+	}
+	if err := cpio.WriteRecords(rw, recs); err != nil {
+		return err
+	}
+	return cpio.WriteTrailer(rw)
+}
+
+// FADT fields pointing to the FACS and the DSDT, which are not in the XSDT.
+const (
+	fadtFirmwareCtrl  = 36
+	fadtDSDT          = 40
+	fadtXFirmwareCtrl = 132
+	fadtXDSDT         = 140
+)
+
+// align64 rounds n up to a multiple of 64, the alignment the FACS needs.
+func align64(n int) int {
+	return (n + 63) &^ 63
+}
+
+// Pack lays out tabs in a blob to be loaded at the physical address base,
+// e.g. as a kexec_load segment: an RSDP, an XSDT of the tables, then the
+// tables. The FADT is patched to point to the DSDT and FACS of tabs, which
+// the XSDT does not list. The RSDP is at base, which is what the kexec'd
+// kernel's acpi_rsdp= must be.
+func Pack(base int64, tabs ...Table) ([]byte, error) {
+	var (
+		list       []Table
+		dsdt, facs Table
+		fadt       []byte
+	)
+	for _, t := range tabs {
+		switch t.Sig() {
+		case "DSDT":
+			dsdt = t
+		case "FACS":
+			facs = t
+		case "FACP":
+			// The FADT is patched, copy it.
+			fadt = append([]byte(nil), t.Data()...)
+			list = append(list, nil)
+		default:
+			list = append(list, t)
+		}
+	}
+
+	// The RSDP, XSDT and tables, each aligned for the FACS' sake.
+	xsdtOff := align64(headerLength)
+	off := align64(xsdtOff + headerLength + 8*len(list))
+	addrs := map[Table]int{}
+	var order []Table
+	place := func(t Table) {
+		addrs[t] = off
+		order = append(order, t)
+		off = align64(off + len(t.Data()))
+	}
+	for _, t := range []Table{facs, dsdt} {
+		if t != nil {
+			place(t)
+		}
+	}
+	xsdt := make([]byte, 0, 8*len(list))
+	for _, t := range list {
+		if t == nil {
+			f, err := patchFADT(fadt, base, addrs[facs], addrs[dsdt], facs != nil, dsdt != nil)
+			if err != nil {
+				return nil, err
+			}
+			t = f
+		}
+		place(t)
+		var a [8]byte
+		binary.LittleEndian.PutUint64(a[:], uint64(base)+uint64(addrs[t]))
+		xsdt = append(xsdt, a[:]...)
+	}
+	x, err := NewTable("XSDT", 1, "UROOT", "UROOTSDT", 1, xsdt)
+	if err != nil {
+		return nil, err
+	}
+
+	b := make([]byte, off)
+	// The length of the RSDP is the length of its version 2 form.
+	copy(b, NewRSDP(uintptr(base)+uintptr(xsdtOff), headerLength))
+	copy(b[xsdtOff:], x.Data())
+	for _, t := range order {
+		copy(b[addrs[t]:], t.Data())
+	}
+	return b, nil
+}
+
+// patchFADT points the FADT f to the FACS and the DSDT at their offsets
+// from base, if they are given.
+func patchFADT(f []byte, base int64, facs, dsdt int, hasFACS, hasDSDT bool) (Table, error) {
+	if len(f) < fadtDSDT+4 {
+		return nil, fmt.Errorf("FACP: table is %d bytes, want at least %d", len(f), fadtDSDT+4)
+	}
+	for _, p := range []struct {
+		ok           bool
+		off          int
+		addr, addr64 int
+	}{
+		{hasFACS, facs, fadtFirmwareCtrl, fadtXFirmwareCtrl},
+		{hasDSDT, dsdt, fadtDSDT, fadtXDSDT},
+	} {
+		if !p.ok {
+			continue
+		}
+		a := uint64(base) + uint64(p.off)
+		if len(f) >= p.addr64+8 {
+			// The 64-bit address takes precedence; clear the
+			// 32-bit one, which must not differ.
+			binary.LittleEndian.PutUint64(f[p.addr64:], a)
+			binary.LittleEndian.PutUint32(f[p.addr:], 0)
+			continue
+		}
+		if a > 0xffffffff {
+			return nil, fmt.Errorf("FACP: revision %d has no room for 64-bit address %#x", f[8], a)
+		}
+		binary.LittleEndian.PutUint32(f[p.addr:], uint32(a))
+	}
+	return Fix(f)
+}
@@ -0,0 +1,173 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package acpi
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/u-root/u-root/pkg/cpio"
+)
+
+func TestNewTable(t *testing.T) {
+	tab, err := NewTable("SSDT", 2, "UROOT", "CPUHP", 3, []byte{1, 2, 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tab.Len() != headerLength+3 || !ChecksumValid(tab) {
+		t.Errorf("NewTable() = %s, want %d bytes and a valid checksum", HeaderString(tab), headerLength+3)
+	}
+	d := tab.Data()
+	if tab.Revision() != 2 || idString(d[10:16]) != "UROOT" || idString(d[16:24]) != "CPUHP" || tab.OEMRevision() != 3 {
+		t.Errorf("NewTable() = %s", HeaderString(tab))
+	}
+	if _, err := NewTable("SSDT", 2, "UROOT", "NINECHARS", 3, nil); err == nil {
+		t.Errorf("NewTable() with a 9-byte OEM table ID succeeded")
+	}
+
+	// Patch the SRAT, then fix its checksum.
+	srat := append([]byte(nil), mkTable(t, "SRAT", make([]byte, 12)).Data()...)
+	srat[headerLength] = 1
+	srat = srat[:len(srat)-4]
+	tab, err = Fix(srat)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tab.Len() != uint32(len(srat)) || !ChecksumValid(tab) {
+		t.Errorf("Fix() = %s, want %d bytes and a valid checksum", HeaderString(tab), len(srat))
+	}
+}
+
+func ssdt(t *testing.T, id string, body ...byte) Table {
+	tab, err := NewTable("SSDT", 2, "UROOT", id, 1, body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tab
+}
+
+func TestReplace(t *testing.T) {
+	dsdt, srat := mkTable(t, "DSDT"), mkTable(t, "SRAT")
+	ssdt1, ssdt2 := ssdt(t, "ONE"), ssdt(t, "TWO")
+	tabs := []Table{dsdt, ssdt1, srat, ssdt2}
+
+	newSRAT, newSSDT2, newSSDT3 := mkTable(t, "SRAT", []byte{1}), ssdt(t, "TWO", 1), ssdt(t, "THREE")
+	got := Replace(tabs, newSRAT, newSSDT2, newSSDT3)
+	want := []Table{dsdt, ssdt1, newSRAT, newSSDT2, newSSDT3}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Replace() = %v, want %v", got, want)
+	}
+	if tabs[2] != srat {
+		t.Errorf("Replace() modified its argument")
+	}
+
+	got = Remove(tabs, "SSDT", "DSDT")
+	if want := []Table{srat}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Remove() = %v, want %v", got, want)
+	}
+}
+
+func TestWriteUpgrade(t *testing.T) {
+	tabs := []Table{mkTable(t, "SRAT", []byte{1}), ssdt(t, "ONE"), ssdt(t, "TWO")}
+	var b bytes.Buffer
+	if err := WriteUpgrade(&b, tabs...); err != nil {
+		t.Fatal(err)
+	}
+	recs, err := cpio.ReadAllRecords(cpio.Newc.Reader(bytes.NewReader(b.Bytes())))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"kernel", "kernel/firmware", "kernel/firmware/acpi",
+		"kernel/firmware/acpi/srat.aml", "kernel/firmware/acpi/ssdt1.aml", "kernel/firmware/acpi/ssdt2.aml"}
+	var names []string
+	for _, r := range recs {
+		names = append(names, r.Name)
+	}
+	if !reflect.DeepEqual(names, want) {
+		t.Fatalf("archive has %q, want %q", names, want)
+	}
+	for i, tab := range tabs {
+		r := recs[3+i]
+		d := make([]byte, r.FileSize)
+		if _, err := r.ReadAt(d, 0); err != nil && err != io.EOF {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(d, tab.Data()) {
+			t.Errorf("%s is % x, want % x", r.Name, d, tab.Data())
+		}
+	}
+}
+
+func TestPack(t *testing.T) {
+	const base = 0x100000000
+	dsdt := mkTable(t, "DSDT", []byte{1, 2, 3})
+	// A version 6 FADT, with room for the 64-bit addresses.
+	fadt := mkTable(t, "FACP", make([]byte, 276-headerLength))
+	facs := mkTable(t, "FACS", make([]byte, 64-headerLength))
+	srat := mkTable(t, "SRAT", make([]byte, 12))
+
+	b, err := Pack(base, dsdt, fadt, facs, srat)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(b, []byte("RSDP PTR")) || gencsum(b[:20]) != 0 || gencsum(b[:headerLength]) != 0 {
+		t.Fatalf("blob does not start with a valid RSDP: % x", b[:headerLength])
+	}
+	table := func(addr uint64) Table {
+		off := addr - base
+		if addr < base || off+headerLength > uint64(len(b)) {
+			t.Fatalf("address %#x is out of the blob", addr)
+		}
+		tabs, err := NewRaw(b[off : off+uint64(binary.LittleEndian.Uint32(b[off+4:]))])
+		if err != nil {
+			t.Fatal(err)
+		}
+		return tabs[0]
+	}
+	x := table(binary.LittleEndian.Uint64(b[xSDTAddrOff:]))
+	if x.Sig() != "XSDT" || !ChecksumValid(x) {
+		t.Fatalf("XSDT: %s", HeaderString(x))
+	}
+	sdt, err := NewSDT(x, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sdt.Addrs) != 2 {
+		t.Fatalf("XSDT has %d tables, want 2", len(sdt.Addrs))
+	}
+	f, s := table(uint64(sdt.Addrs[0])), table(uint64(sdt.Addrs[1]))
+	if f.Sig() != "FACP" || !ChecksumValid(f) || !bytes.Equal(s.Data(), srat.Data()) {
+		t.Fatalf("XSDT lists %s and %s", HeaderString(f), HeaderString(s))
+	}
+
+	fd := f.Data()
+	if got := table(binary.LittleEndian.Uint64(fd[fadtXDSDT:])); !bytes.Equal(got.Data(), dsdt.Data()) {
+		t.Errorf("X_DSDT points to %s", HeaderString(got))
+	}
+	a := binary.LittleEndian.Uint64(fd[fadtXFirmwareCtrl:])
+	if got := table(a); a%64 != 0 || !bytes.Equal(got.Data(), facs.Data()) {
+		t.Errorf("X_FIRMWARE_CTRL %#x points to %s", a, HeaderString(got))
+	}
+	if binary.LittleEndian.Uint32(fd[fadtDSDT:]) != 0 || binary.LittleEndian.Uint32(fd[fadtFirmwareCtrl:]) != 0 {
+		t.Errorf("32-bit FADT addresses are set")
+	}
+
+	// A version 1 FADT has no 64-bit addresses.
+	fadt = mkTable(t, "FACP", make([]byte, 116-headerLength))
+	if _, err := Pack(base, dsdt, fadt); err == nil {
+		t.Errorf("Pack() of a version 1 FADT above 4 GiB succeeded")
+	}
+	b, err = Pack(0x1000, dsdt, fadt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fd = b[binary.LittleEndian.Uint64(b[xSDTAddrOff:])-0x1000+headerLength:]
+	if a := binary.LittleEndian.Uint32(fd[:8]); a-0x1000 >= uint32(len(b)) {
+		t.Errorf("XSDT entry %#x is out of the blob", a)
+	}
+}
@@ -28,6 +28,8 @@ type RSDP struct {
 func NewRSDP(addr uintptr, len uint) []byte {
 	var r [headerLength]byte
 	copy(r[:], defaultRSDP)
+	// defaultRSDP has a space where the checksum goes.
+	r[cSUM1Off] = 0
 
 	// This is a bit of a cheat. All the fields are 0.  So we get a
 	// checksum, set up the XSDT fields, get the second checksum.
@@ -0,0 +1,134 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package acpi
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+)
+
+// SPCR is the Serial Port Console Redirection table. It describes the
+// serial console the firmware used.
+type SPCR struct {
+	Table
+
+	InterfaceType uint8
+	// AddressSpace is 0 for memory mapped registers and 1 for I/O
+	// ports.
+	AddressSpace  uint8
+	RegisterWidth uint8
+	BaseAddress   uint64
+	InterruptType uint8
+	IRQ           uint8
+	GSI           uint32
+	// Baud is the baud rate, 0 if the firmware left it as it was.
+	Baud         uint32
+	Parity       uint8
+	StopBits     uint8
+	FlowControl  uint8
+	TerminalType uint8
+}
+
+// spcrInterfaces names the common serial interface types.
+var spcrInterfaces = map[uint8]string{
+	0x00: "16550",
+	0x01: "16450",
+	0x03: "ARM PL011",
+	0x0e: "ARM SBSA generic",
+	0x12: "16550-compatible",
+}
+
+var spcrBauds = map[uint8]uint32{
+	3: 9600,
+	4: 19200,
+	6: 57600,
+	7: 115200,
+}
+
+// NewSPCR decodes the SPCR t.
+func NewSPCR(t Table) (*SPCR, error) {
+	if t.Sig() != "SPCR" {
+		return nil, fmt.Errorf("table %s is not an SPCR", t.Sig())
+	}
+	b, err := fixed(t, 28)
+	if err != nil {
+		return nil, err
+	}
+	return &SPCR{
+		Table:         t,
+		InterfaceType: b[0],
+		AddressSpace:  b[4],
+		RegisterWidth: b[5],
+		BaseAddress:   binary.LittleEndian.Uint64(b[8:]),
+		InterruptType: b[16],
+		IRQ:           b[17],
+		GSI:           binary.LittleEndian.Uint32(b[18:]),
+		Baud:          spcrBauds[b[22]],
+		Parity:        b[23],
+		StopBits:      b[24],
+		FlowControl:   b[25],
+		TerminalType:  b[26],
+	}, nil
+}
+
+// Interface returns the name of the serial interface type.
+func (s *SPCR) Interface() string {
+	if n, ok := spcrInterfaces[s.InterfaceType]; ok {
+		return n
+	}
+	return fmt.Sprintf("type %#02x", s.InterfaceType)
+}
+
+// Console returns the serial console as a Linux earlycon= value, e.g.
+// "uart8250,io,0x3f8,115200", or "" if there is no earlycon driver for
+// the interface.
+func (s *SPCR) Console() string {
+	var drv string
+	switch s.InterfaceType {
+	case 0x00, 0x01, 0x12:
+		drv = "uart8250"
+	case 0x03, 0x0e:
+		drv = "pl011"
+	default:
+		return ""
+	}
+	space := "mmio"
+	if s.AddressSpace == 1 {
+		space = "io"
+	} else if drv == "uart8250" && s.RegisterWidth == 32 {
+		space = "mmio32"
+	}
+	c := fmt.Sprintf("%s,%s,%#x", drv, space, s.BaseAddress)
+	if drv == "pl011" {
+		c = fmt.Sprintf("%s,%#x", drv, s.BaseAddress)
+	}
+	if s.Baud != 0 {
+		c += fmt.Sprintf(",%d", s.Baud)
+	}
+	return c
+}
+
+// String implements fmt.Stringer.
+func (s *SPCR) String() string {
+	space := "memory"
+	if s.AddressSpace == 1 {
+		space = "I/O"
+	}
+	baud := "as configured"
+	if s.Baud != 0 {
+		baud = fmt.Sprint(s.Baud)
+	}
+	l := []string{
+		HeaderString(s),
+		fmt.Sprintf("  interface %s, %s address %#x, register width %d", s.Interface(), space, s.BaseAddress, s.RegisterWidth),
+		fmt.Sprintf("  interrupt type %#x, IRQ %d, GSI %d", s.InterruptType, s.IRQ, s.GSI),
+		fmt.Sprintf("  baud %s, parity %d, stop bits %d, flow control %#x, terminal type %d", baud, s.Parity, s.StopBits, s.FlowControl, s.TerminalType),
+	}
+	if c := s.Console(); c != "" {
+		l = append(l, "  earlycon="+c)
+	}
+	return strings.Join(l, "\n")
+}
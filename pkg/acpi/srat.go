@@ -0,0 +1,121 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package acpi
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+)
+
+// SRAT entry types.
+const (
+	sratLocalAPIC = 0
+	sratMemory    = 1
+	sratX2APIC    = 2
+)
+
+// SRAT is the System Resource Affinity Table. It assigns processors and
+// memory ranges to NUMA proximity domains.
+type SRAT struct {
+	Table
+
+	CPUs   []CPUAffinity
+	Memory []MemoryAffinity
+}
+
+// CPUAffinity is the proximity domain of a processor, identified by its
+// local APIC or x2APIC ID.
+type CPUAffinity struct {
+	ProximityDomain uint32
+	APICID          uint32
+	X2APIC          bool
+	Flags           uint32
+}
+
+// Enabled returns true if the entry is in use.
+func (c CPUAffinity) Enabled() bool {
+	return c.Flags&1 != 0
+}
+
+// MemoryAffinity is the proximity domain of a range of memory.
+type MemoryAffinity struct {
+	ProximityDomain uint32
+	Base            uint64
+	Length          uint64
+	Flags           uint32
+}
+
+// Enabled returns true if the entry is in use.
+func (m MemoryAffinity) Enabled() bool {
+	return m.Flags&1 != 0
+}
+
+// HotPluggable returns true if the range may be hot-plugged.
+func (m MemoryAffinity) HotPluggable() bool {
+	return m.Flags&2 != 0
+}
+
+// NewSRAT decodes the SRAT t.
+func NewSRAT(t Table) (*SRAT, error) {
+	if t.Sig() != "SRAT" {
+		return nil, fmt.Errorf("table %s is not an SRAT", t.Sig())
+	}
+	// 12 reserved bytes precede the entries.
+	b, err := fixed(t, 12)
+	if err != nil {
+		return nil, err
+	}
+	st, err := subtables("SRAT", b[12:])
+	if err != nil {
+		return nil, err
+	}
+	s := &SRAT{Table: t}
+	for _, e := range st {
+		d := e.data
+		switch {
+		case e.typ == sratLocalAPIC && len(d) >= 16:
+			// The proximity domain is split in bits 0-7 and 8-31.
+			pd := uint32(d[2]) | uint32(d[9])<<8 | uint32(d[10])<<16 | uint32(d[11])<<24
+			s.CPUs = append(s.CPUs, CPUAffinity{
+				ProximityDomain: pd,
+				APICID:          uint32(d[3]),
+				Flags:           binary.LittleEndian.Uint32(d[4:]),
+			})
+		case e.typ == sratMemory && len(d) >= 40:
+			s.Memory = append(s.Memory, MemoryAffinity{
+				ProximityDomain: binary.LittleEndian.Uint32(d[2:]),
+				Base:            binary.LittleEndian.Uint64(d[8:]),
+				Length:          binary.LittleEndian.Uint64(d[16:]),
+				Flags:           binary.LittleEndian.Uint32(d[28:]),
+			})
+		case e.typ == sratX2APIC && len(d) >= 24:
+			s.CPUs = append(s.CPUs, CPUAffinity{
+				ProximityDomain: binary.LittleEndian.Uint32(d[4:]),
+				APICID:          binary.LittleEndian.Uint32(d[8:]),
+				X2APIC:          true,
+				Flags:           binary.LittleEndian.Uint32(d[12:]),
+			})
+		}
+	}
+	return s, nil
+}
+
+// String implements fmt.Stringer.
+func (s *SRAT) String() string {
+	l := []string{HeaderString(s)}
+	for _, c := range s.CPUs {
+		kind := "APIC"
+		if c.X2APIC {
+			kind = "x2APIC"
+		}
+		l = append(l, fmt.Sprintf("  CPU: %s ID %d, proximity domain %d, enabled %t", kind, c.APICID, c.ProximityDomain, c.Enabled()))
+	}
+	for _, m := range s.Memory {
+		l = append(l, fmt.Sprintf("  memory: [%#016x-%#016x], proximity domain %d, enabled %t, hot-pluggable %t",
+			m.Base, m.Base+m.Length-1, m.ProximityDomain, m.Enabled(), m.HotPluggable()))
+	}
+	return strings.Join(l, "\n")
+}
@@ -82,8 +82,14 @@ func getBBLock(bblock string) (*lockfile.Lockfile, error) {
 
 // BuildBusybox builds a busybox of the given Go packages.
 //
-// pkgs is a list of Go import paths. If nil is returned, binaryPath will hold
-// the busybox-style binary.
+// pkgs is a list of Go import paths or of directories of Go commands. If nil
+// is returned, binaryPath will hold the busybox-style binary.
+//
+// Packages do not have to be in the u-root tree. The rewritten files of a
+// package are written to its .bb subdirectory if it is in GOPATH, so that it
+// keeps using its own vendored and internal packages, and to
+// u-root/bb/external/ otherwise. Commands outside of GOPATH can only use
+// packages in GOPATH and the standard library.
 func BuildBusybox(env golang.Environ, pkgs []string, noStrip bool, binaryPath string) error {
 	urootPkg, err := env.Package("github.com/u-root/u-root")
 	if err != nil {
@@ -145,12 +151,16 @@ func BuildBusybox(env golang.Environ, pkgs []string, noStrip bool, binaryPath st
 		}
 		seenPackages[basePkg] = true
 
-		// TODO: use bbDir to derive import path below or vice versa.
-		if err := RewritePackage(env, pkg, "github.com/u-root/u-root/pkg/bb/bbmain", importer); err != nil {
+		buildp, err := findPackage(env, pkg)
+		if err != nil {
+			return err
+		}
+		dest, importPath := bbPackagePath(buildp, bbDir)
+		if err := rewriteBuildPackage(buildp, dest, "github.com/u-root/u-root/pkg/bb/bbmain", importer); err != nil {
 			return err
 		}
 
-		bbPackages = append(bbPackages, path.Join(pkg, ".bb"))
+		bbPackages = append(bbPackages, importPath)
 	}
 
 	bb, err := NewPackageFromEnv(env, "github.com/u-root/u-root/pkg/bb/bbmain/cmd", importer)
@@ -286,21 +296,54 @@ func SrcFiles(p *build.Package) []string {
 	return files
 }
 
-// RewritePackage rewrites pkgPath to be bb-mode compatible, where destDir is
-// the file system destination of the written files and bbImportPath is the Go
-// import path of the bb package to register with.
+// findPackage returns the package pkg, which is an import path or the
+// directory of a package.
+func findPackage(env golang.Environ, pkg string) (*build.Package, error) {
+	if filepath.IsAbs(pkg) || build.IsLocalImport(pkg) {
+		return env.PackageByPath(pkg)
+	}
+	return env.Package(pkg)
+}
+
+// bbImportPrefix is the import path of the rewritten packages outside of
+// GOPATH, in the bb directory.
+const bbImportPrefix = "github.com/u-root/u-root/bb/external"
+
+// bbPackagePath returns the directory the rewritten files of p are written
+// to, and its import path.
+//
+// Packages outside of GOPATH have no import path to add .bb to, so they are
+// rewritten into bbDir.
+func bbPackagePath(p *build.Package, bbDir string) (string, string) {
+	if p.ImportPath == "." || build.IsLocalImport(p.ImportPath) || filepath.IsAbs(p.ImportPath) {
+		name := filepath.Base(p.Dir)
+		return filepath.Join(bbDir, "external", name), path.Join(bbImportPrefix, name)
+	}
+	return filepath.Join(p.Dir, ".bb"), path.Join(p.ImportPath, ".bb")
+}
+
+// RewritePackage rewrites pkgPath to be bb-mode compatible, into its .bb
+// subdirectory, where bbImportPath is the Go import path of the bb package to
+// register with.
 func RewritePackage(env golang.Environ, pkgPath, bbImportPath string, importer types.Importer) error {
-	buildp, err := env.Package(pkgPath)
+	buildp, err := findPackage(env, pkgPath)
 	if err != nil {
 		return err
 	}
+	return rewriteBuildPackage(buildp, filepath.Join(buildp.Dir, ".bb"), bbImportPath, importer)
+}
 
-	p, err := NewPackage(filepath.Base(buildp.Dir), buildp.ImportPath, SrcFiles(buildp), importer)
+// rewriteBuildPackage rewrites buildp into dest.
+func rewriteBuildPackage(buildp *build.Package, dest, bbImportPath string, importer types.Importer) error {
+	importPath := buildp.ImportPath
+	if importPath == "." {
+		importPath = "main"
+	}
+	p, err := NewPackage(filepath.Base(buildp.Dir), importPath, SrcFiles(buildp), importer)
 	if err != nil {
 		return err
 	}
-	dest := filepath.Join(buildp.Dir, ".bb")
-	// If .bb directory already exists, delete it. This will prevent stale
+	// If the directory already exists, delete it. This will prevent stale
 	// files from being included in the build.
 	if err := os.RemoveAll(dest); err != nil {
 		return fmt.Errorf("error removing stale directory %q: %v", dest, err)
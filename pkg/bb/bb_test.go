@@ -5,6 +5,7 @@
 package bb
 
 import (
+	"go/build"
 	"io/ioutil"
 	"os"
 	"os/exec"
@@ -32,3 +33,26 @@ func TestPackageRewriteFile(t *testing.T) {
 		t.Fatalf("foo failed: %v %v", string(o), err)
 	}
 }
+
+func TestBBPackagePath(t *testing.T) {
+	for _, tt := range []struct {
+		p          *build.Package
+		dest, path string
+	}{
+		{
+			p:    &build.Package{Dir: "/go/src/example.com/tools/cmds/uinit", ImportPath: "example.com/tools/cmds/uinit"},
+			dest: "/go/src/example.com/tools/cmds/uinit/.bb",
+			path: "example.com/tools/cmds/uinit/.bb",
+		},
+		{
+			p:    &build.Package{Dir: "/home/me/mytool", ImportPath: "."},
+			dest: "/u-root/bb/external/mytool",
+			path: "github.com/u-root/u-root/bb/external/mytool",
+		},
+	} {
+		dest, path := bbPackagePath(tt.p, "/u-root/bb")
+		if dest != tt.dest || path != tt.path {
+			t.Errorf("bbPackagePath(%q) = %q, %q, want %q, %q", tt.p.Dir, dest, path, tt.dest, tt.path)
+		}
+	}
+}
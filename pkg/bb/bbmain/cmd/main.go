@@ -123,6 +123,6 @@ func init() {
 		os.Args = os.Args[1:]
 		run()
 	}
-	bbmain.Register("bb", bbmain.Noop, bbmain.ListCmds)
+	bbmain.Register("bb", bbmain.Noop, bbmain.Main)
 	bbmain.RegisterDefault(bbmain.Noop, m)
 }
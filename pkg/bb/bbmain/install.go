@@ -0,0 +1,130 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bbmain
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// Names returns the sorted names of the registered commands, bb itself
+// excluded.
+func Names() []string {
+	var names []string
+	for n := range bbCmds {
+		if n != "bb" {
+			names = append(names, n)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Install creates a link to the busybox binary bb in dir for every registered
+// command, so the commands can be run by name from dir.
+//
+// Links are hard links unless symlink is set. Existing links to bb are
+// recreated; other files are left alone and reported in the returned error.
+func Install(bb, dir string, symlink bool) error {
+	bbFI, err := os.Stat(bb)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	var skipped []string
+	for _, n := range Names() {
+		p := filepath.Join(dir, n)
+		if fi, err := os.Lstat(p); err == nil {
+			if !isLinkTo(p, fi, bbFI) {
+				skipped = append(skipped, p)
+				continue
+			}
+			if err := os.Remove(p); err != nil {
+				return err
+			}
+		}
+		if symlink {
+			err = os.Symlink(bb, p)
+		} else {
+			err = os.Link(bb, p)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if len(skipped) > 0 {
+		return fmt.Errorf("not replacing %q: not links to %s", skipped, bb)
+	}
+	return nil
+}
+
+// isLinkTo returns whether p, whose Lstat is fi, is a symlink or hard link to
+// the file of bbFI.
+func isLinkTo(p string, fi, bbFI os.FileInfo) bool {
+	if fi.Mode()&os.ModeSymlink != 0 {
+		target, err := os.Stat(p)
+		// Dangling symlinks are left from an older bb.
+		return err != nil || os.SameFile(target, bbFI)
+	}
+	return os.SameFile(fi, bbFI)
+}
+
+// Main is the main of the bb command itself.
+//
+// Without arguments it lists problems with the commands and /bbin, see
+// ListCmds. Otherwise it takes:
+//
+//     bb --list             list the commands
+//     bb --install [-s] DIR link the commands into DIR (symlinks with -s)
+//     bb command [args]     run command
+func Main() {
+	args := os.Args[1:]
+	if len(args) == 0 {
+		ListCmds()
+		return
+	}
+	switch args[0] {
+	case "--list":
+		for _, n := range Names() {
+			fmt.Println(n)
+		}
+	case "--install":
+		args = args[1:]
+		symlink := len(args) > 0 && args[0] == "-s"
+		if symlink {
+			args = args[1:]
+		}
+		if len(args) != 1 {
+			fmt.Fprintln(os.Stderr, "usage: bb --install [-s] DIR")
+			os.Exit(1)
+		}
+		bb, err := os.Executable()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "bb: %v\n", err)
+			os.Exit(1)
+		}
+		if err := Install(bb, args[0], symlink); err != nil {
+			fmt.Fprintf(os.Stderr, "bb: %v\n", err)
+			os.Exit(1)
+		}
+	case "--help", "-h":
+		fmt.Println("usage: bb [--list | --install [-s] DIR | command [args...]]")
+	default:
+		name := args[0]
+		if _, ok := bbCmds[name]; !ok || name == "bb" {
+			fmt.Fprintf(os.Stderr, "bb: %s: %v\n", name, ErrNotRegistered)
+			os.Exit(1)
+		}
+		os.Args = args
+		if err := Run(name); err != nil {
+			fmt.Fprintf(os.Stderr, "bb: %s: %v\n", name, err)
+			os.Exit(1)
+		}
+	}
+}
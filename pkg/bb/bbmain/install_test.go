@@ -0,0 +1,66 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bbmain
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestInstall(t *testing.T) {
+	for _, n := range []string{"bb", "ls", "cat"} {
+		Register(n, Noop, Noop)
+	}
+	defer func() {
+		bbCmds = map[string]bbCmd{}
+	}()
+	if got, want := Names(), []string{"cat", "ls"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Names() = %q, want %q", got, want)
+	}
+
+	dir, err := ioutil.TempDir("", "bbinstall")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	bb := filepath.Join(dir, "bb")
+	if err := ioutil.WriteFile(bb, []byte("bb"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	bin := filepath.Join(dir, "bin")
+
+	for _, symlink := range []bool{true, false, true} {
+		if err := Install(bb, bin, symlink); err != nil {
+			t.Fatalf("Install(symlink %t) = %v", symlink, err)
+		}
+		for _, n := range []string{"cat", "ls"} {
+			fi, err := os.Lstat(filepath.Join(bin, n))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := fi.Mode()&os.ModeSymlink != 0; got != symlink {
+				t.Errorf("%s is a symlink: %t, want %t", n, got, symlink)
+			}
+		}
+	}
+
+	// Files that are not bb are not replaced.
+	ls := filepath.Join(bin, "ls")
+	if err := os.Remove(ls); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(ls, []byte("ls"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := Install(bb, bin, false); err == nil {
+		t.Errorf("Install replaced a file")
+	}
+	if b, err := ioutil.ReadFile(ls); err != nil || string(b) != "ls" {
+		t.Errorf("ls = %q, %v, want ls", b, err)
+	}
+}
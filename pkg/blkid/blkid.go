@@ -0,0 +1,300 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package blkid identifies the file system or other content of a block
+// device from its superblock, and reads its label and UUID.
+//
+// Types are named as by util-linux' blkid, so they can be passed to mount.
+package blkid
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode/utf16"
+)
+
+// ErrUnknown is returned when no known superblock is found.
+var ErrUnknown = errors.New("unknown content")
+
+// Info is what probing found.
+type Info struct {
+	// Type is the file system type, such as ext4, or another kind of
+	// content, such as swap or crypto_LUKS.
+	Type  string
+	Label string
+	UUID  string
+}
+
+// Filesystem returns whether Type is a file system which can be mounted,
+// as opposed to swap, encrypted or LVM volumes.
+func (i *Info) Filesystem() bool {
+	switch i.Type {
+	case "swap", "crypto_LUKS", "LVM2_member", "jbd":
+		return false
+	}
+	return true
+}
+
+// prober recognizes one type. It returns nil if the superblock is not of
+// that type.
+type prober func(r io.ReaderAt) (*Info, error)
+
+// probers are tried in order. Types whose magic lives further into the
+// device come first, since some file systems do not clear the start of the
+// device when formatted over another.
+var probers = []prober{
+	probeBtrfs,
+	probeLUKS,
+	probeLVM,
+	probeSwap,
+	probeISO9660,
+	probeExt,
+	probeXFS,
+	probeF2FS,
+	probeSquashfs,
+	probeFAT,
+}
+
+// Probe identifies the content of r.
+func Probe(r io.ReaderAt) (*Info, error) {
+	for _, p := range probers {
+		i, err := p(r)
+		if err != nil {
+			return nil, err
+		}
+		if i != nil {
+			return i, nil
+		}
+	}
+	return nil, ErrUnknown
+}
+
+// ProbeFile identifies the content of the device or image at path.
+func ProbeFile(path string) (*Info, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	i, err := Probe(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return i, nil
+}
+
+// read reads n bytes at off. Devices shorter than off+n cannot have that
+// superblock, so a short read returns nil without an error.
+func read(r io.ReaderAt, off int64, n int) ([]byte, error) {
+	b := make([]byte, n)
+	m, err := r.ReadAt(b, off)
+	if m == n {
+		return b, nil
+	}
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return nil, nil
+	}
+	return nil, err
+}
+
+func hasMagic(r io.ReaderAt, off int64, magic string) (bool, error) {
+	b, err := read(r, off, len(magic))
+	if err != nil || b == nil {
+		return false, err
+	}
+	return string(b) == magic, nil
+}
+
+func uuid(b []byte) string {
+	if bytes.Equal(b, make([]byte, len(b))) {
+		return ""
+	}
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+// cstring returns b up to the first NUL, without trailing spaces.
+func cstring(b []byte) string {
+	if i := bytes.IndexByte(b, 0); i >= 0 {
+		b = b[:i]
+	}
+	return strings.TrimRight(string(b), " ")
+}
+
+var le = binary.LittleEndian
+
+// ext2, ext3 and ext4 share a superblock at 1024.
+func probeExt(r io.ReaderAt) (*Info, error) {
+	sb, err := read(r, 1024, 256)
+	if err != nil || sb == nil || le.Uint16(sb[0x38:]) != 0xef53 {
+		return nil, err
+	}
+	const (
+		compatHasJournal = 0x4
+
+		incompatJournalDev = 0x8
+		// Features ext2 and ext3 do not have.
+		incompatExt4 = 0x40 | 0x80 | 0x100 | 0x200 | 0x400 | 0x8000 | 0x10000
+		roCompatExt4 = 0x8 | 0x10 | 0x20 | 0x40 | 0x400
+	)
+	compat, incompat, roCompat := le.Uint32(sb[0x5c:]), le.Uint32(sb[0x60:]), le.Uint32(sb[0x64:])
+	i := &Info{
+		UUID:  uuid(sb[0x68:0x78]),
+		Label: cstring(sb[0x78:0x88]),
+	}
+	switch {
+	case incompat&incompatJournalDev != 0:
+		i.Type = "jbd"
+	case incompat&incompatExt4 != 0 || roCompat&roCompatExt4 != 0:
+		i.Type = "ext4"
+	case compat&compatHasJournal != 0:
+		i.Type = "ext3"
+	default:
+		i.Type = "ext2"
+	}
+	return i, nil
+}
+
+func probeFAT(r io.ReaderAt) (*Info, error) {
+	bs, err := read(r, 0, 512)
+	if err != nil || bs == nil || bs[510] != 0x55 || bs[511] != 0xaa {
+		return nil, err
+	}
+	// The extended boot record is at a different offset for FAT32.
+	var serial, label []byte
+	switch {
+	case string(bs[0x52:0x57]) == "FAT32":
+		serial, label = bs[0x43:0x47], bs[0x47:0x52]
+	case string(bs[0x36:0x39]) == "FAT" || string(bs[0x36:0x3b]) == "MSDOS":
+		serial, label = bs[0x27:0x2b], bs[0x2b:0x36]
+	default:
+		return nil, nil
+	}
+	i := &Info{Type: "vfat", Label: cstring(label)}
+	if i.Label == "NO NAME" {
+		i.Label = ""
+	}
+	if s := le.Uint32(serial); s != 0 {
+		i.UUID = fmt.Sprintf("%04X-%04X", s>>16, s&0xffff)
+	}
+	return i, nil
+}
+
+func probeXFS(r io.ReaderAt) (*Info, error) {
+	sb, err := read(r, 0, 120)
+	if err != nil || sb == nil || string(sb[:4]) != "XFSB" {
+		return nil, err
+	}
+	return &Info{Type: "xfs", UUID: uuid(sb[32:48]), Label: cstring(sb[108:120])}, nil
+}
+
+func probeBtrfs(r io.ReaderAt) (*Info, error) {
+	sb, err := read(r, 0x10000, 0x22b)
+	if err != nil || sb == nil || string(sb[0x40:0x48]) != "_BHRfS_M" {
+		return nil, err
+	}
+	return &Info{Type: "btrfs", UUID: uuid(sb[0x20:0x30]), Label: cstring(sb[0x12b:0x22b])}, nil
+}
+
+func probeSquashfs(r io.ReaderAt) (*Info, error) {
+	ok, err := hasMagic(r, 0, "hsqs")
+	if !ok {
+		return nil, err
+	}
+	return &Info{Type: "squashfs"}, nil
+}
+
+func probeISO9660(r io.ReaderAt) (*Info, error) {
+	// The primary volume descriptor is at sector 16.
+	pvd, err := read(r, 0x8000, 830)
+	if err != nil || pvd == nil || string(pvd[1:6]) != "CD001" {
+		return nil, err
+	}
+	i := &Info{Type: "iso9660", Label: cstring(pvd[40:72])}
+	// Like blkid, use the creation time as UUID.
+	if d := pvd[813:829]; d[0] != 0 && string(d) != "0000000000000000" {
+		i.UUID = fmt.Sprintf("%s-%s-%s-%s-%s-%s-%s", d[0:4], d[4:6], d[6:8], d[8:10], d[10:12], d[12:14], d[14:16])
+	}
+	return i, nil
+}
+
+func probeSwap(r io.ReaderAt) (*Info, error) {
+	// The signature is at the end of the first page, whose size depends
+	// on the architecture that created it.
+	for _, page := range []int64{4096, 8192, 16384, 65536} {
+		for _, sig := range []string{"SWAPSPACE2", "SWAP-SPACE"} {
+			ok, err := hasMagic(r, page-10, sig)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+			i := &Info{Type: "swap"}
+			if sig == "SWAPSPACE2" {
+				h, err := read(r, 1024, 44)
+				if err != nil || h == nil {
+					return nil, err
+				}
+				i.UUID, i.Label = uuid(h[12:28]), cstring(h[28:44])
+			}
+			return i, nil
+		}
+	}
+	return nil, nil
+}
+
+func probeF2FS(r io.ReaderAt) (*Info, error) {
+	sb, err := read(r, 0x400, 124+512*2)
+	if err != nil || sb == nil || le.Uint32(sb) != 0xf2f52010 {
+		return nil, err
+	}
+	name := make([]uint16, 512)
+	for j := range name {
+		name[j] = le.Uint16(sb[124+2*j:])
+	}
+	for j, c := range name {
+		if c == 0 {
+			name = name[:j]
+			break
+		}
+	}
+	return &Info{Type: "f2fs", UUID: uuid(sb[108:124]), Label: string(utf16.Decode(name))}, nil
+}
+
+func probeLUKS(r io.ReaderAt) (*Info, error) {
+	h, err := read(r, 0, 208)
+	if err != nil || h == nil || string(h[:6]) != "LUKS\xba\xbe" {
+		return nil, err
+	}
+	i := &Info{Type: "crypto_LUKS", UUID: cstring(h[168:208])}
+	// LUKS2 headers have a label.
+	if binary.BigEndian.Uint16(h[6:]) == 2 {
+		i.Label = cstring(h[24:72])
+	}
+	return i, nil
+}
+
+func probeLVM(r io.ReaderAt) (*Info, error) {
+	// The label is in one of the first four sectors.
+	for s := int64(0); s < 4; s++ {
+		l, err := read(r, s*512, 64)
+		if err != nil || l == nil {
+			return nil, err
+		}
+		if string(l[:8]) != "LABELONE" || string(l[24:32]) != "LVM2 001" {
+			continue
+		}
+		u := l[32:64]
+		return &Info{
+			Type: "LVM2_member",
+			UUID: fmt.Sprintf("%s-%s-%s-%s-%s-%s-%s", u[0:6], u[6:10], u[10:14], u[14:18], u[18:22], u[22:26], u[26:32]),
+		}, nil
+	}
+	return nil, nil
+}
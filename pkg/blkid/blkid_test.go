@@ -0,0 +1,151 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blkid
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	"github.com/u-root/u-root/pkg/mount/gpt"
+)
+
+var testUUID = []byte{0x12, 0x34, 0x56, 0x78, 0x9a, 0xbc, 0xde, 0xf0, 0x01, 0x23, 0x45, 0x67, 0x89, 0xab, 0xcd, 0xef}
+
+const testUUIDString = "12345678-9abc-def0-0123-456789abcdef"
+
+type image []byte
+
+func newImage(size int) image { return make(image, size) }
+
+func (i image) put(off int, b []byte) image {
+	copy(i[off:], b)
+	return i
+}
+
+func (i image) put16(off int, v uint16) image {
+	binary.LittleEndian.PutUint16(i[off:], v)
+	return i
+}
+
+func (i image) put32(off int, v uint32) image {
+	binary.LittleEndian.PutUint32(i[off:], v)
+	return i
+}
+
+func (i image) WriteAt(b []byte, off int64) (int, error) {
+	return copy(i[off:], b), nil
+}
+
+func ext(compat, incompat, roCompat uint32) image {
+	return newImage(4096).put16(1024+0x38, 0xef53).
+		put32(1024+0x5c, compat).put32(1024+0x60, incompat).put32(1024+0x64, roCompat).
+		put(1024+0x68, testUUID).put(1024+0x78, []byte("root"))
+}
+
+func TestProbe(t *testing.T) {
+	f2fsName := make([]byte, 8)
+	for j, c := range "data" {
+		binary.LittleEndian.PutUint16(f2fsName[2*j:], uint16(c))
+	}
+	for _, tt := range []struct {
+		name string
+		img  image
+		want Info
+	}{
+		{"ext2", ext(0, 0x2, 0x1), Info{Type: "ext2", Label: "root", UUID: testUUIDString}},
+		{"ext3", ext(0x4, 0x2, 0x1), Info{Type: "ext3", Label: "root", UUID: testUUIDString}},
+		{"ext4", ext(0x4, 0x2c2, 0x1), Info{Type: "ext4", Label: "root", UUID: testUUIDString}},
+		{"fat16", newImage(512).put(0x27, []byte{0xef, 0xbe, 0xad, 0xde}).put(0x2b, []byte("EFI        FAT16   ")).put(510, []byte{0x55, 0xaa}),
+			Info{Type: "vfat", Label: "EFI", UUID: "DEAD-BEEF"}},
+		{"fat32", newImage(512).put(0x43, []byte{0x34, 0x12, 0xcd, 0xab}).put(0x47, []byte("NO NAME    FAT32   ")).put(510, []byte{0x55, 0xaa}),
+			Info{Type: "vfat", UUID: "ABCD-1234"}},
+		{"xfs", newImage(512).put(0, []byte("XFSB")).put(32, testUUID).put(108, []byte("home")),
+			Info{Type: "xfs", Label: "home", UUID: testUUIDString}},
+		{"btrfs", newImage(0x10400).put(0x10020, testUUID).put(0x10040, []byte("_BHRfS_M")).put(0x1012b, []byte("pool")),
+			Info{Type: "btrfs", Label: "pool", UUID: testUUIDString}},
+		{"squashfs", newImage(512).put(0, []byte("hsqs")), Info{Type: "squashfs"}},
+		{"iso9660", newImage(0x9000).put(0x8001, []byte("CD001")).put(0x8028, []byte("UROOT                           ")).put(0x8000+813, []byte("2021010203040500")),
+			Info{Type: "iso9660", Label: "UROOT", UUID: "2021-01-02-03-04-05-00"}},
+		{"swap", newImage(4096).put(1024+12, testUUID).put(1024+28, []byte("swap0")).put(4086, []byte("SWAPSPACE2")),
+			Info{Type: "swap", Label: "swap0", UUID: testUUIDString}},
+		{"f2fs", newImage(8192).put32(0x400, 0xf2f52010).put(0x400+108, testUUID).put(0x400+124, f2fsName),
+			Info{Type: "f2fs", Label: "data", UUID: testUUIDString}},
+		{"luks2", newImage(4096).put(0, []byte("LUKS\xba\xbe\x00\x02")).put(24, []byte("secret")).put(168, []byte(testUUIDString)),
+			Info{Type: "crypto_LUKS", Label: "secret", UUID: testUUIDString}},
+		{"lvm", newImage(4096).put(512, []byte("LABELONE")).put(512+24, []byte("LVM2 001abcdefghijklmnopqrstuvwxyz012345")),
+			Info{Type: "LVM2_member", UUID: "abcdef-ghij-klmn-opqr-stuv-wxyz-012345"}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Probe(bytes.NewReader(tt.img))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if *got != tt.want {
+				t.Errorf("Probe = %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProbeUnknown(t *testing.T) {
+	for _, size := range []int{0, 100, 1 << 20} {
+		if _, err := Probe(bytes.NewReader(newImage(size))); !errors.Is(err, ErrUnknown) {
+			t.Errorf("Probe(%d zero bytes) = %v, want %v", size, err, ErrUnknown)
+		}
+	}
+}
+
+func TestProbePartition(t *testing.T) {
+	const sectors = 1 << 12
+	disk := newImage(sectors * gpt.BlockSize)
+	p, err := gpt.NewTable(sectors)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.Add(2, gpt.Types["efi"], 0, 0, "EFI System"); err != nil {
+		t.Fatal(err)
+	}
+	if err := gpt.Write(disk, p); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ProbePartition(bytes.NewReader(disk), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := PartInfo{UUID: p.Primary.Parts[1].UniqueGUID.String(), Label: "EFI System", Type: "c12a7328-f81f-11d2-ba4b-00a0c93ec93b"}
+	if *got != want {
+		t.Errorf("ProbePartition(GPT, 2) = %+v, want %+v", *got, want)
+	}
+	if _, err := ProbePartition(bytes.NewReader(disk), 1); err == nil {
+		t.Errorf("ProbePartition of an unused GPT entry succeeded")
+	}
+
+	mbr := newImage(512).put32(440, 0x1234abcd).put(446+16+4, []byte{0x83}).put(510, []byte{0x55, 0xaa})
+	for _, tt := range []struct {
+		n    int
+		want PartInfo
+	}{
+		{2, PartInfo{UUID: "1234abcd-02", Type: "0x83"}},
+		{5, PartInfo{UUID: "1234abcd-05"}},
+	} {
+		got, err := ProbePartition(bytes.NewReader(mbr), tt.n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if *got != tt.want {
+			t.Errorf("ProbePartition(MBR, %d) = %+v, want %+v", tt.n, *got, tt.want)
+		}
+	}
+	for _, n := range []int{0, 1} {
+		if _, err := ProbePartition(bytes.NewReader(mbr), n); err == nil {
+			t.Errorf("ProbePartition(MBR, %d) succeeded", n)
+		}
+	}
+	if _, err := ProbePartition(bytes.NewReader(newImage(512)), 1); !errors.Is(err, ErrUnknown) {
+		t.Errorf("ProbePartition(zeroes) = %v, want %v", err, ErrUnknown)
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blkid
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/u-root/u-root/pkg/mount/gpt"
+)
+
+// PartInfo is what the partition table of a disk says about one of its
+// partitions.
+type PartInfo struct {
+	// UUID is the GPT partition GUID, or for MBRs the disk signature and
+	// the partition number, as for root=PARTUUID= of Linux.
+	UUID string
+	// Label is the GPT partition name. MBR partitions have none.
+	Label string
+	// Type is the GPT partition type GUID, or the MBR partition type,
+	// e.g. 0x83.
+	Type string
+}
+
+// ProbePartition reads partition n, from 1, of the GPT or MBR of disk.
+func ProbePartition(disk io.ReaderAt, n int) (*PartInfo, error) {
+	if n < 1 {
+		return nil, fmt.Errorf("invalid partition number %d", n)
+	}
+	mbr, err := read(disk, 0, 512)
+	if err != nil {
+		return nil, err
+	}
+	if mbr == nil || mbr[510] != 0x55 || mbr[511] != 0xaa {
+		return nil, fmt.Errorf("no partition table: %w", ErrUnknown)
+	}
+	// A protective MBR's only partition is of type 0xee.
+	if mbr[446+4] == 0xee {
+		g, err := gpt.Table(disk, gpt.HeaderOff)
+		if err != nil {
+			return nil, err
+		}
+		if n > len(g.Parts) || !g.Parts[n-1].IsUsed() {
+			return nil, fmt.Errorf("no partition %d in the GPT", n)
+		}
+		p := g.Parts[n-1]
+		return &PartInfo{
+			UUID:  p.UniqueGUID.String(),
+			Label: p.Name.Text(),
+			Type:  p.PartGUID.String(),
+		}, nil
+	}
+	i := &PartInfo{UUID: fmt.Sprintf("%08x-%02x", le.Uint32(mbr[440:]), n)}
+	// Logical partitions are in extended boot records.
+	if n <= 4 {
+		t := mbr[446+16*(n-1)+4]
+		if t == 0 {
+			return nil, fmt.Errorf("no partition %d in the MBR", n)
+		}
+		i.Type = fmt.Sprintf("%#x", t)
+	}
+	return i, nil
+}
@@ -11,11 +11,16 @@
 // This package also supports the systemd-boot loader.conf as described in
 // https://www.freedesktop.org/software/systemd/man/loader.conf.html. Only the
 // "default" keyword is implemented.
+//
+// Entries are looked for in $BOOT/loader/entries, where $BOOT is the root of
+// the file system, or its boot directory if /boot is not a separate
+// partition.
 package bls
 
 import (
 	"bufio"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"sort"
@@ -30,22 +35,25 @@ const (
 )
 
 func cutConf(s string) string {
-	if strings.HasSuffix(s, ".conf") {
-		return s[:len(s)-6]
-	}
-	return s
+	return strings.TrimSuffix(s, ".conf")
 }
 
 // ScanBLSEntries scans the filesystem root for valid BLS entries.
 // This function skips over invalid or unreadable entries in an effort
 // to return everything that is bootable.
 func ScanBLSEntries(log ulog.Logger, fsRoot string) ([]boot.OSImage, error) {
-	entriesDir := filepath.Join(fsRoot, blsEntriesDir)
-
-	files, err := filepath.Glob(filepath.Join(entriesDir, "*.conf"))
+	files, err := filepath.Glob(filepath.Join(fsRoot, blsEntriesDir, "*.conf"))
 	if err != nil {
 		return nil, fmt.Errorf("no BootLoaderSpec entries found: %w", err)
 	}
+	if len(files) == 0 {
+		// The root file system of a distribution without a separate
+		// /boot partition.
+		bootDir := filepath.Join(fsRoot, "boot")
+		if files, _ = filepath.Glob(filepath.Join(bootDir, blsEntriesDir, "*.conf")); len(files) > 0 {
+			fsRoot = bootDir
+		}
+	}
 
 	// loader.conf is not in the real spec; it's an implementation detail
 	// of systemd-boot. It is specified in
@@ -53,7 +61,7 @@ func ScanBLSEntries(log ulog.Logger, fsRoot string) ([]boot.OSImage, error) {
 	loaderConf, err := parseConf(filepath.Join(fsRoot, "loader", "loader.conf"))
 	if err != nil {
 		// loader.conf is optional.
-		loaderConf = make(map[string]string)
+		loaderConf = make(map[string][]string)
 	}
 
 	// TODO: Rank entries by version or machine-id attribute as suggested
@@ -73,14 +81,14 @@ func ScanBLSEntries(log ulog.Logger, fsRoot string) ([]boot.OSImage, error) {
 	return sortImages(loaderConf, imgs), nil
 }
 
-func sortImages(loaderConf map[string]string, imgs map[string]boot.OSImage) []boot.OSImage {
+func sortImages(loaderConf map[string][]string, imgs map[string]boot.OSImage) []boot.OSImage {
 	// rankedImages = sort(default-images) + sort(remaining images)
 	var rankedImages []boot.OSImage
 
-	pattern, ok := loaderConf["default"]
-	if !ok {
-		// All images are default.
-		pattern = "*"
+	// All images are default if there is no default keyword.
+	pattern := "*"
+	if v, ok := loaderConf["default"]; ok {
+		pattern = v[len(v)-1]
 	}
 
 	var defaultIdents []string
@@ -89,7 +97,7 @@ func sortImages(loaderConf map[string]string, imgs map[string]boot.OSImage) []bo
 	// Find default and non-default identifiers.
 	for ident := range imgs {
 		ok, err := filepath.Match(pattern, ident)
-		if err != nil && ok {
+		if err == nil && ok {
 			defaultIdents = append(defaultIdents, ident)
 		} else {
 			otherIdents = append(otherIdents, ident)
@@ -110,14 +118,17 @@ func sortImages(loaderConf map[string]string, imgs map[string]boot.OSImage) []bo
 	return rankedImages
 }
 
-func parseConf(entryPath string) (map[string]string, error) {
+// parseConf returns the values of the keys of a config file, in the order
+// they appear, as keys such as options and initrd may be given more than
+// once.
+func parseConf(entryPath string) (map[string][]string, error) {
 	f, err := os.Open(entryPath)
 	if err != nil {
 		return nil, err
 	}
 	defer f.Close()
 
-	vals := make(map[string]string)
+	vals := make(map[string][]string)
 
 	scanner := bufio.NewScanner(f)
 	for scanner.Scan() {
@@ -127,11 +138,11 @@ func parseConf(entryPath string) (map[string]string, error) {
 		}
 		line = strings.TrimSpace(line)
 
-		sline := strings.SplitN(line, " ", 2)
-		if len(sline) != 2 {
+		sline := strings.Fields(line)
+		if len(sline) < 2 {
 			continue
 		}
-		vals[sline[0]] = strings.TrimSpace(sline[1])
+		vals[sline[0]] = append(vals[sline[0]], strings.Join(sline[1:], " "))
 	}
 	return vals, nil
 }
@@ -147,54 +158,65 @@ func filePath(fsRoot, value string) string {
 	return filepath.Join(fsRoot, value)
 }
 
-func parseLinuxImage(vals map[string]string, fsRoot string) (boot.OSImage, error) {
+// value returns the last value of key, as a key given more than once
+// overrides the previous ones.
+func value(vals map[string][]string, key string) string {
+	if v := vals[key]; len(v) > 0 {
+		return v[len(v)-1]
+	}
+	return ""
+}
+
+func parseLinuxImage(vals map[string][]string, fsRoot string) (boot.OSImage, error) {
 	linux := &boot.LinuxImage{}
 
-	var cmdlines []string
-	for key, val := range vals {
-		switch key {
-		case "linux":
-			f, err := os.Open(filePath(fsRoot, val))
-			if err != nil {
-				return nil, err
-			}
-			linux.Kernel = f
-
-		// TODO: initrd may be specified more than once.
-		case "initrd":
-			f, err := os.Open(filePath(fsRoot, val))
-			if err != nil {
-				return nil, err
-			}
-			linux.Initrd = f
-
-		case "devicetree":
-			// Explicitly return an error rather than ignore this,
-			// because the intended kernel likely won't boot
-			// correctly if we silently ignore this attribute.
-			return nil, fmt.Errorf("devicetree attribute unsupported for Linux entries")
-
-		// options may appear more than once.
-		case "options":
-			cmdlines = append(cmdlines, val)
+	// Spec says kernel is required.
+	kernel := value(vals, "linux")
+	if kernel == "" {
+		return nil, fmt.Errorf("malformed Linux config: linux keyword missing")
+	}
+	f, err := os.Open(filePath(fsRoot, kernel))
+	if err != nil {
+		return nil, err
+	}
+	linux.Kernel = f
+
+	// initrd may be specified more than once; the initrds are loaded in
+	// order.
+	var initrds []io.ReaderAt
+	for _, val := range vals["initrd"] {
+		f, err := os.Open(filePath(fsRoot, val))
+		if err != nil {
+			return nil, err
 		}
+		initrds = append(initrds, f)
+	}
+	switch len(initrds) {
+	case 0:
+	case 1:
+		linux.Initrd = initrds[0]
+	default:
+		linux.Initrd = boot.CatInitrds(initrds...)
 	}
 
-	// Spec says kernel is required.
-	if linux.Kernel == nil {
-		return nil, fmt.Errorf("malformed Linux config: linux keyword missing")
+	if _, ok := vals["devicetree"]; ok {
+		// Explicitly return an error rather than ignore this,
+		// because the intended kernel likely won't boot
+		// correctly if we silently ignore this attribute.
+		return nil, fmt.Errorf("devicetree attribute unsupported for Linux entries")
 	}
 
 	var name []string
-	if title, ok := vals["title"]; ok && len(title) > 0 {
+	if title := value(vals, "title"); len(title) > 0 {
 		name = append(name, title)
 	}
-	if version, ok := vals["version"]; ok && len(version) > 0 {
+	if version := value(vals, "version"); len(version) > 0 {
 		name = append(name, version)
 	}
 	// If both title and version were empty, so will this.
 	linux.Name = strings.Join(name, " ")
-	linux.Cmdline = strings.Join(cmdlines, " ")
+	// options may appear more than once.
+	linux.Cmdline = strings.Join(vals["options"], " ")
 	return linux, nil
 }
 
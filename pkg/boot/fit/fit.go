@@ -2,15 +2,36 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
+// Package fit boots U-Boot FIT (Flattened Image Tree) images.
+//
+// A FIT image is a device tree whose images node holds the kernels,
+// ramdisks and device trees, and whose configurations node lists which of
+// them boot together. The images are checked against their hash nodes,
+// and, if keys are given, against their signature nodes, before they are
+// decompressed and loaded.
 package fit
 
 import (
 	"bytes"
+	"crypto"
+	"crypto/md5"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
 	"fmt"
+	"hash"
+	"hash/crc32"
 	"io"
+	"io/ioutil"
 	"os"
+	"strings"
 
 	"github.com/u-root/u-root/pkg/boot"
+	"github.com/u-root/u-root/pkg/compress"
 	"github.com/u-root/u-root/pkg/dt"
 )
 
@@ -29,6 +50,9 @@ type Image struct {
 	ConfigOverride string
 	// SkipInitRAMFS skips the search for an ramdisk entry in the config
 	SkipInitRAMFS bool
+	// Keys, if set, are the RSA public keys one of which must have signed
+	// the kernel and initramfs, in their signature nodes.
+	Keys []*rsa.PublicKey
 }
 
 var _ = boot.OSImage(&Image{})
@@ -95,6 +119,201 @@ func (i *Image) Edit(f func(s string) string) {
 	i.Cmdline = f(i.Cmdline)
 }
 
+// ErrUnsigned is returned by Load if keys are given, but an image has no
+// signature of them.
+var ErrUnsigned = errors.New("image is not signed by any of the keys")
+
+// node returns the child of n called name.
+func node(n *dt.Node, name string) (*dt.Node, error) {
+	for _, c := range n.Children {
+		if c.Name == name {
+			return c, nil
+		}
+	}
+	return nil, fmt.Errorf("cannot find node name %q", name)
+}
+
+// property returns the value of the property of n called name.
+func property(n *dt.Node, name string) ([]byte, bool) {
+	p, ok := n.LookProperty(name)
+	if !ok {
+		return nil, false
+	}
+	return p.Value, true
+}
+
+// stringProperty returns the string property of n called name, or "".
+func stringProperty(n *dt.Node, name string) string {
+	p, ok := n.LookProperty(name)
+	if !ok {
+		return ""
+	}
+	s, err := p.AsString()
+	if err != nil {
+		return ""
+	}
+	return s
+}
+
+// newHash returns the hash of the algo property of a hash node.
+func newHash(algo string) (hash.Hash, crypto.Hash, error) {
+	switch algo {
+	case "crc32":
+		return crc32.NewIEEE(), 0, nil
+	case "md5":
+		return md5.New(), crypto.MD5, nil
+	case "sha1":
+		return sha1.New(), crypto.SHA1, nil
+	case "sha256":
+		return sha256.New(), crypto.SHA256, nil
+	case "sha384":
+		return sha512.New384(), crypto.SHA384, nil
+	case "sha512":
+		return sha512.New(), crypto.SHA512, nil
+	}
+	return nil, 0, fmt.Errorf("unsupported hash algorithm %q", algo)
+}
+
+// checkHashes checks data against the hash nodes of the image node n.
+func checkHashes(n *dt.Node, data []byte) error {
+	for _, c := range n.Children {
+		if !strings.HasPrefix(c.Name, "hash") {
+			continue
+		}
+		want, ok := property(c, "value")
+		if !ok {
+			return fmt.Errorf("%s/%s: no hash value", n.Name, c.Name)
+		}
+		h, _, err := newHash(stringProperty(c, "algo"))
+		if err != nil {
+			return fmt.Errorf("%s/%s: %v", n.Name, c.Name, err)
+		}
+		h.Write(data)
+		if got := h.Sum(nil); !bytes.Equal(got, want) {
+			return fmt.Errorf("%s/%s: %s hash is %x, want %x", n.Name, c.Name, stringProperty(c, "algo"), got, want)
+		}
+	}
+	return nil
+}
+
+// checkSignature checks data against the signature nodes of the image node
+// n: one of them must be a signature of data by one of keys.
+//
+// The algo property of a signature node is the hash and the key type, e.g.
+// "sha256,rsa2048", and its padding property is "pkcs-1.5", the default,
+// or "pss".
+func checkSignature(n *dt.Node, data []byte, keys []*rsa.PublicKey) error {
+	for _, c := range n.Children {
+		if !strings.HasPrefix(c.Name, "signature") {
+			continue
+		}
+		sig, ok := property(c, "value")
+		if !ok {
+			continue
+		}
+		algo := strings.SplitN(stringProperty(c, "algo"), ",", 2)
+		if len(algo) != 2 || !strings.HasPrefix(algo[1], "rsa") {
+			continue
+		}
+		h, ch, err := newHash(algo[0])
+		if err != nil || ch == 0 {
+			continue
+		}
+		h.Write(data)
+		sum := h.Sum(nil)
+		for _, k := range keys {
+			if stringProperty(c, "padding") == "pss" {
+				err = rsa.VerifyPSS(k, ch, sum, sig, nil)
+			} else {
+				err = rsa.VerifyPKCS1v15(k, ch, sum, sig)
+			}
+			if err == nil {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("%s: %w", n.Name, ErrUnsigned)
+}
+
+// decompress returns data decompressed with the compression property of the
+// image node n.
+func decompress(n *dt.Node, data []byte) ([]byte, error) {
+	c := stringProperty(n, "compression")
+	switch c {
+	case "", "none":
+		return data, nil
+	case "lzma":
+		// The xz reader does not read raw LZMA streams.
+		return nil, fmt.Errorf("%s: unsupported compression %q", n.Name, c)
+	}
+	f, err := compress.ByName(c)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", n.Name, err)
+	}
+	r, err := f.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", n.Name, err)
+	}
+	defer r.Close()
+	return ioutil.ReadAll(r)
+}
+
+// ReadImage returns the data of the image node called name, after checking
+// its hashes, and its signatures if i has keys, and decompressing it.
+func (i *Image) ReadImage(name string) ([]byte, error) {
+	images, err := node(i.Root.RootNode, "images")
+	if err != nil {
+		return nil, err
+	}
+	n, err := node(images, name)
+	if err != nil {
+		return nil, err
+	}
+	data, ok := property(n, "data")
+	if !ok {
+		return nil, fmt.Errorf("%s: no data, external data is not supported", name)
+	}
+	if err := checkHashes(n, data); err != nil {
+		return nil, err
+	}
+	if len(i.Keys) > 0 {
+		if err := checkSignature(n, data, i.Keys); err != nil {
+			return nil, err
+		}
+	}
+	return decompress(n, data)
+}
+
+// ReadKeys returns the RSA public keys of the PEM file at path, as
+// written by "openssl rsa -pubout".
+func ReadKeys(path string) ([]*rsa.PublicKey, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var keys []*rsa.PublicKey
+	for {
+		var p *pem.Block
+		p, b = pem.Decode(b)
+		if p == nil {
+			break
+		}
+		k, err := x509.ParsePKIXPublicKey(p.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", path, err)
+		}
+		rk, ok := k.(*rsa.PublicKey)
+		if !ok {
+			return nil, fmt.Errorf("%s: %T is not an RSA key", path, k)
+		}
+		keys = append(keys, rk)
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("%s: no public keys", path)
+	}
+	return keys, nil
+}
+
 // Load LinuxImage to memory
 func loadLinuxImage(i *boot.LinuxImage, verbose bool) error {
 	return i.Load(verbose)
@@ -104,9 +323,12 @@ func loadLinuxImage(i *boot.LinuxImage, verbose bool) error {
 var loadImage = loadLinuxImage
 
 // Load loads an image and reboots
+//
+// The device tree of the configuration, if any, is checked too, but not
+// loaded: kexec_file_load(2) passes the device tree of the running kernel to
+// the new one.
 func (i *Image) Load(verbose bool) error {
-	w := i.Root.Root().Walk("images").Walk(i.Kernel)
-	b, err := w.Property("data").AsBytes()
+	b, err := i.ReadImage(i.Kernel)
 	if err != nil {
 		return err
 	}
@@ -117,14 +339,22 @@ func (i *Image) Load(verbose bool) error {
 	}
 
 	if len(i.InitRAMFS) != 0 {
-		w := i.Root.Root().Walk("images").Walk(i.InitRAMFS)
-		b, err := w.Property("data").AsBytes()
+		b, err := i.ReadImage(i.InitRAMFS)
 		if err != nil {
 			return err
 		}
 		image.Initrd = bytes.NewReader(b)
 	}
 
+	if tc, err := i.GetConfigName(); err == nil {
+		fdt, _ := i.Root.Root().Walk("configurations").Walk(tc).Property("fdt").AsString()
+		if fdt != "" {
+			if _, err := i.ReadImage(fdt); err != nil {
+				return err
+			}
+		}
+	}
+
 	if err := loadImage(image, verbose); err != nil {
 		return err
 	}
@@ -5,11 +5,19 @@
 package fit
 
 import (
+	"bytes"
+	"compress/gzip"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"errors"
 	"os"
 	"strings"
 	"testing"
 
 	"github.com/u-root/u-root/pkg/boot"
+	"github.com/u-root/u-root/pkg/dt"
 )
 
 const (
@@ -139,3 +147,95 @@ func TestLabel(t *testing.T) {
 		t.Fatalf("Expected Image label to contain name %s, got %s", n, l)
 	}
 }
+
+func TestLoadHashMismatch(t *testing.T) {
+	i, err := New("testdata/fitimage.itb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	i.Kernel = "kernel@0"
+
+	// Corrupt the kernel data.
+	images, _ := node(i.Root.RootNode, "images")
+	kernel, _ := node(images, "kernel@0")
+	for n, p := range kernel.Properties {
+		if p.Name == "data" {
+			kernel.Properties[n].Value = append([]byte{0}, p.Value[1:]...)
+		}
+	}
+
+	defer func(old func(i *boot.LinuxImage, verbose bool) error) { loadImage = old }(loadImage)
+	loadImage = func(i *boot.LinuxImage, verbose bool) error {
+		t.Fatal("loaded an image with a mismatching hash")
+		return nil
+	}
+
+	if err := i.Load(false); err == nil || !strings.Contains(err.Error(), "hash") {
+		t.Fatalf("Load() = %v, want hash mismatch error", err)
+	}
+}
+
+func TestReadImage(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	content := []byte("arm64 kernel Image")
+	var gz bytes.Buffer
+	w := gzip.NewWriter(&gz)
+	w.Write(content)
+	w.Close()
+
+	sum := sha256.Sum256(gz.Bytes())
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, sum[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	str := func(s string) []byte { return append([]byte(s), 0) }
+	i := &Image{Root: &dt.FDT{RootNode: &dt.Node{Children: []*dt.Node{{
+		Name: "images",
+		Children: []*dt.Node{{
+			Name: "kernel",
+			Properties: []dt.Property{
+				{Name: "data", Value: gz.Bytes()},
+				{Name: "compression", Value: str("gzip")},
+			},
+			Children: []*dt.Node{
+				{Name: "hash-1", Properties: []dt.Property{
+					{Name: "algo", Value: str("sha256")},
+					{Name: "value", Value: sum[:]},
+				}},
+				{Name: "signature-1", Properties: []dt.Property{
+					{Name: "algo", Value: str("sha256,rsa2048")},
+					{Name: "value", Value: sig},
+				}},
+			},
+		}},
+	}}}}}
+
+	for _, tt := range []struct {
+		desc string
+		keys []*rsa.PublicKey
+		err  error
+	}{
+		{desc: "no keys"},
+		{desc: "signed", keys: []*rsa.PublicKey{&other.PublicKey, &key.PublicKey}},
+		{desc: "wrong key", keys: []*rsa.PublicKey{&other.PublicKey}, err: ErrUnsigned},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			i.Keys = tt.keys
+			got, err := i.ReadImage("kernel")
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("ReadImage() = %v, want %v", err, tt.err)
+			}
+			if err == nil && !bytes.Equal(got, content) {
+				t.Errorf("ReadImage() = %q, want %q", got, content)
+			}
+		})
+	}
+}
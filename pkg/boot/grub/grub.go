@@ -24,6 +24,8 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
+	"unicode"
 
 	"github.com/spf13/pflag"
 	"github.com/u-root/u-root/pkg/boot"
@@ -111,6 +113,17 @@ func ParseLocalConfig(ctx context.Context, diskDir string, devices block.BlockDe
 	return nil, fmt.Errorf("no valid grub config found")
 }
 
+// Config is a parsed grub configuration.
+type Config struct {
+	// Images are the boot entries, the default one first.
+	Images []boot.OSImage
+
+	// Timeout is how long the menu is shown before booting the default
+	// entry, from the timeout variable. It is negative if the menu waits
+	// forever, which is also the default.
+	Timeout time.Duration
+}
+
 // ParseConfigFile parses a grub configuration as specified in
 // https://www.gnu.org/software/grub/manual/grub/
 //
@@ -120,6 +133,16 @@ func ParseLocalConfig(ctx context.Context, diskDir string, devices block.BlockDe
 // relative path - e.g. kernel and initramfs paths are requested relative to
 // the root.
 func ParseConfigFile(ctx context.Context, s curl.Schemes, configFile string, root *url.URL, devices block.BlockDevices, mountPool *mount.Pool) ([]boot.OSImage, error) {
+	c, err := ParseConfig(ctx, s, configFile, root, devices, mountPool)
+	if err != nil {
+		return nil, err
+	}
+	return c.Images, nil
+}
+
+// ParseConfig parses a grub configuration like ParseConfigFile, and also
+// returns the menu timeout.
+func ParseConfig(ctx context.Context, s curl.Schemes, configFile string, root *url.URL, devices block.BlockDevices, mountPool *mount.Pool) (*Config, error) {
 	p := newParser(root, devices, mountPool, s)
 	if err := p.appendFile(ctx, configFile); err != nil {
 		return nil, err
@@ -135,23 +158,26 @@ func ParseConfigFile(ctx context.Context, s curl.Schemes, configFile string, roo
 		p.labelOrder = append([]string{defaultEntry}, p.labelOrder...)
 	}
 
-	var images []boot.OSImage
+	c := &Config{Timeout: -1}
 	for _, label := range p.labelOrder {
 		if img, ok := p.linuxEntries[label]; ok {
 			if _, ok := seenLinux[img]; !ok {
-				images = append(images, img)
+				c.Images = append(c.Images, img)
 				seenLinux[img] = struct{}{}
 			}
 		}
 
 		if img, ok := p.mbEntries[label]; ok {
 			if _, ok := seenMB[img]; !ok {
-				images = append(images, img)
+				c.Images = append(c.Images, img)
 				seenMB[img] = struct{}{}
 			}
 		}
 	}
-	return images, nil
+	if t, err := strconv.Atoi(p.variables["timeout"]); err == nil && t >= 0 {
+		c.Timeout = time.Duration(t) * time.Second
+	}
+	return c, nil
 }
 
 type parser struct {
@@ -175,6 +201,10 @@ type parser struct {
 	// curLabel is the last parsed label from a "menuentry".
 	curLabel string
 
+	// configDir is the directory of the config file being parsed, where
+	// load_env looks for grubenv.
+	configDir string
+
 	devices   block.BlockDevices
 	mountPool *mount.Pool
 	schemes   curl.Schemes
@@ -259,6 +289,7 @@ func (c *parser) appendFile(ctx context.Context, url string) error {
 	if err != nil {
 		return err
 	}
+	c.configDir = filepath.Dir(url)
 	if len(config) > 500 {
 		// Avoid flooding the console on real systems
 		// TODO: do we want to pass a verbose flag or a logger?
@@ -269,6 +300,77 @@ func (c *parser) appendFile(ctx context.Context, url string) error {
 	return c.append(ctx, string(config))
 }
 
+// varName returns the name of the variable s starts with, after a $, and its
+// length in s: ${name}, or $name made of letters, digits and underscores.
+func varName(s string) (string, int) {
+	if strings.HasPrefix(s, "{") {
+		if i := strings.IndexByte(s, '}'); i > 0 {
+			return s[1:i], i + 1
+		}
+		return "", 0
+	}
+	n := 0
+	for n < len(s) && (s[n] == '_' || unicode.IsLetter(rune(s[n])) || unicode.IsDigit(rune(s[n]))) {
+		n++
+	}
+	return s[:n], n
+}
+
+// expand replaces the $name and ${name} variables of line with their values,
+// except within single quotes and when the $ is escaped. Unset variables are
+// empty.
+func (c *parser) expand(line string) string {
+	var b strings.Builder
+	var quoted bool
+	for i := 0; i < len(line); i++ {
+		switch ch := line[i]; {
+		case ch == '\\' && !quoted && i+1 < len(line):
+			b.WriteString(line[i : i+2])
+			i++
+		case ch == '\'':
+			quoted = !quoted
+			b.WriteByte(ch)
+		case ch == '$' && !quoted:
+			name, n := varName(line[i+1:])
+			if n == 0 {
+				b.WriteByte(ch)
+				continue
+			}
+			b.WriteString(c.variables[name])
+			i += n
+		default:
+			b.WriteByte(ch)
+		}
+	}
+	return b.String()
+}
+
+// loadEnv sets the variables of the grub environment block at file, made of
+// name=value lines.
+func (c *parser) loadEnv(ctx context.Context, file string) error {
+	u, err := parseURL(file, c.variables["root"])
+	if err != nil {
+		return err
+	}
+	r, err := c.schemes.Fetch(ctx, u)
+	if err != nil {
+		return err
+	}
+	env, err := uio.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	for _, line := range strings.Split(string(env), "\n") {
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		if kv := strings.SplitN(line, "=", 2); len(kv) == 2 {
+			c.variables[kv[0]] = kv[1]
+		}
+	}
+	return nil
+}
+
 // CmdlineQuote quotes the command line as grub-core/lib/cmdline.c does
 func cmdlineQuote(args []string) string {
 	q := make([]string, len(args))
@@ -298,6 +400,7 @@ func cmdlineQuote(args []string) string {
 func (c *parser) append(ctx context.Context, config string) error {
 	// Here's a shitty parser.
 	for _, line := range strings.Split(config, "\n") {
+		line = c.expand(line)
 		// Add extra backslash for OpenSUSE/Fedora/RHEL use case. shlex
 		// will convert it back to a single backslash.
 		line = hexEscape.ReplaceAllString(line, `\\$0`)
@@ -310,6 +413,18 @@ func (c *parser) append(ctx context.Context, config string) error {
 		if c.W != nil && directive == "echo" {
 			fmt.Fprintf(c.W, "echo:%#v\n", kv[1:])
 		}
+		if directive == "load_env" {
+			// load_env [-f file] [vars...] reads grubenv next to the
+			// config file by default.
+			file := filepath.Join(c.configDir, "grubenv")
+			if len(kv) > 2 && (kv[1] == "-f" || kv[1] == "--file") {
+				file = kv[2]
+			}
+			if err := c.loadEnv(ctx, file); err != nil && !curl.IsURLError(err) {
+				log.Printf("[grub] Could not load environment %s: %v", file, err)
+			}
+			continue
+		}
 
 		if len(kv) <= 1 {
 			continue
@@ -462,11 +577,20 @@ func (c *parser) append(ctx context.Context, config string) error {
 
 		case "initrd", "initrd16", "initrdefi":
 			if e, ok := c.linuxEntries[c.curEntry]; ok {
-				i, err := c.getFile(arg)
-				if err != nil {
-					return err
+				// Several initrds, e.g. microcode and the
+				// initramfs, are concatenated.
+				var initrds []io.ReaderAt
+				for _, f := range kv[1:] {
+					i, err := c.getFile(f)
+					if err != nil {
+						return err
+					}
+					initrds = append(initrds, i)
+				}
+				e.Initrd = initrds[0]
+				if len(initrds) > 1 {
+					e.Initrd = boot.CatInitrds(initrds...)
 				}
-				e.Initrd = i
 			}
 
 		case "multiboot":
@@ -5,8 +5,16 @@
 package grub
 
 import (
+	"context"
 	"fmt"
+	"net/url"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/u-root/u-root/pkg/boot"
+	"github.com/u-root/u-root/pkg/curl"
+	"github.com/u-root/u-root/pkg/uio"
 )
 
 func TestCmdlineQuote(t *testing.T) {
@@ -45,3 +53,82 @@ func TestCmdlineQuote(t *testing.T) {
 		})
 	}
 }
+
+func TestExpand(t *testing.T) {
+	p := newParser(&url.URL{Scheme: "file", Path: "/"}, nil, nil, nil)
+	p.variables["a"] = "x"
+	p.variables["b_2"] = "y z"
+	for _, tt := range []struct {
+		in   string
+		want string
+	}{
+		{in: "no variables", want: "no variables"},
+		{in: "$a ${a}b $b_2", want: "x xb y z"},
+		{in: `"${b_2}"`, want: `"y z"`},
+		{in: "$unset.", want: "."},
+		{in: `'$a' \$a`, want: `'$a' \$a`},
+		{in: "$ and ${", want: "$ and ${"},
+	} {
+		if got := p.expand(tt.in); got != tt.want {
+			t.Errorf("expand(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParseConfig(t *testing.T) {
+	fs := curl.NewMockScheme("file")
+	fs.Add("", "/boot/grub/grub.cfg", `
+load_env
+set timeout=3
+set dir=/boot
+menuentry 'First' {
+	linux ${dir}/vmlinuz-1 root=/dev/sda2
+	initrd $dir/ucode.img $dir/initrd-1
+}
+menuentry 'Second' {
+	linux ${dir}/vmlinuz-2
+	initrd ${dir}/initrd-2
+}
+set default="${saved_entry}"
+`)
+	fs.Add("", "/boot/grub/grubenv", "# GRUB Environment Block\nsaved_entry=Second\n####")
+	fs.Add("", "/boot/vmlinuz-1", "kernel1")
+	fs.Add("", "/boot/vmlinuz-2", "kernel2")
+	fs.Add("", "/boot/ucode.img", "ucode")
+	fs.Add("", "/boot/initrd-1", "initrd1")
+	fs.Add("", "/boot/initrd-2", "initrd2")
+	s := make(curl.Schemes)
+	s.Register(fs.Scheme, fs)
+
+	c, err := ParseConfig(context.Background(), s, "boot/grub/grub.cfg", &url.URL{Scheme: "file", Path: "/"}, nil, nil)
+	if err != nil {
+		t.Fatalf("ParseConfig() = %v", err)
+	}
+	if c.Timeout != 3*time.Second {
+		t.Errorf("Timeout = %v, want 3s", c.Timeout)
+	}
+	if len(c.Images) != 2 {
+		t.Fatalf("got %d images, want 2", len(c.Images))
+	}
+	for i, want := range []struct {
+		name, kernel, initrd, cmdline string
+	}{
+		{"Second", "kernel2", "initrd2", ""},
+		// Concatenated initrds are padded to 512 bytes.
+		{"First", "kernel1", "ucode" + strings.Repeat("\x00", 507) + "initrd1", "root=/dev/sda2"},
+	} {
+		img, ok := c.Images[i].(*boot.LinuxImage)
+		if !ok {
+			t.Fatalf("image %d is %T, want *boot.LinuxImage", i, c.Images[i])
+		}
+		if img.Name != want.name || img.Cmdline != want.cmdline {
+			t.Errorf("image %d = (%q, %q), want (%q, %q)", i, img.Name, img.Cmdline, want.name, want.cmdline)
+		}
+		if k, err := uio.ReadAll(img.Kernel); err != nil || string(k) != want.kernel {
+			t.Errorf("image %d kernel = (%q, %v), want %q", i, k, err, want.kernel)
+		}
+		if r, err := uio.ReadAll(img.Initrd); err != nil || string(r) != want.initrd {
+			t.Errorf("image %d initrd = (%q, %v), want %q", i, r, err, want.initrd)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package kexec
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+)
+
+var (
+	iomemFile       = "/proc/iomem"
+	crashLoadedFile = "/sys/kernel/kexec_crash_loaded"
+)
+
+// ErrNoCrashKernelRegion is returned if no memory was reserved for a crash
+// kernel, with the crashkernel= kernel parameter.
+var ErrNoCrashKernelRegion = errors.New("no memory reserved for a crash kernel, boot with crashkernel=")
+
+// CrashKernelRegion returns the memory reserved for a crash kernel with the
+// crashkernel= parameter, as listed in /proc/iomem. Only root sees the
+// addresses of the region.
+func CrashKernelRegion() (Range, error) {
+	f, err := os.Open(iomemFile)
+	if err != nil {
+		return Range{}, err
+	}
+	defer f.Close()
+	return parseCrashKernelRegion(f)
+}
+
+// parseCrashKernelRegion returns the first "Crash kernel" range of an
+// iomem file, whose lines look like "  0a000000-19ffffff : Crash kernel".
+func parseCrashKernelRegion(r io.Reader) (Range, error) {
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		f := strings.SplitN(s.Text(), ":", 2)
+		if len(f) != 2 || strings.TrimSpace(f[1]) != "Crash kernel" {
+			continue
+		}
+		ends := strings.SplitN(strings.TrimSpace(f[0]), "-", 2)
+		if len(ends) != 2 {
+			return Range{}, fmt.Errorf("invalid iomem range %q", f[0])
+		}
+		start, err := strconv.ParseUint(ends[0], 16, 64)
+		if err != nil {
+			return Range{}, fmt.Errorf("invalid iomem range %q: %v", f[0], err)
+		}
+		end, err := strconv.ParseUint(ends[1], 16, 64)
+		if err != nil {
+			return Range{}, fmt.Errorf("invalid iomem range %q: %v", f[0], err)
+		}
+		if start == 0 && end == 0 {
+			return Range{}, fmt.Errorf("crash kernel region is hidden, read %s as root", iomemFile)
+		}
+		// iomem ends are inclusive.
+		return RangeFromInterval(uintptr(start), uintptr(end+1)), nil
+	}
+	if err := s.Err(); err != nil {
+		return Range{}, err
+	}
+	return Range{}, ErrNoCrashKernelRegion
+}
+
+// CrashLoaded returns whether a crash kernel is loaded, to be executed on
+// panic.
+func CrashLoaded() (bool, error) {
+	b, err := ioutil.ReadFile(crashLoadedFile)
+	if err != nil {
+		return false, err
+	}
+	return strings.TrimSpace(string(b)) == "1", nil
+}
@@ -0,0 +1,56 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package kexec
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseCrashKernelRegion(t *testing.T) {
+	for _, tt := range []struct {
+		desc  string
+		iomem string
+		want  Range
+		err   string
+	}{
+		{
+			desc: "reserved",
+			iomem: `00000000-00000fff : Reserved
+00001000-0009fbff : System RAM
+00100000-bffdffff : System RAM
+  0a000000-19ffffff : Crash kernel
+  4b000000-4be02536 : Kernel code
+`,
+			want: Range{Start: 0x0a000000, Size: 0x10000000},
+		},
+		{
+			desc:  "not reserved",
+			iomem: "00100000-bffdffff : System RAM\n",
+			err:   ErrNoCrashKernelRegion.Error(),
+		},
+		{
+			desc:  "hidden",
+			iomem: "00000000-00000000 : System RAM\n  00000000-00000000 : Crash kernel\n",
+			err:   "hidden",
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			got, err := parseCrashKernelRegion(strings.NewReader(tt.iomem))
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("parseCrashKernelRegion() = %v, want error containing %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("parseCrashKernelRegion() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ package kexec
 import (
 	"fmt"
 	"os"
+	"syscall"
 
 	"golang.org/x/sys/unix"
 )
@@ -16,9 +17,35 @@ import (
 // FileLoad loads the given kernel as the new kernel with the given ramfs and
 // cmdline.
 //
-// The kexec_file_load(2) syscall is x86-64 and arm64 only.
+// The kexec_file_load(2) syscall is x86-64 and arm64 only. Kernels built with
+// CONFIG_KEXEC_SIG verify the signature of the new kernel; an ErrSignature
+// error is returned if it is rejected.
 func FileLoad(kernel, ramfs *os.File, cmdline string) error {
-	var flags int
+	return fileLoad(kernel, ramfs, cmdline, 0)
+}
+
+// FileLoadPanic loads the given kernel as the crash kernel, which is executed
+// on panic, with the given ramfs and cmdline.
+//
+// The kernel is loaded into the memory reserved with the crashkernel=
+// parameter, and the running kernel generates the ELF core header that
+// describes its memory to the crash kernel, as /proc/vmcore.
+func FileLoadPanic(kernel, ramfs *os.File, cmdline string) error {
+	if _, err := CrashKernelRegion(); err == ErrNoCrashKernelRegion {
+		return err
+	}
+	return fileLoad(kernel, ramfs, cmdline, unix.KEXEC_FILE_ON_CRASH)
+}
+
+// FileUnloadPanic unloads the crash kernel, if one is loaded.
+func FileUnloadPanic() error {
+	if err := unix.KexecFileLoad(-1, -1, "", unix.KEXEC_FILE_UNLOAD|unix.KEXEC_FILE_ON_CRASH); err != nil {
+		return fmt.Errorf("sys_kexec(unload crash kernel) = %v", err)
+	}
+	return nil
+}
+
+func fileLoad(kernel, ramfs *os.File, cmdline string, flags int) error {
 	var ramfsfd int
 	if ramfs != nil {
 		ramfsfd = int(ramfs.Fd())
@@ -27,6 +54,9 @@ func FileLoad(kernel, ramfs *os.File, cmdline string) error {
 	}
 
 	if err := unix.KexecFileLoad(int(kernel.Fd()), ramfsfd, cmdline, flags); err != nil {
+		if errno, ok := err.(syscall.Errno); ok && isSignatureErrno(errno) {
+			return fmt.Errorf("sys_kexec(%d, %d, %s, %x) = %v: %w", kernel.Fd(), ramfsfd, cmdline, flags, err, ErrSignature)
+		}
 		return fmt.Errorf("sys_kexec(%d, %d, %s, %x) = %v", kernel.Fd(), ramfsfd, cmdline, flags, err)
 	}
 	return nil
@@ -14,3 +14,11 @@ import (
 func FileLoad(kernel, ramfs *os.File, cmdline string) error {
 	return syscall.ENOSYS
 }
+
+func FileLoadPanic(kernel, ramfs *os.File, cmdline string) error {
+	return syscall.ENOSYS
+}
+
+func FileUnloadPanic() error {
+	return syscall.ENOSYS
+}
@@ -37,10 +37,17 @@ type ErrKexec struct {
 	Segments []Segment
 	Flags    uint64
 	Errno    syscall.Errno
+
+	// Lockdown is the lockdown mode of the kernel, if it is the reason
+	// kexec_load(2) is not permitted.
+	Lockdown string
 }
 
 // Error implements error.
 func (e ErrKexec) Error() string {
+	if e.Lockdown != "" {
+		return fmt.Sprintf("kexec_load(entry=%#x) = errno %s: kexec_load is disabled in %s lockdown mode, only signed kernels can be loaded with kexec_file_load", e.Entry, e.Errno, e.Lockdown)
+	}
 	return fmt.Sprintf("kexec_load(entry=%#x, segments=%s, flags %#x) = errno %s", e.Entry, e.Segments, e.Flags, e.Errno)
 }
 
@@ -56,11 +63,18 @@ func rawLoad(entry uintptr, segments []Segment, flags uint64) error {
 		uintptr(unsafe.Pointer(&segments[0])),
 		uintptr(flags),
 		0, 0); errno != 0 {
+		var lockdown string
+		if errno == unix.EPERM {
+			if m := Lockdown(); m != "none" {
+				lockdown = m
+			}
+		}
 		return ErrKexec{
 			Entry:    entry,
 			Segments: segments,
 			Flags:    flags,
 			Errno:    errno,
+			Lockdown: lockdown,
 		}
 	}
 	return nil
@@ -0,0 +1,57 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package kexec
+
+import (
+	"errors"
+	"io/ioutil"
+	"strings"
+	"syscall"
+)
+
+// lockdownFile is where the kernel reports its lockdown mode.
+var lockdownFile = "/sys/kernel/security/lockdown"
+
+// ErrSignature is returned by FileLoad if the kernel rejected the
+// signature of the new kernel, or required one that it does not have.
+var ErrSignature = errors.New("kernel signature rejected")
+
+// Lockdown returns the lockdown mode of the running kernel: "none",
+// "integrity" or "confidentiality". It is "none" if the kernel does not
+// support lockdown.
+//
+// In the integrity and confidentiality modes, kexec_load(2) is disabled and
+// kexec_file_load(2) only loads signed kernels.
+func Lockdown() string {
+	b, err := ioutil.ReadFile(lockdownFile)
+	if err != nil {
+		return "none"
+	}
+	return parseLockdown(string(b))
+}
+
+// parseLockdown returns the selected mode of the lockdown file, e.g.
+// integrity for "none [integrity] confidentiality".
+func parseLockdown(s string) string {
+	for _, m := range strings.Fields(s) {
+		if strings.HasPrefix(m, "[") && strings.HasSuffix(m, "]") {
+			return strings.Trim(m, "[]")
+		}
+	}
+	return "none"
+}
+
+// isSignatureErrno returns whether errno is how kexec_file_load(2) reports
+// a missing, malformed or untrusted kernel signature.
+func isSignatureErrno(errno syscall.Errno) bool {
+	switch errno {
+	case syscall.EKEYREJECTED, syscall.EKEYREVOKED, syscall.EKEYEXPIRED, syscall.ENOKEY, syscall.EBADMSG:
+		return true
+	case syscall.EPERM:
+		// Unsigned kernels are not permitted in lockdown mode.
+		return Lockdown() != "none"
+	}
+	return false
+}
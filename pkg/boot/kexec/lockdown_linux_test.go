@@ -0,0 +1,23 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package kexec
+
+import "testing"
+
+func TestParseLockdown(t *testing.T) {
+	for _, tt := range []struct {
+		in   string
+		want string
+	}{
+		{"[none] integrity confidentiality\n", "none"},
+		{"none [integrity] confidentiality\n", "integrity"},
+		{"none integrity [confidentiality]\n", "confidentiality"},
+		{"", "none"},
+	} {
+		if got := parseLockdown(tt.in); got != tt.want {
+			t.Errorf("parseLockdown(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
@@ -24,6 +24,14 @@ type LinuxImage struct {
 	Kernel  io.ReaderAt
 	Initrd  io.ReaderAt
 	Cmdline string
+
+	// Verifier, if set, checks the kernel and the initrd before they are
+	// loaded, e.g. their signatures.
+	Verifier Verifier
+
+	// Measurer, if set, measures the kernel, as it is loaded, the initrd
+	// and the command line before they are loaded.
+	Measurer Measurer
 }
 
 var _ OSImage = &LinuxImage{}
@@ -81,13 +89,41 @@ func (li *LinuxImage) Edit(f func(cmdline string) string) {
 	li.Cmdline = f(li.Cmdline)
 }
 
-// Load implements OSImage.Load and kexec_load's the kernel with its initramfs.
+// Load implements OSImage.Load and kexec_file_load's the kernel with its
+// initramfs, after checking them with the Verifier and measuring them with
+// the Measurer, if any.
 func (li *LinuxImage) Load(verbose bool) error {
+	return li.load(verbose, kexec.FileLoad)
+}
+
+// LoadPanic loads the kernel with its initramfs as the crash kernel, which
+// is executed on panic, e.g. to save /proc/vmcore with kdump. Memory must
+// have been reserved for it with the crashkernel= parameter.
+func (li *LinuxImage) LoadPanic(verbose bool) error {
+	return li.load(verbose, kexec.FileLoadPanic)
+}
+
+func (li *LinuxImage) load(verbose bool, fileLoad func(kernel, ramfs *os.File, cmdline string) error) error {
 	if li.Kernel == nil {
 		return errors.New("LinuxImage.Kernel must be non-nil")
 	}
 
-	kernel, initrd := uio.Reader(util.TryGzipFilter(li.Kernel)), uio.Reader(li.Initrd)
+	kernelSrc := li.Kernel
+	if li.Verifier != nil {
+		// Signatures are of the kernel file, before it is
+		// decompressed.
+		raw, err := copyToFile(uio.Reader(li.Kernel))
+		if err != nil {
+			return err
+		}
+		defer raw.Close()
+		if err := li.Verifier.Verify(stringer(li.Kernel), raw); err != nil {
+			return fmt.Errorf("verifying kernel: %w", err)
+		}
+		kernelSrc = raw
+	}
+
+	kernel, initrd := uio.Reader(util.TryGzipFilter(kernelSrc)), uio.Reader(li.Initrd)
 	if verbose {
 		// In verbose mode, print a dot every 5MiB. It is not pretty,
 		// but it at least proves the files are still downloading.
@@ -124,6 +160,26 @@ func (li *LinuxImage) Load(verbose bool) error {
 		defer i.Close()
 	}
 
+	if li.Verifier != nil && i != nil {
+		if err := li.Verifier.Verify(stringer(li.Initrd), i); err != nil {
+			return fmt.Errorf("verifying initrd: %w", err)
+		}
+	}
+
+	if li.Measurer != nil {
+		if err := li.Measurer.MeasureKernel(stringer(li.Kernel), k); err != nil {
+			return err
+		}
+		if i != nil {
+			if err := li.Measurer.MeasureInitrd(stringer(li.Initrd), i); err != nil {
+				return err
+			}
+		}
+		if err := li.Measurer.MeasureCmdline(li.Cmdline); err != nil {
+			return err
+		}
+	}
+
 	if verbose {
 		log.Printf("Kernel: %s", k.Name())
 		if i != nil {
@@ -131,5 +187,5 @@ func (li *LinuxImage) Load(verbose bool) error {
 		}
 		log.Printf("Command line: %s", li.Cmdline)
 	}
-	return kexec.FileLoad(k, i, li.Cmdline)
+	return fileLoad(k, i, li.Cmdline)
 }
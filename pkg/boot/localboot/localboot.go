@@ -7,10 +7,13 @@ package localboot
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 
 	"github.com/u-root/u-root/pkg/boot"
 	"github.com/u-root/u-root/pkg/boot/bls"
 	"github.com/u-root/u-root/pkg/boot/esxi"
+	"github.com/u-root/u-root/pkg/boot/fit"
 	"github.com/u-root/u-root/pkg/boot/grub"
 	"github.com/u-root/u-root/pkg/boot/syslinux"
 	"github.com/u-root/u-root/pkg/mount"
@@ -40,6 +43,37 @@ func parse(l ulog.Logger, device *block.BlockDev, devices block.BlockDevices, mo
 	}
 	imgs = append(imgs, syslinuxImgs...)
 
+	imgs = append(imgs, parseFIT(l, device, mountDir)...)
+	return imgs
+}
+
+// fitFiles are the usual paths of FIT images, as installed by Yocto and
+// embedded distributions.
+var fitFiles = []string{
+	"fitImage",
+	"boot/fitImage",
+	"image.itb",
+	"boot/image.itb",
+}
+
+// parseFIT returns the configurations of the FIT images of the file system.
+func parseFIT(l ulog.Logger, device *block.BlockDev, mountDir string) []boot.OSImage {
+	var imgs []boot.OSImage
+	for _, name := range fitFiles {
+		f, err := os.Open(filepath.Join(mountDir, name))
+		if err != nil {
+			continue
+		}
+		fitImgs, err := fit.ParseConfig(f)
+		f.Close()
+		if err != nil {
+			l.Printf("No FIT configs found in %s on %s: %v", name, device, err)
+			continue
+		}
+		for k := range fitImgs {
+			imgs = append(imgs, &fitImgs[k])
+		}
+	}
 	return imgs
 }
 
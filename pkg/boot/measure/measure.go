@@ -0,0 +1,246 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package measure measures what is booted into TPM PCRs.
+//
+// The kernel, the initramfs, the kernel command line and the boot policy
+// are hashed with SHA-1 on TPM 1.2 and SHA-256 on TPM 2.0, and extended into
+// PCRs. The measurements are recorded in an event log in the TCG PC Client
+// format, so that a verifier can replay them.
+package measure
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/u-root/u-root/pkg/boot"
+	"github.com/u-root/u-root/pkg/tss"
+	"github.com/u-root/u-root/pkg/uio"
+)
+
+// These are the default PCRs, as GRUB uses them: command lines and policies
+// go into PCR 8, and files into PCR 9.
+const (
+	DefaultKernelPCR  uint32 = 9
+	DefaultInitrdPCR  uint32 = 9
+	DefaultCmdlinePCR uint32 = 8
+	DefaultPolicyPCR  uint32 = 8
+)
+
+// evIPL is the type of the events of the boot loader, of the TCG PC Client
+// Platform Firmware Profile.
+const evIPL uint32 = 0x0000000d
+
+// TPM extends PCRs. *tss.TPM is one.
+type TPM interface {
+	Extend(hash []byte, pcrIndex uint32) error
+	GetVersion() tss.TPMVersion
+}
+
+// Event is a measurement.
+type Event struct {
+	// PCR is the PCR the digest was extended into.
+	PCR uint32
+
+	// Digest is the hash of what was measured.
+	Digest []byte
+
+	// Description is what was measured, e.g. "kernel /boot/vmlinuz".
+	Description string
+}
+
+// Measurer measures boot images into the PCRs of a TPM. It implements
+// boot.Measurer.
+type Measurer struct {
+	TPM TPM
+
+	KernelPCR  uint32
+	InitrdPCR  uint32
+	CmdlinePCR uint32
+	PolicyPCR  uint32
+
+	// Events are the measurements so far.
+	Events []Event
+
+	// LogFile, if set, is where the event log is written after every
+	// measurement.
+	LogFile string
+}
+
+var _ boot.Measurer = &Measurer{}
+
+// New returns a Measurer of the TPM of the system, with the default PCRs.
+func New() (*Measurer, error) {
+	t, err := tss.NewTPM()
+	if err != nil {
+		return nil, err
+	}
+	return NewWithTPM(t), nil
+}
+
+// NewWithTPM returns a Measurer of t, with the default PCRs.
+func NewWithTPM(t TPM) *Measurer {
+	return &Measurer{
+		TPM:        t,
+		KernelPCR:  DefaultKernelPCR,
+		InitrdPCR:  DefaultInitrdPCR,
+		CmdlinePCR: DefaultCmdlinePCR,
+		PolicyPCR:  DefaultPolicyPCR,
+	}
+}
+
+// Attach makes m the Measurer of the Linux images of imgs. Other images are
+// not measured.
+func (m *Measurer) Attach(imgs ...boot.OSImage) {
+	for _, img := range imgs {
+		if li, ok := img.(*boot.LinuxImage); ok {
+			li.Measurer = m
+		}
+	}
+}
+
+// MeasureArgs measures the arguments of the command as its policy, since
+// its flags decide what is booted.
+func (m *Measurer) MeasureArgs() error {
+	return m.MeasurePolicy([]byte(strings.Join(os.Args, " ")), "arguments of "+os.Args[0])
+}
+
+// digest returns the hash of r of the PCR bank of the TPM.
+func (m *Measurer) digest(r io.Reader) ([]byte, error) {
+	var h interface {
+		io.Writer
+		Sum([]byte) []byte
+	}
+	switch m.TPM.GetVersion() {
+	case tss.TPMVersion12:
+		h = sha1.New()
+	case tss.TPMVersion20:
+		h = sha256.New()
+	default:
+		return nil, fmt.Errorf("unsupported TPM version: %x", m.TPM.GetVersion())
+	}
+	if _, err := io.Copy(h, r); err != nil {
+		return nil, err
+	}
+	return h.Sum(nil), nil
+}
+
+// Measure extends the hash of r into pcr, and records it as description.
+func (m *Measurer) Measure(pcr uint32, r io.Reader, description string) error {
+	d, err := m.digest(r)
+	if err != nil {
+		return fmt.Errorf("measuring %s: %v", description, err)
+	}
+	if err := m.TPM.Extend(d, pcr); err != nil {
+		return fmt.Errorf("extending PCR %d with %s: %v", pcr, description, err)
+	}
+	m.Events = append(m.Events, Event{PCR: pcr, Digest: d, Description: description})
+	if m.LogFile != "" {
+		return m.writeLogFile()
+	}
+	return nil
+}
+
+// MeasureKernel implements boot.Measurer.
+func (m *Measurer) MeasureKernel(name string, content io.ReaderAt) error {
+	return m.Measure(m.KernelPCR, uio.Reader(content), "kernel "+name)
+}
+
+// MeasureInitrd implements boot.Measurer.
+func (m *Measurer) MeasureInitrd(name string, content io.ReaderAt) error {
+	return m.Measure(m.InitrdPCR, uio.Reader(content), "initrd "+name)
+}
+
+// MeasureCmdline implements boot.Measurer.
+func (m *Measurer) MeasureCmdline(cmdline string) error {
+	return m.Measure(m.CmdlinePCR, bytes.NewBufferString(cmdline), "cmdline "+cmdline)
+}
+
+// MeasurePolicy measures the policy that selects what is booted, e.g. the
+// boot configuration.
+func (m *Measurer) MeasurePolicy(policy []byte, description string) error {
+	return m.Measure(m.PolicyPCR, bytes.NewReader(policy), "policy "+description)
+}
+
+func (m *Measurer) writeLogFile() error {
+	f, err := os.Create(m.LogFile)
+	if err != nil {
+		return err
+	}
+	if err := m.WriteLog(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// WriteLog writes the event log to w.
+//
+// The log of a TPM 1.2 has the SHA-1 TCG_PCR_EVENT format. The log of a
+// TPM 2.0 has the crypto agile TCG_PCR_EVENT2 format, after the
+// Spec ID event that announces it.
+func (m *Measurer) WriteLog(w io.Writer) error {
+	var b bytes.Buffer
+	le := func(v interface{}) {
+		binary.Write(&b, binary.LittleEndian, v)
+	}
+	switch m.TPM.GetVersion() {
+	case tss.TPMVersion12:
+		for _, e := range m.Events {
+			le(e.PCR)
+			le(evIPL)
+			b.Write(e.Digest)
+			le(uint32(len(e.Description)))
+			b.WriteString(e.Description)
+		}
+	case tss.TPMVersion20:
+		writeSpecIDEvent(&b)
+		for _, e := range m.Events {
+			le(e.PCR)
+			le(evIPL)
+			// One digest, of SHA-256.
+			le(uint32(1))
+			le(uint16(0x000b))
+			b.Write(e.Digest)
+			le(uint32(len(e.Description)))
+			b.WriteString(e.Description)
+		}
+	default:
+		return fmt.Errorf("unsupported TPM version: %x", m.TPM.GetVersion())
+	}
+	_, err := w.Write(b.Bytes())
+	return err
+}
+
+// writeSpecIDEvent writes the EV_NO_ACTION event, in the SHA-1 format, that
+// starts crypto agile logs and lists their digests: SHA-256 only.
+func writeSpecIDEvent(b *bytes.Buffer) {
+	var spec bytes.Buffer
+	spec.WriteString("Spec ID Event03\x00")
+	le := func(w io.Writer, v interface{}) {
+		binary.Write(w, binary.LittleEndian, v)
+	}
+	// Platform class, spec version 2.0 errata 0, and uintnSize 2 for
+	// 64-bit UINTNs.
+	le(&spec, uint32(0))
+	spec.Write([]byte{0, 2, 0, 2})
+	le(&spec, uint32(1))
+	le(&spec, uint16(0x000b))
+	le(&spec, uint16(sha256.Size))
+	// No vendor information.
+	spec.WriteByte(0)
+
+	le(b, uint32(0))
+	// EV_NO_ACTION
+	le(b, uint32(3))
+	b.Write(make([]byte, sha1.Size))
+	le(b, uint32(spec.Len()))
+	b.Write(spec.Bytes())
+}
@@ -0,0 +1,126 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package measure
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/u-root/u-root/pkg/tss"
+)
+
+type extend struct {
+	pcr  uint32
+	hash []byte
+}
+
+type fakeTPM struct {
+	version tss.TPMVersion
+	extends []extend
+}
+
+func (t *fakeTPM) Extend(hash []byte, pcr uint32) error {
+	t.extends = append(t.extends, extend{pcr, hash})
+	return nil
+}
+
+func (t *fakeTPM) GetVersion() tss.TPMVersion {
+	return t.version
+}
+
+func TestMeasure(t *testing.T) {
+	sha256Sum := func(s string) []byte { h := sha256.Sum256([]byte(s)); return h[:] }
+	sha1Sum := func(s string) []byte { h := sha1.Sum([]byte(s)); return h[:] }
+	for _, tt := range []struct {
+		version tss.TPMVersion
+		sum     func(string) []byte
+	}{
+		{tss.TPMVersion12, sha1Sum},
+		{tss.TPMVersion20, sha256Sum},
+	} {
+		tpm := &fakeTPM{version: tt.version}
+		m := NewWithTPM(tpm)
+		m.KernelPCR = 12
+		if err := m.MeasureKernel("vmlinuz", strings.NewReader("kernel")); err != nil {
+			t.Fatal(err)
+		}
+		if err := m.MeasureInitrd("initrd", strings.NewReader("initrd")); err != nil {
+			t.Fatal(err)
+		}
+		if err := m.MeasureCmdline("console=ttyS0"); err != nil {
+			t.Fatal(err)
+		}
+		want := []extend{
+			{12, tt.sum("kernel")},
+			{DefaultInitrdPCR, tt.sum("initrd")},
+			{DefaultCmdlinePCR, tt.sum("console=ttyS0")},
+		}
+		if len(tpm.extends) != len(want) {
+			t.Fatalf("TPM %v: got %d extends, want %d", tt.version, len(tpm.extends), len(want))
+		}
+		for i, e := range tpm.extends {
+			if e.pcr != want[i].pcr || !bytes.Equal(e.hash, want[i].hash) {
+				t.Errorf("TPM %v: extend %d = (%d, %x), want (%d, %x)", tt.version, i, e.pcr, e.hash, want[i].pcr, want[i].hash)
+			}
+		}
+		if len(m.Events) != 3 || m.Events[0].Description != "kernel vmlinuz" {
+			t.Errorf("TPM %v: events = %v", tt.version, m.Events)
+		}
+	}
+}
+
+func TestWriteLog(t *testing.T) {
+	dir, err := ioutil.TempDir("", "measure")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	m := NewWithTPM(&fakeTPM{version: tss.TPMVersion12})
+	m.LogFile = filepath.Join(dir, "log")
+	if err := m.MeasurePolicy([]byte("policy"), "boot"); err != nil {
+		t.Fatal(err)
+	}
+	b, err := ioutil.ReadFile(m.LogFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var e struct {
+		PCR    uint32
+		Type   uint32
+		Digest [sha1.Size]byte
+		Size   uint32
+	}
+	r := bytes.NewReader(b)
+	if err := binary.Read(r, binary.LittleEndian, &e); err != nil {
+		t.Fatal(err)
+	}
+	desc, _ := ioutil.ReadAll(r)
+	if e.PCR != DefaultPolicyPCR || e.Type != evIPL || e.Digest != sha1.Sum([]byte("policy")) || string(desc) != "policy boot" || int(e.Size) != len(desc) {
+		t.Errorf("log event = %+v %q", e, desc)
+	}
+
+	m = NewWithTPM(&fakeTPM{version: tss.TPMVersion20})
+	if err := m.MeasureCmdline("quiet"); err != nil {
+		t.Fatal(err)
+	}
+	var log bytes.Buffer
+	if err := m.WriteLog(&log); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(log.Bytes(), []byte("Spec ID Event03")) {
+		t.Errorf("TPM 2.0 log has no Spec ID event")
+	}
+	if !bytes.HasSuffix(log.Bytes(), []byte("cmdline quiet")) {
+		t.Errorf("TPM 2.0 log does not end with the cmdline event")
+	}
+}
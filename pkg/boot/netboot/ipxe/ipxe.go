@@ -3,6 +3,9 @@
 // license that can be found in the LICENSE file.
 
 // Package ipxe implements a trivial IPXE config file parser.
+//
+// The kernel, initrd, imgargs, boot and chain commands of iPXE scripts are
+// supported, with their aliases, as well as set and ${name} expansions.
 package ipxe
 
 import (
@@ -26,12 +29,27 @@ var (
 	ErrNotIpxeScript = errors.New("config file is not ipxe as it does not start with #!ipxe")
 )
 
+// maxChain is how many scripts may chain to each other.
+const maxChain = 8
+
 // parser encapsulates a parsed ipxe configuration file.
 //
-// We currently only support kernel and initrd commands.
+// We currently only support the commands loading and booting images.
 type parser struct {
 	bootImage *boot.LinuxImage
 
+	// kernelName is the name of the kernel image, as used by imgargs.
+	kernelName string
+
+	// initrds are the initrds loaded so far.
+	initrds []io.ReaderAt
+
+	// vars are the variables set by the scripts.
+	vars map[string]string
+
+	// chained is how many scripts were chained to.
+	chained int
+
 	// wd is the current working directory.
 	//
 	// Relative file paths are interpreted relative to this URL.
@@ -48,8 +66,10 @@ type parser struct {
 // `s` is used to get files referred to by URLs in the configuration.
 func ParseConfig(ctx context.Context, l ulog.Logger, configURL *url.URL, s curl.Schemes) (*boot.LinuxImage, error) {
 	c := &parser{
-		schemes: s,
-		log:     l,
+		schemes:   s,
+		log:       l,
+		bootImage: &boot.LinuxImage{},
+		vars:      make(map[string]string),
 	}
 	if err := c.getAndParseFile(ctx, configURL); err != nil {
 		return nil, err
@@ -79,7 +99,7 @@ func (c *parser) getAndParseFile(ctx context.Context, u *url.URL) error {
 		Host:   u.Host,
 		Path:   path.Dir(u.Path),
 	}
-	return c.parseIpxe(config)
+	return c.parseIpxe(ctx, config)
 }
 
 // getFile parses `surl` and returns an io.Reader for the requested url.
@@ -116,52 +136,158 @@ func parseURL(name string, wd *url.URL) (*url.URL, error) {
 	return u, nil
 }
 
-// parseIpxe parses `config` and constructs a BootImage for `c`.
-func (c *parser) parseIpxe(config string) error {
-	// A trivial ipxe script parser.
-	// Currently only supports kernel and initrd commands.
-	c.bootImage = &boot.LinuxImage{}
+// expand replaces the ${name} and ${name:type} variables of line with their
+// values. Unset variables are empty.
+func (c *parser) expand(line string) string {
+	var b strings.Builder
+	for {
+		i := strings.Index(line, "${")
+		if i < 0 {
+			break
+		}
+		j := strings.IndexByte(line[i:], '}')
+		if j < 0 {
+			break
+		}
+		name := line[i+2 : i+j]
+		if k := strings.IndexByte(name, ':'); k >= 0 {
+			name = name[:k]
+		}
+		b.WriteString(line[:i])
+		b.WriteString(c.vars[name])
+		line = line[i+j+1:]
+	}
+	b.WriteString(line)
+	return b.String()
+}
+
+// imageArgs returns the --name option and the other arguments of the image
+// commands, skipping their other options.
+func imageArgs(args []string) (string, []string) {
+	var name string
+	for len(args) > 0 && strings.HasPrefix(args[0], "-") {
+		opt := args[0]
+		args = args[1:]
+		switch {
+		case strings.HasPrefix(opt, "--name="):
+			name = strings.TrimPrefix(opt, "--name=")
+		case opt == "--name", opt == "-n":
+			if len(args) > 0 {
+				name, args = args[0], args[1:]
+			}
+		case opt == "--timeout", opt == "-t":
+			if len(args) > 0 {
+				args = args[1:]
+			}
+		}
+	}
+	return name, args
+}
+
+// imageName is the name iPXE gives to the image at surl without --name.
+func imageName(name, surl string) string {
+	if name != "" {
+		return name
+	}
+	if u, err := url.Parse(surl); err == nil {
+		return path.Base(u.Path)
+	}
+	return path.Base(surl)
+}
+
+// chain boots the image at surl: a script is parsed in place of the current
+// one, other images are booted as kernels with args as command line.
+func (c *parser) chain(ctx context.Context, surl string, args []string) error {
+	if c.chained++; c.chained > maxChain {
+		return fmt.Errorf("too many chained scripts, at %s", surl)
+	}
+	u, err := parseURL(surl, c.wd)
+	if err != nil {
+		return err
+	}
+	r, err := c.schemes.Fetch(ctx, u)
+	if err != nil {
+		return err
+	}
+	magic := make([]byte, len("#!ipxe"))
+	if n, _ := r.ReadAt(magic, 0); string(magic[:n]) == "#!ipxe" {
+		return c.getAndParseFile(ctx, u)
+	}
+	c.bootImage.Kernel = r
+	c.bootImage.Cmdline = strings.Join(args, " ")
+	return nil
+}
 
+// parseIpxe parses `config` and constructs a BootImage for `c`.
+func (c *parser) parseIpxe(ctx context.Context, config string) error {
 	for _, line := range strings.Split(config, "\n") {
 		// Skip blank lines and comment lines.
 		line = strings.TrimSpace(line)
 		if line == "" || line[0] == '#' {
 			continue
 		}
+		// Only the first command of "cmd || other" and "cmd && other"
+		// is run, as if it succeeds.
+		for _, sep := range []string{" || ", " && "} {
+			if i := strings.Index(line, sep); i >= 0 {
+				c.log.Printf("Ignoring %q of ipxe cmd: %s", line[i:], line)
+				line = line[:i]
+			}
+		}
 
-		args := strings.Fields(line)
+		args := strings.Fields(c.expand(line))
 		if len(args) == 0 {
 			continue
 		}
 		cmd := strings.ToLower(args[0])
 
 		switch cmd {
-		case "kernel":
-			if len(args) > 1 {
-				k, err := c.getFile(args[1])
+		case "kernel", "imgselect", "imgload":
+			name, args := imageArgs(args[1:])
+			if len(args) > 0 {
+				k, err := c.getFile(args[0])
 				if err != nil {
 					return err
 				}
 				c.bootImage.Kernel = k
+				c.kernelName = imageName(name, args[0])
 			}
 
 			// Add cmdline if there are any.
-			if len(args) > 2 {
-				c.bootImage.Cmdline = strings.Join(args[2:], " ")
+			if len(args) > 1 {
+				c.bootImage.Cmdline = strings.Join(args[1:], " ")
 			}
 
-		case "initrd":
-			if len(args) > 1 {
-				var initrds []io.ReaderAt
-				for _, f := range strings.Split(args[1], ",") {
+		case "initrd", "module", "imgfetch":
+			_, args := imageArgs(args[1:])
+			if len(args) > 0 {
+				for _, f := range strings.Split(args[0], ",") {
 					i, err := c.getFile(f)
 					if err != nil {
 						return err
 					}
-					initrds = append(initrds, i)
+					c.initrds = append(c.initrds, i)
 				}
-				c.bootImage.Initrd = boot.CatInitrds(initrds...)
+				c.bootImage.Initrd = boot.CatInitrds(c.initrds...)
+			}
+
+		case "imgargs":
+			if len(args) > 1 && args[1] == c.kernelName {
+				c.bootImage.Cmdline = strings.Join(args[2:], " ")
+			}
+
+		case "set":
+			if len(args) > 1 {
+				c.vars[args[1]] = strings.Join(args[2:], " ")
+			}
+
+		case "chain", "imgexec":
+			_, args := imageArgs(args[1:])
+			if len(args) == 0 {
+				return fmt.Errorf("chain without an image: %s", line)
 			}
+			// Chained images do not return.
+			return c.chain(ctx, args[0], args[1:])
 
 		case "boot":
 			// Stop parsing at this point, we should go ahead and
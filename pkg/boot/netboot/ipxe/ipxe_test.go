@@ -388,6 +388,132 @@ func TestIpxeConfig(t *testing.T) {
 				Initrd: strings.NewReader(content2),
 			},
 		},
+		{
+			desc: "imgargs, named kernel and two initrd lines",
+			schemeFunc: func() curl.Schemes {
+				s := make(curl.Schemes)
+				fs := curl.NewMockScheme("http")
+				conf := `#!ipxe
+				kernel --name vmlinuz --timeout 5000 kernel
+				initrd initrd-file.001
+				initrd --name second initrd-file.002
+				imgargs other ignored=1
+				imgargs vmlinuz console=ttyS0
+				boot vmlinuz`
+				fs.Add("someplace.com", "/foobar/pxefiles/ipxeconfig", conf)
+				fs.Add("someplace.com", "/foobar/pxefiles/kernel", content1)
+				fs.Add("someplace.com", "/foobar/pxefiles/initrd-file.001", content512_1)
+				fs.Add("someplace.com", "/foobar/pxefiles/initrd-file.002", content512_2)
+				s.Register(fs.Scheme, fs)
+				return s
+			},
+			curl: &url.URL{
+				Scheme: "http",
+				Host:   "someplace.com",
+				Path:   "/foobar/pxefiles/ipxeconfig",
+			},
+			want: &boot.LinuxImage{
+				Kernel:  strings.NewReader(content1),
+				Initrd:  strings.NewReader(content1024),
+				Cmdline: "console=ttyS0",
+			},
+		},
+		{
+			desc: "variables",
+			schemeFunc: func() curl.Schemes {
+				s := make(curl.Schemes)
+				fs := curl.NewMockScheme("http")
+				conf := `#!ipxe
+				set base http://someplace.com/files
+				set console ttyS1
+				kernel ${base}/kernel console=${console:string} ip=${unset}
+				boot`
+				fs.Add("someplace.com", "/foobar/pxefiles/ipxeconfig", conf)
+				fs.Add("someplace.com", "/files/kernel", content1)
+				s.Register(fs.Scheme, fs)
+				return s
+			},
+			curl: &url.URL{
+				Scheme: "http",
+				Host:   "someplace.com",
+				Path:   "/foobar/pxefiles/ipxeconfig",
+			},
+			want: &boot.LinuxImage{
+				Kernel:  strings.NewReader(content1),
+				Cmdline: "console=ttyS1 ip=",
+			},
+		},
+		{
+			desc: "chain to a script",
+			schemeFunc: func() curl.Schemes {
+				s := make(curl.Schemes)
+				fs := curl.NewMockScheme("http")
+				conf := `#!ipxe
+				set dir boot
+				chain --autofree ${dir}/next.ipxe || goto failed
+				kernel not-reached`
+				next := `#!ipxe
+				kernel kernel quiet
+				boot`
+				fs.Add("someplace.com", "/foobar/pxefiles/ipxeconfig", conf)
+				fs.Add("someplace.com", "/foobar/pxefiles/boot/next.ipxe", next)
+				fs.Add("someplace.com", "/foobar/pxefiles/boot/kernel", content1)
+				s.Register(fs.Scheme, fs)
+				return s
+			},
+			curl: &url.URL{
+				Scheme: "http",
+				Host:   "someplace.com",
+				Path:   "/foobar/pxefiles/ipxeconfig",
+			},
+			want: &boot.LinuxImage{
+				Kernel:  strings.NewReader(content1),
+				Cmdline: "quiet",
+			},
+		},
+		{
+			desc: "chain to a kernel",
+			schemeFunc: func() curl.Schemes {
+				s := make(curl.Schemes)
+				fs := curl.NewMockScheme("http")
+				conf := `#!ipxe
+				initrd initrd-file
+				chain kernel console=ttyS0`
+				fs.Add("someplace.com", "/foobar/pxefiles/ipxeconfig", conf)
+				fs.Add("someplace.com", "/foobar/pxefiles/kernel", content1)
+				fs.Add("someplace.com", "/foobar/pxefiles/initrd-file", content2)
+				s.Register(fs.Scheme, fs)
+				return s
+			},
+			curl: &url.URL{
+				Scheme: "http",
+				Host:   "someplace.com",
+				Path:   "/foobar/pxefiles/ipxeconfig",
+			},
+			want: &boot.LinuxImage{
+				Kernel:  strings.NewReader(content1),
+				Initrd:  strings.NewReader(content2),
+				Cmdline: "console=ttyS0",
+			},
+		},
+		{
+			desc: "chain loop",
+			schemeFunc: func() curl.Schemes {
+				s := make(curl.Schemes)
+				fs := curl.NewMockScheme("http")
+				conf := `#!ipxe
+				chain ipxeconfig`
+				fs.Add("someplace.com", "/foobar/pxefiles/ipxeconfig", conf)
+				s.Register(fs.Scheme, fs)
+				return s
+			},
+			curl: &url.URL{
+				Scheme: "http",
+				Host:   "someplace.com",
+				Path:   "/foobar/pxefiles/ipxeconfig",
+			},
+			err: fmt.Errorf("too many chained scripts, at ipxeconfig"),
+		},
 	} {
 		t.Run(fmt.Sprintf("Test [%02d] %s", i, tt.desc), func(t *testing.T) {
 			got, err := ParseConfig(context.Background(), ulogtest.Logger{t}, tt.curl, tt.schemeFunc())
@@ -7,7 +7,6 @@ package stboot
 import (
 	"errors"
 	"fmt"
-	"io"
 	"io/ioutil"
 	"log"
 	"os"
@@ -15,6 +14,7 @@ import (
 	"strings"
 
 	"github.com/u-root/u-root/pkg/boot/jsonboot"
+	"github.com/u-root/u-root/pkg/cp"
 	"github.com/u-root/u-root/pkg/uzip"
 )
 
@@ -414,25 +414,8 @@ func makeConfigDir(cfg *Stconfig, origDir string) (string, error) {
 // exist it is created. In case case src does not exist, creation of dst
 // or copying fails an error is returned
 func copyFile(src, dst string) error {
-	in, err := os.Open(src)
-	if err != nil {
-		return err
-	}
-	defer in.Close()
-
-	if err = os.MkdirAll(filepath.Dir(dst), os.ModePerm); err != nil {
-		return err
-	}
-
-	out, err := os.Create(dst)
-	if err != nil {
-		return err
-	}
-	defer out.Close()
-
-	if _, err = io.Copy(out, in); err != nil {
+	if err := os.MkdirAll(filepath.Dir(dst), os.ModePerm); err != nil {
 		return err
 	}
-
-	return out.Sync()
+	return cp.Options{Sync: true}.Copy(src, dst)
 }
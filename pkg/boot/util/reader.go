@@ -5,31 +5,16 @@
 package util
 
 import (
-	"bytes"
-	"compress/gzip"
 	"io"
-	"io/ioutil"
 
-	"github.com/u-root/u-root/pkg/uio"
+	"github.com/u-root/u-root/pkg/compress"
 )
 
-func readGzip(r io.Reader) ([]byte, error) {
-	z, err := gzip.NewReader(r)
-	if err != nil {
-		return nil, err
-	}
-	defer z.Close()
-	return ioutil.ReadAll(z)
-}
-
-// TryGzipFilter tries to read from an io.ReaderAt to see if it is a Gzip. If it is not, the
-// io.ReaderAt is returned.
-// TODO: could be tryCompressionFilters, grub support gz,xz and lzop
+// TryGzipFilter tries to read from an io.ReaderAt to see if it is compressed
+// with one of the formats of pkg/compress, e.g. gzip, xz or zstd, and returns
+// the decompressed content if it is. If it is not, the io.ReaderAt is
+// returned.
 // TODO: do we want to keep the filter inside multiboot? This could be the responsibility of the caller...
 func TryGzipFilter(r io.ReaderAt) io.ReaderAt {
-	b, err := readGzip(uio.Reader(r))
-	if err == nil {
-		return bytes.NewReader(b)
-	}
-	return r
+	return compress.TryDecompress(r)
 }
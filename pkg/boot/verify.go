@@ -0,0 +1,67 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package boot
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/u-root/u-root/pkg/uio"
+	"github.com/u-root/u-root/pkg/vfile"
+	"golang.org/x/crypto/openpgp"
+)
+
+// Verifier checks the files of an OSImage before they are loaded.
+type Verifier interface {
+	// Verify returns an error if content, the file called name, must not
+	// be loaded.
+	//
+	// content is the copy of the file that is loaded, so that it cannot
+	// change between its verification and kexec.
+	Verify(name string, content io.ReaderAt) error
+}
+
+// PGPVerifier verifies files against detached OpenPGP signatures, which are
+// expected next to them, e.g. in /boot/vmlinuz.sig for /boot/vmlinuz.
+type PGPVerifier struct {
+	KeyRing openpgp.KeyRing
+}
+
+var _ Verifier = PGPVerifier{}
+
+// Verify implements Verifier.
+func (v PGPVerifier) Verify(name string, content io.ReaderAt) error {
+	if v.KeyRing == nil {
+		return vfile.ErrUnsigned{Path: name, Err: vfile.ErrNoKeyRing}
+	}
+	sig, err := os.Open(fmt.Sprintf("%s.sig", name))
+	if err != nil {
+		return vfile.ErrUnsigned{Path: name, Err: err}
+	}
+	defer sig.Close()
+
+	signer, err := openpgp.CheckDetachedSignature(v.KeyRing, uio.Reader(content), sig)
+	if err != nil {
+		return vfile.ErrUnsigned{Path: name, Err: err}
+	}
+	if signer == nil {
+		return vfile.ErrUnsigned{Path: name, Err: vfile.ErrWrongSigner{KeyRing: v.KeyRing}}
+	}
+	return nil
+}
+
+// Measurer records the files and the command line of an OSImage, e.g. into
+// TPM PCRs, before they are loaded.
+type Measurer interface {
+	// MeasureKernel measures content, the kernel called name.
+	MeasureKernel(name string, content io.ReaderAt) error
+
+	// MeasureInitrd measures content, the initrd called name.
+	MeasureInitrd(name string, content io.ReaderAt) error
+
+	// MeasureCmdline measures the kernel command line.
+	MeasureCmdline(cmdline string) error
+}
@@ -0,0 +1,75 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package boot
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/u-root/u-root/pkg/vfile"
+	"golang.org/x/crypto/openpgp"
+)
+
+func TestPGPVerifier(t *testing.T) {
+	key, err := openpgp.NewEntity("boot", "boot", "boot@u-root", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := openpgp.NewEntity("other", "other", "other@u-root", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir, err := ioutil.TempDir("", "verify")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	kernel := filepath.Join(dir, "vmlinuz")
+	if err := ioutil.WriteFile(kernel, []byte("kernel"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	sig, err := os.Create(kernel + ".sig")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := openpgp.DetachSign(sig, key, strings.NewReader("kernel"), nil); err != nil {
+		t.Fatal(err)
+	}
+	sig.Close()
+
+	for _, tt := range []struct {
+		desc    string
+		keyring openpgp.KeyRing
+		name    string
+		content string
+		ok      bool
+	}{
+		{desc: "signed", keyring: openpgp.EntityList{key}, name: kernel, content: "kernel", ok: true},
+		{desc: "modified", keyring: openpgp.EntityList{key}, name: kernel, content: "kernel2"},
+		{desc: "wrong key", keyring: openpgp.EntityList{other}, name: kernel, content: "kernel"},
+		{desc: "no signature", keyring: openpgp.EntityList{key}, name: filepath.Join(dir, "initrd"), content: "kernel"},
+		{desc: "no keyring", name: kernel, content: "kernel"},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			err := PGPVerifier{KeyRing: tt.keyring}.Verify(tt.name, strings.NewReader(tt.content))
+			if tt.ok {
+				if err != nil {
+					t.Errorf("Verify() = %v, want nil", err)
+				}
+				return
+			}
+			var u vfile.ErrUnsigned
+			if !errors.As(err, &u) {
+				t.Errorf("Verify() = %v, want ErrUnsigned", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmdline
+
+import (
+	"fmt"
+	"strings"
+)
+
+// param is a parameter of a Builder.
+type param struct {
+	flag         string
+	canonicalKey string
+	trimmedValue string
+}
+
+// Builder composes a kernel command line, e.g. for a kexec'd kernel, from
+// the command line of a boot configuration, parameters inherited from the
+// running kernel, and new ones.
+//
+// Parameters after --, which the kernel passes to init, are kept after the
+// kernel parameters; Append, Inherit, Set and Remove only change the latter.
+type Builder struct {
+	params []param
+	init   []string
+}
+
+// NewBuilder returns a Builder starting with the parameters of cmdline.
+func NewBuilder(cmdline string) *Builder {
+	b := &Builder{}
+	b.Append(cmdline)
+	return b
+}
+
+// Append appends the parameters of cmdline, which can be quoted as the
+// kernel does. Parameters after -- are appended to those for init.
+func (b *Builder) Append(cmdline string) {
+	toInit := false
+	doParse(cmdline, func(flag, key, canonicalKey, value, trimmedValue string) {
+		switch {
+		case toInit:
+			b.init = append(b.init, flag)
+		case flag == "--":
+			toInit = true
+		default:
+			b.params = append(b.params, param{flag: flag, canonicalKey: canonicalKey, trimmedValue: trimmedValue})
+		}
+	})
+}
+
+// canonical returns keys with - replaced by _, as doParse does.
+func canonical(keys []string) map[string]bool {
+	m := make(map[string]bool, len(keys))
+	for _, k := range keys {
+		m[strings.Replace(k, "-", "_", -1)] = true
+	}
+	return m
+}
+
+// Inherit appends the parameters of cmdline, usually the running kernel's
+// FullCmdLine, whose keys are one of keys. All of them are appended as they
+// are, in order, e.g. both of console=tty0 console=ttyS0,115200.
+func (b *Builder) Inherit(cmdline string, keys ...string) {
+	want := canonical(keys)
+	kernel := NewBuilder(cmdline)
+	for _, p := range kernel.params {
+		if want[p.canonicalKey] {
+			b.params = append(b.params, p)
+		}
+	}
+}
+
+// Remove removes all the parameters whose keys are one of keys.
+func (b *Builder) Remove(keys ...string) {
+	remove := canonical(keys)
+	params := b.params[:0]
+	for _, p := range b.params {
+		if !remove[p.canonicalKey] {
+			params = append(params, p)
+		}
+	}
+	b.params = params
+}
+
+// Set replaces the parameters with key by key=value, quoted if need be, or
+// by key alone if value is empty.
+func (b *Builder) Set(key, value string) error {
+	flag, err := Quote(key, value)
+	if err != nil {
+		return err
+	}
+	b.Remove(key)
+	b.Append(flag)
+	return nil
+}
+
+// Quote returns the parameter key=value, with value in double quotes if it
+// has spaces, or key alone if value is empty. Keys cannot have spaces, = or
+// quotes, and values cannot have double quotes, since the kernel has no way
+// to escape them.
+func Quote(key, value string) (string, error) {
+	if key == "" || strings.ContainsAny(key, "= \t\n\"'") {
+		return "", fmt.Errorf("invalid kernel parameter name %q", key)
+	}
+	if strings.Contains(value, `"`) {
+		return "", fmt.Errorf("kernel parameter %s: value %q cannot have double quotes", key, value)
+	}
+	switch {
+	case value == "":
+		return key, nil
+	case strings.ContainsAny(value, " \t\n"):
+		return fmt.Sprintf(`%s="%s"`, key, value), nil
+	}
+	return key + "=" + value, nil
+}
+
+// String returns the command line. Parameters with the same key and value
+// as an earlier one are left out.
+func (b *Builder) String() string {
+	var flags []string
+	seen := make(map[param]bool)
+	for _, p := range b.params {
+		k := param{canonicalKey: p.canonicalKey, trimmedValue: p.trimmedValue}
+		if seen[k] {
+			continue
+		}
+		seen[k] = true
+		flags = append(flags, p.flag)
+	}
+	if len(b.init) > 0 {
+		flags = append(append(flags, "--"), b.init...)
+	}
+	return strings.Join(flags, " ")
+}
@@ -0,0 +1,67 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmdline
+
+import (
+	"testing"
+)
+
+func TestBuilder(t *testing.T) {
+	running := `BOOT_IMAGE=/vmlinuz ro console=tty0 console=ttyS0,115200 ip=dhcp earlyprintk=serial uroot.uinitargs="-v -x"`
+
+	b := NewBuilder(`root=/dev/sda1 ro console=ttyS1 quiet -- single`)
+	b.Remove("console", "early-printk")
+	b.Inherit(running, "console", "ip", "uroot.uinitargs", "not_there")
+	b.Append(`ro panic=10 init_on_free=1`)
+	if err := b.Set("root", "/dev/sda2"); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Set("uroot.initflags", "systemd debug"); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Set("nokaslr", ""); err != nil {
+		t.Fatal(err)
+	}
+	b.Remove("init-on-free")
+
+	want := `ro quiet console=tty0 console=ttyS0,115200 ip=dhcp uroot.uinitargs="-v -x" panic=10 root=/dev/sda2 uroot.initflags="systemd debug" nokaslr -- single`
+	if got := b.String(); got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+func TestBuilderInit(t *testing.T) {
+	b := NewBuilder(`quiet`)
+	b.Append(`-- a b`)
+	b.Append(`debug`)
+	if got, want := b.String(), "quiet debug -- a b"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+func TestQuote(t *testing.T) {
+	for _, tt := range []struct {
+		key, value, want string
+	}{
+		{"console", "ttyS0,115200", "console=ttyS0,115200"},
+		{"uroot.uinitargs", "-v x", `uroot.uinitargs="-v x"`},
+		{"quiet", "", "quiet"},
+		{"dyndbg", "file foo.c +p", `dyndbg="file foo.c +p"`},
+	} {
+		got, err := Quote(tt.key, tt.value)
+		if err != nil || got != tt.want {
+			t.Errorf("Quote(%q, %q) = %q, %v, want %q", tt.key, tt.value, got, err, tt.want)
+		}
+		// What Quote returns is parsed back to the value.
+		if m := parseToMap(got); tt.value != "" && m[tt.key] != tt.value {
+			t.Errorf("%q parses to %q, want %q", got, m[tt.key], tt.value)
+		}
+	}
+	for _, kv := range [][2]string{{"", "x"}, {"a=b", "c"}, {"a b", "c"}, {"a", `b"c`}} {
+		if _, err := Quote(kv[0], kv[1]); err == nil {
+			t.Errorf("Quote(%q, %q) succeeded, want an error", kv[0], kv[1])
+		}
+	}
+}
@@ -4,36 +4,11 @@
 
 package cmdline
 
-import (
-	"fmt"
-	"strings"
-)
-
 // RemoveFilter filters out variable for a given space-separated kernel commandline
 func removeFilter(input string, variables []string) string {
-	var newCl []string
-
-	// kernel variables must allow '-' and '_' to be equivalent in variable
-	// names. We will replace dashes with underscores for processing as
-	// `doParse` is doing.
-	for i, v := range variables {
-		variables[i] = strings.Replace(v, "-", "_", -1)
-	}
-
-	doParse(input, func(flag, key, canonicalKey, value, trimmedValue string) {
-		skip := false
-		for _, v := range variables {
-			if canonicalKey == v {
-				skip = true
-				break
-			}
-		}
-		if skip {
-			return
-		}
-		newCl = append(newCl, flag)
-	})
-	return strings.Join(newCl, " ")
+	b := NewBuilder(input)
+	b.Remove(variables...)
+	return b.String()
 }
 
 // Filter represents and kernel commandline filter
@@ -51,7 +26,7 @@ type updater struct {
 // NewUpdateFilter return a kernel command line Filter that:
 // removes variables listed in 'removeVar',
 // append extra parameters from the 'appendCmd' and
-// append variables listed in 'reuseVar' using the value from the running kernel
+// append variables listed in 'reuseVar' using the values from the running kernel
 func NewUpdateFilter(appendCmd string, removeVar, reuseVar []string) Filter {
 	return &updater{
 		appendCmd: appendCmd,
@@ -61,16 +36,9 @@ func NewUpdateFilter(appendCmd string, removeVar, reuseVar []string) Filter {
 }
 
 func (u *updater) Update(cmdline string) string {
-	acl := ""
-	if len(u.appendCmd) > 0 {
-		acl = " " + u.appendCmd
-	}
-	for _, f := range u.reuseVar {
-		value, present := Flag(f)
-		if present {
-			acl = fmt.Sprintf("%s %s=%s", acl, f, value)
-		}
-	}
-
-	return removeFilter(cmdline, u.removeVar) + acl
+	b := NewBuilder(cmdline)
+	b.Remove(u.removeVar...)
+	b.Append(u.appendCmd)
+	b.Inherit(FullCmdLine(), u.reuseVar...)
+	return b.String()
 }
@@ -44,7 +44,8 @@ func TestUpdateFilter(t *testing.T) {
 	toAppend := "append=me"
 
 	cl := `keep=5 console=ttyS1 keep2 earlyconsole=ttyS1`
-	want := `keep=5 keep2 append=me console=ttyS0,115200`
+	// All the consoles are reused.
+	want := `keep=5 keep2 append=me console=tty0 console=ttyS0,115200`
 
 	filter := NewUpdateFilter(toAppend, toRemove, toReuse)
 	got := filter.Update(cl)
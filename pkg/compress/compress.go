@@ -0,0 +1,282 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package compress is a registry of compression formats.
+//
+// Formats are found by name, by file name extension, or by the magic bytes
+// their streams start with, so that compressed archives, kernels and
+// modules can be read without knowing how they were compressed.
+package compress
+
+import (
+	"bufio"
+	"bytes"
+	"compress/bzip2"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"runtime"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/klauspost/pgzip"
+	"github.com/u-root/u-root/pkg/uio"
+	"github.com/ulikunitz/xz"
+)
+
+// DefaultLevel is the default compression level of every format.
+const DefaultLevel = -1
+
+// Format is a compression format.
+type Format struct {
+	// Name is the name of the format, e.g. "gzip".
+	Name string
+
+	// Extensions are the file name extensions of the format, the usual
+	// one first, e.g. ".gz".
+	Extensions []string
+
+	// Magics are the bytes the compressed streams start with.
+	Magics [][]byte
+
+	// NewReader returns a reader of the decompressed content of r.
+	NewReader func(r io.Reader) (io.ReadCloser, error)
+
+	// NewWriter returns a writer compressing to w at the given level,
+	// from 1 (fastest) to 9 (smallest), or DefaultLevel. Closing it
+	// flushes the compressed stream, but does not close w.
+	//
+	// It is nil for the formats that can only be read.
+	NewWriter func(w io.Writer, level int) (io.WriteCloser, error)
+}
+
+func (f *Format) String() string {
+	return f.Name
+}
+
+// CanWrite returns whether f can compress.
+func (f *Format) CanWrite() bool {
+	return f.NewWriter != nil
+}
+
+// Writer returns a writer compressing to w at the given level.
+func (f *Format) Writer(w io.Writer, level int) (io.WriteCloser, error) {
+	if f.NewWriter == nil {
+		return nil, fmt.Errorf("cannot compress with %s", f.Name)
+	}
+	return f.NewWriter(w, level)
+}
+
+// These are the formats of the registry.
+var (
+	Gzip = &Format{
+		Name:       "gzip",
+		Extensions: []string{".gz", ".tgz"},
+		Magics:     [][]byte{{0x1f, 0x8b}},
+		NewReader: func(r io.Reader) (io.ReadCloser, error) {
+			return gzip.NewReader(r)
+		},
+		NewWriter: func(w io.Writer, level int) (io.WriteCloser, error) {
+			return NewGzipWriter(w, level, 0, 0)
+		},
+	}
+
+	Bzip2 = &Format{
+		Name:       "bzip2",
+		Extensions: []string{".bz2", ".tbz2"},
+		Magics:     [][]byte{[]byte("BZh")},
+		NewReader: func(r io.Reader) (io.ReadCloser, error) {
+			return ioutil.NopCloser(bzip2.NewReader(r)), nil
+		},
+	}
+
+	Xz = &Format{
+		Name:       "xz",
+		Extensions: []string{".xz", ".txz"},
+		Magics:     [][]byte{{0xfd, '7', 'z', 'X', 'Z', 0x00}},
+		NewReader: func(r io.Reader) (io.ReadCloser, error) {
+			xr, err := xz.NewReader(r)
+			if err != nil {
+				return nil, err
+			}
+			return ioutil.NopCloser(xr), nil
+		},
+		NewWriter: newXzWriter,
+	}
+
+	Zstd = &Format{
+		Name:       "zstd",
+		Extensions: []string{".zst", ".tzst"},
+		Magics:     [][]byte{{0x28, 0xb5, 0x2f, 0xfd}},
+		NewReader: func(r io.Reader) (io.ReadCloser, error) {
+			d, err := zstd.NewReader(r)
+			if err != nil {
+				return nil, err
+			}
+			return zstdReader{d}, nil
+		},
+		NewWriter: func(w io.Writer, level int) (io.WriteCloser, error) {
+			if level == DefaultLevel {
+				return zstd.NewWriter(w)
+			}
+			return zstd.NewWriter(w, zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(level)))
+		},
+	}
+
+	Lz4 = &Format{
+		Name:       "lz4",
+		Extensions: []string{".lz4"},
+		Magics:     [][]byte{lz4FrameMagic, lz4LegacyMagic},
+		NewReader: func(r io.Reader) (io.ReadCloser, error) {
+			return ioutil.NopCloser(newLz4Reader(r)), nil
+		},
+	}
+)
+
+var formats = []*Format{Gzip, Bzip2, Xz, Zstd, Lz4}
+
+// Register adds f to the registry.
+func Register(f *Format) {
+	formats = append(formats, f)
+}
+
+// Formats returns the formats of the registry.
+func Formats() []*Format {
+	return formats
+}
+
+// ByName returns the format called name.
+func ByName(name string) (*Format, error) {
+	for _, f := range formats {
+		if f.Name == name {
+			return f, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown compression %q", name)
+}
+
+// ByExtension returns the format of the file name, or nil if its extension
+// is not of a known format.
+func ByExtension(name string) *Format {
+	for _, f := range formats {
+		for _, ext := range f.Extensions {
+			if strings.HasSuffix(name, ext) {
+				return f
+			}
+		}
+	}
+	return nil
+}
+
+// Detect returns the format of the stream starting with b, or nil if it is
+// not compressed with a known format.
+func Detect(b []byte) *Format {
+	for _, f := range formats {
+		for _, m := range f.Magics {
+			if bytes.HasPrefix(b, m) {
+				return f
+			}
+		}
+	}
+	return nil
+}
+
+// maxMagic is the longest magic of the registry.
+func maxMagic() int {
+	var n int
+	for _, f := range formats {
+		for _, m := range f.Magics {
+			if len(m) > n {
+				n = len(m)
+			}
+		}
+	}
+	return n
+}
+
+// NewReader returns a reader of the decompressed content of r, whose format
+// is detected from its first bytes. An r not compressed with a known format
+// is read as is.
+func NewReader(r io.Reader) (io.ReadCloser, error) {
+	br := bufio.NewReader(r)
+	// A short stream is not compressed, which its reader will tell.
+	b, _ := br.Peek(maxMagic())
+	if f := Detect(b); f != nil {
+		return f.NewReader(br)
+	}
+	return ioutil.NopCloser(br), nil
+}
+
+// TryDecompress returns the decompressed content of r if it is compressed
+// with a known format. Otherwise, or if it cannot be decompressed, r is
+// returned.
+func TryDecompress(r io.ReaderAt) io.ReaderAt {
+	b := make([]byte, maxMagic())
+	n, _ := r.ReadAt(b, 0)
+	f := Detect(b[:n])
+	if f == nil {
+		return r
+	}
+	d, err := f.NewReader(uio.Reader(r))
+	if err != nil {
+		return r
+	}
+	defer d.Close()
+	c, err := ioutil.ReadAll(d)
+	if err != nil {
+		return r
+	}
+	return bytes.NewReader(c)
+}
+
+// NewGzipWriter returns a gzip writer to w compressing blocks of blockSize
+// bytes on up to processes cores. Zero values mean 1 MiB blocks and all
+// cores.
+func NewGzipWriter(w io.Writer, level, blockSize, processes int) (io.WriteCloser, error) {
+	zw, err := pgzip.NewWriterLevel(w, level)
+	if err != nil {
+		return nil, err
+	}
+	if blockSize == 0 {
+		blockSize = 1 << 20
+	}
+	if processes == 0 {
+		processes = runtime.GOMAXPROCS(0)
+	}
+	if err := zw.SetConcurrency(blockSize, processes); err != nil {
+		return nil, err
+	}
+	return zw, nil
+}
+
+// xzDictCaps are the dictionary sizes of the levels of xz(1).
+var xzDictCaps = [...]int{
+	256 << 10, 1 << 20, 2 << 20, 4 << 20, 4 << 20,
+	8 << 20, 8 << 20, 16 << 20, 32 << 20, 64 << 20,
+}
+
+// newXzWriter returns an xz writer with CRC32 checks, which is what the
+// kernel's decompressor supports.
+func newXzWriter(w io.Writer, level int) (io.WriteCloser, error) {
+	c := xz.WriterConfig{CheckSum: xz.CRC32}
+	if level == DefaultLevel {
+		level = 6
+	}
+	if level < 0 || level >= len(xzDictCaps) {
+		return nil, fmt.Errorf("invalid xz level %d", level)
+	}
+	c.DictCap = xzDictCaps[level]
+	return c.NewWriter(w)
+}
+
+// zstdReader closes the decoder, which stops its goroutines.
+type zstdReader struct {
+	*zstd.Decoder
+}
+
+func (z zstdReader) Close() error {
+	z.Decoder.Close()
+	return nil
+}
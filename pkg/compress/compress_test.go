@@ -0,0 +1,159 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package compress
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func TestRoundTrip(t *testing.T) {
+	content := []byte(strings.Repeat("u-root is a universal root. ", 1000))
+	for _, f := range Formats() {
+		if !f.CanWrite() {
+			continue
+		}
+		for _, level := range []int{DefaultLevel, 1, 9} {
+			var b bytes.Buffer
+			w, err := f.Writer(&b, level)
+			if err != nil {
+				t.Fatalf("%s.Writer(level %d): %v", f, level, err)
+			}
+			if _, err := w.Write(content); err != nil {
+				t.Fatal(err)
+			}
+			if err := w.Close(); err != nil {
+				t.Fatal(err)
+			}
+			if got := Detect(b.Bytes()); got != f {
+				t.Errorf("Detect(%s stream) = %v", f, got)
+			}
+			r, err := NewReader(&b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := ioutil.ReadAll(r)
+			r.Close()
+			if err != nil || !bytes.Equal(got, content) {
+				t.Errorf("%s level %d: got %d bytes, %v, want %d bytes", f, level, len(got), err, len(content))
+			}
+		}
+	}
+}
+
+func TestRead(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		in   []byte
+		want string
+	}{
+		{
+			name: "uncompressed",
+			in:   []byte("hi"),
+			want: "hi",
+		},
+		{
+			name: "bzip2",
+			in: []byte{
+				0x42, 0x5a, 0x68, 0x39, 0x31, 0x41, 0x59, 0x26, 0x53, 0x59, 0xc1, 0xc0,
+				0x80, 0xe2, 0x00, 0x00, 0x01, 0x41, 0x00, 0x00, 0x10, 0x02, 0x44, 0xa0,
+				0x00, 0x30, 0xcd, 0x00, 0xc3, 0x46, 0x29, 0x97, 0x17, 0x72, 0x45, 0x38,
+				0x50, 0x90, 0xc1, 0xc0, 0x80, 0xe2,
+			},
+			want: "hello\n",
+		},
+		{
+			// Dependent blocks, the second one with a match in the
+			// first, then an uncompressed block.
+			name: "lz4 frame",
+			in: []byte{
+				0x04, 0x22, 0x4d, 0x18, 0x40, 0x40, 0xc0,
+				0x04, 0x00, 0x00, 0x00, 0x30, 'a', 'b', 'c',
+				0x05, 0x00, 0x00, 0x00, 0x05, 0x03, 0x00, 0x10, 'd',
+				0x03, 0x00, 0x00, 0x80, 'x', 'y', 'z',
+				0x00, 0x00, 0x00, 0x00,
+			},
+			want: "abcabcabcabcdxyz",
+		},
+		{
+			name: "lz4 legacy",
+			in: []byte{
+				0x02, 0x21, 0x4c, 0x18,
+				0x06, 0x00, 0x00, 0x00, 0x30, 'a', 'b', 'c', 0x03, 0x00,
+			},
+			want: "abcabca",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := NewReader(bytes.NewReader(tt.in))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer r.Close()
+			got, err := ioutil.ReadAll(r)
+			if err != nil || string(got) != tt.want {
+				t.Errorf("read %q, %v, want %q", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestCorruptLz4(t *testing.T) {
+	// The match is before the start of the block.
+	in := []byte{0x02, 0x21, 0x4c, 0x18, 0x04, 0x00, 0x00, 0x00, 0x10, 'a', 0x02, 0x00}
+	r, err := NewReader(bytes.NewReader(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ioutil.ReadAll(r); err == nil {
+		t.Errorf("reading a corrupt lz4 block succeeded")
+	}
+}
+
+func TestLookup(t *testing.T) {
+	for name, want := range map[string]*Format{
+		"initramfs.cpio.gz":  Gzip,
+		"x.tgz":              Gzip,
+		"kernel.xz":          Xz,
+		"root.tar.zst":       Zstd,
+		"vmlinux.lz4":        Lz4,
+		"module.ko.bz2":      Bzip2,
+		"initramfs.cpio":     nil,
+		"initramfs.cpio.zip": nil,
+	} {
+		if got := ByExtension(name); got != want {
+			t.Errorf("ByExtension(%q) = %v, want %v", name, got, want)
+		}
+	}
+	if f, err := ByName("zstd"); f != Zstd || err != nil {
+		t.Errorf("ByName(zstd) = %v, %v, want zstd", f, err)
+	}
+	if _, err := ByName("zip"); err == nil {
+		t.Errorf("ByName(zip) succeeded")
+	}
+	if _, err := Bzip2.Writer(ioutil.Discard, DefaultLevel); err == nil {
+		t.Errorf("bzip2 writer succeeded")
+	}
+}
+
+func TestTryDecompress(t *testing.T) {
+	var b bytes.Buffer
+	w, err := Gzip.Writer(&b, DefaultLevel)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.Write([]byte("kernel")) //nolint:errcheck
+	w.Close()
+	for in, want := range map[string]string{b.String(): "kernel", "plain": "plain"} {
+		r := TryDecompress(strings.NewReader(in))
+		got := make([]byte, 16)
+		n, _ := r.ReadAt(got, 0)
+		if string(got[:n]) != want {
+			t.Errorf("TryDecompress() = %q, want %q", got[:n], want)
+		}
+	}
+}
@@ -0,0 +1,266 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package compress
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+)
+
+var (
+	lz4FrameMagic  = []byte{0x04, 0x22, 0x4d, 0x18}
+	lz4LegacyMagic = []byte{0x02, 0x21, 0x4c, 0x18}
+)
+
+const (
+	lz4FrameMagicLE  = 0x184d2204
+	lz4LegacyMagicLE = 0x184c2102
+
+	// Skippable frames have the magics 0x184d2a50 to 0x184d2a5f.
+	lz4SkippableMask  = 0xfffffff0
+	lz4SkippableMagic = 0x184d2a50
+
+	// lz4Window is how far back matches go.
+	lz4Window = 64 << 10
+
+	// lz4LegacyBlock is the size of the blocks of the legacy format.
+	lz4LegacyBlock = 8 << 20
+)
+
+var errLz4Corrupt = errors.New("lz4: corrupt block")
+
+// lz4Reader decompresses LZ4 frames, as written by lz4(1), and the legacy
+// format used for kernels. Checksums are not verified.
+type lz4Reader struct {
+	r *bufio.Reader
+
+	// legacy is set within a frame of the legacy format.
+	legacy bool
+	// inFrame is set between a frame header and its end mark.
+	inFrame bool
+	// independent blocks do not refer to the previous ones.
+	independent bool
+	blockSum    bool
+	contentSum  bool
+
+	// out is the decompressed data, after the window of the previous
+	// blocks.
+	out []byte
+	// pos is where the unread decompressed data starts in out.
+	pos int
+}
+
+func newLz4Reader(r io.Reader) *lz4Reader {
+	return &lz4Reader{r: bufio.NewReader(r)}
+}
+
+func (z *lz4Reader) uint32() (uint32, error) {
+	var b [4]byte
+	if _, err := io.ReadFull(z.r, b[:]); err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint32(b[:]), nil
+}
+
+// frame reads the header of the next frame. It returns io.EOF at the end of
+// the stream.
+func (z *lz4Reader) frame() error {
+	for {
+		magic, err := z.uint32()
+		if err == io.ErrUnexpectedEOF {
+			return io.ErrUnexpectedEOF
+		}
+		if err != nil {
+			return io.EOF
+		}
+		switch {
+		case magic == lz4LegacyMagicLE:
+			z.legacy, z.inFrame, z.independent = true, true, true
+			return nil
+		case magic == lz4FrameMagicLE:
+			var d [2]byte
+			if _, err := io.ReadFull(z.r, d[:]); err != nil {
+				return err
+			}
+			flg := d[0]
+			if flg>>6 != 1 {
+				return fmt.Errorf("lz4: unsupported frame version %d", flg>>6)
+			}
+			z.legacy, z.inFrame = false, true
+			z.independent = flg&0x20 != 0
+			z.blockSum = flg&0x10 != 0
+			z.contentSum = flg&0x04 != 0
+			// The content size, the dictionary ID and the header
+			// checksum.
+			skip := 1
+			if flg&0x08 != 0 {
+				skip += 8
+			}
+			if flg&0x01 != 0 {
+				skip += 4
+			}
+			if _, err := io.CopyN(ioutil.Discard, z.r, int64(skip)); err != nil {
+				return err
+			}
+			return nil
+		case magic&lz4SkippableMask == lz4SkippableMagic:
+			n, err := z.uint32()
+			if err != nil {
+				return err
+			}
+			if _, err := io.CopyN(ioutil.Discard, z.r, int64(n)); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("lz4: bad magic %#x", magic)
+		}
+	}
+}
+
+// block decompresses the next block of the frame into z.out. It returns
+// io.EOF at the end of the frame.
+func (z *lz4Reader) block() error {
+	size, err := z.uint32()
+	if z.legacy {
+		// Legacy frames end at the end of the stream or at the next
+		// frame.
+		if err != nil {
+			return io.EOF
+		}
+		if size == lz4LegacyMagicLE || size == lz4FrameMagicLE || size&lz4SkippableMask == lz4SkippableMagic {
+			var b [4]byte
+			binary.LittleEndian.PutUint32(b[:], size)
+			z.r = bufio.NewReader(io.MultiReader(bytes.NewReader(b[:]), z.r))
+			return io.EOF
+		}
+	} else if err != nil {
+		return io.ErrUnexpectedEOF
+	}
+	if size == 0 && !z.legacy {
+		// The end mark.
+		if z.contentSum {
+			if _, err := z.uint32(); err != nil {
+				return io.ErrUnexpectedEOF
+			}
+		}
+		return io.EOF
+	}
+
+	uncompressed := size&0x80000000 != 0 && !z.legacy
+	size &^= 0x80000000
+	if size > lz4LegacyBlock+lz4LegacyBlock/255+16 {
+		return fmt.Errorf("lz4: block of %d bytes is too big", size)
+	}
+	src := make([]byte, size)
+	if _, err := io.ReadFull(z.r, src); err != nil {
+		return io.ErrUnexpectedEOF
+	}
+	if z.blockSum {
+		if _, err := z.uint32(); err != nil {
+			return io.ErrUnexpectedEOF
+		}
+	}
+
+	// Keep the window of the previous blocks for the matches.
+	switch {
+	case z.independent:
+		z.out = z.out[:0]
+	case len(z.out) > lz4Window:
+		n := copy(z.out, z.out[len(z.out)-lz4Window:])
+		z.out = z.out[:n]
+	}
+	z.pos = len(z.out)
+	if uncompressed {
+		z.out = append(z.out, src...)
+		return nil
+	}
+	z.out, err = lz4Block(z.out, src)
+	return err
+}
+
+func (z *lz4Reader) Read(p []byte) (int, error) {
+	for z.pos == len(z.out) {
+		if !z.inFrame {
+			if err := z.frame(); err != nil {
+				return 0, err
+			}
+		}
+		if err := z.block(); err == io.EOF {
+			z.inFrame = false
+		} else if err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, z.out[z.pos:])
+	z.pos += n
+	return n, nil
+}
+
+// lz4Length reads the extra bytes of a literal or match length.
+func lz4Length(src []byte, i, n int) (int, int, error) {
+	for {
+		if i >= len(src) {
+			return 0, 0, errLz4Corrupt
+		}
+		b := src[i]
+		i++
+		n += int(b)
+		if b != 255 {
+			return i, n, nil
+		}
+	}
+}
+
+// lz4Block appends the decompressed block src to dst, whose content is the
+// window matches refer to.
+func lz4Block(dst, src []byte) ([]byte, error) {
+	var err error
+	for i := 0; i < len(src); {
+		token := src[i]
+		i++
+		lit := int(token >> 4)
+		if lit == 15 {
+			if i, lit, err = lz4Length(src, i, lit); err != nil {
+				return nil, err
+			}
+		}
+		if lit > len(src)-i {
+			return nil, errLz4Corrupt
+		}
+		dst = append(dst, src[i:i+lit]...)
+		i += lit
+		// The last sequence has literals only.
+		if i == len(src) {
+			break
+		}
+
+		if i+2 > len(src) {
+			return nil, errLz4Corrupt
+		}
+		offset := int(src[i]) | int(src[i+1])<<8
+		i += 2
+		if offset == 0 || offset > len(dst) {
+			return nil, errLz4Corrupt
+		}
+		match := int(token & 15)
+		if match == 15 {
+			if i, match, err = lz4Length(src, i, match); err != nil {
+				return nil, err
+			}
+		}
+		match += 4
+		// Matches may overlap what they write.
+		start := len(dst) - offset
+		for k := 0; k < match; k++ {
+			dst = append(dst, dst[start+k])
+		}
+	}
+	return dst, nil
+}
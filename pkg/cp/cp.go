@@ -7,6 +7,10 @@
 // CopyTree in particular copies entire trees of files.
 //
 // Only directories, symlinks, and regular files are currently supported.
+//
+// Regular files are cloned where the file system supports it (reflinks), and
+// otherwise copied keeping their holes. Options select the attributes
+// preserved and report progress.
 package cp
 
 import (
@@ -38,8 +42,53 @@ type Options struct {
 
 	// PostCallback is called on each file after it is copied if specified.
 	PostCallback func(src, dst string)
+
+	// PreserveMode copies the setuid, setgid and sticky bits and the
+	// permissions, not masked by the umask.
+	PreserveMode bool
+
+	// PreserveOwner copies the owner and group. This usually needs root.
+	PreserveOwner bool
+
+	// PreserveTimes copies the access and modification times.
+	PreserveTimes bool
+
+	// Strategy is how the contents of regular files are copied.
+	Strategy Strategy
+
+	// Sync flushes regular files to storage after they are copied.
+	Sync bool
+
+	// Progress, if set, is called as the contents of a regular file are
+	// copied with the number of bytes of it done so far, holes included,
+	// and its size.
+	Progress func(src, dst string, done, size int64)
 }
 
+// Strategy is how the contents of regular files are copied.
+type Strategy int
+
+// These are the strategies.
+const (
+	// Auto clones files if the file system supports it or copies them
+	// keeping holes.
+	Auto Strategy = iota
+
+	// ReadWrite reads and writes all bytes of files.
+	ReadWrite
+
+	// Sparse copies the data of files, leaving their holes as holes.
+	//
+	// It falls back to ReadWrite where holes cannot be found, for files
+	// which say they are empty, and on pseudo file systems like /proc,
+	// whose file sizes are not what they read.
+	Sparse
+
+	// Reflink clones files, sharing their data until either copy is
+	// written. It fails if the file system does not support it.
+	Reflink
+)
+
 // Default are the default options. Default follows symlinks.
 var Default = Options{}
 
@@ -48,6 +97,15 @@ var NoFollowSymlinks = Options{
 	NoFollowSymlinks: true,
 }
 
+// Archive copies symlinks as symlinks and preserves all attributes, like
+// cp -a.
+var Archive = Options{
+	NoFollowSymlinks: true,
+	PreserveMode:     true,
+	PreserveOwner:    true,
+	PreserveTimes:    true,
+}
+
 func (o Options) stat(path string) (os.FileInfo, error) {
 	if o.NoFollowSymlinks {
 		return os.Lstat(path)
@@ -69,7 +127,10 @@ func (o Options) Copy(src, dst string) error {
 			return err
 		}
 	}
-	if err := copyFile(src, dst, srcInfo); err != nil {
+	if err := o.copyFile(src, dst, srcInfo); err != nil {
+		return err
+	}
+	if err := o.preserve(dst, srcInfo); err != nil {
 		return err
 	}
 	if o.PostCallback != nil {
@@ -80,7 +141,25 @@ func (o Options) Copy(src, dst string) error {
 
 // CopyTree recursively copies all files in the src tree to dst.
 func (o Options) CopyTree(src, dst string) error {
-	return filepath.Walk(src, func(path string, fi os.FileInfo, err error) error {
+	// Copying into a directory changes its times, so they are set once
+	// all of it is copied, innermost first.
+	type dir struct {
+		path string
+		fi   os.FileInfo
+	}
+	var dirs []dir
+	if o.PreserveTimes {
+		post := o.PostCallback
+		o.PostCallback = func(src, dst string) {
+			if fi, err := o.stat(src); err == nil && fi.IsDir() {
+				dirs = append(dirs, dir{dst, fi})
+			}
+			if post != nil {
+				post(src, dst)
+			}
+		}
+	}
+	err := filepath.Walk(src, func(path string, fi os.FileInfo, err error) error {
 		if err != nil {
 			return err
 		}
@@ -91,6 +170,12 @@ func (o Options) CopyTree(src, dst string) error {
 		}
 		return o.Copy(path, filepath.Join(dst, rel))
 	})
+	for i := len(dirs) - 1; i >= 0; i-- {
+		if terr := setTimes(dirs[i].path, dirs[i].fi); terr != nil && err == nil {
+			err = terr
+		}
+	}
+	return err
 }
 
 // Copy src file to dst file using Default's config.
@@ -104,14 +189,14 @@ func CopyTree(src, dst string) error {
 	return Default.CopyTree(src, dst)
 }
 
-func copyFile(src, dst string, srcInfo os.FileInfo) error {
+func (o Options) copyFile(src, dst string, srcInfo os.FileInfo) error {
 	m := srcInfo.Mode()
 	switch {
 	case m.IsDir():
 		return os.MkdirAll(dst, srcInfo.Mode().Perm())
 
 	case m.IsRegular():
-		return copyRegularFile(src, dst, srcInfo)
+		return o.copyRegularFile(src, dst, srcInfo)
 
 	case m&os.ModeSymlink == os.ModeSymlink:
 		// Yeah, this may not make any sense logically. But this is how
@@ -131,7 +216,7 @@ func copyFile(src, dst string, srcInfo os.FileInfo) error {
 	}
 }
 
-func copyRegularFile(src, dst string, srcfi os.FileInfo) error {
+func (o Options) copyRegularFile(src, dst string, srcfi os.FileInfo) error {
 	srcf, err := os.Open(src)
 	if err != nil {
 		return err
@@ -144,6 +229,81 @@ func copyRegularFile(src, dst string, srcfi os.FileInfo) error {
 	}
 	defer dstf.Close()
 
-	_, err = io.Copy(dstf, srcf)
+	size := srcfi.Size()
+	progress := func(done int64) {
+		if o.Progress != nil {
+			o.Progress(src, dst, done, size)
+		}
+	}
+	switch o.Strategy {
+	case ReadWrite:
+		err = readWrite(dstf, srcf, progress)
+	case Sparse:
+		err = copySparse(dstf, srcf, size, progress)
+	case Reflink:
+		if err = reflink(dstf, srcf); err == nil {
+			progress(size)
+		}
+	default:
+		if err = reflink(dstf, srcf); err == nil {
+			progress(size)
+		} else {
+			err = copySparse(dstf, srcf, size, progress)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	if o.Sync {
+		if err := dstf.Sync(); err != nil {
+			return err
+		}
+	}
+	return dstf.Close()
+}
+
+// progressWriter counts the bytes written for a progress callback.
+type progressWriter struct {
+	w        io.Writer
+	done     int64
+	progress func(done int64)
+}
+
+func (p *progressWriter) Write(b []byte) (int, error) {
+	n, err := p.w.Write(b)
+	p.done += int64(n)
+	p.progress(p.done)
+	return n, err
+}
+
+func readWrite(dst io.Writer, src io.Reader, progress func(int64)) error {
+	_, err := io.Copy(&progressWriter{w: dst, progress: progress}, src)
 	return err
 }
+
+func (o Options) preserve(dst string, fi os.FileInfo) error {
+	symlink := fi.Mode()&os.ModeSymlink != 0
+	if o.PreserveOwner {
+		if err := chown(dst, fi); err != nil {
+			return err
+		}
+	}
+	// Symlinks have no mode, and their times can't portably be set.
+	if symlink {
+		return nil
+	}
+	// Chown clears the setuid and setgid bits, so this comes after it.
+	if o.PreserveMode {
+		if err := os.Chmod(dst, fi.Mode()&(os.ModePerm|os.ModeSetuid|os.ModeSetgid|os.ModeSticky)); err != nil {
+			return err
+		}
+	}
+	if o.PreserveTimes {
+		return setTimes(dst, fi)
+	}
+	return nil
+}
+
+func setTimes(dst string, fi os.FileInfo) error {
+	return os.Chtimes(dst, atime(fi), fi.ModTime())
+}
@@ -0,0 +1,113 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cp
+
+import (
+	"io"
+	"os"
+	"syscall"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+// The whence values of lseek finding data and holes.
+const (
+	seekData = 3
+	seekHole = 4
+)
+
+func reflink(dst, src *os.File) error {
+	if err := unix.IoctlFileClone(int(dst.Fd()), int(src.Fd())); err != nil {
+		return &os.PathError{Op: "reflink", Path: dst.Name(), Err: err}
+	}
+	return nil
+}
+
+// copySparse copies the data regions of src found with SEEK_DATA and
+// SEEK_HOLE, which leaves holes in dst where src has them. Data is copied
+// until src ends, whatever size said, and dst is then extended over a hole
+// at the end of src.
+//
+// Files which say they are empty and files of pseudo file systems like
+// /proc and /sys, whose sizes and holes have nothing to do with what they
+// read, are read and written instead.
+func copySparse(dst, src *os.File, size int64, progress func(int64)) error {
+	if size == 0 || pseudo(src) {
+		return readWrite(dst, src, progress)
+	}
+	fd := int(src.Fd())
+	var off int64
+	for {
+		data, err := unix.Seek(fd, off, seekData)
+		if err == unix.ENXIO {
+			// Only a hole is left, if anything.
+			end, err := unix.Seek(fd, 0, io.SeekEnd)
+			if err != nil {
+				return err
+			}
+			if end > off {
+				if err := dst.Truncate(end); err != nil {
+					return err
+				}
+				off = end
+			}
+			break
+		}
+		if err != nil {
+			if off == 0 {
+				// SEEK_DATA is not supported by the file system.
+				if _, err := src.Seek(0, io.SeekStart); err != nil {
+					return err
+				}
+				return readWrite(dst, src, progress)
+			}
+			return err
+		}
+		hole, err := unix.Seek(fd, data, seekHole)
+		if err != nil {
+			return err
+		}
+		if _, err := dst.Seek(data, io.SeekStart); err != nil {
+			return err
+		}
+		pw := &progressWriter{w: dst, done: data, progress: progress}
+		n, err := io.Copy(pw, io.NewSectionReader(src, data, hole-data))
+		if err != nil {
+			return err
+		}
+		off = data + n
+		if off < hole {
+			// src ended, e.g. it was truncated while being copied.
+			break
+		}
+	}
+	progress(off)
+	return nil
+}
+
+// pseudo reports whether f is on a file system whose files are made up as
+// they are read.
+func pseudo(f *os.File) bool {
+	var st unix.Statfs_t
+	if err := unix.Fstatfs(int(f.Fd()), &st); err != nil {
+		return false
+	}
+	switch uint32(st.Type) {
+	case unix.PROC_SUPER_MAGIC, unix.SYSFS_MAGIC, unix.DEBUGFS_MAGIC,
+		unix.TRACEFS_MAGIC, unix.SECURITYFS_MAGIC, unix.CGROUP_SUPER_MAGIC,
+		unix.CGROUP2_SUPER_MAGIC, unix.EFIVARFS_MAGIC, unix.PSTOREFS_MAGIC,
+		unix.BPF_FS_MAGIC:
+		return true
+	}
+	return false
+}
+
+func atime(fi os.FileInfo) time.Time {
+	if st, ok := fi.Sys().(*syscall.Stat_t); ok {
+		return time.Unix(int64(st.Atim.Sec), int64(st.Atim.Nsec))
+	}
+	return fi.ModTime()
+}
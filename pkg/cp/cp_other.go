@@ -0,0 +1,25 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build !linux
+
+package cp
+
+import (
+	"errors"
+	"os"
+	"time"
+)
+
+func reflink(dst, src *os.File) error {
+	return &os.PathError{Op: "reflink", Path: dst.Name(), Err: errors.New("not supported")}
+}
+
+func copySparse(dst, src *os.File, size int64, progress func(int64)) error {
+	return readWrite(dst, src, progress)
+}
+
+func atime(fi os.FileInfo) time.Time {
+	return fi.ModTime()
+}
@@ -0,0 +1,14 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cp
+
+import (
+	"errors"
+	"os"
+)
+
+func chown(dst string, fi os.FileInfo) error {
+	return &os.PathError{Op: "chown", Path: dst, Err: errors.New("not supported")}
+}
@@ -5,10 +5,12 @@
 package cp_test
 
 import (
+	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/u-root/u-root/pkg/cp"
 	"github.com/u-root/u-root/pkg/cp/cmp"
@@ -58,3 +60,146 @@ func TestSimpleCopy(t *testing.T) {
 	copyAndTest(t, cp.Default, origf, filepath.Join(tmpDir, "foobar-copied"))
 	copyAndTest(t, cp.NoFollowSymlinks, origf, filepath.Join(tmpDir, "foobar-copied-just-symlink"))
 }
+
+func TestStrategies(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "u-root-pkg-cp-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// A file with a 1 MiB hole before its data.
+	src := filepath.Join(tmpDir, "sparse")
+	f, err := os.Create(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.WriteAt([]byte("after the hole"), 1<<20); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, s := range []cp.Strategy{cp.Auto, cp.ReadWrite, cp.Sparse} {
+		var done, size int64
+		o := cp.Options{
+			Strategy: s,
+			Progress: func(_, _ string, d, sz int64) {
+				done, size = d, sz
+			},
+		}
+		dst := filepath.Join(tmpDir, fmt.Sprintf("copy-%d", s))
+		copyAndTest(t, o, src, dst)
+		if want := int64(1<<20 + len("after the hole")); done != want || size != want {
+			t.Errorf("Strategy %d: progress %d/%d, want %d/%d", s, done, size, want, want)
+		}
+	}
+}
+
+func TestTrailingHole(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "u-root-pkg-cp-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// A file ending with a 1 MiB hole.
+	src := filepath.Join(tmpDir, "sparse")
+	if err := ioutil.WriteFile(src, []byte("before the hole"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Truncate(src, 1<<20); err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []cp.Strategy{cp.Auto, cp.Sparse} {
+		copyAndTest(t, cp.Options{Strategy: s}, src, filepath.Join(tmpDir, fmt.Sprintf("copy-%d", s)))
+	}
+}
+
+// TestPseudoFiles copies files whose sizes are not what they read: files
+// of /proc say they are empty, and files of /sys that they are a page.
+func TestPseudoFiles(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "u-root-pkg-cp-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	for _, src := range []string{"/proc/self/cmdline", "/sys/kernel/mm/transparent_hugepage/enabled"} {
+		want, err := ioutil.ReadFile(src)
+		if err != nil || len(want) == 0 {
+			t.Logf("Skipping %s: %v", src, err)
+			continue
+		}
+		for _, s := range []cp.Strategy{cp.Auto, cp.Sparse} {
+			dst := filepath.Join(tmpDir, fmt.Sprintf("%s-%d", filepath.Base(src), s))
+			if err := (cp.Options{Strategy: s}).Copy(src, dst); err != nil {
+				t.Errorf("Strategy %d: Copy(%q -> %q) = %v, want nil", s, src, dst, err)
+				continue
+			}
+			got, err := ioutil.ReadFile(dst)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != string(want) {
+				t.Errorf("Strategy %d: copy of %s is %q, want %q", s, src, got, want)
+			}
+		}
+	}
+}
+
+func TestArchive(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "u-root-pkg-cp-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	src := filepath.Join(tmpDir, "src")
+	if err := os.MkdirAll(filepath.Join(src, "dir"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Join(src, "dir", "file")
+	if err := ioutil.WriteFile(file, []byte("data"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chmod(file, 0751); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink("dir/file", filepath.Join(src, "link")); err != nil {
+		t.Fatal(err)
+	}
+	past := time.Date(2001, 2, 3, 4, 5, 6, 0, time.UTC)
+	for _, p := range []string{file, filepath.Join(src, "dir")} {
+		if err := os.Chtimes(p, past, past); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	o := cp.Archive
+	// Changing owners needs root.
+	o.PreserveOwner = os.Getuid() == 0
+	dst := filepath.Join(tmpDir, "dst")
+	if err := o.CopyTree(src, dst); err != nil {
+		t.Fatal(err)
+	}
+	if err := cmp.IsEqualTree(o, src, dst); err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range []string{"dir", "dir/file"} {
+		fi, err := os.Stat(filepath.Join(dst, p))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !fi.ModTime().Equal(past) {
+			t.Errorf("%s: modification time %v, want %v", p, fi.ModTime(), past)
+		}
+	}
+	if fi, err := os.Stat(filepath.Join(dst, "dir/file")); err != nil || fi.Mode().Perm() != 0751 {
+		t.Errorf("dir/file: mode %v, %v, want 0751", fi.Mode(), err)
+	}
+	if fi, err := os.Lstat(filepath.Join(dst, "link")); err != nil || fi.Mode()&os.ModeSymlink == 0 {
+		t.Errorf("link is not a symlink: %v", err)
+	}
+}
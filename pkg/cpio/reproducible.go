@@ -0,0 +1,150 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cpio
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// ReproducibleWriter is a RecordWriter writing the same archive for the same
+// set of files, whatever order they are written in and whenever they were
+// made.
+//
+// It keeps the records until the trailer or Flush, then writes them sorted by
+// name, made reproducible by MakeReproducible, and without duplicate names,
+// the first one written winning. Regular files with the same content and
+// mode become hard links to the first of them, so the content is only in the
+// archive once.
+type ReproducibleWriter struct {
+	rw      RecordWriter
+	records []Record
+	seen    map[string]struct{}
+}
+
+// NewReproducibleWriter returns a ReproducibleWriter writing to rw.
+func NewReproducibleWriter(rw RecordWriter) *ReproducibleWriter {
+	return &ReproducibleWriter{
+		rw:   rw,
+		seen: make(map[string]struct{}),
+	}
+}
+
+// WriteRecord implements RecordWriter.
+//
+// Records are only written by Flush, or when the trailer is written.
+func (w *ReproducibleWriter) WriteRecord(r Record) error {
+	if r.Name == Trailer {
+		if err := w.Flush(); err != nil {
+			return err
+		}
+		return w.rw.WriteRecord(r)
+	}
+	r = MakeReproducible(r)
+	if _, ok := w.seen[r.Name]; ok {
+		return nil
+	}
+	w.seen[r.Name] = struct{}{}
+	w.records = append(w.records, r)
+	return nil
+}
+
+// contentKey identifies the content and mode of a regular file.
+type contentKey struct {
+	sum  [sha256.Size]byte
+	size uint64
+	mode uint64
+}
+
+func keyOf(r Record) (contentKey, error) {
+	h := sha256.New()
+	if _, err := io.Copy(h, io.NewSectionReader(r.ReaderAt, 0, int64(r.FileSize))); err != nil {
+		return contentKey{}, fmt.Errorf("reading %q: %v", r.Name, err)
+	}
+	k := contentKey{size: r.FileSize, mode: r.Mode}
+	copy(k.sum[:], h.Sum(nil))
+	return k, nil
+}
+
+// Flush writes the records written so far.
+func (w *ReproducibleWriter) Flush() error {
+	records := w.records
+	w.records = nil
+	sort.SliceStable(records, func(i, j int) bool { return records[i].Name < records[j].Name })
+
+	// Group the non-empty regular files by content.
+	groups := make(map[contentKey][]int)
+	var keys []contentKey
+	for i, r := range records {
+		if r.Mode&S_IFMT != S_IFREG || r.FileSize == 0 {
+			continue
+		}
+		k, err := keyOf(r)
+		if err != nil {
+			return err
+		}
+		if _, ok := groups[k]; !ok {
+			keys = append(keys, k)
+		}
+		groups[k] = append(groups[k], i)
+	}
+
+	// The kernel links records with two or more links and the same inode
+	// number to the first of them, which has the content.
+	var ino uint64
+	for _, k := range keys {
+		g := groups[k]
+		if len(g) < 2 {
+			continue
+		}
+		ino++
+		for n, i := range g {
+			records[i].Ino = ino
+			records[i].NLink = uint64(len(g))
+			if n > 0 {
+				records[i].FileSize = 0
+				records[i].ReaderAt = bytes.NewReader(nil)
+			}
+		}
+	}
+
+	return WriteRecords(w.rw, records)
+}
+
+// linkKey identifies the inode of hard linked files in an archive.
+type linkKey struct {
+	ino, major, minor uint64
+}
+
+func isHardLink(r Record) bool {
+	return r.Mode&S_IFMT == S_IFREG && r.NLink > 1 && r.Ino != 0
+}
+
+// ResolveHardLinks gives hard linked regular files in records, of which only
+// one has the content in the archive, the content of that one. Archives of
+// ReproducibleWriter have it in the first. Inode numbers and link counts are
+// kept, but the records no longer need them, e.g. once they are made
+// reproducible.
+func ResolveHardLinks(records []Record) {
+	content := make(map[linkKey]Record)
+	for _, r := range records {
+		k := linkKey{r.Ino, r.Major, r.Minor}
+		if _, ok := content[k]; !ok && isHardLink(r) && r.FileSize > 0 {
+			content[k] = r
+		}
+	}
+	for i, r := range records {
+		if !isHardLink(r) || r.FileSize > 0 {
+			continue
+		}
+		if c, ok := content[linkKey{r.Ino, r.Major, r.Minor}]; ok {
+			records[i].FileSize = c.FileSize
+			records[i].ReaderAt = c.ReaderAt
+		}
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cpio
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+
+	"github.com/u-root/u-root/pkg/uio"
+)
+
+func TestReproducibleWriter(t *testing.T) {
+	archive := func(recs ...Record) []byte {
+		var b bytes.Buffer
+		w := NewReproducibleWriter(Newc.Writer(&b))
+		if err := WriteRecords(w, recs); err != nil {
+			t.Fatal(err)
+		}
+		if err := WriteTrailer(w); err != nil {
+			t.Fatal(err)
+		}
+		return b.Bytes()
+	}
+	a := StaticFile("bin/a", "same content", 0755)
+	a.MTime, a.Ino, a.UID = 1234, 42, 1000
+	b := StaticFile("bin/b", "same content", 0755)
+	c := StaticFile("bin/c", "other content", 0755)
+	d := StaticFile("bin/d", "same content", 0644)
+	dir := Directory("bin", 0755)
+
+	one := archive(c, b, dir, a, d, StaticFile("bin/a", "dropped", 0644))
+	two := archive(dir, a, b, c, d)
+	if !bytes.Equal(one, two) {
+		t.Errorf("archives of the same files in another order differ")
+	}
+
+	recs, err := ReadAllRecords(EOFReader{Newc.Reader(bytes.NewReader(one))})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, r := range recs {
+		names = append(names, r.Name)
+	}
+	if len(recs) != 5 || names[0] != "bin" || names[1] != "bin/a" || names[4] != "bin/d" {
+		t.Fatalf("records %q, want bin, bin/a, bin/b, bin/c, bin/d", names)
+	}
+	ra, rb, rc, rd := recs[1], recs[2], recs[3], recs[4]
+	if ra.MTime != 0 || ra.UID != 0 {
+		t.Errorf("bin/a was not made reproducible: %v", ra.Info)
+	}
+	if ra.FileSize != 12 || rb.FileSize != 0 || ra.Ino == 0 || ra.Ino != rb.Ino || ra.NLink != 2 || rb.NLink != 2 {
+		t.Errorf("bin/a and bin/b are not hard links: %v, %v", ra.Info, rb.Info)
+	}
+	if rc.Ino != 0 || rc.FileSize != 13 || rd.Ino != 0 || rd.FileSize != 12 {
+		t.Errorf("bin/c or bin/d are hard links: %v, %v", rc.Info, rd.Info)
+	}
+}
+
+func TestResolveHardLinks(t *testing.T) {
+	link := func(name, content string, ino uint64) Record {
+		r := StaticFile(name, content, 0644)
+		r.Ino, r.NLink = ino, 2
+		return r
+	}
+	recs := []Record{
+		// The content is in the first link, as ReproducibleWriter
+		// writes them, or in the last, as GNU cpio does.
+		link("a", "first", 1),
+		link("b", "", 1),
+		link("c", "", 2),
+		link("d", "last", 2),
+		// A link whose content is not in the archive stays empty.
+		link("e", "", 3),
+		StaticFile("f", "", 0644),
+	}
+	ResolveHardLinks(recs)
+	for i, want := range []string{"first", "first", "last", "last", "", ""} {
+		b, err := ioutil.ReadAll(uio.Reader(recs[i]))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != want || recs[i].FileSize != uint64(len(want)) {
+			t.Errorf("%s has %d bytes %q, want %q", recs[i].Name, recs[i].FileSize, b, want)
+		}
+	}
+}
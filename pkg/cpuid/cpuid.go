@@ -0,0 +1,123 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package cpuid reads and decodes the leaves of the x86 CPUID instruction.
+package cpuid
+
+import (
+	"encoding/binary"
+	"strings"
+)
+
+// Leaf ranges.
+const (
+	Standard   = 0x00000000
+	Hypervisor = 0x40000000
+	Extended   = 0x80000000
+
+	// maxLeaves bounds each range, in case a CPU or hypervisor reports
+	// a nonsensical maximum.
+	maxLeaves = 0x100
+)
+
+// Regs are the output registers of one CPUID invocation.
+type Regs struct {
+	EAX uint32 `json:"eax"`
+	EBX uint32 `json:"ebx"`
+	ECX uint32 `json:"ecx"`
+	EDX uint32 `json:"edx"`
+}
+
+// Leaf is the result of CPUID for a leaf and subleaf.
+type Leaf struct {
+	Leaf    uint32 `json:"leaf"`
+	Subleaf uint32 `json:"subleaf"`
+	Regs
+}
+
+// Func executes CPUID with EAX=leaf and ECX=subleaf.
+type Func func(leaf, subleaf uint32) Regs
+
+// Native executes the CPUID instruction on the current CPU. It is nil on
+// CPUs which do not implement CPUID.
+var Native Func
+
+// bits returns bits lo to hi, inclusive, of v.
+func bits(v uint32, lo, hi uint) uint32 {
+	return (v >> lo) & (1<<(hi-lo+1) - 1)
+}
+
+func regString(regs ...uint32) string {
+	b := make([]byte, 4*len(regs))
+	for i, r := range regs {
+		binary.LittleEndian.PutUint32(b[4*i:], r)
+	}
+	return strings.TrimRight(string(b), "\x00")
+}
+
+// subleaves returns the subleaves of leaf l to dump.
+func subleaves(f Func, l uint32, r Regs) []Leaf {
+	leaves := []Leaf{{Leaf: l, Regs: r}}
+	more := func(max uint32, stop func(Regs) bool) {
+		for s := uint32(1); s <= max && s < maxLeaves; s++ {
+			r := f(l, s)
+			if stop != nil && stop(r) {
+				break
+			}
+			leaves = append(leaves, Leaf{Leaf: l, Subleaf: s, Regs: r})
+		}
+	}
+	switch l {
+	case 0x4, 0x8000001d:
+		// Deterministic cache parameters end with a null cache type.
+		if bits(r.EAX, 0, 4) != 0 {
+			more(maxLeaves, func(r Regs) bool { return bits(r.EAX, 0, 4) == 0 })
+		}
+	case 0x7, 0x14, 0x17, 0x18:
+		// EAX of subleaf 0 is the last subleaf.
+		more(r.EAX, nil)
+	case 0xb, 0x1f:
+		// Topology levels end with a level type of 0.
+		more(maxLeaves, func(r Regs) bool { return bits(r.ECX, 8, 15) == 0 })
+	case 0xd:
+		// Subleaf 1 is always valid; the others are the XSAVE state
+		// components, some of which are not supported.
+		for s := uint32(1); s < 64; s++ {
+			r := f(l, s)
+			if s == 1 || r != (Regs{}) {
+				leaves = append(leaves, Leaf{Leaf: l, Subleaf: s, Regs: r})
+			}
+		}
+	case 0xf, 0x10:
+		more(3, nil)
+	}
+	return leaves
+}
+
+func dumpRange(f Func, base uint32) []Leaf {
+	r := f(base, 0)
+	last := r.EAX
+	if last < base || last-base >= maxLeaves {
+		if base != Standard {
+			return nil
+		}
+		last = base + maxLeaves - 1
+	}
+	leaves := []Leaf{{Leaf: base, Regs: r}}
+	for l := base + 1; l <= last; l++ {
+		leaves = append(leaves, subleaves(f, l, f(l, 0))...)
+	}
+	return leaves
+}
+
+// Dump returns all the leaves and subleaves the CPU reports: the standard
+// leaves, the hypervisor leaves if running under a hypervisor, and the
+// extended leaves.
+func Dump(f Func) []Leaf {
+	leaves := dumpRange(f, Standard)
+	if bits(f(1, 0).ECX, 31, 31) != 0 {
+		leaves = append(leaves, dumpRange(f, Hypervisor)...)
+	}
+	return append(leaves, dumpRange(f, Extended)...)
+}
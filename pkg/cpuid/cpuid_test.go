@@ -0,0 +1,102 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cpuid
+
+import (
+	"reflect"
+	"testing"
+)
+
+type fake map[[2]uint32]Regs
+
+func (f fake) cpuid(leaf, subleaf uint32) Regs {
+	return f[[2]uint32{leaf, subleaf}]
+}
+
+// skylake is a Skylake client running under KVM, trimmed to the leaves
+// the decoder looks at.
+var skylake = fake{
+	{0, 0}:          {EAX: 0xd, EBX: 0x756e6547, ECX: 0x6c65746e, EDX: 0x49656e69},
+	{1, 0}:          {EAX: 0x000506e3, EBX: 0x03100800, ECX: 0x80000201, EDX: 0x00000011},
+	{4, 0}:          {EAX: 0x1c004121, EBX: 0x01c0003f, ECX: 0x0000003f},
+	{4, 1}:          {EAX: 0x1c004143, EBX: 0x03c0003f, ECX: 0x00001fff},
+	{7, 0}:          {EBX: 0x00000020},
+	{0xb, 0}:        {EAX: 1, EBX: 2, ECX: 0x100},
+	{0xb, 1}:        {EAX: 4, EBX: 8, ECX: 0x201},
+	{0x40000000, 0}: {EAX: 0x40000001, EBX: 0x4b4d564b, ECX: 0x564b4d56, EDX: 0x4d},
+	{0x80000000, 0}: {EAX: 0x80000008},
+	{0x80000001, 0}: {ECX: 0x1, EDX: 0x20100800},
+	{0x80000002, 0}: {EAX: 0x65746e49, EBX: 0x2952286c, ECX: 0x726f4320, EDX: 0x4d542865},
+	{0x80000003, 0}: {EAX: 0x37692029, EBX: 0x3036362d, ECX: 0x43205530, EDX: 0x40205550},
+	{0x80000004, 0}: {EAX: 0x362e3220, EBX: 0x7a484730, ECX: 0, EDX: 0},
+	{0x80000008, 0}: {EAX: 0x00003027},
+}
+
+func TestDecode(t *testing.T) {
+	i := Decode(skylake.cpuid)
+	if i.Vendor != "GenuineIntel" {
+		t.Errorf("Vendor = %q", i.Vendor)
+	}
+	if i.Brand != "Intel(R) Core(TM) i7-6600U CPU @ 2.60GHz" {
+		t.Errorf("Brand = %q", i.Brand)
+	}
+	if i.Family != 6 || i.Model != 0x5e || i.Stepping != 3 {
+		t.Errorf("family/model/stepping = %d/%#x/%d, want 6/0x5e/3", i.Family, i.Model, i.Stepping)
+	}
+	if i.APICID != 3 {
+		t.Errorf("APICID = %d, want 3", i.APICID)
+	}
+	if i.PhysBits != 39 || i.VirtBits != 48 {
+		t.Errorf("address bits = %d/%d, want 39/48", i.PhysBits, i.VirtBits)
+	}
+	want := []string{"avx2", "fpu", "hypervisor", "lahf_lm", "lm", "nx", "pni", "ssse3", "syscall", "tsc"}
+	if !reflect.DeepEqual(i.Features, want) {
+		t.Errorf("Features = %v, want %v", i.Features, want)
+	}
+	if !i.Has("avx2") || i.Has("avx512f") {
+		t.Errorf("Has(avx2) = %v, Has(avx512f) = %v", i.Has("avx2"), i.Has("avx512f"))
+	}
+	if i.Hypervisor == nil || i.Hypervisor.Vendor != "KVMKVMKVM" {
+		t.Errorf("Hypervisor = %+v, want KVM", i.Hypervisor)
+	}
+	wantCaches := []Cache{
+		{Level: 1, Type: "data", Size: 32 << 10, Ways: 8, LineSize: 64, Sets: 64, SharedByCPUs: 2},
+		{Level: 2, Type: "unified", Size: 8 << 20, Ways: 16, LineSize: 64, Sets: 8192, SharedByCPUs: 2},
+	}
+	if !reflect.DeepEqual(i.Caches, wantCaches) {
+		t.Errorf("Caches = %+v, want %+v", i.Caches, wantCaches)
+	}
+}
+
+func TestDump(t *testing.T) {
+	leaves := Dump(skylake.cpuid)
+	var got [][2]uint32
+	for _, l := range leaves {
+		if l.Leaf == 4 || l.Leaf == 0xb || l.Leaf >= Hypervisor {
+			got = append(got, [2]uint32{l.Leaf, l.Subleaf})
+		}
+	}
+	want := [][2]uint32{
+		{4, 0}, {4, 1}, {0xb, 0}, {0xb, 1},
+		{0x40000000, 0}, {0x40000001, 0},
+		{0x80000000, 0}, {0x80000001, 0}, {0x80000002, 0}, {0x80000003, 0},
+		{0x80000004, 0}, {0x80000005, 0}, {0x80000006, 0}, {0x80000007, 0}, {0x80000008, 0},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Dump leaves = %x, want %x", got, want)
+	}
+	if len(leaves) < 16 || leaves[0].Leaf != 0 || leaves[15].Leaf != 0xd {
+		t.Errorf("Dump standard leaves wrong: %+v", leaves[:16])
+	}
+}
+
+func TestNative(t *testing.T) {
+	if Native == nil {
+		t.Skip("no CPUID on this architecture")
+	}
+	if i := Decode(Native); i.Vendor == "" {
+		t.Errorf("Decode(Native) has no vendor")
+	}
+}
@@ -0,0 +1,16 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build 386 amd64
+
+package cpuid
+
+func native(leaf, subleaf uint32) (eax, ebx, ecx, edx uint32)
+
+func init() {
+	Native = func(leaf, subleaf uint32) Regs {
+		a, b, c, d := native(leaf, subleaf)
+		return Regs{EAX: a, EBX: b, ECX: c, EDX: d}
+	}
+}
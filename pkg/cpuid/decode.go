@@ -0,0 +1,221 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cpuid
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Cache describes a cache from the deterministic cache parameter leaves.
+type Cache struct {
+	Level        uint32 `json:"level"`
+	Type         string `json:"type"`
+	Size         uint32 `json:"size"`
+	Ways         uint32 `json:"ways"`
+	LineSize     uint32 `json:"line_size"`
+	Sets         uint32 `json:"sets"`
+	SharedByCPUs uint32 `json:"shared_by_cpus"`
+}
+
+// String formats c like "L1d: 32 KiB, 8-way, 64 byte lines".
+func (c Cache) String() string {
+	t := map[string]string{"data": "d", "instruction": "i"}[c.Type]
+	return fmt.Sprintf("L%d%s: %d KiB, %d-way, %d byte lines, shared by %d CPUs", c.Level, t, c.Size/1024, c.Ways, c.LineSize, c.SharedByCPUs)
+}
+
+// HypervisorInfo holds the hypervisor leaves.
+type HypervisorInfo struct {
+	Vendor  string `json:"vendor"`
+	MaxLeaf uint32 `json:"max_leaf"`
+}
+
+// Info is the decoded CPUID information of a CPU.
+type Info struct {
+	Vendor     string          `json:"vendor"`
+	Brand      string          `json:"brand,omitempty"`
+	Family     uint32          `json:"family"`
+	Model      uint32          `json:"model"`
+	Stepping   uint32          `json:"stepping"`
+	APICID     uint32          `json:"apic_id"`
+	MaxLeaf    uint32          `json:"max_leaf"`
+	MaxExtLeaf uint32          `json:"max_extended_leaf"`
+	PhysBits   uint32          `json:"physical_address_bits,omitempty"`
+	VirtBits   uint32          `json:"virtual_address_bits,omitempty"`
+	Features   []string        `json:"features"`
+	Caches     []Cache         `json:"caches,omitempty"`
+	Hypervisor *HypervisorInfo `json:"hypervisor,omitempty"`
+}
+
+// Feature flags, by register bit, named as in /proc/cpuinfo.
+var (
+	leaf1EDX = map[uint]string{
+		0: "fpu", 1: "vme", 2: "de", 3: "pse", 4: "tsc", 5: "msr", 6: "pae", 7: "mce",
+		8: "cx8", 9: "apic", 11: "sep", 12: "mtrr", 13: "pge", 14: "mca", 15: "cmov",
+		16: "pat", 17: "pse36", 18: "pn", 19: "clflush", 21: "dts", 22: "acpi", 23: "mmx",
+		24: "fxsr", 25: "sse", 26: "sse2", 27: "ss", 28: "ht", 29: "tm", 30: "ia64", 31: "pbe",
+	}
+	leaf1ECX = map[uint]string{
+		0: "pni", 1: "pclmulqdq", 2: "dtes64", 3: "monitor", 4: "ds_cpl", 5: "vmx", 6: "smx",
+		7: "est", 8: "tm2", 9: "ssse3", 10: "cid", 11: "sdbg", 12: "fma", 13: "cx16",
+		14: "xtpr", 15: "pdcm", 17: "pcid", 18: "dca", 19: "sse4_1", 20: "sse4_2",
+		21: "x2apic", 22: "movbe", 23: "popcnt", 24: "tsc_deadline_timer", 25: "aes",
+		26: "xsave", 27: "osxsave", 28: "avx", 29: "f16c", 30: "rdrand", 31: "hypervisor",
+	}
+	leaf7EBX = map[uint]string{
+		0: "fsgsbase", 1: "tsc_adjust", 2: "sgx", 3: "bmi1", 4: "hle", 5: "avx2", 7: "smep",
+		8: "bmi2", 9: "erms", 10: "invpcid", 11: "rtm", 12: "cqm", 14: "mpx", 15: "rdt_a",
+		16: "avx512f", 17: "avx512dq", 18: "rdseed", 19: "adx", 20: "smap", 21: "avx512ifma",
+		23: "clflushopt", 24: "clwb", 25: "intel_pt", 26: "avx512pf", 27: "avx512er",
+		28: "avx512cd", 29: "sha_ni", 30: "avx512bw", 31: "avx512vl",
+	}
+	leaf7ECX = map[uint]string{
+		1: "avx512vbmi", 2: "umip", 3: "pku", 4: "ospke", 5: "waitpkg", 6: "avx512_vbmi2",
+		8: "gfni", 9: "vaes", 10: "vpclmulqdq", 11: "avx512_vnni", 12: "avx512_bitalg",
+		13: "tme", 14: "avx512_vpopcntdq", 16: "la57", 22: "rdpid", 25: "cldemote",
+		27: "movdiri", 28: "movdir64b", 30: "sgx_lc",
+	}
+	leaf7EDX = map[uint]string{
+		2: "avx512_4vnniw", 3: "avx512_4fmaps", 4: "fsrm", 8: "avx512_vp2intersect",
+		10: "md_clear", 14: "serialize", 16: "tsxldtrk", 18: "pconfig", 20: "ibt",
+		26: "spec_ctrl", 27: "intel_stibp", 28: "flush_l1d", 29: "arch_capabilities",
+		30: "core_capabilities", 31: "spec_ctrl_ssbd",
+	}
+	ext1EDX = map[uint]string{
+		11: "syscall", 20: "nx", 22: "mmxext", 25: "fxsr_opt", 26: "pdpe1gb", 27: "rdtscp",
+		29: "lm", 30: "3dnowext", 31: "3dnow",
+	}
+	ext1ECX = map[uint]string{
+		0: "lahf_lm", 1: "cmp_legacy", 2: "svm", 3: "extapic", 4: "cr8_legacy", 5: "abm",
+		6: "sse4a", 7: "misalignsse", 8: "3dnowprefetch", 9: "osvw", 10: "ibs", 11: "xop",
+		12: "skinit", 13: "wdt", 15: "lwp", 16: "fma4", 17: "tce", 19: "nodeid_msr",
+		21: "tbm", 22: "topoext", 23: "perfctr_core", 24: "perfctr_nb", 26: "bpext",
+		27: "ptsc", 28: "perfctr_llc", 29: "mwaitx",
+	}
+)
+
+func flags(v uint32, names map[uint]string) []string {
+	var f []string
+	for b, n := range names {
+		if v&(1<<b) != 0 {
+			f = append(f, n)
+		}
+	}
+	return f
+}
+
+var cacheTypes = []string{"", "data", "instruction", "unified"}
+
+// caches decodes the deterministic cache parameters of leaf l, leaf 4 on
+// Intel and 0x8000001d on AMD.
+func caches(f Func, l uint32) []Cache {
+	var cs []Cache
+	for s := uint32(0); s < maxLeaves; s++ {
+		r := f(l, s)
+		t := bits(r.EAX, 0, 4)
+		if t == 0 {
+			break
+		}
+		c := Cache{
+			Level:        bits(r.EAX, 5, 7),
+			Type:         "unknown",
+			Ways:         bits(r.EBX, 22, 31) + 1,
+			LineSize:     bits(r.EBX, 0, 11) + 1,
+			Sets:         r.ECX + 1,
+			SharedByCPUs: bits(r.EAX, 14, 25) + 1,
+		}
+		if int(t) < len(cacheTypes) {
+			c.Type = cacheTypes[t]
+		}
+		c.Size = c.Ways * (bits(r.EBX, 12, 21) + 1) * c.LineSize * c.Sets
+		cs = append(cs, c)
+	}
+	return cs
+}
+
+// Decode decodes the vendor, signature, features, caches and hypervisor
+// of a CPU.
+func Decode(f Func) *Info {
+	r := f(0, 0)
+	i := &Info{
+		MaxLeaf: r.EAX,
+		Vendor:  regString(r.EBX, r.EDX, r.ECX),
+	}
+	var fl []string
+	if i.MaxLeaf >= 1 {
+		r := f(1, 0)
+		family, model := bits(r.EAX, 8, 11), bits(r.EAX, 4, 7)
+		i.Stepping = bits(r.EAX, 0, 3)
+		i.Family, i.Model = family, model
+		if family == 0xf {
+			i.Family += bits(r.EAX, 20, 27)
+		}
+		if family == 0x6 || family == 0xf {
+			i.Model += bits(r.EAX, 16, 19) << 4
+		}
+		i.APICID = bits(r.EBX, 24, 31)
+		fl = append(fl, flags(r.EDX, leaf1EDX)...)
+		fl = append(fl, flags(r.ECX, leaf1ECX)...)
+		if r.ECX&(1<<31) != 0 {
+			h := f(Hypervisor, 0)
+			i.Hypervisor = &HypervisorInfo{
+				Vendor:  regString(h.EBX, h.ECX, h.EDX),
+				MaxLeaf: h.EAX,
+			}
+		}
+	}
+	if i.MaxLeaf >= 7 {
+		r := f(7, 0)
+		fl = append(fl, flags(r.EBX, leaf7EBX)...)
+		fl = append(fl, flags(r.ECX, leaf7ECX)...)
+		fl = append(fl, flags(r.EDX, leaf7EDX)...)
+	}
+	if i.MaxLeaf >= 4 && i.Vendor == "GenuineIntel" {
+		i.Caches = caches(f, 4)
+	}
+
+	i.MaxExtLeaf = f(Extended, 0).EAX
+	if i.MaxExtLeaf < Extended || i.MaxExtLeaf-Extended >= maxLeaves {
+		i.MaxExtLeaf = 0
+	}
+	if i.MaxExtLeaf >= 0x80000001 {
+		r := f(0x80000001, 0)
+		fl = append(fl, flags(r.EDX, ext1EDX)...)
+		fl = append(fl, flags(r.ECX, ext1ECX)...)
+		if i.Caches == nil && r.ECX&(1<<22) != 0 && i.MaxExtLeaf >= 0x8000001d {
+			i.Caches = caches(f, 0x8000001d)
+		}
+	}
+	if i.MaxExtLeaf >= 0x80000004 {
+		var regs []uint32
+		for l := uint32(0x80000002); l <= 0x80000004; l++ {
+			r := f(l, 0)
+			regs = append(regs, r.EAX, r.EBX, r.ECX, r.EDX)
+		}
+		i.Brand = strings.TrimSpace(regString(regs...))
+	}
+	if i.MaxExtLeaf >= 0x80000008 {
+		r := f(0x80000008, 0)
+		i.PhysBits, i.VirtBits = bits(r.EAX, 0, 7), bits(r.EAX, 8, 15)
+	}
+
+	// Features may be reported by both Intel and AMD leaves.
+	seen := map[string]bool{}
+	for _, n := range fl {
+		if !seen[n] {
+			seen[n] = true
+			i.Features = append(i.Features, n)
+		}
+	}
+	sort.Strings(i.Features)
+	return i
+}
+
+// Has returns whether the CPU has the named feature.
+func (i *Info) Has(feature string) bool {
+	n := sort.SearchStrings(i.Features, feature)
+	return n < len(i.Features) && i.Features[n] == feature
+}
@@ -0,0 +1,159 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package curl
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/url"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/u-root/u-root/pkg/progress"
+	"github.com/u-root/u-root/pkg/uio"
+)
+
+// Stream is the contents of a fetched file, from an offset, as they are
+// received.
+type Stream struct {
+	io.ReadCloser
+
+	// Offset is the offset in the file of the first byte of the stream.
+	// It may be less than the one asked for.
+	Offset int64
+
+	// Size is the size of the file, or -1 if it is unknown.
+	Size int64
+}
+
+// StreamFetcher is a FileScheme which can fetch the contents of files from
+// an offset, without buffering them, e.g. to resume downloads.
+type StreamFetcher interface {
+	FetchStream(ctx context.Context, u *url.URL, off int64) (*Stream, error)
+}
+
+// readCloser reads its Reader and closes its Closer.
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// FetchStream fetches the file with the given `u` from offset off, as it
+// is received if the FileScheme of `u.Scheme` is a StreamFetcher.
+// Otherwise, the stream is that of Fetch, from offset 0.
+func (s Schemes) FetchStream(ctx context.Context, u *url.URL, off int64) (*Stream, error) {
+	fg, ok := s[u.Scheme]
+	if !ok {
+		return nil, &URLError{URL: u, Err: ErrNoSuchScheme}
+	}
+	if sf, ok := fg.(StreamFetcher); ok {
+		st, err := sf.FetchStream(ctx, u, off)
+		if err != nil {
+			return nil, &URLError{URL: u, Err: err}
+		}
+		return st, nil
+	}
+	r, err := fg.Fetch(ctx, u)
+	if err != nil {
+		return nil, &URLError{URL: u, Err: err}
+	}
+	rc := readCloser{uio.Reader(r), ioutil.NopCloser(nil)}
+	if c, ok := r.(io.Closer); ok {
+		rc.Closer = c
+	}
+	return &Stream{ReadCloser: rc, Size: -1}, nil
+}
+
+// DownloadOptions are the options of Schemes.Download.
+type DownloadOptions struct {
+	// BackOff determines how often to retry failed downloads and how long
+	// to wait in between. Each retry continues where the last one
+	// stopped. If it is nil, downloads are not retried.
+	BackOff backoff.BackOff
+
+	// DoRetry returns true if a failed download shall be retried. If it
+	// is nil, all are retried while BackOff agrees.
+	DoRetry DoRetry
+
+	// Progress is where the progress of downloads is reported, unless it
+	// is nil. ProgressName names the file in reports, instead of its URL.
+	Progress     io.Writer
+	ProgressName string
+}
+
+// Download writes the file with the given `u` to w, which has its first
+// off bytes already, e.g. from an interrupted download. It returns the
+// size of the file, as written when it fails.
+//
+// Only the rest of the file is fetched if the FileScheme of `u.Scheme`
+// is a StreamFetcher. Otherwise, and from servers which do not support
+// it, all of the file is fetched and the first off bytes skipped.
+func (s Schemes) Download(ctx context.Context, u *url.URL, w io.Writer, off int64, o DownloadOptions) (int64, error) {
+	var m *progress.Meter
+	defer func() {
+		if m != nil {
+			m.Stop()
+		}
+	}()
+	back := backoff.BackOff(&backoff.StopBackOff{})
+	if o.BackOff != nil {
+		o.BackOff.Reset()
+		back = o.BackOff
+	}
+	back = backoff.WithContext(back, ctx)
+	for {
+		st, err := s.FetchStream(ctx, u, off)
+		if err == nil {
+			if m == nil && o.Progress != nil {
+				name := o.ProgressName
+				if name == "" {
+					name = u.String()
+				}
+				m = progress.New(o.Progress, name, st.Size)
+				m.Set(off)
+				m.Start()
+			}
+			var n int64
+			n, err = download(st, w, off, m)
+			off += n
+		}
+		if err == nil {
+			return off, nil
+		}
+		if o.DoRetry != nil && !o.DoRetry(u, err) {
+			return off, err
+		}
+		d := back.NextBackOff()
+		if d == backoff.Stop {
+			return off, err
+		}
+		log.Printf("Error: Getting %v: %v; retrying in %v", u, err, d.Round(time.Millisecond))
+		time.Sleep(d)
+	}
+}
+
+// download writes the stream st to w from offset off, and returns the
+// number of bytes written.
+func download(st *Stream, w io.Writer, off int64, m *progress.Meter) (int64, error) {
+	defer st.Close()
+	// The stream may start before off.
+	if _, err := io.CopyN(ioutil.Discard, st, off-st.Offset); err != nil {
+		return 0, err
+	}
+	if m != nil {
+		w = m.Writer(w)
+	}
+	n, err := io.Copy(w, st)
+	if err != nil {
+		return n, err
+	}
+	if st.Size >= 0 && off+n != st.Size {
+		return n, fmt.Errorf("got %d bytes, want %d: %w", off+n, st.Size, io.ErrUnexpectedEOF)
+	}
+	return n, nil
+}
@@ -0,0 +1,91 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package curl
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+)
+
+const kernel = "a kernel which takes a while to download"
+
+func TestDownload(t *testing.T) {
+	var ranges []string
+	var notFound int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/404" {
+			notFound++
+			http.NotFound(w, r)
+			return
+		}
+		ranges = append(ranges, r.Header.Get("Range"))
+		if len(ranges) == 1 {
+			// Break the connection halfway.
+			w.Header().Set("Content-Length", "40")
+			w.Write([]byte(kernel[:10]))
+			return
+		}
+		http.ServeContent(w, r, "", time.Time{}, strings.NewReader(kernel))
+	}))
+	defer ts.Close()
+	u, err := url.Parse(ts.URL + "/kernel")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := Schemes{"http": DefaultHTTPClient}
+
+	// Without retries, the broken download fails.
+	var b bytes.Buffer
+	n, err := s.Download(context.Background(), u, &b, 0, DownloadOptions{})
+	if err == nil || n != 10 {
+		t.Fatalf("Download(%s) = %d, %v, want 10 and an error", u, n, err)
+	}
+	// It continues where it stopped.
+	n, err = s.Download(context.Background(), u, &b, n, DownloadOptions{})
+	if err != nil || n != int64(len(kernel)) || b.String() != kernel {
+		t.Errorf("Download(%s) = %d, %v, %q, want %d, nil, %q", u, n, err, b.String(), len(kernel), kernel)
+	}
+	if want := []string{"", "bytes=10-"}; strings.Join(ranges, ",") != strings.Join(want, ",") {
+		t.Errorf("Range headers = %q, want %q", ranges, want)
+	}
+
+	// With retries, it continues by itself.
+	ranges, b = nil, bytes.Buffer{}
+	o := DownloadOptions{BackOff: backoff.WithMaxRetries(&backoff.ZeroBackOff{}, 3)}
+	if n, err := s.Download(context.Background(), u, &b, 0, o); err != nil || n != int64(len(kernel)) || b.String() != kernel {
+		t.Errorf("Download(%s) = %d, %v, %q, want %d, nil, %q", u, n, err, b.String(), len(kernel), kernel)
+	}
+
+	// A complete file is not fetched again.
+	if n, err := s.Download(context.Background(), u, &b, int64(len(kernel)), DownloadOptions{}); err != nil || n != int64(len(kernel)) {
+		t.Errorf("Download(%s) of a complete file = %d, %v, want %d, nil", u, n, err, len(kernel))
+	}
+
+	// Errors which should not be retried are not.
+	o.DoRetry = RetryHTTP
+	if _, err := s.Download(context.Background(), &url.URL{Scheme: "http", Host: u.Host, Path: "/404"}, &b, 0, o); err == nil || notFound != 1 {
+		t.Errorf("Download of a missing file = %v after %d tries, want an error after 1", err, notFound)
+	}
+}
+
+func TestDownloadFetch(t *testing.T) {
+	// Schemes which are not StreamFetchers fetch all of the file.
+	fs := NewMockScheme("fooftp")
+	fs.Add("192.168.0.1", "/kernel", kernel)
+	u := &url.URL{Scheme: "fooftp", Host: "192.168.0.1", Path: "/kernel"}
+	b := bytes.NewBufferString(kernel[:10])
+	n, err := Schemes{"fooftp": fs}.Download(context.Background(), u, b, 10, DownloadOptions{})
+	if err != nil || n != int64(len(kernel)) || b.String() != kernel {
+		t.Errorf("Download(%s) = %d, %v, %q, want %d, nil, %q", u, n, err, b.String(), len(kernel), kernel)
+	}
+}
@@ -4,24 +4,30 @@
 
 // Package curl implements routines to fetch files given a URL.
 //
-// curl currently supports HTTP, TFTP, and local files.
+// curl currently supports HTTP, HTTPS, TFTP, and local files.
 package curl
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"log"
 	"net"
 	"net/http"
 	"net/url"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/cenkalti/backoff/v4"
+	"github.com/u-root/u-root/pkg/progress"
 	"github.com/u-root/u-root/pkg/uio"
 	"pack.ag/tftp"
 )
@@ -266,6 +272,55 @@ func (s *SchemeWithRetries) Fetch(ctx context.Context, u *url.URL) (io.ReaderAt,
 	return nil, err
 }
 
+// SchemeWithProgress wraps a FileScheme and reports the progress of reading
+// the fetched files to W.
+//
+// Files read within Delay are not reported, so configuration files don't
+// clutter the console while kernels and initramfses do.
+type SchemeWithProgress struct {
+	Scheme FileScheme
+	W      io.Writer
+	Delay  time.Duration
+}
+
+// Fetch implements FileScheme.Fetch.
+func (s *SchemeWithProgress) Fetch(ctx context.Context, u *url.URL) (io.ReaderAt, error) {
+	r, err := s.Scheme.Fetch(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	m := progress.New(s.W, u.String(), 0)
+	m.Delay = s.Delay
+	return &progressReaderAt{r: m.ReaderAt(r), m: m}, nil
+}
+
+// progressReaderAt starts its Meter on the first read, and stops it at EOF.
+type progressReaderAt struct {
+	r     io.ReaderAt
+	m     *progress.Meter
+	start sync.Once
+	stop  sync.Once
+}
+
+func (p *progressReaderAt) ReadAt(b []byte, off int64) (int, error) {
+	p.start.Do(p.m.Start)
+	n, err := p.r.ReadAt(b, off)
+	if err == io.EOF {
+		p.stop.Do(p.m.Stop)
+	}
+	return n, err
+}
+
+// WithProgress returns the schemes of s wrapped to report the progress of
+// reading fetched files taking longer than a second to w.
+func (s Schemes) WithProgress(w io.Writer) Schemes {
+	ps := make(Schemes, len(s))
+	for name, fs := range s {
+		ps[name] = &SchemeWithProgress{Scheme: fs, W: w, Delay: time.Second}
+	}
+	return ps
+}
+
 // HTTPClientCodeError is returned by HTTPClient.Fetch when the server replies
 // with a non-200 code.
 type HTTPClientCodeError struct {
@@ -295,6 +350,52 @@ func NewHTTPClient(c *http.Client) *HTTPClient {
 	}
 }
 
+// NewHTTPSClient returns a new HTTPS FileScheme trusting only the
+// certificates of roots, or those of the system if roots is nil.
+func NewHTTPSClient(roots *x509.CertPool) *HTTPClient {
+	return NewHTTPClientWithOptions(HTTPOptions{RootCAs: roots})
+}
+
+// HTTPOptions are the options of NewHTTPClientWithOptions.
+type HTTPOptions struct {
+	// RootCAs are the certificates trusted for HTTPS, or those of the
+	// system if it is nil.
+	RootCAs *x509.CertPool
+
+	// Insecure skips verifying the certificates of HTTPS servers.
+	Insecure bool
+
+	// NoProxy ignores the proxies of the HTTP_PROXY, HTTPS_PROXY and
+	// NO_PROXY environment variables, which are used otherwise.
+	NoProxy bool
+}
+
+// NewHTTPClientWithOptions returns a new HTTP and HTTPS FileScheme with
+// the options of o.
+func NewHTTPClientWithOptions(o HTTPOptions) *HTTPClient {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	t.TLSClientConfig = &tls.Config{RootCAs: o.RootCAs, InsecureSkipVerify: o.Insecure}
+	if o.NoProxy {
+		t.Proxy = nil
+	}
+	return NewHTTPClient(&http.Client{Transport: t})
+}
+
+// CertPool returns a pool of the PEM encoded certificates of files.
+func CertPool(files ...string) (*x509.CertPool, error) {
+	p := x509.NewCertPool()
+	for _, f := range files {
+		b, err := ioutil.ReadFile(f)
+		if err != nil {
+			return nil, err
+		}
+		if !p.AppendCertsFromPEM(b) {
+			return nil, fmt.Errorf("no PEM certificate in %s", f)
+		}
+	}
+	return p, nil
+}
+
 // Fetch implements FileScheme.Fetch.
 func (h HTTPClient) Fetch(ctx context.Context, u *url.URL) (io.ReaderAt, error) {
 	req, err := http.NewRequestWithContext(ctx, "GET", u.String(), nil)
@@ -312,6 +413,48 @@ func (h HTTPClient) Fetch(ctx context.Context, u *url.URL) (io.ReaderAt, error)
 	return uio.NewCachingReader(resp.Body), nil
 }
 
+// FetchStream implements StreamFetcher.FetchStream with a Range request,
+// which servers may ignore and send all of the file.
+func (h HTTPClient) FetchStream(ctx context.Context, u *url.URL, off int64) (*Stream, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if off > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", off))
+	}
+	resp, err := h.c.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return &Stream{ReadCloser: resp.Body, Size: resp.ContentLength}, nil
+	case http.StatusPartialContent:
+		var start, end int64
+		var total string
+		if _, err := fmt.Sscanf(resp.Header.Get("Content-Range"), "bytes %d-%d/%s", &start, &end, &total); err != nil || start > off {
+			resp.Body.Close()
+			return nil, fmt.Errorf("invalid Content-Range %q for offset %d", resp.Header.Get("Content-Range"), off)
+		}
+		size, err := strconv.ParseInt(total, 10, 64)
+		if err != nil {
+			size = -1
+		}
+		return &Stream{ReadCloser: resp.Body, Offset: start, Size: size}, nil
+	case http.StatusRequestedRangeNotSatisfiable:
+		resp.Body.Close()
+		if off > 0 {
+			// The file is off bytes or shorter: assume it was
+			// fetched in full already.
+			return &Stream{ReadCloser: ioutil.NopCloser(strings.NewReader("")), Offset: off, Size: off}, nil
+		}
+	}
+	resp.Body.Close()
+	return nil, &HTTPClientCodeError{err, resp.StatusCode}
+}
+
 // RetryOr returns a DoRetry function that returns true if any one of fn return
 // true.
 func RetryOr(fn ...DoRetry) DoRetry {
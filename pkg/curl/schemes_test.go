@@ -6,11 +6,16 @@ package curl
 
 import (
 	"context"
+	"encoding/pem"
 	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/cenkalti/backoff/v4"
@@ -268,3 +273,60 @@ func TestLazyFetch(t *testing.T) {
 		})
 	}
 }
+
+func TestHTTPS(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "kernel")
+	}))
+	defer ts.Close()
+	u, err := url.Parse(ts.URL + "/kernel")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir, err := ioutil.TempDir("", "curl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	ca := filepath.Join(dir, "ca.pem")
+	b := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw})
+	if err := ioutil.WriteFile(ca, b, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := CertPool(filepath.Join(dir, "none.pem")); err == nil {
+		t.Errorf("CertPool of a missing file succeeded")
+	}
+	if _, err := CertPool(ca, dir); err == nil {
+		t.Errorf("CertPool of a directory succeeded")
+	}
+
+	roots, err := CertPool(ca)
+	if err != nil {
+		t.Fatalf("CertPool(%s) = %v", ca, err)
+	}
+	r, err := NewHTTPSClient(roots).Fetch(context.Background(), u)
+	if err != nil {
+		t.Fatalf("Fetch(%s) = %v", u, err)
+	}
+	got, err := uio.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != "kernel" {
+		t.Errorf("Fetch(%s) = %q, want %q", u, got, "kernel")
+	}
+
+	// The certificate of the test server is not trusted by an empty pool.
+	empty, err := CertPool()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewHTTPSClient(empty).Fetch(context.Background(), u); err == nil {
+		t.Errorf("Fetch(%s) with no trusted certificates succeeded", u)
+	}
+	// Unless they are not checked.
+	if _, err := NewHTTPClientWithOptions(HTTPOptions{RootCAs: empty, Insecure: true}).Fetch(context.Background(), u); err != nil {
+		t.Errorf("Fetch(%s) without checking certificates = %v", u, err)
+	}
+}
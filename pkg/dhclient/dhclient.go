@@ -12,7 +12,6 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
-	"log"
 	"net"
 	"net/url"
 	"strings"
@@ -23,13 +22,14 @@ import (
 	"github.com/insomniacslk/dhcp/dhcpv4/nclient4"
 	"github.com/insomniacslk/dhcp/dhcpv6"
 	"github.com/insomniacslk/dhcp/dhcpv6/nclient6"
+	"github.com/u-root/u-root/pkg/ulog"
 	"github.com/vishvananda/netlink"
 	"golang.org/x/sys/unix"
 )
 
 // isIpv6LinkReady returns true if the interface has a link-local address
 // which is not tentative.
-func isIpv6LinkReady(l netlink.Link) (bool, error) {
+func isIpv6LinkReady(l netlink.Link, logger ulog.Logger) (bool, error) {
 	addrs, err := netlink.AddrList(l, netlink.FAMILY_V6)
 	if err != nil {
 		return false, err
@@ -37,7 +37,7 @@ func isIpv6LinkReady(l netlink.Link) (bool, error) {
 	for _, addr := range addrs {
 		if addr.IP.IsLinkLocalUnicast() && (addr.Flags&unix.IFA_F_TENTATIVE == 0) {
 			if addr.Flags&unix.IFA_F_DADFAILED != 0 {
-				log.Printf("DADFAILED for %v, continuing anyhow", addr.IP)
+				logger.Printf("DADFAILED for %v, continuing anyhow", addr.IP)
 			}
 			return true, nil
 		}
@@ -159,6 +159,21 @@ type Config struct {
 
 	// If true, add Client Identifier (61) option to the IPv4 request.
 	V4ClientIdentifier bool
+
+	// If true, identify as a PXE client, so that ProxyDHCP servers
+	// answer, and request the PXE vendor options (43).
+	PXE bool
+
+	// Logger logs the progress of requests. If not set, it defaults to
+	// ulog.Log.
+	Logger ulog.Logger
+}
+
+func (c Config) logger() ulog.Logger {
+	if c.Logger == nil {
+		return ulog.Log
+	}
+	return c.Logger
 }
 
 func lease4(ctx context.Context, iface netlink.Link, c Config) (Lease, error) {
@@ -196,18 +211,62 @@ func lease4(ctx context.Context, iface netlink.Link, c Config) (Lease, error) {
 		ident = append(ident, iface.Attrs().HardwareAddr...)
 		reqmods = append(reqmods, dhcpv4.WithOption(dhcpv4.OptClientIdentifier(ident)))
 	}
+	if c.PXE {
+		reqmods = append(reqmods,
+			dhcpv4.WithOption(dhcpv4.OptClassIdentifier(PXEClassIdentifier)),
+			dhcpv4.WithRequestedOptions(dhcpv4.OptionVendorSpecificInformation, dhcpv4.OptionClassIdentifier),
+		)
+	}
 
-	log.Printf("Attempting to get DHCPv4 lease on %s", iface.Attrs().Name)
-	lease, err := client.Request(ctx, reqmods...)
+	c.logger().Printf("Attempting to get DHCPv4 lease on %s", iface.Attrs().Name)
+	offer, proxy, err := discoverOffer(ctx, client, reqmods...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to receive an offer: %w", err)
+	}
+	lease, err := client.RequestFromOffer(ctx, offer, reqmods...)
 	if err != nil {
 		return nil, err
 	}
 
 	packet := NewPacket4(iface, lease.ACK)
-	log.Printf("Got DHCPv4 lease on %s: %v", iface.Attrs().Name, lease.ACK.Summary())
+	packet.Proxy = proxy
+	c.logger().Printf("Got DHCPv4 lease on %s: %v", iface.Attrs().Name, lease.ACK.Summary())
+	if proxy != nil {
+		c.logger().Printf("Got ProxyDHCP offer on %s: %v", iface.Attrs().Name, proxy.Summary())
+	}
 	return packet, nil
 }
 
+// discoverOffer sends a DHCPDiscover message and returns the first offer of
+// an address, along with the first ProxyDHCP offer received before it, if
+// any.
+//
+// Unlike nclient4's DiscoverOffer, ProxyDHCP offers, which have no address,
+// are not taken for the offer to request.
+func discoverOffer(ctx context.Context, client *nclient4.Client, mods ...dhcpv4.Modifier) (offer, proxy *dhcpv4.DHCPv4, err error) {
+	discover, err := dhcpv4.NewDiscovery(client.InterfaceAddr(), dhcpv4.PrependModifiers(mods,
+		dhcpv4.WithOption(dhcpv4.OptMaxMessageSize(nclient4.MaxMessageSize)))...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to create a discovery request: %w", err)
+	}
+	offer, err = client.SendAndRead(ctx, client.RemoteAddr(), discover, func(m *dhcpv4.DHCPv4) bool {
+		if m.MessageType() != dhcpv4.MessageTypeOffer {
+			return false
+		}
+		if isProxyOffer(m) {
+			if proxy == nil {
+				proxy = m
+			}
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return offer, proxy, nil
+}
+
 func lease6(ctx context.Context, iface netlink.Link, c Config, linkUpTimeout time.Duration) (Lease, error) {
 	// For ipv6, we cannot bind to the port until Duplicate Address
 	// Detection (DAD) is complete which is indicated by the link being no
@@ -219,7 +278,7 @@ func lease6(ctx context.Context, iface netlink.Link, c Config, linkUpTimeout tim
 	// Hardcode the timeout to 30s for now.
 	linkTimeout := time.After(linkUpTimeout)
 	for {
-		if ready, err := isIpv6LinkReady(iface); err != nil {
+		if ready, err := isIpv6LinkReady(iface, c.logger()); err != nil {
 			return nil, err
 		} else if ready {
 			break
@@ -260,14 +319,14 @@ func lease6(ctx context.Context, iface netlink.Link, c Config, linkUpTimeout tim
 		},
 		c.Modifiers6...)
 
-	log.Printf("Attempting to get DHCPv6 lease on %s", iface.Attrs().Name)
+	c.logger().Printf("Attempting to get DHCPv6 lease on %s", iface.Attrs().Name)
 	p, err := client.RapidSolicit(ctx, reqmods...)
 	if err != nil {
 		return nil, err
 	}
 
 	packet := NewPacket6(iface, p)
-	log.Printf("Got DHCPv6 lease on %s: %v", iface.Attrs().Name, p.Summary())
+	c.logger().Printf("Got DHCPv6 lease on %s: %v", iface.Attrs().Name, p.Summary())
 	return packet, nil
 }
 
@@ -326,9 +385,9 @@ func SendRequests(ctx context.Context, ifs []netlink.Link, ipv4, ipv6 bool, c Co
 		go func(iface netlink.Link) {
 			defer wg.Done()
 
-			log.Printf("Bringing up interface %s...", iface.Attrs().Name)
+			c.logger().Printf("Bringing up interface %s...", iface.Attrs().Name)
 			if _, err := IfUp(iface.Attrs().Name, linkUpTimeout); err != nil {
-				log.Printf("Could not bring up interface %s: %v", iface.Attrs().Name, err)
+				c.logger().Printf("Could not bring up interface %s: %v", iface.Attrs().Name, err)
 				return
 			}
 
@@ -20,6 +20,10 @@ import (
 type Packet4 struct {
 	iface netlink.Link
 	P     *dhcpv4.DHCPv4
+
+	// Proxy is the offer of a ProxyDHCP server received along with the
+	// lease, if any. It carries the boot information when P does not.
+	Proxy *dhcpv4.DHCPv4
 }
 
 var _ Lease = &Packet4{}
@@ -145,22 +149,32 @@ var (
 	ErrNoServerHostName = errors.New("no server host name present in DHCP message")
 )
 
-func (p *Packet4) bootfilename() string {
+func bootfilename(m *dhcpv4.DHCPv4) string {
 	// Look for dhcp option presence first, then legacy BootFileName in header.
-	bootFileName := p.P.BootFileNameOption()
+	bootFileName := m.BootFileNameOption()
 	bootFileName = strings.TrimRight(bootFileName, "\x00")
 	if len(bootFileName) > 0 {
 		return bootFileName
 	}
-	if len(p.P.BootFileName) >= 0 {
-		return p.P.BootFileName
-	}
-	return ""
+	return m.BootFileName
 }
 
-// Boot returns the boot file assigned.
+func (p *Packet4) bootfilename() string {
+	return bootfilename(p.P)
+}
+
+// Boot returns the boot file assigned, or the one of the ProxyDHCP offer if
+// the lease has none.
 func (p *Packet4) Boot() (*url.URL, error) {
-	bootFileName := p.bootfilename()
+	u, err := bootURL(p.P)
+	if err == ErrNoBootFile && p.Proxy != nil {
+		return bootURL(p.Proxy)
+	}
+	return u, err
+}
+
+func bootURL(m *dhcpv4.DHCPv4) (*url.URL, error) {
+	bootFileName := bootfilename(m)
 	if len(bootFileName) == 0 {
 		return nil, ErrNoBootFile
 	}
@@ -175,17 +189,17 @@ func (p *Packet4) Boot() (*url.URL, error) {
 		// Defaults to tftp is not specified.
 		u.Scheme = "tftp"
 		u.Path = bootFileName
-		if len(p.P.ServerHostName) == 0 {
-			server := p.P.ServerIdentifier()
+		if len(m.ServerHostName) == 0 {
+			server := m.ServerIdentifier()
 			if server != nil {
 				u.Host = server.String()
-			} else if !p.P.ServerIPAddr.Equal(net.IPv4zero) {
-				u.Host = p.P.ServerIPAddr.String()
+			} else if !m.ServerIPAddr.Equal(net.IPv4zero) {
+				u.Host = m.ServerIPAddr.String()
 			} else {
 				return nil, ErrNoServerHostName
 			}
 		} else {
-			u.Host = p.P.ServerHostName
+			u.Host = m.ServerHostName
 		}
 	}
 	return u, nil
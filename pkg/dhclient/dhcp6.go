@@ -12,6 +12,7 @@ import (
 
 	"github.com/insomniacslk/dhcp/dhcpv4"
 	"github.com/insomniacslk/dhcp/dhcpv6"
+	"github.com/insomniacslk/dhcp/iana"
 	"github.com/vishvananda/netlink"
 	"golang.org/x/sys/unix"
 )
@@ -48,42 +49,44 @@ func Configure6(iface netlink.Link, packet *dhcpv6.Message) error {
 
 // Configure configures interface using this packet.
 func (p *Packet6) Configure() error {
-	l := p.Lease()
-	if l == nil {
-		return fmt.Errorf("no lease returned")
+	addrs, err := p.Addresses()
+	if err != nil {
+		return err
 	}
 
-	// Add the address to the iface.
-	dst := &netlink.Addr{
-		IPNet: &net.IPNet{
-			IP: l.IPv6Addr,
-
-			// This mask tells Linux which addresses we know to be
-			// "on-link" (i.e., reachable on this interface without
-			// having to talk to a router).
-			//
-			// Since DHCPv6 does not give us that information, we
-			// have to assume that no addresses are on-link. To do
-			// that, we use /128. (See also RFC 5942 Section 5,
-			// "Observed Incorrect Implementation Behavior".)
-			Mask: net.CIDRMask(128, 128),
-		},
-		PreferedLft: int(l.PreferredLifetime),
-		ValidLft:    int(l.ValidLifetime),
-		// Optimistic DAD (Duplicate Address Detection) means we can
-		// use the address before DAD is complete. The DHCP server's
-		// job was to give us a unique IP so there is little risk of a
-		// collision.
-		Flags: unix.IFA_F_OPTIMISTIC,
-	}
-	if err := netlink.AddrReplace(p.iface, dst); err != nil {
-		if os.IsExist(err) {
-			return fmt.Errorf("add/replace %s to %v: %v", dst, p.iface, err)
+	// Add the addresses to the iface.
+	for _, l := range addrs {
+		dst := &netlink.Addr{
+			IPNet: &net.IPNet{
+				IP: l.IPv6Addr,
+
+				// This mask tells Linux which addresses we know to be
+				// "on-link" (i.e., reachable on this interface without
+				// having to talk to a router).
+				//
+				// Since DHCPv6 does not give us that information, we
+				// have to assume that no addresses are on-link. To do
+				// that, we use /128. (See also RFC 5942 Section 5,
+				// "Observed Incorrect Implementation Behavior".)
+				Mask: net.CIDRMask(128, 128),
+			},
+			PreferedLft: int(l.PreferredLifetime.Seconds()),
+			ValidLft:    int(l.ValidLifetime.Seconds()),
+			// Optimistic DAD (Duplicate Address Detection) means we can
+			// use the address before DAD is complete. The DHCP server's
+			// job was to give us a unique IP so there is little risk of a
+			// collision.
+			Flags: unix.IFA_F_OPTIMISTIC,
+		}
+		if err := netlink.AddrReplace(p.iface, dst); err != nil {
+			if os.IsExist(err) {
+				return fmt.Errorf("add/replace %s to %v: %v", dst, p.iface, err)
+			}
 		}
 	}
 
-	if ips := p.DNS(); ips != nil {
-		if err := WriteDNSSettings(ips, nil, ""); err != nil {
+	if ns, sl := p.GatherDNSSettings(); ns != nil {
+		if err := WriteDNSSettings(ns, sl, ""); err != nil {
 			return err
 		}
 	}
@@ -91,7 +94,11 @@ func (p *Packet6) Configure() error {
 }
 
 func (p *Packet6) String() string {
-	return fmt.Sprintf("IPv6 DHCP Lease IP %s", p.Lease().IPv6Addr)
+	l := p.Lease()
+	if l == nil {
+		return "IPv6 DHCP Lease without address"
+	}
+	return fmt.Sprintf("IPv6 DHCP Lease IP %s", l.IPv6Addr)
 }
 
 // Lease returns lease information assigned.
@@ -103,6 +110,40 @@ func (p *Packet6) Lease() *dhcpv6.OptIAAddress {
 	return iana.Options.OneAddress()
 }
 
+// Addresses returns the addresses of the non-temporary address association
+// (IA_NA) assigned. It fails if the server did not assign any, e.g. because
+// it has no address available.
+func (p *Packet6) Addresses() ([]*dhcpv6.OptIAAddress, error) {
+	ia := p.p.Options.OneIANA()
+	if ia == nil {
+		return nil, fmt.Errorf("no IA_NA in DHCPv6 reply")
+	}
+	if s := ia.Options.Status(); s != nil && s.StatusCode != iana.StatusSuccess {
+		return nil, fmt.Errorf("IA_NA not assigned: %s: %s", s.StatusCode, s.StatusMessage)
+	}
+	var addrs []*dhcpv6.OptIAAddress
+	for _, a := range ia.Options.Addresses() {
+		if s := a.Options.Status(); s != nil && s.StatusCode != iana.StatusSuccess {
+			continue
+		}
+		addrs = append(addrs, a)
+	}
+	if len(addrs) == 0 {
+		return nil, fmt.Errorf("no address in IA_NA")
+	}
+	return addrs, nil
+}
+
+// GatherDNSSettings gets the nameservers and the domain search list of the
+// packet.
+func (p *Packet6) GatherDNSSettings() (ns []net.IP, sl []string) {
+	ns = p.DNS()
+	if l := p.p.Options.DomainSearchList(); l != nil {
+		sl = l.Labels
+	}
+	return
+}
+
 // DNS returns DNS servers assigned.
 func (p *Packet6) DNS() []net.IP {
 	return p.p.Options.DNS()
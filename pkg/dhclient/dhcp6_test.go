@@ -0,0 +1,101 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dhclient
+
+import (
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/insomniacslk/dhcp/dhcpv6"
+	"github.com/insomniacslk/dhcp/iana"
+)
+
+func mustNew6(t *testing.T, modifiers ...dhcpv6.Modifier) *dhcpv6.Message {
+	m, err := dhcpv6.NewMessage(modifiers...)
+	if err != nil {
+		t.Fatalf("NewMessage() = %v", err)
+	}
+	return m
+}
+
+// withIANA adds an IA_NA with addrs. Unlike dhcpv6.WithIANA, it keeps
+// distinct addresses.
+func withIANA(addrs ...*dhcpv6.OptIAAddress) dhcpv6.Modifier {
+	return func(d dhcpv6.DHCPv6) {
+		ia := &dhcpv6.OptIANA{}
+		for _, a := range addrs {
+			ia.Options.Add(a)
+		}
+		d.(*dhcpv6.Message).UpdateOption(ia)
+	}
+}
+
+func TestAddresses(t *testing.T) {
+	a1 := &dhcpv6.OptIAAddress{IPv6Addr: net.ParseIP("fd00::1"), PreferredLifetime: time.Hour, ValidLifetime: 2 * time.Hour}
+	a2 := &dhcpv6.OptIAAddress{IPv6Addr: net.ParseIP("fd00::2")}
+	failed := &dhcpv6.OptIAAddress{IPv6Addr: net.ParseIP("fd00::3")}
+	failed.Options.Add(&dhcpv6.OptStatusCode{StatusCode: iana.StatusNoAddrsAvail})
+
+	noAddrs := mustNew6(t, withIANA())
+	noAddrs.Options.OneIANA().Options.Add(&dhcpv6.OptStatusCode{StatusCode: iana.StatusNoAddrsAvail})
+
+	for _, tt := range []struct {
+		name    string
+		m       *dhcpv6.Message
+		want    []net.IP
+		wantErr bool
+	}{
+		{
+			name:    "no IA_NA",
+			m:       mustNew6(t),
+			wantErr: true,
+		},
+		{
+			name:    "no address available",
+			m:       noAddrs,
+			wantErr: true,
+		},
+		{
+			name: "addresses",
+			m:    mustNew6(t, withIANA(a1, failed, a2)),
+			want: []net.IP{a1.IPv6Addr, a2.IPv6Addr},
+		},
+		{
+			name:    "only failed",
+			m:       mustNew6(t, withIANA(failed)),
+			wantErr: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			addrs, err := NewPacket6(nil, tt.m).Addresses()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Addresses() = %v, want error %t", err, tt.wantErr)
+			}
+			var got []net.IP
+			for _, a := range addrs {
+				got = append(got, a.IPv6Addr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Addresses() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGatherDNSSettings6(t *testing.T) {
+	m := mustNew6(t,
+		dhcpv6.WithDNS(net.ParseIP("fd00::53")),
+		dhcpv6.WithDomainSearchList("example.com", "example.org"),
+	)
+	ns, sl := NewPacket6(nil, m).GatherDNSSettings()
+	if want := []net.IP{net.ParseIP("fd00::53")}; !reflect.DeepEqual(ns, want) {
+		t.Errorf("nameservers = %v, want %v", ns, want)
+	}
+	if want := []string{"example.com", "example.org"}; !reflect.DeepEqual(sl, want) {
+		t.Errorf("search list = %v, want %v", sl, want)
+	}
+}
@@ -0,0 +1,264 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dhclient
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/insomniacslk/dhcp/dhcpv4"
+	"github.com/insomniacslk/dhcp/dhcpv6"
+	"github.com/u-root/u-root/pkg/cmdline"
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+// IPConfig is the network configuration of the ip= kernel parameter, as
+// described in Documentation/admin-guide/nfs/nfsroot.rst:
+//
+//   ip=<client-ip>:<server-ip>:<gw-ip>:<netmask>:<hostname>:<device>:<autoconf>:<dns0-ip>:<dns1-ip>:<ntp0-ip>
+//
+// or ip=<autoconf> alone, e.g. ip=dhcp.
+type IPConfig struct {
+	// Addr is the address of the client. If it is nil, the interface is
+	// configured by DHCP, unless Autoconf is off.
+	Addr net.IP
+
+	// Server is the address of the NFS or boot server.
+	Server net.IP
+
+	// Gateway is the default gateway.
+	Gateway net.IP
+
+	// Netmask is the netmask of Addr. If it is not given, the mask of the
+	// class of Addr is used, as the kernel does.
+	Netmask net.IPMask
+
+	// Hostname is the host name to set.
+	Hostname string
+
+	// Device is the interface to configure. If it is empty, the first
+	// interface is configured.
+	Device string
+
+	// Autoconf is the autoconfiguration protocol: off, none, on, any,
+	// dhcp, dhcp6, bootp, rarp or both.
+	Autoconf string
+
+	// DNS are the name servers.
+	DNS []net.IP
+
+	// NTP is the NTP server.
+	NTP net.IP
+}
+
+// autoconfs are the known autoconfiguration protocols.
+var autoconfs = map[string]bool{
+	"":      true,
+	"off":   true,
+	"none":  true,
+	"on":    true,
+	"any":   true,
+	"dhcp":  true,
+	"dhcp6": true,
+	"bootp": true,
+	"rarp":  true,
+	"both":  true,
+}
+
+// ParseIPConfig parses the value of the ip= kernel parameter.
+func ParseIPConfig(s string) (*IPConfig, error) {
+	if !strings.Contains(s, ":") {
+		if !autoconfs[s] {
+			return nil, fmt.Errorf("ip=%s: unknown autoconfiguration %q", s, s)
+		}
+		return &IPConfig{Autoconf: s}, nil
+	}
+
+	f := strings.Split(s, ":")
+	if len(f) > 10 {
+		return nil, fmt.Errorf("ip=%s: got %d fields, want at most 10", s, len(f))
+	}
+	// Missing fields are empty.
+	f = append(f, make([]string, 10-len(f))...)
+
+	c := &IPConfig{
+		Hostname: f[4],
+		Device:   f[5],
+		Autoconf: f[6],
+	}
+	if !autoconfs[c.Autoconf] {
+		return nil, fmt.Errorf("ip=%s: unknown autoconfiguration %q", s, c.Autoconf)
+	}
+	for _, a := range []struct {
+		name string
+		s    string
+		ip   *net.IP
+	}{
+		{"client-ip", f[0], &c.Addr},
+		{"server-ip", f[1], &c.Server},
+		{"gw-ip", f[2], &c.Gateway},
+		{"ntp0-ip", f[9], &c.NTP},
+	} {
+		if a.s == "" {
+			continue
+		}
+		if *a.ip = net.ParseIP(a.s); *a.ip == nil {
+			return nil, fmt.Errorf("ip=%s: invalid %s %q", s, a.name, a.s)
+		}
+	}
+	for _, d := range f[7:9] {
+		if d == "" {
+			continue
+		}
+		ip := net.ParseIP(d)
+		if ip == nil {
+			return nil, fmt.Errorf("ip=%s: invalid DNS server %q", s, d)
+		}
+		c.DNS = append(c.DNS, ip)
+	}
+	if f[3] != "" {
+		m := net.ParseIP(f[3])
+		if m == nil || m.To4() == nil {
+			return nil, fmt.Errorf("ip=%s: invalid netmask %q", s, f[3])
+		}
+		c.Netmask = net.IPMask(m.To4())
+	}
+	return c, nil
+}
+
+// IPConfigFromCmdline returns the configuration of the ip= kernel parameter,
+// or nil if it is not set.
+func IPConfigFromCmdline() (*IPConfig, error) {
+	s, ok := cmdline.Flag("ip")
+	if !ok {
+		return nil, nil
+	}
+	return ParseIPConfig(s)
+}
+
+// Static returns whether c is a static configuration, i.e. it has an
+// address.
+func (c *IPConfig) Static() bool {
+	return c.Addr != nil
+}
+
+// Off returns whether c disables the configuration of interfaces.
+func (c *IPConfig) Off() bool {
+	return !c.Static() && (c.Autoconf == "off" || c.Autoconf == "none")
+}
+
+// IPNet returns the address and netmask of c.
+func (c *IPConfig) IPNet() *net.IPNet {
+	mask := c.Netmask
+	if mask == nil {
+		mask = c.Addr.DefaultMask()
+		if mask == nil {
+			// IPv6 addresses have no class.
+			mask = net.CIDRMask(128, 128)
+		}
+	}
+	return &net.IPNet{IP: c.Addr, Mask: mask}
+}
+
+// Apply configures the interface of c among ifs, if c is static, and returns
+// its lease. The interfaces left to configure by DHCP are returned: none if
+// c is static or off, the one of Device if it is set, or else all of ifs.
+func (c *IPConfig) Apply(ifs []netlink.Link, linkUpTimeout time.Duration) ([]netlink.Link, Lease, error) {
+	if c.Off() {
+		return nil, nil, nil
+	}
+	var dev []netlink.Link
+	for _, iface := range ifs {
+		if c.Device == "" || iface.Attrs().Name == c.Device {
+			dev = append(dev, iface)
+		}
+	}
+	if !c.Static() {
+		return dev, nil, nil
+	}
+	if len(dev) == 0 {
+		return nil, nil, fmt.Errorf("no interface %q for ip=", c.Device)
+	}
+	iface, err := IfUp(dev[0].Attrs().Name, linkUpTimeout)
+	if err != nil {
+		return nil, nil, err
+	}
+	l := NewStaticLease(iface, c)
+	if err := l.Configure(); err != nil {
+		return nil, nil, err
+	}
+	return nil, l, nil
+}
+
+// StaticLease is a Lease of a static configuration.
+type StaticLease struct {
+	iface  netlink.Link
+	Config *IPConfig
+}
+
+var _ Lease = &StaticLease{}
+
+// NewStaticLease returns the lease of the static configuration c of iface.
+func NewStaticLease(iface netlink.Link, c *IPConfig) *StaticLease {
+	return &StaticLease{iface: iface, Config: c}
+}
+
+func (l *StaticLease) String() string {
+	return fmt.Sprintf("Static IP %s", l.Config.IPNet())
+}
+
+// Configure adds the address, the default route and the DNS servers of the
+// configuration to the system, and sets the host name.
+func (l *StaticLease) Configure() error {
+	c := l.Config
+	dst := &netlink.Addr{IPNet: c.IPNet()}
+	if err := netlink.AddrReplace(l.iface, dst); err != nil {
+		return fmt.Errorf("add/replace %s to %v: %v", dst, l.iface, err)
+	}
+	if c.Gateway != nil {
+		r := &netlink.Route{
+			LinkIndex: l.iface.Attrs().Index,
+			Gw:        c.Gateway,
+		}
+		if err := netlink.RouteReplace(r); err != nil {
+			return fmt.Errorf("%s: add %s: %v", l.iface.Attrs().Name, r, err)
+		}
+	}
+	if c.DNS != nil {
+		if err := WriteDNSSettings(c.DNS, nil, ""); err != nil {
+			return err
+		}
+	}
+	if c.Hostname != "" {
+		if err := unix.Sethostname([]byte(c.Hostname)); err != nil {
+			return fmt.Errorf("setting host name %q: %v", c.Hostname, err)
+		}
+	}
+	return nil
+}
+
+// Boot fails, as static configurations have no boot file.
+func (l *StaticLease) Boot() (*url.URL, error) {
+	return nil, ErrNoBootFile
+}
+
+// ISCSIBoot fails, as static configurations have no root path.
+func (l *StaticLease) ISCSIBoot() (*net.TCPAddr, string, error) {
+	return nil, "", ErrNoRootPath
+}
+
+// Link returns the interface of the configuration.
+func (l *StaticLease) Link() netlink.Link {
+	return l.iface
+}
+
+// Message returns no DHCP message.
+func (l *StaticLease) Message() (*dhcpv4.DHCPv4, *dhcpv6.Message) {
+	return nil, nil
+}
@@ -0,0 +1,122 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dhclient
+
+import (
+	"net"
+	"reflect"
+	"testing"
+)
+
+func TestParseIPConfig(t *testing.T) {
+	for _, tt := range []struct {
+		in      string
+		want    *IPConfig
+		wantErr bool
+	}{
+		{
+			in:   "dhcp",
+			want: &IPConfig{Autoconf: "dhcp"},
+		},
+		{
+			in:   "off",
+			want: &IPConfig{Autoconf: "off"},
+		},
+		{
+			in:      "static",
+			wantErr: true,
+		},
+		{
+			in: "10.0.0.2::10.0.0.1:255.255.255.0:host:eth0:off",
+			want: &IPConfig{
+				Addr:     net.ParseIP("10.0.0.2"),
+				Gateway:  net.ParseIP("10.0.0.1"),
+				Netmask:  net.IPv4Mask(255, 255, 255, 0),
+				Hostname: "host",
+				Device:   "eth0",
+				Autoconf: "off",
+			},
+		},
+		{
+			in: "10.0.0.2:10.0.0.3:10.0.0.1:255.255.0.0::eth1:none:8.8.8.8:8.8.4.4:10.0.0.4",
+			want: &IPConfig{
+				Addr:     net.ParseIP("10.0.0.2"),
+				Server:   net.ParseIP("10.0.0.3"),
+				Gateway:  net.ParseIP("10.0.0.1"),
+				Netmask:  net.IPv4Mask(255, 255, 0, 0),
+				Device:   "eth1",
+				Autoconf: "none",
+				DNS:      []net.IP{net.ParseIP("8.8.8.8"), net.ParseIP("8.8.4.4")},
+				NTP:      net.ParseIP("10.0.0.4"),
+			},
+		},
+		{
+			in:   ":::::eth0:dhcp",
+			want: &IPConfig{Device: "eth0", Autoconf: "dhcp"},
+		},
+		{
+			in:      "10.0.0.300::::::off",
+			wantErr: true,
+		},
+		{
+			in:      "10.0.0.2:::255.255.256.0",
+			wantErr: true,
+		},
+		{
+			in:      "10.0.0.2::::::static",
+			wantErr: true,
+		},
+		{
+			in:      "1:2:3:4:5:6:7:8:9:10:11",
+			wantErr: true,
+		},
+	} {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseIPConfig(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseIPConfig(%q) = %v, want error %t", tt.in, err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseIPConfig(%q) = %#v, want %#v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIPConfigIPNet(t *testing.T) {
+	for _, tt := range []struct {
+		c    *IPConfig
+		want string
+	}{
+		{&IPConfig{Addr: net.ParseIP("10.0.0.2")}, "10.0.0.2/8"},
+		{&IPConfig{Addr: net.ParseIP("192.168.1.2")}, "192.168.1.2/24"},
+		{&IPConfig{Addr: net.ParseIP("10.0.0.2"), Netmask: net.IPv4Mask(255, 255, 255, 128)}, "10.0.0.2/25"},
+		{&IPConfig{Addr: net.ParseIP("fd00::2")}, "fd00::2/128"},
+	} {
+		if got := tt.c.IPNet().String(); got != tt.want {
+			t.Errorf("IPNet() = %s, want %s", got, tt.want)
+		}
+	}
+}
+
+func TestIPConfigOff(t *testing.T) {
+	for _, tt := range []struct {
+		c      *IPConfig
+		off    bool
+		static bool
+	}{
+		{c: &IPConfig{Autoconf: "off"}, off: true},
+		{c: &IPConfig{Autoconf: "none"}, off: true},
+		{c: &IPConfig{Autoconf: "dhcp"}},
+		{c: &IPConfig{Addr: net.ParseIP("10.0.0.2"), Autoconf: "off"}, static: true},
+	} {
+		if got := tt.c.Off(); got != tt.off {
+			t.Errorf("%#v.Off() = %t, want %t", tt.c, got, tt.off)
+		}
+		if got := tt.c.Static(); got != tt.static {
+			t.Errorf("%#v.Static() = %t, want %t", tt.c, got, tt.static)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dhclient
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/insomniacslk/dhcp/dhcpv4"
+)
+
+// PXEClassIdentifier is the class identifier PXE clients send, and
+// ProxyDHCP servers answer to.
+const PXEClassIdentifier = "PXEClient"
+
+// PXE vendor options, carried in option 43 of PXE replies, as defined by the
+// PXE specification.
+const (
+	PXEDiscoveryControl uint8 = 6
+	PXEBootServers      uint8 = 8
+	PXEBootMenu         uint8 = 9
+	PXEMenuPrompt       uint8 = 10
+	PXEBootItem         uint8 = 71
+)
+
+// PXE is the network boot information of a DHCPv4 reply.
+type PXE struct {
+	// NextServer is the server to fetch the boot file from, siaddr of
+	// the BOOTP header.
+	NextServer net.IP
+
+	// ServerName is the TFTP server name, from option 66 or the sname
+	// field of the BOOTP header.
+	ServerName string
+
+	// BootFile is the boot file name, from option 67 or the file field of
+	// the BOOTP header.
+	BootFile string
+
+	// VendorOptions are the suboptions of option 43, the vendor specific
+	// information, by code.
+	VendorOptions map[uint8][]byte
+
+	// Proxy is set if the information comes from a ProxyDHCP offer rather
+	// than from the lease.
+	Proxy bool
+}
+
+func (p *PXE) String() string {
+	s := fmt.Sprintf("PXE boot file %q", p.BootFile)
+	if p.NextServer != nil && !p.NextServer.Equal(net.IPv4zero) {
+		s += fmt.Sprintf(" from %s", p.NextServer)
+	}
+	if p.ServerName != "" {
+		s += fmt.Sprintf(" (server %s)", p.ServerName)
+	}
+	if p.Proxy {
+		s += " via ProxyDHCP"
+	}
+	return s
+}
+
+// ParseVendorOptions parses the suboptions of a vendor specific information
+// option, which are encoded as option 43 of PXE replies: a code byte, a
+// length byte and the value. Pad (0) and end (255) codes have no length.
+func ParseVendorOptions(b []byte) (map[uint8][]byte, error) {
+	opts := make(map[uint8][]byte)
+	for i := 0; i < len(b); {
+		code := b[i]
+		i++
+		switch code {
+		case 0:
+			continue
+		case 255:
+			return opts, nil
+		}
+		if i >= len(b) {
+			return nil, fmt.Errorf("vendor option %d has no length", code)
+		}
+		n := int(b[i])
+		i++
+		if i+n > len(b) {
+			return nil, fmt.Errorf("vendor option %d is %d bytes long, only %d left", code, n, len(b)-i)
+		}
+		opts[code] = b[i : i+n]
+		i += n
+	}
+	return opts, nil
+}
+
+// isProxyOffer returns whether m is an offer of a ProxyDHCP server, which
+// carries boot information but no address.
+func isProxyOffer(m *dhcpv4.DHCPv4) bool {
+	return m.MessageType() == dhcpv4.MessageTypeOffer &&
+		(m.YourIPAddr == nil || m.YourIPAddr.IsUnspecified()) &&
+		strings.HasPrefix(m.ClassIdentifier(), PXEClassIdentifier)
+}
+
+// pxeInfo returns the boot information of m.
+func pxeInfo(m *dhcpv4.DHCPv4) (*PXE, error) {
+	p := &PXE{
+		NextServer: m.ServerIPAddr,
+		ServerName: m.TFTPServerName(),
+		BootFile:   bootfilename(m),
+	}
+	if p.ServerName == "" {
+		p.ServerName = m.ServerHostName
+	}
+	if v := m.GetOneOption(dhcpv4.OptionVendorSpecificInformation); v != nil {
+		opts, err := ParseVendorOptions(v)
+		if err != nil {
+			return nil, err
+		}
+		p.VendorOptions = opts
+	}
+	return p, nil
+}
+
+// PXE returns the network boot information of the lease. If the lease has
+// no boot file, the information of the ProxyDHCP offer is returned, if one
+// was received.
+func (p *Packet4) PXE() (*PXE, error) {
+	info, err := pxeInfo(p.P)
+	if err != nil || info.BootFile != "" || p.Proxy == nil {
+		return info, err
+	}
+	info, err = pxeInfo(p.Proxy)
+	if err != nil {
+		return nil, err
+	}
+	info.Proxy = true
+	return info, nil
+}
@@ -0,0 +1,160 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dhclient
+
+import (
+	"net"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/insomniacslk/dhcp/dhcpv4"
+)
+
+func TestParseVendorOptions(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		in      []byte
+		want    map[uint8][]byte
+		wantErr bool
+	}{
+		{
+			name: "empty",
+			want: map[uint8][]byte{},
+		},
+		{
+			name: "discovery control and end",
+			in:   []byte{0, PXEDiscoveryControl, 1, 8, 255, 9, 9},
+			want: map[uint8][]byte{PXEDiscoveryControl: {8}},
+		},
+		{
+			name: "two options",
+			in:   []byte{PXEMenuPrompt, 2, 0, 'x', PXEBootItem, 4, 0, 1, 0, 0},
+			want: map[uint8][]byte{
+				PXEMenuPrompt: {0, 'x'},
+				PXEBootItem:   {0, 1, 0, 0},
+			},
+		},
+		{
+			name:    "no length",
+			in:      []byte{PXEBootMenu},
+			wantErr: true,
+		},
+		{
+			name:    "too long",
+			in:      []byte{PXEBootMenu, 4, 1, 2},
+			wantErr: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseVendorOptions(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseVendorOptions(%v) = %v, want error %t", tt.in, err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseVendorOptions(%v) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func proxyOffer(t *testing.T, modifiers ...dhcpv4.Modifier) *dhcpv4.DHCPv4 {
+	return mustNew(t, append([]dhcpv4.Modifier{
+		dhcpv4.WithMessageType(dhcpv4.MessageTypeOffer),
+		dhcpv4.WithOption(dhcpv4.OptClassIdentifier(PXEClassIdentifier)),
+	}, modifiers...)...)
+}
+
+func TestIsProxyOffer(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		m    *dhcpv4.DHCPv4
+		want bool
+	}{
+		{
+			name: "proxy",
+			m:    proxyOffer(t),
+			want: true,
+		},
+		{
+			name: "address",
+			m:    proxyOffer(t, dhcpv4.WithYourIP(net.IP{10, 0, 0, 5})),
+		},
+		{
+			name: "not PXE",
+			m:    mustNew(t, dhcpv4.WithMessageType(dhcpv4.MessageTypeOffer)),
+		},
+		{
+			name: "ack",
+			m: mustNew(t,
+				dhcpv4.WithMessageType(dhcpv4.MessageTypeAck),
+				dhcpv4.WithOption(dhcpv4.OptClassIdentifier(PXEClassIdentifier)),
+			),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isProxyOffer(tt.m); got != tt.want {
+				t.Errorf("isProxyOffer() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPXE(t *testing.T) {
+	ack := mustNew(t,
+		dhcpv4.WithMessageType(dhcpv4.MessageTypeAck),
+		dhcpv4.WithYourIP(net.IP{10, 0, 0, 5}),
+	)
+	proxy := proxyOffer(t,
+		dhcpv4.WithServerIP(net.IP{10, 0, 0, 2}),
+		dhcpv4.WithOption(dhcpv4.OptBootFileName("pxelinux.0")),
+		dhcpv4.WithOption(dhcpv4.OptGeneric(dhcpv4.OptionVendorSpecificInformation, []byte{PXEDiscoveryControl, 1, 8, 255})),
+	)
+
+	p := NewPacket4(nil, ack)
+	if _, err := p.Boot(); err != ErrNoBootFile {
+		t.Errorf("Boot() without proxy = %v, want %v", err, ErrNoBootFile)
+	}
+	got, err := p.PXE()
+	if err != nil {
+		t.Fatalf("PXE() = %v", err)
+	}
+	if got.BootFile != "" || got.Proxy {
+		t.Errorf("PXE() without proxy = %v, want no boot file", got)
+	}
+
+	p.Proxy = proxy
+	got, err = p.PXE()
+	if err != nil {
+		t.Fatalf("PXE() = %v", err)
+	}
+	want := &PXE{
+		NextServer:    net.IP{10, 0, 0, 2},
+		BootFile:      "pxelinux.0",
+		VendorOptions: map[uint8][]byte{PXEDiscoveryControl: {8}},
+		Proxy:         true,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("PXE() = %#v, want %#v", got, want)
+	}
+	u, err := p.Boot()
+	if err != nil {
+		t.Fatalf("Boot() = %v", err)
+	}
+	if wantURL := (&url.URL{Scheme: "tftp", Host: "10.0.0.2", Path: "pxelinux.0"}); !reflect.DeepEqual(u, wantURL) {
+		t.Errorf("Boot() = %s, want %s", u, wantURL)
+	}
+
+	// The boot file of the lease wins over the proxy's.
+	ack.UpdateOption(dhcpv4.OptBootFileName("grubx64.efi"))
+	ack.ServerIPAddr = net.IP{10, 0, 0, 1}
+	got, err = p.PXE()
+	if err != nil {
+		t.Fatalf("PXE() = %v", err)
+	}
+	if got.BootFile != "grubx64.efi" || got.Proxy {
+		t.Errorf("PXE() = %v, want the boot file of the lease", got)
+	}
+}
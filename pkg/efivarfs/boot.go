@@ -0,0 +1,146 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package efivarfs
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+)
+
+// Load option attributes, as defined by the UEFI specification.
+const (
+	LoadOptionActive         uint32 = 0x00000001
+	LoadOptionForceReconnect uint32 = 0x00000002
+	LoadOptionHidden         uint32 = 0x00000008
+	LoadOptionCategoryApp    uint32 = 0x00000100
+)
+
+// LoadOption is an EFI_LOAD_OPTION, the content of the Boot#### variables:
+//
+//	typedef struct _EFI_LOAD_OPTION {
+//	    UINT32 Attributes;
+//	    UINT16 FilePathListLength;
+//	    // CHAR16 Description[];
+//	    // EFI_DEVICE_PATH_PROTOCOL FilePathList[];
+//	    // UINT8 OptionalData[];
+//	} EFI_LOAD_OPTION;
+//
+// Only the first device path of the list is kept.
+type LoadOption struct {
+	Attributes   uint32
+	Description  string
+	FilePath     DevicePath
+	OptionalData []byte
+}
+
+// Active returns whether the firmware tries to boot the option.
+func (o *LoadOption) Active() bool {
+	return o.Attributes&LoadOptionActive != 0
+}
+
+// ParseLoadOption parses the EFI_LOAD_OPTION in b.
+func ParseLoadOption(b []byte) (*LoadOption, error) {
+	if len(b) < 8 {
+		return nil, fmt.Errorf("load option of %d bytes, want at least 8", len(b))
+	}
+	o := &LoadOption{Attributes: binary.LittleEndian.Uint32(b)}
+	fpl := int(binary.LittleEndian.Uint16(b[4:]))
+	i := 6
+	for i+1 < len(b) && (b[i] != 0 || b[i+1] != 0) {
+		i += 2
+	}
+	if i+1 >= len(b) {
+		return nil, fmt.Errorf("load option description is not terminated")
+	}
+	o.Description = DecodeUTF16(b[6:i])
+	i += 2
+	if i+fpl > len(b) {
+		return nil, fmt.Errorf("load option file path list of %d bytes, only %d left", fpl, len(b)-i)
+	}
+	p, err := ParseDevicePath(b[i : i+fpl])
+	if err != nil {
+		return nil, err
+	}
+	o.FilePath = p
+	if len(b) > i+fpl {
+		o.OptionalData = b[i+fpl:]
+	}
+	return o, nil
+}
+
+// Marshal returns the EFI_LOAD_OPTION of o.
+func (o *LoadOption) Marshal() []byte {
+	fp := o.FilePath.Marshal()
+	b := make([]byte, 6)
+	binary.LittleEndian.PutUint32(b, o.Attributes)
+	binary.LittleEndian.PutUint16(b[4:], uint16(len(fp)))
+	b = append(b, EncodeUTF16(o.Description)...)
+	b = append(b, fp...)
+	return append(b, o.OptionalData...)
+}
+
+func (o *LoadOption) String() string {
+	s := o.Description + "\t" + o.FilePath.String()
+	if len(o.OptionalData) > 0 {
+		// Linux and shells take UTF-16 arguments, others are binary.
+		if len(o.OptionalData)%2 == 0 {
+			s += "\t" + strconv.Quote(DecodeUTF16(o.OptionalData))
+		} else {
+			s += fmt.Sprintf("\t%x", o.OptionalData)
+		}
+	}
+	return s
+}
+
+// BootVar returns the Boot#### variable of the boot entry n.
+func BootVar(n uint16) Var {
+	return Var{Name: fmt.Sprintf("Boot%04X", n), GUID: GlobalGUID}
+}
+
+// The variables of the boot manager.
+var (
+	BootOrder   = Var{Name: "BootOrder", GUID: GlobalGUID}
+	BootNext    = Var{Name: "BootNext", GUID: GlobalGUID}
+	BootCurrent = Var{Name: "BootCurrent", GUID: GlobalGUID}
+	Timeout     = Var{Name: "Timeout", GUID: GlobalGUID}
+)
+
+// BootNumber returns the number of the boot entry v, if it is one.
+func BootNumber(v Var) (uint16, bool) {
+	if v.GUID != GlobalGUID || len(v.Name) != 8 || v.Name[:4] != "Boot" {
+		return 0, false
+	}
+	// Boot numbers are upper case hex digits.
+	for _, c := range v.Name[4:] {
+		if !(c >= '0' && c <= '9' || c >= 'A' && c <= 'F') {
+			return 0, false
+		}
+	}
+	n, err := strconv.ParseUint(v.Name[4:], 16, 16)
+	return uint16(n), err == nil
+}
+
+// ParseUint16s parses the little endian UINT16 array of variables such as
+// BootOrder.
+func ParseUint16s(b []byte) ([]uint16, error) {
+	if len(b)%2 != 0 {
+		return nil, fmt.Errorf("UINT16 array of odd length %d", len(b))
+	}
+	n := make([]uint16, len(b)/2)
+	for i := range n {
+		n[i] = binary.LittleEndian.Uint16(b[2*i:])
+	}
+	return n, nil
+}
+
+// MarshalUint16s returns the little endian UINT16 array of n.
+func MarshalUint16s(n []uint16) []byte {
+	b := make([]byte, 2*len(n))
+	for i, v := range n {
+		binary.LittleEndian.PutUint16(b[2*i:], v)
+	}
+	return b
+}
@@ -0,0 +1,203 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package efivarfs
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/u-root/u-root/pkg/mount/gpt"
+)
+
+// BootEntry is a Boot#### variable.
+type BootEntry struct {
+	Number uint16
+	*LoadOption
+}
+
+// ReadBootEntry returns the boot entry n.
+func ReadBootEntry(n uint16) (*BootEntry, error) {
+	_, d, err := Read(BootVar(n))
+	if err != nil {
+		return nil, err
+	}
+	o, err := ParseLoadOption(d)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %v", BootVar(n), err)
+	}
+	return &BootEntry{Number: n, LoadOption: o}, nil
+}
+
+// BootEntries returns the boot entries, by number. Entries which cannot be
+// parsed are skipped.
+func BootEntries() ([]*BootEntry, error) {
+	vars, err := List()
+	if err != nil {
+		return nil, err
+	}
+	var entries []*BootEntry
+	for _, v := range vars {
+		n, ok := BootNumber(v)
+		if !ok {
+			continue
+		}
+		e, err := ReadBootEntry(n)
+		if err != nil {
+			continue
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+// WriteBootEntry creates or replaces the boot entry n.
+func WriteBootEntry(n uint16, o *LoadOption) error {
+	return Write(BootVar(n), DefaultAttributes, o.Marshal())
+}
+
+// FreeBootNumber returns the lowest number without a boot entry.
+func FreeBootNumber() (uint16, error) {
+	vars, err := List()
+	if err != nil {
+		return 0, err
+	}
+	used := map[uint16]bool{}
+	for _, v := range vars {
+		if n, ok := BootNumber(v); ok {
+			used[n] = true
+		}
+	}
+	for n := 0; n <= 0xffff; n++ {
+		if !used[uint16(n)] {
+			return uint16(n), nil
+		}
+	}
+	return 0, fmt.Errorf("no free boot entry number")
+}
+
+// ReadUint16s returns the UINT16 array of the variable v, e.g. BootOrder,
+// or BootNext in an array of one.
+func ReadUint16s(v Var) ([]uint16, error) {
+	_, d, err := Read(v)
+	if err != nil {
+		return nil, err
+	}
+	n, err := ParseUint16s(d)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %v", v, err)
+	}
+	return n, nil
+}
+
+// WriteUint16s sets the variable v to the UINT16 array n.
+func WriteUint16s(v Var, n []uint16) error {
+	return Write(v, DefaultAttributes, MarshalUint16s(n))
+}
+
+// ReadBootOrder returns BootOrder, or nothing if it is not set.
+func ReadBootOrder() ([]uint16, error) {
+	n, err := ReadUint16s(BootOrder)
+	if errors.Is(err, ErrNotFound) {
+		return nil, nil
+	}
+	return n, err
+}
+
+// WriteBootOrder sets BootOrder.
+func WriteBootOrder(n []uint16) error {
+	return WriteUint16s(BootOrder, n)
+}
+
+// DeleteBootEntry deletes the boot entry n, and removes it from BootOrder
+// and BootNext.
+func DeleteBootEntry(n uint16) error {
+	if err := Delete(BootVar(n)); err != nil {
+		return err
+	}
+	order, err := ReadBootOrder()
+	if err != nil {
+		return err
+	}
+	var o []uint16
+	for _, b := range order {
+		if b != n {
+			o = append(o, b)
+		}
+	}
+	if len(o) != len(order) {
+		if err := WriteBootOrder(o); err != nil {
+			return err
+		}
+	}
+	if next, err := ReadUint16s(BootNext); err == nil && len(next) == 1 && next[0] == n {
+		return Delete(BootNext)
+	}
+	return nil
+}
+
+// sysBlock is where the block devices are in sysfs. It can be overridden
+// for testing.
+var sysBlock = "/sys/class/block"
+
+func readSysUint(dir, name string) (uint64, error) {
+	b, err := ioutil.ReadFile(filepath.Join(dir, name))
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(strings.TrimSpace(string(b)), 10, 64)
+}
+
+// PartitionDevicePath returns the device path of the file at path on the
+// GPT partition dev, e.g. /dev/sda1, for a boot entry. The partition's
+// number, start and size are those of sysfs, its GUID the one of the GPT
+// of its disk.
+func PartitionDevicePath(dev, path string) (DevicePath, error) {
+	name := filepath.Base(dev)
+	dir, err := filepath.EvalSymlinks(filepath.Join(sysBlock, name))
+	if err != nil {
+		return nil, fmt.Errorf("%s: not a block device: %v", dev, err)
+	}
+	part, err := readSysUint(dir, "partition")
+	if err != nil {
+		return nil, fmt.Errorf("%s: not a partition: %v", dev, err)
+	}
+	// sysfs counts in 512-byte sectors, the firmware in logical blocks.
+	start, err := readSysUint(dir, "start")
+	if err != nil {
+		return nil, err
+	}
+	size, err := readSysUint(dir, "size")
+	if err != nil {
+		return nil, err
+	}
+	disk := filepath.Join(filepath.Dir(dev), filepath.Base(filepath.Dir(dir)))
+	bs, err := readSysUint(filepath.Dir(dir), "queue/logical_block_size")
+	if err != nil || bs == 0 {
+		bs = 512
+	}
+
+	f, err := os.Open(disk)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	pt, err := gpt.New(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", disk, err)
+	}
+	if part == 0 || int(part) > len(pt.Primary.Parts) {
+		return nil, fmt.Errorf("%s: no partition %d in the GPT", disk, part)
+	}
+	hd, err := HardDriveNode(uint32(part), start*512/bs, size*512/bs, pt.Primary.Parts[part-1].UniqueGUID.String())
+	if err != nil {
+		return nil, err
+	}
+	return DevicePath{hd, FilePathNode(path)}, nil
+}
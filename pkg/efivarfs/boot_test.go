@@ -0,0 +1,149 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package efivarfs
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+)
+
+const partGUID = "c12a7328-f81f-11d2-ba4b-00a0c93ec93b"
+
+func TestGUID(t *testing.T) {
+	g, err := ParseGUID(partGUID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []byte{0x28, 0x73, 0x2a, 0xc1, 0x1f, 0xf8, 0xd2, 0x11, 0xba, 0x4b, 0x00, 0xa0, 0xc9, 0x3e, 0xc9, 0x3b}
+	if !bytes.Equal(g[:], want) {
+		t.Errorf("ParseGUID(%s) = % x, want % x", partGUID, g, want)
+	}
+	if s := GUIDString(g[:]); s != partGUID {
+		t.Errorf("GUIDString() = %s, want %s", s, partGUID)
+	}
+	if _, err := ParseGUID("c12a7328"); err == nil {
+		t.Errorf("ParseGUID of a short GUID succeeded")
+	}
+}
+
+func TestLoadOption(t *testing.T) {
+	hd, err := HardDriveNode(1, 0x800, 0x100000, partGUID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	o := &LoadOption{
+		Attributes:   LoadOptionActive,
+		Description:  "Linux",
+		FilePath:     DevicePath{hd, FilePathNode("/EFI/Linux/vmlinuz.efi")},
+		OptionalData: EncodeUTF16("console=ttyS0")[:26],
+	}
+	b := o.Marshal()
+	got, err := ParseLoadOption(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, o) {
+		t.Errorf("ParseLoadOption(Marshal()) = %+v, want %+v", got, o)
+	}
+	want := `Linux	HD(1,GPT,` + partGUID + `,0x800,0x100000)/File(\EFI\Linux\vmlinuz.efi)	"console=ttyS0"`
+	if s := got.String(); s != want {
+		t.Errorf("String() = %q, want %q", s, want)
+	}
+	if s := MBRHardDriveNode(2, 63, 1024, 0xdeadbeef).String(); s != "HD(2,MBR,0xdeadbeef,0x3f,0x400)" {
+		t.Errorf("MBR node String() = %q", s)
+	}
+	if s := (DevicePathNode{Type: DevicePathHardware, SubType: 1, Data: []byte{0, 2}}).String(); s != "Path(1,1,0002)" {
+		t.Errorf("unknown node String() = %q", s)
+	}
+
+	for _, bad := range [][]byte{
+		b[:6],
+		// The description is not terminated.
+		b[:12],
+		// The file path list is cut.
+		b[:len(b)-40],
+	} {
+		if _, err := ParseLoadOption(bad); err == nil {
+			t.Errorf("ParseLoadOption(% x) succeeded", bad)
+		}
+	}
+}
+
+func TestBootNumber(t *testing.T) {
+	for _, tt := range []struct {
+		v  Var
+		n  uint16
+		ok bool
+	}{
+		{BootVar(0x1a), 0x1a, true},
+		{Var{"Boot000a", GlobalGUID}, 0, false},
+		{BootOrder, 0, false},
+		{Var{"Boot0001", partGUID}, 0, false},
+	} {
+		if n, ok := BootNumber(tt.v); n != tt.n || ok != tt.ok {
+			t.Errorf("BootNumber(%v) = %#x, %v, want %#x, %v", tt.v, n, ok, tt.n, tt.ok)
+		}
+	}
+}
+
+func TestBootEntries(t *testing.T) {
+	dir, err := ioutil.TempDir("", "efivarfs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	Path = dir
+
+	o := &LoadOption{Attributes: LoadOptionActive, Description: "shell", FilePath: DevicePath{FilePathNode(`\shell.efi`)}}
+	for i := 0; i < 3; i++ {
+		n, err := FreeBootNumber()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != uint16(i) {
+			t.Errorf("FreeBootNumber() = %d, want %d", n, i)
+		}
+		if err := WriteBootEntry(n, o); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := WriteBootOrder([]uint16{2, 1, 0}); err != nil {
+		t.Fatal(err)
+	}
+	if err := WriteUint16s(BootNext, []uint16{1}); err != nil {
+		t.Fatal(err)
+	}
+	// Not a boot entry.
+	if err := Write(Var{"Boot0003", partGUID}, DefaultAttributes, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := DeleteBootEntry(1); err != nil {
+		t.Fatal(err)
+	}
+	entries, err := BootEntries()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 || entries[0].Number != 0 || entries[1].Number != 2 || !reflect.DeepEqual(entries[1].LoadOption, o) {
+		t.Errorf("BootEntries() = %v, want entries 0 and 2", entries)
+	}
+	order, err := ReadBootOrder()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []uint16{2, 0}; !reflect.DeepEqual(order, want) {
+		t.Errorf("BootOrder = %v, want %v", order, want)
+	}
+	if _, err := ReadUint16s(BootNext); err == nil {
+		t.Errorf("BootNext is still set")
+	}
+	if n, err := FreeBootNumber(); err != nil || n != 1 {
+		t.Errorf("FreeBootNumber() = %d, %v, want 1", n, err)
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package efivarfs
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"unicode/utf16"
+)
+
+// Device path node types and subtypes, as defined by the UEFI specification.
+const (
+	DevicePathHardware  uint8 = 0x01
+	DevicePathACPI      uint8 = 0x02
+	DevicePathMessaging uint8 = 0x03
+	DevicePathMedia     uint8 = 0x04
+	DevicePathBIOS      uint8 = 0x05
+	DevicePathEnd       uint8 = 0x7f
+
+	MediaHardDrive uint8 = 0x01
+	MediaFilePath  uint8 = 0x04
+
+	EndEntire uint8 = 0xff
+)
+
+// Partition formats and signature types of hard drive nodes.
+const (
+	partFormatMBR = 0x01
+	partFormatGPT = 0x02
+
+	sigTypeMBR = 0x01
+	sigTypeGPT = 0x02
+)
+
+// DevicePathNode is a node of a device path: a type, a subtype and data
+// whose layout depends on them.
+type DevicePathNode struct {
+	Type    uint8
+	SubType uint8
+	Data    []byte
+}
+
+// DevicePath is a device path, which tells the firmware where to find a
+// file, e.g. a boot loader. It does not include the end node.
+type DevicePath []DevicePathNode
+
+// ParseDevicePath parses the device path in b, up to its end node.
+func ParseDevicePath(b []byte) (DevicePath, error) {
+	var p DevicePath
+	for len(b) > 0 {
+		if len(b) < 4 {
+			return nil, fmt.Errorf("device path node of %d bytes, want at least 4", len(b))
+		}
+		l := int(binary.LittleEndian.Uint16(b[2:]))
+		if l < 4 || l > len(b) {
+			return nil, fmt.Errorf("device path node of type %#x has bad length %d, %d bytes left", b[0], l, len(b))
+		}
+		n := DevicePathNode{Type: b[0], SubType: b[1], Data: b[4:l]}
+		b = b[l:]
+		if n.Type == DevicePathEnd && n.SubType == EndEntire {
+			return p, nil
+		}
+		p = append(p, n)
+	}
+	return nil, fmt.Errorf("device path has no end node")
+}
+
+// Marshal returns the binary form of p, with its end node.
+func (p DevicePath) Marshal() []byte {
+	var b []byte
+	for _, n := range append(p, DevicePathNode{Type: DevicePathEnd, SubType: EndEntire}) {
+		var h [4]byte
+		h[0], h[1] = n.Type, n.SubType
+		binary.LittleEndian.PutUint16(h[2:], uint16(4+len(n.Data)))
+		b = append(append(b, h[:]...), n.Data...)
+	}
+	return b
+}
+
+// String returns the text form of p, as printed by efibootmgr, e.g.
+// HD(1,GPT,...)/File(\EFI\BOOT\BOOTX64.EFI).
+func (p DevicePath) String() string {
+	var s []string
+	for _, n := range p {
+		s = append(s, n.String())
+	}
+	return strings.Join(s, "/")
+}
+
+func (n DevicePathNode) String() string {
+	d := n.Data
+	switch {
+	case n.Type == DevicePathMedia && n.SubType == MediaHardDrive && len(d) == 38:
+		part := binary.LittleEndian.Uint32(d)
+		start := binary.LittleEndian.Uint64(d[4:])
+		size := binary.LittleEndian.Uint64(d[12:])
+		switch d[36] {
+		case partFormatGPT:
+			return fmt.Sprintf("HD(%d,GPT,%s,%#x,%#x)", part, GUIDString(d[20:36]), start, size)
+		case partFormatMBR:
+			return fmt.Sprintf("HD(%d,MBR,%#08x,%#x,%#x)", part, binary.LittleEndian.Uint32(d[20:]), start, size)
+		}
+	case n.Type == DevicePathMedia && n.SubType == MediaFilePath:
+		return fmt.Sprintf("File(%s)", DecodeUTF16(d))
+	}
+	return fmt.Sprintf("Path(%d,%d,%s)", n.Type, n.SubType, hex.EncodeToString(d))
+}
+
+// HardDriveNode returns the node of partition number part, starting at LBA
+// start and of size sectors, of a GPT disk. guid is the unique GUID of the
+// partition, its PARTUUID.
+func HardDriveNode(part uint32, start, size uint64, guid string) (DevicePathNode, error) {
+	g, err := ParseGUID(guid)
+	if err != nil {
+		return DevicePathNode{}, err
+	}
+	d := make([]byte, 38)
+	binary.LittleEndian.PutUint32(d, part)
+	binary.LittleEndian.PutUint64(d[4:], start)
+	binary.LittleEndian.PutUint64(d[12:], size)
+	copy(d[20:], g[:])
+	d[36], d[37] = partFormatGPT, sigTypeGPT
+	return DevicePathNode{Type: DevicePathMedia, SubType: MediaHardDrive, Data: d}, nil
+}
+
+// MBRHardDriveNode returns the node of partition number part of an MBR
+// disk with the disk signature sig.
+func MBRHardDriveNode(part uint32, start, size uint64, sig uint32) DevicePathNode {
+	d := make([]byte, 38)
+	binary.LittleEndian.PutUint32(d, part)
+	binary.LittleEndian.PutUint64(d[4:], start)
+	binary.LittleEndian.PutUint64(d[12:], size)
+	binary.LittleEndian.PutUint32(d[20:], sig)
+	d[36], d[37] = partFormatMBR, sigTypeMBR
+	return DevicePathNode{Type: DevicePathMedia, SubType: MediaHardDrive, Data: d}
+}
+
+// FilePathNode returns the node of the file at path on a partition. Slashes
+// are turned into the backslashes the firmware expects.
+func FilePathNode(path string) DevicePathNode {
+	path = strings.ReplaceAll(path, "/", `\`)
+	if !strings.HasPrefix(path, `\`) {
+		path = `\` + path
+	}
+	return DevicePathNode{Type: DevicePathMedia, SubType: MediaFilePath, Data: EncodeUTF16(path)}
+}
+
+// EncodeUTF16 returns s as a NUL-terminated UTF-16LE string.
+func EncodeUTF16(s string) []byte {
+	u := utf16.Encode([]rune(s + "\x00"))
+	b := make([]byte, 2*len(u))
+	for i, c := range u {
+		binary.LittleEndian.PutUint16(b[2*i:], c)
+	}
+	return b
+}
+
+// DecodeUTF16 returns the UTF-16LE string in b, up to its NUL terminator,
+// if any.
+func DecodeUTF16(b []byte) string {
+	u := make([]uint16, 0, len(b)/2)
+	for i := 0; i+1 < len(b); i += 2 {
+		c := binary.LittleEndian.Uint16(b[i:])
+		if c == 0 {
+			break
+		}
+		u = append(u, c)
+	}
+	return string(utf16.Decode(u))
+}
+
+// ParseGUID returns the binary form of a GUID, whose first three fields
+// are little endian, as in GPTs and device paths.
+func ParseGUID(s string) ([16]byte, error) {
+	var g [16]byte
+	if !guidRE.MatchString(s) {
+		return g, fmt.Errorf("invalid GUID %q", s)
+	}
+	b, err := hex.DecodeString(strings.ReplaceAll(s, "-", ""))
+	if err != nil {
+		return g, fmt.Errorf("invalid GUID %q", s)
+	}
+	binary.LittleEndian.PutUint32(g[:], binary.BigEndian.Uint32(b))
+	binary.LittleEndian.PutUint16(g[4:], binary.BigEndian.Uint16(b[4:]))
+	binary.LittleEndian.PutUint16(g[6:], binary.BigEndian.Uint16(b[6:]))
+	copy(g[8:], b[8:])
+	return g, nil
+}
+
+// GUIDString returns the text form of the binary GUID b.
+func GUIDString(b []byte) string {
+	return fmt.Sprintf("%08x-%04x-%04x-%x-%x",
+		binary.LittleEndian.Uint32(b), binary.LittleEndian.Uint16(b[4:]), binary.LittleEndian.Uint16(b[6:]), b[8:10], b[10:16])
+}
@@ -0,0 +1,148 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package efivarfs reads and writes UEFI variables through efivarfs.
+//
+// Each variable is a file named Name-GUID. The first four bytes of the file
+// are the variable's attributes, the rest its data. Writes must set both in
+// a single write.
+//
+// For more, see:
+// https://www.kernel.org/doc/html/latest/filesystems/efivarfs.html
+package efivarfs
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Path is where efivarfs is mounted. It can be overridden for testing.
+var Path = "/sys/firmware/efi/efivars"
+
+// GlobalGUID is the vendor GUID of the variables defined by the UEFI
+// specification, such as BootOrder.
+const GlobalGUID = "8be4df61-93ca-11d2-aa0d-00e098032b8c"
+
+// KnownGUIDs names some common vendor GUIDs.
+var KnownGUIDs = map[string]string{
+	GlobalGUID:                             "EFI Global Variable",
+	"d719b2cb-3d3a-4596-a3bc-dad00e67656f": "EFI Image Security Database",
+	"605dab50-e046-4300-abb6-3dd810dd8b23": "Shim",
+	"4a67b082-0a4c-41cf-b6c7-440b29bb8c4f": "Linux Boot Loader",
+}
+
+// Attributes are the attributes of a variable.
+type Attributes uint32
+
+// Attributes, as defined by the UEFI specification.
+const (
+	AttributeNonVolatile                       Attributes = 0x00000001
+	AttributeBootserviceAccess                 Attributes = 0x00000002
+	AttributeRuntimeAccess                     Attributes = 0x00000004
+	AttributeHardwareErrorRecord               Attributes = 0x00000008
+	AttributeAuthenticatedWriteAccess          Attributes = 0x00000010
+	AttributeTimeBasedAuthenticatedWriteAccess Attributes = 0x00000020
+	AttributeAppendWrite                       Attributes = 0x00000040
+)
+
+// DefaultAttributes are the attributes of most persistent variables.
+const DefaultAttributes = AttributeNonVolatile | AttributeBootserviceAccess | AttributeRuntimeAccess
+
+var attributeNames = []struct {
+	a     Attributes
+	short string
+	long  string
+}{
+	{AttributeNonVolatile, "NV", "Non-Volatile"},
+	{AttributeBootserviceAccess, "BS", "Boot Service Access"},
+	{AttributeRuntimeAccess, "RT", "Runtime Service Access"},
+	{AttributeHardwareErrorRecord, "HR", "Hardware Error Record"},
+	{AttributeAuthenticatedWriteAccess, "AW", "Authenticated Write Access"},
+	{AttributeTimeBasedAuthenticatedWriteAccess, "AT", "Time-Based Authenticated Write Access"},
+	{AttributeAppendWrite, "AP", "Append Write"},
+}
+
+// String returns the short names of the attributes, as in "NV,BS,RT".
+func (a Attributes) String() string {
+	var n []string
+	for _, an := range attributeNames {
+		if a&an.a != 0 {
+			n = append(n, an.short)
+			a &^= an.a
+		}
+	}
+	if a != 0 {
+		n = append(n, fmt.Sprintf("%#x", uint32(a)))
+	}
+	return strings.Join(n, ",")
+}
+
+// Names returns the long names of the attributes.
+func (a Attributes) Names() []string {
+	var n []string
+	for _, an := range attributeNames {
+		if a&an.a != 0 {
+			n = append(n, an.long)
+		}
+	}
+	return n
+}
+
+// ParseAttributes parses attributes in the format returned by String.
+func ParseAttributes(s string) (Attributes, error) {
+	var a Attributes
+	for _, f := range strings.Split(s, ",") {
+		if f == "" {
+			continue
+		}
+		found := false
+		for _, an := range attributeNames {
+			if strings.EqualFold(f, an.short) {
+				a |= an.a
+				found = true
+			}
+		}
+		if !found {
+			return 0, fmt.Errorf("unknown attribute %q, want one of NV, BS, RT, HR, AW, AT, AP", f)
+		}
+	}
+	return a, nil
+}
+
+// Var identifies a variable.
+type Var struct {
+	Name string
+	GUID string
+}
+
+var guidRE = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+const guidLen = 36
+
+// ParseVar parses a variable name. Both the efivarfs order, Name-GUID, and
+// the order used by the efivar tool, GUID-Name, are accepted. A name
+// without a GUID is a global variable.
+func ParseVar(s string) (Var, error) {
+	if len(s) > guidLen+1 {
+		if g := s[len(s)-guidLen:]; guidRE.MatchString(g) && s[len(s)-guidLen-1] == '-' {
+			return Var{Name: s[:len(s)-guidLen-1], GUID: strings.ToLower(g)}, nil
+		}
+		if g := s[:guidLen]; guidRE.MatchString(g) && s[guidLen] == '-' {
+			return Var{Name: s[guidLen+1:], GUID: strings.ToLower(g)}, nil
+		}
+	}
+	if s == "" || strings.ContainsAny(s, "/") {
+		return Var{}, fmt.Errorf("invalid variable name %q", s)
+	}
+	if guidRE.MatchString(s) {
+		return Var{}, fmt.Errorf("variable %q has no name", s)
+	}
+	return Var{Name: s, GUID: GlobalGUID}, nil
+}
+
+// String returns the variable's file name in efivarfs.
+func (v Var) String() string {
+	return v.Name + "-" + v.GUID
+}
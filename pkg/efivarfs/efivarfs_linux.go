@@ -0,0 +1,187 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package efivarfs
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"golang.org/x/sys/unix"
+)
+
+// fsImmutableFL is FS_IMMUTABLE_FL from linux/fs.h. efivarfs sets it on
+// all variables but those the kernel knows are safe to delete, since
+// deleting some variables bricks some machines.
+const fsImmutableFL = 0x10
+
+// efivarfsMagic is EFIVARFS_MAGIC from linux/magic.h.
+const efivarfsMagic = 0xde5e81e4
+
+// ErrNotFound is returned when a variable does not exist.
+var ErrNotFound = errors.New("variable not found")
+
+func path(v Var) string {
+	return filepath.Join(Path, v.String())
+}
+
+// List returns all variables, sorted by GUID and name.
+func List() ([]Var, error) {
+	fis, err := ioutil.ReadDir(Path)
+	if err != nil {
+		return nil, err
+	}
+	var vars []Var
+	for _, fi := range fis {
+		n := fi.Name()
+		if len(n) <= guidLen+1 || !guidRE.MatchString(n[len(n)-guidLen:]) {
+			continue
+		}
+		vars = append(vars, Var{Name: n[:len(n)-guidLen-1], GUID: n[len(n)-guidLen:]})
+	}
+	sort.Slice(vars, func(i, j int) bool {
+		if vars[i].GUID != vars[j].GUID {
+			return vars[i].GUID < vars[j].GUID
+		}
+		return vars[i].Name < vars[j].Name
+	})
+	return vars, nil
+}
+
+// Read returns the attributes and data of a variable.
+func Read(v Var) (Attributes, []byte, error) {
+	b, err := ioutil.ReadFile(path(v))
+	if os.IsNotExist(err) {
+		return 0, nil, fmt.Errorf("%v: %w", v, ErrNotFound)
+	}
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(b) < 4 {
+		return 0, nil, fmt.Errorf("%v: short variable of %d bytes", v, len(b))
+	}
+	return Attributes(binary.LittleEndian.Uint32(b)), b[4:], nil
+}
+
+// Write creates or replaces a variable. With AttributeAppendWrite, data is
+// appended to the variable instead.
+func Write(v Var, attrs Attributes, data []byte) error {
+	p := path(v)
+	restore, err := makeMutable(p)
+	if err != nil {
+		return err
+	}
+	defer restore()
+
+	flags := os.O_WRONLY | os.O_CREATE
+	if attrs&AttributeAppendWrite != 0 {
+		flags |= os.O_APPEND
+	}
+	f, err := os.OpenFile(p, flags, 0644)
+	if err != nil {
+		return err
+	}
+	if attrs&AttributeAppendWrite == 0 {
+		if err := truncate(f); err != nil {
+			f.Close()
+			return err
+		}
+	}
+	b := make([]byte, 4+len(data))
+	binary.LittleEndian.PutUint32(b, uint32(attrs))
+	copy(b[4:], data)
+	// The firmware only sees one SetVariable call per write(2), so a
+	// short write must not be retried.
+	n, err := unix.Write(int(f.Fd()), b)
+	if err == nil && n != len(b) {
+		err = fmt.Errorf("short write of %d/%d bytes", n, len(b))
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return fmt.Errorf("writing %v: %v", v, err)
+	}
+	return nil
+}
+
+// Delete removes a variable.
+func Delete(v Var) error {
+	p := path(v)
+	if _, err := makeMutable(p); err != nil {
+		return err
+	}
+	if err := os.Remove(p); os.IsNotExist(err) {
+		return fmt.Errorf("%v: %w", v, ErrNotFound)
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+// truncate empties f, unless it is in efivarfs, where each write replaces
+// the variable anyway. Other file systems are used for testing.
+func truncate(f *os.File) error {
+	var st unix.Statfs_t
+	if err := unix.Fstatfs(int(f.Fd()), &st); err != nil {
+		return err
+	}
+	if uint32(st.Type) == efivarfsMagic {
+		return nil
+	}
+	return f.Truncate(0)
+}
+
+// IsImmutable returns whether the immutable flag is set on a variable.
+func IsImmutable(v Var) (bool, error) {
+	f, err := os.Open(path(v))
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+	flags, err := unix.IoctlGetUint32(int(f.Fd()), unix.FS_IOC_GETFLAGS)
+	if err == unix.ENOTTY || err == unix.EOPNOTSUPP {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return flags&fsImmutableFL != 0, nil
+}
+
+// makeMutable clears the immutable flag of the file at p, if it exists and
+// has it set. The returned function sets it again.
+func makeMutable(p string) (func(), error) {
+	nop := func() {}
+	f, err := os.Open(p)
+	if os.IsNotExist(err) {
+		return nop, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	flags, err := unix.IoctlGetUint32(int(f.Fd()), unix.FS_IOC_GETFLAGS)
+	if err != nil || flags&fsImmutableFL == 0 {
+		// Not supported, as on file systems used for testing.
+		return nop, nil
+	}
+	if err := unix.IoctlSetPointerInt(int(f.Fd()), unix.FS_IOC_SETFLAGS, int(flags&^fsImmutableFL)); err != nil {
+		return nil, fmt.Errorf("clearing immutable flag of %s: %v", p, err)
+	}
+	return func() {
+		f, err := os.Open(p)
+		if err != nil {
+			// Deleted, or replaced by a removable variable.
+			return
+		}
+		defer f.Close()
+		unix.IoctlSetPointerInt(int(f.Fd()), unix.FS_IOC_SETFLAGS, int(flags))
+	}, nil
+}
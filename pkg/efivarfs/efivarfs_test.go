@@ -0,0 +1,117 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package efivarfs
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestParseVar(t *testing.T) {
+	const shim = "605dab50-e046-4300-abb6-3dd810dd8b23"
+	for _, tt := range []struct {
+		in   string
+		want Var
+		err  bool
+	}{
+		{in: "BootOrder", want: Var{"BootOrder", GlobalGUID}},
+		{in: "BootOrder-" + GlobalGUID, want: Var{"BootOrder", GlobalGUID}},
+		{in: GlobalGUID + "-Boot0001", want: Var{"Boot0001", GlobalGUID}},
+		{in: "MokList-605DAB50-E046-4300-ABB6-3DD810DD8B23", want: Var{"MokList", shim}},
+		{in: "", err: true},
+		{in: "a/b", err: true},
+		{in: shim, err: true},
+	} {
+		got, err := ParseVar(tt.in)
+		if (err != nil) != tt.err {
+			t.Errorf("ParseVar(%q) err = %v, want error %v", tt.in, err, tt.err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseVar(%q) = %+v, want %+v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestAttributes(t *testing.T) {
+	a := DefaultAttributes | AttributeAppendWrite
+	if got, want := a.String(), "NV,BS,RT,AP"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+	p, err := ParseAttributes("nv,BS,rt,AP")
+	if err != nil || p != a {
+		t.Errorf("ParseAttributes = %v, %v, want %v", p, err, a)
+	}
+	if _, err := ParseAttributes("NV,XX"); err == nil {
+		t.Errorf("ParseAttributes(NV,XX) succeeded")
+	}
+	if got := Attributes(0x100).String(); got != "0x100" {
+		t.Errorf("String() of unknown bit = %q", got)
+	}
+}
+
+func TestReadWrite(t *testing.T) {
+	dir, err := ioutil.TempDir("", "efivarfs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	Path = dir
+
+	// Not a variable.
+	if err := ioutil.WriteFile(filepath.Join(dir, "README"), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	order := Var{"BootOrder", GlobalGUID}
+	if err := Write(order, DefaultAttributes, []byte{1, 0, 0, 0}); err != nil {
+		t.Fatal(err)
+	}
+	if err := Write(order, DefaultAttributes, []byte{0, 0, 1, 0}); err != nil {
+		t.Fatal(err)
+	}
+	mok := Var{"MokList", "605dab50-e046-4300-abb6-3dd810dd8b23"}
+	if err := Write(mok, AttributeBootserviceAccess, []byte("a")); err != nil {
+		t.Fatal(err)
+	}
+
+	vars, err := List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []Var{mok, order}; !reflect.DeepEqual(vars, want) {
+		t.Errorf("List() = %v, want %v", vars, want)
+	}
+
+	a, d, err := Read(order)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a != DefaultAttributes || !bytes.Equal(d, []byte{0, 0, 1, 0}) {
+		t.Errorf("Read(%v) = %v, %x", order, a, d)
+	}
+	a, d, err = Read(mok)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a != AttributeBootserviceAccess || string(d) != "a" {
+		t.Errorf("Read(%v) = %v, %q", mok, a, d)
+	}
+
+	if err := Delete(order); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := Read(order); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Read after Delete: err = %v, want ErrNotFound", err)
+	}
+	if err := Delete(order); !errors.Is(err, ErrNotFound) {
+		t.Errorf("second Delete: err = %v, want ErrNotFound", err)
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"strings"
 
 	"github.com/u-root/u-root/pkg/ls"
 )
@@ -52,6 +53,11 @@ type finder struct {
 	debug      func(string, ...interface{})
 	files      chan *File
 	sendErrors bool
+
+	// minDepth and maxDepth bound the depth of the files found below
+	// the root, which is at depth 0. maxDepth is -1 for no limit.
+	minDepth int
+	maxDepth int
 }
 
 type Set func(*finder)
@@ -111,6 +117,32 @@ func WithModeMatch(mode, modeMask os.FileMode) Set {
 	}
 }
 
+// WithMinDepth ensures only files at least depth levels below the root are
+// returned. The root itself is at depth 0.
+func WithMinDepth(depth int) Set {
+	return func(f *finder) {
+		f.minDepth = depth
+	}
+}
+
+// WithMaxDepth ensures only files at most depth levels below the root are
+// returned, and does not descend any further. The root itself is at depth
+// 0.
+func WithMaxDepth(depth int) Set {
+	return func(f *finder) {
+		f.maxDepth = depth
+	}
+}
+
+// depth returns how many levels below the root n is.
+func (f *finder) depth(n string) int {
+	rel, err := filepath.Rel(f.root, n)
+	if err != nil || rel == "." {
+		return 0
+	}
+	return strings.Count(rel, string(filepath.Separator)) + 1
+}
+
 // WithDebugLog logs messages to l.
 func WithDebugLog(l func(string, ...interface{})) Set {
 	return func(f *finder) {
@@ -134,6 +166,7 @@ func Find(ctx context.Context, opt ...Set) <-chan *File {
 		files:      make(chan *File, 128),
 		match:      filepath.Match,
 		sendErrors: true,
+		maxDepth:   -1,
 	}
 
 	for _, o := range opt {
@@ -149,6 +182,18 @@ func Find(ctx context.Context, opt ...Set) <-chan *File {
 				return nil
 			}
 
+			// Directories at the maximum depth are found, but not
+			// descended into.
+			var next error
+			depth := f.depth(n)
+			if f.maxDepth >= 0 && depth >= f.maxDepth && fi != nil && fi.IsDir() {
+				next = filepath.SkipDir
+			}
+			if depth < f.minDepth {
+				f.debug("%s: depth %d is less than %d", n, depth, f.minDepth)
+				return next
+			}
+
 			file := &File{
 				Name:     n,
 				FileInfo: fi,
@@ -162,18 +207,18 @@ func Find(ctx context.Context, opt ...Set) <-chan *File {
 					m, err := f.match(f.pattern, n)
 					if err != nil {
 						f.debug("%s: err on matching: %v", n, err)
-						return nil
+						return next
 					}
 					if !m {
 						f.debug("%s: name does not match %q", n, f.pattern)
-						return nil
+						return next
 					}
 				}
 				m := fi.Mode()
 				f.debug("%s: file mode %v / want mode %s with mask %s", n, m, f.mode, f.modeMask)
 				if masked := m & f.modeMask; masked != f.mode {
 					f.debug("%s: mode %s (masked %s) does not match expected mode %s", n, m, masked, f.mode)
-					return nil
+					return next
 				}
 				f.debug("Found: %s", n)
 			}
@@ -182,7 +227,7 @@ func Find(ctx context.Context, opt ...Set) <-chan *File {
 				return fmt.Errorf("should never be returned to user: stop walking")
 
 			case f.files <- file:
-				return nil
+				return next
 			}
 		})
 		close(f.files)
@@ -61,6 +61,23 @@ func TestSimple(t *testing.T) {
 			opts:  WithFilenameMatch("*file"),
 			names: []string{"/root/xyz/file"},
 		},
+		{
+			name: "max depth",
+			opts: WithMaxDepth(1),
+			names: []string{
+				"",
+				"/root",
+			},
+		},
+		{
+			name: "min depth",
+			opts: WithMinDepth(2),
+			names: []string{
+				"/root/xyz",
+				"/root/xyz/0777",
+				"/root/xyz/file",
+			},
+		},
 	}
 	d, err := ioutil.TempDir(os.TempDir(), "u-root.cmds.find")
 	if err != nil {
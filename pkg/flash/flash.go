@@ -0,0 +1,280 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package flash reads, erases and writes flash chips.
+//
+// Chips are accessed through Linux MTD devices, which also covers the
+// Intel PCH SPI controller when the intel-spi driver exposes it, or
+// directly over spidev with the common SPI NOR commands.
+//
+// Writes compare the new image with the chip one erase block at a time
+// and only erase and program the blocks that differ. A layout, in the
+// format of flashrom's -l files, restricts a write to some regions.
+package flash
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Chip is a flash chip.
+type Chip interface {
+	io.ReaderAt
+	// WriteAt programs bytes, which only clears bits. The area must
+	// have been erased first to set bytes to arbitrary values.
+	io.WriterAt
+	// Erase sets the n bytes at off to 0xff. Both must be multiples
+	// of EraseSize.
+	Erase(off, n int64) error
+	// Size is the size of the chip in bytes.
+	Size() int64
+	// EraseSize is the size of the erase blocks.
+	EraseSize() int64
+	// WriteProtected returns whether some of the chip cannot be
+	// written, and a description of the protection.
+	WriteProtected() (bool, string, error)
+	Close() error
+}
+
+// Region is a named range of a chip, from Start to End inclusive.
+type Region struct {
+	Start int64
+	End   int64
+	Name  string
+}
+
+// ParseLayout parses a flashrom layout file, with lines such as
+//
+//	00000000:00000fff RO_VPD
+//
+// Empty lines and lines starting with # are skipped.
+func ParseLayout(r io.Reader) ([]Region, error) {
+	var rs []Region
+	s := bufio.NewScanner(r)
+	for n := 1; s.Scan(); n++ {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		f := strings.Fields(line)
+		if len(f) != 2 {
+			return nil, fmt.Errorf("layout line %d: want START:END NAME, got %q", n, line)
+		}
+		se := strings.Split(f[0], ":")
+		if len(se) != 2 {
+			return nil, fmt.Errorf("layout line %d: want START:END, got %q", n, f[0])
+		}
+		start, err := strconv.ParseInt(strings.TrimPrefix(se[0], "0x"), 16, 64)
+		if err != nil {
+			return nil, fmt.Errorf("layout line %d: %v", n, err)
+		}
+		end, err := strconv.ParseInt(strings.TrimPrefix(se[1], "0x"), 16, 64)
+		if err != nil {
+			return nil, fmt.Errorf("layout line %d: %v", n, err)
+		}
+		if end < start {
+			return nil, fmt.Errorf("layout line %d: region %s ends before it starts", n, f[1])
+		}
+		rs = append(rs, Region{Start: start, End: end, Name: f[1]})
+	}
+	return rs, s.Err()
+}
+
+// Select returns the regions named in names.
+func Select(layout []Region, names ...string) ([]Region, error) {
+	var rs []Region
+	for _, n := range names {
+		found := false
+		for _, r := range layout {
+			if r.Name == n {
+				rs = append(rs, r)
+				found = true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("no region %q in layout", n)
+		}
+	}
+	return rs, nil
+}
+
+// ranges returns the regions to work on, the whole chip if there are none.
+func ranges(c Chip, regions []Region) ([]Region, error) {
+	if len(regions) == 0 {
+		return []Region{{Start: 0, End: c.Size() - 1, Name: "chip"}}, nil
+	}
+	for _, r := range regions {
+		if r.Start < 0 || r.End >= c.Size() {
+			return nil, fmt.Errorf("region %s [%#x-%#x] is outside the %#x byte chip", r.Name, r.Start, r.End, c.Size())
+		}
+	}
+	return regions, nil
+}
+
+// Read reads the whole chip.
+func Read(c Chip) ([]byte, error) {
+	b := make([]byte, c.Size())
+	if _, err := c.ReadAt(b, 0); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// Verify compares the regions of the chip, or the whole chip, with img,
+// an image of the whole chip.
+func Verify(c Chip, img []byte, regions ...Region) error {
+	if int64(len(img)) != c.Size() {
+		return fmt.Errorf("image is %d bytes, chip is %d", len(img), c.Size())
+	}
+	rs, err := ranges(c, regions)
+	if err != nil {
+		return err
+	}
+	for _, r := range rs {
+		b := make([]byte, r.End-r.Start+1)
+		if _, err := c.ReadAt(b, r.Start); err != nil {
+			return err
+		}
+		if i := mismatch(b, img[r.Start:r.End+1]); i >= 0 {
+			return fmt.Errorf("region %s: verify failed at %#x: read %#02x, want %#02x", r.Name, r.Start+int64(i), b[i], img[r.Start+int64(i)])
+		}
+	}
+	return nil
+}
+
+func mismatch(a, b []byte) int {
+	for i := range a {
+		if a[i] != b[i] {
+			return i
+		}
+	}
+	return -1
+}
+
+// Stats counts the work done by Write.
+type Stats struct {
+	// Blocks is the number of erase blocks compared.
+	Blocks int
+	// Erased is the number of erase blocks erased.
+	Erased int
+	// Written is the number of bytes programmed.
+	Written int64
+}
+
+// Write writes the regions of img, an image of the whole chip, to the
+// chip, or all of it if no region is given. Blocks which already hold the
+// right content are skipped and blocks only erased when a bit must change
+// from 0 to 1. The regions are verified afterwards.
+func Write(c Chip, img []byte, regions ...Region) (*Stats, error) {
+	if int64(len(img)) != c.Size() {
+		return nil, fmt.Errorf("image is %d bytes, chip is %d", len(img), c.Size())
+	}
+	rs, err := ranges(c, regions)
+	if err != nil {
+		return nil, err
+	}
+	// want is the chip as it should be: its current content, with
+	// the regions replaced. Only the blocks the regions touch are
+	// read.
+	es := c.EraseSize()
+	blocks := map[int64]bool{}
+	for _, r := range rs {
+		for b := r.Start / es; b <= r.End/es; b++ {
+			blocks[b] = true
+		}
+	}
+	st := &Stats{}
+	for b := int64(0); b < c.Size()/es; b++ {
+		if !blocks[b] {
+			continue
+		}
+		st.Blocks++
+		off := b * es
+		cur := make([]byte, es)
+		if _, err := c.ReadAt(cur, off); err != nil {
+			return st, err
+		}
+		want := append([]byte{}, cur...)
+		for _, r := range rs {
+			s, e := max(r.Start, off), min(r.End+1, off+es)
+			if s < e {
+				copy(want[s-off:e-off], img[s:e])
+			}
+		}
+		if bytes.Equal(cur, want) {
+			continue
+		}
+		needErase := false
+		for i := range cur {
+			if ^cur[i]&want[i] != 0 {
+				needErase = true
+				break
+			}
+		}
+		if needErase {
+			if err := c.Erase(off, es); err != nil {
+				return st, fmt.Errorf("erasing block at %#x: %v", off, err)
+			}
+			st.Erased++
+			for i := range cur {
+				cur[i] = 0xff
+			}
+		}
+		// Program the changed span only, skipping bytes already
+		// erased to 0xff.
+		first, last := -1, -1
+		for i := range cur {
+			if cur[i] != want[i] {
+				if first < 0 {
+					first = i
+				}
+				last = i
+			}
+		}
+		if first >= 0 {
+			if _, err := c.WriteAt(want[first:last+1], off+int64(first)); err != nil {
+				return st, fmt.Errorf("writing block at %#x: %v", off, err)
+			}
+			st.Written += int64(last - first + 1)
+		}
+	}
+	return st, Verify(c, img, rs...)
+}
+
+// Erase erases the regions of the chip, or all of it. Regions must be
+// aligned to erase blocks.
+func Erase(c Chip, regions ...Region) error {
+	rs, err := ranges(c, regions)
+	if err != nil {
+		return err
+	}
+	es := c.EraseSize()
+	for _, r := range rs {
+		if r.Start%es != 0 || (r.End+1)%es != 0 {
+			return fmt.Errorf("region %s [%#x-%#x] is not aligned to the %#x byte erase blocks", r.Name, r.Start, r.End, es)
+		}
+		if err := c.Erase(r.Start, r.End-r.Start+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func min(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max(a, b int64) int64 {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,147 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package flash
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// memChip is a Chip in memory which, like flash, can only clear bits when
+// writing.
+type memChip struct {
+	b       []byte
+	es      int64
+	erases  int
+	written int
+}
+
+func newMemChip(size, es int64) *memChip {
+	return &memChip{b: bytes.Repeat([]byte{0xff}, int(size)), es: es}
+}
+
+func (m *memChip) ReadAt(b []byte, off int64) (int, error) {
+	return copy(b, m.b[off:]), nil
+}
+
+func (m *memChip) WriteAt(b []byte, off int64) (int, error) {
+	for i, c := range b {
+		m.b[off+int64(i)] &= c
+	}
+	m.written += len(b)
+	return len(b), nil
+}
+
+func (m *memChip) Erase(off, n int64) error {
+	if off%m.es != 0 || n%m.es != 0 {
+		return fmt.Errorf("unaligned erase")
+	}
+	for i := off; i < off+n; i++ {
+		m.b[i] = 0xff
+	}
+	m.erases += int(n / m.es)
+	return nil
+}
+
+func (m *memChip) Size() int64                           { return int64(len(m.b)) }
+func (m *memChip) EraseSize() int64                      { return m.es }
+func (m *memChip) WriteProtected() (bool, string, error) { return false, "", nil }
+func (m *memChip) Close() error                          { return nil }
+
+func TestParseLayout(t *testing.T) {
+	l, err := ParseLayout(strings.NewReader("# flash map\n00000000:00000fff RO_VPD\n\n0x1000:0x1fff RW_VPD\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Region{{0, 0xfff, "RO_VPD"}, {0x1000, 0x1fff, "RW_VPD"}}
+	if !reflect.DeepEqual(l, want) {
+		t.Errorf("ParseLayout() = %v, want %v", l, want)
+	}
+	if r, err := Select(l, "RW_VPD"); err != nil || !reflect.DeepEqual(r, want[1:]) {
+		t.Errorf("Select(RW_VPD) = %v, %v, want %v", r, err, want[1:])
+	}
+	if _, err := Select(l, "BIOS"); err == nil {
+		t.Errorf("Select(BIOS) succeeded")
+	}
+	for _, bad := range []string{"0:fff", "0-fff X", "0:zz X", "fff:0 X"} {
+		if _, err := ParseLayout(strings.NewReader(bad)); err == nil {
+			t.Errorf("ParseLayout(%q) succeeded", bad)
+		}
+	}
+}
+
+func TestWrite(t *testing.T) {
+	c := newMemChip(4*1024, 1024)
+	img := bytes.Repeat([]byte{0xa5}, 4*1024)
+	st, err := Write(c, img)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The chip is erased, so nothing needs erasing.
+	if st.Blocks != 4 || st.Erased != 0 || st.Written != 4*1024 {
+		t.Errorf("first write: %+v", st)
+	}
+
+	// Unchanged blocks are skipped.
+	img[1500] = 0x00
+	if st, err = Write(c, img); err != nil {
+		t.Fatal(err)
+	}
+	if st.Erased != 0 || st.Written != 1 {
+		t.Errorf("clearing bits: %+v, want no erase and 1 byte written", st)
+	}
+	img[1500] = 0xff
+	if st, err = Write(c, img); err != nil {
+		t.Fatal(err)
+	}
+	if st.Erased != 1 || st.Written != 1024 {
+		t.Errorf("setting bits: %+v, want 1 erase and 1024 bytes written", st)
+	}
+	if err := Verify(c, img); err != nil {
+		t.Error(err)
+	}
+
+	// A region write leaves the rest of each touched block alone.
+	img2 := bytes.Repeat([]byte{0x11}, 4*1024)
+	if st, err = Write(c, img2, Region{Start: 1024 + 512, End: 2048 + 99, Name: "r"}); err != nil {
+		t.Fatal(err)
+	}
+	if st.Blocks != 2 {
+		t.Errorf("region write compared %d blocks, want 2", st.Blocks)
+	}
+	want := append(append(append([]byte{}, img[:1536]...), img2[1536:2148]...), img[2148:]...)
+	if !bytes.Equal(c.b, want) {
+		t.Errorf("region write changed bytes outside the region")
+	}
+	if err := Verify(c, img); err == nil {
+		t.Errorf("Verify of the old image succeeded")
+	}
+
+	if _, err := Write(c, img[:10]); err == nil {
+		t.Errorf("Write of short image succeeded")
+	}
+	if _, err := Write(c, img, Region{Start: 0, End: 4096}); err == nil {
+		t.Errorf("Write of region past the end succeeded")
+	}
+}
+
+func TestErase(t *testing.T) {
+	c := newMemChip(4*1024, 1024)
+	if _, err := Write(c, make([]byte, 4*1024)); err != nil {
+		t.Fatal(err)
+	}
+	if err := Erase(c, Region{Start: 1024, End: 2047}); err != nil {
+		t.Fatal(err)
+	}
+	if c.b[1023] != 0 || c.b[1024] != 0xff || c.b[2047] != 0xff || c.b[2048] != 0 {
+		t.Errorf("Erase touched the wrong bytes")
+	}
+	if err := Erase(c, Region{Start: 1, End: 1024}); err == nil {
+		t.Errorf("unaligned Erase succeeded")
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package flash
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// MTD ioctls, from include/uapi/mtd/mtd-abi.h.
+const (
+	memGetInfo  = 0x80204d01
+	memErase    = 0x40084d02
+	memIsLocked = 0x80084d17
+
+	// mtdWriteable is set in the flags of writable devices.
+	mtdWriteable = 0x400
+)
+
+type mtdInfo struct {
+	Type      uint8
+	_         [3]uint8
+	Flags     uint32
+	Size      uint32
+	EraseSize uint32
+	WriteSize uint32
+	OOBSize   uint32
+	_         uint64
+}
+
+type eraseInfo struct {
+	Start  uint32
+	Length uint32
+}
+
+// MTD is a flash chip, or a partition of one, behind a Linux MTD device.
+type MTD struct {
+	f    *os.File
+	info mtdInfo
+}
+
+var _ Chip = &MTD{}
+
+// OpenMTD opens an MTD character device such as /dev/mtd0.
+func OpenMTD(dev string) (*MTD, error) {
+	f, err := os.OpenFile(dev, os.O_RDWR, 0)
+	if os.IsPermission(err) {
+		f, err = os.Open(dev)
+	}
+	if err != nil {
+		return nil, err
+	}
+	m := &MTD{f: f}
+	if _, _, errno := unix.Syscall(unix.SYS_IOCTL, f.Fd(), memGetInfo, uintptr(unsafe.Pointer(&m.info))); errno != 0 {
+		f.Close()
+		return nil, fmt.Errorf("%s: MEMGETINFO: %v", dev, errno)
+	}
+	return m, nil
+}
+
+// MTDDevices returns the MTD character devices and their names, e.g.
+// "/dev/mtd0" and "BIOS".
+func MTDDevices() (map[string]string, error) {
+	ns, err := filepath.Glob("/sys/class/mtd/mtd*/name")
+	if err != nil {
+		return nil, err
+	}
+	devs := map[string]string{}
+	for _, n := range ns {
+		dir := filepath.Base(filepath.Dir(n))
+		// mtdNro are the read-only aliases.
+		if strings.HasSuffix(dir, "ro") {
+			continue
+		}
+		b, err := ioutil.ReadFile(n)
+		if err != nil {
+			return nil, err
+		}
+		devs[filepath.Join("/dev", dir)] = strings.TrimSpace(string(b))
+	}
+	return devs, nil
+}
+
+// ReadAt implements io.ReaderAt.
+func (m *MTD) ReadAt(b []byte, off int64) (int, error) {
+	return m.f.ReadAt(b, off)
+}
+
+// WriteAt implements io.WriterAt.
+func (m *MTD) WriteAt(b []byte, off int64) (int, error) {
+	return m.f.WriteAt(b, off)
+}
+
+// Erase implements Chip.Erase.
+func (m *MTD) Erase(off, n int64) error {
+	es := m.EraseSize()
+	if off%es != 0 || n%es != 0 {
+		return fmt.Errorf("erase of %#x bytes at %#x is not aligned to %#x", n, off, es)
+	}
+	e := eraseInfo{Start: uint32(off), Length: uint32(n)}
+	if _, _, errno := unix.Syscall(unix.SYS_IOCTL, m.f.Fd(), memErase, uintptr(unsafe.Pointer(&e))); errno != 0 {
+		return fmt.Errorf("MEMERASE: %v", errno)
+	}
+	return nil
+}
+
+// Size implements Chip.Size.
+func (m *MTD) Size() int64 {
+	return int64(m.info.Size)
+}
+
+// EraseSize implements Chip.EraseSize.
+func (m *MTD) EraseSize() int64 {
+	return int64(m.info.EraseSize)
+}
+
+// WriteProtected implements Chip.WriteProtected. It reports read-only
+// devices and devices with locked blocks, where the driver can tell.
+func (m *MTD) WriteProtected() (bool, string, error) {
+	if m.info.Flags&mtdWriteable == 0 {
+		return true, "device is read-only", nil
+	}
+	e := eraseInfo{Start: 0, Length: m.info.Size}
+	r, _, errno := unix.Syscall(unix.SYS_IOCTL, m.f.Fd(), memIsLocked, uintptr(unsafe.Pointer(&e)))
+	switch {
+	case errno == unix.EOPNOTSUPP || errno == unix.ENOTTY:
+		return false, "writable, the driver does not report locks", nil
+	case errno != 0:
+		return false, "", fmt.Errorf("MEMISLOCKED: %v", errno)
+	case r == 1:
+		return true, "some blocks are locked", nil
+	}
+	return false, "writable, no blocks locked", nil
+}
+
+// Close implements Chip.Close.
+func (m *MTD) Close() error {
+	return m.f.Close()
+}
@@ -0,0 +1,234 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package flash
+
+import (
+	"fmt"
+	"time"
+)
+
+// SPI NOR commands common to all chips.
+const (
+	cmdWriteEnable  = 0x06
+	cmdReadStatus   = 0x05
+	cmdRead         = 0x03
+	cmdPageProgram  = 0x02
+	cmdSectorErase  = 0x20
+	cmdReadJEDECID  = 0x9f
+	statusBusy      = 0x01
+	statusWEL       = 0x02
+	statusBP        = 0x1c
+	statusSRWD      = 0x80
+	norPageSize     = 256
+	norSectorSize   = 4096
+	norMaxSize      = 16 << 20
+	defaultMaxXfer  = 4096
+	progressTimeout = 10 * time.Second
+)
+
+// Transport exchanges bytes with a SPI device. Chip select is held for
+// the whole of one Transfer; rx receives as many bytes as tx sends.
+type Transport interface {
+	Transfer(tx, rx []byte) error
+	Close() error
+}
+
+// NOR is a SPI NOR flash chip with 3 byte addresses, i.e. of up to 16
+// MiB, erased in 4 KiB sectors.
+type NOR struct {
+	t    Transport
+	id   [3]byte
+	size int64
+	// MaxTransfer is the largest transfer the Transport supports.
+	MaxTransfer int
+}
+
+var _ Chip = &NOR{}
+
+// NewNOR identifies the chip on t by its JEDEC ID. The size is taken from
+// the capacity byte of the ID.
+func NewNOR(t Transport) (*NOR, error) {
+	n := &NOR{t: t, MaxTransfer: defaultMaxXfer}
+	rx := make([]byte, 4)
+	if err := t.Transfer([]byte{cmdReadJEDECID, 0, 0, 0}, rx); err != nil {
+		return nil, err
+	}
+	copy(n.id[:], rx[1:])
+	if n.id[0] == 0 || n.id[0] == 0xff {
+		return nil, fmt.Errorf("no SPI flash found, JEDEC ID %#x", n.id)
+	}
+	if n.id[2] < 0x10 || n.id[2] > 0x20 {
+		return nil, fmt.Errorf("JEDEC ID %#x: unknown capacity", n.id)
+	}
+	n.size = 1 << n.id[2]
+	if n.size > norMaxSize {
+		return nil, fmt.Errorf("JEDEC ID %#x: chips larger than 16 MiB need 4 byte addresses, which are not supported", n.id)
+	}
+	return n, nil
+}
+
+// ID returns the JEDEC manufacturer and device ID.
+func (n *NOR) ID() [3]byte {
+	return n.id
+}
+
+func (n *NOR) cmd(c byte, addr int64, tx []byte, rxLen int) ([]byte, error) {
+	b := []byte{c, byte(addr >> 16), byte(addr >> 8), byte(addr)}
+	b = append(b, tx...)
+	b = append(b, make([]byte, rxLen)...)
+	rx := make([]byte, len(b))
+	if err := n.t.Transfer(b, rx); err != nil {
+		return nil, err
+	}
+	return rx[4+len(tx):], nil
+}
+
+func (n *NOR) status() (byte, error) {
+	rx := make([]byte, 2)
+	if err := n.t.Transfer([]byte{cmdReadStatus, 0}, rx); err != nil {
+		return 0, err
+	}
+	return rx[1], nil
+}
+
+// writeEnable sets the write enable latch, which a program or erase
+// clears again.
+func (n *NOR) writeEnable() error {
+	if err := n.t.Transfer([]byte{cmdWriteEnable}, make([]byte, 1)); err != nil {
+		return err
+	}
+	s, err := n.status()
+	if err != nil {
+		return err
+	}
+	if s&statusWEL == 0 {
+		return fmt.Errorf("write enable latch not set, status %#02x", s)
+	}
+	return nil
+}
+
+func (n *NOR) wait() error {
+	deadline := time.Now().Add(progressTimeout)
+	for time.Now().Before(deadline) {
+		s, err := n.status()
+		if err != nil {
+			return err
+		}
+		if s&statusBusy == 0 {
+			return nil
+		}
+		time.Sleep(time.Millisecond)
+	}
+	return fmt.Errorf("flash still busy after %v", progressTimeout)
+}
+
+func (n *NOR) check(l int, off int64) error {
+	if off < 0 || off+int64(l) > n.size {
+		return fmt.Errorf("%d bytes at %#x are outside the %#x byte chip", l, off, n.size)
+	}
+	return nil
+}
+
+// ReadAt implements io.ReaderAt.
+func (n *NOR) ReadAt(b []byte, off int64) (int, error) {
+	if err := n.check(len(b), off); err != nil {
+		return 0, err
+	}
+	chunk := n.MaxTransfer - 4
+	for done := 0; done < len(b); {
+		l := len(b) - done
+		if l > chunk {
+			l = chunk
+		}
+		rx, err := n.cmd(cmdRead, off+int64(done), nil, l)
+		if err != nil {
+			return done, err
+		}
+		done += copy(b[done:], rx)
+	}
+	return len(b), nil
+}
+
+// WriteAt implements io.WriterAt. It programs one page at a time.
+func (n *NOR) WriteAt(b []byte, off int64) (int, error) {
+	if err := n.check(len(b), off); err != nil {
+		return 0, err
+	}
+	for done := 0; done < len(b); {
+		a := off + int64(done)
+		// Programs wrap around at the end of a page.
+		l := norPageSize - int(a%norPageSize)
+		if l > len(b)-done {
+			l = len(b) - done
+		}
+		if l > n.MaxTransfer-4 {
+			l = n.MaxTransfer - 4
+		}
+		if err := n.writeEnable(); err != nil {
+			return done, err
+		}
+		if _, err := n.cmd(cmdPageProgram, a, b[done:done+l], 0); err != nil {
+			return done, err
+		}
+		if err := n.wait(); err != nil {
+			return done, err
+		}
+		done += l
+	}
+	return len(b), nil
+}
+
+// Erase implements Chip.Erase.
+func (n *NOR) Erase(off, l int64) error {
+	if off%norSectorSize != 0 || l%norSectorSize != 0 {
+		return fmt.Errorf("erase of %#x bytes at %#x is not aligned to %#x", l, off, norSectorSize)
+	}
+	if err := n.check(int(l), off); err != nil {
+		return err
+	}
+	for a := off; a < off+l; a += norSectorSize {
+		if err := n.writeEnable(); err != nil {
+			return err
+		}
+		if _, err := n.cmd(cmdSectorErase, a, nil, 0); err != nil {
+			return err
+		}
+		if err := n.wait(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Size implements Chip.Size.
+func (n *NOR) Size() int64 {
+	return n.size
+}
+
+// EraseSize implements Chip.EraseSize.
+func (n *NOR) EraseSize() int64 {
+	return norSectorSize
+}
+
+// WriteProtected implements Chip.WriteProtected from the block protect
+// and status register write disable bits of the status register. What
+// the block protect bits cover depends on the chip.
+func (n *NOR) WriteProtected() (bool, string, error) {
+	s, err := n.status()
+	if err != nil {
+		return false, "", err
+	}
+	bp := (s & statusBP) >> 2
+	desc := fmt.Sprintf("status %#02x, block protect %d", s, bp)
+	if s&statusSRWD != 0 {
+		desc += ", status register locked while WP# is low"
+	}
+	return bp != 0, desc, nil
+}
+
+// Close implements Chip.Close.
+func (n *NOR) Close() error {
+	return n.t.Close()
+}
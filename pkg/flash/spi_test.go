@@ -0,0 +1,118 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package flash
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+)
+
+// fakeNOR simulates the SPI NOR commands NOR uses.
+type fakeNOR struct {
+	mem    []byte
+	id     [3]byte
+	status byte
+}
+
+func (f *fakeNOR) Transfer(tx, rx []byte) error {
+	addr := func() int {
+		return int(tx[1])<<16 | int(tx[2])<<8 | int(tx[3])
+	}
+	switch tx[0] {
+	case cmdReadJEDECID:
+		copy(rx[1:], f.id[:])
+	case cmdReadStatus:
+		rx[1] = f.status
+	case cmdWriteEnable:
+		f.status |= statusWEL
+	case cmdRead:
+		copy(rx[4:], f.mem[addr():])
+	case cmdPageProgram:
+		if f.status&statusWEL == 0 {
+			return fmt.Errorf("program without write enable")
+		}
+		a := addr()
+		for i, c := range tx[4:] {
+			// Wrap around within the page.
+			p := a&^(norPageSize-1) + (a+i)%norPageSize
+			f.mem[p] &= c
+		}
+		f.status &^= statusWEL
+	case cmdSectorErase:
+		if f.status&statusWEL == 0 {
+			return fmt.Errorf("erase without write enable")
+		}
+		a := addr() &^ (norSectorSize - 1)
+		for i := a; i < a+norSectorSize; i++ {
+			f.mem[i] = 0xff
+		}
+		f.status &^= statusWEL
+	default:
+		return fmt.Errorf("unknown command %#02x", tx[0])
+	}
+	return nil
+}
+
+func (f *fakeNOR) Close() error { return nil }
+
+func TestNOR(t *testing.T) {
+	f := &fakeNOR{mem: bytes.Repeat([]byte{0xff}, 64<<10), id: [3]byte{0xef, 0x40, 0x10}}
+	n, err := NewNOR(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 0x10 is 64 KiB.
+	if n.Size() != 64<<10 || n.ID() != f.id {
+		t.Errorf("size %#x, ID %x, want 64 KiB, %x", n.Size(), n.ID(), f.id)
+	}
+	n.MaxTransfer = 100
+
+	img := make([]byte, n.Size())
+	for i := range img {
+		img[i] = byte(i * 7)
+	}
+	if _, err := Write(n, img); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(f.mem, img) {
+		t.Errorf("chip content does not match the image")
+	}
+	img[5000] ^= 0xff
+	st, err := Write(n, img)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st.Erased != 1 {
+		t.Errorf("rewrite erased %d sectors, want 1", st.Erased)
+	}
+	b, err := Read(n)
+	if err != nil || !bytes.Equal(b, img) {
+		t.Errorf("Read() does not match the image, err %v", err)
+	}
+
+	if wp, _, err := n.WriteProtected(); err != nil || wp {
+		t.Errorf("WriteProtected() = %v, %v, want false", wp, err)
+	}
+	f.status = 0x9c
+	if wp, desc, err := n.WriteProtected(); err != nil || !wp {
+		t.Errorf("WriteProtected() = %v, %q, %v, want true", wp, desc, err)
+	}
+
+	if _, err := n.ReadAt(make([]byte, 2), n.Size()-1); err == nil {
+		t.Errorf("read past the end succeeded")
+	}
+	if err := n.Erase(10, norSectorSize); err == nil {
+		t.Errorf("unaligned erase succeeded")
+	}
+}
+
+func TestNORProbe(t *testing.T) {
+	for _, id := range [][3]byte{{0xff, 0xff, 0xff}, {0, 0, 0}, {0xc2, 0x20, 0x19}, {0xc2, 0x20, 0x05}} {
+		if _, err := NewNOR(&fakeNOR{id: id}); err == nil {
+			t.Errorf("NewNOR with ID %x succeeded", id)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package flash
+
+import (
+	"fmt"
+	"os"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// spidev ioctls, from include/uapi/linux/spi/spidev.h.
+const (
+	spiIOCMessage1     = 0x40206b00
+	spiIOCWrMaxSpeedHz = 0x40046b04
+)
+
+// spiIOCTransfer is struct spi_ioc_transfer.
+type spiIOCTransfer struct {
+	TxBuf       uint64
+	RxBuf       uint64
+	Len         uint32
+	SpeedHz     uint32
+	DelayUsecs  uint16
+	BitsPerWord uint8
+	CSChange    uint8
+	TxNbits     uint8
+	RxNbits     uint8
+	WordDelay   uint8
+	_           uint8
+}
+
+// Spidev is a Transport over a Linux spidev device such as
+// /dev/spidev0.0.
+type Spidev struct {
+	f *os.File
+}
+
+var _ Transport = &Spidev{}
+
+// OpenSpidev opens dev. If speed is not zero, it sets the maximum clock
+// in Hz.
+func OpenSpidev(dev string, speed uint32) (*Spidev, error) {
+	f, err := os.OpenFile(dev, os.O_RDWR, 0)
+	if err != nil {
+		return nil, err
+	}
+	if speed != 0 {
+		if _, _, errno := unix.Syscall(unix.SYS_IOCTL, f.Fd(), spiIOCWrMaxSpeedHz, uintptr(unsafe.Pointer(&speed))); errno != 0 {
+			f.Close()
+			return nil, fmt.Errorf("%s: setting speed to %d Hz: %v", dev, speed, errno)
+		}
+	}
+	return &Spidev{f: f}, nil
+}
+
+// Transfer implements Transport.Transfer.
+func (s *Spidev) Transfer(tx, rx []byte) error {
+	if len(tx) != len(rx) {
+		return fmt.Errorf("transfer of %d bytes with a %d byte receive buffer", len(tx), len(rx))
+	}
+	if len(tx) == 0 {
+		return nil
+	}
+	t := spiIOCTransfer{
+		TxBuf: uint64(uintptr(unsafe.Pointer(&tx[0]))),
+		RxBuf: uint64(uintptr(unsafe.Pointer(&rx[0]))),
+		Len:   uint32(len(tx)),
+	}
+	if _, _, errno := unix.Syscall(unix.SYS_IOCTL, s.f.Fd(), spiIOCMessage1, uintptr(unsafe.Pointer(&t))); errno != 0 {
+		return fmt.Errorf("SPI_IOC_MESSAGE: %v", errno)
+	}
+	return nil
+}
+
+// Close implements Transport.Close.
+func (s *Spidev) Close() error {
+	return s.f.Close()
+}
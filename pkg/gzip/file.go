@@ -6,9 +6,11 @@ package gzip
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"strings"
 
+	"github.com/u-root/u-root/pkg/compress"
 	"github.com/u-root/u-root/pkg/uio"
 )
 
@@ -128,20 +130,11 @@ func (f *File) Process() error {
 		fmt.Fprintf(os.Stderr, "%s to %s\n", i.Name(), o.Name())
 	}
 
-	if f.Options.Decompress {
-		if err := Decompress(i, o, f.Options.Blocksize, f.Options.Processes); err != nil {
-			if !f.Options.Stdout {
-				o.Close()
-			}
-			return err
-		}
-	} else {
-		if err := Compress(i, o, f.Options.Level, f.Options.Blocksize, f.Options.Processes); err != nil {
-			if !f.Options.Stdout {
-				o.Close()
-			}
-			return err
+	if err := f.convert(i, o); err != nil {
+		if !f.Options.Stdout {
+			o.Close()
 		}
+		return err
 	}
 
 	if f.Options.Stdout {
@@ -149,3 +142,33 @@ func (f *File) Process() error {
 	}
 	return o.Close()
 }
+
+// convert compresses or decompresses i to o, in the format of the options.
+func (f *File) convert(i io.Reader, o io.Writer) error {
+	format := f.Options.Format
+	if format == nil || format == compress.Gzip {
+		if f.Options.Decompress {
+			return Decompress(i, o, f.Options.Blocksize, f.Options.Processes)
+		}
+		return Compress(i, o, f.Options.Level, f.Options.Blocksize, f.Options.Processes)
+	}
+
+	if f.Options.Decompress {
+		r, err := format.NewReader(i)
+		if err != nil {
+			return err
+		}
+		defer r.Close()
+		_, err = io.Copy(o, r)
+		return err
+	}
+	w, err := format.Writer(o, f.Options.Level)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(w, i); err != nil {
+		w.Close()
+		return err
+	}
+	return w.Close()
+}
@@ -13,6 +13,7 @@ import (
 	"runtime"
 
 	"github.com/klauspost/pgzip"
+	"github.com/u-root/u-root/pkg/compress"
 )
 
 // Options represents the CLI options possible, controlling how
@@ -31,6 +32,29 @@ type Options struct {
 	Test       bool
 	Verbose    bool
 	Suffix     string
+
+	// Format is the compression format, gzip unless the binary is
+	// named after another one, e.g. xz or zstdcat.
+	Format *compress.Format
+}
+
+// alias is how gzip behaves when its binary has another name.
+type alias struct {
+	format     *compress.Format
+	decompress bool
+	stdout     bool
+}
+
+var aliases = map[string]alias{
+	"gunzip":  {compress.Gzip, true, false},
+	"gzcat":   {compress.Gzip, true, true},
+	"zcat":    {compress.Gzip, true, true},
+	"xz":      {compress.Xz, false, false},
+	"unxz":    {compress.Xz, true, false},
+	"xzcat":   {compress.Xz, true, true},
+	"zstd":    {compress.Zstd, false, false},
+	"unzstd":  {compress.Zstd, true, false},
+	"zstdcat": {compress.Zstd, true, true},
 }
 
 // ParseArgs takes CLI args and parses them via a Flagset into fields in
@@ -71,13 +95,27 @@ func (o *Options) ParseArgs(args []string, cmdLine *flag.FlagSet) error {
 		return err
 	}
 
-	return o.validate(len(cmdLine.Args()) > 0)
+	if err := o.validate(len(cmdLine.Args()) > 0); err != nil {
+		return err
+	}
+	// The suffix is the one of the format, unless told otherwise.
+	suffix := false
+	cmdLine.Visit(func(f *flag.Flag) {
+		if f.Name == "S" {
+			suffix = true
+		}
+	})
+	if !suffix {
+		o.Suffix = o.Format.Extensions[0]
+	}
+	return nil
 }
 
 // Validate checks options.
 // Forces decompression to be enabled when test mode is enabled.
 // It further modifies options if the running binary is named
-// gunzip or gzcat to allow for expected behavor. Checks if there is piped stdin data.
+// gunzip or gzcat, or after another format, e.g. xz or zstdcat, to allow for
+// expected behavor. Checks if there is piped stdin data.
 func (o *Options) validate(moreArgs bool) error {
 	if !moreArgs && !o.Force {
 		return fmt.Errorf("gzip: standard output is a terminal -- ignoring")
@@ -92,12 +130,12 @@ func (o *Options) validate(moreArgs bool) error {
 		o.Decompress = true
 	}
 
-	// Support gunzip and gzcat symlinks
-	if filepath.Base(os.Args[0]) == "gunzip" {
-		o.Decompress = true
-	} else if filepath.Base(os.Args[0]) == "gzcat" {
-		o.Decompress = true
-		o.Stdout = true
+	// Support gunzip, gzcat, xz, zstdcat, ... symlinks
+	o.Format = compress.Gzip
+	if a, ok := aliases[filepath.Base(os.Args[0])]; ok {
+		o.Format = a.format
+		o.Decompress = o.Decompress || a.decompress
+		o.Stdout = o.Stdout || a.stdout
 	}
 
 	// no args passed compress stdin to stdout
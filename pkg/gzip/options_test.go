@@ -6,10 +6,12 @@ package gzip
 
 import (
 	"flag"
+	"os"
 	"runtime"
 	"testing"
 
 	"github.com/klauspost/pgzip"
+	"github.com/u-root/u-root/pkg/compress"
 )
 
 func TestOptions_ParseArgs(t *testing.T) {
@@ -167,3 +169,32 @@ func Test_parseLevels(t *testing.T) {
 		})
 	}
 }
+
+func TestOptions_aliases(t *testing.T) {
+	defer func(args []string) { os.Args = args }(os.Args)
+	tests := []struct {
+		args       []string
+		format     *compress.Format
+		decompress bool
+		stdout     bool
+		suffix     string
+	}{
+		{args: []string{"gzip", "f"}, format: compress.Gzip, suffix: ".gz"},
+		{args: []string{"/bbin/zcat", "f"}, format: compress.Gzip, decompress: true, stdout: true, suffix: ".gz"},
+		{args: []string{"xz", "f"}, format: compress.Xz, suffix: ".xz"},
+		{args: []string{"unxz", "-S", ".lzma", "f"}, format: compress.Xz, decompress: true, suffix: ".lzma"},
+		{args: []string{"zstdcat", "f"}, format: compress.Zstd, decompress: true, stdout: true, suffix: ".zst"},
+	}
+	for _, tt := range tests {
+		os.Args = tt.args
+		var o Options
+		if err := o.ParseArgs(tt.args, flag.NewFlagSet(tt.args[0], flag.ContinueOnError)); err != nil {
+			t.Errorf("ParseArgs(%q) = %v", tt.args, err)
+			continue
+		}
+		if o.Format != tt.format || o.Decompress != tt.decompress || o.Stdout != tt.stdout || o.Suffix != tt.suffix {
+			t.Errorf("ParseArgs(%q): format %v, decompress %t, stdout %t, suffix %q, want %v, %t, %t, %q",
+				tt.args, o.Format, o.Decompress, o.Stdout, o.Suffix, tt.format, tt.decompress, tt.stdout, tt.suffix)
+		}
+	}
+}
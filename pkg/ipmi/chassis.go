@@ -0,0 +1,29 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ipmi
+
+const (
+	// Chassis Device Commands
+	BMC_CHASSIS_CONTROL Command = 0x02
+)
+
+// ChassisControl is an action of the Chassis Control command.
+type ChassisControl byte
+
+// Chassis Control actions.
+const (
+	ChassisPowerDown    ChassisControl = 0x00
+	ChassisPowerUp      ChassisControl = 0x01
+	ChassisPowerCycle   ChassisControl = 0x02
+	ChassisHardReset    ChassisControl = 0x03
+	ChassisPulseDiag    ChassisControl = 0x04
+	ChassisSoftShutdown ChassisControl = 0x05
+)
+
+// ChassisControl powers the system up or down, or resets it.
+func (i *IPMI) ChassisControl(c ChassisControl) error {
+	_, err := i.SendRecv(_IPMI_NETFN_CHASSIS, BMC_CHASSIS_CONTROL, []byte{byte(c)})
+	return err
+}
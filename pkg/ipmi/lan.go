@@ -0,0 +1,163 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ipmi
+
+import (
+	"fmt"
+	"net"
+)
+
+const (
+	//LAN Device Commands
+	BMC_SET_LAN_CONFIG Command = 0x01
+
+	// LAN configuration parameters.
+	LAN_PARAM_SET_IN_PROGRESS = 0
+	LAN_PARAM_IP              = 3
+	LAN_PARAM_IP_SOURCE       = 4
+	LAN_PARAM_MAC             = 5
+	LAN_PARAM_NETMASK         = 6
+	LAN_PARAM_GATEWAY         = 12
+	LAN_PARAM_GATEWAY_MAC     = 13
+	LAN_PARAM_VLAN_ID         = 20
+)
+
+// IPSource is where the BMC gets its address from.
+type IPSource byte
+
+// IP address sources.
+const (
+	IPSourceUnspecified IPSource = 0
+	IPSourceStatic      IPSource = 1
+	IPSourceDHCP        IPSource = 2
+	IPSourceBIOS        IPSource = 3
+	IPSourceOther       IPSource = 4
+)
+
+func (s IPSource) String() string {
+	switch s {
+	case IPSourceStatic:
+		return "Static Address"
+	case IPSourceDHCP:
+		return "DHCP Address"
+	case IPSourceBIOS:
+		return "BIOS Assigned Address"
+	case IPSourceOther:
+		return "Other"
+	}
+	return "Unspecified"
+}
+
+// LanConfig is the network configuration of a BMC LAN channel.
+type LanConfig struct {
+	IPSource   IPSource
+	IP         net.IP
+	Netmask    net.IPMask
+	Gateway    net.IP
+	GatewayMAC net.HardwareAddr
+	MAC        net.HardwareAddr
+	// VLAN is 0 if VLANs are disabled.
+	VLAN uint16
+}
+
+// lanParam returns the data of a LAN configuration parameter, which
+// must be at least n bytes.
+func (i *IPMI) lanParam(channel, param byte, n int) ([]byte, error) {
+	data, err := i.GetLanConfig(channel, param)
+	if err != nil {
+		return nil, err
+	}
+	// Completion code and parameter revision precede the data.
+	if len(data) < 2+n {
+		return nil, fmt.Errorf("LAN parameter %d: short response % x", param, data)
+	}
+	return data[2 : 2+n], nil
+}
+
+// GetLanConfigs reads the network configuration of a LAN channel.
+// Parameters the BMC does not support are left empty.
+func (i *IPMI) GetLanConfigs(channel byte) (*LanConfig, error) {
+	c := &LanConfig{}
+	b, err := i.lanParam(channel, LAN_PARAM_IP, 4)
+	if err != nil {
+		return nil, err
+	}
+	c.IP = net.IP(append([]byte{}, b...))
+	if b, err := i.lanParam(channel, LAN_PARAM_IP_SOURCE, 1); err == nil {
+		c.IPSource = IPSource(b[0] & 0xf)
+	}
+	if b, err := i.lanParam(channel, LAN_PARAM_NETMASK, 4); err == nil {
+		c.Netmask = net.IPMask(append([]byte{}, b...))
+	}
+	if b, err := i.lanParam(channel, LAN_PARAM_GATEWAY, 4); err == nil {
+		c.Gateway = net.IP(append([]byte{}, b...))
+	}
+	if b, err := i.lanParam(channel, LAN_PARAM_GATEWAY_MAC, 6); err == nil {
+		c.GatewayMAC = net.HardwareAddr(append([]byte{}, b...))
+	}
+	if b, err := i.lanParam(channel, LAN_PARAM_MAC, 6); err == nil {
+		c.MAC = net.HardwareAddr(append([]byte{}, b...))
+	}
+	if b, err := i.lanParam(channel, LAN_PARAM_VLAN_ID, 2); err == nil && b[1]&0x80 != 0 {
+		c.VLAN = uint16(b[0]) | uint16(b[1]&0xf)<<8
+	}
+	return c, nil
+}
+
+// SetLanConfig sets a LAN configuration parameter.
+func (i *IPMI) SetLanConfig(channel, param byte, value []byte) error {
+	_, err := i.SendRecv(_IPMI_NETFN_TRANSPORT, BMC_SET_LAN_CONFIG, append([]byte{channel, param}, value...))
+	return err
+}
+
+// setLanParams sets parameters between "set in progress" and "set
+// complete", as the specification asks. Not all BMCs implement the
+// set in progress parameter, so errors setting it are ignored.
+func (i *IPMI) setLanParams(channel, param byte, value []byte) error {
+	i.SetLanConfig(channel, LAN_PARAM_SET_IN_PROGRESS, []byte{1})
+	err := i.SetLanConfig(channel, param, value)
+	i.SetLanConfig(channel, LAN_PARAM_SET_IN_PROGRESS, []byte{0})
+	return err
+}
+
+func ip4(ip net.IP) ([]byte, error) {
+	b := ip.To4()
+	if b == nil {
+		return nil, fmt.Errorf("%v is not an IPv4 address", ip)
+	}
+	return b, nil
+}
+
+// SetLanIP sets a static address of the LAN channel.
+func (i *IPMI) SetLanIP(channel byte, ip net.IP) error {
+	b, err := ip4(ip)
+	if err != nil {
+		return err
+	}
+	return i.setLanParams(channel, LAN_PARAM_IP, b)
+}
+
+// SetLanNetmask sets the netmask of the LAN channel.
+func (i *IPMI) SetLanNetmask(channel byte, mask net.IP) error {
+	b, err := ip4(mask)
+	if err != nil {
+		return err
+	}
+	return i.setLanParams(channel, LAN_PARAM_NETMASK, b)
+}
+
+// SetLanGateway sets the default gateway of the LAN channel.
+func (i *IPMI) SetLanGateway(channel byte, gw net.IP) error {
+	b, err := ip4(gw)
+	if err != nil {
+		return err
+	}
+	return i.setLanParams(channel, LAN_PARAM_GATEWAY, b)
+}
+
+// SetLanIPSource selects a static address or DHCP for the LAN channel.
+func (i *IPMI) SetLanIPSource(channel byte, s IPSource) error {
+	return i.setLanParams(channel, LAN_PARAM_IP_SOURCE, []byte{byte(s)})
+}
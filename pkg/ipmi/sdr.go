@@ -0,0 +1,358 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ipmi
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+const (
+	_IPMI_NETFN_SENSOR NetFn = 0x4
+
+	// Sensor Device Commands
+	BMC_GET_SENSOR_READING Command = 0x2d
+
+	// SDR Repository Commands
+	BMC_RESERVE_SDR_REPO Command = 0x22
+	BMC_GET_SDR          Command = 0x23
+
+	// SDR record types.
+	SDR_FULL_SENSOR    = 0x01
+	SDR_COMPACT_SENSOR = 0x02
+
+	sdrHeaderLen = 5
+	// sdrChunk is the largest partial read every BMC supports.
+	sdrChunk = 16
+	// lastRecordID ends the repository and the SEL.
+	lastRecordID = 0xffff
+)
+
+// SensorType is the type of what a sensor monitors, as in table 42-3 of
+// the IPMI 2.0 specification.
+type SensorType uint8
+
+var sensorTypes = map[SensorType]string{
+	0x01: "Temperature",
+	0x02: "Voltage",
+	0x03: "Current",
+	0x04: "Fan",
+	0x05: "Physical Security",
+	0x06: "Platform Security",
+	0x07: "Processor",
+	0x08: "Power Supply",
+	0x09: "Power Unit",
+	0x0a: "Cooling Device",
+	0x0b: "Other Units-based Sensor",
+	0x0c: "Memory",
+	0x0d: "Drive Slot",
+	0x0e: "POST Memory Resize",
+	0x0f: "System Firmware Progress",
+	0x10: "Event Logging Disabled",
+	0x11: "Watchdog 1",
+	0x12: "System Event",
+	0x13: "Critical Interrupt",
+	0x14: "Button/Switch",
+	0x15: "Module/Board",
+	0x16: "Microcontroller",
+	0x17: "Add-in Card",
+	0x18: "Chassis",
+	0x19: "Chip Set",
+	0x1a: "Other FRU",
+	0x1b: "Cable/Interconnect",
+	0x1c: "Terminator",
+	0x1d: "System Boot Initiated",
+	0x1e: "Boot Error",
+	0x1f: "OS Boot",
+	0x20: "OS Critical Stop",
+	0x21: "Slot/Connector",
+	0x22: "System ACPI Power State",
+	0x23: "Watchdog 2",
+	0x24: "Platform Alert",
+	0x25: "Entity Presence",
+	0x26: "Monitor ASIC",
+	0x27: "LAN",
+	0x28: "Management Subsystem Health",
+	0x29: "Battery",
+	0x2a: "Session Audit",
+	0x2b: "Version Change",
+	0x2c: "FRU State",
+}
+
+func (t SensorType) String() string {
+	if s, ok := sensorTypes[t]; ok {
+		return s
+	}
+	if t >= 0xc0 {
+		return fmt.Sprintf("OEM reserved %#02x", uint8(t))
+	}
+	return fmt.Sprintf("Unknown %#02x", uint8(t))
+}
+
+// sensorUnits are the base units of table 43-15.
+var sensorUnits = []string{
+	"unspecified", "degrees C", "degrees F", "degrees K", "Volts", "Amps",
+	"Watts", "Joules", "Coulombs", "VA", "Nits", "lumen", "lux", "Candela",
+	"kPa", "PSI", "Newton", "CFM", "RPM", "Hz", "microsecond", "millisecond",
+	"second", "minute", "hour", "day", "week",
+}
+
+// SDR is a full or compact sensor record of the SDR repository.
+type SDR struct {
+	RecordID   uint16
+	RecordType uint8
+	Owner      uint8
+	LUN        uint8
+	Number     uint8
+	EntityID   uint8
+	Instance   uint8
+	SensorType SensorType
+	// EventType is the event/reading type code: 0x01 for threshold
+	// sensors, 0x6f for sensor specific ones.
+	EventType uint8
+	Name      string
+
+	units1, baseUnit uint8
+
+	// The conversion factors of full sensor records.
+	analog        bool
+	linearization uint8
+	m, b          int32
+	k1, k2        int8
+}
+
+// Threshold returns true for threshold based sensors, whose readings are
+// analog values.
+func (s *SDR) Threshold() bool {
+	return s.EventType == 0x01 && s.analog
+}
+
+// Unit returns the unit of the converted readings.
+func (s *SDR) Unit() string {
+	if s.units1&1 != 0 {
+		return "percent"
+	}
+	if int(s.baseUnit) < len(sensorUnits) {
+		return sensorUnits[s.baseUnit]
+	}
+	return fmt.Sprintf("unit %d", s.baseUnit)
+}
+
+// signExtend sign extends the bits low bits of v.
+func signExtend(v uint32, bits uint) int32 {
+	return int32(v<<(32-bits)) >> (32 - bits)
+}
+
+// Convert converts a raw reading with the formula of section 36.3,
+// y = L[(M*x + B*10^K1) * 10^K2].
+func (s *SDR) Convert(raw uint8) float64 {
+	var x float64
+	switch s.units1 >> 6 {
+	case 1:
+		// One's complement.
+		if raw&0x80 != 0 {
+			x = -float64(^raw)
+		} else {
+			x = float64(raw)
+		}
+	case 2:
+		x = float64(int8(raw))
+	default:
+		x = float64(raw)
+	}
+	y := (float64(s.m)*x + float64(s.b)*math.Pow10(int(s.k1))) * math.Pow10(int(s.k2))
+	switch s.linearization & 0x7f {
+	case 1:
+		y = math.Log(y)
+	case 2:
+		y = math.Log10(y)
+	case 3:
+		y = math.Log2(y)
+	case 4:
+		y = math.Exp(y)
+	case 5:
+		y = math.Pow(10, y)
+	case 6:
+		y = math.Exp2(y)
+	case 7:
+		y = 1 / y
+	case 8:
+		y = y * y
+	case 9:
+		y = y * y * y
+	case 10:
+		y = math.Sqrt(y)
+	case 11:
+		y = math.Cbrt(y)
+	}
+	return y
+}
+
+// parseSDR decodes a full or compact sensor record. Other record types
+// return nil.
+func parseSDR(b []byte) (*SDR, error) {
+	if len(b) < sdrHeaderLen {
+		return nil, fmt.Errorf("SDR of %d bytes is too short", len(b))
+	}
+	s := &SDR{RecordID: binary.LittleEndian.Uint16(b), RecordType: b[3]}
+	var idOff int
+	switch s.RecordType {
+	case SDR_FULL_SENSOR:
+		idOff = 47
+	case SDR_COMPACT_SENSOR:
+		idOff = 31
+	default:
+		return nil, nil
+	}
+	if len(b) < idOff+1 {
+		return nil, fmt.Errorf("SDR %#04x of type %d is only %d bytes", s.RecordID, s.RecordType, len(b))
+	}
+	s.Owner, s.LUN, s.Number = b[5], b[6]&3, b[7]
+	s.EntityID, s.Instance = b[8], b[9]
+	s.SensorType, s.EventType = SensorType(b[12]), b[13]
+	s.units1, s.baseUnit = b[20], b[21]
+	if s.RecordType == SDR_FULL_SENSOR {
+		s.analog = s.units1>>6 != 3
+		s.linearization = b[23]
+		s.m = signExtend(uint32(b[24])|uint32(b[25]>>6)<<8, 10)
+		s.b = signExtend(uint32(b[26])|uint32(b[27]>>6)<<8, 10)
+		s.k2 = int8(signExtend(uint32(b[29]>>4), 4))
+		s.k1 = int8(signExtend(uint32(b[29]&0xf), 4))
+	}
+	l := int(b[idOff] & 0x1f)
+	id := b[idOff+1:]
+	if l < len(id) {
+		id = id[:l]
+	}
+	s.Name = string(id)
+	return s, nil
+}
+
+func (i *IPMI) reserveSDRRepo() (uint16, error) {
+	data, err := i.SendRecv(_IPMI_NETFN_STORAGE, BMC_RESERVE_SDR_REPO, nil)
+	if err != nil {
+		return 0, err
+	}
+	if len(data) < 3 {
+		return 0, fmt.Errorf("short reservation response % x", data)
+	}
+	return binary.LittleEndian.Uint16(data[1:]), nil
+}
+
+// getSDR reads the n bytes at off of record id. It returns the data and
+// the ID of the next record.
+func (i *IPMI) getSDR(res, id uint16, off, n uint8) ([]byte, uint16, error) {
+	req := []byte{byte(res), byte(res >> 8), byte(id), byte(id >> 8), off, n}
+	data, err := i.SendRecv(_IPMI_NETFN_STORAGE, BMC_GET_SDR, req)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(data) < 3 {
+		return nil, 0, fmt.Errorf("short SDR response % x", data)
+	}
+	return data[3:], binary.LittleEndian.Uint16(data[1:]), nil
+}
+
+// GetSDRs reads the sensor records of the SDR repository. Records of
+// other types, such as FRU locators, are skipped.
+func (i *IPMI) GetSDRs() ([]*SDR, error) {
+	res, err := i.reserveSDRRepo()
+	if err != nil {
+		return nil, fmt.Errorf("reserving SDR repository: %v", err)
+	}
+	var sdrs []*SDR
+	for id := uint16(0); id != lastRecordID; {
+		hdr, next, err := i.getSDR(res, id, 0, sdrHeaderLen)
+		if err != nil {
+			return nil, fmt.Errorf("reading SDR %#04x: %v", id, err)
+		}
+		if len(hdr) < sdrHeaderLen {
+			return nil, fmt.Errorf("SDR %#04x: short header % x", id, hdr)
+		}
+		rec := hdr
+		for l := int(hdr[4]); len(rec)-sdrHeaderLen < l; {
+			n := l - (len(rec) - sdrHeaderLen)
+			if n > sdrChunk {
+				n = sdrChunk
+			}
+			b, _, err := i.getSDR(res, id, uint8(len(rec)), uint8(n))
+			if err != nil {
+				return nil, fmt.Errorf("reading SDR %#04x: %v", id, err)
+			}
+			if len(b) == 0 {
+				return nil, fmt.Errorf("SDR %#04x: empty read", id)
+			}
+			rec = append(rec, b...)
+		}
+		s, err := parseSDR(rec)
+		if err != nil {
+			return nil, err
+		}
+		if s != nil {
+			sdrs = append(sdrs, s)
+		}
+		if next == id {
+			break
+		}
+		id = next
+	}
+	return sdrs, nil
+}
+
+// SensorReading is the state of a sensor.
+type SensorReading struct {
+	Raw uint8
+	// Unavailable is set when the sensor has no valid reading, e.g.
+	// because the device it monitors is not powered.
+	Unavailable bool
+	Scanning    bool
+	// States holds the threshold comparison bits of threshold
+	// sensors, and the asserted states of discrete ones.
+	States uint16
+}
+
+// GetSensorReading reads the sensor of s.
+func (i *IPMI) GetSensorReading(s *SDR) (*SensorReading, error) {
+	data, err := i.SendRecv(_IPMI_NETFN_SENSOR, BMC_GET_SENSOR_READING, []byte{s.Number})
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < 3 {
+		return nil, fmt.Errorf("short sensor reading % x", data)
+	}
+	r := &SensorReading{
+		Raw:         data[1],
+		Unavailable: data[2]&0x20 != 0,
+		Scanning:    data[2]&0x40 != 0,
+	}
+	if len(data) > 3 {
+		r.States = uint16(data[3])
+	}
+	if len(data) > 4 {
+		r.States |= uint16(data[4]) << 8
+	}
+	return r, nil
+}
+
+// thresholdStates names the bits of the threshold comparison status.
+var thresholdStates = []string{"lnc", "lcr", "lnr", "unc", "ucr", "unr"}
+
+// Status returns the reading state as ipmitool prints it: "ok", the most
+// severe threshold crossed, e.g. "ucr", or "na".
+func (r *SensorReading) Status(s *SDR) string {
+	if r.Unavailable || !r.Scanning {
+		return "na"
+	}
+	if !s.Threshold() {
+		return fmt.Sprintf("0x%04x", r.States)
+	}
+	for _, b := range []uint{5, 2, 4, 1, 3, 0} {
+		if r.States&(1<<b) != 0 {
+			return thresholdStates[b]
+		}
+	}
+	return "ok"
+}
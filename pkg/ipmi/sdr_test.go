@@ -0,0 +1,188 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ipmi
+
+import (
+	"encoding/hex"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// unhex decodes the hex bytes of s, which may be separated by spaces.
+func unhex(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(strings.Replace(s, " ", "", -1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+// Records as the BMC returns them, laid out as in tables 43-1 (full) and
+// 43-2 (compact) of the IPMI 2.0 specification.
+var (
+	// CPU Temp: unsigned, degrees C, y = x.
+	cpuTemp = "0100 51 01 33 20 00 01 03 01 7f 68 01 01 800a 800a 1b1b" +
+		" 00 01 00 00 01 00 00 00 00 00 07 28 5a0a7f00 69645f000000 0202 0000 00" +
+		" c8" + hex.EncodeToString([]byte("CPU Temp"))
+	// 12V: unsigned, Volts, y = 63x * 10^-3.
+	volts12 = "0200 51 01 2e 20 00 30 07 01 7f 68 02 01 800a 800a 1b1b" +
+		" 00 04 00 00 3f 00 00 00 00 d0 07 bc ffff00 00 000000000000 0000 0000 00" +
+		" c3" + hex.EncodeToString([]byte("12V"))
+	// Inlet Temp: two's complement, y = 2x + -10 * 10^1.
+	inletTemp = "0400 51 01 35 20 00 02 37 01 7f 68 01 01 800a 800a 1b1b" +
+		" 80 01 00 00 02 00 f6 c0 00 01 07 00 00000000 000000000000 0000 0000 00" +
+		" ca" + hex.EncodeToString([]byte("Inlet Temp"))
+	// PSU1 Status: compact, discrete, sensor specific.
+	psu1 = "0300 51 02 26 20 00 40 0a 01 67 40 08 6f 0f00 0f00 0f00" +
+		" c0 00 00 0100 00 00 000000 00" +
+		" cb" + hex.EncodeToString([]byte("PSU1 Status"))
+	// A FRU device locator record, which is not a sensor.
+	fruLocator = "0500 51 11 13 20 00 00 00 10 00 00 01 00 c8" + hex.EncodeToString([]byte("Baseboard"))
+)
+
+func TestParseSDR(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		b    string
+		// cut bytes are cut off the end of b.
+		cut  int
+		want *SDR
+		err  bool
+	}{
+		{
+			name: "full threshold sensor",
+			b:    cpuTemp,
+			want: &SDR{
+				RecordID: 1, RecordType: SDR_FULL_SENSOR, Owner: 0x20, Number: 1,
+				EntityID: 3, Instance: 1, SensorType: 0x01, EventType: 0x01, Name: "CPU Temp",
+				baseUnit: 1, analog: true, m: 1,
+			},
+		},
+		{
+			name: "full sensor with result exponent",
+			b:    volts12,
+			want: &SDR{
+				RecordID: 2, RecordType: SDR_FULL_SENSOR, Owner: 0x20, Number: 0x30,
+				EntityID: 7, Instance: 1, SensorType: 0x02, EventType: 0x01, Name: "12V",
+				baseUnit: 4, analog: true, m: 63, k2: -3,
+			},
+		},
+		{
+			name: "full sensor with negative offset",
+			b:    inletTemp,
+			want: &SDR{
+				RecordID: 4, RecordType: SDR_FULL_SENSOR, Owner: 0x20, Number: 2,
+				EntityID: 0x37, Instance: 1, SensorType: 0x01, EventType: 0x01, Name: "Inlet Temp",
+				units1: 0x80, baseUnit: 1, analog: true, m: 2, b: -10, k1: 1,
+			},
+		},
+		{
+			name: "compact discrete sensor",
+			b:    psu1,
+			want: &SDR{
+				RecordID: 3, RecordType: SDR_COMPACT_SENSOR, Owner: 0x20, Number: 0x40,
+				EntityID: 0x0a, Instance: 1, SensorType: 0x08, EventType: 0x6f, Name: "PSU1 Status",
+				units1: 0xc0,
+			},
+		},
+		{
+			name: "name longer than the record",
+			b:    psu1,
+			cut:  len(" Status"),
+			want: &SDR{
+				RecordID: 3, RecordType: SDR_COMPACT_SENSOR, Owner: 0x20, Number: 0x40,
+				EntityID: 0x0a, Instance: 1, SensorType: 0x08, EventType: 0x6f, Name: "PSU1",
+				units1: 0xc0,
+			},
+		},
+		{name: "other record type", b: fruLocator},
+		{name: "short header", b: "0100 51", err: true},
+		{name: "truncated full record", b: cpuTemp, cut: 30, err: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			b := unhex(t, tt.b)
+			got, err := parseSDR(b[:len(b)-tt.cut])
+			if (err != nil) != tt.err {
+				t.Fatalf("parseSDR() = %v, want error %v", err, tt.err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseSDR() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvert(t *testing.T) {
+	record := func(t *testing.T, s string) *SDR {
+		sdr, err := parseSDR(unhex(t, s))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return sdr
+	}
+	for _, tt := range []struct {
+		name string
+		sdr  *SDR
+		raw  uint8
+		want float64
+		unit string
+	}{
+		{"CPU Temp", record(t, cpuTemp), 45, 45, "degrees C"},
+		{"12V", record(t, volts12), 0xbe, 11.97, "Volts"},
+		{"Inlet Temp", record(t, inletTemp), 0xfb, -110, "degrees C"},
+		{"Inlet Temp positive", record(t, inletTemp), 60, 20, "degrees C"},
+		{"one's complement", &SDR{units1: 0x40, m: 1}, 0xfe, -1, "unspecified"},
+		{"percent", &SDR{units1: 0x01, m: 1}, 50, 50, "percent"},
+		{"square", &SDR{linearization: 8, m: 1}, 12, 144, "unspecified"},
+		{"inverse", &SDR{linearization: 7, m: 4}, 5, 0.05, "unspecified"},
+		{"OEM unit", &SDR{baseUnit: 200, m: 1}, 1, 1, "unit 200"},
+	} {
+		if got := tt.sdr.Convert(tt.raw); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("%s: Convert(%#x) = %v, want %v", tt.name, tt.raw, got, tt.want)
+		}
+		if got := tt.sdr.Unit(); got != tt.unit {
+			t.Errorf("%s: Unit() = %q, want %q", tt.name, got, tt.unit)
+		}
+	}
+}
+
+func TestSensorStatus(t *testing.T) {
+	threshold := &SDR{EventType: 0x01, analog: true}
+	discrete := &SDR{EventType: 0x6f}
+	for _, tt := range []struct {
+		name string
+		sdr  *SDR
+		r    SensorReading
+		want string
+	}{
+		{"ok", threshold, SensorReading{Scanning: true}, "ok"},
+		{"unavailable", threshold, SensorReading{Scanning: true, Unavailable: true}, "na"},
+		{"not scanning", threshold, SensorReading{States: 1 << 4}, "na"},
+		{"upper critical", threshold, SensorReading{Scanning: true, States: 1<<3 | 1<<4}, "ucr"},
+		{"upper non-recoverable", threshold, SensorReading{Scanning: true, States: 1<<3 | 1<<4 | 1<<5}, "unr"},
+		{"lower non-recoverable before upper critical", threshold, SensorReading{Scanning: true, States: 1<<2 | 1<<4}, "lnr"},
+		{"lower non-critical", threshold, SensorReading{Scanning: true, States: 1}, "lnc"},
+		{"discrete", discrete, SensorReading{Scanning: true, States: 0x0102}, "0x0102"},
+	} {
+		if got := tt.r.Status(tt.sdr); got != tt.want {
+			t.Errorf("%s: Status() = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestSensorType(t *testing.T) {
+	for typ, want := range map[SensorType]string{
+		0x01: "Temperature",
+		0x2c: "FRU State",
+		0x50: "Unknown 0x50",
+		0xc3: "OEM reserved 0xc3",
+	} {
+		if got := typ.String(); got != want {
+			t.Errorf("SensorType(%#x) = %q, want %q", uint8(typ), got, want)
+		}
+	}
+}
@@ -0,0 +1,172 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ipmi
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+)
+
+const (
+	// SEL device Commands
+	BMC_RESERVE_SEL   Command = 0x42
+	BMC_GET_SEL_ENTRY Command = 0x43
+	BMC_CLEAR_SEL     Command = 0x47
+
+	selRecordLen = 16
+
+	// Timestamps up to this value count seconds since the BMC started,
+	// not since 1970.
+	selTimestampInitMax = 0x20000000
+	selTimestampUnknown = 0xffffffff
+)
+
+// Event offsets of threshold sensors, event type 0x01.
+var thresholdEvents = []string{
+	"Lower Non-critical going low",
+	"Lower Non-critical going high",
+	"Lower Critical going low",
+	"Lower Critical going high",
+	"Lower Non-recoverable going low",
+	"Lower Non-recoverable going high",
+	"Upper Non-critical going low",
+	"Upper Non-critical going high",
+	"Upper Critical going low",
+	"Upper Critical going high",
+	"Upper Non-recoverable going low",
+	"Upper Non-recoverable going high",
+}
+
+// sensorSpecificEvents are the common offsets of sensor specific events,
+// event type 0x6f, from table 42-3.
+var sensorSpecificEvents = map[SensorType][]string{
+	0x05: {"General Chassis intrusion", "Drive Bay intrusion", "I/O Card area intrusion", "Processor area intrusion", "System unplugged from LAN", "Unauthorized dock", "FAN area intrusion"},
+	0x07: {"IERR", "Thermal Trip", "FRB1/BIST failure", "FRB2/Hang in POST failure", "FRB3/Processor startup/init failure", "Configuration Error", "SM BIOS Uncorrectable CPU-complex Error", "Presence detected", "Disabled", "Terminator presence detected", "Throttled", "Uncorrectable machine check exception", "Correctable machine check error"},
+	0x08: {"Presence detected", "Failure detected", "Predictive failure", "Power Supply AC lost", "AC lost or out-of-range", "AC out-of-range, but present", "Configuration error"},
+	0x0c: {"Correctable ECC", "Uncorrectable ECC", "Parity", "Memory Scrub Failed", "Memory Device Disabled", "Correctable ECC logging limit reached", "Presence Detected", "Configuration Error", "Spare", "Memory Automatically Throttled", "Critical Overtemperature"},
+	0x0f: {"System Firmware Error", "System Firmware Hang", "System Firmware Progress"},
+	0x10: {"Correctable memory error logging disabled", "Event logging disabled", "Log area reset/cleared", "All event logging disabled", "Log full", "Log almost full"},
+	0x12: {"System Reconfigured", "OEM System boot event", "Undetermined system hardware failure", "Entry added to auxiliary log", "PEF Action", "Timestamp Clock Sync"},
+	0x13: {"Front Panel NMI", "Bus Timeout", "I/O Channel check NMI", "Software NMI", "PCI PERR", "PCI SERR", "EISA failsafe timeout", "Bus Correctable error", "Bus Uncorrectable error", "Fatal NMI", "Bus Fatal Error", "Bus Degraded"},
+	0x1d: {"Initiated by power up", "Initiated by hard reset", "Initiated by warm reset", "User requested PXE boot", "Automatic boot to diagnostic", "OS/run-time software initiated hard reset", "OS/run-time software initiated warm reset", "System Restart"},
+	0x1e: {"No bootable media", "Non-bootable disk in drive", "PXE server not found", "Invalid boot sector", "Timeout waiting for selection"},
+	0x20: {"Stop during OS load/init", "Run-time critical stop", "OS graceful stop", "OS graceful shutdown", "PEF initiated soft shutdown", "Agent not responding"},
+	0x23: {"Timer expired", "Hard reset", "Power down", "Power cycle", "", "", "", "", "Timer interrupt"},
+}
+
+// Time returns the time the BMC logged the event. Events logged before
+// the BMC clock was set have a zero time and the number of seconds since
+// the BMC started in StandardEvent.Timestamp. OEM records
+// without a timestamp have a zero time.
+func (e *Event) Time() time.Time {
+	ts := e.StandardEvent.Timestamp
+	if e.RecordType >= 0xe0 || ts == selTimestampUnknown || ts <= selTimestampInitMax {
+		return time.Time{}
+	}
+	return time.Unix(int64(ts), 0).UTC()
+}
+
+// Asserted returns false for deassertion events.
+func (e *Event) Asserted() bool {
+	return e.EventTypeDir&0x80 == 0
+}
+
+// Description describes a standard event. Unknown events are described
+// by their raw offset.
+func (e *Event) Description() string {
+	if e.RecordType != 0x02 {
+		return "OEM record"
+	}
+	off := int(e.EventData[0] & 0xf)
+	var names []string
+	switch typ := e.EventTypeDir & 0x7f; {
+	case typ == 0x01:
+		names = thresholdEvents
+	case typ == 0x6f:
+		names = sensorSpecificEvents[SensorType(e.SensorType)]
+	}
+	if off < len(names) && names[off] != "" {
+		return names[off]
+	}
+	return fmt.Sprintf("Event type %#02x offset %#x", e.EventTypeDir&0x7f, off)
+}
+
+// unmarshalEvent decodes a 16 byte SEL record, the inverse of marshall.
+func unmarshalEvent(b []byte) (*Event, error) {
+	if len(b) < selRecordLen {
+		return nil, fmt.Errorf("SEL record of %d bytes, want %d", len(b), selRecordLen)
+	}
+	e := &Event{
+		RecordID:   binary.LittleEndian.Uint16(b),
+		RecordType: b[2],
+	}
+	switch {
+	case e.RecordType < 0xc0:
+		e.StandardEvent = StandardEvent{
+			Timestamp:    binary.LittleEndian.Uint32(b[3:]),
+			GenID:        binary.LittleEndian.Uint16(b[7:]),
+			EvMRev:       b[9],
+			SensorType:   b[10],
+			SensorNum:    b[11],
+			EventTypeDir: b[12],
+		}
+		copy(e.EventData[:], b[13:16])
+	case e.RecordType < 0xe0:
+		e.OEMTsEvent.Timestamp = binary.LittleEndian.Uint32(b[3:])
+		e.StandardEvent.Timestamp = e.OEMTsEvent.Timestamp
+		copy(e.ManfID[:], b[7:10])
+		copy(e.OEMTsDefinedData[:], b[10:16])
+	default:
+		copy(e.OEMNontsDefinedData[:], b[3:16])
+	}
+	return e, nil
+}
+
+// GetSELEntries reads all the records of the SEL.
+func (i *IPMI) GetSELEntries() ([]*Event, error) {
+	var events []*Event
+	for id := uint16(0); id != lastRecordID; {
+		// Whole records need no reservation.
+		req := []byte{0, 0, byte(id), byte(id >> 8), 0, 0xff}
+		data, err := i.SendRecv(_IPMI_NETFN_STORAGE, BMC_GET_SEL_ENTRY, req)
+		if err != nil {
+			// An empty SEL has no first record.
+			if id == 0 && len(events) == 0 {
+				if info, ierr := i.GetSELInfo(); ierr == nil && info.Entries == 0 {
+					return nil, nil
+				}
+			}
+			return nil, fmt.Errorf("reading SEL record %#04x: %v", id, err)
+		}
+		if len(data) < 3 {
+			return nil, fmt.Errorf("short SEL response % x", data)
+		}
+		e, err := unmarshalEvent(data[3:])
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, e)
+		next := binary.LittleEndian.Uint16(data[1:])
+		if next == id {
+			break
+		}
+		id = next
+	}
+	return events, nil
+}
+
+// ClearSEL erases all the SEL records.
+func (i *IPMI) ClearSEL() error {
+	data, err := i.SendRecv(_IPMI_NETFN_STORAGE, BMC_RESERVE_SEL, nil)
+	if err != nil {
+		return fmt.Errorf("reserving SEL: %v", err)
+	}
+	if len(data) < 3 {
+		return fmt.Errorf("short reservation response % x", data)
+	}
+	_, err = i.SendRecv(_IPMI_NETFN_STORAGE, BMC_CLEAR_SEL, []byte{data[1], data[2], 'C', 'L', 'R', 0xaa})
+	return err
+}
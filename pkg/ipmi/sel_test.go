@@ -0,0 +1,117 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ipmi
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestUnmarshalEvent(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		b        string
+		want     *Event
+		time     time.Time
+		asserted bool
+		desc     string
+		err      bool
+	}{
+		{
+			// CPU Temp crossed its upper critical threshold, with
+			// the reading and the threshold in the event data.
+			name: "threshold",
+			b:    "0100 02 00105e5f 2000 04 01 01 01 596464",
+			want: &Event{RecordID: 1, RecordType: 2, StandardEvent: StandardEvent{
+				Timestamp: 1600000000, GenID: 0x20, EvMRev: 4, SensorType: 1, SensorNum: 1,
+				EventTypeDir: 1, EventData: [3]uint8{0x59, 0x64, 0x64},
+			}},
+			time:     time.Date(2020, 9, 13, 12, 26, 40, 0, time.UTC),
+			asserted: true,
+			desc:     "Upper Critical going high",
+		},
+		{
+			// PSU1 is no longer failing, logged before the BMC
+			// clock was set.
+			name: "sensor specific deassertion",
+			b:    "0200 02 000a0000 2000 04 08 40 ef 01ffff",
+			want: &Event{RecordID: 2, RecordType: 2, StandardEvent: StandardEvent{
+				Timestamp: 0xa00, GenID: 0x20, EvMRev: 4, SensorType: 8, SensorNum: 0x40,
+				EventTypeDir: 0xef, EventData: [3]uint8{0x01, 0xff, 0xff},
+			}},
+			desc: "Failure detected",
+		},
+		{
+			name: "unknown timestamp",
+			b:    "0300 02 ffffffff 4100 04 20 83 6f 03ffff",
+			want: &Event{RecordID: 3, RecordType: 2, StandardEvent: StandardEvent{
+				Timestamp: 0xffffffff, GenID: 0x41, EvMRev: 4, SensorType: 0x20, SensorNum: 0x83,
+				EventTypeDir: 0x6f, EventData: [3]uint8{0x03, 0xff, 0xff},
+			}},
+			asserted: true,
+			desc:     "OS graceful shutdown",
+		},
+		{
+			name: "unknown offset",
+			b:    "0400 02 00105e5f 2000 04 01 01 6f 02ffff",
+			want: &Event{RecordID: 4, RecordType: 2, StandardEvent: StandardEvent{
+				Timestamp: 1600000000, GenID: 0x20, EvMRev: 4, SensorType: 1, SensorNum: 1,
+				EventTypeDir: 0x6f, EventData: [3]uint8{0x02, 0xff, 0xff},
+			}},
+			time:     time.Date(2020, 9, 13, 12, 26, 40, 0, time.UTC),
+			asserted: true,
+			desc:     "Event type 0x6f offset 0x2",
+		},
+		{
+			name: "OEM with timestamp",
+			b:    "0500 c1 00105e5f 570100 010203040506",
+			want: &Event{
+				RecordID: 5, RecordType: 0xc1,
+				StandardEvent: StandardEvent{Timestamp: 1600000000},
+				OEMTsEvent: OEMTsEvent{
+					Timestamp: 1600000000, ManfID: [3]uint8{0x57, 0x01, 0x00},
+					OEMTsDefinedData: [6]uint8{1, 2, 3, 4, 5, 6},
+				},
+			},
+			time:     time.Date(2020, 9, 13, 12, 26, 40, 0, time.UTC),
+			asserted: true,
+			desc:     "OEM record",
+		},
+		{
+			name: "OEM without timestamp",
+			b:    "0600 e0 0102030405060708090a0b0c0d",
+			want: &Event{
+				RecordID: 6, RecordType: 0xe0,
+				OEMNontsEvent: OEMNontsEvent{OEMNontsDefinedData: [13]uint8{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13}},
+			},
+			asserted: true,
+			desc:     "OEM record",
+		},
+		{name: "short", b: "0100 02 00105e5f 2000", err: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			e, err := unmarshalEvent(unhex(t, tt.b))
+			if (err != nil) != tt.err {
+				t.Fatalf("unmarshalEvent() = %v, want error %v", err, tt.err)
+			}
+			if !reflect.DeepEqual(e, tt.want) {
+				t.Fatalf("unmarshalEvent() = %+v, want %+v", e, tt.want)
+			}
+			if e == nil {
+				return
+			}
+			if got := e.Time(); !got.Equal(tt.time) {
+				t.Errorf("Time() = %v, want %v", got, tt.time)
+			}
+			if got := e.Asserted(); got != tt.asserted {
+				t.Errorf("Asserted() = %v, want %v", got, tt.asserted)
+			}
+			if got := e.Description(); got != tt.desc {
+				t.Errorf("Description() = %q, want %q", got, tt.desc)
+			}
+		})
+	}
+}
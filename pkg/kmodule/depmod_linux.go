@@ -0,0 +1,275 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package kmodule
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// The index format of depmod's .bin files, e.g. modules.dep.bin: a trie of
+// keys, each with prioritized values.
+const (
+	indexMagic   = 0xb007f457
+	indexVersion = 0x00020001
+
+	indexNodePrefix = 0x80000000
+	indexNodeValues = 0x40000000
+	indexNodeChilds = 0x20000000
+	indexNodeMask   = 0x0fffffff
+
+	// Keys are module names and aliases. This bounds the recursion of
+	// broken indexes.
+	maxKey = 4096
+)
+
+// kv is a key and a value of a depmod file.
+type kv struct {
+	key, value string
+}
+
+type indexReader struct {
+	b   []byte
+	kvs []kv
+}
+
+func (r *indexReader) uint32(off int) (uint32, error) {
+	if off < 0 || off+4 > len(r.b) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	return binary.BigEndian.Uint32(r.b[off:]), nil
+}
+
+func (r *indexReader) string(off int) (string, int, error) {
+	if off < 0 || off > len(r.b) {
+		return "", 0, io.ErrUnexpectedEOF
+	}
+	n := bytes.IndexByte(r.b[off:], 0)
+	if n < 0 {
+		return "", 0, io.ErrUnexpectedEOF
+	}
+	return string(r.b[off : off+n]), off + n + 1, nil
+}
+
+// node adds the values of the node at offset and then those of its
+// children, whose keys start with key, so keys are in order.
+func (r *indexReader) node(offset uint32, key string, depth int) error {
+	if depth > maxKey {
+		return fmt.Errorf("key longer than %d bytes", maxKey)
+	}
+	off := int(offset & indexNodeMask)
+	if offset&indexNodePrefix != 0 {
+		prefix, next, err := r.string(off)
+		if err != nil {
+			return err
+		}
+		key += prefix
+		off = next
+	}
+	type child struct {
+		offset uint32
+		c      byte
+	}
+	var children []child
+	if offset&indexNodeChilds != 0 {
+		if off+2 > len(r.b) {
+			return io.ErrUnexpectedEOF
+		}
+		first, last := int(r.b[off]), int(r.b[off+1])
+		off += 2
+		for c := first; c <= last; c++ {
+			o, err := r.uint32(off)
+			if err != nil {
+				return err
+			}
+			off += 4
+			if o != 0 {
+				children = append(children, child{o, byte(c)})
+			}
+		}
+	}
+	if offset&indexNodeValues != 0 {
+		n, err := r.uint32(off)
+		if err != nil {
+			return err
+		}
+		off += 4
+		for i := uint32(0); i < n; i++ {
+			// Skip the priority.
+			var v string
+			if v, off, err = r.string(off + 4); err != nil {
+				return err
+			}
+			r.kvs = append(r.kvs, kv{key, v})
+		}
+	}
+	for _, c := range children {
+		if err := r.node(c.offset, key+string([]byte{c.c}), depth+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readIndex returns all keys and values of the depmod index in b.
+func readIndex(b []byte) ([]kv, error) {
+	r := &indexReader{b: b}
+	magic, err := r.uint32(0)
+	if err != nil || magic != indexMagic {
+		return nil, fmt.Errorf("not a module index")
+	}
+	if v, _ := r.uint32(4); v>>16 != indexVersion>>16 {
+		return nil, fmt.Errorf("unsupported module index version %#x", v)
+	}
+	root, err := r.uint32(8)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.node(root, "", 0); err != nil {
+		return nil, fmt.Errorf("invalid module index: %v", err)
+	}
+	return r.kvs, nil
+}
+
+// readDepmod returns the lines of the depmod file name in moduleDir, split
+// into keys and values at the first sep, or those of its index, name.bin,
+// if there is no text version.
+func readDepmod(moduleDir, name, sep string) ([]kv, error) {
+	f, err := os.Open(filepath.Join(moduleDir, name))
+	if os.IsNotExist(err) {
+		b, berr := ioutil.ReadFile(filepath.Join(moduleDir, name+".bin"))
+		if berr != nil {
+			return nil, err
+		}
+		return readIndex(b)
+	} else if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var kvs []kv
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		txt := strings.TrimSpace(scanner.Text())
+		if txt == "" || txt[0] == '#' {
+			continue
+		}
+		i := strings.Index(txt, sep)
+		if i < 0 {
+			return nil, fmt.Errorf("%s: invalid line %q", name, txt)
+		}
+		kvs = append(kvs, kv{strings.TrimSpace(txt[:i]), strings.TrimSpace(txt[i+len(sep):])})
+	}
+	return kvs, scanner.Err()
+}
+
+// canonicalName returns the name of a module, as /proc/modules and the
+// depmod files other than modules.dep have it, with underscores.
+func canonicalName(name string) string {
+	return strings.Replace(name, "-", "_", -1)
+}
+
+// modName returns the name of the module at mp.
+func modName(mp string) string {
+	return canonicalName(strings.TrimSuffix(uncompressedName(mp), ".ko"))
+}
+
+// alias is an alias of modules.alias, e.g. the hardware a module drives.
+type alias struct {
+	pattern string
+	module  string
+}
+
+// readAliases returns the aliases of modules.alias in moduleDir, or none if
+// there is no such file.
+func readAliases(moduleDir string) ([]alias, error) {
+	kvs, err := readDepmod(moduleDir, "modules.alias", " ")
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var aliases []alias
+	for _, kv := range kvs {
+		// The text version has alias lines, the index only aliases.
+		pattern, module := kv.key, kv.value
+		if pattern == "alias" {
+			f := strings.Fields(module)
+			if len(f) != 2 {
+				return nil, fmt.Errorf("modules.alias: invalid alias %q", module)
+			}
+			pattern, module = f[0], f[1]
+		}
+		aliases = append(aliases, alias{pattern, module})
+	}
+	return aliases, nil
+}
+
+// resolveAlias returns the names of the modules name is an alias of. Aliases
+// are shell patterns, e.g. pci:v00008086d000010D3sv*sd*bc*sc*i*.
+func resolveAlias(aliases []alias, name string) []string {
+	var mods []string
+	seen := map[string]bool{}
+	for _, a := range aliases {
+		if ok, _ := path.Match(a.pattern, name); ok && !seen[a.module] {
+			seen[a.module] = true
+			mods = append(mods, a.module)
+		}
+	}
+	return mods
+}
+
+// softdep are the modules to load before and after a module which does not
+// need them to load, e.g. crypto implementations.
+type softdep struct {
+	pre, post []string
+}
+
+// readSoftdeps returns the soft dependencies of modules.softdep in
+// moduleDir by module name, or none if there is no such file.
+func readSoftdeps(moduleDir string) (map[string]softdep, error) {
+	kvs, err := readDepmod(moduleDir, "modules.softdep", " ")
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	softdeps := map[string]softdep{}
+	for _, kv := range kvs {
+		if kv.key != "softdep" {
+			continue
+		}
+		f := strings.Fields(kv.value)
+		if len(f) == 0 {
+			return nil, fmt.Errorf("modules.softdep: softdep without module")
+		}
+		name := canonicalName(f[0])
+		s := softdeps[name]
+		var list *[]string
+		for _, w := range f[1:] {
+			switch w {
+			case "pre:":
+				list = &s.pre
+			case "post:":
+				list = &s.post
+			default:
+				if list == nil {
+					return nil, fmt.Errorf("modules.softdep: %s: %q is neither pre: nor post:", name, w)
+				}
+				*list = append(*list, w)
+			}
+		}
+		softdeps[name] = s
+	}
+	return softdeps, nil
+}
@@ -11,16 +11,17 @@ package kmodule
 import (
 	"bufio"
 	"bytes"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"os"
 	"path"
 	"path/filepath"
+	"strconv"
 	"strings"
 
-	"github.com/klauspost/pgzip"
-	"github.com/ulikunitz/xz"
+	"github.com/u-root/u-root/pkg/compress"
 	"golang.org/x/sys/unix"
 )
 
@@ -39,23 +40,20 @@ func Init(image []byte, opts string) error {
 }
 
 // FileInit loads the kernel module contained by `f` with the given opts and
-// flags. Uncompresses modules with a .xz and .gz suffix before loading.
+// flags. Uncompresses modules with the suffix of a known compression, e.g.
+// .xz, .gz or .zst, before loading.
 //
 // FileInit falls back to init_module(2) via Init when the finit_module(2)
 // syscall is not available and when loading compressed modules.
 func FileInit(f *os.File, opts string, flags uintptr) error {
 	var r io.Reader
-	if strings.HasSuffix(f.Name(), ".xz") {
-		var err error
-		if r, err = xz.NewReader(f); err != nil {
-			return err
-		}
-
-	} else if strings.HasSuffix(f.Name(), ".gz") {
-		var err error
-		if r, err = pgzip.NewReader(f); err != nil {
+	if c := compress.ByExtension(f.Name()); c != nil {
+		cr, err := c.NewReader(f)
+		if err != nil {
 			return err
 		}
+		defer cr.Close()
+		r = cr
 	}
 
 	if r == nil {
@@ -83,6 +81,10 @@ func Delete(name string, flags uintptr) error {
 	return unix.DeleteModule(name, int(flags))
 }
 
+// ErrNotFound is returned, wrapped, for modules which are not in the module
+// directory, by name or alias.
+var ErrNotFound = errors.New("module not found")
+
 type modState uint8
 
 const (
@@ -99,6 +101,10 @@ type dependency struct {
 
 type depMap map[string]*dependency
 
+// procModules lists the loaded modules. It is a variable so tests can
+// override it.
+var procModules = "/proc/modules"
+
 // ProbeOpts contains optional parameters to Probe.
 //
 // An empty ProbeOpts{} should lead to the default behavior.
@@ -117,30 +123,81 @@ func Probe(name string, modParams string) error {
 
 // ProbeOptions loads the given kernel module and its dependencies.
 // This functions takes ProbeOpts.
+//
+// name may also be an alias of modules.alias, e.g. of the hardware a module
+// drives, which loads all modules with that alias. Soft dependencies of
+// modules.softdep are loaded before and after the module, ignoring errors.
 func ProbeOptions(name, modParams string, opts ProbeOpts) error {
-	deps, err := genDeps(opts)
+	moduleDir, err := findModuleDir(opts)
+	if err != nil {
+		return err
+	}
+	deps, err := genDeps(moduleDir, opts)
 	if err != nil {
 		return fmt.Errorf("could not generate dependency map %v", err)
 	}
+	softdeps, err := readSoftdeps(moduleDir)
+	if err != nil {
+		return err
+	}
 
 	modPath, err := findModPath(name, deps)
-	if err != nil {
-		return fmt.Errorf("could not find module path %q: %v", name, err)
+	if err == nil {
+		return probe(modPath, modParams, deps, softdeps, opts)
+	}
+	aliases, aerr := readAliases(moduleDir)
+	if aerr != nil {
+		return aerr
 	}
+	mods := resolveAlias(aliases, name)
+	if len(mods) == 0 {
+		return fmt.Errorf("could not find module path %q: %w", name, err)
+	}
+	var lastErr error
+	for _, m := range mods {
+		p, err := findModPath(m, deps)
+		if err == nil {
+			err = probe(p, modParams, deps, softdeps, opts)
+		}
+		if err != nil {
+			lastErr = err
+		}
+	}
+	return lastErr
+}
 
+// probe loads the module at modPath with its soft and hard dependencies.
+func probe(modPath, modParams string, deps depMap, softdeps map[string]softdep, opts ProbeOpts) error {
 	dep := deps[modPath]
 
 	if dep.state == builtin || dep.state == loaded {
 		return nil
 	}
 
+	soft := softdeps[modName(modPath)]
 	dep.state = loading
+	loadSoft(soft.pre, deps, opts)
 	for _, d := range dep.deps {
 		if err := loadDeps(d, deps, opts); err != nil {
 			return err
 		}
 	}
-	return loadModule(modPath, modParams, opts)
+	if err := loadModule(modPath, modParams, opts); err != nil {
+		return err
+	}
+	dep.state = loaded
+	loadSoft(soft.post, deps, opts)
+	return nil
+}
+
+// loadSoft loads soft dependencies. They are not needed, so those which
+// cannot be loaded are skipped.
+func loadSoft(names []string, deps depMap, opts ProbeOpts) {
+	for _, n := range names {
+		if p, err := findModPath(n, deps); err == nil {
+			_ = loadDeps(p, deps, opts)
+		}
+	}
 }
 
 func checkBuiltin(moduleDir string, deps depMap) error {
@@ -165,14 +222,14 @@ func checkBuiltin(moduleDir string, deps depMap) error {
 	return scanner.Err()
 }
 
-func genDeps(opts ProbeOpts) (depMap, error) {
-	deps := make(depMap)
+// findModuleDir returns the directory of the modules of the kernel of opts.
+func findModuleDir(opts ProbeOpts) (string, error) {
 	rel := opts.KVer
 
 	if rel == "" {
 		var u unix.Utsname
 		if err := unix.Uname(&u); err != nil {
-			return nil, fmt.Errorf("could not get release (uname -r): %v", err)
+			return "", fmt.Errorf("could not get release (uname -r): %v", err)
 		}
 		rel = string(u.Release[:bytes.IndexByte(u.Release[:], 0)])
 	}
@@ -184,39 +241,37 @@ func genDeps(opts ProbeOpts) (depMap, error) {
 			break
 		}
 	}
+	return moduleDir, nil
+}
+
+func genDeps(moduleDir string, opts ProbeOpts) (depMap, error) {
+	deps := make(depMap)
 
-	f, err := os.Open(filepath.Join(moduleDir, "modules.dep"))
+	lines, err := readDepmod(moduleDir, "modules.dep", ":")
 	if err != nil {
 		return nil, fmt.Errorf("could not open dependency file: %v", err)
 	}
-	defer f.Close()
-
-	scanner := bufio.NewScanner(f)
-	for scanner.Scan() {
-		txt := scanner.Text()
-		nameDeps := strings.Split(txt, ":")
-		modPath, modDeps := nameDeps[0], nameDeps[1]
+	for _, l := range lines {
+		modPath, modDeps := l.key, l.value
+		// modules.dep.bin has the lines of modules.dep by module name.
+		if i := strings.IndexByte(modDeps, ':'); i >= 0 {
+			modPath, modDeps = modDeps[:i], modDeps[i+1:]
+		}
 		modPath = filepath.Join(moduleDir, strings.TrimSpace(modPath))
 
 		var dependency dependency
-		if len(modDeps) > 0 {
-			for _, dep := range strings.Split(strings.TrimSpace(modDeps), " ") {
-				dependency.deps = append(dependency.deps, filepath.Join(moduleDir, dep))
-			}
+		for _, dep := range strings.Fields(modDeps) {
+			dependency.deps = append(dependency.deps, filepath.Join(moduleDir, dep))
 		}
 		deps[modPath] = &dependency
 	}
 
-	if err := scanner.Err(); err != nil {
-		return nil, err
-	}
-
 	if err = checkBuiltin(moduleDir, deps); err != nil {
 		return nil, err
 	}
 
 	if !opts.IgnoreProcMods {
-		fm, err := os.Open("/proc/modules")
+		fm, err := os.Open(procModules)
 		if err == nil {
 			defer fm.Close()
 			genLoadedMods(fm, deps)
@@ -226,6 +281,20 @@ func genDeps(opts ProbeOpts) (depMap, error) {
 	return deps, nil
 }
 
+// uncompressedName returns the file name of the module at mp without the
+// suffix of its compression, if it has one.
+func uncompressedName(mp string) string {
+	base := path.Base(mp)
+	if c := compress.ByExtension(base); c != nil {
+		for _, ext := range c.Extensions {
+			if strings.HasSuffix(base, ext) {
+				return strings.TrimSuffix(base, ext)
+			}
+		}
+	}
+	return base
+}
+
 func findModPath(name string, m depMap) (string, error) {
 	// Kernel modules do not have any consistency with use of hyphens and underscores
 	// matching from the module's name to the module's file path. Thus try matching
@@ -234,15 +303,13 @@ func findModPath(name string, m depMap) (string, error) {
 	nameU := strings.Replace(name, "-", "_", -1)
 
 	for mp := range m {
-		switch path.Base(mp) {
-		case nameH + ".ko", nameH + ".ko.gz", nameH + ".ko.xz":
-			return mp, nil
-		case nameU + ".ko", nameU + ".ko.gz", nameU + ".ko.xz":
+		switch uncompressedName(mp) {
+		case nameH + ".ko", nameU + ".ko":
 			return mp, nil
 		}
 	}
 
-	return "", fmt.Errorf("could not find path for module %q", name)
+	return "", fmt.Errorf("%w: %q", ErrNotFound, name)
 }
 
 func loadDeps(path string, m depMap, opts ProbeOpts) error {
@@ -309,3 +376,127 @@ func genLoadedMods(r io.Reader, deps depMap) error {
 	}
 	return scanner.Err()
 }
+
+// loadedModule is a module of /proc/modules.
+type loadedModule struct {
+	// refs is the number of references to the module, by the modules
+	// which use it and others, e.g. open devices.
+	refs int
+	// users are the modules which use the module.
+	users []string
+}
+
+// readLoadedMods returns the modules of /proc/modules by name.
+func readLoadedMods(r io.Reader) (map[string]*loadedModule, error) {
+	mods := map[string]*loadedModule{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		f := strings.Fields(scanner.Text())
+		if len(f) < 4 {
+			return nil, fmt.Errorf("invalid line %q", scanner.Text())
+		}
+		m := &loadedModule{}
+		// Modules which cannot be unloaded have - as references.
+		if n, err := strconv.Atoi(f[2]); err == nil {
+			m.refs = n
+		} else {
+			m.refs = -1
+		}
+		for _, u := range strings.Split(f[3], ",") {
+			if u != "" && u != "-" && u != "[permanent]" {
+				m.users = append(m.users, u)
+			}
+		}
+		mods[f[0]] = m
+	}
+	return mods, scanner.Err()
+}
+
+// Remove unloads the given kernel module and then those of its dependencies
+// no other module uses.
+// It is calls RemoveOptions with the default ProbeOpts.
+func Remove(name string) error {
+	return RemoveOptions(name, ProbeOpts{})
+}
+
+// RemoveOptions unloads the given kernel module and then those of its
+// dependencies no other module uses, as modprobe -r does. It fails if the
+// module is in use. DryRunCB of opts is called with the path of each module
+// to unload instead of unloading it.
+func RemoveOptions(name string, opts ProbeOpts) error {
+	moduleDir, err := findModuleDir(opts)
+	if err != nil {
+		return err
+	}
+	deps, err := genDeps(moduleDir, ProbeOpts{KVer: opts.KVer, RootDir: opts.RootDir, IgnoreProcMods: true})
+	if err != nil {
+		return fmt.Errorf("could not generate dependency map %v", err)
+	}
+	modPath, err := findModPath(name, deps)
+	if err != nil {
+		return fmt.Errorf("could not find module path %q: %w", name, err)
+	}
+	if deps[modPath].state == builtin {
+		return fmt.Errorf("module %s is builtin", modName(modPath))
+	}
+
+	f, err := os.Open(procModules)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	mods, err := readLoadedMods(f)
+	if err != nil {
+		return fmt.Errorf("%s: %v", procModules, err)
+	}
+
+	m, ok := mods[modName(modPath)]
+	if !ok {
+		return fmt.Errorf("module %s is not loaded", modName(modPath))
+	}
+	if m.refs != 0 {
+		if len(m.users) > 0 {
+			return fmt.Errorf("module %s is in use by %s", modName(modPath), strings.Join(m.users, ", "))
+		}
+		return fmt.Errorf("module %s is in use", modName(modPath))
+	}
+	if err := unloadModule(modPath, mods, opts); err != nil {
+		return err
+	}
+
+	// Unloading a dependency may free others, whatever their order.
+	for freed := true; freed; {
+		freed = false
+		for _, d := range deps[modPath].deps {
+			if m, ok := mods[modName(d)]; ok && m.refs == 0 {
+				if err := unloadModule(d, mods, opts); err != nil {
+					return err
+				}
+				freed = true
+			}
+		}
+	}
+	return nil
+}
+
+// unloadModule unloads the module at path and drops the references it had
+// to the modules it used from mods.
+func unloadModule(path string, mods map[string]*loadedModule, opts ProbeOpts) error {
+	name := modName(path)
+	if opts.DryRunCB != nil {
+		opts.DryRunCB(path)
+	} else if err := Delete(name, unix.O_NONBLOCK); err != nil {
+		return fmt.Errorf("could not unload %s: %v", name, err)
+	}
+	delete(mods, name)
+	for _, m := range mods {
+		for i, u := range m.users {
+			if u == name {
+				m.users = append(m.users[:i], m.users[i+1:]...)
+				m.refs--
+				break
+			}
+		}
+	}
+	return nil
+}
@@ -6,7 +6,13 @@ package kmodule
 
 import (
 	"bytes"
+	"encoding/binary"
+	"io/ioutil"
+	"os"
 	"path"
+	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -32,3 +38,232 @@ func TestGenLoadedMods(t *testing.T) {
 		}
 	}
 }
+
+// writeIndex returns a depmod index of kvs, without prefix nodes.
+func writeIndex(kvs []kv) []byte {
+	b := make([]byte, 12)
+	binary.BigEndian.PutUint32(b, indexMagic)
+	binary.BigEndian.PutUint32(b[4:], indexVersion)
+	var node func(kvs []kv, depth int) uint32
+	node = func(kvs []kv, depth int) uint32 {
+		var values []string
+		children := map[byte][]kv{}
+		first, last := 255, 0
+		for _, kv := range kvs {
+			if len(kv.key) == depth {
+				values = append(values, kv.value)
+				continue
+			}
+			c := kv.key[depth]
+			children[c] = append(children[c], kv)
+			if int(c) < first {
+				first = int(c)
+			}
+			if int(c) > last {
+				last = int(c)
+			}
+		}
+		offsets := map[byte]uint32{}
+		for c, kvs := range children {
+			offsets[c] = node(kvs, depth+1)
+		}
+		offset := uint32(len(b))
+		if len(children) > 0 {
+			offset |= indexNodeChilds
+			b = append(b, byte(first), byte(last))
+			for c := first; c <= last; c++ {
+				b = append(b, make([]byte, 4)...)
+				binary.BigEndian.PutUint32(b[len(b)-4:], offsets[byte(c)])
+			}
+		}
+		if len(values) > 0 {
+			offset |= indexNodeValues
+			b = append(b, make([]byte, 4)...)
+			binary.BigEndian.PutUint32(b[len(b)-4:], uint32(len(values)))
+			for _, v := range values {
+				b = append(b, make([]byte, 4)...)
+				b = append(b, v...)
+				b = append(b, 0)
+			}
+		}
+		return offset
+	}
+	root := node(kvs, 0)
+	binary.BigEndian.PutUint32(b[8:], root)
+	return b
+}
+
+func TestReadIndex(t *testing.T) {
+	want := []kv{
+		{"ab", "1"},
+		{"abc", "2"},
+		{"abc", "3"},
+		{"b", "4"},
+	}
+	got, err := readIndex(writeIndex(want))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("readIndex = %v, want %v", got, want)
+	}
+	if _, err := readIndex([]byte("modules.dep")); err == nil {
+		t.Errorf("readIndex of text succeeded")
+	}
+}
+
+// moduleTree creates the module directory of kernel 5.0.0 in a temporary
+// root directory, and returns the options to use it.
+func moduleTree(t *testing.T, files map[string]string) (ProbeOpts, func()) {
+	root, err := ioutil.TempDir("", "kmodule")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := filepath.Join(root, "lib/modules/5.0.0")
+	for name, content := range files {
+		p := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(p, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return ProbeOpts{RootDir: root, KVer: "5.0.0", IgnoreProcMods: true}, func() { os.RemoveAll(root) }
+}
+
+const modulesDep = `kernel/fs/btrfs/btrfs.ko.xz: kernel/crypto/xor.ko.xz kernel/lib/raid6/raid6_pq.ko.zst kernel/lib/libcrc32c.ko
+kernel/crypto/xor.ko.xz:
+kernel/lib/raid6/raid6_pq.ko.zst:
+kernel/lib/libcrc32c.ko:
+kernel/crypto/crc32c-intel.ko:
+kernel/drivers/net/e1000e/e1000e.ko.gz:
+`
+
+func TestProbeOptions(t *testing.T) {
+	var bin []kv
+	for _, l := range strings.Split(strings.TrimSpace(modulesDep), "\n") {
+		bin = append(bin, kv{modName(strings.Split(l, ":")[0]), l})
+	}
+	for _, tt := range []struct {
+		name  string
+		files map[string]string
+		mod   string
+		want  []string
+	}{
+		{
+			name:  "dependencies",
+			files: map[string]string{"modules.dep": modulesDep},
+			mod:   "btrfs",
+			want:  []string{"crypto/xor.ko.xz", "lib/raid6/raid6_pq.ko.zst", "lib/libcrc32c.ko", "fs/btrfs/btrfs.ko.xz"},
+		},
+		{
+			name:  "index",
+			files: map[string]string{"modules.dep.bin": string(writeIndex(bin))},
+			mod:   "btrfs",
+			want:  []string{"crypto/xor.ko.xz", "lib/raid6/raid6_pq.ko.zst", "lib/libcrc32c.ko", "fs/btrfs/btrfs.ko.xz"},
+		},
+		{
+			name: "builtin",
+			files: map[string]string{
+				"modules.dep":     modulesDep,
+				"modules.builtin": "kernel/crypto/xor.ko\nkernel/lib/raid6/raid6_pq.ko.zst\n",
+			},
+			mod:  "btrfs",
+			want: []string{"crypto/xor.ko.xz", "lib/libcrc32c.ko", "fs/btrfs/btrfs.ko.xz"},
+		},
+		{
+			name: "softdep",
+			files: map[string]string{
+				"modules.dep":     modulesDep,
+				"modules.softdep": "# Soft dependencies extracted from modules themselves.\nsoftdep libcrc32c pre: crc32c-intel post: missing\n",
+			},
+			mod:  "libcrc32c",
+			want: []string{"crypto/crc32c-intel.ko", "lib/libcrc32c.ko"},
+		},
+		{
+			name: "alias",
+			files: map[string]string{
+				"modules.dep":   modulesDep,
+				"modules.alias": "# Aliases extracted from modules themselves.\nalias pci:v00008086d000010D3sv*sd*bc*sc*i* e1000e\nalias fs-btrfs btrfs\n",
+			},
+			mod:  "pci:v00008086d000010D3sv00008086sd0000A01Fbc02sc00i00",
+			want: []string{"drivers/net/e1000e/e1000e.ko.gz"},
+		},
+		{
+			name: "alias index",
+			files: map[string]string{
+				"modules.dep":       modulesDep,
+				"modules.alias.bin": string(writeIndex([]kv{{"fs-btrfs", "btrfs"}})),
+			},
+			mod:  "fs-btrfs",
+			want: []string{"crypto/xor.ko.xz", "lib/raid6/raid6_pq.ko.zst", "lib/libcrc32c.ko", "fs/btrfs/btrfs.ko.xz"},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			opts, cleanup := moduleTree(t, tt.files)
+			defer cleanup()
+			var got []string
+			opts.DryRunCB = func(p string) {
+				got = append(got, strings.TrimPrefix(p, filepath.Join(opts.RootDir, "lib/modules/5.0.0/kernel")+"/"))
+			}
+			if err := ProbeOptions(tt.mod, "", opts); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("loaded %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRemoveOptions(t *testing.T) {
+	opts, cleanup := moduleTree(t, map[string]string{
+		"modules.dep":     modulesDep,
+		"modules.builtin": "kernel/crypto/aes.ko\n",
+	})
+	defer cleanup()
+
+	old := procModules
+	defer func() { procModules = old }()
+	procModules = filepath.Join(opts.RootDir, "modules")
+	if err := ioutil.WriteFile(procModules, []byte(`btrfs 1306624 0 - Live 0x0000000000000000
+raid6_pq 110592 1 btrfs, Live 0x0000000000000000
+libcrc32c 16384 2 btrfs,crc32c_intel, Live 0x0000000000000000
+crc32c_intel 24576 1 - Live 0x0000000000000000
+e1000e 282624 0 - Live 0x0000000000000000
+`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		mod  string
+		want []string
+		err  string
+	}{
+		{mod: "btrfs", want: []string{"btrfs", "raid6_pq"}},
+		{mod: "e1000e", want: []string{"e1000e"}},
+		{mod: "libcrc32c", err: "module libcrc32c is in use by btrfs, crc32c_intel"},
+		{mod: "crc32c-intel", err: "module crc32c_intel is in use"},
+		{mod: "aes", err: "module aes is builtin"},
+		{mod: "missing", err: `could not find module path "missing"`},
+	} {
+		t.Run(tt.mod, func(t *testing.T) {
+			var got []string
+			opts.DryRunCB = func(p string) { got = append(got, modName(p)) }
+			err := RemoveOptions(tt.mod, opts)
+			if tt.err != "" {
+				if err == nil || !strings.HasPrefix(err.Error(), tt.err) {
+					t.Fatalf("RemoveOptions(%s) = %v, want %s", tt.mod, err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("unloaded %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
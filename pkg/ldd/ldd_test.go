@@ -8,6 +8,11 @@ package ldd
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
 	"strings"
 	"testing"
 )
@@ -76,3 +81,74 @@ func TestLddList(t *testing.T) {
 		t.Logf("%v has deps of %v", f, n)
 	}
 }
+
+// TestNeeded tests that following the DT_NEEDED entries of /bin/date finds
+// the libraries the interpreter lists.
+func TestNeeded(t *testing.T) {
+	interp, err := GetInterp("/bin/date")
+	if err != nil || interp == "" {
+		t.Skipf("/bin/date has no interpreter: %v", err)
+	}
+	want, err := runinterp(interp, "/bin/date")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := needed("/bin/date")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The interpreter is needed by libc, but not listed with =>.
+	have := make(map[string]bool)
+	for _, l := range got {
+		have[filepath.Base(l)] = true
+	}
+	for _, l := range want {
+		if !have[filepath.Base(l)] {
+			t.Errorf("needed(/bin/date) = %v, want %v among them", got, l)
+		}
+	}
+}
+
+func TestResolverCache(t *testing.T) {
+	d, err := ioutil.TempDir("", "ldd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(d)
+	b, err := ioutil.ReadFile("/bin/date")
+	if err != nil {
+		t.Skip(err)
+	}
+	if err := os.Mkdir(filepath.Join(d, "sub"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	names := []string{filepath.Join(d, "a"), filepath.Join(d, "b"), filepath.Join(d, "sub", "c")}
+	for _, n := range names {
+		if err := ioutil.WriteFile(n, b, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	r := NewResolver()
+	r.Workers = 2
+	got, err := r.List(names)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// a and b are the same file in the same directory.
+	if len(r.cache) != 2 {
+		t.Errorf("%d files in the cache, want 2", len(r.cache))
+	}
+	again, err := r.List(names)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.cache) != 2 {
+		t.Errorf("%d files in the cache after resolving again, want 2", len(r.cache))
+	}
+	sort.Strings(got)
+	sort.Strings(again)
+	if !reflect.DeepEqual(got, again) {
+		t.Errorf("List from the cache = %v, want %v", again, got)
+	}
+}
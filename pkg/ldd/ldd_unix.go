@@ -19,12 +19,16 @@
 // need to add that file name; if it succeeds, we need to add that file name
 // and repeat with the next link in the chain. We can let the kernel do the
 // work of figuring what to do if and when we hit EMLINK.
+//
+// If there is no interpreter to run, e.g. for binaries of another
+// architecture, or it fails, we follow the DT_NEEDED entries of the
+// dynamic section instead, and look for the libraries the way the dynamic
+// loader does.
 package ldd
 
 import (
 	"debug/elf"
 	"fmt"
-	"log"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -150,68 +154,18 @@ func GetInterp(file string) (string, error) {
 //
 // If a file has no dependencies, that is not an error. The only possible error
 // is if a file does not exist, or it says it has an interpreter but we can't
-// read it, or we are not able to run its interpreter.
+// read it, or we are not able to run its interpreter, nor to find the
+// libraries of its DT_NEEDED entries.
 //
 // It's not an error for a file to not be an ELF.
+//
+// The files are resolved in parallel, and what is found is cached for later
+// calls, see Resolver.
 func Ldd(names []string) ([]*FileInfo, error) {
-	var (
-		list    = make(map[string]*FileInfo)
-		interps = make(map[string]*FileInfo)
-		libs    []*FileInfo
-	)
-	for _, n := range names {
-		if err := follow(n, list); err != nil {
-			return nil, err
-		}
-	}
-	for _, n := range names {
-		interp, err := GetInterp(n)
-		if err != nil {
-			return nil, err
-		}
-		if interp == "" {
-			continue
-		}
-		// We could just append the interp but people
-		// expect to see that first.
-		if interps[interp] == nil {
-			err := follow(interp, interps)
-			if err != nil {
-				return nil, err
-			}
-		}
-		// oh boy. Now to run the interp and get more names.
-		n, err := runinterp(interp, n)
-		if err != nil {
-			return nil, err
-		}
-		for i := range n {
-			if err := follow(n[i], list); err != nil {
-				log.Fatalf("ldd: %v", err)
-			}
-		}
-	}
-
-	for i := range interps {
-		libs = append(libs, interps[i])
-	}
-
-	for i := range list {
-		libs = append(libs, list[i])
-	}
-
-	return libs, nil
+	return defaultResolver.Ldd(names)
 }
 
 // List returns the dependency file paths of files in names.
 func List(names []string) ([]string, error) {
-	var list []string
-	l, err := Ldd(names)
-	if err != nil {
-		return nil, err
-	}
-	for i := range l {
-		list = append(list, l[i].FullName)
-	}
-	return list, nil
+	return defaultResolver.List(names)
 }
@@ -24,3 +24,9 @@ func LdSo(bit64 bool) (string, error) {
 	}
 	return "", fmt.Errorf("could not find ld.so in %v", path)
 }
+
+// libDirs returns the directories the dynamic loader looks for libraries
+// in.
+func libDirs() []string {
+	return []string{"/lib", "/usr/lib", "/usr/local/lib"}
+}
@@ -6,9 +6,15 @@ package ldd
 
 import (
 	"fmt"
+	"io/ioutil"
 	"path/filepath"
+	"strings"
 )
 
+// defaultLibDirs are where the dynamic loader looks for libraries after
+// those of /etc/ld.so.conf.
+var defaultLibDirs = []string{"/lib64", "/usr/lib64", "/lib", "/usr/lib"}
+
 // LdSo finds the loader binary.
 func LdSo(bit64 bool) (string, error) {
 	bits := 32
@@ -27,3 +33,39 @@ func LdSo(bit64 bool) (string, error) {
 	}
 	return "", fmt.Errorf("could not find ld.so in %v", choices)
 }
+
+// libDirs returns the directories the dynamic loader looks for libraries
+// in, those of /etc/ld.so.conf first.
+func libDirs() []string {
+	return append(ldSoConf("/etc/ld.so.conf", nil, 0), defaultLibDirs...)
+}
+
+// ldSoConf appends the directories of the ld.so.conf file name to dirs,
+// following its include lines up to a few levels deep.
+func ldSoConf(name string, dirs []string, depth int) []string {
+	b, err := ioutil.ReadFile(name)
+	if err != nil || depth > 8 {
+		return dirs
+	}
+	for _, line := range strings.Split(string(b), "\n") {
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "", strings.HasPrefix(line, "hwcap "):
+		case strings.HasPrefix(line, "include "):
+			pattern := strings.TrimSpace(strings.TrimPrefix(line, "include "))
+			if !filepath.IsAbs(pattern) {
+				pattern = filepath.Join(filepath.Dir(name), pattern)
+			}
+			files, _ := filepath.Glob(pattern)
+			for _, f := range files {
+				dirs = ldSoConf(f, dirs, depth+1)
+			}
+		default:
+			dirs = append(dirs, line)
+		}
+	}
+	return dirs
+}
@@ -0,0 +1,39 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ldd
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestLdSoConf(t *testing.T) {
+	d, err := ioutil.TempDir("", "ldso")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(d)
+	if err := os.Mkdir(filepath.Join(d, "ld.so.conf.d"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	for n, s := range map[string]string{
+		"ld.so.conf":           "# libraries\n/opt/lib\ninclude ld.so.conf.d/*.conf\n\nhwcap 0 nosegneg\n",
+		"ld.so.conf.d/a.conf":  "/usr/local/lib # local\n",
+		"ld.so.conf.d/b.conf":  "/lib/x86_64-linux-gnu\n",
+		"ld.so.conf.d/c.other": "/nope\n",
+	} {
+		if err := ioutil.WriteFile(filepath.Join(d, n), []byte(s), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	got := ldSoConf(filepath.Join(d, "ld.so.conf"), nil, 0)
+	want := []string{"/opt/lib", "/usr/local/lib", "/lib/x86_64-linux-gnu"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ldSoConf = %q, want %q", got, want)
+	}
+}
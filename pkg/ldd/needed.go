@@ -0,0 +1,110 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build freebsd linux
+
+package ldd
+
+import (
+	"debug/elf"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// needed returns the libraries name needs, from the DT_NEEDED entries of
+// name and, in turn, of those libraries. They are looked for like the
+// dynamic loader does: in the run paths of the object needing them, then
+// in $LD_LIBRARY_PATH, then in the system's library directories. Only
+// libraries of the same class and machine as name are taken.
+func needed(name string) ([]string, error) {
+	f, err := elf.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	class, machine := f.Class, f.Machine
+	f.Close()
+
+	var (
+		libs  []string
+		seen  = make(map[string]bool)
+		queue = []string{name}
+		dirs  = append(filepath.SplitList(os.Getenv("LD_LIBRARY_PATH")), libDirs()...)
+	)
+	for len(queue) > 0 {
+		obj := queue[0]
+		queue = queue[1:]
+		f, err := elf.Open(obj)
+		if err != nil {
+			return nil, err
+		}
+		sonames, err := f.DynString(elf.DT_NEEDED)
+		if err != nil {
+			f.Close()
+			return nil, fmt.Errorf("%s: %v", obj, err)
+		}
+		search := append(runPaths(f, obj), dirs...)
+		f.Close()
+
+		for _, soname := range sonames {
+			if seen[soname] {
+				continue
+			}
+			seen[soname] = true
+			lib, err := findLib(soname, search, class, machine)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %v", obj, err)
+			}
+			libs = append(libs, lib)
+			queue = append(queue, lib)
+		}
+	}
+	return libs, nil
+}
+
+// runPaths returns the DT_RUNPATH, or else DT_RPATH, directories of the
+// object f at path, with $ORIGIN expanded.
+func runPaths(f *elf.File, path string) []string {
+	paths, _ := f.DynString(elf.DT_RUNPATH)
+	if len(paths) == 0 {
+		paths, _ = f.DynString(elf.DT_RPATH)
+	}
+	origin := filepath.Dir(path)
+	if p, err := filepath.EvalSymlinks(path); err == nil {
+		origin = filepath.Dir(p)
+	}
+	var dirs []string
+	for _, p := range paths {
+		for _, d := range filepath.SplitList(p) {
+			d = strings.Replace(d, "${ORIGIN}", origin, -1)
+			dirs = append(dirs, strings.Replace(d, "$ORIGIN", origin, -1))
+		}
+	}
+	return dirs
+}
+
+// findLib returns the first library soname in dirs of the given class and
+// machine. A soname with a slash is a path already.
+func findLib(soname string, dirs []string, class elf.Class, machine elf.Machine) (string, error) {
+	if strings.Contains(soname, "/") {
+		return soname, nil
+	}
+	for _, d := range dirs {
+		if d == "" {
+			continue
+		}
+		p := filepath.Join(d, soname)
+		f, err := elf.Open(p)
+		if err != nil {
+			continue
+		}
+		ok := f.Class == class && f.Machine == machine
+		f.Close()
+		if ok {
+			return p, nil
+		}
+	}
+	return "", fmt.Errorf("%s not found in %v", soname, dirs)
+}
@@ -0,0 +1,211 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build freebsd linux
+
+package ldd
+
+import (
+	"crypto/sha256"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+)
+
+// deps are the direct results of resolving one file: its interpreter, if
+// it exists, and the libraries it needs.
+type deps struct {
+	interp string
+	libs   []string
+}
+
+// cacheKey identifies a file by its contents, and by its directory, since
+// $ORIGIN in run paths depends on where a file is.
+type cacheKey struct {
+	sum [sha256.Size]byte
+	dir string
+}
+
+// Resolver finds the library dependencies of files. It resolves several
+// files at once, and caches what it found for each file, so that files
+// which are seen again, e.g. the same binary in several builds, are not
+// resolved again.
+//
+// A Resolver can be used by several goroutines.
+type Resolver struct {
+	// Workers is how many files are resolved at once. If it is 0, it is
+	// the number of CPUs.
+	Workers int
+
+	mu    sync.Mutex
+	cache map[cacheKey]*deps
+}
+
+// NewResolver returns a Resolver with an empty cache.
+func NewResolver() *Resolver {
+	return &Resolver{cache: make(map[cacheKey]*deps)}
+}
+
+// defaultResolver is used by Ldd and List, so that their callers share
+// its cache.
+var defaultResolver = NewResolver()
+
+func key(name string) (cacheKey, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return cacheKey{}, err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return cacheKey{}, err
+	}
+	k := cacheKey{dir: filepath.Dir(name)}
+	copy(k.sum[:], h.Sum(nil))
+	return k, nil
+}
+
+// resolve resolves one file, or returns what it found for the same file
+// before.
+func (r *Resolver) resolve(name string) (*deps, error) {
+	k, err := key(name)
+	if err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	d, ok := r.cache[k]
+	r.mu.Unlock()
+	if ok {
+		return d, nil
+	}
+	if d, err = resolve(name); err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	r.cache[k] = d
+	r.mu.Unlock()
+	return d, nil
+}
+
+// resolve asks the interpreter of name for its libraries. If there is no
+// interpreter to ask, e.g. for binaries of other architectures or on
+// systems without a dynamic loader, or it fails, the DT_NEEDED entries are
+// followed instead.
+func resolve(name string) (*deps, error) {
+	interp, err := GetInterp(name)
+	if err != nil {
+		// It may be a shared library for which there is no loader.
+		libs, nerr := needed(name)
+		if nerr != nil {
+			return nil, err
+		}
+		return &deps{libs: libs}, nil
+	}
+	if interp == "" {
+		return &deps{}, nil
+	}
+	if _, err := os.Stat(interp); err != nil {
+		interp = ""
+	} else if libs, err := runinterp(interp, name); err == nil {
+		return &deps{interp: interp, libs: libs}, nil
+	}
+	libs, err := needed(name)
+	if err != nil {
+		return nil, err
+	}
+	return &deps{interp: interp, libs: libs}, nil
+}
+
+// resolveAll resolves names with a pool of workers. The results are in the
+// order of names.
+func (r *Resolver) resolveAll(names []string) ([]*deps, error) {
+	workers := r.Workers
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	var (
+		all  = make([]*deps, len(names))
+		errs = make([]error, len(names))
+		next = make(chan int)
+		wg   sync.WaitGroup
+	)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range next {
+				all[i], errs[i] = r.resolve(names[i])
+			}
+		}()
+	}
+	for i := range names {
+		next <- i
+	}
+	close(next)
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return all, nil
+}
+
+// Ldd returns a list of all library dependencies for a set of files, like
+// the package's Ldd.
+func (r *Resolver) Ldd(names []string) ([]*FileInfo, error) {
+	var (
+		list    = make(map[string]*FileInfo)
+		interps = make(map[string]*FileInfo)
+		libs    []*FileInfo
+	)
+	for _, n := range names {
+		if err := follow(n, list); err != nil {
+			return nil, err
+		}
+	}
+	all, err := r.resolveAll(names)
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range all {
+		// We could just append the interp but people
+		// expect to see that first.
+		if d.interp != "" && interps[d.interp] == nil {
+			if err := follow(d.interp, interps); err != nil {
+				return nil, err
+			}
+		}
+		for _, l := range d.libs {
+			if err := follow(l, list); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for i := range interps {
+		libs = append(libs, interps[i])
+	}
+
+	for i := range list {
+		libs = append(libs, list[i])
+	}
+
+	return libs, nil
+}
+
+// List returns the dependency file paths of files in names.
+func (r *Resolver) List(names []string) ([]string, error) {
+	var list []string
+	l, err := r.Ldd(names)
+	if err != nil {
+		return nil, err
+	}
+	for i := range l {
+		list = append(list, l[i].FullName)
+	}
+	return list, nil
+}
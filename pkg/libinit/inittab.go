@@ -0,0 +1,111 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package libinit
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// DefaultInittab is where init reads the services to supervise from.
+const DefaultInittab = "/etc/inittab"
+
+// Actions of inittab entries.
+const (
+	// ActionWait entries run first, one after the other, and init waits
+	// for each to exit.
+	ActionWait = "wait"
+
+	// ActionOnce entries are started after the wait entries, and not
+	// restarted when they exit.
+	ActionOnce = "once"
+
+	// ActionRespawn entries are started after the wait entries, and
+	// restarted whenever they exit.
+	ActionRespawn = "respawn"
+
+	// ActionShutdown entries run one after the other when init shuts the
+	// system down, before the remaining processes are killed.
+	ActionShutdown = "shutdown"
+)
+
+// Entry is a service of an inittab.
+type Entry struct {
+	// TTY is the device the service's stdin, stdout and stderr are
+	// connected to, relative to /dev, e.g. ttyS0. If it is empty, the
+	// service shares init's console.
+	TTY string
+
+	// Action is when the service runs, one of the Action constants.
+	Action string
+
+	// Env are NAME=value pairs added to init's environment.
+	Env []string
+
+	// Args are the command and its arguments.
+	Args []string
+}
+
+func (e Entry) String() string {
+	return strings.Join(append(append([]string(nil), e.Env...), e.Args...), " ")
+}
+
+// ParseInittab parses a busybox-style inittab. Every line that is not
+// empty or a comment is an entry,
+//
+//     tty:runlevels:action:command
+//
+// e.g.
+//
+//     ::wait:/bbin/dhclient -ipv6=false
+//     ttyS0::respawn:TERM=vt100 /bin/sh
+//     ::shutdown:/bbin/sync
+//
+// Runlevels are ignored. The command is split into words at white space,
+// without quoting, and leading NAME=value words set its environment.
+func ParseInittab(r io.Reader) ([]Entry, error) {
+	var entries []Entry
+	s := bufio.NewScanner(r)
+	for n := 1; s.Scan(); n++ {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		f := strings.SplitN(line, ":", 4)
+		if len(f) != 4 {
+			return nil, fmt.Errorf("inittab line %d: want tty:runlevels:action:command, got %q", n, line)
+		}
+		e := Entry{TTY: strings.TrimPrefix(f[0], "/dev/"), Action: f[2]}
+		switch e.Action {
+		case ActionWait, ActionOnce, ActionRespawn, ActionShutdown:
+		default:
+			return nil, fmt.Errorf("inittab line %d: unknown action %q", n, e.Action)
+		}
+		words := strings.Fields(f[3])
+		for len(words) > 0 && strings.Contains(words[0], "=") && !strings.HasPrefix(words[0], "=") {
+			e.Env = append(e.Env, words[0])
+			words = words[1:]
+		}
+		if len(words) == 0 {
+			return nil, fmt.Errorf("inittab line %d: no command", n)
+		}
+		e.Args = words
+		entries = append(entries, e)
+	}
+	return entries, s.Err()
+}
+
+// ReadInittab parses the inittab at path.
+func ReadInittab(path string) ([]Entry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ParseInittab(f)
+}
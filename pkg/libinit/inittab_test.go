@@ -0,0 +1,46 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package libinit
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseInittab(t *testing.T) {
+	const tab = `
+# Comments and empty lines are skipped.
+
+::wait:/bbin/dhclient -ipv6=false
+/dev/ttyS0:2345:respawn:TERM=vt100 HOME=/ /bin/sh
+  ::once:/bbin/sshd -wait-net 30s
+::shutdown:/bbin/sync
+`
+	got, err := ParseInittab(strings.NewReader(tab))
+	if err != nil {
+		t.Fatalf("ParseInittab = %v, want nil", err)
+	}
+	want := []Entry{
+		{Action: ActionWait, Args: []string{"/bbin/dhclient", "-ipv6=false"}},
+		{TTY: "ttyS0", Action: ActionRespawn, Env: []string{"TERM=vt100", "HOME=/"}, Args: []string{"/bin/sh"}},
+		{Action: ActionOnce, Args: []string{"/bbin/sshd", "-wait-net", "30s"}},
+		{Action: ActionShutdown, Args: []string{"/bbin/sync"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseInittab = %+v, want %+v", got, want)
+	}
+
+	for _, tab := range []string{
+		"::respawn",
+		"::sysinit:/bin/sh",
+		"::once:",
+		"::once:FOO=bar",
+	} {
+		if _, err := ParseInittab(strings.NewReader(tab)); err == nil {
+			t.Errorf("ParseInittab(%q) = nil, want error", tab)
+		}
+	}
+}
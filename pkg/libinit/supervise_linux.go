@@ -0,0 +1,276 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package libinit
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+var (
+	// minRespawnDelay and maxRespawnDelay bound how long a respawn
+	// entry waits to be restarted. The delay doubles every time it
+	// exits, until it ran for at least maxRespawnDelay.
+	minRespawnDelay = time.Second
+	maxRespawnDelay = time.Minute
+
+	// killTimeout is how long processes have to exit at shutdown, after
+	// SIGTERM and before SIGKILL. It also bounds each shutdown entry.
+	killTimeout = 5 * time.Second
+)
+
+// ShutdownSignals map the signals which make Supervise shut down to the
+// reboot(2) commands it returns for them, as with busybox's init. The
+// commands are unsigned, as LINUX_REBOOT_CMD_HALT overflows a 32-bit int.
+var ShutdownSignals = map[os.Signal]uint32{
+	unix.SIGTERM: unix.LINUX_REBOOT_CMD_RESTART,
+	unix.SIGUSR1: unix.LINUX_REBOOT_CMD_HALT,
+	unix.SIGUSR2: unix.LINUX_REBOOT_CMD_POWER_OFF,
+}
+
+// service is a running or waiting Entry.
+type service struct {
+	Entry
+	pid     int
+	started time.Time
+	delay   time.Duration
+}
+
+type supervisor struct {
+	debug    func(string, ...interface{})
+	procs    map[int]*service
+	chld     chan os.Signal
+	stop     chan os.Signal
+	restart  chan *service
+	done     chan struct{}
+	stopping bool
+}
+
+// Supervise runs the entries of an inittab until init gets one of the
+// ShutdownSignals: the wait entries one after the other, then the once and
+// respawn entries, restarting the respawn ones with a growing delay when
+// they exit. It reaps all other children meanwhile.
+//
+// At shutdown, it runs the shutdown entries, then sends SIGTERM and, after
+// a while, SIGKILL to the remaining processes: all of them if it is pid 1,
+// else only the services. It returns the reboot(2) command of the signal;
+// syncing and rebooting is up to the caller.
+func Supervise(entries []Entry, debug func(string, ...interface{})) uint32 {
+	s := &supervisor{
+		debug:   debug,
+		procs:   map[int]*service{},
+		chld:    make(chan os.Signal, 1),
+		stop:    make(chan os.Signal, 1),
+		restart: make(chan *service),
+		done:    make(chan struct{}),
+	}
+	signal.Notify(s.chld, unix.SIGCHLD)
+	defer signal.Stop(s.chld)
+	for sig := range ShutdownSignals {
+		signal.Notify(s.stop, sig)
+	}
+	defer signal.Stop(s.stop)
+	defer close(s.done)
+
+	sig := s.run(entries)
+	debug("Got %v, shutting down", sig)
+	s.stopping = true
+	for _, e := range entries {
+		if e.Action == ActionShutdown {
+			s.wait(&service{Entry: e}, time.After(killTimeout))
+		}
+	}
+	s.killAll()
+	return ShutdownSignals[sig]
+}
+
+// run runs the entries until a shutdown signal, and returns it.
+func (s *supervisor) run(entries []Entry) os.Signal {
+	for _, e := range entries {
+		if e.Action == ActionWait {
+			if sig := s.wait(&service{Entry: e}, nil); sig != nil {
+				return sig
+			}
+		}
+	}
+	for _, e := range entries {
+		if e.Action == ActionOnce || e.Action == ActionRespawn {
+			s.start(&service{Entry: e})
+		}
+	}
+	for {
+		select {
+		case <-s.chld:
+			s.reap()
+		case svc := <-s.restart:
+			s.start(svc)
+		case sig := <-s.stop:
+			return sig
+		}
+	}
+}
+
+// wait runs svc and waits for it to exit, for timeout, or, unless
+// stopping, for a shutdown signal, which it returns.
+func (s *supervisor) wait(svc *service, timeout <-chan time.Time) os.Signal {
+	if !s.start(svc) {
+		return nil
+	}
+	for svc.pid != 0 {
+		select {
+		case <-s.chld:
+			s.reap()
+		case r := <-s.restart:
+			s.start(r)
+		case sig := <-s.stop:
+			if !s.stopping {
+				return sig
+			}
+		case <-timeout:
+			s.debug("%v still runs, not waiting for it", svc)
+			return nil
+		}
+	}
+	return nil
+}
+
+// command returns the command of e, connected to its tty.
+func command(e Entry) (*exec.Cmd, error) {
+	// Commands in the PATH are fine too.
+	c := Command(e.Args[0], WithArguments(e.Args[1:]...))
+	if !filepath.IsAbs(e.Args[0]) {
+		p, err := exec.LookPath(e.Args[0])
+		if err != nil {
+			return nil, err
+		}
+		c.Path = p
+		c.Args[0] = e.Args[0]
+	}
+	c.Env = append(os.Environ(), e.Env...)
+	// Services without a tty need their own session as well, but may
+	// not all control init's.
+	c.SysProcAttr.Setctty = false
+	if e.TTY != "" {
+		tty, err := os.OpenFile(filepath.Join("/dev", e.TTY), os.O_RDWR, 0)
+		if err != nil {
+			return nil, err
+		}
+		c.Stdin, c.Stdout, c.Stderr = tty, tty, tty
+		c.SysProcAttr.Setctty = true
+	}
+	return c, nil
+}
+
+// start starts svc, or schedules its restart if it is respawned. It
+// reports whether svc runs.
+func (s *supervisor) start(svc *service) bool {
+	svc.started = time.Now()
+	c, err := command(svc.Entry)
+	if err == nil {
+		err = c.Start()
+		if f, ok := c.Stdin.(*os.File); ok && f != os.Stdin {
+			f.Close()
+		}
+	}
+	if err != nil {
+		s.debug("Error starting %v: %v", svc, err)
+		s.exited(svc)
+		return false
+	}
+	s.debug("Started %v, PID %d", svc, c.Process.Pid)
+	svc.pid = c.Process.Pid
+	s.procs[svc.pid] = svc
+	// The process is reaped by reap, not Wait.
+	c.Process.Release()
+	return true
+}
+
+// exited restarts svc later if it is respawned.
+func (s *supervisor) exited(svc *service) {
+	if svc.Action != ActionRespawn || s.stopping {
+		return
+	}
+	if svc.delay == 0 || time.Since(svc.started) >= maxRespawnDelay {
+		svc.delay = minRespawnDelay
+	} else if svc.delay *= 2; svc.delay > maxRespawnDelay {
+		svc.delay = maxRespawnDelay
+	}
+	s.debug("Restarting %v in %v", svc, svc.delay)
+	time.AfterFunc(svc.delay, func() {
+		select {
+		case s.restart <- svc:
+		case <-s.done:
+		}
+	})
+}
+
+// reap reaps all exited children, and returns how many there are left.
+func (s *supervisor) reap() (int, error) {
+	for {
+		var ws unix.WaitStatus
+		pid, err := unix.Wait4(-1, &ws, unix.WNOHANG, nil)
+		if err != nil {
+			return 0, err
+		}
+		if pid == 0 {
+			return len(s.procs), nil
+		}
+		svc, ok := s.procs[pid]
+		if !ok {
+			s.debug("Reaped PID %d, exit status %d", pid, ws.ExitStatus())
+			continue
+		}
+		delete(s.procs, pid)
+		svc.pid = 0
+		s.debug("%v exited: %v", svc, exitString(ws))
+		s.exited(svc)
+	}
+}
+
+func exitString(ws unix.WaitStatus) string {
+	if ws.Signaled() {
+		return fmt.Sprintf("signal %v", ws.Signal())
+	}
+	return fmt.Sprintf("exit status %d", ws.ExitStatus())
+}
+
+// kill signals the remaining processes: all of them if this is pid 1,
+// else the process groups of the services.
+func (s *supervisor) kill(sig syscall.Signal) {
+	if os.Getpid() == 1 {
+		unix.Kill(-1, sig)
+		return
+	}
+	for pid := range s.procs {
+		unix.Kill(-pid, sig)
+		unix.Kill(pid, sig)
+	}
+}
+
+// killAll sends SIGTERM to the remaining processes, and SIGKILL to those
+// still there after killTimeout, and reaps them.
+func (s *supervisor) killAll() {
+	// remaining reports whether processes remain: any child for pid 1,
+	// supervised ones otherwise.
+	remaining := func() bool {
+		n, err := s.reap()
+		return err == nil && (n > 0 || os.Getpid() == 1)
+	}
+	s.kill(unix.SIGTERM)
+	for end := time.Now().Add(killTimeout); remaining(); time.Sleep(50 * time.Millisecond) {
+		if time.Now().After(end) {
+			s.debug("Killing the remaining processes")
+			s.kill(unix.SIGKILL)
+			end = time.Now().Add(killTimeout)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package libinit
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+func TestSupervise(t *testing.T) {
+	dir, err := ioutil.TempDir("", "supervise")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	defer func(min time.Duration) { minRespawnDelay = min }(minRespawnDelay)
+	minRespawnDelay = 10 * time.Millisecond
+
+	log := filepath.Join(dir, "log")
+	sh := func(action, script string) Entry {
+		return Entry{Action: action, Env: []string{"LOG=" + log}, Args: []string{"sh", "-c", script}}
+	}
+	entries := []Entry{
+		sh(ActionRespawn, `echo respawn >> $LOG; exit 1`),
+		sh(ActionOnce, `echo once >> $LOG; sleep 60`),
+		sh(ActionWait, `sleep 0.1; echo wait >> $LOG`),
+		sh(ActionShutdown, `echo shutdown >> $LOG`),
+	}
+	go func() {
+		time.Sleep(time.Second)
+		unix.Kill(os.Getpid(), unix.SIGUSR2)
+	}()
+	start := time.Now()
+	if cmd := Supervise(entries, t.Logf); cmd != unix.LINUX_REBOOT_CMD_POWER_OFF {
+		t.Errorf("Supervise = %#x, want %#x", cmd, unix.LINUX_REBOOT_CMD_POWER_OFF)
+	}
+	if d := time.Since(start); d > 5*time.Second {
+		t.Errorf("Supervise took %v, want the once entry killed", d)
+	}
+
+	b, err := ioutil.ReadFile(log)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Fields(string(b))
+	count := map[string]int{}
+	for _, l := range lines {
+		count[l]++
+	}
+	if lines[0] != "wait" || lines[len(lines)-1] != "shutdown" {
+		t.Errorf("Log = %q, want wait first and shutdown last", lines)
+	}
+	if count["once"] != 1 || count["respawn"] < 3 {
+		t.Errorf("Log = %q, want once once and respawn respawned", lines)
+	}
+}
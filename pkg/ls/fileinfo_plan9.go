@@ -27,11 +27,11 @@ var unprintableRe = regexp.MustCompile("[[:cntrl:]\n]")
 // `extractImportantParts` populates our own struct which we can modify at will
 // before printing.
 type FileInfo struct {
-	Name  string
-	Mode  os.FileMode
-	UID   string
-	Size  int64
-	MTime time.Time
+	Name  string      `json:"name"`
+	Mode  os.FileMode `json:"mode"`
+	UID   string      `json:"uid"`
+	Size  int64       `json:"size"`
+	MTime time.Time   `json:"mtime"`
 }
 
 // FromOSFileInfo converts os.FileInfo to an ls.FileInfo.
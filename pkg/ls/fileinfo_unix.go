@@ -30,13 +30,14 @@ var unprintableRe = regexp.MustCompile("[[:cntrl:]\n]")
 // `extractImportantParts` populates our own struct which we can modify at will
 // before printing.
 type FileInfo struct {
-	Name          string
-	Mode          os.FileMode
-	Rdev          uint64
-	UID, GID      uint32
-	Size          int64
-	MTime         time.Time
-	SymlinkTarget string
+	Name          string      `json:"name"`
+	Mode          os.FileMode `json:"mode"`
+	Rdev          uint64      `json:"rdev,omitempty"`
+	UID           uint32      `json:"uid"`
+	GID           uint32      `json:"gid"`
+	Size          int64       `json:"size"`
+	MTime         time.Time   `json:"mtime"`
+	SymlinkTarget string      `json:"symlink_target,omitempty"`
 }
 
 // FromOSFileInfo converts os.FileInfo to an ls.FileInfo.
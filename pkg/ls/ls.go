@@ -4,3 +4,15 @@
 
 // Package ls implements formatting tools to list files like the Linux ls tool.
 package ls
+
+import "encoding/json"
+
+// MarshalJSON implements json.Marshaler. The mode is encoded as ls -l
+// prints it, like "drwxr-xr-x".
+func (fi FileInfo) MarshalJSON() ([]byte, error) {
+	type plain FileInfo
+	return json.Marshal(struct {
+		plain
+		Mode string `json:"mode"`
+	}{plain(fi), fi.Mode.String()})
+}
@@ -0,0 +1,175 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mei
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// SysfsClassPath is where the mei driver exports its devices. It can be
+// overridden for testing.
+var SysfsClassPath = "/sys/class/mei"
+
+// PCI config space offsets of the host firmware status registers, HFSTS1
+// to HFSTS6.
+var pciHfsts = []int64{pciMEHfsts1, 0x48, pciMEHfsts3, 0x64, 0x68, 0x6c}
+
+// Values of WorkingState and OperationMode of an ME running normally.
+const (
+	WorkingStateNormal  = meHfs1CwsNormal
+	OperationModeNormal = meHfs1ComNormal
+)
+
+// FWStatus holds the host firmware status registers, HFSTS1 to HFSTS6.
+// Older MEs only have the first two.
+type FWStatus []uint32
+
+// ReadFWStatus reads the firmware status registers of the MEI device at
+// meiPath. The mei driver exports them in sysfs since Linux 4.10; on older
+// kernels they are read from PCI config space.
+func ReadFWStatus(meiPath string) (FWStatus, error) {
+	b, err := ioutil.ReadFile(filepath.Join(SysfsClassPath, filepath.Base(meiPath), "fw_status"))
+	if err == nil {
+		var s FWStatus
+		for _, f := range strings.Fields(string(b)) {
+			v, err := strconv.ParseUint(f, 16, 32)
+			if err != nil {
+				return nil, fmt.Errorf("invalid fw_status %q: %v", f, err)
+			}
+			s = append(s, uint32(v))
+		}
+		return s, nil
+	}
+
+	dev, err := GetMeiPciDevice()
+	if err != nil {
+		return nil, err
+	}
+	var s FWStatus
+	for _, off := range pciHfsts {
+		v, err := dev.ReadConfigRegister(off, 32)
+		if err != nil {
+			return nil, fmt.Errorf("PCI config read failed: %v", err)
+		}
+		s = append(s, uint32(v))
+	}
+	return s, nil
+}
+
+func (s FWStatus) reg(n int) uint32 {
+	if n > len(s) {
+		return 0
+	}
+	return s[n-1]
+}
+
+func bits(v uint32, lo, n uint) uint32 {
+	return (v >> lo) & (1<<n - 1)
+}
+
+// HFSTS1 fields, see union me_hfsts1 in coreboot's
+// src/soc/intel/common/block/include/intelblocks/cse.h .
+
+// WorkingState is the current working state of the ME.
+func (s FWStatus) WorkingState() uint32 { return bits(s.reg(1), 0, 4) }
+
+// ManufacturingMode is set if the ME is in manufacturing mode, which
+// platforms must not ship in.
+func (s FWStatus) ManufacturingMode() bool { return bits(s.reg(1), 4, 1) != 0 }
+
+// FPTBad is set if the flash partition table is corrupt.
+func (s FWStatus) FPTBad() bool { return bits(s.reg(1), 5, 1) != 0 }
+
+// OperationState is the current operation state of the ME.
+func (s FWStatus) OperationState() uint32 { return bits(s.reg(1), 6, 3) }
+
+// InitComplete is set once the ME firmware finished initializing.
+func (s FWStatus) InitComplete() bool { return bits(s.reg(1), 9, 1) != 0 }
+
+// UpdateInProgress is set while the ME firmware is being updated.
+func (s FWStatus) UpdateInProgress() bool { return bits(s.reg(1), 11, 1) != 0 }
+
+// ErrorCode is the error code of the ME.
+func (s FWStatus) ErrorCode() uint32 { return bits(s.reg(1), 12, 4) }
+
+// OperationMode is the current operation mode of the ME.
+func (s FWStatus) OperationMode() uint32 { return bits(s.reg(1), 16, 4) }
+
+// SKU is the firmware SKU, from HFSTS3.
+func (s FWStatus) SKU() uint32 { return bits(s.reg(3), 4, 3) }
+
+var (
+	workingStates = map[uint32]string{
+		0:               "Reset",
+		1:               "Initializing",
+		2:               "Recovery",
+		3:               "Test",
+		4:               "Disabled",
+		meHfs1CwsNormal: "Normal",
+		6:               "Disable Wait",
+		7:               "OS State Transition",
+		8:               "Invalid CPU Plugged In",
+	}
+	operationStates = map[uint32]string{
+		0: "Preboot",
+		1: "M0 with UMA",
+		4: "M3 without UMA",
+		5: "M0 without UMA",
+		6: "Bring Up",
+		7: "M0 without UMA but with error",
+	}
+	operationModes = map[uint32]string{
+		meHfs1ComNormal: "Normal",
+		2:               "Debug",
+		3:               "Soft Temporary Disable",
+		4:               "Security Override via Jumper",
+		5:               "Security Override via MEI Message",
+	}
+	errorCodes = map[uint32]string{
+		0: "No Error",
+		1: "Uncategorized Failure",
+		3: "Image Failure",
+		4: "Debug Failure",
+	}
+	skus = map[uint32]string{
+		2:                 "Consumer",
+		3:                 "Corporate",
+		meHfs3FwSkuCustom: "Lite",
+	}
+)
+
+func name(m map[uint32]string, v uint32) string {
+	if n, ok := m[v]; ok {
+		return fmt.Sprintf("%s (%d)", n, v)
+	}
+	return fmt.Sprintf("Unknown (%d)", v)
+}
+
+// String decodes the status registers, one field per line.
+func (s FWStatus) String() string {
+	var b strings.Builder
+	for i, r := range s {
+		fmt.Fprintf(&b, "HFSTS%d: 0x%08x\n", i+1, r)
+	}
+	if len(s) == 0 {
+		return ""
+	}
+	fmt.Fprintf(&b, "Working state:      %s\n", name(workingStates, s.WorkingState()))
+	fmt.Fprintf(&b, "Operation state:    %s\n", name(operationStates, s.OperationState()))
+	fmt.Fprintf(&b, "Operation mode:     %s\n", name(operationModes, s.OperationMode()))
+	fmt.Fprintf(&b, "Error code:         %s\n", name(errorCodes, s.ErrorCode()))
+	fmt.Fprintf(&b, "Manufacturing mode: %t\n", s.ManufacturingMode())
+	fmt.Fprintf(&b, "FPT bad:            %t\n", s.FPTBad())
+	fmt.Fprintf(&b, "Init complete:      %t\n", s.InitComplete())
+	fmt.Fprintf(&b, "Update in progress: %t\n", s.UpdateInProgress())
+	if len(s) >= 3 {
+		fmt.Fprintf(&b, "Firmware SKU:       %s\n", name(skus, s.SKU()))
+	}
+	return b.String()
+}
@@ -0,0 +1,193 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mei
+
+import (
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestFWStatusFields(t *testing.T) {
+	type fields struct {
+		working, opState, opMode, errCode, sku uint32
+		mfg, fptBad, initDone, updating        bool
+	}
+	for _, tt := range []struct {
+		name string
+		s    FWStatus
+		want fields
+	}{
+		{
+			name: "normal",
+			s:    FWStatus{0x90000245, 0x80000010, 0x00000020},
+			want: fields{working: WorkingStateNormal, opState: 1, opMode: OperationModeNormal, sku: 2, initDone: true},
+		},
+		{
+			name: "updating with errors",
+			s:    FWStatus{0x00031835, 0, 0x00000050},
+			want: fields{working: 5, opMode: 3, errCode: 1, sku: 5, mfg: true, fptBad: true, updating: true},
+		},
+		{
+			name: "recovery without HFSTS3",
+			s:    FWStatus{0x000000c2, 0},
+			want: fields{working: 2, opState: 3},
+		},
+		{name: "empty"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got := fields{
+				working:  tt.s.WorkingState(),
+				opState:  tt.s.OperationState(),
+				opMode:   tt.s.OperationMode(),
+				errCode:  tt.s.ErrorCode(),
+				sku:      tt.s.SKU(),
+				mfg:      tt.s.ManufacturingMode(),
+				fptBad:   tt.s.FPTBad(),
+				initDone: tt.s.InitComplete(),
+				updating: tt.s.UpdateInProgress(),
+			}
+			if got != tt.want {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFWStatusString(t *testing.T) {
+	for _, tt := range []struct {
+		s    FWStatus
+		want string
+	}{
+		{
+			s: FWStatus{0x90000245, 0x80000010, 0x00000020},
+			want: `HFSTS1: 0x90000245
+HFSTS2: 0x80000010
+HFSTS3: 0x00000020
+Working state:      Normal (5)
+Operation state:    M0 with UMA (1)
+Operation mode:     Normal (0)
+Error code:         No Error (0)
+Manufacturing mode: false
+FPT bad:            false
+Init complete:      true
+Update in progress: false
+Firmware SKU:       Consumer (2)
+`,
+		},
+		{
+			s: FWStatus{0x000f0098, 0},
+			want: `HFSTS1: 0x000f0098
+HFSTS2: 0x00000000
+Working state:      Invalid CPU Plugged In (8)
+Operation state:    Unknown (2)
+Operation mode:     Unknown (15)
+Error code:         No Error (0)
+Manufacturing mode: true
+FPT bad:            false
+Init complete:      false
+Update in progress: false
+`,
+		},
+		{s: nil, want: ""},
+	} {
+		if got := tt.s.String(); got != tt.want {
+			t.Errorf("%#x.String() = %q, want %q", []uint32(tt.s), got, tt.want)
+		}
+	}
+}
+
+func TestReadFWStatus(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mei")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	defer func(p string) { SysfsClassPath = p }(SysfsClassPath)
+	SysfsClassPath = dir
+	if err := os.Mkdir(filepath.Join(dir, "mei0"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		name string
+		file string
+		want FWStatus
+		err  bool
+	}{
+		{
+			name: "six registers",
+			file: "90000245\n80000010\n00000020\n00000000\n00000000\n00000000\n",
+			want: FWStatus{0x90000245, 0x80000010, 0x20, 0, 0, 0},
+		},
+		{name: "two registers", file: "1E000255\n60000106\n", want: FWStatus{0x1e000255, 0x60000106}},
+		{name: "garbage", file: "90000245\nnope\n", err: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ioutil.WriteFile(filepath.Join(dir, "mei0", "fw_status"), []byte(tt.file), 0644); err != nil {
+				t.Fatal(err)
+			}
+			got, err := ReadFWStatus("/dev/mei0")
+			if (err != nil) != tt.err {
+				t.Fatalf("ReadFWStatus() = %v, want error %v", err, tt.err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ReadFWStatus() = %#x, want %#x", []uint32(got), []uint32(tt.want))
+			}
+		})
+	}
+}
+
+func TestParseFWVersion(t *testing.T) {
+	unhex := func(s string) []byte {
+		b, err := hex.DecodeString(strings.Replace(s, " ", "", -1))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return b
+	}
+	for _, tt := range []struct {
+		name string
+		b    string
+		want *FWVersion
+		err  bool
+	}{
+		{
+			name: "with FITC",
+			b:    "0000 0f00 0a06 2800 0000 0f00 0a06 2800 0000 0f00 0a06 2800",
+			want: &FWVersion{
+				Code:     Version{Major: 15, Minor: 0, Hotfix: 40, Build: 1546},
+				Recovery: Version{Major: 15, Minor: 0, Hotfix: 40, Build: 1546},
+				FITC:     Version{Major: 15, Minor: 0, Hotfix: 40, Build: 1546},
+			},
+		},
+		{
+			name: "without FITC",
+			b:    "0100 0b00 c204 3000 0000 0b00 4604 1e00",
+			want: &FWVersion{
+				Code:     Version{Major: 11, Minor: 1, Hotfix: 48, Build: 1218},
+				Recovery: Version{Major: 11, Minor: 0, Hotfix: 30, Build: 1094},
+			},
+		},
+		{name: "short", b: "0000 0f00 0a06 2800", err: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseFWVersion(unhex(tt.b))
+			if (err != nil) != tt.err {
+				t.Fatalf("parseFWVersion() = %v, want error %v", err, tt.err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseFWVersion() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+	if got, want := (Version{Major: 15, Minor: 0, Hotfix: 40, Build: 1546}).String(), "15.0.40.1546"; got != want {
+		t.Errorf("Version.String() = %q, want %q", got, want)
+	}
+}
@@ -116,6 +116,18 @@ const (
 	mkhiHMRFPOGetStatus = 0x3
 )
 
+// MKHI generic command IDs.
+const (
+	mkhiGenGetFWVersion = 0x2
+)
+
+// HMRFPO states returned by HMRFPOStatus.
+const (
+	HMRFPODisabled = 0x0
+	HMRFPOLocked   = 0x1
+	HMRFPOEnabled  = 0x2
+)
+
 // OpenMKHI opens an MKHI client connection.
 func OpenMKHI(meiPath string) (*MKHIClient, error) {
 	m, err := OpenMEI(meiPath, MKHIGuid)
@@ -257,6 +269,88 @@ func (m *MKHIClient) EnableHMRFPO() error {
 	return nil
 }
 
+// transact sends an MKHI command with the given payload and returns the
+// payload of the response.
+func (m *MKHIClient) transact(group, command uint8, payload []byte) ([]byte, error) {
+	var hdr mkhiHdr
+	hdr.SetGroupID(group)
+	hdr.SetCommand(command)
+	if _, err := m.MEI.Write(append(hdr[:], payload...)); err != nil {
+		return nil, fmt.Errorf("write to MEI failed: %v", err)
+	}
+	buf := make([]byte, m.MEI.ClientProperties.MaxMsgLength())
+	n, err := m.MEI.Read(buf)
+	if err != nil {
+		return nil, fmt.Errorf("read from MEI failed: %v", err)
+	}
+	if n < len(hdr) {
+		return nil, fmt.Errorf("short MKHI response of %d bytes", n)
+	}
+	var resp mkhiHdr
+	copy(resp[:], buf)
+	if !resp.IsResponse() || resp.GroupID() != group || resp.Command() != command {
+		return nil, fmt.Errorf("unexpected MKHI response to group 0x%02x command 0x%02x: %x", group, command, resp)
+	}
+	if resp.Result() != 0 {
+		return nil, fmt.Errorf("MKHI command 0x%02x/0x%02x failed, result is 0x%02x", group, command, resp.Result())
+	}
+	return buf[len(hdr):n], nil
+}
+
+// Version is the version of an ME firmware partition.
+type Version struct {
+	Major, Minor, Hotfix, Build uint16
+}
+
+func (v Version) String() string {
+	return fmt.Sprintf("%d.%d.%d.%d", v.Major, v.Minor, v.Hotfix, v.Build)
+}
+
+// FWVersion holds the versions of the ME firmware partitions. FITC is not
+// reported by older MEs.
+type FWVersion struct {
+	Code, Recovery, FITC Version
+}
+
+// GetFWVersion returns the ME firmware version.
+func (m *MKHIClient) GetFWVersion() (*FWVersion, error) {
+	b, err := m.transact(mkhiGroupIDGen, mkhiGenGetFWVersion, nil)
+	if err != nil {
+		return nil, err
+	}
+	return parseFWVersion(b)
+}
+
+// parseFWVersion decodes the payload of a get firmware version response.
+func parseFWVersion(b []byte) (*FWVersion, error) {
+	if len(b) < 16 {
+		return nil, fmt.Errorf("short firmware version response of %d bytes", len(b))
+	}
+	// Each version is minor, major, build and hotfix.
+	ver := func(b []byte) Version {
+		le := binary.LittleEndian
+		return Version{Minor: le.Uint16(b), Major: le.Uint16(b[2:]), Build: le.Uint16(b[4:]), Hotfix: le.Uint16(b[6:])}
+	}
+	v := &FWVersion{Code: ver(b), Recovery: ver(b[8:])}
+	if len(b) >= 24 {
+		v.FITC = ver(b[16:])
+	}
+	return v, nil
+}
+
+// HMRFPOStatus returns the state of the Host ME Region Flash Protection
+// Override: one of HMRFPODisabled, HMRFPOLocked and HMRFPOEnabled.
+func (m *MKHIClient) HMRFPOStatus() (uint8, error) {
+	b, err := m.transact(mkhiGroupIDHMRFPO, mkhiHMRFPOGetStatus, nil)
+	if err != nil {
+		return 0, err
+	}
+	if len(b) < 1 {
+		return 0, errors.New("empty HMRFPO status response")
+	}
+	return b[0], nil
+}
+
 // GetMeiPciDevice will return the MEI PCI device object after scanning the PCI
 // bus.
 func GetMeiPciDevice() (*pci.PCI, error) {
@@ -90,6 +90,28 @@ func TestIO(t *testing.T) {
 	}
 }
 
+func TestFault(t *testing.T) {
+	tmpFile, err := ioutil.TempFile("", "io_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpFile.Write(make([]byte, 100))
+	tmpFile.Close()
+	defer os.Remove(tmpFile.Name())
+	memPath = tmpFile.Name()
+	defer func() { memPath = "/dev/mem" }()
+
+	// Pages past the end of the file can be mapped, but not accessed.
+	addr := 4 * pageSize
+	var data Uint32
+	if err := Read(addr, &data); err == nil {
+		t.Errorf("Read(%#x) past the end of the file succeeded", addr)
+	}
+	if err := Write(addr, &data); err == nil {
+		t.Errorf("Write(%#x) past the end of the file succeeded", addr)
+	}
+}
+
 func ExampleRead() {
 	var data Uint32
 	if err := Read(0x1000000, &data); err != nil {
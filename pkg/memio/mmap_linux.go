@@ -7,6 +7,8 @@ package memio
 import (
 	"fmt"
 	"os"
+	"runtime"
+	"runtime/debug"
 	"syscall"
 	"unsafe"
 )
@@ -27,6 +29,23 @@ func mmap(f *os.File, addr int64, size int64, prot int) (mem []byte, offset int6
 	return
 }
 
+// access runs f, which loads from or stores to mapped memory, and returns
+// an error rather than crashing if that faults, e.g. with a SIGBUS for an
+// address no device decodes.
+func access(f func() error) (err error) {
+	defer debug.SetPanicOnFault(debug.SetPanicOnFault(true))
+	defer func() {
+		if r := recover(); r != nil {
+			e, ok := r.(runtime.Error)
+			if !ok {
+				panic(r)
+			}
+			err = e
+		}
+	}()
+	return f()
+}
+
 // Read reads data from physical memory at address addr. On x86 platforms,
 // this uses the seek+read syscalls. On arm platforms, this uses mmap.
 func Read(addr int64, data UintN) error {
@@ -44,7 +63,7 @@ func Read(addr int64, data UintN) error {
 
 	// MMIO makes this a bit tricky. Reads must be conducted in one load
 	// operation. Review the generated assembly to make sure.
-	if err := data.read(unsafe.Pointer(&mem[offset])); err != nil {
+	if err := access(func() error { return data.read(unsafe.Pointer(&mem[offset])) }); err != nil {
 		return fmt.Errorf("Reading %#x/%d: %v", addr, data.Size(), err)
 	}
 	return nil
@@ -67,5 +86,8 @@ func Write(addr int64, data UintN) error {
 
 	// MMIO makes this a bit tricky. Writes must be conducted in one store
 	// operation. Review the generated assembly to make sure.
-	return data.write(unsafe.Pointer(&mem[offset]))
+	if err := access(func() error { return data.write(unsafe.Pointer(&mem[offset])) }); err != nil {
+		return fmt.Errorf("Writing %#x/%d: %v", addr, data.Size(), err)
+	}
+	return nil
 }
@@ -0,0 +1,387 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mkfs
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"time"
+)
+
+const (
+	ext4SuperOff     = 1024
+	ext4SuperSize    = 1024
+	ext4DescSize     = 32
+	ext4InodeSize    = 256
+	ext4ExtraIsize   = 32
+	ext4FirstIno     = 11
+	ext4RootIno      = 2
+	ext4LostFoundIno = 11
+	// mke2fs makes lost+found that large, so that fsck does not have to
+	// allocate blocks to fill it.
+	ext4LostFoundSize = 16384
+
+	ext4IncompatFiletype    = 0x2
+	ext4IncompatExtents     = 0x40
+	ext4RoCompatSparseSuper = 0x1
+	ext4RoCompatLargeFile   = 0x2
+	ext4RoCompatGDTCsum     = 0x10
+	ext4RoCompatExtraIsize  = 0x40
+
+	ext4InodeUninit  = 0x1
+	ext4ITableZeroed = 0x4
+
+	ext4ExtentsFl = 0x80000
+	ext4DirMode   = 0x4000
+)
+
+// Ext4Options are the options of an ext4 file system.
+type Ext4Options struct {
+	// Label is the volume name, up to 16 bytes.
+	Label string
+	// UUID is the file system UUID. The zero UUID is a random one.
+	UUID [16]byte
+	// BlockSize is 1024, 2048 or 4096. 0 is 1024 below 512 MiB, 4096
+	// otherwise, as for mke2fs.
+	BlockSize int
+	// InodeRatio is the number of bytes per inode. 0 is 16384.
+	InodeRatio int
+	// ReservedPercent is the percentage of blocks only root can use.
+	// mke2fs reserves 5.
+	ReservedPercent int
+}
+
+// ext4Group is a block group.
+type ext4Group struct {
+	start, blocks uint64
+	super         bool
+	// The bitmaps and the inode table follow the superblock and group
+	// descriptors, if any.
+	blockBitmap uint64
+	// used is the number of blocks in use from start.
+	used uint64
+}
+
+type ext4Layout struct {
+	bs, blocks, first   uint64
+	perGroup, inodes    uint64
+	gdtBlocks, itBlocks uint64
+	groups              []ext4Group
+}
+
+// hasSuper returns whether group g has a superblock with sparse_super:
+// groups 0, 1 and powers of 3, 5 and 7.
+func hasSuper(g uint64) bool {
+	if g <= 1 {
+		return true
+	}
+	for _, p := range []uint64{3, 5, 7} {
+		n := p
+		for n < g {
+			n *= p
+		}
+		if n == g {
+			return true
+		}
+	}
+	return false
+}
+
+func newExt4Layout(bs, blocks, ratio uint64) (*ext4Layout, error) {
+	for {
+		l := &ext4Layout{bs: bs, blocks: blocks, perGroup: 8 * bs}
+		if bs == 1024 {
+			l.first = 1
+		}
+		if blocks <= l.first {
+			return nil, fmt.Errorf("%d blocks is too small for ext4", blocks)
+		}
+		n := (blocks - l.first + l.perGroup - 1) / l.perGroup
+		ipg := (blocks*bs/ratio + n - 1) / n
+		// Whole blocks of inodes, and whole bytes of the bitmap.
+		m := bs / ext4InodeSize
+		if m < 8 {
+			m = 8
+		}
+		ipg = (ipg + m - 1) / m * m
+		if ipg < 16 {
+			ipg = 16
+		}
+		if ipg > 8*bs {
+			ipg = 8 * bs
+		}
+		l.inodes = ipg
+		l.itBlocks = ipg * ext4InodeSize / bs
+		l.gdtBlocks = (n*ext4DescSize + bs - 1) / bs
+
+		shrink := false
+		for g := uint64(0); g < n; g++ {
+			grp := ext4Group{start: l.first + g*l.perGroup, super: hasSuper(g)}
+			grp.blocks = blocks - grp.start
+			if grp.blocks > l.perGroup {
+				grp.blocks = l.perGroup
+			}
+			grp.blockBitmap = grp.start
+			if grp.super {
+				grp.blockBitmap += 1 + l.gdtBlocks
+			}
+			grp.used = grp.blockBitmap + 2 + l.itBlocks - grp.start
+			// mke2fs drops a last group too small to be of use.
+			if g > 0 && g == n-1 && grp.blocks < grp.used+50 {
+				shrink = true
+				break
+			}
+			if grp.used > grp.blocks {
+				return nil, fmt.Errorf("%d blocks is too small for ext4", blocks)
+			}
+			l.groups = append(l.groups, grp)
+		}
+		if !shrink {
+			return l, nil
+		}
+		blocks = l.first + (n-1)*l.perGroup
+	}
+}
+
+var crc16Table = func() (t [256]uint16) {
+	for i := range t {
+		c := uint16(i)
+		for j := 0; j < 8; j++ {
+			if c&1 != 0 {
+				c = c>>1 ^ 0xa001
+			} else {
+				c >>= 1
+			}
+		}
+		t[i] = c
+	}
+	return
+}()
+
+// crc16 is the CRC-16 of Linux' lib/crc16.c, which group descriptors use
+// with uninit_bg.
+func crc16(crc uint16, b []byte) uint16 {
+	for _, c := range b {
+		crc = crc>>8 ^ crc16Table[byte(crc)^c]
+	}
+	return crc
+}
+
+// setBits sets bits from to to of the bitmap b.
+func setBits(b []byte, from, to uint64) {
+	for i := from; i < to; i++ {
+		b[i/8] |= 1 << (i % 8)
+	}
+}
+
+// ext4DirInode returns a directory inode of the n blocks from first.
+func ext4DirInode(mode, links uint16, first, n, bs uint64, now uint32) []byte {
+	le := binary.LittleEndian
+	b := make([]byte, ext4InodeSize)
+	le.PutUint16(b, ext4DirMode|mode)
+	le.PutUint32(b[0x4:], uint32(n*bs))
+	for _, off := range []int{0x8, 0xc, 0x10, 0x90} {
+		le.PutUint32(b[off:], now)
+	}
+	le.PutUint16(b[0x1a:], links)
+	le.PutUint32(b[0x1c:], uint32(n*bs/512))
+	le.PutUint32(b[0x20:], ext4ExtentsFl)
+	// The extent tree in i_block: a header, and a single extent.
+	le.PutUint16(b[0x28:], 0xf30a)
+	le.PutUint16(b[0x2a:], 1)
+	le.PutUint16(b[0x2c:], 4)
+	le.PutUint16(b[0x38:], uint16(n))
+	le.PutUint32(b[0x3c:], uint32(first))
+	le.PutUint16(b[0x80:], ext4ExtraIsize)
+	return b
+}
+
+// dirent writes a directory entry of a directory.
+func dirent(b []byte, ino uint32, recLen int, name string) {
+	binary.LittleEndian.PutUint32(b, ino)
+	binary.LittleEndian.PutUint16(b[4:], uint16(recLen))
+	b[6] = byte(len(name))
+	if ino != 0 {
+		b[7] = 2
+	}
+	copy(b[8:], name)
+}
+
+// Ext4 creates an ext4 file system of size bytes on w, with the
+// sparse_super, extents, filetype, large_file and uninit_bg features and
+// without a journal. o may be nil.
+//
+// Only the inode table of the first group is written; the kernel zeroes the
+// others in the background once mounted.
+func Ext4(w io.WriterAt, size int64, o *Ext4Options) error {
+	if o == nil {
+		o = &Ext4Options{}
+	}
+	bs := uint64(o.BlockSize)
+	if bs == 0 {
+		bs = 4096
+		if size < 512<<20 {
+			bs = 1024
+		}
+	}
+	if bs != 1024 && bs != 2048 && bs != 4096 {
+		return fmt.Errorf("block size %d is not 1024, 2048 or 4096", bs)
+	}
+	ratio := uint64(o.InodeRatio)
+	if ratio == 0 {
+		ratio = 16384
+	}
+	if ratio < bs {
+		return fmt.Errorf("inode ratio %d is less than the block size %d", ratio, bs)
+	}
+	if len(o.Label) > 16 {
+		return fmt.Errorf("label %q is longer than 16 bytes", o.Label)
+	}
+	if o.ReservedPercent < 0 || o.ReservedPercent > 50 {
+		return fmt.Errorf("reserved percentage %d is not within 0-50", o.ReservedPercent)
+	}
+	uuid := o.UUID
+	if uuid == [16]byte{} {
+		var err error
+		if uuid, err = randomUUID(); err != nil {
+			return err
+		}
+	}
+	blocks := uint64(size) / bs
+	if blocks > 0xffffffff {
+		return fmt.Errorf("%d blocks is more than ext4 without 64bit can address", blocks)
+	}
+	l, err := newExt4Layout(bs, blocks, ratio)
+	if err != nil {
+		return err
+	}
+
+	// The root directory and lost+found are right after the first
+	// group's inode table.
+	g0 := &l.groups[0]
+	root := g0.start + g0.used
+	lostFound := root + 1
+	lfBlocks := ext4LostFoundSize / bs
+	g0.used += 1 + lfBlocks
+	if g0.used > g0.blocks {
+		return fmt.Errorf("%d blocks is too small for ext4", blocks)
+	}
+
+	le := binary.LittleEndian
+	now := uint32(time.Now().Unix())
+	var free uint64
+	for _, g := range l.groups {
+		free += g.blocks - g.used
+	}
+	inodes := l.inodes * uint64(len(l.groups))
+
+	sb := make([]byte, ext4SuperSize)
+	le.PutUint32(sb[0x0:], uint32(inodes))
+	le.PutUint32(sb[0x4:], uint32(l.blocks))
+	le.PutUint32(sb[0x8:], uint32(l.blocks*uint64(o.ReservedPercent)/100))
+	le.PutUint32(sb[0xc:], uint32(free))
+	le.PutUint32(sb[0x10:], uint32(inodes-ext4FirstIno))
+	le.PutUint32(sb[0x14:], uint32(l.first))
+	var log uint32
+	for 1024<<log < bs {
+		log++
+	}
+	le.PutUint32(sb[0x18:], log)
+	le.PutUint32(sb[0x1c:], log)
+	le.PutUint32(sb[0x20:], uint32(l.perGroup))
+	le.PutUint32(sb[0x24:], uint32(l.perGroup))
+	le.PutUint32(sb[0x28:], uint32(l.inodes))
+	le.PutUint32(sb[0x30:], now)
+	le.PutUint16(sb[0x36:], 0xffff)
+	le.PutUint16(sb[0x38:], 0xef53)
+	// Clean, and continue on errors.
+	le.PutUint16(sb[0x3a:], 1)
+	le.PutUint16(sb[0x3c:], 1)
+	le.PutUint32(sb[0x40:], now)
+	le.PutUint32(sb[0x4c:], 1)
+	le.PutUint32(sb[0x54:], ext4FirstIno)
+	le.PutUint16(sb[0x58:], ext4InodeSize)
+	le.PutUint32(sb[0x60:], ext4IncompatFiletype|ext4IncompatExtents)
+	le.PutUint32(sb[0x64:], ext4RoCompatSparseSuper|ext4RoCompatLargeFile|ext4RoCompatGDTCsum|ext4RoCompatExtraIsize)
+	copy(sb[0x68:], uuid[:])
+	copy(sb[0x78:], o.Label)
+	if _, err := io.ReadFull(rand.Reader, sb[0xec:0xfc]); err != nil {
+		return err
+	}
+	le.PutUint32(sb[0x108:], now)
+	le.PutUint16(sb[0x15c:], ext4ExtraIsize)
+	le.PutUint16(sb[0x15e:], ext4ExtraIsize)
+
+	gdt := make([]byte, l.gdtBlocks*bs)
+	for i, g := range l.groups {
+		d := gdt[i*ext4DescSize : (i+1)*ext4DescSize]
+		freeInodes, dirs, flags := l.inodes, 0, ext4InodeUninit
+		if i == 0 {
+			freeInodes, dirs, flags = l.inodes-ext4FirstIno, 2, ext4ITableZeroed
+		}
+		le.PutUint32(d[0x0:], uint32(g.blockBitmap))
+		le.PutUint32(d[0x4:], uint32(g.blockBitmap+1))
+		le.PutUint32(d[0x8:], uint32(g.blockBitmap+2))
+		le.PutUint16(d[0xc:], uint16(g.blocks-g.used))
+		le.PutUint16(d[0xe:], uint16(freeInodes))
+		le.PutUint16(d[0x10:], uint16(dirs))
+		le.PutUint16(d[0x12:], uint16(flags))
+		le.PutUint16(d[0x1c:], uint16(freeInodes))
+		var n [4]byte
+		le.PutUint32(n[:], uint32(i))
+		le.PutUint16(d[0x1e:], crc16(crc16(crc16(0xffff, uuid[:]), n[:]), d[:0x1e]))
+	}
+
+	if l.first != 0 {
+		if err := zero(w, 0, int64(l.first*bs)); err != nil {
+			return err
+		}
+	}
+	for i, g := range l.groups {
+		// The superblock, group descriptors and bitmaps.
+		meta := make([]byte, (g.blockBitmap+2-g.start)*bs)
+		if g.super {
+			off := uint64(0)
+			if g.start == 0 {
+				off = ext4SuperOff
+			}
+			copy(meta[off:], sb)
+			le.PutUint16(meta[off+0x5a:], uint16(i))
+			copy(meta[bs:], gdt)
+		}
+		bb := meta[(g.blockBitmap-g.start)*bs:]
+		setBits(bb, 0, g.used)
+		setBits(bb, g.blocks, 8*bs)
+		ib := meta[(g.blockBitmap+1-g.start)*bs:]
+		if i == 0 {
+			setBits(ib, 0, ext4FirstIno)
+		}
+		setBits(ib, l.inodes, 8*bs)
+		if _, err := w.WriteAt(meta, int64(g.start*bs)); err != nil {
+			return err
+		}
+	}
+
+	// The first inode table, root and lost+found.
+	data := make([]byte, (l.itBlocks+1+lfBlocks)*bs)
+	copy(data[(ext4RootIno-1)*ext4InodeSize:], ext4DirInode(0755, 3, root, 1, bs, now))
+	copy(data[(ext4LostFoundIno-1)*ext4InodeSize:], ext4DirInode(0700, 2, lostFound, lfBlocks, bs, now))
+	b := data[l.itBlocks*bs:]
+	dirent(b, ext4RootIno, 12, ".")
+	dirent(b[12:], ext4RootIno, 12, "..")
+	dirent(b[24:], ext4LostFoundIno, int(bs)-24, "lost+found")
+	b = b[bs:]
+	dirent(b, ext4LostFoundIno, 12, ".")
+	dirent(b[12:], ext4RootIno, int(bs)-12, "..")
+	for i := uint64(1); i < lfBlocks; i++ {
+		dirent(b[i*bs:], 0, int(bs), "")
+	}
+	if _, err := w.WriteAt(data, int64((g0.blockBitmap+2)*bs)); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mkfs
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	fatSectorSize      = 512
+	fatReservedSectors = 32
+	fatCount           = 2
+	// FAT32 needs at least that many clusters, or it is FAT16.
+	fatMinClusters = 65525
+	fatMaxClusters = 0x0ffffff5
+)
+
+// FATOptions are the options of a FAT32 file system.
+type FATOptions struct {
+	// Label is the volume label, up to 11 characters. It is upper cased.
+	Label string
+	// VolumeID is the serial number, shown as the UUID, e.g. 1234-ABCD. 0
+	// is a random one.
+	VolumeID uint32
+	// SectorsPerCluster is a power of 2 up to 128. 0 is Microsoft's
+	// default for the size.
+	SectorsPerCluster int
+}
+
+// sectorsPerCluster returns the cluster size Microsoft's format uses for a
+// FAT32 of sectors sectors.
+func sectorsPerCluster(sectors uint64) int {
+	switch {
+	case sectors <= 532480:
+		return 1
+	case sectors <= 16777216:
+		return 8
+	case sectors <= 33554432:
+		return 16
+	case sectors <= 67108864:
+		return 32
+	}
+	return 64
+}
+
+// FAT32 creates a FAT32 file system of size bytes on w. o may be nil.
+func FAT32(w io.WriterAt, size int64, o *FATOptions) error {
+	if o == nil {
+		o = &FATOptions{}
+	}
+	sectors := uint64(size) / fatSectorSize
+	if sectors > 0xffffffff {
+		return fmt.Errorf("%d sectors is more than FAT32 can address", sectors)
+	}
+	spc := o.SectorsPerCluster
+	if spc == 0 {
+		spc = sectorsPerCluster(sectors)
+	}
+	if spc < 1 || spc > 128 || spc&(spc-1) != 0 {
+		return fmt.Errorf("%d sectors per cluster is not a power of 2 up to 128", spc)
+	}
+	label := strings.ToUpper(o.Label)
+	if label == "" {
+		label = "NO NAME"
+	}
+	if len(label) > 11 {
+		return fmt.Errorf("label %q is longer than 11 characters", o.Label)
+	}
+	label = fmt.Sprintf("%-11s", label)
+	id := o.VolumeID
+	if id == 0 {
+		var b [4]byte
+		if _, err := io.ReadFull(rand.Reader, b[:]); err != nil {
+			return err
+		}
+		id = binary.LittleEndian.Uint32(b[:])
+	}
+
+	// The size of a FAT, as in Microsoft's specification.
+	if sectors <= fatReservedSectors {
+		return fmt.Errorf("%d bytes is too small for FAT32", size)
+	}
+	d := uint64(256*spc+fatCount) / 2
+	fatSize := (sectors - fatReservedSectors + d - 1) / d
+	dataStart := fatReservedSectors + fatCount*fatSize
+	if dataStart >= sectors {
+		return fmt.Errorf("%d bytes is too small for FAT32", size)
+	}
+	clusters := (sectors - dataStart) / uint64(spc)
+	if clusters < fatMinClusters || clusters >= fatMaxClusters {
+		return fmt.Errorf("%d clusters of %d sectors, FAT32 needs %d to %d", clusters, spc, fatMinClusters, fatMaxClusters-1)
+	}
+
+	le := binary.LittleEndian
+	bs := make([]byte, fatSectorSize)
+	copy(bs, []byte{0xeb, 0x58, 0x90})
+	copy(bs[3:], "u-root  ")
+	le.PutUint16(bs[11:], fatSectorSize)
+	bs[13] = byte(spc)
+	le.PutUint16(bs[14:], fatReservedSectors)
+	bs[16] = fatCount
+	// No root directory entries and 16-bit sizes, this is FAT32.
+	bs[21] = 0xf8
+	le.PutUint16(bs[24:], 32)
+	le.PutUint16(bs[26:], 64)
+	le.PutUint32(bs[32:], uint32(sectors))
+	le.PutUint32(bs[36:], uint32(fatSize))
+	// The root directory is the first cluster, the FSInfo and the backup
+	// boot sectors are where everyone puts them.
+	le.PutUint32(bs[44:], 2)
+	le.PutUint16(bs[48:], 1)
+	le.PutUint16(bs[50:], 6)
+	bs[64] = 0x80
+	bs[66] = 0x29
+	le.PutUint32(bs[67:], id)
+	copy(bs[71:], label)
+	copy(bs[82:], "FAT32   ")
+	// The jump lands here: int 18h, no bootable disk, and loop.
+	copy(bs[0x5a:], []byte{0xcd, 0x18, 0xeb, 0xfe})
+	bs[510], bs[511] = 0x55, 0xaa
+
+	info := make([]byte, fatSectorSize)
+	le.PutUint32(info, 0x41615252)
+	le.PutUint32(info[484:], 0x61417272)
+	// The root directory uses cluster 2.
+	le.PutUint32(info[488:], uint32(clusters-1))
+	le.PutUint32(info[492:], 3)
+	le.PutUint32(info[508:], 0xaa550000)
+
+	fat := make([]byte, 12)
+	le.PutUint32(fat, 0x0ffffff8)
+	le.PutUint32(fat[4:], 0x0fffffff)
+	le.PutUint32(fat[8:], 0x0fffffff)
+
+	// The label is also a volume label entry of the root directory.
+	root := make([]byte, 32)
+	if o.Label != "" {
+		copy(root, label)
+		root[11] = 0x08
+	}
+
+	clusterSize := int64(spc) * fatSectorSize
+	if err := zero(w, 0, int64(dataStart)*fatSectorSize+clusterSize); err != nil {
+		return err
+	}
+	for _, s := range []struct {
+		sector uint64
+		b      []byte
+	}{
+		{0, bs},
+		{1, info},
+		{6, bs},
+		{7, info},
+		{fatReservedSectors, fat},
+		{fatReservedSectors + fatSize, fat},
+		{dataStart, root},
+	} {
+		if _, err := w.WriteAt(s.b, int64(s.sector)*fatSectorSize); err != nil {
+			return err
+		}
+	}
+	return nil
+}
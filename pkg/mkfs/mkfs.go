@@ -0,0 +1,57 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package mkfs creates FAT32 and ext4 file systems, and swap areas.
+//
+// The file systems are empty but for the root directory, and lost+found
+// for ext4, and have the features Linux and UEFI firmware need, no more.
+package mkfs
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ParseUUID parses a UUID in its text form,
+// 0fc63daf-8483-4772-8e79-3d69d8477de4. The bytes are in text order, as
+// ext4 stores them.
+func ParseUUID(s string) ([16]byte, error) {
+	var u [16]byte
+	b, err := hex.DecodeString(strings.Replace(s, "-", "", 4))
+	if err != nil || len(b) != len(u) || len(s) != 36 {
+		return u, fmt.Errorf("invalid UUID %q", s)
+	}
+	copy(u[:], b)
+	return u, nil
+}
+
+// randomUUID returns a random, version 4, UUID.
+func randomUUID() ([16]byte, error) {
+	var u [16]byte
+	if _, err := io.ReadFull(rand.Reader, u[:]); err != nil {
+		return u, err
+	}
+	u[6] = u[6]&0x0f | 0x40
+	u[8] = u[8]&0x3f | 0x80
+	return u, nil
+}
+
+// zero writes n zero bytes at off.
+func zero(w io.WriterAt, off, n int64) error {
+	b := make([]byte, 1<<20)
+	for n > 0 {
+		if n < int64(len(b)) {
+			b = b[:n]
+		}
+		if _, err := w.WriteAt(b, off); err != nil {
+			return err
+		}
+		off += int64(len(b))
+		n -= int64(len(b))
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mkfs
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/u-root/u-root/pkg/blkid"
+)
+
+func image(t *testing.T, size int64) *os.File {
+	f, err := ioutil.TempFile("", "mkfs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Truncate(size); err != nil {
+		t.Fatal(err)
+	}
+	return f
+}
+
+func TestParseUUID(t *testing.T) {
+	u, err := ParseUUID("0fc63daf-8483-4772-8e79-3d69d8477de4")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u[0] != 0x0f || u[15] != 0xe4 {
+		t.Errorf("ParseUUID() = %x", u)
+	}
+	for _, s := range []string{"", "0fc63daf84834772-8e79-3d69d8477de4", "0fc63daf-8483-4772-8e79-3d69d8477de4-", "zfc63daf-8483-4772-8e79-3d69d8477de4"} {
+		if _, err := ParseUUID(s); err == nil {
+			t.Errorf("ParseUUID(%q) succeeded", s)
+		}
+	}
+}
+
+func TestFAT32(t *testing.T) {
+	f := image(t, 64<<20)
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	if err := FAT32(f, 64<<20, &FATOptions{Label: "efi", VolumeID: 0x1234abcd}); err != nil {
+		t.Fatal(err)
+	}
+	i, err := blkid.Probe(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (blkid.Info{Type: "vfat", Label: "EFI", UUID: "1234-ABCD"}); *i != want {
+		t.Errorf("Probe() = %+v, want %+v", i, want)
+	}
+	if fsck, err := exec.LookPath("fsck.vfat"); err == nil {
+		if out, err := exec.Command(fsck, "-n", f.Name()).CombinedOutput(); err != nil {
+			t.Errorf("fsck.vfat: %v\n%s", err, out)
+		}
+	}
+
+	for _, tt := range []struct {
+		size int64
+		o    *FATOptions
+	}{
+		{16 << 20, nil},
+		{64 << 20, &FATOptions{SectorsPerCluster: 3}},
+		{64 << 20, &FATOptions{Label: "MUCH TOO LONG"}},
+	} {
+		if err := FAT32(f, tt.size, tt.o); err == nil {
+			t.Errorf("FAT32(%d, %+v) succeeded", tt.size, tt.o)
+		}
+	}
+}
+
+func TestExt4(t *testing.T) {
+	for _, tt := range []struct {
+		size int64
+		bs   int
+	}{
+		{8 << 20, 0},
+		{300 << 20, 4096},
+		// The last group is too small, and dropped.
+		{64<<20 + 4<<10, 2048},
+	} {
+		f := image(t, tt.size)
+		defer os.Remove(f.Name())
+		defer f.Close()
+
+		o := &Ext4Options{Label: "root", BlockSize: tt.bs, ReservedPercent: 5}
+		o.UUID, _ = ParseUUID("0fc63daf-8483-4772-8e79-3d69d8477de4")
+		if err := Ext4(f, tt.size, o); err != nil {
+			t.Fatal(err)
+		}
+		i, err := blkid.Probe(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := (blkid.Info{Type: "ext4", Label: "root", UUID: "0fc63daf-8483-4772-8e79-3d69d8477de4"}); *i != want {
+			t.Errorf("Probe() = %+v, want %+v", i, want)
+		}
+		if fsck, err := exec.LookPath("e2fsck"); err == nil {
+			if out, err := exec.Command(fsck, "-fn", f.Name()).CombinedOutput(); err != nil {
+				t.Errorf("e2fsck of %d bytes with blocks of %d: %v\n%s", tt.size, tt.bs, err, out)
+			}
+		}
+	}
+
+	f := image(t, 1<<20)
+	defer os.Remove(f.Name())
+	defer f.Close()
+	for _, o := range []*Ext4Options{
+		{BlockSize: 512},
+		{Label: "a label longer than 16"},
+		{InodeRatio: 512},
+	} {
+		if err := Ext4(f, 1<<20, o); err == nil {
+			t.Errorf("Ext4(%+v) succeeded", o)
+		}
+	}
+	if err := Ext4(f, 16<<10, nil); err == nil {
+		t.Errorf("Ext4 of 16 KiB succeeded")
+	}
+}
+
+func TestSwap(t *testing.T) {
+	f := image(t, 1<<20)
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	o := &SwapOptions{Label: "swap0", PageSize: 4096}
+	o.UUID, _ = ParseUUID("0657fd6d-a4ab-43c4-84e5-0933c84b4f4f")
+	if err := Swap(f, 1<<20, o); err != nil {
+		t.Fatal(err)
+	}
+	i, err := blkid.Probe(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (blkid.Info{Type: "swap", Label: "swap0", UUID: "0657fd6d-a4ab-43c4-84e5-0933c84b4f4f"}); *i != want {
+		t.Errorf("Probe() = %+v, want %+v", i, want)
+	}
+
+	for _, tt := range []struct {
+		size int64
+		o    *SwapOptions
+	}{
+		{9 * 4096, &SwapOptions{PageSize: 4096}},
+		{1 << 20, &SwapOptions{PageSize: 1000}},
+		{1 << 20, &SwapOptions{Label: "a label longer than 16"}},
+	} {
+		if err := Swap(f, tt.size, tt.o); err == nil {
+			t.Errorf("Swap(%d, %+v) succeeded", tt.size, tt.o)
+		}
+	}
+}
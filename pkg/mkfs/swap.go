@@ -0,0 +1,67 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mkfs
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+)
+
+// SwapOptions are the options of a swap area.
+type SwapOptions struct {
+	// Label is up to 16 bytes.
+	Label string
+	// UUID is the swap area's UUID. The zero UUID is a random one.
+	UUID [16]byte
+	// PageSize is the page size of the kernel that swaps to the area,
+	// which must be the one of the running kernel. 0 is that one.
+	PageSize int
+}
+
+// Swap makes a swap area of size bytes on w, with a version 1 header, as
+// for swapon(2).
+func Swap(w io.WriterAt, size int64, o *SwapOptions) error {
+	if o == nil {
+		o = &SwapOptions{}
+	}
+	ps := int64(o.PageSize)
+	if ps == 0 {
+		ps = int64(os.Getpagesize())
+	}
+	if ps < 4096 || ps&(ps-1) != 0 {
+		return fmt.Errorf("page size %d is not a power of 2 from 4096", ps)
+	}
+	if len(o.Label) > 16 {
+		return fmt.Errorf("label %q is longer than 16 bytes", o.Label)
+	}
+	// The kernel wants at least 10 pages.
+	pages := size / ps
+	if pages < 10 {
+		return fmt.Errorf("%d bytes is too small for a swap area of pages of %d", size, ps)
+	}
+	if pages-1 > 0xffffffff {
+		pages = 0xffffffff + 1
+	}
+	uuid := o.UUID
+	if uuid == [16]byte{} {
+		var err error
+		if uuid, err = randomUUID(); err != nil {
+			return err
+		}
+	}
+
+	// The header is after the boot sector, the signature at the end of
+	// the first page.
+	p := make([]byte, ps)
+	binary.LittleEndian.PutUint32(p[1024:], 1)
+	binary.LittleEndian.PutUint32(p[1028:], uint32(pages-1))
+	copy(p[1036:], uuid[:])
+	copy(p[1052:], o.Label)
+	copy(p[ps-10:], "SWAPSPACE2")
+	_, err := w.WriteAt(p, 0)
+	return err
+}
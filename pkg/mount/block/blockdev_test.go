@@ -110,3 +110,19 @@ func TestParsePCIBlockList(t *testing.T) {
 		})
 	}
 }
+
+func TestSplitSpec(t *testing.T) {
+	for _, tt := range []struct {
+		spec, kind, value string
+	}{
+		{"/dev/sda1", "", "/dev/sda1"},
+		{"LABEL=BOOT", SpecLabel, "BOOT"},
+		{`UUID="ace5-5144"`, SpecUUID, "ace5-5144"},
+		{"PARTUUID=1234-5678", SpecPartUUID, "1234-5678"},
+		{"server:/export=x", "", "server:/export=x"},
+	} {
+		if kind, value := SplitSpec(tt.spec); kind != tt.kind || value != tt.value {
+			t.Errorf("SplitSpec(%q) = %q, %q, want %q, %q", tt.spec, kind, value, tt.kind, tt.value)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package block
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/u-root/u-root/pkg/blkid"
+)
+
+// FSLabel returns the label of the file system on the block device.
+func (b *BlockDev) FSLabel() (string, error) {
+	i, err := blkid.ProbeFile(b.DevicePath())
+	if err != nil {
+		return "", err
+	}
+	return i.Label, nil
+}
+
+// FilterFSLabel returns the block devices whose file system has the given
+// label.
+func (b BlockDevices) FilterFSLabel(label string) BlockDevices {
+	var devices BlockDevices
+	for _, device := range b {
+		if l, err := device.FSLabel(); err == nil && l == label {
+			devices = append(devices, device)
+		}
+	}
+	return devices
+}
+
+// The kinds of device specs taken by Resolve.
+const (
+	SpecLabel    = "LABEL"
+	SpecUUID     = "UUID"
+	SpecPartUUID = "PARTUUID"
+)
+
+// SplitSpec splits a device spec like "LABEL=BOOT" into its kind and value.
+// The kind of a plain device path, like /dev/sda1, is empty.
+func SplitSpec(spec string) (kind, value string) {
+	i := strings.IndexByte(spec, '=')
+	if i < 0 {
+		return "", spec
+	}
+	switch k := spec[:i]; k {
+	case SpecLabel, SpecUUID, SpecPartUUID:
+		return k, strings.Trim(spec[i+1:], `"`)
+	}
+	return "", spec
+}
+
+// only returns the single device of devices.
+func only(devices BlockDevices, spec string) (*BlockDev, error) {
+	switch len(devices) {
+	case 0:
+		return nil, fmt.Errorf("no block device with %s", spec)
+	case 1:
+		return devices[0], nil
+	}
+	return nil, fmt.Errorf("%d block devices with %s: %v", len(devices), spec, devices)
+}
+
+// DeviceByLabel returns the block device with a file system labeled label.
+func DeviceByLabel(label string) (*BlockDev, error) {
+	devices, err := GetBlockDevices()
+	if err != nil {
+		return nil, err
+	}
+	return only(devices.FilterFSLabel(label), SpecLabel+"="+label)
+}
+
+// DeviceByUUID returns the block device whose file system has the given
+// UUID. Case does not matter.
+func DeviceByUUID(uuid string) (*BlockDev, error) {
+	devices, err := GetBlockDevices()
+	if err != nil {
+		return nil, err
+	}
+	var matches BlockDevices
+	for _, device := range devices {
+		if strings.EqualFold(device.FsUUID, uuid) {
+			matches = append(matches, device)
+		}
+	}
+	return only(matches, SpecUUID+"="+uuid)
+}
+
+// DeviceByPartUUID returns the GPT partition with the given unique partition
+// GUID.
+func DeviceByPartUUID(partUUID string) (*BlockDev, error) {
+	devices, err := GetBlockDevices()
+	if err != nil {
+		return nil, err
+	}
+	return only(devices.FilterPartID(partUUID), SpecPartUUID+"="+partUUID)
+}
+
+// Resolve returns the path of the device named by spec, which is either a
+// path, returned as is, or LABEL=, UUID= or PARTUUID= followed by a value,
+// as in fstab.
+func Resolve(spec string) (string, error) {
+	var (
+		device *BlockDev
+		err    error
+	)
+	switch kind, value := SplitSpec(spec); kind {
+	case SpecLabel:
+		device, err = DeviceByLabel(value)
+	case SpecUUID:
+		device, err = DeviceByUUID(value)
+	case SpecPartUUID:
+		device, err = DeviceByPartUUID(value)
+	default:
+		return spec, nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return device.DevicePath(), nil
+}
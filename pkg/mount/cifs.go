@@ -0,0 +1,179 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mount
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+)
+
+// lookupIP resolves CIFS server names. Tests override it.
+var lookupIP = net.LookupIP
+
+// cifsVersions maps the accepted values of vers= to the kernel's.
+var cifsVersions = map[string]string{
+	"1":       "1.0",
+	"1.0":     "1.0",
+	"2":       "2.0",
+	"2.0":     "2.0",
+	"2.1":     "2.1",
+	"3":       "3",
+	"smb3":    "3",
+	"3.0":     "3.0",
+	"3.02":    "3.02",
+	"3.1.1":   "3.1.1",
+	"3.11":    "3.1.1",
+	"default": "default",
+}
+
+// cifsSecurity are the values of sec= the kernel knows.
+var cifsSecurity = map[string]bool{
+	"none":     true,
+	"krb5":     true,
+	"krb5i":    true,
+	"ntlm":     true,
+	"ntlmi":    true,
+	"ntlmv2":   true,
+	"ntlmv2i":  true,
+	"ntlmssp":  true,
+	"ntlmsspi": true,
+}
+
+// readCIFSCredentials reads a credentials file of username=, password= and
+// domain= lines, as mount.cifs takes.
+func readCIFSCredentials(path string) (map[string]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("cifs credentials: %v", err)
+	}
+	defer f.Close()
+	creds := map[string]string{}
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		kv := strings.SplitN(strings.TrimSpace(s.Text()), "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		switch kv[0] {
+		case "username", "user":
+			creds["username"] = kv[1]
+		case "password", "pass":
+			creds["password"] = kv[1]
+		case "domain", "dom", "workgroup":
+			creds["domain"] = kv[1]
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, fmt.Errorf("cifs credentials: %v", err)
+	}
+	return creds, nil
+}
+
+// CIFSOptions turns the mount options of a cifs mount of source, like
+// //server/share, into the ones the kernel takes, as mount.cifs does:
+//
+//   - credentials=FILE is replaced by the username, password and domain in
+//     FILE,
+//   - user, pass and dom are spelled out, and user=name%pass and
+//     user=domain/name are split,
+//   - vers= and sec= are checked and normalized,
+//   - ip= is added with the address of the server, which the kernel does not
+//     resolve.
+//
+// If there is a user but no password, and the mount is not sec=none or
+// guest, password is called to ask for it. It may be nil.
+func CIFSOptions(source string, options []string, password func(user string) (string, error)) ([]string, error) {
+	if !strings.HasPrefix(source, "//") && !strings.HasPrefix(source, `\\`) {
+		return nil, fmt.Errorf("cifs: source %q is not //server/share", source)
+	}
+	creds := map[string]string{}
+	var rest []string
+	var guest, hasIP, noPassword bool
+	for _, opt := range options {
+		kv := strings.SplitN(opt, "=", 2)
+		k, v := kv[0], ""
+		if len(kv) == 2 {
+			v = kv[1]
+		}
+		switch k {
+		case "credentials", "cred":
+			c, err := readCIFSCredentials(v)
+			if err != nil {
+				return nil, err
+			}
+			for ck, cv := range c {
+				if _, ok := creds[ck]; !ok {
+					creds[ck] = cv
+				}
+			}
+		case "username", "user":
+			if i := strings.IndexByte(v, '%'); i >= 0 {
+				creds["password"] = v[i+1:]
+				v = v[:i]
+			}
+			if i := strings.IndexAny(v, `/\`); i >= 0 {
+				creds["domain"] = v[:i]
+				v = v[i+1:]
+			}
+			creds["username"] = v
+		case "password", "pass":
+			creds["password"] = v
+		case "domain", "dom", "workgroup":
+			creds["domain"] = v
+		case "vers":
+			vers, ok := cifsVersions[strings.ToLower(v)]
+			if !ok {
+				return nil, fmt.Errorf("cifs: unknown protocol version vers=%s", v)
+			}
+			rest = append(rest, "vers="+vers)
+		case "sec":
+			v = strings.ToLower(v)
+			if !cifsSecurity[v] {
+				return nil, fmt.Errorf("cifs: unknown security mode sec=%s", v)
+			}
+			noPassword = noPassword || v == "none" || strings.HasPrefix(v, "krb5")
+			rest = append(rest, "sec="+v)
+		case "guest":
+			guest = true
+			rest = append(rest, opt)
+		case "ip", "addr":
+			hasIP = true
+			rest = append(rest, "ip="+v)
+		default:
+			rest = append(rest, opt)
+		}
+	}
+
+	user, ok := creds["username"]
+	if _, hasPass := creds["password"]; ok && !hasPass && !guest && !noPassword && password != nil {
+		p, err := password(user)
+		if err != nil {
+			return nil, fmt.Errorf("cifs: reading password: %v", err)
+		}
+		creds["password"] = p
+	}
+	var o []string
+	for _, k := range []string{"username", "password", "domain"} {
+		if v, ok := creds[k]; ok {
+			o = append(o, k+"="+v)
+		}
+	}
+
+	if !hasIP {
+		server := strings.FieldsFunc(source, func(r rune) bool { return r == '/' || r == '\\' })
+		if len(server) == 0 {
+			return nil, fmt.Errorf("cifs: no server in %q", source)
+		}
+		ips, err := lookupIP(server[0])
+		if err != nil || len(ips) == 0 {
+			return nil, fmt.Errorf("cifs: could not resolve %s: %v", server[0], err)
+		}
+		rest = append(rest, "ip="+ips[0].String())
+	}
+	return append(o, rest...), nil
+}
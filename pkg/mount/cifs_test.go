@@ -0,0 +1,65 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mount
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestCIFSOptions(t *testing.T) {
+	d, err := ioutil.TempDir("", "cifs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(d)
+	creds := filepath.Join(d, "creds")
+	if err := ioutil.WriteFile(creds, []byte("username=alice\n  password=s3cret\ndomain=CORP\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	lookupIP = func(host string) ([]net.IP, error) {
+		return []net.IP{net.IPv4(192, 168, 0, 2)}, nil
+	}
+	defer func() { lookupIP = net.LookupIP }()
+	ask := func(user string) (string, error) { return "asked-" + user, nil }
+
+	for _, tt := range []struct {
+		opts []string
+		want []string
+		err  bool
+	}{
+		{
+			opts: []string{"credentials=" + creds, "vers=SMB3", "ro"},
+			want: []string{"username=alice", "password=s3cret", "domain=CORP", "vers=3", "ro", "ip=192.168.0.2"},
+		},
+		{
+			opts: []string{"user=CORP/bob", "sec=NTLMSSP", "addr=10.0.0.1"},
+			want: []string{"username=bob", "password=asked-bob", "domain=CORP", "sec=ntlmssp", "ip=10.0.0.1"},
+		},
+		{
+			opts: []string{"user=bob%pw", "pass=other"},
+			want: []string{"username=bob", "password=other", "ip=192.168.0.2"},
+		},
+		{
+			opts: []string{"user=bob", "guest"},
+			want: []string{"username=bob", "guest", "ip=192.168.0.2"},
+		},
+		{opts: []string{"vers=4"}, err: true},
+		{opts: []string{"sec=plain"}, err: true},
+		{opts: []string{"credentials=" + filepath.Join(d, "missing")}, err: true},
+	} {
+		got, err := CIFSOptions("//server/share", tt.opts, ask)
+		if (err != nil) != tt.err || (err == nil && !reflect.DeepEqual(got, tt.want)) {
+			t.Errorf("CIFSOptions(%q) = %q, %v, want %q, error %t", tt.opts, got, err, tt.want, tt.err)
+		}
+	}
+	if _, err := CIFSOptions("server:/share", nil, nil); err == nil {
+		t.Errorf("CIFSOptions(server:/share) succeeded")
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mount
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// FstabEntry is an entry of fstab(5).
+type FstabEntry struct {
+	// Spec is the device or remote file system to mount, e.g. /dev/sda1,
+	// LABEL=BOOT or server:/export.
+	Spec string
+
+	// File is the mount point.
+	File string
+
+	// VfsType is the file system type. "auto" or empty means it is to be
+	// detected.
+	VfsType string
+
+	// Options are the mount options. Entries without any get "defaults".
+	Options []string
+
+	// Freq is the dump frequency. It is unused.
+	Freq int
+
+	// PassNo is the fsck order. It is unused.
+	PassNo int
+}
+
+// HasOption returns whether e has the option o.
+func (e FstabEntry) HasOption(o string) bool {
+	for _, opt := range e.Options {
+		if opt == o {
+			return true
+		}
+	}
+	return false
+}
+
+// IsSwap returns whether e is a swap area rather than a file system.
+func (e FstabEntry) IsSwap() bool {
+	return e.VfsType == "swap"
+}
+
+// unescapeFstab decodes the \ooo octal escapes of fstab fields, used for
+// spaces (\040) and tabs (\011).
+func unescapeFstab(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+4 <= len(s) {
+			if n, err := strconv.ParseUint(s[i+1:i+4], 8, 8); err == nil {
+				b.WriteByte(byte(n))
+				i += 3
+				continue
+			}
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+// ParseFstab parses the fstab(5) format. Blank lines and comments starting
+// with # are skipped. The fields after the mount point are optional.
+func ParseFstab(r io.Reader) ([]FstabEntry, error) {
+	var entries []FstabEntry
+	s := bufio.NewScanner(r)
+	for line := 1; s.Scan(); line++ {
+		f := strings.Fields(s.Text())
+		if len(f) == 0 || strings.HasPrefix(f[0], "#") {
+			continue
+		}
+		if len(f) < 2 || len(f) > 6 {
+			return nil, fmt.Errorf("fstab line %d: got %d fields, want 2 to 6", line, len(f))
+		}
+		e := FstabEntry{
+			Spec:    unescapeFstab(f[0]),
+			File:    unescapeFstab(f[1]),
+			VfsType: "auto",
+			Options: []string{"defaults"},
+		}
+		if len(f) > 2 {
+			e.VfsType = f[2]
+		}
+		if len(f) > 3 {
+			e.Options = strings.Split(unescapeFstab(f[3]), ",")
+		}
+		for i, p := range []*int{&e.Freq, &e.PassNo} {
+			if len(f) <= 4+i {
+				break
+			}
+			n, err := strconv.Atoi(f[4+i])
+			if err != nil {
+				return nil, fmt.Errorf("fstab line %d: field %d: %v", line, 5+i, err)
+			}
+			*p = n
+		}
+		entries = append(entries, e)
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// ReadFstab parses the fstab file at path, usually /etc/fstab.
+func ReadFstab(path string) ([]FstabEntry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ParseFstab(f)
+}
+
+// below returns whether path is strictly below dir.
+func below(path, dir string) bool {
+	path, dir = filepath.Clean(path), filepath.Clean(dir)
+	if path == dir {
+		return false
+	}
+	return dir == "/" || strings.HasPrefix(path, dir+"/")
+}
+
+// SortFstab orders entries so that every mount point comes after the mount
+// points it is below, e.g. /usr before /usr/local, whatever their order in
+// the file. Otherwise the order of the file is kept.
+func SortFstab(entries []FstabEntry) {
+	sorted := make([]FstabEntry, 0, len(entries))
+	done := make([]bool, len(entries))
+	var visit func(i int)
+	visit = func(i int) {
+		done[i] = true
+		for j := range entries {
+			if !done[j] && below(entries[i].File, entries[j].File) {
+				visit(j)
+			}
+		}
+		sorted = append(sorted, entries[i])
+	}
+	for i := range entries {
+		if !done[i] {
+			visit(i)
+		}
+	}
+	copy(entries, sorted)
+}
@@ -0,0 +1,62 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mount
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const fstab = `# /etc/fstab
+/dev/sda2	/		ext4	defaults	0 1
+/dev/sda1	/boot/efi	vfat	umask=0077	0 2
+
+LABEL=home	/home		auto
+/dev/sda3	none		swap	sw		0 0
+server:/usr/local /usr/local nfs ro,noauto
+/dev/sda4	/usr		xfs	noatime
+/dev/sda5	/boot		ext2	defaults
+/dev/sda6	/mnt/my\040disk	ext4
+`
+
+func TestParseFstab(t *testing.T) {
+	entries, err := ParseFstab(strings.NewReader(fstab))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []FstabEntry{
+		{Spec: "/dev/sda2", File: "/", VfsType: "ext4", Options: []string{"defaults"}, PassNo: 1},
+		{Spec: "/dev/sda1", File: "/boot/efi", VfsType: "vfat", Options: []string{"umask=0077"}, PassNo: 2},
+		{Spec: "LABEL=home", File: "/home", VfsType: "auto", Options: []string{"defaults"}},
+		{Spec: "/dev/sda3", File: "none", VfsType: "swap", Options: []string{"sw"}},
+		{Spec: "server:/usr/local", File: "/usr/local", VfsType: "nfs", Options: []string{"ro", "noauto"}},
+		{Spec: "/dev/sda4", File: "/usr", VfsType: "xfs", Options: []string{"noatime"}},
+		{Spec: "/dev/sda5", File: "/boot", VfsType: "ext2", Options: []string{"defaults"}},
+		{Spec: "/dev/sda6", File: "/mnt/my disk", VfsType: "ext4", Options: []string{"defaults"}},
+	}
+	if !reflect.DeepEqual(entries, want) {
+		t.Errorf("ParseFstab() =\n%+v\nwant\n%+v", entries, want)
+	}
+	if !entries[4].HasOption("noauto") || entries[0].HasOption("noauto") || !entries[3].IsSwap() {
+		t.Errorf("HasOption or IsSwap are wrong")
+	}
+
+	SortFstab(entries)
+	var files []string
+	for _, e := range entries {
+		files = append(files, e.File)
+	}
+	wantFiles := []string{"/", "/boot", "/boot/efi", "/home", "none", "/usr", "/usr/local", "/mnt/my disk"}
+	if !reflect.DeepEqual(files, wantFiles) {
+		t.Errorf("SortFstab() order = %q, want %q", files, wantFiles)
+	}
+
+	for _, bad := range []string{"/dev/sda1\n", "/dev/sda1 / ext4 defaults x 1\n"} {
+		if _, err := ParseFstab(strings.NewReader(bad)); err == nil {
+			t.Errorf("ParseFstab(%q) succeeded", bad)
+		}
+	}
+}
@@ -0,0 +1,404 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gpt
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"unicode/utf16"
+)
+
+// partBlocks is the number of blocks of a partition array of MaxNPart
+// entries of 128 bytes.
+const partBlocks = MaxNPart * 128 / BlockSize
+
+// alignment is where new partitions start by default, 1 MiB, as fdisk and
+// sgdisk do.
+const alignment = 1 << 20 / BlockSize
+
+// ErrNoSpace is returned when a partition does not fit.
+var ErrNoSpace = errors.New("no space for partition")
+
+// Types are partition types, by the names sgdisk and systemd use.
+var Types = map[string]GUID{
+	"efi":   MustParseGUID("c12a7328-f81f-11d2-ba4b-00a0c93ec93b"),
+	"bios":  MustParseGUID("21686148-6449-6e6f-744e-656564454649"),
+	"linux": MustParseGUID("0fc63daf-8483-4772-8e79-3d69d8477de4"),
+	"swap":  MustParseGUID("0657fd6d-a4ab-43c4-84e5-0933c84b4f4f"),
+	"home":  MustParseGUID("933ac7e1-2eb4-4f13-b844-0e14e2aef915"),
+	"lvm":   MustParseGUID("e6d6d379-f507-44c2-a23c-238f2a3df928"),
+	"raid":  MustParseGUID("a19d880f-05fc-4d3b-a006-743f0f84911e"),
+	"root":  MustParseGUID("4f68bce3-e8cd-4db1-96e7-fbcaf984b709"),
+}
+
+// ParseGUID parses a GUID in its text form,
+// 0fc63daf-8483-4772-8e79-3d69d8477de4.
+func ParseGUID(s string) (GUID, error) {
+	var g GUID
+	f := strings.Split(s, "-")
+	if len(f) != 5 || len(f[0]) != 8 || len(f[1]) != 4 || len(f[2]) != 4 || len(f[3]) != 4 || len(f[4]) != 12 {
+		return g, fmt.Errorf("invalid GUID %q", s)
+	}
+	b, err := hex.DecodeString(strings.Join(f, ""))
+	if err != nil {
+		return g, fmt.Errorf("invalid GUID %q", s)
+	}
+	g.L = binary.BigEndian.Uint32(b)
+	g.W1 = binary.BigEndian.Uint16(b[4:])
+	g.W2 = binary.BigEndian.Uint16(b[6:])
+	copy(g.B[:], b[8:])
+	return g, nil
+}
+
+// MustParseGUID is ParseGUID panicking on errors, for constants.
+func MustParseGUID(s string) GUID {
+	g, err := ParseGUID(s)
+	if err != nil {
+		panic(err)
+	}
+	return g
+}
+
+// ParseType returns the GUID of a type name of Types, or of a GUID.
+func ParseType(s string) (GUID, error) {
+	if g, ok := Types[strings.ToLower(s)]; ok {
+		return g, nil
+	}
+	return ParseGUID(s)
+}
+
+// NewGUID returns a random, version 4, GUID.
+func NewGUID() (GUID, error) {
+	var b [16]byte
+	if _, err := io.ReadFull(rand.Reader, b[:]); err != nil {
+		return GUID{}, err
+	}
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return GUID{
+		L:  binary.BigEndian.Uint32(b[:]),
+		W1: binary.BigEndian.Uint16(b[4:]),
+		W2: binary.BigEndian.Uint16(b[6:]),
+		B:  [8]byte{b[8], b[9], b[10], b[11], b[12], b[13], b[14], b[15]},
+	}, nil
+}
+
+// Text returns the name, which is UTF-16LE.
+func (n PartName) Text() string {
+	var u []uint16
+	for i := 0; i < len(n); i += 2 {
+		c := binary.LittleEndian.Uint16(n[i:])
+		if c == 0 {
+			break
+		}
+		u = append(u, c)
+	}
+	return string(utf16.Decode(u))
+}
+
+// NewPartName returns s as a partition name. s is cut to 36 UTF-16 code
+// units.
+func NewPartName(s string) PartName {
+	var n PartName
+	u := utf16.Encode([]rune(s))
+	for i := 0; i < len(u) && 2*i < len(n); i++ {
+		binary.LittleEndian.PutUint16(n[2*i:], u[i])
+	}
+	return n
+}
+
+// IsUsed returns whether the entry is a partition.
+func (p *Part) IsUsed() bool {
+	return p.PartGUID != GUID{}
+}
+
+// ProtectiveMBR returns the MBR of a GPT on a disk of sectors blocks: a
+// single partition of type 0xee covering the disk, up to what MBRs can
+// address.
+func ProtectiveMBR(sectors uint64) *MBR {
+	var m MBR
+	size := sectors - 1
+	if size > 0xffffffff {
+		size = 0xffffffff
+	}
+	e := m[446:462]
+	// Status, first CHS 0/0/2, type, last CHS, first LBA and size.
+	copy(e, []byte{0x00, 0x00, 0x02, 0x00, 0xee, 0xff, 0xff, 0xff})
+	binary.LittleEndian.PutUint32(e[8:], 1)
+	binary.LittleEndian.PutUint32(e[12:], uint32(size))
+	m[510], m[511] = 0x55, 0xaa
+	return &m
+}
+
+// NewTable returns an empty partition table for a disk of sectors blocks,
+// with a protective MBR and a random disk GUID.
+func NewTable(sectors uint64) (*PartitionTable, error) {
+	if sectors < 2*(1+partBlocks)+2 {
+		return nil, fmt.Errorf("disk of %d blocks is too small for a GPT", sectors)
+	}
+	g, err := NewGUID()
+	if err != nil {
+		return nil, err
+	}
+	p := &PartitionTable{
+		MasterBootRecord: ProtectiveMBR(sectors),
+		Primary: &GPT{
+			Header: Header{
+				Signature:  Signature,
+				Revision:   Revision,
+				HeaderSize: HeaderSize,
+				CurrentLBA: 1,
+				BackupLBA:  sectors - 1,
+				FirstLBA:   2 + partBlocks,
+				LastLBA:    sectors - 2 - partBlocks,
+				DiskGUID:   g,
+				PartStart:  2,
+				NPart:      MaxNPart,
+				PartSize:   128,
+			},
+			Parts: make([]Part, MaxNPart),
+		},
+	}
+	p.syncBackup()
+	return p, nil
+}
+
+// syncBackup makes the backup GPT a copy of the primary one, at the end of
+// the disk.
+func (p *PartitionTable) syncBackup() {
+	h := p.Primary.Header
+	h.CurrentLBA, h.BackupLBA = p.Primary.BackupLBA, p.Primary.CurrentLBA
+	h.PartStart = p.Primary.BackupLBA - partBlocks
+	p.Backup = &GPT{Header: h, Parts: append([]Part(nil), p.Primary.Parts...)}
+}
+
+// Recover reads the partition table of a disk of sectors blocks, like New,
+// but uses the backup GPT if the primary one is damaged, and the other way
+// around. The GPTs are moved for the disk size, e.g. after it grew, and a
+// missing protective MBR is added. The result is to be written back.
+func Recover(r io.ReaderAt, sectors uint64) (*PartitionTable, error) {
+	p := &PartitionTable{MasterBootRecord: &MBR{}}
+	if _, err := r.ReadAt(p.MasterBootRecord[:], 0); err != nil {
+		return nil, err
+	}
+	g, perr := Table(r, HeaderOff)
+	if perr != nil {
+		b, berr := Table(r, int64(sectors-1)*BlockSize)
+		if berr != nil {
+			return nil, fmt.Errorf("no valid GPT: %v; %v", perr, berr)
+		}
+		g = b
+		g.CurrentLBA, g.BackupLBA = 1, b.CurrentLBA
+		g.PartStart = 2
+	}
+	if g.NPart*g.PartSize > partBlocks*BlockSize {
+		return nil, fmt.Errorf("partition array of %d entries of %d bytes does not fit %d blocks", g.NPart, g.PartSize, partBlocks)
+	}
+	p.Primary = g
+	if m := p.MasterBootRecord; m[510] != 0x55 || m[511] != 0xaa {
+		p.MasterBootRecord = ProtectiveMBR(sectors)
+	}
+	if err := p.Relocate(sectors); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Relocate moves the backup GPT to the end of a disk of sectors blocks,
+// e.g. after the disk grew, and makes the space up to it usable.
+func (p *PartitionTable) Relocate(sectors uint64) error {
+	g := p.Primary
+	last := sectors - 2 - partBlocks
+	for i := range g.Parts {
+		if g.Parts[i].IsUsed() && g.Parts[i].LastLBA > last {
+			return fmt.Errorf("partition %d ends at block %d, after the disk's last usable block %d", i+1, g.Parts[i].LastLBA, last)
+		}
+	}
+	g.BackupLBA = sectors - 1
+	g.LastLBA = last
+	p.syncBackup()
+	return nil
+}
+
+// Extent is a range of blocks, Last included.
+type Extent struct {
+	First, Last uint64
+}
+
+// Free returns the unused extents of the table.
+func (p *PartitionTable) Free() []Extent {
+	g := p.Primary
+	var used []Extent
+	for _, pt := range g.Parts {
+		if pt.IsUsed() {
+			used = append(used, Extent{pt.FirstLBA, pt.LastLBA})
+		}
+	}
+	sort.Slice(used, func(i, j int) bool { return used[i].First < used[j].First })
+	var free []Extent
+	next := g.FirstLBA
+	for _, u := range used {
+		if u.First > next {
+			free = append(free, Extent{next, u.First - 1})
+		}
+		if u.Last+1 > next {
+			next = u.Last + 1
+		}
+	}
+	if next <= g.LastLBA {
+		free = append(free, Extent{next, g.LastLBA})
+	}
+	return free
+}
+
+// overlaps returns the number of a partition but n overlapping e, or 0.
+func (p *PartitionTable) overlaps(n int, e Extent) int {
+	for i, pt := range p.Primary.Parts {
+		if i+1 != n && pt.IsUsed() && pt.FirstLBA <= e.Last && e.First <= pt.LastLBA {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+func (p *PartitionTable) check(n int, e Extent) error {
+	g := p.Primary
+	if e.First > e.Last || e.First < g.FirstLBA || e.Last > g.LastLBA {
+		return fmt.Errorf("blocks %d-%d are not within the usable blocks %d-%d: %w", e.First, e.Last, g.FirstLBA, g.LastLBA, ErrNoSpace)
+	}
+	if o := p.overlaps(n, e); o != 0 {
+		return fmt.Errorf("blocks %d-%d overlap partition %d: %w", e.First, e.Last, o, ErrNoSpace)
+	}
+	return nil
+}
+
+// Add adds a partition of type typ from block first to block last, and
+// returns its number, from 1. If first is 0, the partition starts at the
+// first free block aligned to 1 MiB. If last is 0, the partition ends where
+// the free extent it starts in does.
+//
+// If n is 0, the first unused entry is used.
+func (p *PartitionTable) Add(n int, typ GUID, first, last uint64, name string) (int, error) {
+	g := p.Primary
+	if n == 0 {
+		for i := range g.Parts {
+			if !g.Parts[i].IsUsed() {
+				n = i + 1
+				break
+			}
+		}
+		if n == 0 {
+			return 0, fmt.Errorf("all %d entries are used: %w", len(g.Parts), ErrNoSpace)
+		}
+	}
+	if n < 1 || n > len(g.Parts) {
+		return 0, fmt.Errorf("partition number %d is not within 1-%d", n, len(g.Parts))
+	}
+	if g.Parts[n-1].IsUsed() {
+		return 0, fmt.Errorf("partition %d exists", n)
+	}
+	free := p.Free()
+	if first == 0 {
+		for _, f := range free {
+			a := (f.First + alignment - 1) / alignment * alignment
+			if a > f.Last {
+				continue
+			}
+			if last == 0 || a <= last && last <= f.Last {
+				first = a
+				break
+			}
+		}
+		if first == 0 {
+			return 0, ErrNoSpace
+		}
+	}
+	if last == 0 {
+		for _, f := range free {
+			if f.First <= first && first <= f.Last {
+				last = f.Last
+			}
+		}
+	}
+	if err := p.check(n, Extent{first, last}); err != nil {
+		return 0, err
+	}
+	u, err := NewGUID()
+	if err != nil {
+		return 0, err
+	}
+	g.Parts[n-1] = Part{PartGUID: typ, UniqueGUID: u, FirstLBA: first, LastLBA: last, Name: NewPartName(name)}
+	p.syncBackup()
+	return n, nil
+}
+
+func (p *PartitionTable) part(n int) (*Part, error) {
+	g := p.Primary
+	if n < 1 || n > len(g.Parts) || !g.Parts[n-1].IsUsed() {
+		return nil, fmt.Errorf("no partition %d", n)
+	}
+	return &g.Parts[n-1], nil
+}
+
+// Delete deletes partition n.
+func (p *PartitionTable) Delete(n int) error {
+	if _, err := p.part(n); err != nil {
+		return err
+	}
+	p.Primary.Parts[n-1] = Part{}
+	p.syncBackup()
+	return nil
+}
+
+// Resize moves the end of partition n to block last. If last is 0, the
+// partition grows up to the next partition or the end of the usable space.
+func (p *PartitionTable) Resize(n int, last uint64) error {
+	pt, err := p.part(n)
+	if err != nil {
+		return err
+	}
+	if last == 0 {
+		last = p.Primary.LastLBA
+		for _, o := range p.Primary.Parts {
+			if o.IsUsed() && o.FirstLBA > pt.LastLBA && o.FirstLBA-1 < last {
+				last = o.FirstLBA - 1
+			}
+		}
+	}
+	if err := p.check(n, Extent{pt.FirstLBA, last}); err != nil {
+		return err
+	}
+	pt.LastLBA = last
+	p.syncBackup()
+	return nil
+}
+
+// SetType sets the type of partition n.
+func (p *PartitionTable) SetType(n int, typ GUID) error {
+	pt, err := p.part(n)
+	if err != nil {
+		return err
+	}
+	pt.PartGUID = typ
+	p.syncBackup()
+	return nil
+}
+
+// SetName sets the name of partition n.
+func (p *PartitionTable) SetName(n int, name string) error {
+	pt, err := p.part(n)
+	if err != nil {
+		return err
+	}
+	pt.Name = NewPartName(name)
+	p.syncBackup()
+	return nil
+}
@@ -0,0 +1,189 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gpt
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// memDisk is a disk in memory.
+type memDisk []byte
+
+func (d memDisk) ReadAt(b []byte, off int64) (int, error) {
+	return bytes.NewReader(d).ReadAt(b, off)
+}
+
+func (d memDisk) WriteAt(b []byte, off int64) (int, error) {
+	return copy(d[off:], b), nil
+}
+
+func TestGUID(t *testing.T) {
+	const s = "0fc63daf-8483-4772-8e79-3d69d8477de4"
+	g, err := ParseGUID(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.String() != s {
+		t.Errorf("ParseGUID(%s).String() = %s", s, g.String())
+	}
+	if typ, err := ParseType("Linux"); err != nil || typ != g {
+		t.Errorf("ParseType(Linux) = %v, %v, want %v", typ, err, g)
+	}
+	for _, bad := range []string{"0fc63daf-8483-4772-8e79", "0fc63daf-8483-4772-8e79-3d69d8477dzz", "ext4"} {
+		if _, err := ParseType(bad); err == nil {
+			t.Errorf("ParseType(%q) succeeded", bad)
+		}
+	}
+	u, err := NewGUID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.W2>>12 != 4 || u.B[0]>>6 != 2 {
+		t.Errorf("NewGUID() = %s, want a version 4 GUID", u.String())
+	}
+	if n := NewPartName("EFI system"); n.Text() != "EFI system" {
+		t.Errorf("NewPartName(EFI system).Text() = %q", n.Text())
+	}
+}
+
+const sectors = 64 << 20 / BlockSize
+
+func TestEdit(t *testing.T) {
+	p, err := NewTable(sectors)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e := p.Free(); !reflect.DeepEqual(e, []Extent{{34, sectors - 34}}) {
+		t.Errorf("Free() = %v on an empty disk", e)
+	}
+	efi, linux := Types["efi"], Types["linux"]
+
+	n, err := p.Add(0, efi, 0, 2048+8191, "EFI")
+	if err != nil || n != 1 {
+		t.Fatalf("Add(efi) = %d, %v, want 1", n, err)
+	}
+	if pt := p.Primary.Parts[0]; pt.FirstLBA != 2048 || pt.LastLBA != 10239 || pt.Name.Text() != "EFI" || pt.PartGUID != efi {
+		t.Errorf("partition 1 = %+v", pt)
+	}
+	if n, err := p.Add(3, linux, 20480, 30719, "data"); err != nil || n != 3 {
+		t.Fatalf("Add(3) = %d, %v, want 3", n, err)
+	}
+	// Partition 2 fills the gap.
+	if n, err := p.Add(0, linux, 0, 0, "root"); err != nil || n != 2 {
+		t.Fatalf("Add(root) = %d, %v, want 2", n, err)
+	}
+	if pt := p.Primary.Parts[1]; pt.FirstLBA != 10240 || pt.LastLBA != 20479 {
+		t.Errorf("partition 2 = %d-%d, want 10240-20479", pt.FirstLBA, pt.LastLBA)
+	}
+	for _, e := range []Extent{{30000, 40000}, {10, 100}, {40000, sectors}} {
+		if _, err := p.Add(0, linux, e.First, e.Last, ""); !errors.Is(err, ErrNoSpace) {
+			t.Errorf("Add(%v) = %v, want ErrNoSpace", e, err)
+		}
+	}
+	if _, err := p.Add(1, linux, 40000, 50000, ""); err == nil {
+		t.Errorf("Add of existing partition 1 succeeded")
+	}
+
+	if err := p.Resize(3, 0); err != nil {
+		t.Fatal(err)
+	}
+	if last := p.Primary.Parts[2].LastLBA; last != sectors-34 {
+		t.Errorf("partition 3 ends at %d after Resize, want %d", last, sectors-34)
+	}
+	if err := p.Resize(1, 10240); !errors.Is(err, ErrNoSpace) {
+		t.Errorf("Resize(1) over partition 2 = %v, want ErrNoSpace", err)
+	}
+	if err := p.Delete(2); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Delete(2); err == nil {
+		t.Errorf("second Delete(2) succeeded")
+	}
+	if err := p.Resize(1, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.SetName(1, "ESP"); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.SetType(3, Types["home"]); err != nil {
+		t.Fatal(err)
+	}
+
+	disk := make(memDisk, sectors*BlockSize)
+	if err := Write(disk, p); err != nil {
+		t.Fatal(err)
+	}
+	got, err := New(disk)
+	if err != nil {
+		t.Fatalf("reading back the table: %v", err)
+	}
+	if got.MasterBootRecord[450] != 0xee || got.MasterBootRecord[511] != 0xaa {
+		t.Errorf("no protective MBR")
+	}
+	want := []Part{p.Primary.Parts[0], {}, p.Primary.Parts[2]}
+	if !reflect.DeepEqual(got.Primary.Parts[:3], want) || !reflect.DeepEqual(got.Backup.Parts, got.Primary.Parts) {
+		t.Errorf("read back %+v, want %+v", got.Primary.Parts[:3], want)
+	}
+	if pt := got.Primary.Parts[0]; pt.LastLBA != 20479 || pt.Name.Text() != "ESP" {
+		t.Errorf("partition 1 = %d-%d %q, want 2048-20479 ESP", pt.FirstLBA, pt.LastLBA, pt.Name.Text())
+	}
+}
+
+func TestRecover(t *testing.T) {
+	p, err := NewTable(sectors)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.Add(0, Types["linux"], 0, 0, "root"); err != nil {
+		t.Fatal(err)
+	}
+	want := p.Primary.Parts[0]
+
+	// Grow the disk, and damage the primary GPT.
+	disk := make(memDisk, 2*sectors*BlockSize)
+	if err := Write(disk, p); err != nil {
+		t.Fatal(err)
+	}
+	disk[HeaderOff+60]++
+	copy(disk[2*sectors*BlockSize-BlockSize:], disk[(sectors-1)*BlockSize:sectors*BlockSize])
+	copy(disk[(2*sectors-1-partBlocks)*BlockSize:], disk[(sectors-1-partBlocks)*BlockSize:(sectors-1)*BlockSize])
+	if _, err := New(disk); err == nil {
+		t.Fatalf("New() of a damaged GPT succeeded")
+	}
+	// The backup points to its own partition array.
+	b, err := Table(disk, (sectors-1)*BlockSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.CurrentLBA, b.PartStart = 2*sectors-1, 2*sectors-1-partBlocks
+	if err := writeGPT(disk, b); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := Recover(disk, 2*sectors)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Primary.LastLBA != 2*sectors-34 || r.Backup.CurrentLBA != 2*sectors-1 {
+		t.Errorf("recovered table has last usable block %d and backup at %d", r.Primary.LastLBA, r.Backup.CurrentLBA)
+	}
+	if err := Write(disk, r); err != nil {
+		t.Fatal(err)
+	}
+	got, err := New(disk)
+	if err != nil {
+		t.Fatalf("reading back the recovered table: %v", err)
+	}
+	if got.Primary.Parts[0] != want {
+		t.Errorf("recovered partition %+v, want %+v", got.Primary.Parts[0], want)
+	}
+
+	if _, err := Recover(make(memDisk, sectors*BlockSize), sectors); err == nil {
+		t.Errorf("Recover() of an empty disk succeeded")
+	}
+}
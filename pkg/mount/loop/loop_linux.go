@@ -35,13 +35,15 @@ var _ mount.Mounter = &Loop{}
 // source is the file to use as a loop block device. fstype the file system
 // name. data is the data argument to the mount(2) syscall.
 func New(source, fstype string, data string) (*Loop, error) {
-	devicename, err := FindDevice()
+	return NewWithOptions(source, fstype, data, Options{})
+}
+
+// NewWithOptions is New with the loop device parameters of o.
+func NewWithOptions(source, fstype, data string, o Options) (*Loop, error) {
+	devicename, err := Attach(source, o)
 	if err != nil {
 		return nil, err
 	}
-	if err := SetFile(devicename, source); err != nil {
-		return nil, err
-	}
 	return &Loop{
 		Dev:    devicename,
 		Source: source,
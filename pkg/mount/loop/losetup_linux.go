@@ -7,6 +7,7 @@ package loop
 import (
 	"fmt"
 	"os"
+	"unsafe"
 
 	"golang.org/x/sys/unix"
 )
@@ -30,19 +31,60 @@ const (
 	_LOOP_CTL_GET_FREE = 0x4C82
 )
 
+// ControlPath is the loop control device.
+var ControlPath = "/dev/loop-control"
+
+// control runs the loop control ioctl cmd with argument n. It returns the
+// number of the loop device.
+func control(cmd uintptr, n int) (int, error) {
+	cfd, err := os.OpenFile(ControlPath, os.O_RDWR, 0644)
+	if err != nil {
+		return 0, err
+	}
+	defer cfd.Close()
+	r1, _, errno := unix.Syscall(unix.SYS_IOCTL, cfd.Fd(), cmd, uintptr(n))
+	if errno != 0 {
+		return 0, errno
+	}
+	return int(r1), nil
+}
+
+// devicePath returns the path of loop device n, creating its device node
+// if there is none, as without devtmpfs.
+func devicePath(n int) (string, error) {
+	name := fmt.Sprintf("/dev/loop%d", n)
+	if _, err := os.Stat(name); os.IsNotExist(err) {
+		if err := unix.Mknod(name, unix.S_IFBLK|0660, int(unix.Mkdev(loopMajor, uint32(n)))); err != nil {
+			return "", err
+		}
+	}
+	return name, nil
+}
+
 // FindDevice finds an unused loop device and returns its /dev/loopN path.
+//
+// The kernel allocates a new device if all are in use.
 func FindDevice() (string, error) {
-	cfd, err := os.OpenFile("/dev/loop-control", os.O_RDWR, 0644)
+	number, err := control(_LOOP_CTL_GET_FREE, 0)
 	if err != nil {
 		return "", err
 	}
-	defer cfd.Close()
+	return devicePath(number)
+}
 
-	number, err := GetFree(int(cfd.Fd()))
+// AddDevice allocates loop device n and returns its /dev/loopN path.
+func AddDevice(n int) (string, error) {
+	number, err := control(_LOOP_CTL_ADD, n)
 	if err != nil {
 		return "", err
 	}
-	return fmt.Sprintf("/dev/loop%d", number), nil
+	return devicePath(number)
+}
+
+// RemoveDevice frees the unused loop device n.
+func RemoveDevice(n int) error {
+	_, err := control(_LOOP_CTL_REMOVE, n)
+	return err
 }
 
 // ClearFD clears the loop device associated with file descriptor fd.
@@ -75,14 +117,45 @@ func SetFD(lfd, ffd int) error {
 
 // SetFile associates loop device "devicename" with regular file "filename"
 func SetFile(devicename, filename string) error {
+	return SetFileOptions(devicename, filename, Options{})
+}
+
+// Options are the parameters of a loop device.
+type Options struct {
+	// Offset is where the device starts in the file, in bytes.
+	Offset uint64
+
+	// SizeLimit is the size of the device in bytes. 0 means up to the end
+	// of the file.
+	SizeLimit uint64
+
+	// ReadOnly makes a read-only device. Files that cannot be opened for
+	// writing always give one.
+	ReadOnly bool
+
+	// PartScan makes the kernel scan the device for partitions, which
+	// appear as /dev/loopNpM.
+	PartScan bool
+
+	// AutoClear makes the kernel detach the device when its last user, for
+	// example a mount, is gone.
+	AutoClear bool
+}
+
+// SetFileOptions associates loop device "devicename" with regular file
+// "filename", with the parameters of o.
+func SetFileOptions(devicename, filename string, o Options) error {
 	mode := os.O_RDWR
+	if o.ReadOnly {
+		mode = os.O_RDONLY
+	}
 	file, err := os.OpenFile(filename, mode, 0644)
-	if err != nil {
+	if err != nil && mode == os.O_RDWR {
 		mode = os.O_RDONLY
 		file, err = os.OpenFile(filename, mode, 0644)
-		if err != nil {
-			return err
-		}
+	}
+	if err != nil {
+		return err
 	}
 	defer file.Close()
 
@@ -92,7 +165,68 @@ func SetFile(devicename, filename string) error {
 	}
 	defer device.Close()
 
-	return SetFD(int(device.Fd()), int(file.Fd()))
+	if err := SetFD(int(device.Fd()), int(file.Fd())); err != nil {
+		return err
+	}
+	info := &unix.LoopInfo64{
+		Offset:    o.Offset,
+		Sizelimit: o.SizeLimit,
+	}
+	if o.PartScan {
+		info.Flags |= unix.LO_FLAGS_PARTSCAN
+	}
+	if o.AutoClear {
+		info.Flags |= unix.LO_FLAGS_AUTOCLEAR
+	}
+	copy(info.File_name[:_LO_NAME_SIZE-1], filename)
+	if err := SetStatus(int(device.Fd()), info); err != nil {
+		ClearFD(int(device.Fd())) //nolint:errcheck
+		return err
+	}
+	return nil
+}
+
+// Attach associates a free loop device with regular file "filename", with the
+// parameters of o, and returns the device's path.
+func Attach(filename string, o Options) (string, error) {
+	devicename, err := FindDevice()
+	if err != nil {
+		return "", err
+	}
+	if err := SetFileOptions(devicename, filename, o); err != nil {
+		return "", err
+	}
+	return devicename, nil
+}
+
+// GetStatus returns the status of the loop device lfd.
+func GetStatus(lfd int) (*unix.LoopInfo64, error) {
+	var info unix.LoopInfo64
+	if _, _, errno := unix.Syscall(unix.SYS_IOCTL, uintptr(lfd), _LOOP_GET_STATUS64, uintptr(unsafe.Pointer(&info))); errno != 0 {
+		return nil, errno
+	}
+	return &info, nil
+}
+
+// SetStatus sets the status of the loop device lfd. Of the flags, only
+// LO_FLAGS_AUTOCLEAR and LO_FLAGS_PARTSCAN can be changed.
+func SetStatus(lfd int, info *unix.LoopInfo64) error {
+	if _, _, errno := unix.Syscall(unix.SYS_IOCTL, uintptr(lfd), _LOOP_SET_STATUS64, uintptr(unsafe.Pointer(info))); errno != 0 {
+		return errno
+	}
+	return nil
+}
+
+// Status returns the status of the loop device "devicename". It fails with
+// ENXIO if no file is associated with the device.
+func Status(devicename string) (*unix.LoopInfo64, error) {
+	device, err := os.Open(devicename)
+	if err != nil {
+		return nil, err
+	}
+	defer device.Close()
+
+	return GetStatus(int(device.Fd()))
 }
 
 // ClearFile clears the fd association of the loop device "devicename".
@@ -105,3 +239,25 @@ func ClearFile(devicename string) error {
 
 	return ClearFD(int(device.Fd()))
 }
+
+// loopMajor is the major device number of loop devices.
+const loopMajor = 7
+
+// IsDevice returns whether devicename is a loop device.
+func IsDevice(devicename string) bool {
+	var st unix.Stat_t
+	if err := unix.Stat(devicename, &st); err != nil {
+		return false
+	}
+	return st.Mode&unix.S_IFMT == unix.S_IFBLK && unix.Major(st.Rdev) == loopMajor
+}
+
+// Detach clears the loop device "devicename" like ClearFile, but also
+// succeeds if no file is associated with it anymore, as the kernel may have
+// done it already, e.g. for a device with LO_FLAGS_AUTOCLEAR.
+func Detach(devicename string) error {
+	if err := ClearFile(devicename); err != nil && err != unix.ENXIO {
+		return err
+	}
+	return nil
+}
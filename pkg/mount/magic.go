@@ -178,7 +178,8 @@ func FindMagics(blk []byte) []magic {
 	return matches
 }
 
-// FSFromBlock determines the file system type of a block device.
+// FSFromBlock determines the file system type of a block device, first by
+// probing its superblock with FSProbe, then by the magic numbers of magics.
 // It returns a string and an error. The error can be for an IO operation,
 // an unknown magic number, or a magic with an unsupported file system.
 // There is still a question here about whether this ought to act like
@@ -192,6 +193,15 @@ func FSFromBlock(n string) (fs string, flags uintptr, err error) {
 		return "", 0, err
 	}
 	defer f.Close()
+	if fs, err := FSProbe(f); err == nil && FindFileSystem(fs) == nil {
+		if readOnlyFS[fs] {
+			flags = MS_RDONLY
+		}
+		return fs, flags, nil
+	}
+
+	// Fall back to the magic numbers, e.g. for an ext2 superblock in a
+	// kernel with only ext4.
 	var block = make([]byte, blocksize)
 	if _, err := io.ReadAtLeast(f, block, len(block)); err != nil {
 		return "", 0, fmt.Errorf("no suitable filesystem for %q: %v", n, err)
@@ -26,16 +26,41 @@ const (
 	MS_RELATIME = unix.MS_RELATIME
 	MS_SYNC     = unix.MS_SYNC
 	MS_NOATIME  = unix.MS_NOATIME
+	MS_REC      = unix.MS_REC
+	MS_REMOUNT  = unix.MS_REMOUNT
+	MS_MOVE     = unix.MS_MOVE
 
 	ReadOnly = unix.MS_RDONLY | unix.MS_NOATIME
 )
 
+// Propagation flags. Only one of them can be set at a time, optionally with
+// MS_REC.
+const (
+	MS_SHARED     = unix.MS_SHARED
+	MS_PRIVATE    = unix.MS_PRIVATE
+	MS_SLAVE      = unix.MS_SLAVE
+	MS_UNBINDABLE = unix.MS_UNBINDABLE
+
+	Propagation = MS_SHARED | MS_PRIVATE | MS_SLAVE | MS_UNBINDABLE
+)
+
 // Unmount flags.
 const (
 	MNT_FORCE  = unix.MNT_FORCE
 	MNT_DETACH = unix.MNT_DETACH
 )
 
+// Flags of Pool.UnmountAll, which are not passed on to umount2.
+const (
+	// DetachBusy detaches mount points which are busy lazily, as
+	// MNT_DETACH does, rather than failing to unmount them.
+	DetachBusy = 1 << 30
+
+	// KeepMountPoints keeps the directories of mount points, rather than
+	// removing them, if they are empty, once they are unmounted.
+	KeepMountPoints = 1 << 29
+)
+
 // Mounter is a device that can be attached at a file system path.
 type Mounter interface {
 	// DevName returns the name of the device.
@@ -97,6 +122,56 @@ func Mount(dev, path, fsType, data string, flags uintptr) (*MountPoint, error) {
 	}, nil
 }
 
+// BindMount makes the file or directory tree at src visible at dst too.
+//
+// With MS_REC in flags, the mounts below src are bound as well. With
+// MS_RDONLY, the bind mount is then remounted read-only, as the kernel ignores
+// the other flags of a bind mount.
+func BindMount(src, dst string, flags uintptr) (*MountPoint, error) {
+	mp, err := Mount(src, dst, "", "", MS_BIND|flags&MS_REC)
+	if err != nil {
+		return nil, err
+	}
+	if other := flags &^ (MS_BIND | MS_REC); other != 0 {
+		if err := Remount(dst, "", MS_BIND|other); err != nil {
+			return nil, err
+		}
+		mp.Flags |= other
+	}
+	return mp, nil
+}
+
+// Remount changes the flags and file system specific options of the mount at
+// path, e.g. to make it writable.
+func Remount(path, data string, flags uintptr) error {
+	if err := unix.Mount("", path, "", MS_REMOUNT|flags, data); err != nil {
+		return &os.PathError{
+			Op:   "remount",
+			Path: path,
+			Err:  fmt.Errorf("flags %#x: %v", flags, err),
+		}
+	}
+	return nil
+}
+
+// SetPropagation sets how mount and unmount events propagate to and from the
+// mount at path, and with MS_REC the mounts below it. flags is one of the
+// propagation flags MS_SHARED, MS_PRIVATE, MS_SLAVE and MS_UNBINDABLE,
+// optionally with MS_REC.
+func SetPropagation(path string, flags uintptr) error {
+	if p := flags & Propagation; p == 0 || p&(p-1) != 0 || flags&^(Propagation|MS_REC) != 0 {
+		return fmt.Errorf("invalid propagation flags %#x", flags)
+	}
+	if err := unix.Mount("", path, "", flags, ""); err != nil {
+		return &os.PathError{
+			Op:   "set propagation",
+			Path: path,
+			Err:  fmt.Errorf("flags %#x: %v", flags, err),
+		}
+	}
+	return nil
+}
+
 // TryMount tries to mount a device on the given mountpoint, trying in order
 // the supported block device file systems on the system.
 func TryMount(device, path, data string, flags uintptr) (*MountPoint, error) {
@@ -138,11 +213,23 @@ func Unmount(path string, force, lazy bool) error {
 }
 
 // Pool keeps track of multiple MountPoint.
+//
+// It unmounts them all at once, e.g. before switching root or shutting down,
+// innermost first.
 type Pool struct {
 	// List of items mounted by this pool.
 	MountPoints []*MountPoint
 	// Temporary directory which contains sub-directories for mounts.
 	tmpDir string
+	// freers release the devices of mount points, like loop devices, after
+	// they are unmounted.
+	freers map[*MountPoint]freer
+}
+
+// freer is a Mounter with a device to release after unmounting, like
+// loop.Loop.
+type freer interface {
+	Free() error
 }
 
 // Mount mounts a file system using Mounter and returns the MountPoint. If the
@@ -150,6 +237,9 @@ type Pool struct {
 //
 // Note the pool is keyed on Mounter.DevName() alone meaning DevName is used to
 // determine whether it has already been mounted.
+//
+// If mounter has a Free() error method, like loop.Loop, it is called by
+// UnmountAll after unmounting.
 func (p *Pool) Mount(mounter Mounter, flags uintptr) (*MountPoint, error) {
 	for _, m := range p.MountPoints {
 		if m.Device == mounter.DevName() {
@@ -178,36 +268,98 @@ func (p *Pool) Mount(mounter Mounter, flags uintptr) (*MountPoint, error) {
 		return nil, err
 	}
 	p.MountPoints = append(p.MountPoints, m)
+	if f, ok := mounter.(freer); ok {
+		if p.freers == nil {
+			p.freers = map[*MountPoint]freer{}
+		}
+		p.freers[m] = f
+	}
 	return m, err
 }
 
+// MountAt mounts a file system like Mount, the function, and adds it to the
+// pool.
+func (p *Pool) MountAt(dev, path, fsType, data string, flags uintptr) (*MountPoint, error) {
+	m, err := Mount(dev, path, fsType, data, flags)
+	if err != nil {
+		return nil, err
+	}
+	p.Add(m)
+	return m, nil
+}
+
 // Add adds MountPoints to the pool.
 func (p *Pool) Add(m ...*MountPoint) {
 	p.MountPoints = append(p.MountPoints, m...)
 }
 
-// UnmountAll umounts all the mountpoints from the pool. This makes a
-// best-effort attempt to unmount everything and cleanup temporary directories.
-// If this function fails, it can be re-tried.
+// unmountOrder returns the mount points, the last mounted first, and mount
+// points below others before them.
+func unmountOrder(mps []*MountPoint) []*MountPoint {
+	order := make([]*MountPoint, 0, len(mps))
+	done := make([]bool, len(mps))
+	var visit func(i int)
+	visit = func(i int) {
+		done[i] = true
+		for j := len(mps) - 1; j >= 0; j-- {
+			if !done[j] && below(mps[j].Path, mps[i].Path) {
+				visit(j)
+			}
+		}
+		order = append(order, mps[i])
+	}
+	for i := len(mps) - 1; i >= 0; i-- {
+		if !done[i] {
+			visit(i)
+		}
+	}
+	return order
+}
+
+// UnmountAll umounts all the mountpoints from the pool, the innermost first.
+// This makes a best-effort attempt to unmount everything and cleanup
+// temporary directories. If this function fails, it can be re-tried for the
+// mount points left.
+//
+// Besides the unmount flags, flags can have DetachBusy and KeepMountPoints.
 func (p *Pool) UnmountAll(flags uintptr) error {
 	// Errors get concatenated together here.
 	var returnErr error
+	var left []*MountPoint
 
-	for _, m := range p.MountPoints {
-		if err := m.Unmount(flags); err != nil {
+	uflags := flags &^ (DetachBusy | KeepMountPoints)
+	for _, m := range unmountOrder(p.MountPoints) {
+		err := unix.Unmount(m.Path, int(uflags))
+		if err == unix.EBUSY && flags&DetachBusy != 0 && uflags&MNT_DETACH == 0 {
+			err = unix.Unmount(m.Path, int(uflags|MNT_DETACH))
+		}
+		if err != nil {
+			err = &os.PathError{Op: "unmount", Path: m.Path, Err: fmt.Errorf("flags %#x: %v", uflags, err)}
 			if returnErr == nil {
 				returnErr = err
 			} else {
 				returnErr = fmt.Errorf("%w; %s", returnErr, err.Error())
 			}
+			left = append([]*MountPoint{m}, left...)
+			continue
+		}
+
+		if f, ok := p.freers[m]; ok {
+			if err := f.Free(); err != nil && returnErr == nil {
+				returnErr = err
+			}
+			delete(p.freers, m)
 		}
 
 		// unix.Rmdir is used (instead of os.RemoveAll) because it
 		// fails when the directory is non-empty. It would be a bit
 		// dangerous to use os.RemoveAll because it could accidentally
 		// delete everything in a mount.
-		unix.Rmdir(m.Path)
+		if flags&KeepMountPoints == 0 {
+			unix.Rmdir(m.Path)
+		}
 	}
+	p.MountPoints = left
 
 	if returnErr == nil && p.tmpDir != "" {
 		returnErr = unix.Rmdir(p.tmpDir)
@@ -462,3 +462,57 @@ func TestMountPool(t *testing.T) {
 		t.Fatalf("expected sda1 mounted 1 times; but mounted %d times", sda1.count)
 	}
 }
+
+func TestPoolUnmountAll(t *testing.T) {
+	testutil.SkipIfNotRoot(t)
+
+	dir, err := ioutil.TempDir("", "u-root-mount-pool")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	busy, idle := filepath.Join(dir, "busy"), filepath.Join(dir, "idle")
+	var p mount.Pool
+	for _, d := range []string{busy, idle} {
+		if err := os.Mkdir(d, 0755); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := p.MountAt("none", d, "tmpfs", "", 0); err != nil {
+			t.Skipf("Cannot mount tmpfs: %v", err)
+		}
+		defer mount.Unmount(d, false, true)
+	}
+	f, err := os.Create(filepath.Join(busy, "open"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	// Busy mount points are only detached if asked for.
+	if err := p.UnmountAll(0); err == nil {
+		t.Fatalf("UnmountAll(0) with %s busy = nil, want an error", busy)
+	}
+	if _, err := os.Stat(idle); !os.IsNotExist(err) {
+		t.Errorf("%s was not removed after unmounting: %v", idle, err)
+	}
+	if len(p.MountPoints) != 1 || p.MountPoints[0].Path != busy {
+		t.Fatalf("Mount points left = %v, want %s", p.MountPoints, busy)
+	}
+	if err := p.UnmountAll(mount.DetachBusy | mount.KeepMountPoints); err != nil {
+		t.Fatalf("UnmountAll(DetachBusy|KeepMountPoints) = %v, want nil", err)
+	}
+	if _, err := os.Stat(filepath.Join(busy, "open")); !os.IsNotExist(err) {
+		t.Errorf("%s is still mounted", busy)
+	}
+	if _, err := os.Stat(busy); err != nil {
+		t.Errorf("%s was removed with KeepMountPoints: %v", busy, err)
+	}
+}
+
+func TestSetPropagationFlags(t *testing.T) {
+	for _, flags := range []uintptr{0, mount.MS_SHARED | mount.MS_PRIVATE, mount.MS_SLAVE | mount.MS_RDONLY} {
+		if err := mount.SetPropagation("/", flags); err == nil {
+			t.Errorf("SetPropagation(/, %#x) succeeded", flags)
+		}
+	}
+}
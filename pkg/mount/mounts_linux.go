@@ -0,0 +1,79 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mount
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// MountsPath is the kernel's list of the mounts of the namespace.
+var MountsPath = "/proc/self/mounts"
+
+// Mounts returns the mounts of the namespace, in the order they were
+// mounted.
+func Mounts() ([]*MountPoint, error) {
+	f, err := os.Open(MountsPath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var mps []*MountPoint
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		// The format is the fstab one, with the same escapes.
+		fields := strings.Fields(s.Text())
+		if len(fields) < 4 {
+			continue
+		}
+		mp := &MountPoint{
+			Device: unescapeFstab(fields[0]),
+			Path:   unescapeFstab(fields[1]),
+			FSType: fields[2],
+			Data:   fields[3],
+		}
+		for _, o := range strings.Split(fields[3], ",") {
+			if o == "ro" {
+				mp.Flags |= MS_RDONLY
+			}
+		}
+		mps = append(mps, mp)
+	}
+	return mps, s.Err()
+}
+
+// MountsBelow returns the mounts at and below path, the last mounted first,
+// which is an order they can be unmounted in.
+func MountsBelow(path string) ([]*MountPoint, error) {
+	mps, err := Mounts()
+	if err != nil {
+		return nil, err
+	}
+	path = filepath.Clean(path)
+	var tree []*MountPoint
+	for i := len(mps) - 1; i >= 0; i-- {
+		if p := filepath.Clean(mps[i].Path); p == path || below(p, path) {
+			tree = append(tree, mps[i])
+		}
+	}
+	return tree, nil
+}
+
+// UnmountTree unmounts the file systems mounted at and below path, innermost
+// first. force and lazy are as for Unmount.
+func UnmountTree(path string, force, lazy bool) error {
+	mps, err := MountsBelow(path)
+	if err != nil {
+		return err
+	}
+	for _, mp := range mps {
+		if err := Unmount(mp.Path, force, lazy); err != nil {
+			return err
+		}
+	}
+	return nil
+}
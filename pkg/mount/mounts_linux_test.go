@@ -0,0 +1,62 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mount
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestMountsBelow(t *testing.T) {
+	d, err := ioutil.TempDir("", "mounts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(d)
+	MountsPath = filepath.Join(d, "mounts")
+	defer func() { MountsPath = "/proc/self/mounts" }()
+	if err := ioutil.WriteFile(MountsPath, []byte(`/dev/root / ext4 rw,relatime 0 0
+proc /proc proc rw 0 0
+/dev/loop0 /mnt squashfs ro 0 0
+tmpfs /mnt/a\040b tmpfs rw 0 0
+tmpfs /mntx tmpfs rw 0 0
+sysfs /mnt/a\040b/c sysfs rw 0 0
+`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	mps, err := MountsBelow("/mnt/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var paths []string
+	for _, mp := range mps {
+		paths = append(paths, mp.Path)
+	}
+	want := []string{"/mnt/a b/c", "/mnt/a b", "/mnt"}
+	if !reflect.DeepEqual(paths, want) {
+		t.Errorf("MountsBelow(/mnt/) = %q, want %q", paths, want)
+	}
+	if mps[2].Device != "/dev/loop0" || mps[2].FSType != "squashfs" || mps[2].Flags != MS_RDONLY {
+		t.Errorf("MountsBelow(/mnt/)[2] = %v", mps[2])
+	}
+}
+
+func TestUnmountOrder(t *testing.T) {
+	var mps []*MountPoint
+	for _, p := range []string{"/mnt/a/b", "/", "/mnt", "/mnt/a", "/tmp", "/mnt/c"} {
+		mps = append(mps, &MountPoint{Path: p})
+	}
+	var paths []string
+	for _, mp := range unmountOrder(mps) {
+		paths = append(paths, mp.Path)
+	}
+	want := []string{"/mnt/c", "/tmp", "/mnt/a/b", "/mnt/a", "/mnt", "/"}
+	if !reflect.DeepEqual(paths, want) {
+		t.Errorf("unmountOrder() = %q, want %q", paths, want)
+	}
+}
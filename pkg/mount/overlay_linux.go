@@ -0,0 +1,166 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mount
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/sys/unix"
+)
+
+// Overlay is an overlay file system, which merges directories, the upper one
+// writable, into one tree.
+//
+// Overlay implements Mounter.
+type Overlay struct {
+	// Lower are the read-only lower directories, the first one on top.
+	Lower []string
+
+	// Upper is the directory changes are written to. Without one, the
+	// overlay is read-only.
+	Upper string
+
+	// Work is an empty directory on the file system of Upper overlayfs
+	// needs. It is required with Upper.
+	Work string
+
+	// CreateWork creates Work if it does not exist.
+	CreateWork bool
+}
+
+var _ Mounter = &Overlay{}
+
+// checkDir returns an error if path is not a directory usable by overlayfs.
+func checkDir(kind, path string) error {
+	if path == "" {
+		return fmt.Errorf("overlay: empty %s", kind)
+	}
+	if strings.ContainsAny(path, ":,") {
+		return fmt.Errorf("overlay: %s %q contains ':' or ','", kind, path)
+	}
+	fi, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("overlay: %s: %v", kind, err)
+	}
+	if !fi.IsDir() {
+		return fmt.Errorf("overlay: %s %q is not a directory", kind, path)
+	}
+	return nil
+}
+
+// Validate checks that the directories of o exist and are usable together.
+// If o.CreateWork is set, the work directory may not exist yet.
+func (o *Overlay) Validate() error {
+	if len(o.Lower) == 0 {
+		return fmt.Errorf("overlay: no lowerdir")
+	}
+	if len(o.Lower) == 1 && o.Upper == "" {
+		return fmt.Errorf("overlay: a single lowerdir needs an upperdir")
+	}
+	for _, l := range o.Lower {
+		if err := checkDir("lowerdir", l); err != nil {
+			return err
+		}
+	}
+	if o.Upper == "" && o.Work == "" {
+		return nil
+	}
+	if o.Upper == "" || o.Work == "" {
+		return fmt.Errorf("overlay: upperdir and workdir must be given together")
+	}
+	w, u := filepath.Clean(o.Work), filepath.Clean(o.Upper)
+	if w == u || below(w, u) || below(u, w) {
+		return fmt.Errorf("overlay: workdir %q and upperdir %q must not contain each other", o.Work, o.Upper)
+	}
+	if err := checkDir("upperdir", o.Upper); err != nil {
+		return err
+	}
+	if o.CreateWork {
+		if _, err := os.Stat(o.Work); os.IsNotExist(err) {
+			return checkDir("parent of workdir", filepath.Dir(o.Work))
+		}
+	}
+	if err := checkDir("workdir", o.Work); err != nil {
+		return err
+	}
+	var ws, us unix.Stat_t
+	if err := unix.Stat(o.Work, &ws); err != nil {
+		return err
+	}
+	if err := unix.Stat(o.Upper, &us); err != nil {
+		return err
+	}
+	if ws.Dev != us.Dev {
+		return fmt.Errorf("overlay: workdir %q and upperdir %q must be on the same file system", o.Work, o.Upper)
+	}
+	return nil
+}
+
+// Data returns the mount(2) data of o, like
+// "lowerdir=/a:/b,upperdir=/u,workdir=/w".
+func (o *Overlay) Data() string {
+	data := "lowerdir=" + strings.Join(o.Lower, ":")
+	if o.Upper != "" {
+		data += ",upperdir=" + o.Upper + ",workdir=" + o.Work
+	}
+	return data
+}
+
+// DevName implements Mounter.
+func (o *Overlay) DevName() string {
+	return "overlay"
+}
+
+// Mount implements Mounter. It validates o, and creates the work directory
+// if asked to, before mounting.
+func (o *Overlay) Mount(path string, flags uintptr) (*MountPoint, error) {
+	return o.MountData(path, "", flags)
+}
+
+// MountData is Mount with additional overlayfs options, like "redirect_dir=on".
+func (o *Overlay) MountData(path, data string, flags uintptr) (*MountPoint, error) {
+	if err := o.Validate(); err != nil {
+		return nil, err
+	}
+	if o.CreateWork && o.Work != "" {
+		if err := os.MkdirAll(o.Work, 0755); err != nil {
+			return nil, fmt.Errorf("overlay: %v", err)
+		}
+	}
+	d := o.Data()
+	if data != "" {
+		d += "," + data
+	}
+	return Mount("overlay", path, "overlay", d, flags)
+}
+
+// ParseOverlayOptions takes the lowerdir=, upperdir= and workdir= options out
+// of options, and returns them as an Overlay, with the other options.
+func ParseOverlayOptions(options []string) (*Overlay, []string, error) {
+	var o Overlay
+	var rest []string
+	for _, opt := range options {
+		kv := strings.SplitN(opt, "=", 2)
+		if kv[0] != "lowerdir" && kv[0] != "upperdir" && kv[0] != "workdir" {
+			rest = append(rest, opt)
+			continue
+		}
+		if len(kv) != 2 || kv[1] == "" {
+			return nil, nil, fmt.Errorf("overlay: %s needs a directory", kv[0])
+		}
+		switch kv[0] {
+		case "lowerdir":
+			o.Lower = strings.Split(kv[1], ":")
+		case "upperdir":
+			o.Upper = kv[1]
+		case "workdir":
+			o.Work = kv[1]
+		}
+	}
+	return &o, rest, nil
+}
@@ -0,0 +1,61 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mount
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestOverlay(t *testing.T) {
+	d, err := ioutil.TempDir("", "overlay")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(d)
+	for _, n := range []string{"a", "b", "upper"} {
+		if err := os.Mkdir(filepath.Join(d, n), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	a, b, upper, work := filepath.Join(d, "a"), filepath.Join(d, "b"), filepath.Join(d, "upper"), filepath.Join(d, "work")
+
+	o, rest, err := ParseOverlayOptions([]string{"lowerdir=" + a + ":" + b, "upperdir=" + upper, "workdir=" + work, "index=on"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &Overlay{Lower: []string{a, b}, Upper: upper, Work: work}
+	if !reflect.DeepEqual(o, want) || !reflect.DeepEqual(rest, []string{"index=on"}) {
+		t.Errorf("ParseOverlayOptions() = %+v, %q, want %+v, [index=on]", o, rest, want)
+	}
+	if got, want := o.Data(), "lowerdir="+a+":"+b+",upperdir="+upper+",workdir="+work; got != want {
+		t.Errorf("Data() = %q, want %q", got, want)
+	}
+	if err := o.Validate(); err == nil {
+		t.Errorf("Validate() without a workdir succeeded")
+	}
+	o.CreateWork = true
+	if err := o.Validate(); err != nil {
+		t.Errorf("Validate() with CreateWork = %v", err)
+	}
+
+	for _, bad := range []*Overlay{
+		{},
+		{Lower: []string{a}},
+		{Lower: []string{a, filepath.Join(d, "missing")}},
+		{Lower: []string{a}, Upper: upper},
+		{Lower: []string{a}, Upper: upper, Work: filepath.Join(upper, "work"), CreateWork: true},
+	} {
+		if err := bad.Validate(); err == nil {
+			t.Errorf("Validate(%+v) succeeded", bad)
+		}
+	}
+	if _, _, err := ParseOverlayOptions([]string{"lowerdir="}); err == nil {
+		t.Errorf("ParseOverlayOptions(lowerdir=) succeeded")
+	}
+}
@@ -0,0 +1,34 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mount
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/u-root/u-root/pkg/blkid"
+)
+
+// FSProbe returns the type of the file system in r, named as mount takes it,
+// like "ext4" or "vfat", by looking at its superblock.
+//
+// ext2, ext3, ext4, vfat, xfs, squashfs, iso9660, btrfs and f2fs are
+// recognized. Other content of block devices, like swap, is an error.
+func FSProbe(r io.ReaderAt) (string, error) {
+	i, err := blkid.Probe(r)
+	if err != nil {
+		return "", err
+	}
+	if !i.Filesystem() {
+		return "", fmt.Errorf("%s is not a file system", i.Type)
+	}
+	return i.Type, nil
+}
+
+// readOnlyFS are the file systems that can only be mounted read-only.
+var readOnlyFS = map[string]bool{
+	"squashfs": true,
+	"iso9660":  true,
+}
@@ -0,0 +1,34 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mount
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestFSProbe(t *testing.T) {
+	ext4 := make([]byte, 4096)
+	ext4[1024+0x38], ext4[1024+0x39] = 0x53, 0xef
+	ext4[1024+0x60] = 0x40
+	swap := make([]byte, 4096)
+	copy(swap[4096-10:], "SWAPSPACE2")
+	for _, tt := range []struct {
+		name string
+		img  []byte
+		want string
+		err  bool
+	}{
+		{name: "ext4", img: ext4, want: "ext4"},
+		{name: "squashfs", img: append([]byte("hsqs"), make([]byte, 508)...), want: "squashfs"},
+		{name: "swap", img: swap, err: true},
+		{name: "zeros", img: make([]byte, 4096), err: true},
+	} {
+		got, err := FSProbe(bytes.NewReader(tt.img))
+		if (err != nil) != tt.err || got != tt.want {
+			t.Errorf("%s: FSProbe() = %q, %v, want %q, error %t", tt.name, got, err, tt.want, tt.err)
+		}
+	}
+}
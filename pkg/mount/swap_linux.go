@@ -0,0 +1,94 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mount
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// Flags of swapon(2), from linux/swap.h.
+const (
+	SWAP_FLAG_PREFER        = 0x8000
+	SWAP_FLAG_PRIO_MASK     = 0x7fff
+	SWAP_FLAG_DISCARD       = 0x10000
+	SWAP_FLAG_DISCARD_ONCE  = 0x20000
+	SWAP_FLAG_DISCARD_PAGES = 0x40000
+)
+
+// SwapsPath is the kernel's list of active swap areas.
+var SwapsPath = "/proc/swaps"
+
+// Swap is an active swap area.
+type Swap struct {
+	Filename string
+	// Type is partition or file.
+	Type string
+	// Size and Used are in KiB.
+	Size, Used uint64
+	Priority   int
+}
+
+// Swapon starts swapping to the swap area at path, a device or a file,
+// with SWAP_FLAG flags.
+func Swapon(path string, flags int) error {
+	p, err := unix.BytePtrFromString(path)
+	if err != nil {
+		return err
+	}
+	if _, _, e := unix.Syscall(unix.SYS_SWAPON, uintptr(unsafe.Pointer(p)), uintptr(flags), 0); e != 0 {
+		return &os.PathError{Op: "swapon", Path: path, Err: e}
+	}
+	return nil
+}
+
+// Swapoff stops swapping to the swap area at path.
+func Swapoff(path string) error {
+	p, err := unix.BytePtrFromString(path)
+	if err != nil {
+		return err
+	}
+	if _, _, e := unix.Syscall(unix.SYS_SWAPOFF, uintptr(unsafe.Pointer(p)), 0, 0); e != 0 {
+		return &os.PathError{Op: "swapoff", Path: path, Err: e}
+	}
+	return nil
+}
+
+// Swaps returns the active swap areas.
+func Swaps() ([]Swap, error) {
+	f, err := os.Open(SwapsPath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var swaps []Swap
+	s := bufio.NewScanner(f)
+	// Filename Type Size Used Priority
+	s.Scan()
+	for s.Scan() {
+		fields := strings.Fields(s.Text())
+		if len(fields) != 5 {
+			return nil, fmt.Errorf("%s: invalid line %q", SwapsPath, s.Text())
+		}
+		sw := Swap{Filename: unescapeFstab(fields[0]), Type: fields[1]}
+		var errs [3]error
+		sw.Size, errs[0] = strconv.ParseUint(fields[2], 10, 64)
+		sw.Used, errs[1] = strconv.ParseUint(fields[3], 10, 64)
+		sw.Priority, errs[2] = strconv.Atoi(fields[4])
+		for _, err := range errs {
+			if err != nil {
+				return nil, fmt.Errorf("%s: invalid line %q: %v", SwapsPath, s.Text(), err)
+			}
+		}
+		swaps = append(swaps, sw)
+	}
+	return swaps, s.Err()
+}
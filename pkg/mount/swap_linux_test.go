@@ -0,0 +1,47 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mount
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestSwaps(t *testing.T) {
+	d, err := ioutil.TempDir("", "swaps")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(d)
+	SwapsPath = filepath.Join(d, "swaps")
+	defer func() { SwapsPath = "/proc/swaps" }()
+	if err := ioutil.WriteFile(SwapsPath, []byte(`Filename				Type		Size		Used		Priority
+/dev/zram0                              partition	1048572		0		100
+/swap\040file                           file		524284		1024		-2
+`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	got, err := Swaps()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Swap{
+		{Filename: "/dev/zram0", Type: "partition", Size: 1048572, Priority: 100},
+		{Filename: "/swap file", Type: "file", Size: 524284, Used: 1024, Priority: -2},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Swaps() = %v, want %v", got, want)
+	}
+
+	if err := ioutil.WriteFile(SwapsPath, []byte("Filename Type Size Used Priority\n/dev/sda2 partition x 0 -2\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Swaps(); err == nil {
+		t.Errorf("Swaps() of an invalid size succeeded")
+	}
+}
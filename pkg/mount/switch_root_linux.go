@@ -139,6 +139,28 @@ func addSpecialMounts(newRoot string) error {
 	return nil
 }
 
+// unmountOldMounts unmounts the file systems mounted in the old root which
+// are not moved to newRootDir, so they do not leak across the switch. Busy
+// ones are detached lazily.
+func unmountOldMounts(newRootDir string) error {
+	newRootDir, err := filepath.Abs(newRootDir)
+	if err != nil {
+		return err
+	}
+	mps, err := Mounts()
+	if err != nil {
+		return err
+	}
+	var p Pool
+	for _, mp := range mps {
+		if mp.Path == "/" || mp.Path == newRootDir || below(mp.Path, newRootDir) || below(newRootDir, mp.Path) {
+			continue
+		}
+		p.Add(mp)
+	}
+	return p.UnmountAll(DetachBusy | KeepMountPoints)
+}
+
 // SameFilesystem returns true if both paths reside in the same filesystem.
 // This is achieved by comparing Stat_t.Dev, which contains the fs device's
 // major/minor numbers.
@@ -177,6 +199,11 @@ func newRoot(newRootDir string) error {
 		return fmt.Errorf("switch_root: moving mounts failed %v", err)
 	}
 
+	log.Printf("switch_root: unmounting the other mounts of the old root")
+	if err := unmountOldMounts(newRootDir); err != nil {
+		log.Printf("switch_root: %v", err)
+	}
+
 	log.Printf("switch_root: Changing directory")
 	if err := unix.Chdir(newRootDir); err != nil {
 		return fmt.Errorf("switch_root: failed change directory to new_root %v", err)
@@ -84,6 +84,15 @@ func AllCPUs() (CPUs, error) {
 	return parseCPUs(string(v))
 }
 
+// ParseCPUs parses a list of CPUs like that of /sys/devices/system/cpu,
+// e.g. 0-3,5, or all for all present CPUs.
+func ParseCPUs(s string) (CPUs, error) {
+	if s == "all" {
+		return AllCPUs()
+	}
+	return parseCPUs(s)
+}
+
 // GlobCPUs allow the user to specify CPUs using a glob as one would in /dev/cpu
 func GlobCPUs(g string) (CPUs, []error) {
 	var hadErr bool
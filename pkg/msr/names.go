@@ -0,0 +1,205 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package msr
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Field is a bit field of an MSR, bits Lo to Hi inclusive.
+type Field struct {
+	Name   string
+	Lo, Hi uint
+	// Addr fields hold an address; they are printed in place rather than
+	// shifted down to bit 0.
+	Addr bool
+}
+
+// Value extracts the field from an MSR value.
+func (f Field) Value(v uint64) uint64 {
+	mask := uint64(1)<<(f.Hi-f.Lo+1) - 1
+	if f.Hi-f.Lo == 63 {
+		mask = ^uint64(0)
+	}
+	if f.Addr {
+		return v & (mask << f.Lo)
+	}
+	return (v >> f.Lo) & mask
+}
+
+// Register describes a known MSR.
+type Register struct {
+	Addr   MSR
+	Name   string
+	Fields []Field
+}
+
+// Registers are MSRs known by name. The names are those of the Intel SDM,
+// volume 4.
+var Registers = []Register{
+	{Addr: 0x10, Name: "IA32_TIME_STAMP_COUNTER"},
+	{Addr: 0x17, Name: "IA32_PLATFORM_ID", Fields: []Field{
+		{Name: "Platform Id", Lo: 50, Hi: 52},
+	}},
+	{Addr: 0x1b, Name: "IA32_APIC_BASE", Fields: []Field{
+		{Name: "BSP", Lo: 8, Hi: 8},
+		{Name: "x2APIC Enable", Lo: 10, Hi: 10},
+		{Name: "APIC Global Enable", Lo: 11, Hi: 11},
+		{Name: "APIC Base", Lo: 12, Hi: 51, Addr: true},
+	}},
+	{Addr: IntelIA32FeatureControl, Name: "IA32_FEATURE_CONTROL", Fields: []Field{
+		{Name: "Lock", Lo: 0, Hi: 0},
+		{Name: "Enable VMX inside SMX", Lo: 1, Hi: 1},
+		{Name: "Enable VMX outside SMX", Lo: 2, Hi: 2},
+		{Name: "SENTER Local Function Enables", Lo: 8, Hi: 14},
+		{Name: "SENTER Global Enable", Lo: 15, Hi: 15},
+		{Name: "SGX Launch Control Enable", Lo: 17, Hi: 17},
+		{Name: "SGX Global Enable", Lo: 18, Hi: 18},
+		{Name: "LMCE On", Lo: 20, Hi: 20},
+	}},
+	{Addr: 0x8b, Name: "IA32_BIOS_SIGN_ID", Fields: []Field{
+		{Name: "Microcode Update Signature", Lo: 32, Hi: 63},
+	}},
+	{Addr: 0xce, Name: "MSR_PLATFORM_INFO", Fields: []Field{
+		{Name: "Maximum Non-Turbo Ratio", Lo: 8, Hi: 15},
+		{Name: "PPIN_CAP", Lo: 23, Hi: 23},
+		{Name: "Programmable Ratio Limits for Turbo Mode", Lo: 28, Hi: 28},
+		{Name: "Programmable TDP Limits for Turbo Mode", Lo: 29, Hi: 29},
+		{Name: "Programmable TJ OFFSET", Lo: 30, Hi: 30},
+		{Name: "Maximum Efficiency Ratio", Lo: 40, Hi: 47},
+		{Name: "Minimum Operating Ratio", Lo: 48, Hi: 55},
+	}},
+	{Addr: IntelPkgCstConfigControl, Name: "MSR_PKG_CST_CONFIG_CONTROL", Fields: []Field{
+		{Name: "Package C-State Limit", Lo: 0, Hi: 3},
+		{Name: "I/O MWAIT Redirection Enable", Lo: 10, Hi: 10},
+		{Name: "CFG Lock", Lo: 15, Hi: 15},
+	}},
+	{Addr: 0xfe, Name: "IA32_MTRRCAP", Fields: []Field{
+		{Name: "VCNT", Lo: 0, Hi: 7},
+		{Name: "Fixed Range MTRRs", Lo: 8, Hi: 8},
+		{Name: "Write Combining", Lo: 10, Hi: 10},
+		{Name: "SMRR", Lo: 11, Hi: 11},
+	}},
+	{Addr: IntelFeatureConfig, Name: "MSR_FEATURE_CONFIG", Fields: []Field{
+		{Name: "AES Configuration", Lo: 0, Hi: 1},
+	}},
+	{Addr: 0x198, Name: "IA32_PERF_STATUS", Fields: []Field{
+		{Name: "Current Performance State Value", Lo: 0, Hi: 15},
+	}},
+	{Addr: 0x199, Name: "IA32_PERF_CTL", Fields: []Field{
+		{Name: "Target Performance State Value", Lo: 0, Hi: 15},
+		{Name: "IDA Engage", Lo: 32, Hi: 32},
+	}},
+	{Addr: 0x1a0, Name: "IA32_MISC_ENABLE", Fields: []Field{
+		{Name: "Fast-Strings Enable", Lo: 0, Hi: 0},
+		{Name: "Automatic Thermal Control Circuit Enable", Lo: 3, Hi: 3},
+		{Name: "Performance Monitoring Available", Lo: 7, Hi: 7},
+		{Name: "Enhanced Intel SpeedStep Technology Enable", Lo: 16, Hi: 16},
+		{Name: "ENABLE MONITOR FSM", Lo: 18, Hi: 18},
+		{Name: "Limit CPUID Maxval", Lo: 22, Hi: 22},
+		{Name: "XD Bit Disable", Lo: 34, Hi: 34},
+		{Name: "Turbo Mode Disable", Lo: 38, Hi: 38},
+	}},
+	{Addr: 0x1a2, Name: "MSR_TEMPERATURE_TARGET", Fields: []Field{
+		{Name: "Temperature Target", Lo: 16, Hi: 23},
+		{Name: "TCC Activation Offset", Lo: 24, Hi: 29},
+	}},
+	{Addr: 0x1b1, Name: "IA32_PACKAGE_THERM_STATUS", Fields: []Field{
+		{Name: "Pkg Thermal Status", Lo: 0, Hi: 0},
+		{Name: "Pkg Thermal Status Log", Lo: 1, Hi: 1},
+		{Name: "Pkg PROCHOT # event", Lo: 2, Hi: 2},
+		{Name: "Pkg Critical Temperature Status", Lo: 4, Hi: 4},
+		{Name: "Pkg Digital Readout", Lo: 16, Hi: 22},
+	}},
+	{Addr: 0x1f2, Name: "IA32_SMRR_PHYSBASE", Fields: []Field{
+		{Name: "Type", Lo: 0, Hi: 7},
+		{Name: "PhysBase", Lo: 12, Hi: 31, Addr: true},
+	}},
+	{Addr: 0x1f3, Name: "IA32_SMRR_PHYSMASK", Fields: []Field{
+		{Name: "Valid", Lo: 11, Hi: 11},
+		{Name: "PhysMask", Lo: 12, Hi: 31, Addr: true},
+	}},
+	{Addr: 0x606, Name: "MSR_RAPL_POWER_UNIT", Fields: []Field{
+		{Name: "Power Units", Lo: 0, Hi: 3},
+		{Name: "Energy Status Units", Lo: 8, Hi: 12},
+		{Name: "Time Units", Lo: 16, Hi: 19},
+	}},
+	{Addr: 0x610, Name: "MSR_PKG_POWER_LIMIT", Fields: []Field{
+		{Name: "Package Power Limit #1", Lo: 0, Hi: 14},
+		{Name: "Enable Limit #1", Lo: 15, Hi: 15},
+		{Name: "Package Power Limit #2", Lo: 32, Hi: 46},
+		{Name: "Enable Limit #2", Lo: 47, Hi: 47},
+		{Name: "Lock", Lo: 63, Hi: 63},
+	}},
+	{Addr: IntelDramPowerLimit, Name: "MSR_DRAM_POWER_LIMIT", Fields: []Field{
+		{Name: "DRAM Power Limit #1", Lo: 0, Hi: 14},
+		{Name: "Enable Power Limit #1", Lo: 15, Hi: 15},
+		{Name: "Lock", Lo: 31, Hi: 31},
+	}},
+	{Addr: IntelConfigTDPControl, Name: "MSR_CONFIG_TDP_CONTROL", Fields: []Field{
+		{Name: "TDP Level", Lo: 0, Hi: 1},
+		{Name: "Config_TDP_Lock", Lo: 31, Hi: 31},
+	}},
+	{Addr: IntelIA32DebugInterface, Name: "IA32_DEBUG_INTERFACE", Fields: []Field{
+		{Name: "Enable", Lo: 0, Hi: 0},
+		{Name: "Lock", Lo: 30, Hi: 30},
+		{Name: "Debug Occurred", Lo: 31, Hi: 31},
+	}},
+	{Addr: 0xc0000080, Name: "IA32_EFER", Fields: []Field{
+		{Name: "SYSCALL Enable", Lo: 0, Hi: 0},
+		{Name: "IA-32e Mode Enable", Lo: 8, Hi: 8},
+		{Name: "IA-32e Mode Active", Lo: 10, Hi: 10},
+		{Name: "Execute Disable Bit Enable", Lo: 11, Hi: 11},
+	}},
+}
+
+// Lookup returns the known register at address m.
+func Lookup(m MSR) (*Register, bool) {
+	for i := range Registers {
+		if Registers[i].Addr == m {
+			return &Registers[i], true
+		}
+	}
+	return nil, false
+}
+
+// ParseMSR parses an MSR address or the name of a known MSR. Names are
+// case insensitive, and the IA32_ and MSR_ prefixes may be left out.
+func ParseMSR(s string) (MSR, error) {
+	if n, err := strconv.ParseUint(s, 0, 32); err == nil {
+		return MSR(n), nil
+	}
+	u := strings.ToUpper(s)
+	for _, r := range Registers {
+		if u == r.Name || u == strings.TrimPrefix(strings.TrimPrefix(r.Name, "IA32_"), "MSR_") {
+			return r.Addr, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown MSR %q", s)
+}
+
+// Name returns the name of a known MSR, or its address.
+func (m MSR) Name() string {
+	if r, ok := Lookup(m); ok {
+		return r.Name
+	}
+	return m.String()
+}
+
+// Decode formats the fields of value v of the register, one per line.
+func (r *Register) Decode(v uint64) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s (%v) = %#x\n", r.Name, r.Addr, v)
+	for _, f := range r.Fields {
+		if f.Lo == f.Hi {
+			fmt.Fprintf(&b, "  [%d] %s: %d\n", f.Lo, f.Name, f.Value(v))
+		} else {
+			fmt.Fprintf(&b, "  [%d:%d] %s: %#x\n", f.Hi, f.Lo, f.Name, f.Value(v))
+		}
+	}
+	return b.String()
+}
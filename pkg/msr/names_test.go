@@ -0,0 +1,62 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package msr
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseMSR(t *testing.T) {
+	for _, tt := range []struct {
+		in   string
+		want MSR
+		err  bool
+	}{
+		{in: "0x3a", want: 0x3a},
+		{in: "58", want: 0x3a},
+		{in: "IA32_FEATURE_CONTROL", want: 0x3a},
+		{in: "feature_control", want: 0x3a},
+		{in: "platform_info", want: 0xce},
+		{in: "MSR_PLATFORM_INFO", want: 0xce},
+		{in: "nonesuch", err: true},
+	} {
+		got, err := ParseMSR(tt.in)
+		if (err != nil) != tt.err || got != tt.want {
+			t.Errorf("ParseMSR(%q) = %v, %v, want %v, error %v", tt.in, got, err, tt.want, tt.err)
+		}
+	}
+}
+
+func TestDecode(t *testing.T) {
+	r, ok := Lookup(0xce)
+	if !ok {
+		t.Fatalf("Lookup(0xce) failed")
+	}
+	// A Skylake: max non-turbo 23, max efficiency 8, min operating 4.
+	d := r.Decode(0x4080030011700)
+	for _, want := range []string{
+		"MSR_PLATFORM_INFO (0xce) = 0x4080030011700\n",
+		"  [15:8] Maximum Non-Turbo Ratio: 0x17\n",
+		"  [28] Programmable Ratio Limits for Turbo Mode: 1\n",
+		"  [47:40] Maximum Efficiency Ratio: 0x8\n",
+		"  [55:48] Minimum Operating Ratio: 0x4\n",
+	} {
+		if !strings.Contains(d, want) {
+			t.Errorf("Decode does not contain %q:\n%s", want, d)
+		}
+	}
+
+	r, _ = Lookup(0x1b)
+	if d := r.Decode(0xfee00900); !strings.Contains(d, "APIC Base: 0xfee00000\n") || !strings.Contains(d, "[8] BSP: 1\n") {
+		t.Errorf("APIC base decode:\n%s", d)
+	}
+	if got := MSR(0x3a).Name(); got != "IA32_FEATURE_CONTROL" {
+		t.Errorf("Name() = %q", got)
+	}
+	if got := MSR(0x1234).Name(); got != "0x1234" {
+		t.Errorf("Name() = %q", got)
+	}
+}
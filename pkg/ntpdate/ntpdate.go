@@ -0,0 +1,160 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package ntpdate sets the system clock from one or more (S)NTP servers.
+//
+// Every server is queried once, all at the same time. Responses that fail
+// sanity checks are discarded, and the median offset of the remaining ones
+// is applied, so a single bad server cannot move the clock on its own.
+package ntpdate
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/beevik/ntp"
+)
+
+const (
+	// DefaultConfig is the ntpd configuration file servers are read from.
+	DefaultConfig = "/etc/ntp.conf"
+
+	// DefaultTimeout bounds each query.
+	DefaultTimeout = 5 * time.Second
+
+	// DefaultStepThreshold is the offset above which the clock is stepped
+	// rather than slewed, the same as ntpd's.
+	DefaultStepThreshold = 128 * time.Millisecond
+)
+
+// Options control SetTime.
+type Options struct {
+	// Servers are the NTP servers to query.
+	Servers []string
+
+	// Timeout bounds each query. Zero means DefaultTimeout.
+	Timeout time.Duration
+
+	// StepThreshold is the smallest offset which is corrected by stepping
+	// the clock; smaller offsets are slewed. Zero means
+	// DefaultStepThreshold and a negative value always steps.
+	StepThreshold time.Duration
+
+	// SetRTC also writes the corrected time to the hardware clock.
+	SetRTC bool
+
+	// Debug, if set, is used to log progress.
+	Debug func(string, ...interface{})
+}
+
+// Sample is a valid response from a server.
+type Sample struct {
+	Server string
+	*ntp.Response
+}
+
+// Result describes the adjustment SetTime made.
+type Result struct {
+	// Server is the server whose offset was applied.
+	Server string
+
+	// Offset is the correction applied to the system clock.
+	Offset time.Duration
+
+	// Stepped is true if the clock was set, false if it was slewed.
+	Stepped bool
+}
+
+// query is a variable so tests can replace it.
+var query = ntp.QueryWithOptions
+
+// ParseConfig returns the servers named by "server" and "pool" lines of an
+// ntp.conf. Options such as iburst are ignored.
+func ParseConfig(r io.Reader) []string {
+	var servers []string
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		if w := strings.Fields(s.Text()); len(w) > 1 && (w[0] == "server" || w[0] == "pool") {
+			servers = append(servers, w[1])
+		}
+	}
+	return servers
+}
+
+// check applies the checks of RFC 5905 (through ntp.Response.Validate)
+// plus a bound on the round trip time, which also bounds the error of the
+// offset.
+func check(r *ntp.Response, timeout time.Duration) error {
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	if r.RTT < 0 || r.RTT > timeout {
+		return fmt.Errorf("implausible round trip time %v", r.RTT)
+	}
+	return nil
+}
+
+// Query asks every server for the time, all at once, and returns the
+// samples which pass the sanity checks. It fails only if none do.
+func Query(servers []string, timeout time.Duration, debug func(string, ...interface{})) ([]Sample, error) {
+	if timeout == 0 {
+		timeout = DefaultTimeout
+	}
+	if debug == nil {
+		debug = func(string, ...interface{}) {}
+	}
+	if len(servers) == 0 {
+		return nil, errors.New("no NTP servers given")
+	}
+	// Servers are queried at once, so that unreachable ones only cost
+	// one timeout.
+	results := make([]*ntp.Response, len(servers))
+	var wg sync.WaitGroup
+	for i, s := range servers {
+		wg.Add(1)
+		go func(i int, s string) {
+			defer wg.Done()
+			debug("Querying %v", s)
+			r, err := query(s, ntp.QueryOptions{Timeout: timeout})
+			if err == nil {
+				err = check(r, timeout)
+			}
+			if err != nil {
+				debug("Discarding %v: %v", s, err)
+				return
+			}
+			debug("%v: stratum %d, offset %v, rtt %v", s, r.Stratum, r.ClockOffset, r.RTT)
+			results[i] = r
+		}(i, s)
+	}
+	wg.Wait()
+	var samples []Sample
+	for i, r := range results {
+		if r != nil {
+			samples = append(samples, Sample{Server: servers[i], Response: r})
+		}
+	}
+	if len(samples) == 0 {
+		return nil, fmt.Errorf("unable to get a valid time from servers %v", servers)
+	}
+	return samples, nil
+}
+
+// Select returns the sample with the median offset. With an even number of
+// samples, the one of the middle two with the shorter root distance wins.
+func Select(samples []Sample) Sample {
+	s := append([]Sample(nil), samples...)
+	sort.SliceStable(s, func(i, j int) bool { return s[i].ClockOffset < s[j].ClockOffset })
+	m := len(s) / 2
+	if len(s)%2 == 0 && s[m-1].RootDistance < s[m].RootDistance {
+		m--
+	}
+	return s[m]
+}
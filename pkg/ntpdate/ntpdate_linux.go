@@ -0,0 +1,88 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ntpdate
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/u-root/u-root/pkg/rtc"
+	"golang.org/x/sys/unix"
+)
+
+// adjOffsetSingleshot is the adjtimex mode implementing adjtime(3).
+const adjOffsetSingleshot = 0x8001
+
+// SetTime queries o.Servers and corrects the system clock by the selected
+// offset.
+func SetTime(o Options) (*Result, error) {
+	samples, err := Query(o.Servers, o.Timeout, o.Debug)
+	if err != nil {
+		return nil, err
+	}
+	s := Select(samples)
+	threshold := o.StepThreshold
+	if threshold == 0 {
+		threshold = DefaultStepThreshold
+	}
+	res := &Result{Server: s.Server, Offset: s.ClockOffset}
+	off := s.ClockOffset
+	if off < 0 {
+		off = -off
+	}
+	if threshold < 0 || off >= threshold {
+		res.Stepped = true
+		err = step(s.ClockOffset)
+	} else {
+		err = slew(s.ClockOffset)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if o.SetRTC {
+		// A slewed clock only gets to the right time gradually, while
+		// the RTC is set at once.
+		now := time.Now()
+		if !res.Stepped {
+			now = now.Add(s.ClockOffset)
+		}
+		if err := setRTC(now); err != nil {
+			return res, err
+		}
+	}
+	return res, nil
+}
+
+// step sets the clock forward by offset.
+func step(offset time.Duration) error {
+	tv := unix.NsecToTimeval(time.Now().Add(offset).UnixNano())
+	if err := unix.Settimeofday(&tv); err != nil {
+		return fmt.Errorf("unable to set system time: %v", err)
+	}
+	return nil
+}
+
+// slew gradually corrects the clock by offset, as adjtime(3) does.
+func slew(offset time.Duration) error {
+	tx := unix.Timex{Modes: adjOffsetSingleshot}
+	setOffset(&tx, offset.Microseconds())
+	if _, err := unix.Adjtimex(&tx); err != nil {
+		return fmt.Errorf("unable to slew system time: %v", err)
+	}
+	return nil
+}
+
+// setRTC sets the RTC to t.
+func setRTC(t time.Time) error {
+	r, err := rtc.OpenRTC()
+	if err != nil {
+		return fmt.Errorf("unable to open RTC: %v", err)
+	}
+	defer r.Close()
+	if err := r.Set(t.UTC()); err != nil {
+		return fmt.Errorf("unable to set RTC: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ntpdate
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/beevik/ntp"
+)
+
+var configFileTests = []struct {
+	config string
+	out    []string
+}{
+	{
+		config: "",
+		out:    []string{},
+	},
+	{
+		config: "server 127.0.0.1",
+		out:    []string{"127.0.0.1"},
+	},
+	{
+		config: "server 127.0.0.1\n",
+		out:    []string{"127.0.0.1"},
+	},
+	{
+		config: "servers 127.0.0.1",
+		out:    []string{},
+	},
+	{
+		config: "server time.google.com iburst",
+		out:    []string{"time.google.com"},
+	},
+	{
+		config: "server 127.0.0.1\n" +
+			"server time.google.com",
+		out: []string{"127.0.0.1", "time.google.com"},
+	},
+	{
+		config: "servers 127.0.0.1\n" +
+			"server time.google.com",
+		out: []string{"time.google.com"},
+	},
+	{
+		config: "pool pool.ntp.org iburst\n" +
+			"server time.google.com",
+		out: []string{"pool.ntp.org", "time.google.com"},
+	},
+}
+
+func TestParseConfig(t *testing.T) {
+	for _, tt := range configFileTests {
+		out := ParseConfig(strings.NewReader(tt.config))
+
+		if len(out) != len(tt.out) {
+			t.Errorf("Different lengths! Expected:\n%v\ngot:\n%v", tt.out, out)
+			continue
+		}
+
+		for i := range out {
+			if out[i] != tt.out[i] {
+				t.Errorf("Element at index %d differs. expected:\n%v\ngot:\n%v", i, tt.out, out)
+			}
+		}
+	}
+}
+
+// response returns a valid response with the given offset.
+func response(offset time.Duration) *ntp.Response {
+	now := time.Now()
+	return &ntp.Response{
+		Time:          now,
+		ReferenceTime: now.Add(-time.Minute),
+		ClockOffset:   offset,
+		RTT:           10 * time.Millisecond,
+		Stratum:       2,
+		RootDistance:  offset,
+	}
+}
+
+func TestQuery(t *testing.T) {
+	defer func(q func(string, ntp.QueryOptions) (*ntp.Response, error)) { query = q }(query)
+	kiss := response(time.Second)
+	kiss.Stratum = 0
+	unsynced := response(time.Second)
+	unsynced.Leap = ntp.LeapNotInSync
+	slow := response(time.Second)
+	slow.RTT = time.Minute
+	responses := map[string]*ntp.Response{
+		"good":     response(time.Second),
+		"kiss":     kiss,
+		"unsynced": unsynced,
+		"slow":     slow,
+	}
+	query = func(host string, _ ntp.QueryOptions) (*ntp.Response, error) {
+		if r, ok := responses[host]; ok {
+			return r, nil
+		}
+		return nil, errors.New("no such host")
+	}
+
+	responses["good2"] = response(2 * time.Second)
+	s, err := Query([]string{"kiss", "good2", "nope", "good", "unsynced", "slow"}, time.Second, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(s) != 2 || s[0].Server != "good2" || s[1].Server != "good" {
+		t.Errorf("Query returned %v, want only the good servers, in order", s)
+	}
+
+	for _, servers := range [][]string{nil, {"nope"}, {"kiss", "slow"}} {
+		if _, err := Query(servers, time.Second, nil); err == nil {
+			t.Errorf("Query(%q) succeeded, want error", servers)
+		}
+	}
+}
+
+func TestSelect(t *testing.T) {
+	samples := func(offsets ...time.Duration) []Sample {
+		var s []Sample
+		for _, o := range offsets {
+			s = append(s, Sample{Server: o.String(), Response: response(o)})
+		}
+		return s
+	}
+	for _, tt := range []struct {
+		samples []Sample
+		want    time.Duration
+	}{
+		{samples(time.Second), time.Second},
+		// A single server far off does not win.
+		{samples(time.Second, time.Hour, 2*time.Second), 2 * time.Second},
+		{samples(-time.Hour, time.Second, 3*time.Second), time.Second},
+		// Of the middle two, the shorter root distance wins.
+		{samples(4*time.Second, time.Second, 2*time.Second, time.Hour), 2 * time.Second},
+	} {
+		if got := Select(tt.samples).ClockOffset; got != tt.want {
+			t.Errorf("Select(%v) = %v, want %v", tt.samples, got, tt.want)
+		}
+	}
+}
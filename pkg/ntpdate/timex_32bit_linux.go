@@ -0,0 +1,17 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build 386 arm mips mipsle
+
+package ntpdate
+
+import "golang.org/x/sys/unix"
+
+// The type of Timex.Offset is different on different architectures.
+// This file is for those where it is int32.
+
+// setOffset sets the offset of tx to us microseconds.
+func setOffset(tx *unix.Timex, us int64) {
+	tx.Offset = int32(us)
+}
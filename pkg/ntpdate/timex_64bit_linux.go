@@ -0,0 +1,17 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build amd64 arm64 mips64 mips64le ppc64 ppc64le riscv64 s390x sparc64
+
+package ntpdate
+
+import "golang.org/x/sys/unix"
+
+// The type of Timex.Offset is different on different architectures.
+// This file is for those where it is int64.
+
+// setOffset sets the offset of tx to us microseconds.
+func setOffset(tx *unix.Timex, us int64) {
+	tx.Offset = us
+}
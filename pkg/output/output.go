@@ -0,0 +1,130 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package output renders the results of inspection commands as text or as
+// JSON, so they can be scripted without parsing text.
+//
+// Commands offering JSON take a -json flag, named by FlagName. JSON keys are
+// lower case with underscores, like "mount_point"; Key derives them from
+// column headers. Numbers and booleans are JSON numbers and booleans, not
+// strings, and sizes are in bytes unless the key says otherwise.
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+// FlagName and FlagUsage are the name and usage of the flag selecting JSON
+// output.
+const (
+	FlagName  = "json"
+	FlagUsage = "print JSON"
+)
+
+// JSON writes v as indented JSON, followed by a newline.
+func JSON(w io.Writer, v interface{}) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// Key converts a column header, such as "MAJ:MIN", "Use%" or "Mounted on",
+// to a JSON key, such as "maj_min", "use_pct" or "mounted_on".
+func Key(header string) string {
+	var b strings.Builder
+	sep := false
+	for _, r := range strings.ToLower(strings.Replace(header, "%", " pct", -1)) {
+		if r >= 'a' && r <= 'z' || r >= '0' && r <= '9' {
+			if sep && b.Len() > 0 {
+				b.WriteByte('_')
+			}
+			sep = false
+			b.WriteRune(r)
+			continue
+		}
+		sep = true
+	}
+	return b.String()
+}
+
+// Table is a list of rows with the same columns.
+type Table struct {
+	// Columns are the headers of the columns.
+	Columns []string
+	// Rows hold one value per column. Values are printed with %v in text,
+	// and encoded as they are in JSON.
+	Rows [][]interface{}
+}
+
+// NewTable returns an empty table with the given column headers.
+func NewTable(columns ...string) *Table {
+	return &Table{Columns: columns}
+}
+
+// Add appends a row.
+func (t *Table) Add(values ...interface{}) {
+	t.Rows = append(t.Rows, values)
+}
+
+// WriteText writes the table with aligned columns, under a header.
+func (t *Table) WriteText(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 1, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(t.Columns, "\t"))
+	for _, r := range t.Rows {
+		cells := make([]string, len(r))
+		for i, v := range r {
+			cells[i] = fmt.Sprint(v)
+		}
+		fmt.Fprintln(tw, strings.Join(cells, "\t"))
+	}
+	return tw.Flush()
+}
+
+// MarshalJSON encodes the table as an array of objects, one per row, with
+// the keys of the columns in order.
+func (t *Table) MarshalJSON() ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteByte('[')
+	for i, r := range t.Rows {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteByte('{')
+		for j, v := range r {
+			if j >= len(t.Columns) {
+				return nil, fmt.Errorf("row %d has %d values for %d columns", i, len(r), len(t.Columns))
+			}
+			if j > 0 {
+				b.WriteByte(',')
+			}
+			k, err := json.Marshal(Key(t.Columns[j]))
+			if err != nil {
+				return nil, err
+			}
+			e, err := json.Marshal(v)
+			if err != nil {
+				return nil, err
+			}
+			b.Write(k)
+			b.WriteByte(':')
+			b.Write(e)
+		}
+		b.WriteByte('}')
+	}
+	b.WriteByte(']')
+	return b.Bytes(), nil
+}
+
+// Write writes t as JSON if asJSON is set, as text otherwise.
+func (t *Table) Write(w io.Writer, asJSON bool) error {
+	if asJSON {
+		return JSON(w, t)
+	}
+	return t.WriteText(w)
+}
@@ -0,0 +1,74 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package output
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestKey(t *testing.T) {
+	for in, want := range map[string]string{
+		"PID":        "pid",
+		"MAJ:MIN":    "maj_min",
+		"Use%":       "use_pct",
+		"Mounted on": "mounted_on",
+		"1K-blocks":  "1k_blocks",
+		"buff/cache": "buff_cache",
+		"  TYPE  ":   "type",
+	} {
+		if got := Key(in); got != want {
+			t.Errorf("Key(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestTable(t *testing.T) {
+	tb := NewTable("NAME", "SIZE", "Use%", "RO")
+	tb.Add("sda", uint64(1024), 50, false)
+	tb.Add("sda1", uint64(512), 100, true)
+
+	var out bytes.Buffer
+	if err := tb.Write(&out, false); err != nil {
+		t.Fatal(err)
+	}
+	want := "NAME SIZE Use% RO\nsda  1024 50   false\nsda1 512  100  true\n"
+	if out.String() != want {
+		t.Errorf("text:\n%s\nwant:\n%s", out.String(), want)
+	}
+
+	out.Reset()
+	if err := tb.Write(&out, true); err != nil {
+		t.Fatal(err)
+	}
+	want = `[
+  {
+    "name": "sda",
+    "size": 1024,
+    "use_pct": 50,
+    "ro": false
+  },
+  {
+    "name": "sda1",
+    "size": 512,
+    "use_pct": 100,
+    "ro": true
+  }
+]
+`
+	if out.String() != want {
+		t.Errorf("JSON:\n%s\nwant:\n%s", out.String(), want)
+	}
+
+	out.Reset()
+	if err := NewTable("A").Write(&out, true); err != nil || out.String() != "[]\n" {
+		t.Errorf("empty table = %q, %v, want \"[]\\n\"", out.String(), err)
+	}
+
+	tb.Add(1, 2, 3, 4, 5)
+	if err := tb.Write(&out, true); err == nil {
+		t.Errorf("encoding row with too many values succeeded")
+	}
+}
@@ -0,0 +1,377 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pci
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+)
+
+// Configuration space offsets and capability IDs used in decoding.
+const (
+	cfgStatus      = 0x06
+	cfgCapPointer  = 0x34
+	cfgExtCapStart = 0x100
+
+	statusCapList = 0x10
+
+	CapPM      = 0x01
+	CapMSI     = 0x05
+	CapVendor  = 0x09
+	CapExpress = 0x10
+	CapMSIX    = 0x11
+
+	ExtCapAER    = 0x01
+	ExtCapSerial = 0x03
+	ExtCapSRIOV  = 0x10
+)
+
+var capNames = map[uint16]string{
+	0x01: "Power Management",
+	0x02: "AGP",
+	0x03: "Vital Product Data",
+	0x04: "Slot Identification",
+	0x05: "MSI",
+	0x06: "CompactPCI hot-swap",
+	0x07: "PCI-X",
+	0x08: "HyperTransport",
+	0x09: "Vendor Specific",
+	0x0a: "Debug port",
+	0x0b: "CompactPCI central resource control",
+	0x0c: "PCI hot-plug",
+	0x0d: "Subsystem",
+	0x0e: "AGP 8x",
+	0x0f: "Secure device",
+	0x10: "Express",
+	0x11: "MSI-X",
+	0x12: "SATA HBA",
+	0x13: "PCI Advanced Features",
+	0x14: "Enhanced Allocation",
+	0x15: "Flattening Portal Bridge",
+}
+
+var extCapNames = map[uint16]string{
+	0x01: "Advanced Error Reporting",
+	0x02: "Virtual Channel",
+	0x03: "Device Serial Number",
+	0x04: "Power Budgeting",
+	0x05: "Root Complex Link",
+	0x06: "Root Complex Internal Link",
+	0x07: "Root Complex Event Collector",
+	0x08: "Multi-Function VC",
+	0x09: "Virtual Channel",
+	0x0a: "Root Complex Register Block",
+	0x0b: "Vendor Specific",
+	0x0d: "Access Control Services",
+	0x0e: "Alternative Routing-ID Interpretation",
+	0x0f: "Address Translation Service",
+	0x10: "Single Root I/O Virtualization",
+	0x11: "Multi-Root I/O Virtualization",
+	0x12: "Multicast",
+	0x13: "Page Request Interface",
+	0x15: "Resizable BAR",
+	0x16: "Dynamic Power Allocation",
+	0x17: "TPH Requester",
+	0x18: "Latency Tolerance Reporting",
+	0x19: "Secondary PCI Express",
+	0x1b: "Process Address Space ID",
+	0x1d: "Downstream Port Containment",
+	0x1e: "L1 PM Substates",
+	0x1f: "Precision Time Measurement",
+	0x23: "Designated Vendor-Specific",
+	0x25: "Data Link Feature",
+	0x26: "Physical Layer 16.0 GT/s",
+	0x27: "Lane Margining at the Receiver",
+	0x2a: "Physical Layer 32.0 GT/s",
+}
+
+// Link is the state of a PCI Express link.
+type Link struct {
+	// Speeds are in GT/s.
+	MaxSpeed float64 `json:"max_speed"`
+	MaxWidth int     `json:"max_width"`
+	Speed    float64 `json:"speed"`
+	Width    int     `json:"width"`
+}
+
+// Downgraded returns true if the link trained below its capabilities.
+func (l *Link) Downgraded() bool {
+	return l.Speed < l.MaxSpeed || l.Width < l.MaxWidth
+}
+
+// Capability is a standard or extended capability of a device.
+type Capability struct {
+	ID       uint16 `json:"id"`
+	Extended bool   `json:"extended,omitempty"`
+	Version  uint8  `json:"version,omitempty"`
+	Offset   uint16 `json:"offset"`
+	Name     string `json:"name"`
+	// Details are the decoded registers, in the style of lspci -vv.
+	Details []string `json:"details,omitempty"`
+	// Link is set for the PCI Express capability.
+	Link *Link `json:"link,omitempty"`
+}
+
+// String returns the capability in the style of lspci -v.
+func (c Capability) String() string {
+	s := fmt.Sprintf("Capabilities: [%02x] %s", c.Offset, c.Name)
+	if c.Extended {
+		s = fmt.Sprintf("Capabilities: [%03x v%d] %s", c.Offset, c.Version, c.Name)
+	}
+	for _, d := range c.Details {
+		s += "\n\t" + d
+	}
+	return s
+}
+
+// config is a configuration space which returns 0 beyond its end, like
+// the config file of an unprivileged reader.
+type config []byte
+
+func (c config) u8(off int) uint8 {
+	if off >= len(c) {
+		return 0
+	}
+	return c[off]
+}
+
+func (c config) u16(off int) uint16 {
+	if off+2 > len(c) {
+		return 0
+	}
+	return binary.LittleEndian.Uint16(c[off:])
+}
+
+func (c config) u32(off int) uint32 {
+	if off+4 > len(c) {
+		return 0
+	}
+	return binary.LittleEndian.Uint32(c[off:])
+}
+
+func onOff(b bool) string {
+	if b {
+		return "+"
+	}
+	return "-"
+}
+
+// linkSpeed returns the speed of a link speed encoding in GT/s.
+func linkSpeed(v uint32) float64 {
+	switch v {
+	case 1:
+		return 2.5
+	case 2:
+		return 5
+	case 3:
+		return 8
+	case 4:
+		return 16
+	case 5:
+		return 32
+	case 6:
+		return 64
+	}
+	return 0
+}
+
+var expressTypes = []string{
+	"Endpoint", "Legacy Endpoint", "", "", "Root Port", "Upstream Port",
+	"Downstream Port", "PCI-Express to PCI/PCI-X Bridge", "PCI/PCI-X to PCI-Express Bridge",
+	"Root Complex Integrated Endpoint", "Root Complex Event Collector",
+}
+
+func decodeExpress(c config, off int, cp *Capability) {
+	caps := c.u16(off + 2)
+	t := int(caps>>4) & 0xf
+	if t < len(expressTypes) && expressTypes[t] != "" {
+		cp.Name += " " + expressTypes[t]
+	}
+	devCap, devCtl := c.u32(off+4), c.u16(off+8)
+	cp.Details = append(cp.Details,
+		fmt.Sprintf("DevCap: MaxPayload %d bytes", 128<<(devCap&7)),
+		fmt.Sprintf("DevCtl: CorrErr%s NonFatalErr%s FatalErr%s UnsupReq%s RlxdOrd%s ExtTag%s NoSnoop%s",
+			onOff(devCtl&1 != 0), onOff(devCtl&2 != 0), onOff(devCtl&4 != 0), onOff(devCtl&8 != 0),
+			onOff(devCtl&0x10 != 0), onOff(devCtl&0x100 != 0), onOff(devCtl&0x800 != 0)),
+		fmt.Sprintf("DevCtl: MaxPayload %d bytes, MaxReadReq %d bytes", 128<<((devCtl>>5)&7), 128<<((devCtl>>12)&7)))
+	devSta := c.u16(off + 0xa)
+	cp.Details = append(cp.Details, fmt.Sprintf("DevSta: CorrErr%s NonFatalErr%s FatalErr%s UnsupReq%s",
+		onOff(devSta&1 != 0), onOff(devSta&2 != 0), onOff(devSta&4 != 0), onOff(devSta&8 != 0)))
+
+	// Root complex integrated endpoints and event collectors have no link.
+	if t == 9 || t == 10 {
+		return
+	}
+	linkCap, linkSta := c.u32(off+0xc), c.u16(off+0x12)
+	l := &Link{
+		MaxSpeed: linkSpeed(linkCap & 0xf),
+		MaxWidth: int(linkCap>>4) & 0x3f,
+		Speed:    linkSpeed(uint32(linkSta) & 0xf),
+		Width:    int(linkSta>>4) & 0x3f,
+	}
+	cp.Link = l
+	lnkCtl := c.u16(off + 0x10)
+	cp.Details = append(cp.Details,
+		fmt.Sprintf("LnkCap: Port #%d, Speed %vGT/s, Width x%d, ASPM %s", linkCap>>24, l.MaxSpeed, l.MaxWidth, aspm[(linkCap>>10)&3]),
+		fmt.Sprintf("LnkCtl: ASPM %s; Disabled%s Retrain%s", aspmCtl[lnkCtl&3], onOff(lnkCtl&0x10 != 0), onOff(lnkCtl&0x20 != 0)))
+	sta := fmt.Sprintf("LnkSta: Speed %vGT/s, Width x%d", l.Speed, l.Width)
+	if l.Downgraded() {
+		sta += " (downgraded)"
+	}
+	if linkSta&(1<<11) != 0 {
+		sta += ", Training"
+	}
+	cp.Details = append(cp.Details, sta)
+}
+
+var (
+	aspm    = []string{"not supported", "L0s", "L1", "L0s L1"}
+	aspmCtl = []string{"Disabled", "L0s Enabled", "L1 Enabled", "L0s L1 Enabled"}
+)
+
+func decodeMSI(c config, off int, cp *Capability) {
+	ctl := c.u16(off + 2)
+	cp.Details = append(cp.Details, fmt.Sprintf("Enable%s Count=%d/%d Maskable%s 64bit%s",
+		onOff(ctl&1 != 0), 1<<((ctl>>4)&7), 1<<((ctl>>1)&7), onOff(ctl&0x100 != 0), onOff(ctl&0x80 != 0)))
+	addr, data := uint64(c.u32(off+4)), c.u16(off+8)
+	if ctl&0x80 != 0 {
+		addr |= uint64(c.u32(off+8)) << 32
+		data = c.u16(off + 0xc)
+	}
+	cp.Details = append(cp.Details, fmt.Sprintf("Address: %016x  Data: %04x", addr, data))
+}
+
+func decodePM(c config, off int, cp *Capability) {
+	pmc, csr := c.u16(off+2), c.u16(off+4)
+	cp.Name += fmt.Sprintf(" version %d", pmc&7)
+	cp.Details = append(cp.Details,
+		fmt.Sprintf("Flags: PMEClk%s DSI%s D1%s D2%s PME(D0%s,D1%s,D2%s,D3hot%s,D3cold%s)",
+			onOff(pmc&8 != 0), onOff(pmc&0x20 != 0), onOff(pmc&0x200 != 0), onOff(pmc&0x400 != 0),
+			onOff(pmc&0x800 != 0), onOff(pmc&0x1000 != 0), onOff(pmc&0x2000 != 0), onOff(pmc&0x4000 != 0), onOff(pmc&0x8000 != 0)),
+		fmt.Sprintf("Status: D%d NoSoftRst%s PME-Enable%s PME%s",
+			csr&3, onOff(csr&8 != 0), onOff(csr&0x100 != 0), onOff(csr&0x8000 != 0)))
+}
+
+func decodeMSIX(c config, off int, cp *Capability) {
+	ctl := c.u16(off + 2)
+	table, pba := c.u32(off+4), c.u32(off+8)
+	cp.Details = append(cp.Details,
+		fmt.Sprintf("Enable%s Count=%d Masked%s", onOff(ctl&0x8000 != 0), ctl&0x7ff+1, onOff(ctl&0x4000 != 0)),
+		fmt.Sprintf("Vector table: BAR=%d offset=%08x", table&7, table&^7),
+		fmt.Sprintf("PBA: BAR=%d offset=%08x", pba&7, pba&^7))
+}
+
+var (
+	aerUncorrectable = []struct {
+		bit  uint
+		name string
+	}{
+		{4, "DLP"}, {5, "SDES"}, {12, "TLP"}, {13, "FCP"}, {14, "CmpltTO"}, {15, "CmpltAbrt"},
+		{16, "UnxCmplt"}, {17, "RxOF"}, {18, "MalfTLP"}, {19, "ECRC"}, {20, "UnsupReq"}, {21, "ACSViol"},
+	}
+	aerCorrectable = []struct {
+		bit  uint
+		name string
+	}{
+		{0, "RxErr"}, {6, "BadTLP"}, {7, "BadDLLP"}, {8, "Rollover"}, {12, "Timeout"}, {13, "AdvNonFatalErr"},
+	}
+)
+
+func decodeAER(c config, off int, cp *Capability) {
+	bits := func(v uint32, names []struct {
+		bit  uint
+		name string
+	}) string {
+		var s []string
+		for _, n := range names {
+			s = append(s, n.name+onOff(v&(1<<n.bit) != 0))
+		}
+		return strings.Join(s, " ")
+	}
+	cp.Details = append(cp.Details,
+		"UESta: "+bits(c.u32(off+4), aerUncorrectable),
+		"UEMsk: "+bits(c.u32(off+8), aerUncorrectable),
+		"UESvrt: "+bits(c.u32(off+0xc), aerUncorrectable),
+		"CESta: "+bits(c.u32(off+0x10), aerCorrectable),
+		"CEMsk: "+bits(c.u32(off+0x14), aerCorrectable))
+}
+
+func decodeSRIOV(c config, off int, cp *Capability) {
+	ctl := c.u16(off + 8)
+	cp.Details = append(cp.Details,
+		fmt.Sprintf("IOVCtl: Enable%s", onOff(ctl&1 != 0)),
+		fmt.Sprintf("Initial VFs: %d, Total VFs: %d, Number of VFs: %d", c.u16(off+0xc), c.u16(off+0xe), c.u16(off+0x10)),
+		fmt.Sprintf("VF offset: %d, stride: %d, Device ID: %04x", c.u16(off+0x14), c.u16(off+0x16), c.u16(off+0x1a)))
+}
+
+func decodeSerial(c config, off int, cp *Capability) {
+	lo, hi := c.u32(off+4), c.u32(off+8)
+	var b [8]byte
+	binary.BigEndian.PutUint32(b[:], hi)
+	binary.BigEndian.PutUint32(b[4:], lo)
+	var s []string
+	for _, v := range b {
+		s = append(s, fmt.Sprintf("%02x", v))
+	}
+	cp.Details = append(cp.Details, "Serial Number "+strings.Join(s, "-"))
+}
+
+// ParseCapabilities decodes the capability lists of a configuration
+// space. The extended capabilities are only visible in the 4096 byte
+// configuration space of PCI Express devices.
+func ParseCapabilities(b []byte) []Capability {
+	c := config(b)
+	var caps []Capability
+	if c.u16(cfgStatus)&statusCapList == 0 {
+		return nil
+	}
+	// Capabilities are dword aligned, and there can be at most 48 in the
+	// first 256 bytes. The limit guards against loops.
+	off := int(c.u8(cfgCapPointer)) &^ 3
+	for n := 0; off >= 0x40 && off+2 <= len(c) && n < 48; n++ {
+		id := uint16(c.u8(off))
+		cp := Capability{ID: id, Offset: uint16(off), Name: capNames[id]}
+		if cp.Name == "" {
+			cp.Name = fmt.Sprintf("Capability %#02x", id)
+		}
+		switch id {
+		case CapPM:
+			decodePM(c, off, &cp)
+		case CapExpress:
+			decodeExpress(c, off, &cp)
+		case CapMSI:
+			decodeMSI(c, off, &cp)
+		case CapMSIX:
+			decodeMSIX(c, off, &cp)
+		}
+		caps = append(caps, cp)
+		off = int(c.u8(off+1)) &^ 3
+	}
+
+	off = cfgExtCapStart
+	for n := 0; off >= cfgExtCapStart && n < (4096-cfgExtCapStart)/4; n++ {
+		h := c.u32(off)
+		if h == 0 || h == 0xffffffff {
+			break
+		}
+		id := uint16(h & 0xffff)
+		cp := Capability{ID: id, Extended: true, Version: uint8(h>>16) & 0xf, Offset: uint16(off), Name: extCapNames[id]}
+		if cp.Name == "" {
+			cp.Name = fmt.Sprintf("Extended Capability %#04x", id)
+		}
+		switch id {
+		case ExtCapAER:
+			decodeAER(c, off, &cp)
+		case ExtCapSerial:
+			decodeSerial(c, off, &cp)
+		case ExtCapSRIOV:
+			decodeSRIOV(c, off, &cp)
+		}
+		caps = append(caps, cp)
+		off = int(h>>20) &^ 3
+	}
+	return caps
+}
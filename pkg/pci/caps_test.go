@@ -0,0 +1,140 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pci
+
+import (
+	"encoding/binary"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// nvmeConfig builds the config space of an NVMe drive with a PCI Express
+// x4 Gen3 link trained at x2 Gen3, MSI-X, AER and a serial number.
+func nvmeConfig() []byte {
+	c := make([]byte, 4096)
+	le16 := func(off int, v uint16) { binary.LittleEndian.PutUint16(c[off:], v) }
+	le32 := func(off int, v uint32) { binary.LittleEndian.PutUint32(c[off:], v) }
+	le16(cfgStatus, statusCapList)
+	c[cfgCapPointer] = 0x70
+	// Express endpoint, MaxPayload 256, MaxReadReq 512.
+	c[0x70], c[0x71] = CapExpress, 0xb0
+	le16(0x72, 0x0002)
+	le32(0x74, 0x1)
+	le16(0x78, 0x2020)
+	le32(0x7c, 0x00000043)
+	le16(0x82, 0x0023)
+	// MSI-X, 33 vectors, enabled.
+	c[0xb0], c[0xb1] = CapMSIX, 0
+	le16(0xb2, 0x8020)
+	le32(0xb4, 0x3000)
+	le32(0xb8, 0x2000)
+
+	le32(0x100, 0x15010001)
+	le32(0x104, 1<<14) // CmpltTO
+	le32(0x150, 0x00010003)
+	le32(0x154, 0x04030201)
+	le32(0x158, 0x08070605)
+	return c
+}
+
+func TestParseCapabilities(t *testing.T) {
+	caps := ParseCapabilities(nvmeConfig())
+	var names []string
+	for _, c := range caps {
+		names = append(names, c.Name)
+	}
+	want := []string{"Express Endpoint", "MSI-X", "Advanced Error Reporting", "Device Serial Number"}
+	if !reflect.DeepEqual(names, want) {
+		t.Fatalf("capabilities = %q, want %q", names, want)
+	}
+
+	l := caps[0].Link
+	if l == nil || l.MaxSpeed != 8 || l.MaxWidth != 4 || l.Speed != 8 || l.Width != 2 || !l.Downgraded() {
+		t.Errorf("link = %+v, want 8GT/s x4 trained at 8GT/s x2", l)
+	}
+	for _, tt := range []struct {
+		cap  int
+		want string
+	}{
+		{0, "DevCtl: MaxPayload 256 bytes, MaxReadReq 512 bytes"},
+		{0, "LnkSta: Speed 8GT/s, Width x2 (downgraded)"},
+		{0, "LnkCtl: ASPM Disabled; Disabled- Retrain-"},
+		{1, "Enable+ Count=33 Masked-"},
+		{1, "Vector table: BAR=0 offset=00003000"},
+		{2, "UESta: DLP- SDES- TLP- FCP- CmpltTO+"},
+		{3, "Serial Number 08-07-06-05-04-03-02-01"},
+	} {
+		if d := strings.Join(caps[tt.cap].Details, "\n"); !strings.Contains(d, tt.want) {
+			t.Errorf("%s does not contain %q:\n%s", caps[tt.cap].Name, tt.want, d)
+		}
+	}
+	if s := caps[2].String(); !strings.HasPrefix(s, "Capabilities: [100 v1] Advanced Error Reporting\n\tUESta:") {
+		t.Errorf("String() = %q", s)
+	}
+
+	// Unprivileged readers only see 64 bytes.
+	if caps := ParseCapabilities(nvmeConfig()[:64]); len(caps) != 0 {
+		t.Errorf("capabilities of a short config = %v, want none", caps)
+	}
+}
+
+func TestFilters(t *testing.T) {
+	p := &PCI{Addr: "0000:03:00.1", Vendor: "8086", Device: "1572", Class: "020000"}
+	for _, tt := range []struct {
+		slot, id string
+		match    bool
+	}{
+		{slot: "03:00.1", match: true},
+		{slot: "0000:03:00.1", match: true},
+		{slot: "3:0", match: true},
+		{slot: ".1", match: true},
+		{slot: "03:", match: true},
+		{slot: "04:", match: false},
+		{slot: "03:00.0", match: false},
+		{id: "8086:", match: true},
+		{id: ":1572", match: true},
+		{id: "8086:1572:0200", match: true},
+		{id: "::0200", match: true},
+		{id: "10de:", match: false},
+		{id: "::0300", match: false},
+	} {
+		var f Filter
+		var err error
+		if tt.slot != "" {
+			f, err = SlotFilter(tt.slot)
+		} else {
+			f, err = IDFilter(tt.id)
+		}
+		if err != nil {
+			t.Errorf("filter %q%q: %v", tt.slot, tt.id, err)
+			continue
+		}
+		if got := f(p); got != tt.match {
+			t.Errorf("filter %q%q matches %s = %v, want %v", tt.slot, tt.id, p.Addr, got, tt.match)
+		}
+	}
+	for _, bad := range []string{"1:2:3:4", "zz:00"} {
+		if _, err := SlotFilter(bad); err == nil {
+			t.Errorf("SlotFilter(%q) succeeded", bad)
+		}
+	}
+	if _, err := IDFilter("8086"); err == nil {
+		t.Errorf("IDFilter(8086) succeeded")
+	}
+}
+
+func TestClassName(t *testing.T) {
+	for class, want := range map[string]string{
+		"020000": "Ethernet controller",
+		"010802": "Non-Volatile memory controller",
+		"028800": "Network controller",
+		"ee0000": "ee0000",
+	} {
+		if got := ClassName(class); got != want {
+			t.Errorf("ClassName(%q) = %q, want %q", class, got, want)
+		}
+	}
+}
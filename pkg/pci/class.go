@@ -0,0 +1,95 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pci
+
+import "strconv"
+
+// classNames are the names of the base classes and the common subclasses
+// of the PCI Code and ID Assignment Specification, keyed by the class
+// code without its programming interface, as in the class section of
+// pci.ids.
+var classNames = map[uint16]string{
+	0x0000: "Non-VGA unclassified device",
+	0x0001: "VGA compatible unclassified device",
+	0x0100: "SCSI storage controller",
+	0x0101: "IDE interface",
+	0x0104: "RAID bus controller",
+	0x0105: "ATA controller",
+	0x0106: "SATA controller",
+	0x0107: "Serial Attached SCSI controller",
+	0x0108: "Non-Volatile memory controller",
+	0x0180: "Mass storage controller",
+	0x0200: "Ethernet controller",
+	0x0207: "Infiniband controller",
+	0x0280: "Network controller",
+	0x0300: "VGA compatible controller",
+	0x0302: "3D controller",
+	0x0380: "Display controller",
+	0x0401: "Multimedia audio controller",
+	0x0403: "Audio device",
+	0x0480: "Multimedia controller",
+	0x0500: "RAM memory",
+	0x0580: "Memory controller",
+	0x0600: "Host bridge",
+	0x0601: "ISA bridge",
+	0x0604: "PCI bridge",
+	0x0680: "Bridge",
+	0x0700: "Serial controller",
+	0x0780: "Communication controller",
+	0x0800: "PIC",
+	0x0805: "SD Host controller",
+	0x0806: "IOMMU",
+	0x0880: "System peripheral",
+	0x0c03: "USB controller",
+	0x0c05: "SMBus",
+	0x0c80: "Serial bus controller",
+	0x0d80: "Wireless controller",
+	0x1080: "Encryption controller",
+	0x1101: "Performance counters",
+	0x1180: "Signal processing controller",
+	0x1200: "Processing accelerators",
+	0xff00: "Unassigned class",
+}
+
+var baseClassNames = map[uint8]string{
+	0x00: "Unclassified device",
+	0x01: "Mass storage controller",
+	0x02: "Network controller",
+	0x03: "Display controller",
+	0x04: "Multimedia controller",
+	0x05: "Memory controller",
+	0x06: "Bridge",
+	0x07: "Communication controller",
+	0x08: "Generic system peripheral",
+	0x09: "Input device controller",
+	0x0a: "Docking station",
+	0x0b: "Processor",
+	0x0c: "Serial bus controller",
+	0x0d: "Wireless controller",
+	0x0e: "Intelligent controller",
+	0x0f: "Satellite communications controller",
+	0x10: "Encryption controller",
+	0x11: "Signal processing controller",
+	0x12: "Processing accelerators",
+	0x13: "Non-Essential Instrumentation",
+	0x40: "Coprocessor",
+	0xff: "Unassigned class",
+}
+
+// ClassName returns the name of a class code, e.g. "020000", or the code
+// itself if it is unknown.
+func ClassName(class string) string {
+	c, err := strconv.ParseUint(class, 16, 24)
+	if err != nil {
+		return class
+	}
+	if n, ok := classNames[uint16(c>>8)]; ok {
+		return n
+	}
+	if n, ok := baseClassNames[uint8(c>>16)]; ok {
+		return n
+	}
+	return class
+}
@@ -39,6 +39,26 @@ func (d Devices) ReadConfig() error {
 	return nil
 }
 
+// ReadCapabilities reads the capabilities of all the devices.
+func (d Devices) ReadCapabilities() error {
+	for _, p := range d {
+		if err := p.ReadCapabilities(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadHeader reads the headers, regions and IRQs of all the devices.
+func (d Devices) ReadHeader() error {
+	for _, p := range d {
+		if err := p.ReadHeader(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // ReadConfigRegister reads the config info for all the devices.
 func (d Devices) ReadConfigRegister(offset, size int64) ([]uint64, error) {
 	var vals []uint64
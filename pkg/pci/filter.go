@@ -0,0 +1,100 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pci
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// field matches a hex field; an empty want matches anything.
+func field(want, got string, bits int) (func() bool, error) {
+	if want == "" || want == "*" {
+		return func() bool { return true }, nil
+	}
+	w, err := strconv.ParseUint(want, 16, bits)
+	if err != nil {
+		return nil, err
+	}
+	return func() bool {
+		g, err := strconv.ParseUint(got, 16, 64)
+		return err == nil && g == w
+	}, nil
+}
+
+// SlotFilter returns a Filter for devices in the slot s, in the form
+// [[[[domain]:]bus]:][device][.[function]] of lspci -s. Omitted or *
+// fields match any value.
+func SlotFilter(s string) (Filter, error) {
+	var fn string
+	if i := strings.LastIndexByte(s, '.'); i >= 0 {
+		s, fn = s[:i], s[i+1:]
+	}
+	parts := strings.Split(s, ":")
+	if len(parts) > 3 {
+		return nil, fmt.Errorf("invalid slot %q", s)
+	}
+	// Right-align: device, bus, domain.
+	want := make([]string, 3)
+	copy(want[3-len(parts):], parts)
+	bits := []int{16, 8, 5}
+	if err := checkFields(append(want, fn), append(bits, 3)); err != nil {
+		return nil, err
+	}
+	return func(p *PCI) bool {
+		// Addr is dddd:bb:dd.f
+		var got [4]string
+		a := p.Addr
+		if i := strings.LastIndexByte(a, '.'); i >= 0 {
+			a, got[3] = a[:i], a[i+1:]
+		}
+		copy(got[:3], strings.SplitN(a, ":", 3))
+		for i, w := range want {
+			if m, _ := field(w, got[i], bits[i]); !m() {
+				return false
+			}
+		}
+		m, _ := field(fn, got[3], 3)
+		return m()
+	}, nil
+}
+
+// IDFilter returns a Filter for devices with the IDs s, in the form
+// [vendor]:[device][:class] of lspci -d. The class does not include the
+// programming interface.
+func IDFilter(s string) (Filter, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return nil, fmt.Errorf("invalid ID %q, want [vendor]:[device][:class]", s)
+	}
+	want := make([]string, 3)
+	copy(want, parts)
+	bits := []int{16, 16, 16}
+	if err := checkFields(want, bits); err != nil {
+		return nil, err
+	}
+	return func(p *PCI) bool {
+		class := p.Class
+		if len(class) > 2 {
+			class = class[:len(class)-2]
+		}
+		for i, got := range []string{p.Vendor, p.Device, class} {
+			if m, _ := field(want[i], got, bits[i]); !m() {
+				return false
+			}
+		}
+		return true
+	}, nil
+}
+
+func checkFields(want []string, bits []int) error {
+	for i, w := range want {
+		if _, err := field(w, "", bits[i]); err != nil {
+			return fmt.Errorf("invalid field %q: %v", w, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build ignore
+
+// gen generates ids.go from a pci.ids file.
+//
+//     go run gen.go [-f /usr/share/misc/pci.ids]
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"flag"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+	"text/template"
+)
+
+var in = flag.String("f", "/usr/share/misc/pci.ids", "pci.ids file to read")
+
+var ids = template.Must(template.New("ids").Parse(`// Copyright 2012-2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by gen.go; DO NOT EDIT.
+
+// pci.ids is stripped of all comments, empty lines, sub-devices, and
+// classes, then gzip'ed and base64 encoded. This keeps both the source
+// and the binary small; it is only uncompressed the first time names are
+// looked up.
+
+package pci
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"io/ioutil"
+	"strings"
+)
+
+type idMap map[string]Vendor
+
+var ids idMap
+
+// newIDs uncompresses the contents of pci.ids. It returns a map to be used
+// as lookup from hex ID to human readable label. We do not admit of the
+// possibility of error, any failure should be caught by the test.
+func newIDs() idMap {
+	if ids != nil {
+		return ids
+	}
+	z, err := gzip.NewReader(base64.NewDecoder(base64.StdEncoding, strings.NewReader(pciids)))
+	if err != nil {
+		panic(err)
+	}
+	b, err := ioutil.ReadAll(z)
+	if err != nil {
+		panic(err)
+	}
+	ids = parse(bytes.TrimSpace(b))
+	return ids
+}
+
+const pciids = ` + "`" + `
+{{.}}` + "`" + `
+`))
+
+// strip keeps the vendor and device lines of pci.ids.
+func strip(b []byte) []byte {
+	var out bytes.Buffer
+	s := bufio.NewScanner(bytes.NewReader(b))
+	for s.Scan() {
+		l := s.Text()
+		switch {
+		case strings.HasPrefix(l, "C "):
+			// The device classes follow the vendors.
+			return out.Bytes()
+		case l == "", l[0] == '#', strings.HasPrefix(l, "\t\t"):
+		default:
+			out.WriteString(l)
+			out.WriteByte('\n')
+		}
+	}
+	return out.Bytes()
+}
+
+func main() {
+	flag.Parse()
+	b, err := ioutil.ReadFile(*in)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var z bytes.Buffer
+	w, err := gzip.NewWriterLevel(&z, gzip.BestCompression)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if _, err := w.Write(strip(b)); err != nil {
+		log.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		log.Fatal(err)
+	}
+	enc := base64.StdEncoding.EncodeToString(z.Bytes())
+	var lines []string
+	for len(enc) > 76 {
+		lines, enc = append(lines, enc[:76]), enc[76:]
+	}
+	lines = append(lines, enc)
+
+	f, err := os.Create("ids.go")
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ids.Execute(f, strings.Join(lines, "\n")); err != nil {
+		log.Fatal(err)
+	}
+	if err := f.Close(); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,199 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pci
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// Configuration space header offsets.
+const (
+	cfgCommand       = 0x04
+	cfgCacheLineSize = 0x0c
+	cfgLatencyTimer  = 0x0d
+	cfgInterruptPin  = 0x3d
+)
+
+// Flags of the sysfs resource file, from linux/ioport.h.
+const (
+	ioresourceIO       = 0x100
+	ioresourceMem      = 0x200
+	ioresourcePrefetch = 0x2000
+	ioresourceMem64    = 0x100000
+)
+
+// romRegion is the index of the expansion ROM in the resource file.
+const romRegion = 6
+
+// Region is a BAR or the expansion ROM of a device, as Linux assigned it.
+type Region struct {
+	// Index is the BAR number, or 6 for the expansion ROM.
+	Index        int    `json:"index"`
+	Start        uint64 `json:"start"`
+	Size         uint64 `json:"size"`
+	IO           bool   `json:"io,omitempty"`
+	Bits64       bool   `json:"64bit,omitempty"`
+	Prefetchable bool   `json:"prefetchable,omitempty"`
+}
+
+// humanSize returns a size as lspci prints it, e.g. 16K.
+func humanSize(n uint64) string {
+	for _, u := range []string{"", "K", "M", "G"} {
+		if n < 1024 || n%1024 != 0 || u == "G" {
+			return fmt.Sprintf("%d%s", n, u)
+		}
+		n /= 1024
+	}
+	return ""
+}
+
+// String returns the region in the style of lspci -v.
+func (r Region) String() string {
+	size := humanSize(r.Size)
+	switch {
+	case r.Index == romRegion:
+		return fmt.Sprintf("Expansion ROM at %08x [size=%s]", r.Start, size)
+	case r.IO:
+		return fmt.Sprintf("Region %d: I/O ports at %04x [size=%s]", r.Index, r.Start, size)
+	}
+	bits, pf := "32-bit", "non-prefetchable"
+	if r.Bits64 {
+		bits = "64-bit"
+	}
+	if r.Prefetchable {
+		pf = "prefetchable"
+	}
+	return fmt.Sprintf("Region %d: Memory at %08x (%s, %s) [size=%s]", r.Index, r.Start, bits, pf, size)
+}
+
+// ParseResources parses the sysfs resource file of a device: the start,
+// end and flags of its regions, one per line. Unassigned regions are
+// left out.
+func ParseResources(r io.Reader) ([]Region, error) {
+	var regions []Region
+	s := bufio.NewScanner(r)
+	for i := 0; s.Scan(); i++ {
+		f := strings.Fields(s.Text())
+		if len(f) != 3 {
+			return nil, fmt.Errorf("invalid resource %q", s.Text())
+		}
+		var v [3]uint64
+		for j := range f {
+			n, err := strconv.ParseUint(f[j], 0, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid resource %q: %v", s.Text(), err)
+			}
+			v[j] = n
+		}
+		start, end, flags := v[0], v[1], v[2]
+		// Bridges have windows after the ROM, which are not BARs.
+		if i > romRegion {
+			break
+		}
+		if end == 0 || flags&(ioresourceIO|ioresourceMem) == 0 {
+			continue
+		}
+		regions = append(regions, Region{
+			Index:        i,
+			Start:        start,
+			Size:         end - start + 1,
+			IO:           flags&ioresourceIO != 0,
+			Bits64:       flags&ioresourceMem64 != 0,
+			Prefetchable: flags&ioresourcePrefetch != 0,
+		})
+	}
+	return regions, s.Err()
+}
+
+// ReadHeader decodes the header of the config space, and reads the
+// regions and IRQ of the device.
+func (p *PCI) ReadHeader() error {
+	c, err := ioutil.ReadFile(filepath.Join(p.FullPath, "config"))
+	if err != nil {
+		return err
+	}
+	f, err := os.Open(filepath.Join(p.FullPath, "resource"))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if p.Regions, err = ParseResources(f); err != nil {
+		return err
+	}
+	if b, err := ioutil.ReadFile(filepath.Join(p.FullPath, "irq")); err == nil {
+		p.IRQ, _ = strconv.Atoi(strings.TrimSpace(string(b)))
+	}
+	p.Header = DecodeHeader(c, p.IRQ)
+	return nil
+}
+
+// DecodeHeader decodes the command and status registers, latency and interrupt
+// of a configuration space header, in the style of lspci -vv. irq is the
+// IRQ the interrupt pin is routed to, if it is not 0.
+func DecodeHeader(b []byte, irq int) []string {
+	c := config(b)
+	cmd, sta := c.u16(cfgCommand), c.u16(cfgStatus)
+	bit := func(v uint16, n uint) string { return onOff(v&(1<<n) != 0) }
+	devsel := []string{"fast", "medium", "slow", "unknown"}[(sta>>9)&3]
+	lines := []string{
+		fmt.Sprintf("Control: I/O%s Mem%s BusMaster%s SpecCycle%s MemWINV%s VGASnoop%s ParErr%s Stepping%s SERR%s FastB2B%s DisINTx%s",
+			bit(cmd, 0), bit(cmd, 1), bit(cmd, 2), bit(cmd, 3), bit(cmd, 4), bit(cmd, 5), bit(cmd, 6), bit(cmd, 7), bit(cmd, 8), bit(cmd, 9), bit(cmd, 10)),
+		fmt.Sprintf("Status: Cap%s 66MHz%s UDF%s FastB2B%s ParErr%s DEVSEL=%s >TAbort%s <TAbort%s <MAbort%s >SERR%s <PERR%s INTx%s",
+			bit(sta, 4), bit(sta, 5), bit(sta, 6), bit(sta, 7), bit(sta, 8), devsel, bit(sta, 11), bit(sta, 12), bit(sta, 13), bit(sta, 14), bit(sta, 15), bit(sta, 3)),
+	}
+	if cmd&(1<<2) != 0 {
+		l := fmt.Sprintf("Latency: %d", c.u8(cfgLatencyTimer))
+		if cls := c.u8(cfgCacheLineSize); cls != 0 {
+			l += fmt.Sprintf(", Cache Line Size: %d bytes", int(cls)*4)
+		}
+		lines = append(lines, l)
+	}
+	if pin := c.u8(cfgInterruptPin); pin >= 1 && pin <= 4 {
+		l := fmt.Sprintf("Interrupt: pin %c", 'A'+pin-1)
+		if irq != 0 {
+			l += fmt.Sprintf(" routed to IRQ %d", irq)
+		}
+		lines = append(lines, l)
+	}
+	return lines
+}
+
+// capabilityNames are the names setpci uses for capabilities in register
+// names, e.g. CAP_EXP+0x12.w for the link status.
+var capabilityNames = map[string]struct {
+	id       uint16
+	extended bool
+}{
+	"CAP_PM":     {CapPM, false},
+	"CAP_MSI":    {CapMSI, false},
+	"CAP_VNDR":   {CapVendor, false},
+	"CAP_EXP":    {CapExpress, false},
+	"CAP_MSIX":   {CapMSIX, false},
+	"ECAP_AER":   {ExtCapAER, true},
+	"ECAP_DSN":   {ExtCapSerial, true},
+	"ECAP_SRIOV": {ExtCapSRIOV, true},
+}
+
+// CapabilityOffset returns the offset of the capability named as in
+// setpci, e.g. CAP_EXP or ECAP_AER, once the capabilities are read.
+func (p *PCI) CapabilityOffset(name string) (uint16, error) {
+	n, ok := capabilityNames[strings.ToUpper(name)]
+	if !ok {
+		return 0, fmt.Errorf("unknown capability %q", name)
+	}
+	for _, c := range p.Capabilities {
+		if c.ID == n.id && c.Extended == n.extended {
+			return c.Offset, nil
+		}
+	}
+	return 0, fmt.Errorf("%s has no capability %s", p.Addr, name)
+}
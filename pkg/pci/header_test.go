@@ -0,0 +1,73 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pci
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseResources(t *testing.T) {
+	res := `0x00000000fe000000 0x00000000fe003fff 0x0000000000140204
+0x0000000000000000 0x0000000000000000 0x0000000000000000
+0x00000000f0000000 0x00000000f7ffffff 0x000000000014220c
+0x0000000000000000 0x0000000000000000 0x0000000000000000
+0x000000000000c000 0x000000000000c01f 0x0000000000040101
+0x0000000000000000 0x0000000000000000 0x0000000000000000
+0x00000000fea00000 0x00000000fea7ffff 0x0000000000046200
+0x0000000000001000 0x0000000000001fff 0x0000000000000100
+`
+	regions, err := ParseResources(strings.NewReader(res))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, r := range regions {
+		got = append(got, r.String())
+	}
+	want := []string{
+		"Region 0: Memory at fe000000 (64-bit, non-prefetchable) [size=16K]",
+		"Region 2: Memory at f0000000 (64-bit, prefetchable) [size=128M]",
+		"Region 4: I/O ports at c000 [size=32]",
+		"Expansion ROM at fea00000 [size=512K]",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("regions = %q, want %q", got, want)
+	}
+	if _, err := ParseResources(strings.NewReader("0x0 0x1\n")); err == nil {
+		t.Errorf("ParseResources of a short line succeeded")
+	}
+}
+
+func TestDecodeHeader(t *testing.T) {
+	c := nvmeConfig()
+	c[cfgCommand] = 0x06
+	c[cfgCacheLineSize] = 16
+	c[cfgInterruptPin] = 1
+	want := []string{
+		"Control: I/O- Mem+ BusMaster+ SpecCycle- MemWINV- VGASnoop- ParErr- Stepping- SERR- FastB2B- DisINTx-",
+		"Status: Cap+ 66MHz- UDF- FastB2B- ParErr- DEVSEL=fast >TAbort- <TAbort- <MAbort- >SERR- <PERR- INTx-",
+		"Latency: 0, Cache Line Size: 64 bytes",
+		"Interrupt: pin A routed to IRQ 16",
+	}
+	if got := DecodeHeader(c, 16); !reflect.DeepEqual(got, want) {
+		t.Errorf("DecodeHeader = %q, want %q", got, want)
+	}
+}
+
+func TestCapabilityOffset(t *testing.T) {
+	p := &PCI{Addr: "0000:01:00.0", Capabilities: ParseCapabilities(nvmeConfig())}
+	for name, want := range map[string]uint16{"CAP_EXP": 0x70, "cap_msix": 0xb0, "ECAP_AER": 0x100, "ECAP_DSN": 0x150} {
+		if got, err := p.CapabilityOffset(name); err != nil || got != want {
+			t.Errorf("CapabilityOffset(%s) = %#x, %v, want %#x", name, got, err, want)
+		}
+	}
+	for _, name := range []string{"CAP_MSI", "CAP_FOO"} {
+		if _, err := p.CapabilityOffset(name); err == nil {
+			t.Errorf("CapabilityOffset(%s) succeeded", name)
+		}
+	}
+}
@@ -17,13 +17,20 @@ import (
 // PCI is a PCI device. We will fill this in as we add options.
 // For now it just holds two uint16 per the PCI spec.
 type PCI struct {
-	Addr       string
-	Vendor     string `pci:"vendor"`
-	Device     string `pci:"device"`
-	VendorName string
-	DeviceName string
-	FullPath   string
-	ExtraInfo  []string
+	Addr         string       `json:"slot"`
+	Vendor       string       `pci:"vendor" json:"vendor"`
+	Device       string       `pci:"device" json:"device"`
+	Class        string       `pci:"class" json:"class"`
+	VendorName   string       `json:"vendor_name"`
+	DeviceName   string       `json:"device_name"`
+	ClassName    string       `json:"class_name"`
+	FullPath     string       `json:"-"`
+	ExtraInfo    []string     `json:"extra_info,omitempty"`
+	Capabilities []Capability `json:"capabilities,omitempty"`
+	// Header is the decoded header, in the style of lspci -vv.
+	Header  []string `json:"header,omitempty"`
+	Regions []Region `json:"regions,omitempty"`
+	IRQ     int      `json:"irq,omitempty"`
 }
 
 // String concatenates PCI address, Vendor, and Device and other information
@@ -37,6 +44,7 @@ func (p *PCI) String() string {
 func (p *PCI) SetVendorDeviceName() {
 	ids = newIDs()
 	p.VendorName, p.DeviceName = Lookup(ids, p.Vendor, p.Device)
+	p.ClassName = ClassName(p.Class)
 }
 
 // ReadConfig reads the config space and adds it to ExtraInfo as a hexdump.
@@ -49,6 +57,18 @@ func (p *PCI) ReadConfig() error {
 	return nil
 }
 
+// ReadCapabilities reads the config space and decodes its capabilities.
+// Unless we are root, Linux only lets us read the first 64 bytes, which
+// hold no capabilities.
+func (p *PCI) ReadCapabilities() error {
+	c, err := ioutil.ReadFile(filepath.Join(p.FullPath, "config"))
+	if err != nil {
+		return err
+	}
+	p.Capabilities = ParseCapabilities(c)
+	return nil
+}
+
 type barreg struct {
 	offset int64
 	*os.File
@@ -37,7 +37,7 @@ func onePCI(dir string) (*PCI, error) {
 		// Linux never understood /proc.
 		reflect.ValueOf(&pci).Elem().Field(ix).SetString(string(s[2 : len(s)-1]))
 	}
-	pci.VendorName, pci.DeviceName = pci.Vendor, pci.Device
+	pci.VendorName, pci.DeviceName, pci.ClassName = pci.Vendor, pci.Device, pci.Class
 	return &pci, nil
 }
 
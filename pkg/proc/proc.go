@@ -0,0 +1,286 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package proc reads processes and threads from Linux's /proc, for ps,
+// top, kill and the like.
+//
+// The directory is a parameter, so other proc file systems, e.g. of
+// another host mounted over the network, can be read as well.
+package proc
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultDir is where proc is usually mounted.
+const DefaultDir = "/proc"
+
+// ClockTicks is the unit of the times in stat files, USER_HZ. It is 100 on
+// all architectures.
+const ClockTicks = 100
+
+// PageSize is the unit of the resident set size in stat files.
+var PageSize = uint64(os.Getpagesize())
+
+// Process is a process, or a thread of one.
+type Process struct {
+	// PID is the process ID, the thread group ID of threads.
+	PID int
+
+	// TID is the thread ID. It is PID for processes and main threads.
+	TID int
+
+	PPID int
+	PGRP int
+	SID  int
+
+	// TTYNr is the device number of the controlling terminal, or 0.
+	TTYNr int
+
+	// TPGID is the foreground process group of the controlling
+	// terminal, or -1.
+	TPGID int
+
+	// Comm is the name of the executable, at most 15 bytes.
+	Comm string
+
+	// State is a letter: R is running, S sleeping, D waiting for I/O, Z
+	// zombie, T stopped, and so on.
+	State string
+
+	// Args is the command line. It is empty for kernel threads and
+	// zombies.
+	Args []string
+
+	// UID and EUID are the real and effective user IDs.
+	UID  int
+	EUID int
+
+	// UTime and STime are the CPU time spent in user and kernel mode.
+	UTime time.Duration
+	STime time.Duration
+
+	Priority int
+	Nice     int
+
+	// NumThreads is the number of threads of the process.
+	NumThreads int
+
+	// StartTime is when the process started, since boot.
+	StartTime time.Duration
+
+	// VSize and RSS are the virtual and resident memory sizes in bytes.
+	VSize uint64
+	RSS   uint64
+}
+
+// ParseStat parses the contents of a stat file.
+func ParseStat(s string) (*Process, error) {
+	// The name is in parentheses, and may have spaces and parentheses
+	// itself.
+	open, end := strings.IndexByte(s, '('), strings.LastIndexByte(s, ')')
+	if open < 0 || end < open {
+		return nil, fmt.Errorf("stat %q: no (name)", s)
+	}
+	p := &Process{Comm: s[open+1 : end]}
+	head := strings.Fields(s[:open])
+	f := strings.Fields(s[end+1:])
+	// The fields after the name, starting with the state, up to the
+	// RSS which is the 24th.
+	if len(head) != 1 || len(f) < 22 {
+		return nil, fmt.Errorf("stat %q: want at least 24 fields", s)
+	}
+	p.State = f[0]
+	var err error
+	num := func(s string) int64 {
+		n, perr := strconv.ParseInt(s, 10, 64)
+		if perr != nil && err == nil {
+			err = fmt.Errorf("stat %q: %v", s, perr)
+		}
+		return n
+	}
+	ticks := func(s string) time.Duration {
+		return time.Duration(num(s)) * time.Second / ClockTicks
+	}
+	p.TID = int(num(head[0]))
+	p.PID = p.TID
+	p.PPID = int(num(f[1]))
+	p.PGRP = int(num(f[2]))
+	p.SID = int(num(f[3]))
+	p.TTYNr = int(num(f[4]))
+	p.TPGID = int(num(f[5]))
+	p.UTime = ticks(f[11])
+	p.STime = ticks(f[12])
+	p.Priority = int(num(f[15]))
+	p.Nice = int(num(f[16]))
+	p.NumThreads = int(num(f[17]))
+	p.StartTime = ticks(f[19])
+	p.VSize = uint64(num(f[20]))
+	p.RSS = uint64(num(f[21])) * PageSize
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// parseStatus sets the IDs of p from the contents of a status file.
+func (p *Process) parseStatus(s string) error {
+	uid := false
+	for _, line := range strings.Split(s, "\n") {
+		f := strings.Fields(line)
+		if len(f) < 2 {
+			continue
+		}
+		var err error
+		switch f[0] {
+		case "Tgid:":
+			p.PID, err = strconv.Atoi(f[1])
+		case "Uid:":
+			if len(f) < 3 {
+				return fmt.Errorf("status: short Uid line %q", line)
+			}
+			if p.UID, err = strconv.Atoi(f[1]); err == nil {
+				p.EUID, err = strconv.Atoi(f[2])
+			}
+			uid = true
+		}
+		if err != nil {
+			return fmt.Errorf("status %q: %v", line, err)
+		}
+	}
+	if !uid {
+		return fmt.Errorf("status: no Uid line")
+	}
+	return nil
+}
+
+// Read reads the process or thread in dir, e.g. /proc/1 or
+// /proc/1/task/2.
+func Read(dir string) (*Process, error) {
+	// The files are read before parsing, to be as close to a snapshot
+	// as possible.
+	stat, err := ioutil.ReadFile(filepath.Join(dir, "stat"))
+	if err != nil {
+		return nil, err
+	}
+	status, err := ioutil.ReadFile(filepath.Join(dir, "status"))
+	if err != nil {
+		return nil, err
+	}
+	cmdline, err := ioutil.ReadFile(filepath.Join(dir, "cmdline"))
+	if err != nil && !os.IsPermission(err) {
+		return nil, err
+	}
+
+	p, err := ParseStat(string(stat))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", dir, err)
+	}
+	if err := p.parseStatus(string(status)); err != nil {
+		return nil, fmt.Errorf("%s: %v", dir, err)
+	}
+	if c := strings.TrimRight(string(cmdline), "\x00"); c != "" {
+		p.Args = strings.Split(c, "\x00")
+	}
+	return p, nil
+}
+
+// List reads all processes in the proc file system at dir, sorted by PID.
+// Processes which exit meanwhile, or cannot be read, are left out.
+func List(dir string) ([]*Process, error) {
+	fis, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var ps []*Process
+	for _, fi := range fis {
+		if _, err := strconv.Atoi(fi.Name()); err != nil || !fi.IsDir() {
+			continue
+		}
+		p, err := Read(filepath.Join(dir, fi.Name()))
+		if err != nil {
+			continue
+		}
+		ps = append(ps, p)
+	}
+	sort.Slice(ps, func(i, j int) bool { return ps[i].TID < ps[j].TID })
+	return ps, nil
+}
+
+// Threads reads the threads of process pid in the proc file system at dir,
+// sorted by TID.
+func Threads(dir string, pid int) ([]*Process, error) {
+	return List(filepath.Join(dir, strconv.Itoa(pid), "task"))
+}
+
+// Uptime reads how long ago the system booted from the proc file system at
+// dir.
+func Uptime(dir string) (time.Duration, error) {
+	b, err := ioutil.ReadFile(filepath.Join(dir, "uptime"))
+	if err != nil {
+		return 0, err
+	}
+	f := strings.Fields(string(b))
+	if len(f) == 0 {
+		return 0, fmt.Errorf("%s/uptime is empty", dir)
+	}
+	s, err := strconv.ParseFloat(f[0], 64)
+	if err != nil {
+		return 0, fmt.Errorf("%s/uptime: %v", dir, err)
+	}
+	return time.Duration(s * float64(time.Second)), nil
+}
+
+// CPUTime is the CPU time the process spent, in user and kernel mode.
+func (p *Process) CPUTime() time.Duration {
+	return p.UTime + p.STime
+}
+
+// Elapsed is how long the process has been running, given the uptime.
+func (p *Process) Elapsed(uptime time.Duration) time.Duration {
+	if uptime < p.StartTime {
+		return 0
+	}
+	return uptime - p.StartTime
+}
+
+// CPUPercent is the share of the CPU time the process spent since it
+// started, given the uptime, like ps's %CPU. It can be over 100 for
+// processes with several threads.
+func (p *Process) CPUPercent(uptime time.Duration) float64 {
+	e := p.Elapsed(uptime)
+	if e <= 0 {
+		return 0
+	}
+	return 100 * float64(p.CPUTime()) / float64(e)
+}
+
+// TTY returns the name of the controlling terminal relative to /dev, e.g.
+// pts/0 or ttyS0, or "" if there is none.
+func (p *Process) TTY() string {
+	if p.TTYNr == 0 {
+		return ""
+	}
+	// The device numbers are encoded as by new_encode_dev.
+	major := (p.TTYNr >> 8) & 0xfff
+	minor := (p.TTYNr & 0xff) | ((p.TTYNr >> 12) & 0xfff00)
+	switch {
+	case major >= 136 && major <= 143:
+		return fmt.Sprintf("pts/%d", (major-136)*256+minor)
+	case major == 4 && minor < 64:
+		return fmt.Sprintf("tty%d", minor)
+	case major == 4:
+		return fmt.Sprintf("ttyS%d", minor-64)
+	case major == 5 && minor == 1:
+		return "console"
+	}
+	return fmt.Sprintf("%d,%d", major, minor)
+}
@@ -0,0 +1,138 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package proc
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+const stat = "42 (a (b) c) S 1 42 42 34816 42 4194560 10 0 0 0 150 50 0 0 20 0 2 0 1000 4096 3 18446744073709551615"
+
+func TestParseStat(t *testing.T) {
+	p, err := ParseStat(stat)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Process{
+		PID:        42,
+		TID:        42,
+		PPID:       1,
+		PGRP:       42,
+		SID:        42,
+		TTYNr:      34816,
+		TPGID:      42,
+		Comm:       "a (b) c",
+		State:      "S",
+		UTime:      1500 * time.Millisecond,
+		STime:      500 * time.Millisecond,
+		Priority:   20,
+		NumThreads: 2,
+		StartTime:  10 * time.Second,
+		VSize:      4096,
+		RSS:        3 * PageSize,
+	}
+	if !reflect.DeepEqual(*p, want) {
+		t.Errorf("ParseStat(%q) = %+v, want %+v", stat, *p, want)
+	}
+
+	for _, s := range []string{"", "1 systemd S", "1 (systemd) S 0 1", "x (systemd) S 0 1 1 0 -1 0 0 0 0 0 0 0 0 0 20 0 1 0 2 3 4"} {
+		if _, err := ParseStat(s); err == nil {
+			t.Errorf("ParseStat(%q) succeeded, want an error", s)
+		}
+	}
+}
+
+func TestTTY(t *testing.T) {
+	for nr, want := range map[int]string{
+		0:      "",
+		34816:  "pts/0",
+		34818:  "pts/2",
+		1025:   "tty1",
+		1088:   "ttyS0",
+		1281:   "console",
+		0x1234: "18,52",
+	} {
+		p := &Process{TTYNr: nr}
+		if got := p.TTY(); got != want {
+			t.Errorf("TTY of %d = %q, want %q", nr, got, want)
+		}
+	}
+}
+
+func TestTimes(t *testing.T) {
+	p, err := ParseStat(stat)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := p.CPUTime(); got != 2*time.Second {
+		t.Errorf("CPUTime = %v, want 2s", got)
+	}
+	if got := p.Elapsed(30 * time.Second); got != 20*time.Second {
+		t.Errorf("Elapsed = %v, want 20s", got)
+	}
+	if got := p.CPUPercent(30 * time.Second); got != 10 {
+		t.Errorf("CPUPercent = %v, want 10", got)
+	}
+	if got := p.CPUPercent(5 * time.Second); got != 0 {
+		t.Errorf("CPUPercent before the start = %v, want 0", got)
+	}
+}
+
+func TestList(t *testing.T) {
+	d, err := ioutil.TempDir("", "proc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(d)
+
+	status := "Name:\ta\nTgid:\t42\nUid:\t1000\t0\t0\t0\n"
+	for dir, files := range map[string]map[string]string{
+		"42":         {"stat": stat, "status": status, "cmdline": "a\x00-b\x00"},
+		"42/task/42": {"stat": stat, "status": status, "cmdline": "a\x00-b\x00"},
+		"42/task/43": {"stat": "43 (a) R 1 42 42 0 -1 0 0 0 0 0 0 0 0 0 20 0 2 0 1000 4096 3", "status": status, "cmdline": ""},
+		// Not processes.
+		"7":    {"stat": "garbage", "status": status, "cmdline": ""},
+		"self": {"stat": stat, "status": status, "cmdline": ""},
+		"":     {"uptime": "123.45 678.90\n"},
+	} {
+		if err := os.MkdirAll(filepath.Join(d, dir), 0755); err != nil {
+			t.Fatal(err)
+		}
+		for n, s := range files {
+			if err := ioutil.WriteFile(filepath.Join(d, dir, n), []byte(s), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	ps, err := List(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ps) != 1 || ps[0].PID != 42 || ps[0].UID != 1000 || ps[0].EUID != 0 || len(ps[0].Args) != 2 || ps[0].Args[1] != "-b" {
+		t.Errorf("List = %+v, want process 42 with args [a -b]", ps)
+	}
+
+	ts, err := Threads(d, 42)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ts) != 2 || ts[0].TID != 42 || ts[1].TID != 43 || ts[1].PID != 42 || ts[1].Args != nil {
+		t.Errorf("Threads = %+v, want threads 42 and 43 of 42", ts)
+	}
+
+	up, err := Uptime(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if up != 123450*time.Millisecond {
+		t.Errorf("Uptime = %v, want 2m3.45s", up)
+	}
+}
@@ -0,0 +1,391 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package progress reports the progress of long I/O operations.
+//
+// A Meter counts the bytes of an operation, through Add, Set or by wrapping
+// its readers and writers, and once started reports the count, throughput and
+// estimated time left periodically and on SIGUSR1. On terminals the report is
+// a bar redrawn in place; elsewhere, like on serial consoles and in logs, it
+// is a line per report, printed only when there was progress.
+package progress
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/term"
+)
+
+// Stats are the statistics of an operation at one point in time.
+type Stats struct {
+	// Name names the operation, e.g. a file name.
+	Name string
+
+	// Done is the number of bytes done.
+	Done int64
+
+	// Total is the size of the operation, or 0 if it is unknown.
+	Total int64
+
+	// Elapsed is the time since the operation started.
+	Elapsed time.Duration
+}
+
+// Rate returns the average throughput in bytes per second.
+func (s Stats) Rate() float64 {
+	if s.Elapsed <= 0 {
+		return 0
+	}
+	return float64(s.Done) / s.Elapsed.Seconds()
+}
+
+// ETA returns the estimated time left, if it can be estimated.
+func (s Stats) ETA() (time.Duration, bool) {
+	r := s.Rate()
+	if s.Total <= 0 || r == 0 || s.Done > s.Total {
+		return 0, false
+	}
+	return time.Duration(float64(s.Total-s.Done) / r * float64(time.Second)), true
+}
+
+// Percent returns the percentage done, or -1 if the total is unknown.
+func (s Stats) Percent() int {
+	if s.Total <= 0 {
+		return -1
+	}
+	if s.Done >= s.Total {
+		return 100
+	}
+	return int(s.Done * 100 / s.Total)
+}
+
+// String returns the statistics as one line, like
+//
+//     disk.img: 1.5 GiB / 4.0 GiB (37%), 52.1 MiB/s, ETA 0:49
+func (s Stats) String() string {
+	var b strings.Builder
+	if len(s.Name) != 0 {
+		b.WriteString(s.Name)
+		b.WriteString(": ")
+	}
+	b.WriteString(Size(s.Done))
+	if s.Total > 0 {
+		fmt.Fprintf(&b, " / %s (%d%%)", Size(s.Total), s.Percent())
+	}
+	fmt.Fprintf(&b, ", %s/s", Size(int64(s.Rate())))
+	if eta, ok := s.ETA(); ok {
+		b.WriteString(", ETA ")
+		b.WriteString(Duration(eta))
+	}
+	return b.String()
+}
+
+// Size returns n bytes in IEC units, like "1.5 GiB".
+func Size(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit && exp < 5; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
+// Duration returns d rounded to seconds as [h:]mm:ss, or m:ss under an hour.
+func Duration(d time.Duration) string {
+	s := int64(d.Round(time.Second) / time.Second)
+	if s >= 3600 {
+		return fmt.Sprintf("%d:%02d:%02d", s/3600, s/60%60, s%60)
+	}
+	return fmt.Sprintf("%d:%02d", s/60, s%60)
+}
+
+// Style is how a Meter reports.
+type Style int
+
+// These are the styles.
+const (
+	// Auto is Bar on terminals and Lines otherwise.
+	Auto Style = iota
+
+	// Bar redraws one line in place.
+	Bar
+
+	// Lines prints a line per report.
+	Lines
+)
+
+// Meter counts the bytes of an operation and reports its progress.
+//
+// Set the exported fields before Start.
+type Meter struct {
+	// done is first for the alignment of atomic operations.
+	done int64
+
+	// Style is how the Meter reports.
+	Style Style
+
+	// Interval is the time between reports. If 0, it is a second for
+	// Bar and ten seconds for Lines. If negative, the Meter only reports
+	// on SIGUSR1 and Stop.
+	Interval time.Duration
+
+	// Delay, if set, holds back the reports until the operation has run
+	// that long, so short operations report nothing. SIGUSR1 always
+	// reports.
+	Delay time.Duration
+
+	// Format, if set, formats the reports instead of Stats.String.
+	Format func(s Stats) string
+
+	// Count, if set, returns the bytes done for operations counting
+	// them themselves, instead of Add and Set.
+	Count func() int64
+
+	// Total is the size of the operation, or 0 if it is unknown.
+	Total int64
+
+	name  string
+	w     io.Writer
+	start time.Time
+	now   func() time.Time
+
+	// mu serializes reports.
+	mu       sync.Mutex
+	last     int64
+	reported bool
+	quit     chan struct{}
+	stopped  chan struct{}
+}
+
+// New returns a Meter, writing to w, of the operation name of total bytes, or
+// of unknown size if total is 0.
+func New(w io.Writer, name string, total int64) *Meter {
+	return &Meter{
+		name:  name,
+		Total: total,
+		w:     w,
+		start: time.Now(),
+		now:   time.Now,
+	}
+}
+
+// Add adds n bytes done.
+func (m *Meter) Add(n int64) {
+	atomic.AddInt64(&m.done, n)
+}
+
+// Set sets the bytes done.
+func (m *Meter) Set(n int64) {
+	atomic.StoreInt64(&m.done, n)
+}
+
+// Done returns the bytes done.
+func (m *Meter) Done() int64 {
+	if m.Count != nil {
+		return m.Count()
+	}
+	return atomic.LoadInt64(&m.done)
+}
+
+// Stats returns the current statistics.
+func (m *Meter) Stats() Stats {
+	return Stats{
+		Name:    m.name,
+		Done:    m.Done(),
+		Total:   m.Total,
+		Elapsed: m.now().Sub(m.start),
+	}
+}
+
+// Reader returns a reader counting the bytes read from r.
+func (m *Meter) Reader(r io.Reader) io.Reader {
+	return &reader{r, m}
+}
+
+// Writer returns a writer counting the bytes written to w.
+func (m *Meter) Writer(w io.Writer) io.Writer {
+	return &writer{w, m}
+}
+
+// ReaderAt returns a reader counting up to the furthest offset read from r,
+// for operations reading a file from start to end, even if unevenly.
+func (m *Meter) ReaderAt(r io.ReaderAt) io.ReaderAt {
+	return &readerAt{r: r, m: m}
+}
+
+type readerAt struct {
+	r io.ReaderAt
+	m *Meter
+
+	mu  sync.Mutex
+	max int64
+}
+
+func (r *readerAt) ReadAt(p []byte, off int64) (int, error) {
+	n, err := r.r.ReadAt(p, off)
+	r.mu.Lock()
+	if end := off + int64(n); end > r.max {
+		r.max = end
+		r.m.Set(end)
+	}
+	r.mu.Unlock()
+	return n, err
+}
+
+type reader struct {
+	r io.Reader
+	m *Meter
+}
+
+func (r *reader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.m.Add(int64(n))
+	return n, err
+}
+
+type writer struct {
+	w io.Writer
+	m *Meter
+}
+
+func (w *writer) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	w.m.Add(int64(n))
+	return n, err
+}
+
+func (m *Meter) style() Style {
+	if m.Style != Auto {
+		return m.Style
+	}
+	if f, ok := m.w.(*os.File); ok && term.IsTerminal(int(f.Fd())) {
+		return Bar
+	}
+	return Lines
+}
+
+func (m *Meter) interval() time.Duration {
+	if m.Interval != 0 {
+		return m.Interval
+	}
+	if m.style() == Bar {
+		return time.Second
+	}
+	return 10 * time.Second
+}
+
+// Print reports the progress once.
+func (m *Meter) Print() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.print(true)
+}
+
+// print reports, forced or if there was progress since the last report.
+func (m *Meter) print(force bool) {
+	s := m.Stats()
+	if !force && (s.Elapsed < m.Delay || m.reported && s.Done == m.last) {
+		return
+	}
+	m.last, m.reported = s.Done, true
+	if m.style() == Bar {
+		fmt.Fprintf(m.w, "\r\033[2K%s", m.bar(s))
+		return
+	}
+	fmt.Fprintln(m.w, m.format(s))
+}
+
+func (m *Meter) format(s Stats) string {
+	if m.Format != nil {
+		return m.Format(s)
+	}
+	return s.String()
+}
+
+// bar returns the Bar report of s: with the default format and a known total,
+// a bar precedes the statistics if the terminal is wide enough.
+func (m *Meter) bar(s Stats) string {
+	line := m.format(s)
+	if m.Format != nil || s.Total <= 0 {
+		return line
+	}
+	width := 80
+	if f, ok := m.w.(*os.File); ok {
+		if w, _, err := term.GetSize(int(f.Fd())); err == nil {
+			width = w
+		}
+	}
+	n := width - len(line) - 4
+	if n < 10 {
+		return line
+	}
+	if n > 50 {
+		n = 50
+	}
+	full := n * s.Percent() / 100
+	return fmt.Sprintf("[%s%s] %s", strings.Repeat("=", full), strings.Repeat(" ", n-full), line)
+}
+
+// Start reports the progress now, periodically and on SIGUSR1 until Stop.
+func (m *Meter) Start() {
+	m.quit = make(chan struct{})
+	m.stopped = make(chan struct{})
+	var tick <-chan time.Time
+	var t *time.Ticker
+	if d := m.interval(); d > 0 {
+		if m.Delay == 0 {
+			m.Print()
+		}
+		t = time.NewTicker(d)
+		tick = t.C
+	}
+	usr1, stop := notifyUSR1()
+	go func() {
+		defer close(m.stopped)
+		defer stop()
+		if t != nil {
+			defer t.Stop()
+		}
+		for {
+			select {
+			case <-tick:
+				m.mu.Lock()
+				m.print(false)
+				m.mu.Unlock()
+			case <-usr1:
+				m.Print()
+			case <-m.quit:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops the reports started by Start, and prints a final one.
+func (m *Meter) Stop() {
+	if m.quit != nil {
+		close(m.quit)
+		<-m.stopped
+		m.quit = nil
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !m.reported && m.Stats().Elapsed < m.Delay {
+		return
+	}
+	m.print(true)
+	if m.style() == Bar {
+		fmt.Fprintln(m.w)
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package progress
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestStats(t *testing.T) {
+	for _, tt := range []struct {
+		s    Stats
+		want string
+	}{
+		{
+			s:    Stats{Name: "disk.img", Done: 3 << 29, Total: 4 << 30, Elapsed: 30 * time.Second},
+			want: "disk.img: 1.5 GiB / 4.0 GiB (37%), 51.2 MiB/s, ETA 0:50",
+		},
+		{
+			s:    Stats{Done: 512, Elapsed: time.Second},
+			want: "512 B, 512 B/s",
+		},
+		{
+			s:    Stats{Done: 1 << 20, Total: 1 << 20, Elapsed: 2 * time.Hour},
+			want: "1.0 MiB / 1.0 MiB (100%), 145 B/s, ETA 0:00",
+		},
+	} {
+		if got := tt.s.String(); got != tt.want {
+			t.Errorf("%+v.String() = %q, want %q", tt.s, got, tt.want)
+		}
+	}
+	if got := Duration(3725 * time.Second); got != "1:02:05" {
+		t.Errorf("Duration(3725s) = %q, want 1:02:05", got)
+	}
+}
+
+func TestMeter(t *testing.T) {
+	var out bytes.Buffer
+	m := New(&out, "x", 10)
+	now := m.start
+	m.now = func() time.Time { return now }
+
+	now = now.Add(time.Second)
+	if _, err := io.Copy(ioutil.Discard, m.Reader(strings.NewReader("hello"))); err != nil {
+		t.Fatal(err)
+	}
+	m.print(false)
+	// No progress, no report.
+	m.print(false)
+	if _, err := m.Writer(ioutil.Discard).Write([]byte("world")); err != nil {
+		t.Fatal(err)
+	}
+	now = now.Add(time.Second)
+	m.Stop()
+
+	want := "x: 5 B / 10 B (50%), 5 B/s, ETA 0:01\nx: 10 B / 10 B (100%), 5 B/s, ETA 0:00\n"
+	if out.String() != want {
+		t.Errorf("got:\n%s\nwant:\n%s", out.String(), want)
+	}
+}
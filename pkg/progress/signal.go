@@ -0,0 +1,20 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build !plan9
+
+package progress
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// notifyUSR1 returns a channel receiving SIGUSR1 and a function to stop.
+func notifyUSR1() (<-chan os.Signal, func()) {
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, syscall.SIGUSR1)
+	return c, func() { signal.Stop(c) }
+}
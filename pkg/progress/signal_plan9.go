@@ -0,0 +1,13 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package progress
+
+import "os"
+
+// notifyUSR1 returns a channel that never receives: Plan 9 has notes, not
+// SIGUSR1.
+func notifyUSR1() (<-chan os.Signal, func()) {
+	return nil, func() {}
+}
@@ -14,6 +14,7 @@ package pty
 import (
 	"fmt"
 	"os"
+	"strings"
 	"syscall"
 	"unsafe"
 
@@ -22,15 +23,6 @@ import (
 
 // New returns a new Pty.
 func New() (*Pty, error) {
-	tty, err := termios.New()
-	if err != nil {
-		return nil, err
-	}
-	restorer, err := tty.Get()
-	if err != nil {
-		return nil, err
-	}
-
 	ptm, err := os.OpenFile("/dev/ptmx", os.O_RDWR, 0)
 	if err != nil {
 		return nil, err
@@ -59,6 +51,19 @@ func New() (*Pty, error) {
 	if err != nil {
 		return nil, err
 	}
+
+	// Daemons, e.g. sshd, have no controlling terminal, so the pts
+	// stands in for it, without becoming theirs.
+	tty, err := termios.New()
+	if err != nil {
+		if tty, err = termios.NewTTYS(strings.TrimPrefix(sname, "/dev/")); err != nil {
+			return nil, err
+		}
+	}
+	restorer, err := tty.Get()
+	if err != nil {
+		return nil, err
+	}
 	return &Pty{Ptm: ptm, Pts: pts, Sname: sname, Kid: -1, TTY: tty, Restorer: restorer}, nil
 }
 
@@ -86,6 +86,126 @@ func (n *Network) NewVM(nopts ...NetworkOpt) Device {
 	return networkImpl{args}
 }
 
+// These are the addresses of QEMU's user-mode network.
+var (
+	// UserNetworkHostIP is the address of the host as seen from the VM.
+	UserNetworkHostIP = net.IPv4(10, 0, 2, 2)
+
+	// UserNetworkGuestIP is the address QEMU's DHCP server gives the VM.
+	UserNetworkGuestIP = net.IPv4(10, 0, 2, 15)
+)
+
+// UserNetwork is a Device connecting a VM to the host through QEMU's
+// user-mode network stack.
+//
+// It needs no privileges. QEMU runs a DHCP server giving the VM
+// UserNetworkGuestIP, and connections from the VM to UserNetworkHostIP go to
+// the host's loopback interface, so servers listening on 127.0.0.1 are
+// reachable.
+type UserNetwork struct {
+	// ID is the QEMU netdev ID. If not specified, "user0" is used.
+	ID string
+
+	// MAC is the MAC address of the VM's NIC. If not specified, QEMU
+	// chooses one.
+	MAC net.HardwareAddr
+
+	// TFTPDir, if set, is a directory served to the VM by QEMU's TFTP
+	// server at UserNetworkHostIP.
+	TFTPDir string
+
+	// BootFile is the boot file name QEMU's DHCP server offers.
+	BootFile string
+
+	// HostForwards forward host ports to the VM, in QEMU's hostfwd
+	// syntax, e.g. "tcp:127.0.0.1:5555-:22".
+	HostForwards []string
+
+	// Arch is the architecture under test. This is used to determine the
+	// QEMU command line args.
+	Arch string
+}
+
+func (u UserNetwork) Cmdline() []string {
+	id := u.ID
+	if len(id) == 0 {
+		id = "user0"
+	}
+	netdev := "user,id=" + id
+	if len(u.TFTPDir) != 0 {
+		netdev += ",tftp=" + u.TFTPDir
+	}
+	if len(u.BootFile) != 0 {
+		netdev += ",bootfile=" + u.BootFile
+	}
+	for _, f := range u.HostForwards {
+		netdev += ",hostfwd=" + f
+	}
+	return []string{
+		"-netdev", netdev,
+		"-device", nicArgs(u.Arch, id, u.MAC),
+	}
+}
+
+func (UserNetwork) KArgs() []string { return nil }
+
+// TapNetwork is a Device connecting a VM to an existing tap interface on the
+// host.
+//
+// The tap interface must be created, e.g. with `ip tuntap add`, and
+// configured by the caller, which usually needs root. QEMU's network scripts
+// are not run.
+type TapNetwork struct {
+	// ID is the QEMU netdev ID. If not specified, Ifname is used.
+	ID string
+
+	// Ifname is the name of the host's tap interface.
+	Ifname string
+
+	// MAC is the MAC address of the VM's NIC. If not specified, QEMU
+	// chooses one.
+	MAC net.HardwareAddr
+
+	// Arch is the architecture under test. This is used to determine the
+	// QEMU command line args.
+	Arch string
+}
+
+func (t TapNetwork) Cmdline() []string {
+	if len(t.Ifname) == 0 {
+		return nil
+	}
+	id := t.ID
+	if len(id) == 0 {
+		id = t.Ifname
+	}
+	return []string{
+		"-netdev", fmt.Sprintf("tap,id=%s,ifname=%s,script=no,downscript=no", id, t.Ifname),
+		"-device", nicArgs(t.Arch, id, t.MAC),
+	}
+}
+
+func (TapNetwork) KArgs() []string { return nil }
+
+// nicArgs returns the -device argument of a virtio NIC on netdev id.
+func nicArgs(arch, id string, mac net.HardwareAddr) string {
+	args := fmt.Sprintf("%s,netdev=%s", virtioDevice("net", arch), id)
+	if mac != nil {
+		args += ",mac=" + mac.String()
+	}
+	return args
+}
+
+// virtioDevice returns the name of the QEMU virtio device of the given kind,
+// like "9p" or "net", for arch: the ARM machine has virtio MMIO devices, the
+// others PCI.
+func virtioDevice(kind, arch string) string {
+	if arch == "arm" {
+		return "virtio-" + kind + "-device"
+	}
+	return "virtio-" + kind + "-pci"
+}
+
 type networkImpl struct {
 	args []string
 }
@@ -193,13 +313,7 @@ func (p P9Directory) Cmdline() []string {
 	}
 
 	// Expose the temp directory to QEMU
-	var deviceArgs string
-	switch p.Arch {
-	case "arm":
-		deviceArgs = fmt.Sprintf("virtio-9p-device,fsdev=%s,mount_tag=%s", id, tag)
-	default:
-		deviceArgs = fmt.Sprintf("virtio-9p-pci,fsdev=%s,mount_tag=%s", id, tag)
-	}
+	deviceArgs := fmt.Sprintf("%s,fsdev=%s,mount_tag=%s", virtioDevice("9p", p.Arch), id, tag)
 
 	return []string{
 		// security_model=mapped-file seems to be the best choice. It gives
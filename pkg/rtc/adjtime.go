@@ -0,0 +1,176 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rtc
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// AdjtimePath is where util-linux' hwclock keeps the drift state.
+const AdjtimePath = "/etc/adjtime"
+
+// minCalibration is the shortest interval over which a drift rate is
+// computed; shorter intervals are dominated by the one second resolution of
+// the RTC.
+const minCalibration = 4 * time.Hour
+
+// maxDrift is the largest plausible drift, in seconds per day. Larger
+// values mean the clock was set by something other than hwclock.
+const maxDrift = 86400 / 4
+
+// Adjtime is the contents of an adjtime file, in the format shared with
+// util-linux:
+//
+//	<drift> <last adjust> 0
+//	<last calibration>
+//	UTC|LOCAL
+type Adjtime struct {
+	// Drift is how many seconds the RTC gains per day.
+	Drift float64
+	// LastAdjust is when the RTC was last set or adjusted.
+	LastAdjust time.Time
+	// LastCalibration is when the drift was last measured.
+	LastCalibration time.Time
+	// Local is set if the RTC is kept in local time.
+	Local bool
+}
+
+// ReadAdjtime reads the adjtime file at path. A missing file is not an
+// error; it yields the zero Adjtime, an RTC in UTC without drift.
+func ReadAdjtime(path string) (*Adjtime, error) {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return &Adjtime{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ParseAdjtime(f)
+}
+
+// ParseAdjtime parses the contents of an adjtime file.
+func ParseAdjtime(r io.Reader) (*Adjtime, error) {
+	var lines []string
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		lines = append(lines, strings.TrimSpace(s.Text()))
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	for len(lines) < 3 {
+		lines = append(lines, "")
+	}
+
+	a := &Adjtime{}
+	f := strings.Fields(lines[0])
+	if len(f) > 0 {
+		d, err := strconv.ParseFloat(f[0], 64)
+		if err != nil {
+			return nil, fmt.Errorf("adjtime: invalid drift %q", f[0])
+		}
+		a.Drift = d
+	}
+	var err error
+	if len(f) > 1 {
+		if a.LastAdjust, err = parseUnix(f[1]); err != nil {
+			return nil, err
+		}
+	}
+	if lines[1] != "" {
+		if a.LastCalibration, err = parseUnix(lines[1]); err != nil {
+			return nil, err
+		}
+	}
+	switch lines[2] {
+	case "", "UTC":
+	case "LOCAL":
+		a.Local = true
+	default:
+		return nil, fmt.Errorf("adjtime: line 3 is %q, want UTC or LOCAL", lines[2])
+	}
+	return a, nil
+}
+
+func parseUnix(s string) (time.Time, error) {
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("adjtime: invalid time %q", s)
+	}
+	if n == 0 {
+		return time.Time{}, nil
+	}
+	return time.Unix(n, 0), nil
+}
+
+func formatUnix(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
+
+// String returns a in the adjtime file format.
+func (a *Adjtime) String() string {
+	mode := "UTC"
+	if a.Local {
+		mode = "LOCAL"
+	}
+	return fmt.Sprintf("%f %d 0.000000\n%d\n%s\n", a.Drift, formatUnix(a.LastAdjust), formatUnix(a.LastCalibration), mode)
+}
+
+// Write writes a to the adjtime file at path.
+func (a *Adjtime) Write(path string) error {
+	return ioutil.WriteFile(path, []byte(a.String()), 0644)
+}
+
+// Location returns the time zone the RTC is kept in.
+func (a *Adjtime) Location() *time.Location {
+	if a.Local {
+		return time.Local
+	}
+	return time.UTC
+}
+
+func days(d time.Duration) float64 {
+	return d.Hours() / 24
+}
+
+// Correct returns the time an RTC reading of hw actually stands for, given
+// that the RTC has been drifting since it was last set.
+func (a *Adjtime) Correct(hw time.Time) time.Time {
+	if a.LastAdjust.IsZero() || a.Drift == 0 {
+		return hw
+	}
+	err := a.Drift * days(hw.Sub(a.LastAdjust))
+	return hw.Add(-time.Duration(err * float64(time.Second)))
+}
+
+// Calibrate records that the RTC, which read hw, is being set to sys. If
+// enough time has passed since the last calibration, the difference is used
+// to refine the drift rate.
+func (a *Adjtime) Calibrate(hw, sys time.Time) {
+	if !a.LastCalibration.IsZero() && sys.Sub(a.LastCalibration) >= minCalibration {
+		// The error that remains after correcting for the known drift
+		// is due to the drift rate being off.
+		gained := a.Correct(hw).Sub(sys).Seconds()
+		d := a.Drift + gained/days(sys.Sub(a.LastCalibration))
+		if math.Abs(d) > maxDrift {
+			d = 0
+		}
+		a.Drift = d
+	}
+	a.LastCalibration = sys
+	a.LastAdjust = sys
+}
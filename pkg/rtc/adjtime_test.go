@@ -0,0 +1,77 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rtc
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseAdjtime(t *testing.T) {
+	a, err := ParseAdjtime(strings.NewReader("-1.500000 1600000000 0.000000\n1590000000\nLOCAL\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.Drift != -1.5 || a.LastAdjust.Unix() != 1600000000 || a.LastCalibration.Unix() != 1590000000 || !a.Local {
+		t.Errorf("ParseAdjtime = %+v", a)
+	}
+	if got, want := a.String(), "-1.500000 1600000000 0.000000\n1590000000\nLOCAL\n"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+
+	a, err = ParseAdjtime(strings.NewReader(""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.Local || !a.LastAdjust.IsZero() || a.Location() != time.UTC {
+		t.Errorf("empty adjtime = %+v, want zero", a)
+	}
+
+	for _, bad := range []string{"x 0 0\n0\nUTC\n", "0 0 0\n0\nGMT\n", "0 y 0\n"} {
+		if _, err := ParseAdjtime(strings.NewReader(bad)); err == nil {
+			t.Errorf("ParseAdjtime(%q) succeeded", bad)
+		}
+	}
+}
+
+func TestDrift(t *testing.T) {
+	start := time.Unix(1600000000, 0)
+	day := 24 * time.Hour
+
+	a := &Adjtime{}
+	a.Calibrate(start, start)
+	if a.Drift != 0 || !a.LastCalibration.Equal(start) {
+		t.Fatalf("first Calibrate = %+v", a)
+	}
+
+	// The RTC gained 10 seconds over 5 days.
+	sys := start.Add(5 * day)
+	a.Calibrate(sys.Add(10*time.Second), sys)
+	if math.Abs(a.Drift-2) > 1e-3 {
+		t.Errorf("Drift = %f, want 2", a.Drift)
+	}
+
+	// Another day later, the reading is corrected by 2 seconds.
+	hw := sys.Add(day + 2*time.Second)
+	if got, want := a.Correct(hw), sys.Add(day); got.Sub(want).Round(time.Millisecond) != 0 {
+		t.Errorf("Correct(%v) = %v, want %v", hw, got, want)
+	}
+
+	// Recalibrating after a day in which the clock gained 3 seconds
+	// refines the rate.
+	a.Calibrate(sys.Add(day+3*time.Second), sys.Add(day))
+	if math.Abs(a.Drift-3) > 1e-3 {
+		t.Errorf("Drift = %f, want 3", a.Drift)
+	}
+
+	// Too soon to tell.
+	now := a.LastCalibration.Add(time.Hour)
+	a.Calibrate(now.Add(time.Minute), now)
+	if math.Abs(a.Drift-3) > 1e-3 {
+		t.Errorf("Drift after 1h = %f, want 3", a.Drift)
+	}
+}
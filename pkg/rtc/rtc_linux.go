@@ -12,10 +12,21 @@ import (
 	"golang.org/x/sys/unix"
 )
 
+// RTC is an open real time clock device.
 type RTC struct {
 	file *os.File
 }
 
+// Open opens the RTC device at path.
+func Open(path string) (*RTC, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	return &RTC{f}, nil
+}
+
+// OpenRTC opens the first RTC device found.
 func OpenRTC() (*RTC, error) {
 	devs := []string{
 		"/dev/rtc",
@@ -35,7 +46,14 @@ func OpenRTC() (*RTC, error) {
 	return nil, errors.New("no RTC device found")
 }
 
+// Read returns the time of the RTC, which must be kept in UTC.
 func (r *RTC) Read() (time.Time, error) {
+	return r.ReadIn(time.UTC)
+}
+
+// ReadIn returns the time of the RTC, which is kept in the time zone loc.
+// Machines dual booting Windows usually keep it in local time.
+func (r *RTC) ReadIn(loc *time.Location) (time.Time, error) {
 	rt, err := unix.IoctlGetRTCTime(int(r.file.Fd()))
 	if err != nil {
 		return time.Time{}, err
@@ -48,9 +66,11 @@ func (r *RTC) Read() (time.Time, error) {
 		int(rt.Min),
 		int(rt.Sec),
 		0,
-		time.UTC), nil
+		loc), nil
 }
 
+// Set sets the RTC to the wall clock fields of tu, which callers should
+// convert to UTC first.
 func (r *RTC) Set(tu time.Time) error {
 	rt := unix.RTCTime{Sec: int32(tu.Second()),
 		Min:   int32(tu.Minute()),
@@ -64,3 +84,13 @@ func (r *RTC) Set(tu time.Time) error {
 
 	return unix.IoctlSetRTCTime(int(r.file.Fd()), &rt)
 }
+
+// SetIn sets the RTC to t in the time zone loc.
+func (r *RTC) SetIn(t time.Time, loc *time.Location) error {
+	return r.Set(t.In(loc))
+}
+
+// Close closes the RTC device.
+func (r *RTC) Close() error {
+	return r.file.Close()
+}
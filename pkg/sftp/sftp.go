@@ -0,0 +1,697 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package sftp implements the server side of the SSH file transfer
+// protocol, version 3, as in draft-ietf-secsh-filexfer-02, which OpenSSH's
+// sftp, scp and sshfs use.
+//
+// Paths are those of the local file system, relative ones to the working
+// directory. There is no access control beyond that of the files.
+package sftp
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Version is the protocol version served.
+const Version = 3
+
+// Packet types.
+const (
+	fxpInit     = 1
+	fxpVersion  = 2
+	fxpOpen     = 3
+	fxpClose    = 4
+	fxpRead     = 5
+	fxpWrite    = 6
+	fxpLstat    = 7
+	fxpFstat    = 8
+	fxpSetstat  = 9
+	fxpFsetstat = 10
+	fxpOpendir  = 11
+	fxpReaddir  = 12
+	fxpRemove   = 13
+	fxpMkdir    = 14
+	fxpRmdir    = 15
+	fxpRealpath = 16
+	fxpStat     = 17
+	fxpRename   = 18
+	fxpReadlink = 19
+	fxpSymlink  = 20
+	fxpStatus   = 101
+	fxpHandle   = 102
+	fxpData     = 103
+	fxpName     = 104
+	fxpAttrs    = 105
+)
+
+// Status codes.
+const (
+	fxOK               = 0
+	fxEOF              = 1
+	fxNoSuchFile       = 2
+	fxPermissionDenied = 3
+	fxFailure          = 4
+	fxBadMessage       = 5
+	fxOpUnsupported    = 8
+)
+
+// Open flags.
+const (
+	fxfRead   = 0x01
+	fxfWrite  = 0x02
+	fxfAppend = 0x04
+	fxfCreat  = 0x08
+	fxfTrunc  = 0x10
+	fxfExcl   = 0x20
+)
+
+// Attribute flags.
+const (
+	attrSize        = 0x01
+	attrUIDGID      = 0x02
+	attrPermissions = 0x04
+	attrACModTime   = 0x08
+	attrExtended    = 0x80000000
+)
+
+const (
+	// maxPacket bounds packets, which clients keep to 34000 bytes.
+	maxPacket = 256 * 1024
+
+	// maxRead bounds the data of a read, and dirEntries the names of a
+	// readdir reply.
+	maxRead    = 64 * 1024
+	dirEntries = 100
+)
+
+// errBadMessage is returned for malformed packets.
+var errBadMessage = errors.New("malformed packet")
+
+// decoder decodes the fields of a packet.
+type decoder struct {
+	b   []byte
+	err error
+}
+
+func (d *decoder) uint32() uint32 {
+	if len(d.b) < 4 {
+		d.err = errBadMessage
+		return 0
+	}
+	v := binary.BigEndian.Uint32(d.b)
+	d.b = d.b[4:]
+	return v
+}
+
+func (d *decoder) uint64() uint64 {
+	if len(d.b) < 8 {
+		d.err = errBadMessage
+		return 0
+	}
+	v := binary.BigEndian.Uint64(d.b)
+	d.b = d.b[8:]
+	return v
+}
+
+func (d *decoder) string() string {
+	n := d.uint32()
+	if d.err != nil || uint32(len(d.b)) < n {
+		d.err = errBadMessage
+		return ""
+	}
+	s := string(d.b[:n])
+	d.b = d.b[n:]
+	return s
+}
+
+// attrs are the attributes of a file: the fields of flags are set.
+type attrs struct {
+	flags        uint32
+	size         uint64
+	uid, gid     uint32
+	permissions  uint32
+	atime, mtime uint32
+}
+
+func (d *decoder) attrs() attrs {
+	a := attrs{flags: d.uint32()}
+	if a.flags&attrSize != 0 {
+		a.size = d.uint64()
+	}
+	if a.flags&attrUIDGID != 0 {
+		a.uid, a.gid = d.uint32(), d.uint32()
+	}
+	if a.flags&attrPermissions != 0 {
+		a.permissions = d.uint32()
+	}
+	if a.flags&attrACModTime != 0 {
+		a.atime, a.mtime = d.uint32(), d.uint32()
+	}
+	if a.flags&attrExtended != 0 {
+		for n := d.uint32(); n > 0 && d.err == nil; n-- {
+			d.string()
+			d.string()
+		}
+	}
+	return a
+}
+
+// encoder encodes the fields of a packet.
+type encoder []byte
+
+func (e *encoder) uint32(v uint32) {
+	*e = append(*e, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+func (e *encoder) uint64(v uint64) {
+	e.uint32(uint32(v >> 32))
+	e.uint32(uint32(v))
+}
+
+func (e *encoder) string(s string) {
+	e.uint32(uint32(len(s)))
+	*e = append(*e, s...)
+}
+
+func (e *encoder) attrs(a attrs) {
+	e.uint32(a.flags)
+	if a.flags&attrSize != 0 {
+		e.uint64(a.size)
+	}
+	if a.flags&attrUIDGID != 0 {
+		e.uint32(a.uid)
+		e.uint32(a.gid)
+	}
+	if a.flags&attrPermissions != 0 {
+		e.uint32(a.permissions)
+	}
+	if a.flags&attrACModTime != 0 {
+		e.uint32(a.atime)
+		e.uint32(a.mtime)
+	}
+}
+
+// fileAttrs returns the attributes of fi.
+func fileAttrs(fi os.FileInfo) attrs {
+	a := attrs{
+		flags:       attrSize | attrUIDGID | attrPermissions | attrACModTime,
+		size:        uint64(fi.Size()),
+		permissions: unixMode(fi.Mode()),
+		mtime:       uint32(fi.ModTime().Unix()),
+	}
+	a.atime = a.mtime
+	a.uid, a.gid, a.atime = owner(fi, a.atime)
+	return a
+}
+
+// Unix file types of permissions.
+const (
+	sIFSOCK = 0140000
+	sIFLNK  = 0120000
+	sIFREG  = 0100000
+	sIFBLK  = 0060000
+	sIFDIR  = 0040000
+	sIFCHR  = 0020000
+	sIFIFO  = 0010000
+)
+
+// unixMode returns the Unix mode of m, with the file type.
+func unixMode(m os.FileMode) uint32 {
+	v := uint32(m.Perm())
+	switch {
+	case m&os.ModeDir != 0:
+		v |= sIFDIR
+	case m&os.ModeSymlink != 0:
+		v |= sIFLNK
+	case m&os.ModeNamedPipe != 0:
+		v |= sIFIFO
+	case m&os.ModeSocket != 0:
+		v |= sIFSOCK
+	case m&os.ModeCharDevice != 0:
+		v |= sIFCHR
+	case m&os.ModeDevice != 0:
+		v |= sIFBLK
+	default:
+		v |= sIFREG
+	}
+	if m&os.ModeSetuid != 0 {
+		v |= 04000
+	}
+	if m&os.ModeSetgid != 0 {
+		v |= 02000
+	}
+	if m&os.ModeSticky != 0 {
+		v |= 01000
+	}
+	return v
+}
+
+// modeReplacer makes FileMode.String look like ls, as in pkg/ls.
+var modeReplacer = strings.NewReplacer("Dc", "c", "D", "b", "L", "l", "S", "s")
+
+// longName returns the line of ls -l for fi, which clients show.
+func longName(fi os.FileInfo) string {
+	a := fileAttrs(fi)
+	t := fi.ModTime()
+	date := t.Format("Jan _2 15:04")
+	if time.Since(t) > 180*24*time.Hour || t.After(time.Now()) {
+		date = t.Format("Jan _2  2006")
+	}
+	return fmt.Sprintf("%s %4d %-8d %-8d %8d %s %s", modeReplacer.Replace(fi.Mode().String()), links(fi), a.uid, a.gid, fi.Size(), date, fi.Name())
+}
+
+// handle is an open file or directory.
+type handle struct {
+	f *os.File
+
+	// dir is the path of a directory, whose entries are read by
+	// readdir.
+	dir string
+}
+
+// Server serves the file transfer protocol on a channel.
+type Server struct {
+	rw      io.ReadWriter
+	handles map[string]*handle
+	next    uint64
+}
+
+// NewServer returns a server for the channel rw, e.g. an SSH channel of the
+// sftp subsystem.
+func NewServer(rw io.ReadWriter) *Server {
+	return &Server{rw: rw, handles: map[string]*handle{}}
+}
+
+// Serve serves the requests of rw until it is closed.
+func Serve(rw io.ReadWriter) error {
+	s := NewServer(rw)
+	defer s.Close()
+	return s.Serve()
+}
+
+// Close closes the files left open by the client.
+func (s *Server) Close() error {
+	for name, h := range s.handles {
+		h.f.Close()
+		delete(s.handles, name)
+	}
+	return nil
+}
+
+func (s *Server) readPacket() (byte, []byte, error) {
+	var l [4]byte
+	if _, err := io.ReadFull(s.rw, l[:]); err != nil {
+		return 0, nil, err
+	}
+	n := binary.BigEndian.Uint32(l[:])
+	if n == 0 || n > maxPacket {
+		return 0, nil, fmt.Errorf("packet of %d bytes", n)
+	}
+	b := make([]byte, n)
+	if _, err := io.ReadFull(s.rw, b); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return 0, nil, err
+	}
+	return b[0], b[1:], nil
+}
+
+func (s *Server) writePacket(e encoder) error {
+	var l encoder
+	l.uint32(uint32(len(e)))
+	_, err := s.rw.Write(append(l, e...))
+	return err
+}
+
+// Serve serves requests until the channel is closed.
+func (s *Server) Serve() error {
+	typ, b, err := s.readPacket()
+	if err != nil {
+		return err
+	}
+	if typ != fxpInit {
+		return fmt.Errorf("got packet type %d, want init", typ)
+	}
+	// There was no id before version 3, and there are no extensions.
+	e := encoder{fxpVersion}
+	e.uint32(Version)
+	if err := s.writePacket(e); err != nil {
+		return err
+	}
+
+	for {
+		typ, b, err = s.readPacket()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		d := &decoder{b: b}
+		id := d.uint32()
+		if d.err != nil {
+			return errBadMessage
+		}
+		e := s.handle(id, typ, d)
+		if d.err != nil {
+			e = statusPacket(id, fxBadMessage, d.err.Error())
+		}
+		if err := s.writePacket(e); err != nil {
+			return err
+		}
+	}
+}
+
+// statusPacket returns a status reply.
+func statusPacket(id, code uint32, msg string) encoder {
+	e := encoder{fxpStatus}
+	e.uint32(id)
+	e.uint32(code)
+	e.string(msg)
+	e.string("en")
+	return e
+}
+
+// errorPacket returns the status reply of err.
+func errorPacket(id uint32, err error) encoder {
+	if err == nil {
+		return statusPacket(id, fxOK, "Success")
+	}
+	code := uint32(fxFailure)
+	switch {
+	case err == io.EOF:
+		return statusPacket(id, fxEOF, "EOF")
+	case os.IsNotExist(err):
+		code = fxNoSuchFile
+	case os.IsPermission(err):
+		code = fxPermissionDenied
+	}
+	return statusPacket(id, code, err.Error())
+}
+
+// handle returns the reply to request id of type typ.
+func (s *Server) handle(id uint32, typ byte, d *decoder) encoder {
+	reply := func(typ byte) encoder {
+		e := encoder{typ}
+		e.uint32(id)
+		return e
+	}
+	handleReply := func(h *handle) encoder {
+		name := strconv.FormatUint(s.next, 10)
+		s.next++
+		s.handles[name] = h
+		e := reply(fxpHandle)
+		e.string(name)
+		return e
+	}
+	attrsReply := func(fi os.FileInfo, err error) encoder {
+		if err != nil {
+			return errorPacket(id, err)
+		}
+		e := reply(fxpAttrs)
+		e.attrs(fileAttrs(fi))
+		return e
+	}
+	nameReply := func(name string, fi os.FileInfo) encoder {
+		e := reply(fxpName)
+		e.uint32(1)
+		e.string(name)
+		e.string(name)
+		if fi != nil {
+			e.attrs(fileAttrs(fi))
+		} else {
+			e.attrs(attrs{})
+		}
+		return e
+	}
+	getHandle := func() (*handle, error) {
+		name := d.string()
+		h, ok := s.handles[name]
+		if !ok {
+			return nil, fmt.Errorf("invalid handle %q", name)
+		}
+		return h, nil
+	}
+
+	switch typ {
+	case fxpOpen:
+		name, pflags, a := d.string(), d.uint32(), d.attrs()
+		if d.err != nil {
+			return nil
+		}
+		f, err := os.OpenFile(name, openFlags(pflags), fileMode(a))
+		if err != nil {
+			return errorPacket(id, err)
+		}
+		return handleReply(&handle{f: f})
+
+	case fxpOpendir:
+		name := d.string()
+		f, err := os.Open(name)
+		if err != nil {
+			return errorPacket(id, err)
+		}
+		if fi, err := f.Stat(); err != nil || !fi.IsDir() {
+			f.Close()
+			return statusPacket(id, fxFailure, fmt.Sprintf("%s is not a directory", name))
+		}
+		return handleReply(&handle{f: f, dir: name})
+
+	case fxpClose:
+		name := d.string()
+		h, ok := s.handles[name]
+		if !ok {
+			return statusPacket(id, fxFailure, fmt.Sprintf("invalid handle %q", name))
+		}
+		delete(s.handles, name)
+		return errorPacket(id, h.f.Close())
+
+	case fxpRead:
+		h, err := getHandle()
+		off, n := d.uint64(), d.uint32()
+		if err != nil || d.err != nil {
+			return errorPacket(id, err)
+		}
+		if n > maxRead {
+			n = maxRead
+		}
+		b := make([]byte, n)
+		m, err := h.f.ReadAt(b, int64(off))
+		if m == 0 && err != nil {
+			return errorPacket(id, err)
+		}
+		e := reply(fxpData)
+		e.string(string(b[:m]))
+		return e
+
+	case fxpWrite:
+		h, err := getHandle()
+		off, data := d.uint64(), d.string()
+		if err != nil || d.err != nil {
+			return errorPacket(id, err)
+		}
+		_, err = h.f.WriteAt([]byte(data), int64(off))
+		return errorPacket(id, err)
+
+	case fxpReaddir:
+		h, err := getHandle()
+		if err != nil {
+			return errorPacket(id, err)
+		}
+		if h.dir == "" {
+			return statusPacket(id, fxFailure, "not a directory handle")
+		}
+		fis, err := h.f.Readdir(dirEntries)
+		if len(fis) == 0 {
+			return errorPacket(id, err)
+		}
+		e := reply(fxpName)
+		e.uint32(uint32(len(fis)))
+		for _, fi := range fis {
+			e.string(fi.Name())
+			e.string(longName(fi))
+			e.attrs(fileAttrs(fi))
+		}
+		return e
+
+	case fxpStat:
+		return attrsReply(os.Stat(d.string()))
+
+	case fxpLstat:
+		return attrsReply(os.Lstat(d.string()))
+
+	case fxpFstat:
+		h, err := getHandle()
+		if err != nil {
+			return errorPacket(id, err)
+		}
+		return attrsReply(h.f.Stat())
+
+	case fxpSetstat:
+		name, a := d.string(), d.attrs()
+		if d.err != nil {
+			return nil
+		}
+		return errorPacket(id, setAttrs(name, nil, a))
+
+	case fxpFsetstat:
+		h, err := getHandle()
+		a := d.attrs()
+		if err != nil || d.err != nil {
+			return errorPacket(id, err)
+		}
+		return errorPacket(id, setAttrs(h.f.Name(), h.f, a))
+
+	case fxpRemove:
+		name := d.string()
+		if fi, err := os.Lstat(name); err == nil && fi.IsDir() {
+			return statusPacket(id, fxFailure, fmt.Sprintf("%s is a directory", name))
+		}
+		return errorPacket(id, os.Remove(name))
+
+	case fxpMkdir:
+		name, a := d.string(), d.attrs()
+		if d.err != nil {
+			return nil
+		}
+		mode := os.FileMode(0777)
+		if a.flags&attrPermissions != 0 {
+			mode = os.FileMode(a.permissions) & os.ModePerm
+		}
+		return errorPacket(id, os.Mkdir(name, mode))
+
+	case fxpRmdir:
+		name := d.string()
+		if fi, err := os.Lstat(name); err == nil && !fi.IsDir() {
+			return statusPacket(id, fxFailure, fmt.Sprintf("%s is not a directory", name))
+		}
+		return errorPacket(id, os.Remove(name))
+
+	case fxpRename:
+		from, to := d.string(), d.string()
+		if d.err != nil {
+			return nil
+		}
+		// Like OpenSSH, do not replace files.
+		if _, err := os.Lstat(to); err == nil {
+			return statusPacket(id, fxFailure, fmt.Sprintf("%s exists", to))
+		}
+		return errorPacket(id, os.Rename(from, to))
+
+	case fxpRealpath:
+		name := d.string()
+		if name == "" {
+			name = "."
+		}
+		p, err := filepath.Abs(name)
+		if err != nil {
+			return errorPacket(id, err)
+		}
+		return nameReply(p, nil)
+
+	case fxpReadlink:
+		target, err := os.Readlink(d.string())
+		if err != nil {
+			return errorPacket(id, err)
+		}
+		return nameReply(target, nil)
+
+	case fxpSymlink:
+		// OpenSSH sends the target first, unlike the draft.
+		target, link := d.string(), d.string()
+		if d.err != nil {
+			return nil
+		}
+		return errorPacket(id, os.Symlink(target, link))
+	}
+	return statusPacket(id, fxOpUnsupported, fmt.Sprintf("unsupported request type %d", typ))
+}
+
+// openFlags returns the os.OpenFile flags of pflags.
+func openFlags(pflags uint32) int {
+	var flags int
+	switch {
+	case pflags&fxfRead != 0 && pflags&fxfWrite != 0:
+		flags = os.O_RDWR
+	case pflags&fxfWrite != 0:
+		flags = os.O_WRONLY
+	default:
+		flags = os.O_RDONLY
+	}
+	if pflags&fxfAppend != 0 {
+		flags |= os.O_APPEND
+	}
+	if pflags&fxfCreat != 0 {
+		flags |= os.O_CREATE
+	}
+	if pflags&fxfTrunc != 0 {
+		flags |= os.O_TRUNC
+	}
+	if pflags&fxfExcl != 0 {
+		flags |= os.O_EXCL
+	}
+	return flags
+}
+
+// fileMode returns the permissions of new files.
+func fileMode(a attrs) os.FileMode {
+	if a.flags&attrPermissions != 0 {
+		return os.FileMode(a.permissions) & os.ModePerm
+	}
+	return 0666
+}
+
+// setAttrs sets the attributes of the flags of a of the file at name, or
+// of f if it is not nil.
+func setAttrs(name string, f *os.File, a attrs) error {
+	if a.flags&attrSize != 0 {
+		var err error
+		if f != nil {
+			err = f.Truncate(int64(a.size))
+		} else {
+			err = os.Truncate(name, int64(a.size))
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if a.flags&attrPermissions != 0 {
+		mode := os.FileMode(a.permissions) & os.ModePerm
+		var err error
+		if f != nil {
+			err = f.Chmod(mode)
+		} else {
+			err = os.Chmod(name, mode)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if a.flags&attrUIDGID != 0 {
+		var err error
+		if f != nil {
+			err = f.Chown(int(a.uid), int(a.gid))
+		} else {
+			err = os.Chown(name, int(a.uid), int(a.gid))
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if a.flags&attrACModTime != 0 {
+		if err := os.Chtimes(name, time.Unix(int64(a.atime), 0), time.Unix(int64(a.mtime), 0)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
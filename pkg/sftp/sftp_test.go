@@ -0,0 +1,226 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sftp
+
+import (
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+)
+
+// client sends requests to a Server, one at a time.
+type client struct {
+	t  *testing.T
+	c  net.Conn
+	id uint32
+}
+
+func newClient(t *testing.T) (*client, func()) {
+	c, s := net.Pipe()
+	done := make(chan error)
+	go func() { done <- Serve(s) }()
+	cl := &client{t: t, c: c}
+	e := encoder{fxpInit}
+	e.uint32(Version)
+	typ, d := cl.roundTrip(e)
+	if typ != fxpVersion || d.uint32() != Version {
+		t.Fatalf("Version reply = %d, want %d", typ, fxpVersion)
+	}
+	return cl, func() {
+		c.Close()
+		if err := <-done; err != nil {
+			t.Errorf("Serve = %v", err)
+		}
+	}
+}
+
+func (c *client) roundTrip(e encoder) (byte, *decoder) {
+	var l encoder
+	l.uint32(uint32(len(e)))
+	if _, err := c.c.Write(append(l, e...)); err != nil {
+		c.t.Fatal(err)
+	}
+	var b [4]byte
+	if _, err := io.ReadFull(c.c, b[:]); err != nil {
+		c.t.Fatal(err)
+	}
+	p := make([]byte, (&decoder{b: b[:]}).uint32())
+	if _, err := io.ReadFull(c.c, p); err != nil {
+		c.t.Fatal(err)
+	}
+	return p[0], &decoder{b: p[1:]}
+}
+
+// request sends a request with the fields of f, and returns the type of
+// the reply and its fields after the id.
+func (c *client) request(typ byte, f func(*encoder)) (byte, *decoder) {
+	c.id++
+	e := encoder{typ}
+	e.uint32(c.id)
+	if f != nil {
+		f(&e)
+	}
+	rtyp, d := c.roundTrip(e)
+	if id := d.uint32(); id != c.id {
+		c.t.Fatalf("Reply id = %d, want %d", id, c.id)
+	}
+	return rtyp, d
+}
+
+// status sends a request and returns the status code of the reply.
+func (c *client) status(typ byte, f func(*encoder)) uint32 {
+	rtyp, d := c.request(typ, f)
+	if rtyp != fxpStatus {
+		c.t.Fatalf("Reply to %d = %d, want status", typ, rtyp)
+	}
+	return d.uint32()
+}
+
+// handle sends a request and returns the handle of the reply.
+func (c *client) handle(typ byte, f func(*encoder)) string {
+	rtyp, d := c.request(typ, f)
+	if rtyp != fxpHandle {
+		c.t.Fatalf("Reply to %d = %d (status %d), want handle", typ, rtyp, d.uint32())
+	}
+	return d.string()
+}
+
+func fields(s ...string) func(*encoder) {
+	return func(e *encoder) {
+		for _, v := range s {
+			e.string(v)
+		}
+	}
+}
+
+func TestServer(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sftp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	c, done := newClient(t)
+	defer done()
+
+	// Write a file.
+	name := filepath.Join(dir, "f")
+	h := c.handle(fxpOpen, func(e *encoder) {
+		e.string(name)
+		e.uint32(fxfWrite | fxfCreat | fxfTrunc)
+		e.attrs(attrs{flags: attrPermissions, permissions: 0600})
+	})
+	if st := c.status(fxpWrite, func(e *encoder) {
+		e.string(h)
+		e.uint64(0)
+		e.string("hello, world")
+	}); st != fxOK {
+		t.Errorf("Write = %d, want OK", st)
+	}
+	if st := c.status(fxpClose, fields(h)); st != fxOK {
+		t.Errorf("Close = %d, want OK", st)
+	}
+	if st := c.status(fxpClose, fields(h)); st != fxFailure {
+		t.Errorf("Close of a closed handle = %d, want failure", st)
+	}
+
+	// Stat it.
+	typ, d := c.request(fxpStat, fields(name))
+	if typ != fxpAttrs {
+		t.Fatalf("Stat = %d, want attrs", typ)
+	}
+	if a := d.attrs(); a.size != 12 || a.permissions != sIFREG|0600 {
+		t.Errorf("Stat = size %d, permissions %o, want 12, %o", a.size, a.permissions, sIFREG|0600)
+	}
+	if st := c.status(fxpStat, fields(filepath.Join(dir, "none"))); st != fxNoSuchFile {
+		t.Errorf("Stat of a missing file = %d, want no such file", st)
+	}
+
+	// Read it, until EOF.
+	h = c.handle(fxpOpen, func(e *encoder) {
+		e.string(name)
+		e.uint32(fxfRead)
+		e.attrs(attrs{})
+	})
+	read := func(off uint64) (byte, *decoder) {
+		return c.request(fxpRead, func(e *encoder) {
+			e.string(h)
+			e.uint64(off)
+			e.uint32(5)
+		})
+	}
+	if typ, d := read(7); typ != fxpData || d.string() != "world" {
+		t.Errorf("Read = %d, want data %q", typ, "world")
+	}
+	if typ, d := read(12); typ != fxpStatus || d.uint32() != fxEOF {
+		t.Errorf("Read at the end = %d, want EOF status", typ)
+	}
+	c.status(fxpClose, fields(h))
+
+	// Rename it, and list the directory.
+	if st := c.status(fxpMkdir, func(e *encoder) {
+		e.string(filepath.Join(dir, "d"))
+		e.attrs(attrs{})
+	}); st != fxOK {
+		t.Errorf("Mkdir = %d, want OK", st)
+	}
+	if st := c.status(fxpRename, fields(name, filepath.Join(dir, "d"))); st != fxFailure {
+		t.Errorf("Rename onto a directory = %d, want failure", st)
+	}
+	if st := c.status(fxpRename, fields(name, filepath.Join(dir, "g"))); st != fxOK {
+		t.Errorf("Rename = %d, want OK", st)
+	}
+	if st := c.status(fxpSymlink, fields("g", filepath.Join(dir, "l"))); st != fxOK {
+		t.Errorf("Symlink = %d, want OK", st)
+	}
+	if typ, d := c.request(fxpReadlink, fields(filepath.Join(dir, "l"))); typ != fxpName || d.uint32() != 1 || d.string() != "g" {
+		t.Errorf("Readlink = %d, want name g", typ)
+	}
+
+	h = c.handle(fxpOpendir, fields(dir))
+	var names []string
+	for {
+		typ, d := c.request(fxpReaddir, fields(h))
+		if typ == fxpStatus {
+			if st := d.uint32(); st != fxEOF {
+				t.Fatalf("Readdir = %d, want EOF", st)
+			}
+			break
+		}
+		for n := d.uint32(); n > 0; n-- {
+			names = append(names, d.string())
+			d.string()
+			d.attrs()
+		}
+	}
+	c.status(fxpClose, fields(h))
+	sort.Strings(names)
+	if got, want := names, []string{"d", "g", "l"}; len(got) != len(want) || got[0] != want[0] || got[1] != want[1] || got[2] != want[2] {
+		t.Errorf("Readdir = %q, want %q", got, want)
+	}
+
+	// Remove it all.
+	if st := c.status(fxpRemove, fields(filepath.Join(dir, "d"))); st != fxFailure {
+		t.Errorf("Remove of a directory = %d, want failure", st)
+	}
+	for _, n := range []string{"g", "l"} {
+		if st := c.status(fxpRemove, fields(filepath.Join(dir, n))); st != fxOK {
+			t.Errorf("Remove(%s) = %d, want OK", n, st)
+		}
+	}
+	if st := c.status(fxpRmdir, fields(filepath.Join(dir, "d"))); st != fxOK {
+		t.Errorf("Rmdir = %d, want OK", st)
+	}
+
+	if typ, d := c.request(fxpRealpath, fields(dir+"/./x/..")); typ != fxpName || d.uint32() != 1 || d.string() != dir {
+		t.Errorf("Realpath = %d, want name %s", typ, dir)
+	}
+	if st := c.status(200, nil); st != fxOpUnsupported {
+		t.Errorf("Extended request = %d, want unsupported", st)
+	}
+}
@@ -0,0 +1,28 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sftp
+
+import (
+	"os"
+	"syscall"
+)
+
+// owner returns the owner, group and access time of fi, or atime if it has
+// none.
+func owner(fi os.FileInfo, atime uint32) (uint32, uint32, uint32) {
+	st, ok := fi.Sys().(*syscall.Stat_t)
+	if !ok {
+		return 0, 0, atime
+	}
+	return st.Uid, st.Gid, uint32(st.Atim.Sec)
+}
+
+// links returns the number of links to fi.
+func links(fi os.FileInfo) uint64 {
+	if st, ok := fi.Sys().(*syscall.Stat_t); ok {
+		return uint64(st.Nlink)
+	}
+	return 1
+}
@@ -0,0 +1,191 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package shutdown takes the system down cleanly, for halt, poweroff,
+// reboot and shutdown.
+//
+// Processes are asked to exit with SIGTERM and killed with SIGKILL when
+// they do not, file systems are synced and unmounted, the innermost first,
+// and the root is remounted read-only, before the machine is halted,
+// powered off, rebooted, or kexec'ed into a loaded kernel.
+package shutdown
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/u-root/u-root/pkg/boot/kexec"
+	"github.com/u-root/u-root/pkg/mount"
+	"golang.org/x/sys/unix"
+)
+
+// DefaultKillTimeout is how long processes have to exit after SIGTERM.
+const DefaultKillTimeout = 5 * time.Second
+
+var (
+	procDir   = "/proc"
+	kexecFile = "/sys/kernel/kexec_loaded"
+	terminals = []string{"/dev/console", "/dev/pts/*"}
+)
+
+// Options control Shutdown.
+type Options struct {
+	// Force skips killing processes and unmounting file systems. They
+	// are still synced.
+	Force bool
+
+	// KillTimeout is how long processes have to exit after SIGTERM
+	// before they get SIGKILL. Zero means DefaultKillTimeout.
+	KillTimeout time.Duration
+
+	// Debug, if set, is used to log progress.
+	Debug func(string, ...interface{})
+}
+
+// processes returns the pids of the processes other than the caller and
+// init. Kernel threads, which cannot be signaled, and zombies, which are
+// gone, are left out: they have no command line.
+func processes() ([]int, error) {
+	fis, err := ioutil.ReadDir(procDir)
+	if err != nil {
+		return nil, err
+	}
+	var pids []int
+	for _, fi := range fis {
+		pid, err := strconv.Atoi(fi.Name())
+		if err != nil || pid == 1 || pid == os.Getpid() {
+			continue
+		}
+		if b, err := ioutil.ReadFile(filepath.Join(procDir, fi.Name(), "cmdline")); err == nil && len(b) > 0 {
+			pids = append(pids, pid)
+		}
+	}
+	return pids, nil
+}
+
+// KillAll sends SIGTERM to all processes but the caller and init, and
+// SIGKILL to those which are still there after timeout.
+func KillAll(timeout time.Duration, debug func(string, ...interface{})) error {
+	if debug == nil {
+		debug = func(string, ...interface{}) {}
+	}
+	if timeout == 0 {
+		timeout = DefaultKillTimeout
+	}
+	debug("Sending SIGTERM to all processes")
+	// kill(2) spares the caller and init for -1.
+	if err := unix.Kill(-1, unix.SIGTERM); err != nil && err != unix.ESRCH {
+		return err
+	}
+	for end := time.Now().Add(timeout); time.Now().Before(end); time.Sleep(100 * time.Millisecond) {
+		pids, err := processes()
+		if err != nil {
+			return err
+		}
+		if len(pids) == 0 {
+			return nil
+		}
+	}
+	debug("Sending SIGKILL to all processes")
+	if err := unix.Kill(-1, unix.SIGKILL); err != nil && err != unix.ESRCH {
+		return err
+	}
+	return nil
+}
+
+// UnmountAll syncs, then unmounts every file system but the root,
+// innermost first, so their data is on disk before the machine goes down.
+// File systems which are busy are detached lazily, and the directories
+// they were mounted on are kept. The root is remounted read-only.
+func UnmountAll(debug func(string, ...interface{})) error {
+	if debug == nil {
+		debug = func(string, ...interface{}) {}
+	}
+	unix.Sync()
+	mps, err := mount.Mounts()
+	if err != nil {
+		return err
+	}
+	var p mount.Pool
+	for _, mp := range mps {
+		if mp.Path != "/" {
+			p.Add(mp)
+		}
+	}
+	debug("Unmounting %d file systems", len(p.MountPoints))
+	err = p.UnmountAll(mount.DetachBusy | mount.KeepMountPoints)
+	// The initramfs cannot always be remounted, which is fine.
+	if rerr := mount.Remount("/", "", unix.MS_RDONLY); rerr != nil {
+		debug("%v", rerr)
+	}
+	return err
+}
+
+// KexecLoaded reports whether a kernel is loaded to be kexec'ed.
+func KexecLoaded() bool {
+	b, err := ioutil.ReadFile(kexecFile)
+	return err == nil && strings.TrimSpace(string(b)) == "1"
+}
+
+// Shutdown takes the system down cleanly, and then calls reboot(2) with
+// cmd, one of the LINUX_REBOOT_CMD constants, which do not all fit a
+// 32-bit int. If cmd is LINUX_REBOOT_CMD_KEXEC, the loaded kernel is run,
+// and it fails early if there is none.
+//
+// Errors killing processes and unmounting file systems are logged with
+// o.Debug, as the machine goes down anyway. It only returns when the
+// reboot fails, or does not stop the machine, e.g. for suspend.
+func Shutdown(cmd uint32, o Options) error {
+	debug := o.Debug
+	if debug == nil {
+		debug = func(string, ...interface{}) {}
+	}
+	if cmd == unix.LINUX_REBOOT_CMD_KEXEC && !KexecLoaded() {
+		return fmt.Errorf("no kernel is loaded to kexec; load one with kexec -l")
+	}
+	if !o.Force {
+		// The controlling terminal goes away with the processes,
+		// which must not take the caller with it.
+		signal.Ignore(unix.SIGHUP)
+		if err := KillAll(o.KillTimeout, debug); err != nil {
+			debug("Killing processes: %v", err)
+		}
+		if err := UnmountAll(debug); err != nil {
+			debug("Unmounting: %v", err)
+		}
+	}
+	unix.Sync()
+	if cmd == unix.LINUX_REBOOT_CMD_KEXEC {
+		return kexec.Reboot()
+	}
+	return unix.Reboot(int(cmd))
+}
+
+// Wall writes msg to the console and all pseudo terminals, where users
+// are logged in.
+func Wall(msg string) {
+	msg = "\r\nBroadcast message: " + strings.Replace(strings.TrimRight(msg, "\n"), "\n", "\r\n", -1) + "\r\n"
+	for _, pattern := range terminals {
+		names, _ := filepath.Glob(pattern)
+		for _, n := range names {
+			if filepath.Base(n) == "ptmx" {
+				continue
+			}
+			// Terminals which block, e.g. for flow control, are
+			// skipped rather than waited for.
+			f, err := os.OpenFile(n, os.O_WRONLY|unix.O_NOCTTY|unix.O_NONBLOCK, 0)
+			if err != nil {
+				continue
+			}
+			f.WriteString(msg)
+			f.Close()
+		}
+	}
+}
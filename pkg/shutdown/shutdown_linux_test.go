@@ -0,0 +1,110 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package shutdown
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestProcesses(t *testing.T) {
+	dir, err := ioutil.TempDir("", "shutdown")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	defer func(d string) { procDir = d }(procDir)
+	procDir = dir
+
+	for pid, cmdline := range map[string]string{
+		"1":                       "/init\x00",
+		strconv.Itoa(os.Getpid()): "shutdown\x00",
+		"2":                       "", // A kernel thread.
+		"42":                      "/bin/sh\x00",
+		"self":                    "shutdown\x00",
+	} {
+		if err := os.Mkdir(filepath.Join(dir, pid), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(dir, pid, "cmdline"), []byte(cmdline), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	got, err := processes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int{42}; !reflect.DeepEqual(got, want) {
+		t.Errorf("processes() = %v, want %v", got, want)
+	}
+}
+
+func TestKexecLoaded(t *testing.T) {
+	dir, err := ioutil.TempDir("", "shutdown")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	defer func(f string) { kexecFile = f }(kexecFile)
+
+	for _, tt := range []struct {
+		content string
+		want    bool
+	}{
+		{"1\n", true},
+		{"0\n", false},
+		{"", false},
+	} {
+		kexecFile = filepath.Join(dir, "kexec_loaded")
+		if err := ioutil.WriteFile(kexecFile, []byte(tt.content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if got := KexecLoaded(); got != tt.want {
+			t.Errorf("KexecLoaded() with %q = %v, want %v", tt.content, got, tt.want)
+		}
+	}
+	kexecFile = filepath.Join(dir, "none")
+	if KexecLoaded() {
+		t.Errorf("KexecLoaded() without the file = true, want false")
+	}
+}
+
+func TestWall(t *testing.T) {
+	dir, err := ioutil.TempDir("", "shutdown")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	defer func(t []string) { terminals = t }(terminals)
+	terminals = []string{filepath.Join(dir, "console"), filepath.Join(dir, "pts", "*")}
+
+	if err := os.Mkdir(filepath.Join(dir, "pts"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	names := []string{"console", "pts/0", "pts/1", "pts/ptmx"}
+	for _, n := range names {
+		if err := ioutil.WriteFile(filepath.Join(dir, n), nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	Wall("going down\nnow\n")
+	for _, n := range names {
+		b, err := ioutil.ReadFile(filepath.Join(dir, n))
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := "\r\nBroadcast message: going down\r\nnow\r\n"
+		if n == "pts/ptmx" {
+			want = ""
+		}
+		if string(b) != want {
+			t.Errorf("%s = %q, want %q", n, b, want)
+		}
+	}
+}
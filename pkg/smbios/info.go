@@ -143,6 +143,32 @@ func (i *Info) GetProcessorInfo() ([]*ProcessorInfo, error) {
 	return res, nil
 }
 
+// GetMemoryControllerInfo returns all the Memory Controller Info (type 5) tables present.
+func (i *Info) GetMemoryControllerInfo() ([]*MemoryControllerInfo, error) {
+	var res []*MemoryControllerInfo
+	for _, t := range i.GetTablesByType(TableTypeMemoryControllerInfo) {
+		v, err := ParseMemoryControllerInfo(t)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, v)
+	}
+	return res, nil
+}
+
+// GetMemoryModuleInfo returns all the Memory Module Info (type 6) tables present.
+func (i *Info) GetMemoryModuleInfo() ([]*MemoryModuleInfo, error) {
+	var res []*MemoryModuleInfo
+	for _, t := range i.GetTablesByType(TableTypeMemoryModuleInfo) {
+		v, err := ParseMemoryModuleInfo(t)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, v)
+	}
+	return res, nil
+}
+
 // GetCacheInfo returns all the Cache Info (type 7) tables present.
 func (i *Info) GetCacheInfo() ([]*CacheInfo, error) {
 	var res []*CacheInfo
@@ -156,6 +182,97 @@ func (i *Info) GetCacheInfo() ([]*CacheInfo, error) {
 	return res, nil
 }
 
+// GetPortConnectorInfo returns all the Port Connector Info (type 8) tables present.
+func (i *Info) GetPortConnectorInfo() ([]*PortConnectorInfo, error) {
+	var res []*PortConnectorInfo
+	for _, t := range i.GetTablesByType(TableTypePortConnectorInfo) {
+		v, err := ParsePortConnectorInfo(t)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, v)
+	}
+	return res, nil
+}
+
+// GetSystemSlots returns all the System Slots (type 9) tables present.
+func (i *Info) GetSystemSlots() ([]*SystemSlots, error) {
+	var res []*SystemSlots
+	for _, t := range i.GetTablesByType(TableTypeSystemSlots) {
+		v, err := ParseSystemSlots(t)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, v)
+	}
+	return res, nil
+}
+
+// GetOnboardDevicesInfo returns all the On Board Devices Info (type 10) tables present.
+func (i *Info) GetOnboardDevicesInfo() ([]*OnboardDevicesInfo, error) {
+	var res []*OnboardDevicesInfo
+	for _, t := range i.GetTablesByType(TableTypeOnboardDevicesInfo) {
+		v, err := ParseOnboardDevicesInfo(t)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, v)
+	}
+	return res, nil
+}
+
+// GetOEMStrings returns all the OEM Strings (type 11) tables present.
+func (i *Info) GetOEMStrings() ([]*OEMStrings, error) {
+	var res []*OEMStrings
+	for _, t := range i.GetTablesByType(TableTypeOEMStrings) {
+		v, err := ParseOEMStrings(t)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, v)
+	}
+	return res, nil
+}
+
+// GetSystemConfigurationOptions returns all the System Configuration Options (type 12) tables present.
+func (i *Info) GetSystemConfigurationOptions() ([]*SystemConfigurationOptions, error) {
+	var res []*SystemConfigurationOptions
+	for _, t := range i.GetTablesByType(TableTypeSystemConfigurationOptions) {
+		v, err := ParseSystemConfigurationOptions(t)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, v)
+	}
+	return res, nil
+}
+
+// GetBIOSLanguageInfo returns all the BIOS Language Info (type 13) tables present.
+func (i *Info) GetBIOSLanguageInfo() ([]*BIOSLanguageInfo, error) {
+	var res []*BIOSLanguageInfo
+	for _, t := range i.GetTablesByType(TableTypeBIOSLanguageInfo) {
+		v, err := ParseBIOSLanguageInfo(t)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, v)
+	}
+	return res, nil
+}
+
+// GetPhysicalMemoryArrays returns all the Physical Memory Array (type 16) tables present.
+func (i *Info) GetPhysicalMemoryArrays() ([]*PhysicalMemoryArray, error) {
+	var res []*PhysicalMemoryArray
+	for _, t := range i.GetTablesByType(TableTypePhysicalMemoryArray) {
+		v, err := ParsePhysicalMemoryArray(t)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, v)
+	}
+	return res, nil
+}
+
 // GetMemoryDevices returns all the Memory Device (type 17) tables present.
 func (i *Info) GetMemoryDevices() ([]*MemoryDevice, error) {
 	var res []*MemoryDevice
@@ -169,6 +286,58 @@ func (i *Info) GetMemoryDevices() ([]*MemoryDevice, error) {
 	return res, nil
 }
 
+// GetMemoryError32Info returns all the 32-bit Memory Error Info (type 18) tables present.
+func (i *Info) GetMemoryError32Info() ([]*MemoryError32Info, error) {
+	var res []*MemoryError32Info
+	for _, t := range i.GetTablesByType(TableTypeMemoryError32Info) {
+		v, err := ParseMemoryError32Info(t)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, v)
+	}
+	return res, nil
+}
+
+// GetMemoryArrayMappedAddresses returns all the Memory Array Mapped Address (type 19) tables present.
+func (i *Info) GetMemoryArrayMappedAddresses() ([]*MemoryArrayMappedAddress, error) {
+	var res []*MemoryArrayMappedAddress
+	for _, t := range i.GetTablesByType(TableTypeMemoryArrayMappedAddress) {
+		v, err := ParseMemoryArrayMappedAddress(t)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, v)
+	}
+	return res, nil
+}
+
+// GetMemoryDeviceMappedAddresses returns all the Memory Device Mapped Address (type 20) tables present.
+func (i *Info) GetMemoryDeviceMappedAddresses() ([]*MemoryDeviceMappedAddress, error) {
+	var res []*MemoryDeviceMappedAddress
+	for _, t := range i.GetTablesByType(TableTypeMemoryDeviceMappedAddress) {
+		v, err := ParseMemoryDeviceMappedAddress(t)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, v)
+	}
+	return res, nil
+}
+
+// GetSystemBootInfo returns all the System Boot Info (type 32) tables present.
+func (i *Info) GetSystemBootInfo() ([]*SystemBootInfo, error) {
+	var res []*SystemBootInfo
+	for _, t := range i.GetTablesByType(TableTypeSystemBootInfo) {
+		v, err := ParseSystemBootInfo(t)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, v)
+	}
+	return res, nil
+}
+
 // GetIPMIDeviceInfo returns all the IPMI Device Info (type 38) tables present.
 func (i *Info) GetIPMIDeviceInfo() ([]*IPMIDeviceInfo, error) {
 	var res []*IPMIDeviceInfo
@@ -182,6 +351,19 @@ func (i *Info) GetIPMIDeviceInfo() ([]*IPMIDeviceInfo, error) {
 	return res, nil
 }
 
+// GetOnboardDevices returns all the Onboard Device (type 41) tables present.
+func (i *Info) GetOnboardDevices() ([]*OnboardDevice, error) {
+	var res []*OnboardDevice
+	for _, t := range i.GetTablesByType(TableTypeOnboardDevice) {
+		v, err := ParseOnboardDevice(t)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, v)
+	}
+	return res, nil
+}
+
 // GetTPMDevices returns all the TPM Device (type 43) tables present.
 func (i *Info) GetTPMDevices() ([]*TPMDevice, error) {
 	var res []*TPMDevice
@@ -14,17 +14,31 @@ type TableType uint8
 
 // Supported table types.
 const (
-	TableTypeBIOSInfo       TableType = 0
-	TableTypeSystemInfo     TableType = 1
-	TableTypeBaseboardInfo  TableType = 2
-	TableTypeChassisInfo    TableType = 3
-	TableTypeProcessorInfo  TableType = 4
-	TableTypeCacheInfo      TableType = 7
-	TableTypeMemoryDevice   TableType = 17
-	TableTypeIPMIDeviceInfo TableType = 38
-	TableTypeTPMDevice      TableType = 43
-	TableTypeInactive       TableType = 126
-	TableTypeEndOfTable     TableType = 127
+	TableTypeBIOSInfo                   TableType = 0
+	TableTypeSystemInfo                 TableType = 1
+	TableTypeBaseboardInfo              TableType = 2
+	TableTypeChassisInfo                TableType = 3
+	TableTypeProcessorInfo              TableType = 4
+	TableTypeMemoryControllerInfo       TableType = 5
+	TableTypeMemoryModuleInfo           TableType = 6
+	TableTypeCacheInfo                  TableType = 7
+	TableTypePortConnectorInfo          TableType = 8
+	TableTypeSystemSlots                TableType = 9
+	TableTypeOnboardDevicesInfo         TableType = 10
+	TableTypeOEMStrings                 TableType = 11
+	TableTypeSystemConfigurationOptions TableType = 12
+	TableTypeBIOSLanguageInfo           TableType = 13
+	TableTypePhysicalMemoryArray        TableType = 16
+	TableTypeMemoryDevice               TableType = 17
+	TableTypeMemoryError32Info          TableType = 18
+	TableTypeMemoryArrayMappedAddress   TableType = 19
+	TableTypeMemoryDeviceMappedAddress  TableType = 20
+	TableTypeSystemBootInfo             TableType = 32
+	TableTypeIPMIDeviceInfo             TableType = 38
+	TableTypeOnboardDevice              TableType = 41
+	TableTypeTPMDevice                  TableType = 43
+	TableTypeInactive                   TableType = 126
+	TableTypeEndOfTable                 TableType = 127
 )
 
 func (t TableType) String() string {
@@ -39,12 +53,40 @@ func (t TableType) String() string {
 		return "Chassis Information"
 	case TableTypeProcessorInfo:
 		return "Processor Information"
+	case TableTypeMemoryControllerInfo:
+		return "Memory Controller Information"
+	case TableTypeMemoryModuleInfo:
+		return "Memory Module Information"
 	case TableTypeCacheInfo:
 		return "Cache Information"
+	case TableTypePortConnectorInfo:
+		return "Port Connector Information"
+	case TableTypeSystemSlots:
+		return "System Slot Information"
+	case TableTypeOnboardDevicesInfo:
+		return "On Board Devices Information"
+	case TableTypeOEMStrings:
+		return "OEM Strings"
+	case TableTypeSystemConfigurationOptions:
+		return "System Configuration Options"
+	case TableTypeBIOSLanguageInfo:
+		return "BIOS Language Information"
+	case TableTypePhysicalMemoryArray:
+		return "Physical Memory Array"
 	case TableTypeMemoryDevice:
 		return "Memory Device"
+	case TableTypeMemoryError32Info:
+		return "32-bit Memory Error Information"
+	case TableTypeMemoryArrayMappedAddress:
+		return "Memory Array Mapped Address"
+	case TableTypeMemoryDeviceMappedAddress:
+		return "Memory Device Mapped Address"
+	case TableTypeSystemBootInfo:
+		return "System Boot Information"
 	case TableTypeIPMIDeviceInfo:
 		return "IPMI Device Information"
+	case TableTypeOnboardDevice:
+		return "Onboard Device"
 	case TableTypeTPMDevice:
 		return "TPM Device"
 	case TableTypeInactive:
@@ -72,12 +114,40 @@ func ParseTypedTable(t *Table) (fmt.Stringer, error) {
 		return ParseChassisInfo(t)
 	case TableTypeProcessorInfo: // 4
 		return ParseProcessorInfo(t)
+	case TableTypeMemoryControllerInfo: // 5
+		return ParseMemoryControllerInfo(t)
+	case TableTypeMemoryModuleInfo: // 6
+		return ParseMemoryModuleInfo(t)
 	case TableTypeCacheInfo: // 7
 		return ParseCacheInfo(t)
+	case TableTypePortConnectorInfo: // 8
+		return ParsePortConnectorInfo(t)
+	case TableTypeSystemSlots: // 9
+		return ParseSystemSlots(t)
+	case TableTypeOnboardDevicesInfo: // 10
+		return ParseOnboardDevicesInfo(t)
+	case TableTypeOEMStrings: // 11
+		return ParseOEMStrings(t)
+	case TableTypeSystemConfigurationOptions: // 12
+		return ParseSystemConfigurationOptions(t)
+	case TableTypeBIOSLanguageInfo: // 13
+		return ParseBIOSLanguageInfo(t)
+	case TableTypePhysicalMemoryArray: // 16
+		return ParsePhysicalMemoryArray(t)
 	case TableTypeMemoryDevice: // 17
 		return NewMemoryDevice(t)
+	case TableTypeMemoryError32Info: // 18
+		return ParseMemoryError32Info(t)
+	case TableTypeMemoryArrayMappedAddress: // 19
+		return ParseMemoryArrayMappedAddress(t)
+	case TableTypeMemoryDeviceMappedAddress: // 20
+		return ParseMemoryDeviceMappedAddress(t)
+	case TableTypeSystemBootInfo: // 32
+		return ParseSystemBootInfo(t)
 	case TableTypeIPMIDeviceInfo: // 38
 		return ParseIPMIDeviceInfo(t)
+	case TableTypeOnboardDevice: // 41
+		return ParseOnboardDevice(t)
 	case TableTypeTPMDevice: // 43
 		return NewTPMDevice(t)
 	case TableTypeInactive: // 126
@@ -0,0 +1,68 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package smbios
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// OnboardDevicesInfo is defined in DSP0134 7.11. It is obsolete, replaced by
+// OnboardDevice.
+type OnboardDevicesInfo struct {
+	Table
+	Devices []OnboardDevicesEntry
+}
+
+// OnboardDevicesEntry is a device of OnboardDevicesInfo.
+type OnboardDevicesEntry struct {
+	DeviceType  OnboardDeviceType
+	Description string
+}
+
+// Enabled reports whether the device is enabled.
+func (e OnboardDevicesEntry) Enabled() bool {
+	return e.DeviceType&0x80 != 0
+}
+
+// ParseOnboardDevicesInfo parses a generic Table into OnboardDevicesInfo.
+func ParseOnboardDevicesInfo(t *Table) (*OnboardDevicesInfo, error) {
+	if t.Type != TableTypeOnboardDevicesInfo {
+		return nil, fmt.Errorf("invalid table type %d", t.Type)
+	}
+	if t.Len() < 6 {
+		return nil, errors.New("required fields missing")
+	}
+	od := &OnboardDevicesInfo{Table: *t}
+	for off := 4; off+2 <= t.Len(); off += 2 {
+		typ, _ := t.GetByteAt(off)
+		desc, _ := t.GetStringAt(off + 1)
+		od.Devices = append(od.Devices, OnboardDevicesEntry{OnboardDeviceType(typ), desc})
+	}
+	return od, nil
+}
+
+func (od *OnboardDevicesInfo) String() string {
+	// Each device has a name line of its own, below the handle.
+	lines := []string{fmt.Sprintf("Handle 0x%04X, DMI type %d, %d bytes", od.Handle, od.Type, od.Length)}
+	for i, d := range od.Devices {
+		status := "Disabled"
+		if d.Enabled() {
+			status = "Enabled"
+		}
+		name := "On Board Device Information"
+		if len(od.Devices) > 1 {
+			name = fmt.Sprintf("On Board Device %d Information", i+1)
+		}
+		lines = append(lines,
+			name,
+			fmt.Sprintf("\tType: %s", d.DeviceType),
+			fmt.Sprintf("\tStatus: %s", status),
+			fmt.Sprintf("\tDescription: %s", d.Description),
+		)
+	}
+	return strings.Join(lines, "\n")
+}
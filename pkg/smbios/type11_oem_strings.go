@@ -0,0 +1,56 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package smbios
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// OEMStrings is defined in DSP0134 7.12.
+type OEMStrings struct {
+	Table
+	Count   uint8    // 04h
+	Strings []string `smbios:"-"`
+}
+
+// ParseOEMStrings parses a generic Table into OEMStrings.
+func ParseOEMStrings(t *Table) (*OEMStrings, error) {
+	if t.Type != TableTypeOEMStrings {
+		return nil, fmt.Errorf("invalid table type %d", t.Type)
+	}
+	if t.Len() < 0x5 {
+		return nil, errors.New("required fields missing")
+	}
+	oi := &OEMStrings{Table: *t}
+	if _, err := parseStruct(t, 0 /* off */, false /* complete */, oi); err != nil {
+		return nil, err
+	}
+	oi.Strings = numberedStrings(t, oi.Count)
+	return oi, nil
+}
+
+// numberedStrings returns the first count strings of t, for the types
+// whose data is nothing but strings.
+func numberedStrings(t *Table, count uint8) []string {
+	res := make([]string, count)
+	for i := range res {
+		if i < len(t.strings) {
+			res[i] = t.strings[i]
+		} else {
+			res[i] = "<BAD INDEX>"
+		}
+	}
+	return res
+}
+
+func (oi *OEMStrings) String() string {
+	lines := []string{oi.Header.String()}
+	for i, s := range oi.Strings {
+		lines = append(lines, fmt.Sprintf("String %d: %s", i+1, s))
+	}
+	return strings.Join(lines, "\n\t")
+}
@@ -0,0 +1,43 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package smbios
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// SystemConfigurationOptions is defined in DSP0134 7.13.
+type SystemConfigurationOptions struct {
+	Table
+	Count   uint8    // 04h
+	Options []string `smbios:"-"`
+}
+
+// ParseSystemConfigurationOptions parses a generic Table into
+// SystemConfigurationOptions.
+func ParseSystemConfigurationOptions(t *Table) (*SystemConfigurationOptions, error) {
+	if t.Type != TableTypeSystemConfigurationOptions {
+		return nil, fmt.Errorf("invalid table type %d", t.Type)
+	}
+	if t.Len() < 0x5 {
+		return nil, errors.New("required fields missing")
+	}
+	sc := &SystemConfigurationOptions{Table: *t}
+	if _, err := parseStruct(t, 0 /* off */, false /* complete */, sc); err != nil {
+		return nil, err
+	}
+	sc.Options = numberedStrings(t, sc.Count)
+	return sc, nil
+}
+
+func (sc *SystemConfigurationOptions) String() string {
+	lines := []string{sc.Header.String()}
+	for i, s := range sc.Options {
+		lines = append(lines, fmt.Sprintf("Option %d: %s", i+1, s))
+	}
+	return strings.Join(lines, "\n\t")
+}
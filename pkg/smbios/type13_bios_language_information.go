@@ -0,0 +1,66 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package smbios
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// BIOSLanguageInfo is defined in DSP0134 7.14.
+type BIOSLanguageInfo struct {
+	Table
+	InstallableLanguages uint8    // 04h
+	Flags                uint8    // 05h
+	CurrentLanguage      string   `smbios:"skip=15"` // 15h
+	Languages            []string `smbios:"-"`
+}
+
+// ParseBIOSLanguageInfo parses a generic Table into BIOSLanguageInfo.
+func ParseBIOSLanguageInfo(t *Table) (*BIOSLanguageInfo, error) {
+	if t.Type != TableTypeBIOSLanguageInfo {
+		return nil, fmt.Errorf("invalid table type %d", t.Type)
+	}
+	if t.Len() < 0x16 {
+		return nil, errors.New("required fields missing")
+	}
+	bl := &BIOSLanguageInfo{Table: *t}
+	if _, err := parseStruct(t, 0 /* off */, false /* complete */, bl); err != nil {
+		return nil, err
+	}
+	// The languages are the first strings.
+	for i := 0; i < int(bl.InstallableLanguages); i++ {
+		l := "<BAD INDEX>"
+		if i < len(t.strings) {
+			l = t.strings[i]
+		}
+		bl.Languages = append(bl.Languages, l)
+	}
+	return bl, nil
+}
+
+// Abbreviated reports whether the languages are in the abbreviated format,
+// e.g. enUS, rather than the long one, e.g. en|US|iso8859-1.
+func (bl *BIOSLanguageInfo) Abbreviated() bool {
+	return bl.Flags&1 != 0
+}
+
+func (bl *BIOSLanguageInfo) String() string {
+	format := "Long"
+	if bl.Abbreviated() {
+		format = "Abbreviated"
+	}
+	lines := []string{
+		bl.Header.String(),
+		fmt.Sprintf("Language Description Format: %s", format),
+		fmt.Sprintf("Installable Languages: %d", bl.InstallableLanguages),
+	}
+	for _, l := range bl.Languages {
+		lines = append(lines, "\t"+l)
+	}
+	lines = append(lines, fmt.Sprintf("Currently Installed Language: %s", bl.CurrentLanguage))
+	return strings.Join(lines, "\n\t")
+}
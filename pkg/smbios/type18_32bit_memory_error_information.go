@@ -0,0 +1,112 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package smbios
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// MemoryError32Info is defined in DSP0134 7.19.
+type MemoryError32Info struct {
+	Table
+	ErrorType               MemoryErrorType        // 04h
+	ErrorGranularity        MemoryErrorGranularity // 05h
+	ErrorOperation          MemoryErrorOperation   // 06h
+	VendorSyndrome          uint32                 // 07h
+	MemoryArrayErrorAddress uint32                 // 0Bh
+	DeviceErrorAddress      uint32                 // 0Fh
+	ErrorResolution         uint32                 // 13h
+}
+
+// ParseMemoryError32Info parses a generic Table into MemoryError32Info.
+func ParseMemoryError32Info(t *Table) (*MemoryError32Info, error) {
+	if t.Type != TableTypeMemoryError32Info {
+		return nil, fmt.Errorf("invalid table type %d", t.Type)
+	}
+	if t.Len() < 0x17 {
+		return nil, errors.New("required fields missing")
+	}
+	me := &MemoryError32Info{Table: *t}
+	if _, err := parseStruct(t, 0 /* off */, false /* complete */, me); err != nil {
+		return nil, err
+	}
+	return me, nil
+}
+
+// errorAddress formats a 32-bit memory error address, 0x80000000 if unknown.
+func errorAddress(v uint32) string {
+	if v == 0x80000000 {
+		return "Unknown"
+	}
+	return fmt.Sprintf("0x%08X", v)
+}
+
+func (me *MemoryError32Info) String() string {
+	syndrome := "Unknown"
+	if me.VendorSyndrome != 0 {
+		syndrome = fmt.Sprintf("0x%08X", me.VendorSyndrome)
+	}
+	lines := []string{
+		me.Header.String(),
+		fmt.Sprintf("Type: %s", me.ErrorType),
+		fmt.Sprintf("Granularity: %s", me.ErrorGranularity),
+		fmt.Sprintf("Operation: %s", me.ErrorOperation),
+		fmt.Sprintf("Vendor Syndrome: %s", syndrome),
+		fmt.Sprintf("Memory Array Address: %s", errorAddress(me.MemoryArrayErrorAddress)),
+		fmt.Sprintf("Device Address: %s", errorAddress(me.DeviceErrorAddress)),
+		fmt.Sprintf("Resolution: %s", errorAddress(me.ErrorResolution)),
+	}
+	return strings.Join(lines, "\n\t")
+}
+
+// MemoryErrorType is defined in DSP0134 7.19.1.
+type MemoryErrorType uint8
+
+func (v MemoryErrorType) String() string {
+	names := []string{
+		"Other", // 0x01
+		"Unknown",
+		"OK",
+		"Bad Read",
+		"Parity Error",
+		"Single-bit Error",
+		"Double-bit Error",
+		"Multi-bit Error",
+		"Nibble Error",
+		"Checksum Error",
+		"CRC Error",
+		"Corrected Single-bit Error",
+		"Corrected Error",
+		"Uncorrectable Error", // 0x0E
+	}
+	if v >= 1 && int(v) <= len(names) {
+		return names[v-1]
+	}
+	return outOfSpec
+}
+
+// MemoryErrorGranularity is defined in DSP0134 7.19.2.
+type MemoryErrorGranularity uint8
+
+func (v MemoryErrorGranularity) String() string {
+	names := []string{"Other", "Unknown", "Device Level", "Memory Partition Level"}
+	if v >= 1 && int(v) <= len(names) {
+		return names[v-1]
+	}
+	return outOfSpec
+}
+
+// MemoryErrorOperation is defined in DSP0134 7.19.3.
+type MemoryErrorOperation uint8
+
+func (v MemoryErrorOperation) String() string {
+	names := []string{"Other", "Unknown", "Read", "Write", "Partial Write"}
+	if v >= 1 && int(v) <= len(names) {
+		return names[v-1]
+	}
+	return outOfSpec
+}
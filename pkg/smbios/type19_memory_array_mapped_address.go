@@ -0,0 +1,81 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package smbios
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// MemoryArrayMappedAddress is defined in DSP0134 7.20.
+type MemoryArrayMappedAddress struct {
+	Table
+	StartingAddress         uint32 // 04h
+	EndingAddress           uint32 // 08h
+	MemoryArrayHandle       uint16 // 0Ch
+	PartitionWidth          uint8  // 0Eh
+	ExtendedStartingAddress uint64 // 0Fh
+	ExtendedEndingAddress   uint64 // 17h
+}
+
+// ParseMemoryArrayMappedAddress parses a generic Table into
+// MemoryArrayMappedAddress.
+func ParseMemoryArrayMappedAddress(t *Table) (*MemoryArrayMappedAddress, error) {
+	if t.Type != TableTypeMemoryArrayMappedAddress {
+		return nil, fmt.Errorf("invalid table type %d", t.Type)
+	}
+	if t.Len() < 0xf {
+		return nil, errors.New("required fields missing")
+	}
+	ma := &MemoryArrayMappedAddress{Table: *t}
+	if _, err := parseStruct(t, 0 /* off */, false /* complete */, ma); err != nil {
+		return nil, err
+	}
+	return ma, nil
+}
+
+func (ma *MemoryArrayMappedAddress) extended() bool {
+	return ma.StartingAddress == 0xffffffff && ma.Len() >= 0x1f
+}
+
+// GetStartingAddressBytes returns the physical address of the first byte
+// of the range.
+func (ma *MemoryArrayMappedAddress) GetStartingAddressBytes() uint64 {
+	if ma.extended() {
+		return ma.ExtendedStartingAddress
+	}
+	return uint64(ma.StartingAddress) << 10
+}
+
+// GetEndingAddressBytes returns the physical address of the last byte of
+// the range.
+func (ma *MemoryArrayMappedAddress) GetEndingAddressBytes() uint64 {
+	if ma.extended() {
+		return ma.ExtendedEndingAddress
+	}
+	return uint64(ma.EndingAddress)<<10 + 0x3ff
+}
+
+func (ma *MemoryArrayMappedAddress) String() string {
+	start, end := ma.GetStartingAddressBytes(), ma.GetEndingAddressBytes()
+	addrFmt := "0x%011X"
+	if ma.extended() {
+		addrFmt = "0x%016X"
+	}
+	sizeStr := "Invalid"
+	if end > start {
+		sizeStr = memorySize(end - start + 1)
+	}
+	lines := []string{
+		ma.Header.String(),
+		fmt.Sprintf("Starting Address: "+addrFmt, start),
+		fmt.Sprintf("Ending Address: "+addrFmt, end),
+		fmt.Sprintf("Range Size: %s", sizeStr),
+		fmt.Sprintf("Physical Array Handle: 0x%04X", ma.MemoryArrayHandle),
+		fmt.Sprintf("Partition Width: %d", ma.PartitionWidth),
+	}
+	return strings.Join(lines, "\n\t")
+}
@@ -0,0 +1,102 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package smbios
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// MemoryDeviceMappedAddress is defined in DSP0134 7.21.
+type MemoryDeviceMappedAddress struct {
+	Table
+	StartingAddress                uint32 // 04h
+	EndingAddress                  uint32 // 08h
+	MemoryDeviceHandle             uint16 // 0Ch
+	MemoryArrayMappedAddressHandle uint16 // 0Eh
+	PartitionRowPosition           uint8  // 10h
+	InterleavePosition             uint8  // 11h
+	InterleavedDataDepth           uint8  // 12h
+	ExtendedStartingAddress        uint64 // 13h
+	ExtendedEndingAddress          uint64 // 1Bh
+}
+
+// ParseMemoryDeviceMappedAddress parses a generic Table into
+// MemoryDeviceMappedAddress.
+func ParseMemoryDeviceMappedAddress(t *Table) (*MemoryDeviceMappedAddress, error) {
+	if t.Type != TableTypeMemoryDeviceMappedAddress {
+		return nil, fmt.Errorf("invalid table type %d", t.Type)
+	}
+	if t.Len() < 0x13 {
+		return nil, errors.New("required fields missing")
+	}
+	md := &MemoryDeviceMappedAddress{Table: *t}
+	if _, err := parseStruct(t, 0 /* off */, false /* complete */, md); err != nil {
+		return nil, err
+	}
+	return md, nil
+}
+
+func (md *MemoryDeviceMappedAddress) extended() bool {
+	return md.StartingAddress == 0xffffffff && md.Len() >= 0x23
+}
+
+// GetStartingAddressBytes returns the physical address of the first byte
+// of the range.
+func (md *MemoryDeviceMappedAddress) GetStartingAddressBytes() uint64 {
+	if md.extended() {
+		return md.ExtendedStartingAddress
+	}
+	return uint64(md.StartingAddress) << 10
+}
+
+// GetEndingAddressBytes returns the physical address of the last byte of
+// the range.
+func (md *MemoryDeviceMappedAddress) GetEndingAddressBytes() uint64 {
+	if md.extended() {
+		return md.ExtendedEndingAddress
+	}
+	return uint64(md.EndingAddress)<<10 + 0x3ff
+}
+
+func (md *MemoryDeviceMappedAddress) String() string {
+	start, end := md.GetStartingAddressBytes(), md.GetEndingAddressBytes()
+	addrFmt := "0x%011X"
+	if md.extended() {
+		addrFmt = "0x%016X"
+	}
+	sizeStr := "Invalid"
+	if end > start {
+		sizeStr = memorySize(end - start + 1)
+	}
+	position := func(v uint8) string {
+		if v == 0xff {
+			return "Unknown"
+		}
+		return fmt.Sprintf("%d", v)
+	}
+	row := outOfSpec
+	if md.PartitionRowPosition != 0 {
+		row = position(md.PartitionRowPosition)
+	}
+	lines := []string{
+		md.Header.String(),
+		fmt.Sprintf("Starting Address: "+addrFmt, start),
+		fmt.Sprintf("Ending Address: "+addrFmt, end),
+		fmt.Sprintf("Range Size: %s", sizeStr),
+		fmt.Sprintf("Physical Device Handle: 0x%04X", md.MemoryDeviceHandle),
+		fmt.Sprintf("Memory Array Mapped Address Handle: 0x%04X", md.MemoryArrayMappedAddressHandle),
+		fmt.Sprintf("Partition Row Position: %s", row),
+	}
+	// Zero means the device is not interleaved.
+	if md.InterleavePosition != 0 {
+		lines = append(lines, fmt.Sprintf("Interleave Position: %s", position(md.InterleavePosition)))
+	}
+	if md.InterleavedDataDepth != 0 {
+		lines = append(lines, fmt.Sprintf("Interleaved Data Depth: %s", position(md.InterleavedDataDepth)))
+	}
+	return strings.Join(lines, "\n\t")
+}
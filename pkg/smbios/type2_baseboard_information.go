@@ -52,6 +52,7 @@ func ParseBaseboardInfo(t *Table) (*BaseboardInfo, error) {
 				return nil, err
 			}
 			bi.ContainedObjectHandles = append(bi.ContainedObjectHandles, h)
+			off += 2
 		}
 	}
 	return bi, nil
@@ -64,15 +65,29 @@ func (bi *BaseboardInfo) String() string {
 		fmt.Sprintf("Product Name: %s", bi.Product),
 		fmt.Sprintf("Version: %s", bi.Version),
 		fmt.Sprintf("Serial Number: %s", bi.SerialNumber),
-		fmt.Sprintf("Asset Tag: %s", bi.AssetTag),
-		fmt.Sprintf("Features:\n%s", bi.BoardFeatures),
-		fmt.Sprintf("Location In Chassis: %s", bi.LocationInChassis),
-		fmt.Sprintf("Chassis Handle: 0x%04X", bi.ChassisHandle),
-		fmt.Sprintf("Type: %s", bi.BoardType),
-		fmt.Sprintf("Contained Object Handles: %d", bi.NumberOfContainedObjectHandles),
+	}
+	if bi.Len() >= 0x9 {
+		lines = append(lines, fmt.Sprintf("Asset Tag: %s", bi.AssetTag))
+	}
+	if bi.Len() >= 0xa {
+		if bi.BoardFeatures&0x1f == 0 {
+			lines = append(lines, "Features: None")
+		} else {
+			lines = append(lines, fmt.Sprintf("Features:\n%s", bi.BoardFeatures))
+		}
+	}
+	if bi.Len() >= 0xe {
+		lines = append(lines,
+			fmt.Sprintf("Location In Chassis: %s", bi.LocationInChassis),
+			fmt.Sprintf("Chassis Handle: 0x%04X", bi.ChassisHandle),
+			fmt.Sprintf("Type: %s", bi.BoardType),
+		)
+	}
+	if bi.Len() >= 0xf {
+		lines = append(lines, fmt.Sprintf("Contained Object Handles: %d", bi.NumberOfContainedObjectHandles))
 	}
 	for _, h := range bi.ContainedObjectHandles {
-		lines = append(lines, fmt.Sprintf("0x%04X", h))
+		lines = append(lines, fmt.Sprintf("\t0x%04X", h))
 	}
 	return strings.Join(lines, "\n\t")
 }
@@ -148,5 +163,5 @@ func (v BoardType) String() string {
 	if name, ok := names[v]; ok {
 		return name
 	}
-	return fmt.Sprintf("%#x", uint8(v))
+	return outOfSpec
 }
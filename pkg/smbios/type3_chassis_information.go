@@ -89,7 +89,7 @@ func (si *ChassisInfo) String() string {
 		fmt.Sprintf("Serial Number: %s", si.SerialNumber),
 		fmt.Sprintf("Asset Tag: %s", si.AssetTagNumber),
 	}
-	if si.Len() >= 9 { // 2.1+
+	if si.Len() >= 0xd { // 2.1+
 		lines = append(lines,
 			fmt.Sprintf("Boot-up State: %s", si.BootupState),
 			fmt.Sprintf("Power Supply State: %s", si.PowerSupplyState),
@@ -97,7 +97,10 @@ func (si *ChassisInfo) String() string {
 			fmt.Sprintf("Security Status: %s", si.SecurityStatus),
 		)
 	}
-	if si.Len() >= 0xd { // 2.3+
+	if si.Len() >= 0x11 { // 2.3+
+		lines = append(lines, fmt.Sprintf("OEM Information: 0x%08X", si.OEMInfo))
+	}
+	if si.Len() >= 0x13 {
 		heightStr, numPCStr := "Unspecified", "Unspecified"
 		if si.Height != 0 {
 			heightStr = fmt.Sprintf("%d U", si.Height)
@@ -106,17 +109,16 @@ func (si *ChassisInfo) String() string {
 			numPCStr = fmt.Sprintf("%d", si.NumberOfPowerCords)
 		}
 		lines = append(lines,
-			fmt.Sprintf("OEM Information: 0x%08X", si.OEMInfo),
 			fmt.Sprintf("Height: %s", heightStr),
 			fmt.Sprintf("Number Of Power Cords: %s", numPCStr),
 		)
+	}
+	if si.Len() >= 0x15 && si.Len() >= 0x15+int(si.ContainedElementCount)*int(si.ContainedElementsRecordLength) {
 		lines = append(lines,
 			fmt.Sprintf("Contained Elements: %d", si.ContainedElementCount),
 		)
 		for _, e := range si.ContainedElements {
-			lines = append(lines,
-				fmt.Sprintf("\t%s %d-%d", e.Type, e.Min, e.Max),
-			)
+			lines = append(lines, fmt.Sprintf("\t%s", e))
 		}
 	}
 	if si.Len() > 0x15+int(si.ContainedElementCount)*int(si.ContainedElementsRecordLength) {
@@ -302,6 +304,13 @@ func (v ChassisSecurityStatus) String() string {
 	return fmt.Sprintf("%#x", uint8(v))
 }
 
+func (e ChassisContainedElement) String() string {
+	if e.Min == e.Max {
+		return fmt.Sprintf("%s (%d)", e.Type, e.Min)
+	}
+	return fmt.Sprintf("%s (%d-%d)", e.Type, e.Min, e.Max)
+}
+
 // ChassisElementType is defined in DSP0134 7.4.4.
 type ChassisElementType uint8
 
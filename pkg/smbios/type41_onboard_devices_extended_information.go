@@ -0,0 +1,98 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package smbios
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// OnboardDevice is defined in DSP0134 7.42.
+type OnboardDevice struct {
+	Table
+	ReferenceDesignation string            // 04h
+	DeviceType           OnboardDeviceType // 05h
+	DeviceTypeInstance   uint8             // 06h
+	SegmentGroupNumber   uint16            // 07h
+	BusNumber            uint8             // 09h
+	DeviceFunctionNumber uint8             // 0Ah
+}
+
+// ParseOnboardDevice parses a generic Table into OnboardDevice.
+func ParseOnboardDevice(t *Table) (*OnboardDevice, error) {
+	if t.Type != TableTypeOnboardDevice {
+		return nil, fmt.Errorf("invalid table type %d", t.Type)
+	}
+	if t.Len() < 0xb {
+		return nil, errors.New("required fields missing")
+	}
+	od := &OnboardDevice{Table: *t}
+	if _, err := parseStruct(t, 0 /* off */, false /* complete */, od); err != nil {
+		return nil, err
+	}
+	return od, nil
+}
+
+// Enabled reports whether the device is enabled.
+func (od *OnboardDevice) Enabled() bool {
+	return od.DeviceType&0x80 != 0
+}
+
+// BusAddress returns the PCI address of the device in the usual
+// segment:bus:device.function form, or an empty string if there is none.
+func (od *OnboardDevice) BusAddress() string {
+	if od.SegmentGroupNumber == 0xffff && od.BusNumber == 0xff && od.DeviceFunctionNumber == 0xff {
+		return ""
+	}
+	return busAddress(od.SegmentGroupNumber, od.BusNumber, od.DeviceFunctionNumber)
+}
+
+func (od *OnboardDevice) String() string {
+	status := "Disabled"
+	if od.Enabled() {
+		status = "Enabled"
+	}
+	lines := []string{
+		od.Header.String(),
+		fmt.Sprintf("Reference Designation: %s", od.ReferenceDesignation),
+		fmt.Sprintf("Type: %s", od.DeviceType),
+		fmt.Sprintf("Status: %s", status),
+		fmt.Sprintf("Type Instance: %d", od.DeviceTypeInstance),
+	}
+	if a := od.BusAddress(); a != "" {
+		lines = append(lines, fmt.Sprintf("Bus Address: %s", a))
+	}
+	return strings.Join(lines, "\n\t")
+}
+
+// OnboardDeviceType is defined in DSP0134 7.42.2. The high bit is the
+// device status.
+type OnboardDeviceType uint8
+
+func (v OnboardDeviceType) String() string {
+	names := []string{
+		"Other", // 0x01
+		"Unknown",
+		"Video",
+		"SCSI Controller",
+		"Ethernet",
+		"Token Ring",
+		"Sound",
+		"PATA Controller",
+		"SATA Controller",
+		"SAS Controller",
+		"Wireless LAN",
+		"Bluetooth",
+		"WWAN",
+		"eMMC",
+		"NVMe Controller",
+		"UFS Controller", // 0x10
+	}
+	if t := int(v & 0x7f); t >= 1 && t <= len(names) {
+		return names[t-1]
+	}
+	return outOfSpec
+}
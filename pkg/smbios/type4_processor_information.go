@@ -252,7 +252,11 @@ func (pi *ProcessorInfo) String() string {
 		if pi.GetThreadCount() > 0 {
 			lines = append(lines, fmt.Sprintf("Thread Count: %d", pi.GetThreadCount()))
 		}
-		lines = append(lines, fmt.Sprintf("Characteristics:\n%s", pi.Characteristics))
+		if pi.Characteristics&^(ProcessorCharacteristicsReserved|ProcessorCharacteristicsUnknown) == 0 {
+			lines = append(lines, "Characteristics: None")
+		} else {
+			lines = append(lines, fmt.Sprintf("Characteristics:\n%s", pi.Characteristics))
+		}
 	}
 	return strings.Join(lines, "\n\t")
 }
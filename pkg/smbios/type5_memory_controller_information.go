@@ -0,0 +1,194 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package smbios
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// MemoryControllerInfo is defined in DSP0134 7.6. It is obsolete, newer
+// systems describe their memory with PhysicalMemoryArray.
+type MemoryControllerInfo struct {
+	Table
+	ErrorDetectingMethod               MemoryControllerErrorDetectingMethod        // 04h
+	ErrorCorrectingCapabilities        MemoryControllerErrorCorrectingCapabilities // 05h
+	SupportedInterleave                MemoryControllerInterleave                  // 06h
+	CurrentInterleave                  MemoryControllerInterleave                  // 07h
+	MaximumMemoryModuleSize            uint8                                       // 08h
+	SupportedSpeeds                    MemoryControllerSpeeds                      // 09h
+	SupportedMemoryTypes               MemoryModuleTypes                           // 0Bh
+	MemoryModuleVoltage                MemoryModuleVoltage                         // 0Dh
+	NumberOfAssociatedMemorySlots      uint8                                       // 0Eh
+	MemoryModuleConfigurationHandles   []uint16                                    `smbios:"-"` // 0Fh
+	EnabledErrorCorrectingCapabilities MemoryControllerErrorCorrectingCapabilities `smbios:"-"` // 0Fh + 2n
+}
+
+// ParseMemoryControllerInfo parses a generic Table into MemoryControllerInfo.
+func ParseMemoryControllerInfo(t *Table) (*MemoryControllerInfo, error) {
+	if t.Type != TableTypeMemoryControllerInfo {
+		return nil, fmt.Errorf("invalid table type %d", t.Type)
+	}
+	if t.Len() < 0xf {
+		return nil, errors.New("required fields missing")
+	}
+	mc := &MemoryControllerInfo{Table: *t}
+	off, err := parseStruct(t, 0 /* off */, false /* complete */, mc)
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < int(mc.NumberOfAssociatedMemorySlots); i++ {
+		h, err := t.GetWordAt(off)
+		if err != nil {
+			return nil, fmt.Errorf("memory module handle %d missing", i)
+		}
+		mc.MemoryModuleConfigurationHandles = append(mc.MemoryModuleConfigurationHandles, h)
+		off += 2
+	}
+	if v, err := t.GetByteAt(off); err == nil {
+		mc.EnabledErrorCorrectingCapabilities = MemoryControllerErrorCorrectingCapabilities(v)
+	}
+	return mc, nil
+}
+
+// GetMaximumMemoryModuleSizeBytes returns the size of the largest memory
+// module the controller supports, in bytes.
+func (mc *MemoryControllerInfo) GetMaximumMemoryModuleSizeBytes() uint64 {
+	return (1 << mc.MaximumMemoryModuleSize) << 20
+}
+
+// GetMaximumTotalMemorySizeBytes returns the largest amount of memory the
+// controller supports, in bytes.
+func (mc *MemoryControllerInfo) GetMaximumTotalMemorySizeBytes() uint64 {
+	return mc.GetMaximumMemoryModuleSizeBytes() * uint64(mc.NumberOfAssociatedMemorySlots)
+}
+
+// listField formats a field with a list of values, one per line, or None.
+func listField(name string, values []string) string {
+	if len(values) == 0 {
+		return name + ": None"
+	}
+	return name + ":\n\t\t" + strings.Join(values, "\n\t\t")
+}
+
+func (mc *MemoryControllerInfo) String() string {
+	var handles []string
+	for _, h := range mc.MemoryModuleConfigurationHandles {
+		handles = append(handles, fmt.Sprintf("0x%04X", h))
+	}
+	lines := []string{
+		mc.Header.String(),
+		fmt.Sprintf("Error Detecting Method: %s", mc.ErrorDetectingMethod),
+		listField("Error Correcting Capabilities", mc.ErrorCorrectingCapabilities.names()),
+		fmt.Sprintf("Supported Interleave: %s", mc.SupportedInterleave),
+		fmt.Sprintf("Current Interleave: %s", mc.CurrentInterleave),
+		fmt.Sprintf("Maximum Memory Module Size: %d MB", mc.GetMaximumMemoryModuleSizeBytes()>>20),
+		fmt.Sprintf("Maximum Total Memory Size: %d MB", mc.GetMaximumTotalMemorySizeBytes()>>20),
+		listField("Supported Speeds", mc.SupportedSpeeds.names()),
+		listField("Supported Memory Types", mc.SupportedMemoryTypes.names()),
+		fmt.Sprintf("Memory Module Voltage: %s", mc.MemoryModuleVoltage),
+		fmt.Sprintf("Associated Memory Slots: %d", mc.NumberOfAssociatedMemorySlots),
+	}
+	for _, h := range handles {
+		lines = append(lines, "\t"+h)
+	}
+	if mc.Len() > 0xf+2*len(handles) {
+		lines = append(lines, listField("Enabled Error Correcting Capabilities", mc.EnabledErrorCorrectingCapabilities.names()))
+	}
+	return strings.Join(lines, "\n\t")
+}
+
+// bitNames returns the names of the bits set in v, names[i] being that of
+// bit i.
+func bitNames(v uint, names []string) []string {
+	var res []string
+	for i, n := range names {
+		if v&(1<<uint(i)) != 0 {
+			res = append(res, n)
+		}
+	}
+	return res
+}
+
+// MemoryControllerErrorDetectingMethod is defined in DSP0134 7.6.1.
+type MemoryControllerErrorDetectingMethod uint8
+
+func (v MemoryControllerErrorDetectingMethod) String() string {
+	names := []string{
+		"Other", // 0x01
+		"Unknown",
+		"None",
+		"8-bit Parity",
+		"32-bit ECC",
+		"64-bit ECC",
+		"128-bit ECC",
+		"CRC", // 0x08
+	}
+	if v >= 1 && int(v) <= len(names) {
+		return names[v-1]
+	}
+	return outOfSpec
+}
+
+// MemoryControllerErrorCorrectingCapabilities is defined in DSP0134 7.6.2.
+type MemoryControllerErrorCorrectingCapabilities uint8
+
+func (v MemoryControllerErrorCorrectingCapabilities) names() []string {
+	return bitNames(uint(v), []string{
+		"Other",
+		"Unknown",
+		"None",
+		"Single-bit Error Correcting",
+		"Double-bit Error Correcting",
+		"Error Scrubbing",
+	})
+}
+
+func (v MemoryControllerErrorCorrectingCapabilities) String() string {
+	return strings.Join(v.names(), ", ")
+}
+
+// MemoryControllerInterleave is defined in DSP0134 7.6.3.
+type MemoryControllerInterleave uint8
+
+func (v MemoryControllerInterleave) String() string {
+	names := []string{
+		"Other", // 0x01
+		"Unknown",
+		"One-way Interleave",
+		"Two-way Interleave",
+		"Four-way Interleave",
+		"Eight-way Interleave",
+		"Sixteen-way Interleave", // 0x07
+	}
+	if v >= 1 && int(v) <= len(names) {
+		return names[v-1]
+	}
+	return outOfSpec
+}
+
+// MemoryControllerSpeeds is defined in DSP0134 7.6.4.
+type MemoryControllerSpeeds uint16
+
+func (v MemoryControllerSpeeds) names() []string {
+	return bitNames(uint(v), []string{"Other", "Unknown", "70 ns", "60 ns", "50 ns"})
+}
+
+func (v MemoryControllerSpeeds) String() string {
+	return strings.Join(v.names(), ", ")
+}
+
+// MemoryModuleVoltage is the Memory Module Voltage field of DSP0134 7.6:
+// the voltages of the memory modules the controller supports.
+type MemoryModuleVoltage uint8
+
+func (v MemoryModuleVoltage) String() string {
+	volts := bitNames(uint(v), []string{"5.0 V", "3.3 V", "2.9 V"})
+	if len(volts) == 0 {
+		return "Unknown"
+	}
+	return strings.Join(volts, " ")
+}
@@ -0,0 +1,134 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package smbios
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// MemoryModuleInfo is defined in DSP0134 7.7. It is obsolete, newer systems
+// describe their memory with MemoryDevice.
+type MemoryModuleInfo struct {
+	Table
+	SocketDesignation string                  // 04h
+	BankConnections   uint8                   // 05h
+	CurrentSpeed      uint8                   // 06h
+	CurrentMemoryType MemoryModuleTypes       // 07h
+	InstalledSize     MemoryModuleSize        // 09h
+	EnabledSize       MemoryModuleSize        // 0Ah
+	ErrorStatus       MemoryModuleErrorStatus // 0Bh
+}
+
+// ParseMemoryModuleInfo parses a generic Table into MemoryModuleInfo.
+func ParseMemoryModuleInfo(t *Table) (*MemoryModuleInfo, error) {
+	if t.Type != TableTypeMemoryModuleInfo {
+		return nil, fmt.Errorf("invalid table type %d", t.Type)
+	}
+	if t.Len() < 0xc {
+		return nil, errors.New("required fields missing")
+	}
+	mm := &MemoryModuleInfo{Table: *t}
+	if _, err := parseStruct(t, 0 /* off */, false /* complete */, mm); err != nil {
+		return nil, err
+	}
+	return mm, nil
+}
+
+// bankConnections formats the RAS# lines of a module, up to two, or None.
+func bankConnections(v uint8) string {
+	if v == 0xff {
+		return "None"
+	}
+	var banks []string
+	if v>>4 != 0xf {
+		banks = append(banks, fmt.Sprintf("%d", v>>4))
+	}
+	if v&0xf != 0xf {
+		banks = append(banks, fmt.Sprintf("%d", v&0xf))
+	}
+	return strings.Join(banks, " ")
+}
+
+func (mm *MemoryModuleInfo) String() string {
+	speed := "Unknown"
+	if mm.CurrentSpeed != 0 {
+		speed = fmt.Sprintf("%d ns", mm.CurrentSpeed)
+	}
+	types := mm.CurrentMemoryType.String()
+	if types == "" {
+		types = "None"
+	}
+	lines := []string{
+		mm.Header.String(),
+		fmt.Sprintf("Socket Designation: %s", mm.SocketDesignation),
+		fmt.Sprintf("Bank Connections: %s", bankConnections(mm.BankConnections)),
+		fmt.Sprintf("Current Speed: %s", speed),
+		fmt.Sprintf("Type: %s", types),
+		fmt.Sprintf("Installed Size: %s", mm.InstalledSize),
+		fmt.Sprintf("Enabled Size: %s", mm.EnabledSize),
+		fmt.Sprintf("Error Status: %s", mm.ErrorStatus),
+	}
+	return strings.Join(lines, "\n\t")
+}
+
+// MemoryModuleTypes is defined in DSP0134 7.7.1.
+type MemoryModuleTypes uint16
+
+func (v MemoryModuleTypes) names() []string {
+	return bitNames(uint(v), []string{
+		"Other",
+		"Unknown",
+		"Standard",
+		"FPM",
+		"EDO",
+		"Parity",
+		"ECC",
+		"SIMM",
+		"DIMM",
+		"Burst EDO",
+		"SDRAM",
+	})
+}
+
+func (v MemoryModuleTypes) String() string {
+	return strings.Join(v.names(), " ")
+}
+
+// MemoryModuleSize is defined in DSP0134 7.7.2.
+type MemoryModuleSize uint8
+
+func (v MemoryModuleSize) String() string {
+	var size string
+	switch v & 0x7f {
+	case 0x7d:
+		return "Not Determinable"
+	case 0x7e:
+		return "Disabled"
+	case 0x7f:
+		return "Not Installed"
+	default:
+		size = fmt.Sprintf("%d MB", 1<<uint(v&0x7f))
+	}
+	if v&0x80 != 0 {
+		return size + " (Double-bank Connection)"
+	}
+	return size + " (Single-bank Connection)"
+}
+
+// MemoryModuleErrorStatus is defined in DSP0134 7.7.3.
+type MemoryModuleErrorStatus uint8
+
+func (v MemoryModuleErrorStatus) String() string {
+	if v&(1<<2) != 0 {
+		return "See Event Log"
+	}
+	errs := bitNames(uint(v), []string{"Uncorrectable Errors", "Correctable Errors"})
+	if len(errs) == 0 {
+		return "OK"
+	}
+	return strings.Join(errs, ", ")
+}
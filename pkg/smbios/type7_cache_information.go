@@ -115,8 +115,8 @@ func (ci *CacheInfo) String() string {
 		fmt.Sprintf("Configuration: %s, %s, Level %d", enDis, sock, (ci.Configuration&7)+1),
 		fmt.Sprintf("Operational Mode: %s", om),
 		fmt.Sprintf("Location: %s", loc),
-		fmt.Sprintf("Installed Size: %s", kmgt(ci.GetInstalledSizeBytes())),
-		fmt.Sprintf("Maximum Size: %s", kmgt(ci.GetMaxSizeBytes())),
+		fmt.Sprintf("Installed Size: %s", cacheSize(ci.GetInstalledSizeBytes())),
+		fmt.Sprintf("Maximum Size: %s", cacheSize(ci.GetMaxSizeBytes())),
 		fmt.Sprintf("Supported SRAM Types:\n%s", ci.SupportedSRAMType),
 		fmt.Sprintf("Installed SRAM Type: %s", strings.TrimSpace(ci.CurrentSRAMType.String())),
 	}
@@ -268,3 +268,12 @@ func (v CacheAssociativity) String() string {
 	}
 	return fmt.Sprintf("%#x", uint8(v))
 }
+
+// cacheSize formats a cache size; sizes are counted in kB, so even an
+// absent cache is "0 kB".
+func cacheSize(v uint64) string {
+	if v == 0 {
+		return "0 kB"
+	}
+	return memorySize(v)
+}
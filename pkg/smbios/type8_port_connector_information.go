@@ -0,0 +1,164 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package smbios
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// PortConnectorInfo is defined in DSP0134 7.9.
+type PortConnectorInfo struct {
+	Table
+	InternalReferenceDesignator string        // 04h
+	InternalConnectorType       ConnectorType // 05h
+	ExternalReferenceDesignator string        // 06h
+	ExternalConnectorType       ConnectorType // 07h
+	PortType                    PortType      // 08h
+}
+
+// ParsePortConnectorInfo parses a generic Table into PortConnectorInfo.
+func ParsePortConnectorInfo(t *Table) (*PortConnectorInfo, error) {
+	if t.Type != TableTypePortConnectorInfo {
+		return nil, fmt.Errorf("invalid table type %d", t.Type)
+	}
+	if t.Len() < 0x9 {
+		return nil, errors.New("required fields missing")
+	}
+	pi := &PortConnectorInfo{Table: *t}
+	if _, err := parseStruct(t, 0 /* off */, false /* complete */, pi); err != nil {
+		return nil, err
+	}
+	return pi, nil
+}
+
+func (pi *PortConnectorInfo) String() string {
+	lines := []string{
+		pi.Header.String(),
+		fmt.Sprintf("Internal Reference Designator: %s", pi.InternalReferenceDesignator),
+		fmt.Sprintf("Internal Connector Type: %s", pi.InternalConnectorType),
+		fmt.Sprintf("External Reference Designator: %s", pi.ExternalReferenceDesignator),
+		fmt.Sprintf("External Connector Type: %s", pi.ExternalConnectorType),
+		fmt.Sprintf("Port Type: %s", pi.PortType),
+	}
+	return strings.Join(lines, "\n\t")
+}
+
+// ConnectorType is defined in DSP0134 7.9.2.
+type ConnectorType uint8
+
+var connectorTypeNames = []string{
+	"None", // 0x00
+	"Centronics",
+	"Mini Centronics",
+	"Proprietary",
+	"DB-25 male",
+	"DB-25 female",
+	"DB-15 male",
+	"DB-15 female",
+	"DB-9 male",
+	"DB-9 female",
+	"RJ-11",
+	"RJ-45",
+	"50 Pin MiniSCSI",
+	"Mini DIN",
+	"Micro DIN",
+	"PS/2",
+	"Infrared",
+	"HP-HIL",
+	"Access Bus (USB)",
+	"SSA SCSI",
+	"Circular DIN-8 male",
+	"Circular DIN-8 female",
+	"On Board IDE",
+	"On Board Floppy",
+	"9 Pin Dual Inline (pin 10 cut)",
+	"25 Pin Dual Inline (pin 26 cut)",
+	"50 Pin Dual Inline",
+	"68 Pin Dual Inline",
+	"On Board Sound Input From CD-ROM",
+	"Mini Centronics Type-14",
+	"Mini Centronics Type-26",
+	"Mini Jack (headphones)",
+	"BNC",
+	"IEEE 1394",
+	"SAS/SATA Plug Receptacle",
+	"USB Type-C Receptacle", // 0x23
+}
+
+var connectorTypePC98Names = []string{
+	"PC-98", // 0xA0
+	"PC-98 Hireso",
+	"PC-H98",
+	"PC-98 Note",
+	"PC-98 Full", // 0xA4
+}
+
+func (v ConnectorType) String() string {
+	switch {
+	case int(v) < len(connectorTypeNames):
+		return connectorTypeNames[v]
+	case v >= 0xa0 && int(v-0xa0) < len(connectorTypePC98Names):
+		return connectorTypePC98Names[v-0xa0]
+	case v == 0xff:
+		return "Other"
+	}
+	return outOfSpec
+}
+
+// PortType is defined in DSP0134 7.9.3.
+type PortType uint8
+
+var portTypeNames = []string{
+	"None", // 0x00
+	"Parallel Port XT/AT Compatible",
+	"Parallel Port PS/2",
+	"Parallel Port ECP",
+	"Parallel Port EPP",
+	"Parallel Port ECP/EPP",
+	"Serial Port XT/AT Compatible",
+	"Serial Port 16450 Compatible",
+	"Serial Port 16550 Compatible",
+	"Serial Port 16550A Compatible",
+	"SCSI Port",
+	"MIDI Port",
+	"Joystick Port",
+	"Keyboard Port",
+	"Mouse Port",
+	"SSA SCSI",
+	"USB",
+	"Firewire (IEEE P1394)",
+	"PCMCIA Type I",
+	"PCMCIA Type II",
+	"PCMCIA Type III",
+	"Cardbus",
+	"Access Bus Port",
+	"SCSI II",
+	"SCSI Wide",
+	"PC-98",
+	"PC-98 Hireso",
+	"PC-H98",
+	"Video Port",
+	"Audio Port",
+	"Modem Port",
+	"Network Port",
+	"SATA",
+	"SAS", // 0x21
+}
+
+func (v PortType) String() string {
+	switch {
+	case int(v) < len(portTypeNames):
+		return portTypeNames[v]
+	case v == 0xa0:
+		return "8251 Compatible"
+	case v == 0xa1:
+		return "8251 FIFO Compatible"
+	case v == 0xff:
+		return "Other"
+	}
+	return outOfSpec
+}
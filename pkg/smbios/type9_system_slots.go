@@ -0,0 +1,324 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package smbios
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// SystemSlots is defined in DSP0134 7.10.
+type SystemSlots struct {
+	Table
+	SlotDesignation      string               // 04h
+	SlotType             SlotType             // 05h
+	SlotDataBusWidth     SlotDataBusWidth     // 06h
+	CurrentUsage         SlotUsage            // 07h
+	SlotLength           SlotLength           // 08h
+	SlotID               uint16               // 09h
+	SlotCharacteristics1 SlotCharacteristics1 // 0Bh
+	SlotCharacteristics2 SlotCharacteristics2 // 0Ch
+	SegmentGroupNumber   uint16               // 0Dh
+	BusNumber            uint8                // 0Fh
+	DeviceFunctionNumber uint8                // 10h
+}
+
+// ParseSystemSlots parses a generic Table into SystemSlots.
+func ParseSystemSlots(t *Table) (*SystemSlots, error) {
+	if t.Type != TableTypeSystemSlots {
+		return nil, fmt.Errorf("invalid table type %d", t.Type)
+	}
+	if t.Len() < 0xc {
+		return nil, errors.New("required fields missing")
+	}
+	ss := &SystemSlots{Table: *t}
+	if _, err := parseStruct(t, 0 /* off */, false /* complete */, ss); err != nil {
+		return nil, err
+	}
+	return ss, nil
+}
+
+// BusAddress returns the PCI address of the slot in the usual
+// segment:bus:device.function form, or an empty string if there is none.
+func (ss *SystemSlots) BusAddress() string {
+	if ss.Len() < 0x11 || (ss.SegmentGroupNumber == 0xffff && ss.BusNumber == 0xff && ss.DeviceFunctionNumber == 0xff) {
+		return ""
+	}
+	return busAddress(ss.SegmentGroupNumber, ss.BusNumber, ss.DeviceFunctionNumber)
+}
+
+func busAddress(segment uint16, bus, devfn uint8) string {
+	return fmt.Sprintf("%04x:%02x:%02x.%x", segment, bus, devfn>>3, devfn&7)
+}
+
+func (ss *SystemSlots) String() string {
+	lines := []string{
+		ss.Header.String(),
+		fmt.Sprintf("Designation: %s", ss.SlotDesignation),
+		fmt.Sprintf("Type: %s%s", ss.SlotDataBusWidth, ss.SlotType),
+		fmt.Sprintf("Current Usage: %s", ss.CurrentUsage),
+		fmt.Sprintf("Length: %s", ss.SlotLength),
+	}
+	id, id2 := uint8(ss.SlotID), uint8(ss.SlotID>>8)
+	switch t := ss.SlotType; {
+	case t == SlotTypePCCard:
+		lines = append(lines, fmt.Sprintf("ID: Adapter %d, Socket %d", id, id2))
+	case t == SlotTypeMCA || t == SlotTypeEISA || t.isPCI():
+		lines = append(lines, fmt.Sprintf("ID: %d", id))
+	}
+	c2 := ss.SlotCharacteristics2
+	if ss.Len() < 0xd {
+		c2 = 0
+	}
+	switch {
+	case ss.SlotCharacteristics1&SlotCharacteristics1Unknown != 0:
+		lines = append(lines, "Characteristics: Unknown")
+	case ss.SlotCharacteristics1&^SlotCharacteristics1Unknown == 0 && c2 == 0:
+		lines = append(lines, "Characteristics: None")
+	default:
+		var cs []string
+		if s := ss.SlotCharacteristics1.String(); s != "" {
+			cs = append(cs, s)
+		}
+		if s := c2.String(); s != "" {
+			cs = append(cs, s)
+		}
+		lines = append(lines, "Characteristics:\n\t\t"+strings.Join(cs, "\n\t\t"))
+	}
+	if a := ss.BusAddress(); a != "" {
+		lines = append(lines, fmt.Sprintf("Bus Address: %s", a))
+	}
+	return strings.Join(lines, "\n\t")
+}
+
+// SlotType is defined in DSP0134 7.10.1.
+type SlotType uint8
+
+// Some of the SlotType values defined in DSP0134 7.10.1.
+const (
+	SlotTypeMCA    SlotType = 0x04
+	SlotTypeEISA   SlotType = 0x05
+	SlotTypePCI    SlotType = 0x06
+	SlotTypePCCard SlotType = 0x07
+)
+
+var slotTypeNames = []string{
+	"Other", // 0x01
+	"Unknown",
+	"ISA",
+	"MCA",
+	"EISA",
+	"PCI",
+	"PC Card (PCMCIA)",
+	"VLB",
+	"Proprietary",
+	"Processor Card",
+	"Proprietary Memory Card",
+	"I/O Riser Card",
+	"NuBus",
+	"PCI-66",
+	"AGP",
+	"AGP 2x",
+	"AGP 4x",
+	"PCI-X",
+	"AGP 8x",
+	"M.2 Socket 1-DP",
+	"M.2 Socket 1-SD",
+	"M.2 Socket 2",
+	"M.2 Socket 3",
+	"MXM Type I",
+	"MXM Type II",
+	"MXM Type III",
+	"MXM Type III-HE",
+	"MXM Type IV",
+	"MXM 3.0 Type A",
+	"MXM 3.0 Type B",
+	"PCI Express 2 SFF-8639",
+	"PCI Express 3 SFF-8639",
+	"PCI Express Mini 52-pin with bottom-side keep-outs",
+	"PCI Express Mini 52-pin without bottom-side keep-outs",
+	"PCI Express Mini 76-pin", // 0x23
+}
+
+var slotTypeA0Names = []string{
+	"PC-98/C20", // 0xA0
+	"PC-98/C24",
+	"PC-98/E",
+	"PC-98/Local Bus",
+	"PC-98/Card",
+	"PCI Express",
+	"PCI Express x1",
+	"PCI Express x2",
+	"PCI Express x4",
+	"PCI Express x8",
+	"PCI Express x16",
+	"PCI Express 2",
+	"PCI Express 2 x1",
+	"PCI Express 2 x2",
+	"PCI Express 2 x4",
+	"PCI Express 2 x8",
+	"PCI Express 2 x16",
+	"PCI Express 3",
+	"PCI Express 3 x1",
+	"PCI Express 3 x2",
+	"PCI Express 3 x4",
+	"PCI Express 3 x8",
+	"PCI Express 3 x16",
+	"", // 0xB7 is not defined
+	"PCI Express 4",
+	"PCI Express 4 x1",
+	"PCI Express 4 x2",
+	"PCI Express 4 x4",
+	"PCI Express 4 x8",
+	"PCI Express 4 x16", // 0xBD
+}
+
+// isPCI reports whether slots of this type are identified by a PCI slot
+// number.
+func (v SlotType) isPCI() bool {
+	return v == SlotTypePCI || (v >= 0x0e && v <= 0x13) || (v >= 0x1f && v <= 0x23) || (v >= 0xa5 && v <= 0xbd)
+}
+
+func (v SlotType) String() string {
+	switch {
+	case v >= 0x01 && int(v) <= len(slotTypeNames):
+		return slotTypeNames[v-1]
+	case v >= 0xa0 && int(v-0xa0) < len(slotTypeA0Names) && slotTypeA0Names[v-0xa0] != "":
+		return slotTypeA0Names[v-0xa0]
+	}
+	return outOfSpec
+}
+
+// SlotDataBusWidth is defined in DSP0134 7.10.2.
+type SlotDataBusWidth uint8
+
+var slotDataBusWidthNames = []string{
+	"", // 0x01, Other
+	"", // Unknown
+	"8-bit ",
+	"16-bit ",
+	"32-bit ",
+	"64-bit ",
+	"128-bit ",
+	"x1 ",
+	"x2 ",
+	"x4 ",
+	"x8 ",
+	"x12 ",
+	"x16 ",
+	"x32 ", // 0x0E
+}
+
+// String returns the width as a prefix for the slot type, with a trailing
+// space, as dmidecode prints it.
+func (v SlotDataBusWidth) String() string {
+	if v >= 0x01 && int(v) <= len(slotDataBusWidthNames) {
+		return slotDataBusWidthNames[v-1]
+	}
+	return outOfSpec
+}
+
+// SlotUsage is defined in DSP0134 7.10.3.
+type SlotUsage uint8
+
+func (v SlotUsage) String() string {
+	names := map[SlotUsage]string{
+		0x01: "Other",
+		0x02: "Unknown",
+		0x03: "Available",
+		0x04: "In Use",
+		0x05: "Unavailable",
+	}
+	if name, ok := names[v]; ok {
+		return name
+	}
+	return outOfSpec
+}
+
+// SlotLength is defined in DSP0134 7.10.4.
+type SlotLength uint8
+
+func (v SlotLength) String() string {
+	names := map[SlotLength]string{
+		0x01: "Other",
+		0x02: "Unknown",
+		0x03: "Short",
+		0x04: "Long",
+		0x05: "2.5\" drive form factor",
+		0x06: "3.5\" drive form factor",
+	}
+	if name, ok := names[v]; ok {
+		return name
+	}
+	return outOfSpec
+}
+
+// SlotCharacteristics1 is defined in DSP0134 7.10.6.
+type SlotCharacteristics1 uint8
+
+// SlotCharacteristics1 fields are defined in DSP0134 7.10.6.
+const (
+	SlotCharacteristics1Unknown         SlotCharacteristics1 = 1 << 0 // Characteristics unknown
+	SlotCharacteristics1Provides5_0V    SlotCharacteristics1 = 1 << 1 // Provides 5.0 volts
+	SlotCharacteristics1Provides3_3V    SlotCharacteristics1 = 1 << 2 // Provides 3.3 volts
+	SlotCharacteristics1Shared          SlotCharacteristics1 = 1 << 3 // Slot's opening is shared with another slot
+	SlotCharacteristics1PCCard16        SlotCharacteristics1 = 1 << 4 // PC Card slot supports PC Card-16
+	SlotCharacteristics1CardBus         SlotCharacteristics1 = 1 << 5 // PC Card slot supports CardBus
+	SlotCharacteristics1ZoomVideo       SlotCharacteristics1 = 1 << 6 // PC Card slot supports Zoom Video
+	SlotCharacteristics1ModemRingResume SlotCharacteristics1 = 1 << 7 // PC Card slot supports Modem Ring Resume
+)
+
+// String returns the set characteristics, except Unknown, one per line.
+func (v SlotCharacteristics1) String() string {
+	var lines []string
+	for _, c := range []struct {
+		f    SlotCharacteristics1
+		name string
+	}{
+		{SlotCharacteristics1Provides5_0V, "5.0 V is provided"},
+		{SlotCharacteristics1Provides3_3V, "3.3 V is provided"},
+		{SlotCharacteristics1Shared, "Opening is shared"},
+		{SlotCharacteristics1PCCard16, "PC Card-16 is supported"},
+		{SlotCharacteristics1CardBus, "Cardbus is supported"},
+		{SlotCharacteristics1ZoomVideo, "Zoom Video is supported"},
+		{SlotCharacteristics1ModemRingResume, "Modem ring resume is supported"},
+	} {
+		if v&c.f != 0 {
+			lines = append(lines, c.name)
+		}
+	}
+	return strings.Join(lines, "\n\t\t")
+}
+
+// SlotCharacteristics2 is defined in DSP0134 7.10.7.
+type SlotCharacteristics2 uint8
+
+// SlotCharacteristics2 fields are defined in DSP0134 7.10.7.
+const (
+	SlotCharacteristics2PME         SlotCharacteristics2 = 1 << 0 // PCI slot supports Power Management Event signal
+	SlotCharacteristics2HotPlug     SlotCharacteristics2 = 1 << 1 // Slot supports hot-plug devices
+	SlotCharacteristics2SMBus       SlotCharacteristics2 = 1 << 2 // PCI slot supports SMBus signal
+	SlotCharacteristics2Bifurcation SlotCharacteristics2 = 1 << 3 // PCIe slot supports bifurcation
+)
+
+// String returns the set characteristics one per line.
+func (v SlotCharacteristics2) String() string {
+	var lines []string
+	for _, c := range []struct {
+		f    SlotCharacteristics2
+		name string
+	}{
+		{SlotCharacteristics2PME, "PME signal is supported"},
+		{SlotCharacteristics2HotPlug, "Hot-plug devices are supported"},
+		{SlotCharacteristics2SMBus, "SMBus signal is supported"},
+		{SlotCharacteristics2Bifurcation, "PCIe slot bifurcation is supported"},
+	} {
+		if v&c.f != 0 {
+			lines = append(lines, c.name)
+		}
+	}
+	return strings.Join(lines, "\n\t\t")
+}
@@ -0,0 +1,99 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package strace
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Filter selects syscalls by number.
+type Filter func(sysno int) bool
+
+// syscallClasses are the classes of ParseFilter, e.g. %file, by which the
+// formats or names of their syscalls are recognized.
+var syscallClasses = map[string]func(i SyscallInfo) bool{
+	"file": func(i SyscallInfo) bool {
+		return i.has(Path, PostPath)
+	},
+	"network": func(i SyscallInfo) bool {
+		return i.has(SockAddr, PostSockAddr, SockLen, SockFamily, SendMsgHdr, RecvMsgHdr)
+	},
+	"process": func(i SyscallInfo) bool {
+		switch i.name {
+		case "clone", "clone3", "fork", "vfork", "execve", "execveat",
+			"exit", "exit_group", "wait4", "waitid", "kill", "tkill", "tgkill":
+			return true
+		}
+		return false
+	},
+	"signal": func(i SyscallInfo) bool {
+		return strings.Contains(i.name, "sig") || strings.HasSuffix(i.name, "kill") || i.name == "pause"
+	},
+}
+
+// has returns whether one of the arguments of i is formatted as one of fs.
+func (i SyscallInfo) has(fs ...FormatSpecifier) bool {
+	for _, a := range i.format {
+		for _, f := range fs {
+			if a == f {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ParseFilter parses the syscall list of strace's -e trace=, e.g.
+// "openat,read", "%file" or "!write": comma-separated syscall names or
+// classes, which are %file, %network, %process and %signal, or all or none.
+// A leading ! selects the other syscalls.
+func ParseFilter(s string) (Filter, error) {
+	invert := strings.HasPrefix(s, "!")
+	s = strings.TrimPrefix(s, "!")
+
+	sel := map[int]bool{}
+	for _, n := range strings.Split(s, ",") {
+		switch {
+		case n == "all":
+			for sysno := range syscalls {
+				sel[int(sysno)] = true
+			}
+		case n == "none":
+		case strings.HasPrefix(n, "%"):
+			class, ok := syscallClasses[n[1:]]
+			if !ok {
+				return nil, fmt.Errorf("unknown syscall class %q", n)
+			}
+			for sysno, i := range syscalls {
+				if class(i) {
+					sel[int(sysno)] = true
+				}
+			}
+		default:
+			found := false
+			for sysno, i := range syscalls {
+				if i.name == n {
+					sel[int(sysno)], found = true, true
+				}
+			}
+			if !found {
+				return nil, fmt.Errorf("unknown syscall %q", n)
+			}
+		}
+	}
+	return func(sysno int) bool { return sel[sysno] != invert }, nil
+}
+
+// FilterTraces calls cb for the syscall events of the syscalls f selects,
+// and for all other events.
+func FilterTraces(f Filter, cb EventCallback) EventCallback {
+	return func(t Task, record *TraceRecord) error {
+		if record.Syscall != nil && !f(record.Syscall.Sysno) {
+			return nil
+		}
+		return cb(t, record)
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package strace
+
+import (
+	"testing"
+
+	"golang.org/x/sys/unix"
+)
+
+func TestParseFilter(t *testing.T) {
+	for _, tt := range []struct {
+		filter  string
+		in, out []int
+	}{
+		{"openat,read", []int{unix.SYS_OPENAT, unix.SYS_READ}, []int{unix.SYS_WRITE}},
+		{"!write", []int{unix.SYS_OPENAT, unix.SYS_READ}, []int{unix.SYS_WRITE}},
+		{"%file", []int{unix.SYS_OPENAT, unix.SYS_EXECVE, unix.SYS_UNLINKAT}, []int{unix.SYS_READ, unix.SYS_SOCKET}},
+		{"%network,close", []int{unix.SYS_CONNECT, unix.SYS_SENDMSG, unix.SYS_SOCKET, unix.SYS_CLOSE}, []int{unix.SYS_READ}},
+		{"%process", []int{unix.SYS_CLONE, unix.SYS_EXECVE, unix.SYS_EXIT_GROUP}, []int{unix.SYS_OPENAT}},
+		{"%signal", []int{unix.SYS_RT_SIGACTION, unix.SYS_KILL}, []int{unix.SYS_READ}},
+		{"all", []int{unix.SYS_READ, unix.SYS_CLONE}, nil},
+		{"none", nil, []int{unix.SYS_READ}},
+	} {
+		f, err := ParseFilter(tt.filter)
+		if err != nil {
+			t.Errorf("ParseFilter(%q) = %v", tt.filter, err)
+			continue
+		}
+		for _, sysno := range tt.in {
+			if !f(sysno) {
+				t.Errorf("%q does not select %s", tt.filter, syscalls[uintptr(sysno)].name)
+			}
+		}
+		for _, sysno := range tt.out {
+			if f(sysno) {
+				t.Errorf("%q selects %s", tt.filter, syscalls[uintptr(sysno)].name)
+			}
+		}
+	}
+
+	for _, s := range []string{"opne", "%files"} {
+		if _, err := ParseFilter(s); err == nil {
+			t.Errorf("ParseFilter(%q) succeeded", s)
+		}
+	}
+}
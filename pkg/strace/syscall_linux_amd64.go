@@ -342,6 +342,20 @@ var syscalls = SyscallMap{
 	unix.SYS_SCHED_GETATTR:     makeSyscallInfo("sched_getattr", Hex, Hex, Hex),
 	unix.SYS_RENAMEAT2:         makeSyscallInfo("renameat2", Hex, Path, Hex, Path, Hex),
 	unix.SYS_SECCOMP:           makeSyscallInfo("seccomp", Hex, Hex, Hex),
+	unix.SYS_EXECVEAT:          makeSyscallInfo("execveat", Hex, Path, ExecveStringVector, ExecveStringVector, Hex),
+	unix.SYS_GETRANDOM:         makeSyscallInfo("getrandom", Hex, Hex, Hex),
+	unix.SYS_MEMFD_CREATE:      makeSyscallInfo("memfd_create", Path, Hex),
+	unix.SYS_COPY_FILE_RANGE:   makeSyscallInfo("copy_file_range", Hex, Hex, Hex, Hex, Hex, Hex),
+	unix.SYS_PREADV2:           makeSyscallInfo("preadv2", Hex, ReadIOVec, Hex, Hex, Hex),
+	unix.SYS_PWRITEV2:          makeSyscallInfo("pwritev2", Hex, WriteIOVec, Hex, Hex, Hex),
+	unix.SYS_STATX:             makeSyscallInfo("statx", Hex, Path, Hex, Hex, Hex),
+	unix.SYS_CLONE3:            makeSyscallInfo("clone3", Hex, Hex),
+	unix.SYS_OPENAT2:           makeSyscallInfo("openat2", Hex, Path, Hex, Hex),
+	unix.SYS_PIDFD_OPEN:        makeSyscallInfo("pidfd_open", Hex, Hex),
+	unix.SYS_PIDFD_SEND_SIGNAL: makeSyscallInfo("pidfd_send_signal", Hex, Hex, Hex, Hex),
+	unix.SYS_CLOSE_RANGE:       makeSyscallInfo("close_range", Hex, Hex, Hex),
+	unix.SYS_KEXEC_FILE_LOAD:   makeSyscallInfo("kexec_file_load", Hex, Hex, Hex, Path, Hex),
+	unix.SYS_BPF:               makeSyscallInfo("bpf", Hex, Hex, Hex),
 }
 
 // FillArgs pulls the correct registers to populate system call arguments
@@ -369,37 +383,7 @@ func (s *SyscallEvent) FillRet() {
 	}
 }
 
-// Signal table (taken from Go runtime, but adding all-caps signal names)
-var signals = [...]string{
-	unix.SIGHUP:  "SIGHUP (hangup)",
-	unix.SIGINT:  "SIGINT (interrupt)",
-	unix.SIGQUIT: "SIGQUIT (quit)",
-	unix.SIGILL:  "SIGILL (illegal instruction)",
-	unix.SIGTRAP: "SIGTRAP (trace/breakpoint trap)",
-	unix.SIGABRT: "SIGABRT (aborted)",
-	unix.SIGBUS:  "SIGBUS (bus error)",
-	unix.SIGFPE:  "SIGFPE (floating point exception)",
-	unix.SIGKILL: "SIGKILL (killed)",
-	unix.SIGUSR1: "SIGUSR1 (user defined signal 1)",
-	unix.SIGSEGV: "SIGSEGV (segmentation fault)",
-	unix.SIGUSR2: "SIGUSR2 (user defined signal 2)",
-	unix.SIGPIPE: "SIGPIPE (broken pipe)",
-	unix.SIGALRM: "SIGALRM (alarm clock)",
-	unix.SIGTERM: "SIGTERM (terminated)",
-	16:           "SIGSTKFLT (stack fault)",
-	17:           "SIGCHLD (child exited)",
-	18:           "SIGCONT (continued)",
-	19:           "SIGSTOP (stopped)",
-	20:           "SIGTSTP (stopped)",
-	21:           "SIGTTIN (stopped - tty input)",
-	22:           "SIGTTOU (stopped - tty output)",
-	23:           "SIGURG (urgent I/O condition)",
-	24:           "SIGXCPU (CPU time limit exceeded)",
-	25:           "SIGXFSZ (file size limit exceeded)",
-	26:           "SIGVTALRM (virtual timer expired)",
-	27:           "SIGPROF (profiling timer expired)",
-	28:           "SIGWINCH (window changed)",
-	29:           "SIGPOLL (I/O possible)",
-	30:           "SIGPWR (power failure)",
-	31:           "SIGSYS (bad system call)",
+// getRegs reads the registers of a stopped process.
+func getRegs(pid int, regs *unix.PtraceRegs) error {
+	return unix.PtraceGetRegs(pid, regs)
 }
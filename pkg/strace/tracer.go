@@ -84,13 +84,24 @@ type NewChildEvent struct {
 	PID int
 }
 
+// ExecEvent is emitted when a process executes a new program with execve(2)
+// or execveat(2).
+type ExecEvent struct {
+	// Path is the program, if it is known.
+	Path string
+
+	// FormerPID is the thread ID of the thread which called execve. All
+	// other threads are gone, and it is now the process ID.
+	FormerPID int
+}
+
 // TraceRecord has information about a process event.
 type TraceRecord struct {
 	PID   int
 	Time  time.Time
 	Event EventType
 
-	// Poor man's union. One of the following five will be populated
+	// Poor man's union. One of the following six will be populated
 	// depending on the Event.
 
 	Syscall    *SyscallEvent
@@ -98,6 +109,7 @@ type TraceRecord struct {
 	SignalStop *SignalEvent
 	Exit       *ExitEvent
 	NewChild   *NewChildEvent
+	Exec       *ExecEvent
 }
 
 // process is a Linux thread.
@@ -217,7 +229,9 @@ func Trace(c *exec.Cmd, recordCallback ...EventCallback) error {
 			// Kill tracee if tracer exits.
 			unix.PTRACE_O_EXITKILL|
 			// Automatically trace fork(2)'d, clone(2)'d, and vfork(2)'d children.
-			unix.PTRACE_O_TRACECLONE|unix.PTRACE_O_TRACEFORK|unix.PTRACE_O_TRACEVFORK); err != nil {
+			unix.PTRACE_O_TRACECLONE|unix.PTRACE_O_TRACEFORK|unix.PTRACE_O_TRACEVFORK|
+			// Stop with an event instead of a SIGTRAP on execve(2).
+			unix.PTRACE_O_TRACEEXEC); err != nil {
 		return &TraceError{
 			PID: c.Process.Pid,
 			Err: os.NewSyscallError("ptrace(PTRACE_SETOPTIONS)", err),
@@ -248,7 +262,7 @@ func (t *tracer) addProcess(pid int, event EventType) {
 func (t *TraceRecord) syscallStop(p *process) error {
 	t.Syscall = &SyscallEvent{}
 
-	if err := unix.PtraceGetRegs(p.pid, &t.Syscall.Regs); err != nil {
+	if err := getRegs(p.pid, &t.Syscall.Regs); err != nil {
 		return &TraceError{
 			PID: p.pid,
 			Err: os.NewSyscallError("ptrace(PTRACE_GETREGS)", err),
@@ -262,6 +276,10 @@ func (t *TraceRecord) syscallStop(p *process) error {
 	// that here, however you'd detect it...
 	if p.lastSyscallStop.Event == SyscallEnter {
 		t.Event = SyscallExit
+		// Some architectures return values in argument registers.
+		if last := p.lastSyscallStop.Syscall; last != nil {
+			t.Syscall.Args = last.Args
+		}
 		t.Syscall.FillRet()
 		t.Syscall.Duration = time.Since(p.lastSyscallStop.Time)
 	} else {
@@ -383,6 +401,30 @@ func (t *tracer) runLoop() error {
 						PID: int(childPID),
 					}
 
+				case unix.PTRACE_EVENT_EXEC:
+					former, err := unix.PtraceGetEventMsg(pid)
+					if err != nil {
+						return &TraceError{
+							PID: pid,
+							Err: os.NewSyscallError("ptrace(PTRACE_GETEVENTMSG)", err),
+						}
+					}
+					// A thread other than the leader
+					// executing takes over its PID; the
+					// other threads are gone without an
+					// exit event.
+					if f, ok := t.processes[int(former)]; ok && int(former) != pid {
+						p.lastSyscallStop = f.lastSyscallStop
+						delete(t.processes, int(former))
+					}
+					path, _ := os.Readlink(fmt.Sprintf("/proc/%d/exe", pid))
+
+					rec.Event = Exec
+					rec.Exec = &ExecEvent{
+						Path:      path,
+						FormerPID: int(former),
+					}
+
 				// Regular signal-delivery-stop.
 				default:
 					rec.Event = SignalStop
@@ -454,6 +496,8 @@ func PrintTraces(w io.Writer) EventCallback {
 			fmt.Fprintf(w, "PID %d got signal %s\n", record.PID, signalString(record.SignalStop.Signal))
 		case NewChild:
 			fmt.Fprintf(w, "PID %d spawned new child %d\n", record.PID, record.NewChild.PID)
+		case Exec:
+			fmt.Fprintf(w, "PID %d executed %s\n", record.PID, record.Exec.Path)
 		}
 		return nil
 	}
@@ -499,6 +543,11 @@ const (
 	//
 	// ptrace calls this a PTRACE_EVENT_(FORK|CLONE|VFORK).
 	NewChild EventType = 0x7
+
+	// Exec means the process executed a new program.
+	//
+	// ptrace calls this a PTRACE_EVENT_EXEC.
+	Exec EventType = 0x8
 )
 
 // A procIO is used to implement io.Reader and io.Writer.
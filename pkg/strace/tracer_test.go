@@ -9,6 +9,7 @@ import (
 	"context"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -85,3 +86,30 @@ func TestMultiProcess(t *testing.T) {
 
 	runAndCollectTrace(t, cmd)
 }
+
+func TestExec(t *testing.T) {
+	sh, err := exec.LookPath("sh")
+	if err != nil {
+		t.Skip(err)
+	}
+	tr, err := exec.LookPath("true")
+	if err != nil {
+		t.Skip(err)
+	}
+	events := runAndCollectTrace(t, exec.Command(sh, "-c", tr+" && "+tr))
+
+	var children, execs int
+	for _, e := range events {
+		switch e.Event {
+		case NewChild:
+			children++
+		case Exec:
+			if p, err := filepath.EvalSymlinks(tr); err == nil && e.Exec.Path == p {
+				execs++
+			}
+		}
+	}
+	if children < 2 || execs != 2 {
+		t.Errorf("got %d children executing %s %d times, want 2 and 2", children, tr, execs)
+	}
+}
@@ -13,6 +13,8 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+
+	"github.com/u-root/u-root/pkg/compress"
 )
 
 // Opts contains options for creating and extracting tar files.
@@ -26,6 +28,16 @@ type Opts struct {
 	// to include all sub-directories. Set to true to prevent this
 	// behavior.
 	NoRecursion bool
+
+	// Compression is the compression of the tar archives created, or nil
+	// to not compress them. Archives being extracted or listed are
+	// decompressed whatever their compression, detected from their first
+	// bytes.
+	Compression *compress.Format
+
+	// ChangeOwner sets the owner and group of the extracted files to the
+	// ones of the archive. This usually needs root.
+	ChangeOwner bool
 }
 
 // passesFilters returns true if the given file passes all filters, false otherwise.
@@ -38,10 +50,16 @@ func passesFilters(hdr *tar.Header, filters []Filter) bool {
 	return true
 }
 
-// applyToArchive applies function f to all files in the given archive
+// applyToArchive applies function f to all files in the given archive,
+// decompressed if needed.
 func applyToArchive(
 	tarFile io.Reader, f func(tr *tar.Reader, hdr *tar.Header) error) error {
-	tr := tar.NewReader(tarFile)
+	r, err := compress.NewReader(tarFile)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	tr := tar.NewReader(r)
 	for {
 		hdr, err := tr.Next()
 		if err == io.EOF {
@@ -84,17 +102,31 @@ func ExtractDir(tarFile io.Reader, dir string, opts *Opts) error {
 		if !passesFilters(hdr, opts.Filters) {
 			return nil
 		}
-		return createFileInRoot(hdr, tr, dir)
+		return createFileInRoot(hdr, tr, dir, opts.ChangeOwner)
 	})
 }
 
+// nopCloser is an io.WriteCloser whose Close does nothing.
+type nopCloser struct {
+	io.Writer
+}
+
+func (nopCloser) Close() error { return nil }
+
 // CreateTar creates a new tar file with all the contents of a directory.
 func CreateTar(tarFile io.Writer, files []string, opts *Opts) error {
 	if opts == nil {
 		opts = &Opts{}
 	}
 
-	tw := tar.NewWriter(tarFile)
+	var cw io.WriteCloser = nopCloser{tarFile}
+	if opts.Compression != nil {
+		var err error
+		if cw, err = opts.Compression.Writer(tarFile, compress.DefaultLevel); err != nil {
+			return err
+		}
+	}
+	tw := tar.NewWriter(cw)
 	for _, file := range files {
 		walk := filepath.Walk
 		if opts.NoRecursion {
@@ -111,8 +143,9 @@ func CreateTar(tarFile io.Writer, files []string, opts *Opts) error {
 			}
 			symlink := ""
 			if info.Mode()&os.ModeSymlink != 0 {
-				// TODO: symlinks
-				return fmt.Errorf("symlinks not yet supported: %q", path)
+				if symlink, err = os.Readlink(path); err != nil {
+					return err
+				}
 			}
 			hdr, err := tar.FileInfoHeader(info, symlink)
 			if err != nil {
@@ -164,22 +197,70 @@ func CreateTar(tarFile io.Writer, files []string, opts *Opts) error {
 	if err := tw.Close(); err != nil {
 		return err
 	}
-	return nil
+	return cw.Close()
 }
 
-func createFileInRoot(hdr *tar.Header, r io.Reader, rootDir string) error {
-	fi := hdr.FileInfo()
-	path := filepath.Clean(filepath.Join(rootDir, hdr.Name))
+// inRoot returns the path of name in rootDir, or an error if name is outside
+// of it.
+func inRoot(name, rootDir string) (string, error) {
+	path := filepath.Clean(filepath.Join(rootDir, name))
 	if !strings.HasPrefix(path, filepath.Clean(rootDir)) {
-		return fmt.Errorf("file outside root directory: %q", path)
+		return "", fmt.Errorf("file outside root directory: %q", path)
+	}
+	return path, nil
+}
+
+// removeNonDir removes the file at path, if it is not a directory, so that
+// it can be replaced. Symlinks are not followed.
+func removeNonDir(path string) error {
+	fi, err := os.Lstat(path)
+	if os.IsNotExist(err) || (err == nil && fi.IsDir()) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return os.Remove(path)
+}
+
+func createFileInRoot(hdr *tar.Header, r io.Reader, rootDir string, changeOwner bool) error {
+	fi := hdr.FileInfo()
+	path, err := inRoot(hdr.Name, rootDir)
+	if err != nil {
+		return err
+	}
+
+	if hdr.Typeflag == tar.TypeLink {
+		target, err := inRoot(hdr.Linkname, rootDir)
+		if err != nil {
+			return err
+		}
+		if err := removeNonDir(path); err != nil {
+			return err
+		}
+		return os.Link(target, path)
 	}
 
 	switch fi.Mode() & os.ModeType {
 	case os.ModeSymlink:
-		// TODO: support symlinks
-		return fmt.Errorf("symlinks not yet supported: %q", path)
+		if err := removeNonDir(path); err != nil {
+			return err
+		}
+		if err := os.Symlink(hdr.Linkname, path); err != nil {
+			return err
+		}
+		if changeOwner {
+			if err := os.Lchown(path, hdr.Uid, hdr.Gid); err != nil {
+				return fmt.Errorf("error setting owner of %q: %v", path, err)
+			}
+		}
+		return nil
 
 	case os.FileMode(0):
+		// Do not write through a symlink of the archive.
+		if err := removeNonDir(path); err != nil {
+			return err
+		}
 		f, err := os.Create(path)
 		if err != nil {
 			return err
@@ -209,11 +290,20 @@ func createFileInRoot(hdr *tar.Header, r io.Reader, rootDir string) error {
 		return fmt.Errorf("%q: Unknown type %#o", path, fi.Mode()&os.ModeType)
 	}
 
-	if err := os.Chmod(path, fi.Mode()&os.ModePerm); err != nil {
+	// The owner is changed first, as it clears the setuid and setgid bits.
+	if changeOwner {
+		if err := os.Lchown(path, hdr.Uid, hdr.Gid); err != nil {
+			return fmt.Errorf("error setting owner of %q: %v", path, err)
+		}
+	}
+	mode := fi.Mode() & (os.ModePerm | os.ModeSetuid | os.ModeSetgid | os.ModeSticky)
+	if err := os.Chmod(path, mode); err != nil {
 		return fmt.Errorf("error setting mode %#o on %q: %v",
-			fi.Mode()&os.ModePerm, path, err)
+			mode, path, err)
+	}
+	if err := os.Chtimes(path, hdr.ModTime, hdr.ModTime); err != nil {
+		return fmt.Errorf("error setting times of %q: %v", path, err)
 	}
-	// TODO: also set ownership, etc...
 	return nil
 }
 
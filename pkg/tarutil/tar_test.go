@@ -5,12 +5,16 @@
 package tarutil
 
 import (
+	"bytes"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"runtime"
 	"testing"
+
+	"github.com/u-root/u-root/pkg/compress"
 )
 
 func extractAndCompare(t *testing.T, tarFile string, files []struct{ name, body string }) {
@@ -187,3 +191,68 @@ func TestListArchive(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestCompressedRoundTrip(t *testing.T) {
+	for _, c := range []*compress.Format{nil, compress.Gzip, compress.Xz, compress.Zstd} {
+		t.Run(fmt.Sprint(c), func(t *testing.T) {
+			tmpDir, err := ioutil.TempDir("", "tartest")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(tmpDir)
+
+			var b bytes.Buffer
+			if err := CreateTar(&b, []string{"test0"}, &Opts{Compression: c}); err != nil {
+				t.Fatal(err)
+			}
+			if got := compress.Detect(b.Bytes()); got != c {
+				t.Errorf("compress.Detect() = %v, want %v", got, c)
+			}
+			if err := ExtractDir(&b, tmpDir, nil); err != nil {
+				t.Fatal(err)
+			}
+			body, err := ioutil.ReadFile(filepath.Join(tmpDir, "test0/dir/b.txt"))
+			if err != nil || string(body) != "world\n" {
+				t.Errorf("test0/dir/b.txt = %q, %v, want %q", body, err, "world\n")
+			}
+		})
+	}
+}
+
+func TestSymlinks(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "tartest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+	src := filepath.Join(tmpDir, "src")
+	if err := os.Mkdir(src, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(src, "file"), []byte("hi"), 0751); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink("file", filepath.Join(src, "link")); err != nil {
+		t.Fatal(err)
+	}
+
+	var b bytes.Buffer
+	if err := CreateTar(&b, []string{src}, nil); err != nil {
+		t.Fatal(err)
+	}
+	dst := filepath.Join(tmpDir, "dst")
+	if err := ExtractDir(&b, dst, nil); err != nil {
+		t.Fatal(err)
+	}
+	link := filepath.Join(dst, src, "link")
+	if target, err := os.Readlink(link); err != nil || target != "file" {
+		t.Errorf("Readlink(%q) = %q, %v, want file", link, target, err)
+	}
+	fi, err := os.Stat(link)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fi.Mode().Perm() != 0751 {
+		t.Errorf("mode of %q is %v, want 0751", link, fi.Mode().Perm())
+	}
+}
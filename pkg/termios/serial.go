@@ -0,0 +1,133 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package termios
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Parity is the parity of the characters on a serial line.
+type Parity int
+
+// These are the parities.
+const (
+	ParityNone Parity = iota
+	ParityEven
+	ParityOdd
+	ParityMark
+	ParitySpace
+)
+
+func (p Parity) String() string {
+	switch p {
+	case ParityNone:
+		return "none"
+	case ParityEven:
+		return "even"
+	case ParityOdd:
+		return "odd"
+	case ParityMark:
+		return "mark"
+	case ParitySpace:
+		return "space"
+	}
+	return fmt.Sprintf("Parity(%d)", int(p))
+}
+
+// FlowControl is the flow control of a serial line.
+type FlowControl int
+
+// These are the kinds of flow control.
+const (
+	FlowNone FlowControl = iota
+	// FlowHardware is RTS/CTS.
+	FlowHardware
+	// FlowSoftware is XON/XOFF.
+	FlowSoftware
+)
+
+func (f FlowControl) String() string {
+	switch f {
+	case FlowNone:
+		return "none"
+	case FlowHardware:
+		return "rts/cts"
+	case FlowSoftware:
+		return "xon/xoff"
+	}
+	return fmt.Sprintf("FlowControl(%d)", int(f))
+}
+
+// SerialConfig holds the line parameters of a serial port.
+type SerialConfig struct {
+	// Baud is the baud rate. 0 leaves it as it is.
+	Baud int
+	// DataBits is the character size, 5 to 8. 0 means 8.
+	DataBits int
+	Parity   Parity
+	// StopBits is 1 or 2. 0 means 1.
+	StopBits int
+	Flow     FlowControl
+}
+
+func (c SerialConfig) dataBits() int {
+	if c.DataBits == 0 {
+		return 8
+	}
+	return c.DataBits
+}
+
+var parities = map[byte]Parity{
+	'n': ParityNone,
+	'e': ParityEven,
+	'o': ParityOdd,
+	'm': ParityMark,
+	's': ParitySpace,
+}
+
+// ParseSerialConfig parses line parameters as the kernel's console= takes
+// them, BBBBPNF: a baud rate, then optionally a parity (n, e, o, m or s),
+// a number of data bits and r for RTS/CTS flow control, like "115200n8".
+func ParseSerialConfig(s string) (SerialConfig, error) {
+	var c SerialConfig
+	rest := strings.TrimLeft(s, "0123456789")
+	baud, err := strconv.Atoi(s[:len(s)-len(rest)])
+	if err != nil {
+		return c, fmt.Errorf("%q: invalid baud rate", s)
+	}
+	c.Baud = baud
+	if rest == "" {
+		return c, nil
+	}
+	p, ok := parities[rest[0]]
+	if !ok {
+		return c, fmt.Errorf("%q: invalid parity %q", s, rest[0])
+	}
+	c.Parity = p
+	rest = rest[1:]
+	if rest != "" && rest[0] >= '5' && rest[0] <= '8' {
+		c.DataBits = int(rest[0] - '0')
+		rest = rest[1:]
+	}
+	switch rest {
+	case "":
+	case "r":
+		c.Flow = FlowHardware
+	default:
+		return c, fmt.Errorf("%q: invalid line parameters", s)
+	}
+	return c, nil
+}
+
+// String returns c in the format taken by ParseSerialConfig.
+func (c SerialConfig) String() string {
+	s := fmt.Sprintf("%d%c%d", c.Baud, c.Parity.String()[0], c.dataBits())
+	if c.Flow == FlowHardware {
+		s += "r"
+	}
+	return s
+}
@@ -0,0 +1,73 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build !plan9
+
+package termios
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ccOrder is the order in which Settings prints the control characters, as
+// stty -a does.
+var ccOrder = []string{"intr", "quit", "erase", "kill", "eof", "eol", "eol2", "start", "stop", "susp", "werase", "lnext"}
+
+// FormatCC returns the control character c the way SetOpts reads it:
+// ^X for control-X, ^? for DEL, M- for characters with the high bit set,
+// and <undef> for a disabled one.
+func FormatCC(c uint8) string {
+	switch {
+	case c == 0:
+		return "<undef>"
+	case c >= 0x80:
+		return "M-" + FormatCC(c-0x80)
+	case c == 0x7f:
+		return "^?"
+	case c < 0x20:
+		return "^" + string(rune(c+'@'))
+	}
+	return string(rune(c))
+}
+
+// Settings returns all the settings of a TTY the way stty -a prints them:
+// the speed and the window size, the control characters, and a line each
+// for the control, input, output and local flags, which are prefixed with -
+// if they are off.
+func (t *TTY) Settings() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "speed %d baud; rows %d; columns %d;\n", t.Ispeed, t.Row, t.Col)
+
+	var ccs []string
+	for _, n := range ccOrder {
+		if _, ok := cc[n]; ok {
+			ccs = append(ccs, fmt.Sprintf("%s = %s;", n, FormatCC(t.CC[n])))
+		}
+	}
+	ccs = append(ccs, fmt.Sprintf("min = %d; time = %d;", t.CC["min"], t.CC["time"]))
+	b.WriteString(strings.Join(ccs, " ") + "\n")
+
+	words := map[int][]string{}
+	for n, f := range boolFields {
+		if !t.Opts[n] {
+			n = "-" + n
+		}
+		words[f.word] = append(words[f.word], n)
+	}
+	for n := range csizes {
+		if t.Opts[n] {
+			words[C] = append(words[C], n)
+		}
+	}
+	for _, w := range []int{C, I, O, L} {
+		opts := words[w]
+		sort.Slice(opts, func(i, j int) bool {
+			return strings.TrimPrefix(opts[i], "-") < strings.TrimPrefix(opts[j], "-")
+		})
+		b.WriteString(strings.Join(opts, " ") + "\n")
+	}
+	return b.String()
+}
@@ -6,7 +6,6 @@ package termios
 
 import (
 	"fmt"
-	"log"
 	"reflect"
 	"sort"
 	"strconv"
@@ -32,6 +31,10 @@ func GTTY(fd int) (*TTY, error) {
 		t.Opts[n] = val != 0
 	}
 
+	for n, v := range csizes {
+		t.Opts[n] = term.Cflag&unix.CSIZE == uint64(v)
+	}
+
 	for n, c := range cc {
 		t.CC[n] = term.Cc[c]
 	}
@@ -39,8 +42,8 @@ func GTTY(fd int) (*TTY, error) {
 	// back in the day, you could have different i and o speeds.
 	// since about 1975, this has not been a thing. It's still in POSIX
 	// evidently. WTF?
-	t.Ispeed = int(term.Ispeed)
-	t.Ospeed = int(term.Ospeed)
+	t.Ispeed = speed(term)
+	t.Ospeed = t.Ispeed
 	t.Row = int(w.Row)
 	t.Col = int(w.Col)
 
@@ -68,12 +71,23 @@ func (t *TTY) STTY(fd int) (*TTY, error) {
 		reflect.ValueOf(term).Elem().Field(b.word).SetUint(i)
 	}
 
+	for n, v := range csizes {
+		if t.Opts[n] {
+			term.Cflag &^= unix.CSIZE
+			term.Cflag |= uint64(v)
+		}
+	}
+
 	for n, c := range cc {
 		term.Cc[c] = t.CC[n]
 	}
 
-	term.Ispeed = uint64(t.Ispeed)
-	term.Ospeed = uint64(t.Ospeed)
+	// A speed of 0 leaves the speed as it is, instead of hanging up.
+	if t.Ispeed != 0 {
+		if err := setSpeed(term, t.Ispeed); err != nil {
+			return nil, err
+		}
+	}
 
 	if err := unix.IoctlSetTermios(fd, unix.TIOCSETA, term); err != nil {
 		return nil, err
@@ -110,54 +124,98 @@ func (t *TTY) String() string {
 	return s
 }
 
-func intarg(s []string) int {
+func intarg(s []string) (int, error) {
 	if len(s) < 2 {
-		log.Fatalf("%s requires an arg", s[0])
+		return 0, fmt.Errorf("%s requires an arg", s[0])
 	}
 	i, err := strconv.Atoi(s[1])
 	if err != nil {
-		log.Fatalf("%s is not a number", s)
+		return 0, fmt.Errorf("%s: %q is not a number", s[0], s[1])
+	}
+	return i, nil
+}
+
+// ccarg parses the value of a control character option: a number, a
+// character, ^X for control-X, ^? for DEL, or "undef" or ^- to disable it.
+func ccarg(s []string) (uint8, error) {
+	if len(s) < 2 {
+		return 0, fmt.Errorf("%s requires an arg", s[0])
+	}
+	switch v := s[1]; {
+	case v == "undef" || v == "^-":
+		return 0, nil
+	case v == "^?":
+		return 0x7f, nil
+	case len(v) == 2 && v[0] == '^':
+		return v[1] & 0x1f, nil
+	case len(v) == 1 && (v[0] < '0' || v[0] > '9'):
+		return v[0], nil
+	}
+	i, err := strconv.ParseUint(s[1], 0, 8)
+	if err != nil {
+		return 0, fmt.Errorf("%s: invalid control character %q", s[0], s[1])
 	}
-	return i
+	return uint8(i), nil
 }
 
 // SetOpts sets opts in a TTY given an array of key-value pairs and
 // booleans. The arguments are a variety of key-value pairs and booleans.
-// booleans are cleared if the first char is a -, set otherwise.
+// booleans are cleared if the first char is a ~ or -, set otherwise.
+// A bare number sets the speed, and cs5 to cs8 the character size.
 func (t *TTY) SetOpts(opts []string) error {
 	for i := 0; i < len(opts); i++ {
 		o := opts[i]
+		var n *int
 		switch o {
-		case "row":
-			t.Row = intarg(opts[i:])
-			i++
-			continue
-		case "col":
-			t.Col = intarg(opts[i:])
+		case "row", "rows":
+			n = &t.Row
+		case "col", "cols", "columns":
+			n = &t.Col
+		case "speed", "ispeed", "ospeed":
+			n = &t.Ispeed
+		}
+		if n != nil {
+			v, err := intarg(opts[i:])
+			if err != nil {
+				return err
+			}
+			*n = v
+			t.Ospeed = t.Ispeed
 			i++
 			continue
-		case "speed":
-			t.Ispeed = intarg(opts[i:])
-			i++
+		}
+		if v, err := strconv.Atoi(o); err == nil {
+			t.Ispeed, t.Ospeed = v, v
 			continue
 		}
 
 		// see if it's one of the control char options.
-		if _, ok := cc[opts[i]]; ok {
-			t.CC[opts[i]] = uint8(intarg(opts[i:]))
+		if _, ok := cc[o]; ok {
+			v, err := ccarg(opts[i:])
+			if err != nil {
+				return err
+			}
+			t.CC[o] = v
 			i++
 			continue
 		}
 
+		if _, ok := csizes[o]; ok {
+			for n := range csizes {
+				t.Opts[n] = n == o
+			}
+			continue
+		}
+
 		// At this point, it has to be one of the boolean ones
 		// or we're done here.
 		set := true
-		if o[0] == '~' {
+		if len(o) > 0 && (o[0] == '~' || o[0] == '-') {
 			set = false
 			o = o[1:]
 		}
 		if _, ok := boolFields[o]; !ok {
-			log.Fatalf("%s: unknown option", o)
+			return fmt.Errorf("%s: unknown option", o)
 		}
 
 		t.Opts[o] = set
@@ -172,7 +230,8 @@ func Raw(fd int) (*TTY, error) {
 		return nil, err
 	}
 
-	t.SetOpts([]string{"~ignbrk", "~brkint", "~parmrk", "~istrip", "~inlcr", "~igncr", "~icrnl", "~ixon", "~opost", "~echo", "~echonl", "~icanon", "~isig", "~iexten", "~parenb" /*"cs8", */, "min", "1", "time", "0"})
+	// t.SetOpts only fails for unknown options.
+	_ = t.SetOpts([]string{"~ignbrk", "~brkint", "~parmrk", "~istrip", "~inlcr", "~igncr", "~icrnl", "~ixon", "~opost", "~echo", "~echonl", "~icanon", "~isig", "~iexten", "~parenb" /*"cs8", */, "min", "1", "time", "0"})
 
 	return t.STTY(fd)
 }
@@ -11,6 +11,8 @@
 // restorer, err := tty.Raw()
 // do things
 // tty.Set(restorer)
+// or, to also restore it if the code panics:
+// err := tty.WithRaw(func() error { do things })
 package termios
 
 type (
@@ -44,6 +46,66 @@ func (t *TTYIO) Raw() (*Termios, error) {
 	return restorer, nil
 }
 
+// CBreak sets the tty into cbreak mode, see MakeCBreak.
+func (t *TTYIO) CBreak() (*Termios, error) {
+	restorer, err := t.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := t.Set(MakeCBreak(restorer)); err != nil {
+		return nil, err
+	}
+	return restorer, nil
+}
+
+// WithRaw runs f with the tty in raw mode, and restores the previous mode
+// when f returns, or panics.
+func (t *TTYIO) WithRaw(f func() error) error {
+	return t.with(MakeRaw, f)
+}
+
+// WithCBreak runs f with the tty in cbreak mode, and restores the previous
+// mode when f returns, or panics.
+func (t *TTYIO) WithCBreak(f func() error) error {
+	return t.with(MakeCBreak, f)
+}
+
+func (t *TTYIO) with(mode func(*Termios) *Termios, f func() error) (err error) {
+	restorer, err := t.Get()
+	if err != nil {
+		return err
+	}
+	if err := t.Set(mode(restorer)); err != nil {
+		return err
+	}
+	// A deferred call also runs when f panics, so the terminal is usable
+	// to read the panic.
+	defer func() {
+		if serr := t.Set(restorer); serr != nil && err == nil {
+			err = serr
+		}
+	}()
+	return f()
+}
+
+// SetSerial sets the line parameters of the serial TTY, see MakeSerial.
+func (t *TTYIO) SetSerial(c SerialConfig) (*Termios, error) {
+	restorer, err := t.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	serial, err := MakeSerial(restorer, c)
+	if err != nil {
+		return nil, err
+	}
+	if err := t.Set(serial); err != nil {
+		return nil, err
+	}
+	return restorer, nil
+}
+
 // Serial configure the serial TTY at given baudrate with ECHO and character conversion (CRNL, ERASE, KILL)
 func (t *TTYIO) Serial(baud int) (*Termios, error) {
 	restorer, err := t.Get()
@@ -75,3 +137,8 @@ func (t *TTYIO) Read(b []byte) (int, error) {
 func (t *TTYIO) Write(b []byte) (int, error) {
 	return t.f.Write(b)
 }
+
+// Close closes the tty.
+func (t *TTYIO) Close() error {
+	return t.f.Close()
+}
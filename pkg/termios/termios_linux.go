@@ -104,9 +104,15 @@ func (t *TTYIO) Ctty(c *exec.Cmd) {
 	c.SysProcAttr.Ctty = int(t.f.Fd())
 }
 
+// clone returns a copy of term that can be modified without changing term.
+func clone(term *Termios) *Termios {
+	t := *term.Termios
+	return &Termios{Termios: &t}
+}
+
 // MakeRaw modifies Termio state so, if it used for an fd or tty, it will set it to raw mode.
 func MakeRaw(term *Termios) *Termios {
-	raw := *term
+	raw := clone(term)
 	raw.Iflag &^= unix.IGNBRK | unix.BRKINT | unix.PARMRK | unix.ISTRIP | unix.INLCR | unix.IGNCR | unix.ICRNL | unix.IXON
 	raw.Oflag &^= unix.OPOST
 	raw.Lflag &^= unix.ECHO | unix.ECHONL | unix.ICANON | unix.ISIG | unix.IEXTEN
@@ -116,12 +122,24 @@ func MakeRaw(term *Termios) *Termios {
 	raw.Cc[unix.VMIN] = 1
 	raw.Cc[unix.VTIME] = 0
 
-	return &raw
+	return raw
+}
+
+// MakeCBreak modifies Termio state so, if it used for an fd or tty, it will
+// set it to cbreak mode: input is available a character at a time, without
+// echo, but signals and output processing still work.
+func MakeCBreak(term *Termios) *Termios {
+	cb := clone(term)
+	cb.Lflag &^= unix.ECHO | unix.ICANON
+	cb.Cc[unix.VMIN] = 1
+	cb.Cc[unix.VTIME] = 0
+
+	return cb
 }
 
 // MakeSerialBaud updates the Termios to set the baudrate
 func MakeSerialBaud(term *Termios, baud int) (*Termios, error) {
-	t := *term
+	t := clone(term)
 	rate, ok := baud2unixB[baud]
 	if !ok {
 		return nil, fmt.Errorf("%d: Unrecognized baud rate", baud)
@@ -132,7 +150,7 @@ func MakeSerialBaud(term *Termios, baud int) (*Termios, error) {
 	t.Ispeed = rate
 	t.Ospeed = rate
 
-	return &t, nil
+	return t, nil
 }
 
 // MakeSerialDefault updates the Termios to typical serial configuration:
@@ -143,7 +161,7 @@ func MakeSerialBaud(term *Termios, baud int) (*Termios, error) {
 // - Local ECHO is added (and handled by line editing)
 // - Map newline to carriage return newline on output
 func MakeSerialDefault(term *Termios) *Termios {
-	t := *term
+	t := clone(term)
 	/* Clear all except baud, stop bit and parity settings */
 	t.Cflag &= unix.CBAUD | unix.CSTOPB | unix.PARENB | unix.PARODD
 	/* Set: 8 bits; ignore Carrier Detect; enable receive */
@@ -158,5 +176,77 @@ func MakeSerialDefault(term *Termios) *Termios {
 	t.Cc[unix.VTIME] = 0
 	t.Line = 0
 
-	return &t
+	return t
+}
+
+// MakeSerial updates the Termios to the typical serial configuration of
+// MakeSerialDefault, with the baud rate, character size, parity, stop bits
+// and flow control of c. A zero Baud leaves the baud rate as it is.
+func MakeSerial(term *Termios, c SerialConfig) (*Termios, error) {
+	t := MakeSerialDefault(term)
+	if c.Baud != 0 {
+		var err error
+		if t, err = MakeSerialBaud(t, c.Baud); err != nil {
+			return nil, err
+		}
+	}
+	size, ok := csizes[fmt.Sprintf("cs%d", c.dataBits())]
+	if !ok {
+		return nil, fmt.Errorf("%d: unsupported number of data bits", c.DataBits)
+	}
+	t.Cflag &^= unix.CSIZE | unix.PARENB | unix.PARODD | unix.CMSPAR | unix.CSTOPB | unix.CRTSCTS
+	t.Cflag |= size
+	switch c.Parity {
+	case ParityNone:
+	case ParityEven:
+		t.Cflag |= unix.PARENB
+	case ParityOdd:
+		t.Cflag |= unix.PARENB | unix.PARODD
+	case ParityMark:
+		t.Cflag |= unix.PARENB | unix.PARODD | unix.CMSPAR
+	case ParitySpace:
+		t.Cflag |= unix.PARENB | unix.CMSPAR
+	default:
+		return nil, fmt.Errorf("%v: unsupported parity", c.Parity)
+	}
+	switch c.StopBits {
+	case 0, 1:
+	case 2:
+		t.Cflag |= unix.CSTOPB
+	default:
+		return nil, fmt.Errorf("%d: unsupported number of stop bits", c.StopBits)
+	}
+	switch c.Flow {
+	case FlowNone:
+	case FlowHardware:
+		t.Cflag |= unix.CRTSCTS
+	case FlowSoftware:
+		t.Iflag |= unix.IXON | unix.IXOFF
+	default:
+		return nil, fmt.Errorf("%v: unsupported flow control", c.Flow)
+	}
+	return t, nil
+}
+
+// speed returns the baud rate of term, or 0 if it is not known.
+func speed(term *unix.Termios) int {
+	for baud, rate := range baud2unixB {
+		if term.Cflag&unix.CBAUD == rate {
+			return baud
+		}
+	}
+	return 0
+}
+
+// setSpeed sets the baud rate of term.
+func setSpeed(term *unix.Termios, baud int) error {
+	rate, ok := baud2unixB[baud]
+	if !ok {
+		return fmt.Errorf("%d: Unrecognized baud rate", baud)
+	}
+	term.Cflag &^= unix.CBAUD
+	term.Cflag |= rate
+	term.Ispeed = rate
+	term.Ospeed = rate
+	return nil
 }
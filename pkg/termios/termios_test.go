@@ -155,3 +155,18 @@ func TestString(t *testing.T) {
 	}
 
 }
+
+func TestFormatCC(t *testing.T) {
+	for c, want := range map[uint8]string{0: "<undef>", 3: "^C", 0x1c: "^\\", 'q': "q", 0x7f: "^?", 0x83: "M-^C", 0xff: "M-^?"} {
+		if got := FormatCC(c); got != want {
+			t.Errorf("FormatCC(%#x) = %q, want %q", c, got, want)
+		}
+		if c == 0 || c >= 0x80 {
+			continue
+		}
+		// SetOpts reads what FormatCC writes.
+		if got, err := ccarg([]string{"intr", want}); err != nil || got != c {
+			t.Errorf("ccarg(%q) = %#x, %v, want %#x", want, got, err, c)
+		}
+	}
+}
@@ -49,9 +49,10 @@ var (
 // init adds constants that are linux-specific
 func init() {
 	var extra = map[string]*bit{
-		"iuclc": {word: I, mask: syscall.IUCLC},
-		"olcuc": {word: O, mask: syscall.OLCUC},
-		"xcase": {word: L, mask: syscall.XCASE},
+		"iuclc":  {word: I, mask: syscall.IUCLC},
+		"olcuc":  {word: O, mask: syscall.OLCUC},
+		"xcase":  {word: L, mask: syscall.XCASE},
+		"cmspar": {word: C, mask: unix.CMSPAR},
 	}
 	for k, v := range extra {
 		boolFields[k] = v
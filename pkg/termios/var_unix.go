@@ -67,16 +67,24 @@ var (
 
 		//Controlprocessing
 
-		"cstopb": {word: C, mask: syscall.CSTOPB},
-		"cread":  {word: C, mask: syscall.CREAD},
-		"parenb": {word: C, mask: syscall.PARENB},
-		"parodd": {word: C, mask: syscall.PARODD},
-		"hupcl":  {word: C, mask: syscall.HUPCL},
-		"clocal": {word: C, mask: syscall.CLOCAL},
+		"cstopb":  {word: C, mask: syscall.CSTOPB},
+		"cread":   {word: C, mask: syscall.CREAD},
+		"parenb":  {word: C, mask: syscall.PARENB},
+		"parodd":  {word: C, mask: syscall.PARODD},
+		"hupcl":   {word: C, mask: syscall.HUPCL},
+		"clocal":  {word: C, mask: syscall.CLOCAL},
+		"crtscts": {word: C, mask: unix.CRTSCTS},
+	}
+	// csizes are the character sizes, a field of the control flags.
+	csizes = map[string]uint32{
+		"cs5": syscall.CS5,
+		"cs6": syscall.CS6,
+		"cs7": syscall.CS7,
+		"cs8": syscall.CS8,
 	}
 	cc = map[string]int{
-		"min":   5,
-		"time":  0,
+		"min":   syscall.VMIN,
+		"time":  syscall.VTIME,
 		"lnext": syscall.VLNEXT,
 		//"flush": syscall.VFLUSH,
 		"intr":  syscall.VINTR,
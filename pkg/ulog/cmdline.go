@@ -0,0 +1,29 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ulog
+
+import (
+	"github.com/u-root/u-root/pkg/cmdline"
+)
+
+// ConfigureFromCmdline configures Default from the kernel command line:
+//
+//     uroot.verbose[=SPEC]   levels, as taken by SetVerbosity
+//     uroot.log=SINKS        sinks, as taken by SetSinkSpec
+//
+// such as "uroot.verbose=warning,dhclient:debug uroot.log=kmsg,/run/boot.log".
+// Errors leave the default in place and are logged to it.
+func ConfigureFromCmdline() {
+	if v, ok := cmdline.Flag("uroot.verbose"); ok {
+		if err := Default.SetVerbosity(v); err != nil {
+			Default.Log("ulog", LevelWarning, "uroot.verbose: "+err.Error())
+		}
+	}
+	if v, ok := cmdline.Flag("uroot.log"); ok {
+		if err := Default.SetSinkSpec(v); err != nil {
+			Default.Log("ulog", LevelWarning, "uroot.log: "+err.Error())
+		}
+	}
+}
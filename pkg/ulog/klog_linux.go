@@ -115,3 +115,34 @@ func (k *KLog) Read(b []byte) (int, error) {
 func (k *KLog) ReadClear(b []byte) (int, error) {
 	return unix.Klogctl(unix.SYSLOG_ACTION_READ_CLEAR, b)
 }
+
+// kmsgLevels maps the levels of Structured to printk levels.
+var kmsgLevels = map[Level]KLogLevel{
+	LevelError:   KLogError,
+	LevelWarning: KLogWarning,
+	LevelInfo:    KLogInfo,
+	LevelDebug:   KLogDebug,
+}
+
+type kmsgSink struct {
+	k *KLog
+}
+
+// KmsgSink returns a Sink writing to the kernel log with the printk level of
+// each entry, so dmesg can filter them. It falls back to text on stderr if
+// /dev/kmsg cannot be written to.
+func KmsgSink() Sink {
+	return kmsgSink{KernelLog}
+}
+
+func (s kmsgSink) Write(e *Entry) error {
+	msg := e.Message
+	if e.Component != "" {
+		msg = e.Component + ": " + msg
+	}
+	if s.k.File == nil {
+		return TextSink(os.Stderr).Write(e)
+	}
+	_, err := fmt.Fprintf(s.k.File, "<%d>%s", kmsgLevels[e.Level], msg)
+	return err
+}
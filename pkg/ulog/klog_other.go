@@ -6,5 +6,12 @@
 
 package ulog
 
+import "os"
+
 // KernelLog prints to stderr log on non-Linux systems.
 var KernelLog = Log
+
+// KmsgSink returns a Sink writing text to stderr on non-Linux systems.
+func KmsgSink() Sink {
+	return TextSink(os.Stderr)
+}
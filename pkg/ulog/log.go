@@ -8,6 +8,11 @@
 // library "log" package Logger, a kernel syslog (dmesg) Logger, and a test
 // Logger that logs via a test's testing.TB.Logf.
 // To use the test logger import "ulog/ulogtest".
+//
+// For init and commands, ulog also has a Structured logger: messages have a
+// level and the component that logged them, are filtered by level per
+// component, and go to text, JSON, kernel log or file sinks. The uroot.verbose
+// and uroot.log kernel parameters configure it, see ConfigureFromCmdline.
 package ulog
 
 import (
@@ -0,0 +1,345 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ulog
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Level is the severity of a message. Lower levels are more severe.
+type Level int
+
+// These are the levels of Structured, a subset of the syslog levels.
+const (
+	LevelError Level = iota
+	LevelWarning
+	LevelInfo
+	LevelDebug
+)
+
+var levelNames = []string{"error", "warning", "info", "debug"}
+
+// String returns the name of the level, like "warning".
+func (l Level) String() string {
+	if l < 0 || int(l) >= len(levelNames) {
+		return fmt.Sprintf("Level(%d)", int(l))
+	}
+	return levelNames[l]
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (l Level) MarshalText() ([]byte, error) {
+	return []byte(l.String()), nil
+}
+
+// ParseLevel parses a level name, like "debug", or number, like "3".
+func ParseLevel(s string) (Level, error) {
+	s = strings.ToLower(s)
+	for i, n := range levelNames {
+		if s == n {
+			return Level(i), nil
+		}
+	}
+	if s == "warn" {
+		return LevelWarning, nil
+	}
+	if n, err := strconv.Atoi(s); err == nil && n >= 0 && n < len(levelNames) {
+		return Level(n), nil
+	}
+	return 0, fmt.Errorf("unknown log level %q", s)
+}
+
+// Entry is one log message.
+type Entry struct {
+	Time      time.Time `json:"time"`
+	Level     Level     `json:"level"`
+	Component string    `json:"component,omitempty"`
+	Message   string    `json:"msg"`
+}
+
+// Sink is where a Structured logger writes entries to.
+type Sink interface {
+	Write(e *Entry) error
+}
+
+type textSink struct {
+	w io.Writer
+}
+
+// TextSink returns a Sink that writes entries to w as lines of text, like
+//
+//     2021/03/04 05:06:07 [info] dhclient: Configured eth0
+func TextSink(w io.Writer) Sink {
+	return textSink{w}
+}
+
+func (t textSink) Write(e *Entry) error {
+	var b strings.Builder
+	b.WriteString(e.Time.Format("2006/01/02 15:04:05 ["))
+	b.WriteString(e.Level.String())
+	b.WriteString("] ")
+	if e.Component != "" {
+		b.WriteString(e.Component)
+		b.WriteString(": ")
+	}
+	b.WriteString(strings.TrimSuffix(e.Message, "\n"))
+	b.WriteByte('\n')
+	_, err := io.WriteString(t.w, b.String())
+	return err
+}
+
+type jsonSink struct {
+	enc *json.Encoder
+}
+
+// JSONSink returns a Sink that writes entries to w as JSON objects, one per
+// line, with the keys "time", "level", "component" and "msg".
+func JSONSink(w io.Writer) Sink {
+	return jsonSink{json.NewEncoder(w)}
+}
+
+func (j jsonSink) Write(e *Entry) error {
+	return j.enc.Encode(e)
+}
+
+// OpenFileSink returns a JSONSink appending to the file at path, which is
+// created if needed. The file stays open for the life of the process.
+func OpenFileSink(path string) (Sink, error) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return JSONSink(f), nil
+}
+
+// Structured is a leveled logger writing entries tagged with the component
+// that logged them to a set of sinks.
+//
+// Entries less severe than the level of their component, or the default level
+// if the component has none, are dropped.
+type Structured struct {
+	mu     sync.Mutex
+	level  Level
+	levels map[string]Level
+	sinks  []Sink
+	now    func() time.Time
+}
+
+// NewStructured returns a Structured logger of the given level and sinks.
+func NewStructured(level Level, sinks ...Sink) *Structured {
+	return &Structured{
+		level:  level,
+		levels: map[string]Level{},
+		sinks:  sinks,
+		now:    time.Now,
+	}
+}
+
+// Default is the Structured logger of init and the commands. It writes text
+// to stderr at LevelInfo until configured otherwise, e.g. by
+// ConfigureFromCmdline.
+var Default = NewStructured(LevelInfo, TextSink(os.Stderr))
+
+// SetLevel sets the default level.
+func (s *Structured) SetLevel(l Level) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.level = l
+}
+
+// SetComponentLevel sets the level of one component.
+func (s *Structured) SetComponentLevel(component string, l Level) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.levels[component] = l
+}
+
+// SetSinks replaces the sinks.
+func (s *Structured) SetSinks(sinks ...Sink) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sinks = sinks
+}
+
+// AddSink adds a sink.
+func (s *Structured) AddSink(sink Sink) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sinks = append(s.sinks, sink)
+}
+
+// Enabled returns whether entries of component at level l are logged.
+func (s *Structured) Enabled(component string, l Level) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.enabled(component, l)
+}
+
+func (s *Structured) enabled(component string, l Level) bool {
+	max, ok := s.levels[component]
+	if !ok {
+		max = s.level
+	}
+	return l <= max
+}
+
+// Log logs msg for component at level l, if enabled.
+//
+// Write errors are dropped: there is nowhere left to report them.
+func (s *Structured) Log(component string, l Level, msg string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.enabled(component, l) {
+		return
+	}
+	e := &Entry{Time: s.now(), Level: l, Component: component, Message: msg}
+	for _, sink := range s.sinks {
+		_ = sink.Write(e)
+	}
+}
+
+// Component returns a logger tagging its entries with name.
+func (s *Structured) Component(name string) *ComponentLogger {
+	return &ComponentLogger{s: s, name: name}
+}
+
+// Component returns a logger of Default tagging its entries with name.
+func Component(name string) *ComponentLogger {
+	return Default.Component(name)
+}
+
+// ComponentLogger logs the entries of one component.
+//
+// It is also a Logger, logging at LevelInfo, so it can be passed to packages
+// taking one.
+type ComponentLogger struct {
+	s    *Structured
+	name string
+}
+
+// Errorf logs an error.
+func (c *ComponentLogger) Errorf(format string, v ...interface{}) {
+	c.logf(LevelError, format, v...)
+}
+
+// Warningf logs a warning.
+func (c *ComponentLogger) Warningf(format string, v ...interface{}) {
+	c.logf(LevelWarning, format, v...)
+}
+
+// Infof logs an informational message.
+func (c *ComponentLogger) Infof(format string, v ...interface{}) {
+	c.logf(LevelInfo, format, v...)
+}
+
+// Debugf logs a debug message.
+func (c *ComponentLogger) Debugf(format string, v ...interface{}) {
+	c.logf(LevelDebug, format, v...)
+}
+
+// Printf logs at LevelInfo.
+func (c *ComponentLogger) Printf(format string, v ...interface{}) {
+	c.logf(LevelInfo, format, v...)
+}
+
+// Print logs at LevelInfo.
+func (c *ComponentLogger) Print(v ...interface{}) {
+	if c.s.Enabled(c.name, LevelInfo) {
+		c.s.Log(c.name, LevelInfo, fmt.Sprint(v...))
+	}
+}
+
+// Enabled returns whether entries at level l are logged. It saves
+// formatting expensive debug output.
+func (c *ComponentLogger) Enabled(l Level) bool {
+	return c.s.Enabled(c.name, l)
+}
+
+// At returns a Logger logging at level l.
+func (c *ComponentLogger) At(l Level) Logger {
+	return levelLogger{c, l}
+}
+
+func (c *ComponentLogger) logf(l Level, format string, v ...interface{}) {
+	// Check before formatting, debug messages are often long.
+	if c.s.Enabled(c.name, l) {
+		c.s.Log(c.name, l, fmt.Sprintf(format, v...))
+	}
+}
+
+type levelLogger struct {
+	c *ComponentLogger
+	l Level
+}
+
+func (ll levelLogger) Printf(format string, v ...interface{}) {
+	ll.c.logf(ll.l, format, v...)
+}
+
+func (ll levelLogger) Print(v ...interface{}) {
+	if ll.c.s.Enabled(ll.c.name, ll.l) {
+		ll.c.s.Log(ll.c.name, ll.l, fmt.Sprint(v...))
+	}
+}
+
+// SetVerbosity sets levels from a comma separated list of levels, applying
+// to all components, and component:level pairs, like
+// "warning,dhclient:debug". An empty spec means debug for all components.
+func (s *Structured) SetVerbosity(spec string) error {
+	if spec == "" {
+		s.SetLevel(LevelDebug)
+		return nil
+	}
+	for _, item := range strings.Split(spec, ",") {
+		c, l := "", item
+		if i := strings.LastIndexByte(item, ':'); i >= 0 {
+			c, l = item[:i], item[i+1:]
+		}
+		level, err := ParseLevel(l)
+		if err != nil {
+			return err
+		}
+		if c == "" {
+			s.SetLevel(level)
+		} else {
+			s.SetComponentLevel(c, level)
+		}
+	}
+	return nil
+}
+
+// SetSinkSpec sets the sinks from a comma separated list of "stderr" (text),
+// "json" (JSON to stderr), "kmsg" (the kernel log) and absolute file paths
+// (JSON).
+func (s *Structured) SetSinkSpec(spec string) error {
+	var sinks []Sink
+	for _, item := range strings.Split(spec, ",") {
+		switch {
+		case item == "stderr":
+			sinks = append(sinks, TextSink(os.Stderr))
+		case item == "json":
+			sinks = append(sinks, JSONSink(os.Stderr))
+		case item == "kmsg":
+			sinks = append(sinks, KmsgSink())
+		case strings.HasPrefix(item, "/"):
+			f, err := OpenFileSink(item)
+			if err != nil {
+				return err
+			}
+			sinks = append(sinks, f)
+		default:
+			return fmt.Errorf("unknown log sink %q", item)
+		}
+	}
+	s.SetSinks(sinks...)
+	return nil
+}
@@ -0,0 +1,107 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ulog
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func newTest(level Level, sinks ...Sink) *Structured {
+	s := NewStructured(level, sinks...)
+	s.now = func() time.Time { return time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC) }
+	return s
+}
+
+func TestStructuredText(t *testing.T) {
+	var b bytes.Buffer
+	s := newTest(LevelInfo, TextSink(&b))
+	l := s.Component("dhclient")
+	l.Debugf("dropped %d", 1)
+	l.Infof("Configured %s", "eth0")
+	l.Errorf("failed")
+	s.Component("").Print("bare")
+	l.At(LevelWarning).Printf("at %s", "warning")
+
+	want := `2021/03/04 05:06:07 [info] dhclient: Configured eth0
+2021/03/04 05:06:07 [error] dhclient: failed
+2021/03/04 05:06:07 [info] bare
+2021/03/04 05:06:07 [warning] dhclient: at warning
+`
+	if b.String() != want {
+		t.Errorf("got:\n%s\nwant:\n%s", b.String(), want)
+	}
+}
+
+func TestStructuredJSON(t *testing.T) {
+	var b bytes.Buffer
+	s := newTest(LevelInfo, JSONSink(&b))
+	s.Component("boot").Warningf("no %s", "kernel")
+
+	want := `{"time":"2021-03-04T05:06:07Z","level":"warning","component":"boot","msg":"no kernel"}` + "\n"
+	if b.String() != want {
+		t.Errorf("got %s, want %s", b.String(), want)
+	}
+	var e map[string]interface{}
+	if err := json.Unmarshal(b.Bytes(), &e); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSetVerbosity(t *testing.T) {
+	for _, tt := range []struct {
+		spec string
+		// want is whether info and debug of "a" and "b" are enabled.
+		want [4]bool
+		err  bool
+	}{
+		{spec: "", want: [4]bool{true, true, true, true}},
+		{spec: "debug", want: [4]bool{true, true, true, true}},
+		{spec: "a:debug", want: [4]bool{true, true, true, false}},
+		{spec: "warning,b:3", want: [4]bool{false, false, true, true}},
+		{spec: "a:loud", err: true, want: [4]bool{true, false, true, false}},
+	} {
+		s := newTest(LevelInfo)
+		err := s.SetVerbosity(tt.spec)
+		if (err != nil) != tt.err {
+			t.Errorf("SetVerbosity(%q) = %v, want error %t", tt.spec, err, tt.err)
+		}
+		got := [4]bool{
+			s.Enabled("a", LevelInfo), s.Enabled("a", LevelDebug),
+			s.Enabled("b", LevelInfo), s.Enabled("b", LevelDebug),
+		}
+		if got != tt.want {
+			t.Errorf("SetVerbosity(%q): enabled = %v, want %v", tt.spec, got, tt.want)
+		}
+	}
+}
+
+func TestParseLevel(t *testing.T) {
+	for in, want := range map[string]Level{
+		"error": LevelError,
+		"WARN":  LevelWarning,
+		"2":     LevelInfo,
+		"debug": LevelDebug,
+	} {
+		if got, err := ParseLevel(in); err != nil || got != want {
+			t.Errorf("ParseLevel(%q) = %v, %v, want %v", in, got, err, want)
+		}
+	}
+	if _, err := ParseLevel("4"); err == nil {
+		t.Errorf("ParseLevel(\"4\") succeeded")
+	}
+}
+
+func TestSetSinkSpec(t *testing.T) {
+	s := newTest(LevelInfo)
+	if err := s.SetSinkSpec("stderr,json"); err != nil || len(s.sinks) != 2 {
+		t.Errorf("SetSinkSpec(\"stderr,json\") = %v, %d sinks, want 2", err, len(s.sinks))
+	}
+	if err := s.SetSinkSpec("syslog"); err == nil {
+		t.Errorf("SetSinkSpec(\"syslog\") succeeded")
+	}
+}
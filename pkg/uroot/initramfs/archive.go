@@ -83,11 +83,39 @@ type Opts struct {
 	// If this is false, the "init" file in BaseArchive will be renamed
 	// "inito" (for init-original) in the output archive.
 	UseExistingInit bool
+
+	// Reproducible writes the records through a cpio.ReproducibleWriter,
+	// sorted, without timestamps, and with files of identical content
+	// hard linked, so that the same files always give the same archive.
+	//
+	// Hard links are only understood by cpio archives.
+	Reproducible bool
+}
+
+// reproducibleWriter is a Writer writing records through a
+// cpio.ReproducibleWriter.
+type reproducibleWriter struct {
+	*cpio.ReproducibleWriter
+
+	w Writer
+}
+
+// Finish implements Writer.Finish.
+func (r reproducibleWriter) Finish() error {
+	if err := r.Flush(); err != nil {
+		return err
+	}
+	return r.w.Finish()
 }
 
 // Write uses the given options to determine which files to write to the output
 // initramfs.
 func Write(opts *Opts) error {
+	out := opts.OutputFile
+	if opts.Reproducible {
+		out = reproducibleWriter{cpio.NewReproducibleWriter(out), out}
+	}
+
 	// Write base archive.
 	if opts.BaseArchive != nil {
 		transform := cpio.MakeReproducible
@@ -107,22 +135,23 @@ func Write(opts *Opts) error {
 			opts.Rename("init", "inito")
 		}
 
-		for {
-			f, err := opts.BaseArchive.ReadRecord()
-			if err == io.EOF {
-				break
-			}
-			if err != nil {
-				return err
-			}
+		records, err := cpio.ReadAllRecords(opts.BaseArchive)
+		if err != nil {
+			return err
+		}
+		// Making the records reproducible drops their inode numbers,
+		// so hard links, e.g. of a reproducible base archive, are
+		// given their content first.
+		cpio.ResolveHardLinks(records)
+		for _, f := range records {
 			// TODO: ignore only the error where it already exists
 			// in archive.
 			opts.Files.AddRecord(transform(f))
 		}
 	}
 
-	if err := opts.Files.WriteTo(opts.OutputFile); err != nil {
+	if err := opts.Files.WriteTo(out); err != nil {
 		return err
 	}
-	return opts.OutputFile.Finish()
+	return out.Finish()
 }
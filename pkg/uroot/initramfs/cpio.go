@@ -9,6 +9,7 @@ import (
 	"io"
 	"os"
 
+	"github.com/u-root/u-root/pkg/compress"
 	"github.com/u-root/u-root/pkg/cpio"
 	"github.com/u-root/u-root/pkg/ulog"
 )
@@ -28,7 +29,26 @@ func (ca CPIOArchiver) OpenWriter(l ulog.Logger, path string) (Writer, error) {
 	if err != nil {
 		return nil, err
 	}
-	return osWriter{ca.RecordFormat.Writer(f), f}, nil
+	return osWriter{ca.RecordFormat.Writer(f), f, nil}, nil
+}
+
+// OpenCompressedWriter opens `path` like OpenWriter, and returns a Writer
+// streaming the archive through the compressor c at the given level, e.g.
+// compress.DefaultLevel.
+func (ca CPIOArchiver) OpenCompressedWriter(l ulog.Logger, path string, c *compress.Format, level int) (Writer, error) {
+	if len(path) == 0 {
+		return nil, fmt.Errorf("path is required")
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return nil, err
+	}
+	cw, err := c.Writer(f, level)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return osWriter{ca.RecordFormat.Writer(cw), f, cw}, nil
 }
 
 // osWriter implements Writer.
@@ -36,12 +56,22 @@ type osWriter struct {
 	cpio.RecordWriter
 
 	f *os.File
+
+	// c is the compressor between the records and f, if there is one.
+	c io.WriteCloser
 }
 
 // Finish implements Writer.Finish.
 func (o osWriter) Finish() error {
 	err := cpio.WriteTrailer(o)
-	o.f.Close()
+	if o.c != nil {
+		if cerr := o.c.Close(); err == nil {
+			err = cerr
+		}
+	}
+	if cerr := o.f.Close(); err == nil {
+		err = cerr
+	}
 	return err
 }
 
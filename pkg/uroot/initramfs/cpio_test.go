@@ -0,0 +1,109 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package initramfs
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/u-root/u-root/pkg/compress"
+	"github.com/u-root/u-root/pkg/cpio"
+	"github.com/u-root/u-root/pkg/uio"
+	"github.com/u-root/u-root/pkg/ulog/ulogtest"
+)
+
+func TestOpenCompressedWriter(t *testing.T) {
+	dir, err := ioutil.TempDir("", "initramfs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for _, c := range []*compress.Format{compress.Gzip, compress.Xz, compress.Zstd} {
+		path := filepath.Join(dir, "initramfs.cpio"+c.Extensions[0])
+		w, err := CPIO.OpenCompressedWriter(ulogtest.Logger{TB: t}, path, c, compress.DefaultLevel)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := w.WriteRecord(cpio.StaticFile("init", "#!/bin/sh\n", 0755)); err != nil {
+			t.Fatal(err)
+		}
+		if err := w.Finish(); err != nil {
+			t.Fatal(err)
+		}
+
+		f, err := os.Open(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+		recs, err := cpio.ReadAllRecords(CPIO.Reader(compress.TryDecompress(f)))
+		if err != nil {
+			t.Fatalf("%s: %v", c, err)
+		}
+		if len(recs) != 1 || recs[0].Name != "init" {
+			t.Errorf("%s: got records %v, want init", c, recs)
+		}
+	}
+}
+
+func TestReproducibleBase(t *testing.T) {
+	dir, err := ioutil.TempDir("", "initramfs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// write writes an archive of files on top of base, and returns its
+	// records.
+	write := func(name string, base Reader, files ...cpio.Record) []cpio.Record {
+		path := filepath.Join(dir, name)
+		w, err := CPIO.OpenWriter(ulogtest.Logger{TB: t}, path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		opts := &Opts{Files: NewFiles(), OutputFile: w, BaseArchive: base, Reproducible: true}
+		for _, f := range files {
+			if err := opts.AddRecord(f); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := Write(opts); err != nil {
+			t.Fatalf("Writing %s: %v", name, err)
+		}
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		recs, err := cpio.ReadAllRecords(CPIO.Reader(bytes.NewReader(b)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return recs
+	}
+
+	// a and b are hard links in the base archive.
+	base := write("base.cpio", nil,
+		cpio.StaticFile("a", "same content", 0644),
+		cpio.StaticFile("b", "same content", 0644))
+	recs := write("initramfs.cpio", cpio.ArchiveFromRecords(base).Reader(), cpio.StaticFile("c", "new", 0644))
+	cpio.ResolveHardLinks(recs)
+	got := map[string]string{}
+	for _, r := range recs {
+		b, err := ioutil.ReadAll(uio.Reader(r))
+		if err != nil {
+			t.Fatal(err)
+		}
+		got[r.Name] = string(b)
+	}
+	for name, want := range map[string]string{"a": "same content", "b": "same content", "c": "new"} {
+		if got[name] != want {
+			t.Errorf("%s = %q, want %q", name, got[name], want)
+		}
+	}
+}
@@ -183,6 +183,11 @@ type Opts struct {
 	// "inito" (init-original).
 	UseExistingInit bool
 
+	// Reproducible makes the archive the same for the same files: records
+	// are sorted and without timestamps, and identical files are hard
+	// linked. See initramfs.Opts.Reproducible.
+	Reproducible bool
+
 	// InitCmd is the name of a command to link /init to.
 	//
 	// This can be an absolute path or the name of a command included in
@@ -266,6 +271,7 @@ func CreateInitramfs(logger ulog.Logger, opts Opts) error {
 		OutputFile:      opts.OutputFile,
 		BaseArchive:     opts.BaseArchive,
 		UseExistingInit: opts.UseExistingInit,
+		Reproducible:    opts.Reproducible,
 	}
 	if err := ParseExtraFiles(logger, archive.Files, opts.ExtraFiles, !opts.SkipLDD); err != nil {
 		return err
@@ -352,11 +358,10 @@ func resolvePackagePath(logger ulog.Logger, env golang.Environ, pkg string) ([]s
 			if err != nil {
 				logger.Printf("Skipping package %q: %v", match, err)
 			} else if p.ImportPath == "." {
-				// TODO: I do not completely understand why
-				// this is triggered. This is only an issue
-				// while this function is run inside the
-				// process of a "go test".
-				importPaths = append(importPaths, pkg)
+				// The directory is not in GOPATH, so it has
+				// no import path. The bb builder takes
+				// directories too.
+				importPaths = append(importPaths, p.Dir)
 			} else {
 				importPaths = append(importPaths, p.ImportPath)
 			}
@@ -480,6 +485,7 @@ func ParseExtraFiles(logger ulog.Logger, archive *initramfs.Files, extraFiles []
 		if lddDeps {
 			// Users are frequently naming directories now, not just files.
 			// Hence we must use walk here, not just check the one file.
+			var elfs []string
 			if err := filepath.Walk(src, func(name string, info os.FileInfo, err error) error {
 				if err != nil {
 					return err
@@ -496,27 +502,36 @@ func ParseExtraFiles(logger ulog.Logger, archive *initramfs.Files, extraFiles []
 				if err = f.Close(); err != nil {
 					logger.Printf("WARNING: Closing ELF file %q: %v", name, err)
 				}
-				// Pull dependencies in the case of binaries. If `path` is not
-				// a binary, `libs` will just be empty.
-				libs, err := ldd.List([]string{name})
-				if err != nil {
-					return fmt.Errorf("WARNING: couldn't add ldd dependencies for %q: %v", name, err)
-				}
-				for _, lib := range libs {
-					// N.B.: we already added information about the src.
-					// Don't add it twice. We have to do this check here in
-					// case we're renaming the src to a different dest.
-					if lib == name {
-						continue
-					}
-					if err := archive.AddFileNoFollow(lib, lib[1:]); err != nil {
-						logger.Printf("WARNING: couldn't add ldd dependencies for %q: %v", lib, err)
-					}
-				}
+				elfs = append(elfs, name)
 				return nil
 			}); err != nil {
 				logger.Printf("Getting dependencies for %q: %v", src, err)
 			}
+			if len(elfs) == 0 {
+				continue
+			}
+			// Pull dependencies in the case of binaries, all at once so
+			// they are resolved in parallel.
+			libs, err := ldd.List(elfs)
+			if err != nil {
+				logger.Printf("WARNING: couldn't add ldd dependencies for %q: %v", src, err)
+				continue
+			}
+			added := make(map[string]bool)
+			for _, name := range elfs {
+				added[name] = true
+			}
+			for _, lib := range libs {
+				// N.B.: we already added information about the src.
+				// Don't add it twice. We have to do this check here in
+				// case we're renaming the src to a different dest.
+				if added[lib] {
+					continue
+				}
+				if err := archive.AddFileNoFollow(lib, lib[1:]); err != nil {
+					logger.Printf("WARNING: couldn't add ldd dependencies for %q: %v", lib, err)
+				}
+			}
 		}
 	}
 	return nil
@@ -0,0 +1,158 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package usb
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Descriptor types.
+const (
+	DescDevice               = 0x01
+	DescConfiguration        = 0x02
+	DescString               = 0x03
+	DescInterface            = 0x04
+	DescEndpoint             = 0x05
+	DescInterfaceAssociation = 0x0b
+	DescHID                  = 0x21
+)
+
+// Descriptor is a raw descriptor, including its length and type bytes.
+type Descriptor []byte
+
+// Type returns the descriptor type.
+func (d Descriptor) Type() uint8 {
+	return d[1]
+}
+
+// ParseDescriptors splits a descriptor chain, such as the descriptors file
+// in sysfs, into its descriptors.
+func ParseDescriptors(b []byte) ([]Descriptor, error) {
+	var ds []Descriptor
+	for len(b) > 0 {
+		l := int(b[0])
+		if l < 2 || l > len(b) {
+			return ds, fmt.Errorf("bad descriptor length %d with %d bytes left", l, len(b))
+		}
+		ds = append(ds, Descriptor(b[:l]))
+		b = b[l:]
+	}
+	return ds, nil
+}
+
+type fieldKind int
+
+const (
+	kNum fieldKind = iota
+	kHex
+	kBCD
+	kClass
+	kEndpoint
+	kAttr
+	kPower
+)
+
+type descField struct {
+	name string
+	size int
+	kind fieldKind
+}
+
+var descFields = map[uint8]struct {
+	title  string
+	indent int
+	fields []descField
+}{
+	DescDevice: {"Device Descriptor", 0, []descField{
+		{"bcdUSB", 2, kBCD}, {"bDeviceClass", 1, kClass}, {"bDeviceSubClass", 1, kNum},
+		{"bDeviceProtocol", 1, kNum}, {"bMaxPacketSize0", 1, kNum}, {"idVendor", 2, kHex},
+		{"idProduct", 2, kHex}, {"bcdDevice", 2, kBCD}, {"iManufacturer", 1, kNum},
+		{"iProduct", 1, kNum}, {"iSerial", 1, kNum}, {"bNumConfigurations", 1, kNum},
+	}},
+	DescConfiguration: {"Configuration Descriptor", 1, []descField{
+		{"wTotalLength", 2, kHex}, {"bNumInterfaces", 1, kNum}, {"bConfigurationValue", 1, kNum},
+		{"iConfiguration", 1, kNum}, {"bmAttributes", 1, kHex}, {"MaxPower", 1, kPower},
+	}},
+	DescInterfaceAssociation: {"Interface Association", 2, []descField{
+		{"bFirstInterface", 1, kNum}, {"bInterfaceCount", 1, kNum}, {"bFunctionClass", 1, kClass},
+		{"bFunctionSubClass", 1, kNum}, {"bFunctionProtocol", 1, kNum}, {"iFunction", 1, kNum},
+	}},
+	DescInterface: {"Interface Descriptor", 2, []descField{
+		{"bInterfaceNumber", 1, kNum}, {"bAlternateSetting", 1, kNum}, {"bNumEndpoints", 1, kNum},
+		{"bInterfaceClass", 1, kClass}, {"bInterfaceSubClass", 1, kNum},
+		{"bInterfaceProtocol", 1, kNum}, {"iInterface", 1, kNum},
+	}},
+	DescEndpoint: {"Endpoint Descriptor", 3, []descField{
+		{"bEndpointAddress", 1, kEndpoint}, {"bmAttributes", 1, kAttr},
+		{"wMaxPacketSize", 2, kHex}, {"bInterval", 1, kNum},
+	}},
+}
+
+var transferTypes = []string{"Control", "Isochronous", "Bulk", "Interrupt"}
+
+// WriteDescriptors prints a descriptor chain in the style of lsusb -v.
+// Descriptors it does not know, such as class specific ones, are dumped
+// in hex.
+func WriteDescriptors(w io.Writer, b []byte) error {
+	ds, err := ParseDescriptors(b)
+	indent := 0
+	for _, d := range ds {
+		f, ok := descFields[d.Type()]
+		if ok {
+			indent = f.indent
+		}
+		pad := strings.Repeat("  ", indent)
+		if !ok {
+			pad += "  "
+			fmt.Fprintf(w, "%sDescriptor type %#02x:\n%s  % x\n", pad, d.Type(), pad, []byte(d))
+			continue
+		}
+		fmt.Fprintf(w, "%s%s:\n", pad, f.title)
+		fmt.Fprintf(w, "%s  %-20s%5d\n", pad, "bLength", d[0])
+		fmt.Fprintf(w, "%s  %-20s%5d\n", pad, "bDescriptorType", d[1])
+		off := 2
+		for _, fl := range f.fields {
+			if off+fl.size > len(d) {
+				break
+			}
+			var v uint16
+			if fl.size == 2 {
+				v = binary.LittleEndian.Uint16(d[off:])
+			} else {
+				v = uint16(d[off])
+			}
+			off += fl.size
+			var s string
+			switch fl.kind {
+			case kNum:
+				s = fmt.Sprintf("%5d", v)
+			case kHex:
+				s = fmt.Sprintf("0x%04x", v)
+				if fl.size == 1 {
+					s = fmt.Sprintf("  0x%02x", v)
+				}
+			case kBCD:
+				s = fmt.Sprintf("%2x.%02x", v>>8, v&0xff)
+			case kClass:
+				s = fmt.Sprintf("%5d %s", v, ClassName(uint8(v)))
+			case kEndpoint:
+				dir := "OUT"
+				if v&0x80 != 0 {
+					dir = "IN"
+				}
+				s = fmt.Sprintf("  0x%02x  EP %d %s", v, v&0xf, dir)
+			case kAttr:
+				s = fmt.Sprintf("%5d  Transfer Type %s", v, transferTypes[v&3])
+			case kPower:
+				s = fmt.Sprintf("%5dmA", 2*v)
+			}
+			fmt.Fprintf(w, "%s  %-20s%s\n", pad, fl.name, s)
+		}
+	}
+	return err
+}
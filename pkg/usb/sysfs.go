@@ -0,0 +1,164 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package usb
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// SysfsPath is where USB devices appear in sysfs.
+var SysfsPath = "/sys/bus/usb/devices"
+
+type attrs struct {
+	dir string
+	err error
+}
+
+func (a *attrs) str(name string) string {
+	b, err := ioutil.ReadFile(filepath.Join(a.dir, name))
+	if err != nil {
+		// Strings like the serial number are optional.
+		if !os.IsNotExist(err) && a.err == nil {
+			a.err = err
+		}
+		return ""
+	}
+	return strings.TrimSpace(string(b))
+}
+
+func (a *attrs) num(name string, base, bits int) uint64 {
+	s := a.str(name)
+	if s == "" {
+		return 0
+	}
+	n, err := strconv.ParseUint(s, base, bits)
+	if err != nil && a.err == nil {
+		a.err = err
+	}
+	return n
+}
+
+func driver(dir string) string {
+	l, err := os.Readlink(filepath.Join(dir, "driver"))
+	if err != nil {
+		return ""
+	}
+	return filepath.Base(l)
+}
+
+func readDevice(dir string) (*Device, error) {
+	a := &attrs{dir: dir}
+	d := &Device{
+		Name:         filepath.Base(dir),
+		Bus:          int(a.num("busnum", 10, 16)),
+		Dev:          int(a.num("devnum", 10, 16)),
+		Vendor:       uint16(a.num("idVendor", 16, 16)),
+		Product:      uint16(a.num("idProduct", 16, 16)),
+		BCDDev:       uint16(a.num("bcdDevice", 16, 16)),
+		Class:        uint8(a.num("bDeviceClass", 16, 8)),
+		SubClass:     uint8(a.num("bDeviceSubClass", 16, 8)),
+		Protocol:     uint8(a.num("bDeviceProtocol", 16, 8)),
+		Version:      a.str("version"),
+		Speed:        a.str("speed"),
+		MaxChild:     int(a.num("maxchild", 10, 16)),
+		Manufacturer: a.str("manufacturer"),
+		ProductName:  a.str("product"),
+		Serial:       a.str("serial"),
+		Driver:       driver(dir),
+	}
+	if a.err != nil {
+		return nil, a.err
+	}
+	d.Descriptors, _ = ioutil.ReadFile(filepath.Join(dir, "descriptors"))
+	return d, nil
+}
+
+func readInterface(dir string) (*Interface, error) {
+	a := &attrs{dir: dir}
+	i := &Interface{
+		Name:       filepath.Base(dir),
+		Number:     uint8(a.num("bInterfaceNumber", 16, 8)),
+		AltSetting: uint8(a.num("bAlternateSetting", 16, 8)),
+		Class:      uint8(a.num("bInterfaceClass", 16, 8)),
+		SubClass:   uint8(a.num("bInterfaceSubClass", 16, 8)),
+		Protocol:   uint8(a.num("bInterfaceProtocol", 16, 8)),
+		Endpoints:  int(a.num("bNumEndpoints", 16, 8)),
+		Driver:     driver(dir),
+	}
+	return i, a.err
+}
+
+// parentName returns the sysfs name of the hub a device is plugged into:
+// 1-1.2 is on 1-1, which is on root hub usb1.
+func parentName(name string) string {
+	if strings.HasPrefix(name, "usb") {
+		return ""
+	}
+	if i := strings.LastIndexByte(name, '.'); i >= 0 {
+		return name[:i]
+	}
+	if i := strings.IndexByte(name, '-'); i >= 0 {
+		return "usb" + name[:i]
+	}
+	return ""
+}
+
+// Devices returns the USB devices sorted by bus and device number, with
+// their interfaces and their place in the topology.
+func Devices() ([]*Device, error) {
+	entries, err := ioutil.ReadDir(SysfsPath)
+	if err != nil {
+		return nil, err
+	}
+	byName := map[string]*Device{}
+	var devs []*Device
+	var ifaces []string
+	for _, e := range entries {
+		n := e.Name()
+		if strings.Contains(n, ":") {
+			ifaces = append(ifaces, n)
+			continue
+		}
+		d, err := readDevice(filepath.Join(SysfsPath, n))
+		if err != nil {
+			return nil, err
+		}
+		byName[n] = d
+		devs = append(devs, d)
+	}
+	sort.Strings(ifaces)
+	for _, n := range ifaces {
+		d, ok := byName[n[:strings.IndexByte(n, ':')]]
+		if !ok {
+			continue
+		}
+		i, err := readInterface(filepath.Join(SysfsPath, n))
+		if err != nil {
+			return nil, err
+		}
+		d.Interfaces = append(d.Interfaces, i)
+	}
+	sort.Slice(devs, func(i, j int) bool {
+		if devs[i].Bus != devs[j].Bus {
+			return devs[i].Bus < devs[j].Bus
+		}
+		return devs[i].Dev < devs[j].Dev
+	})
+	for _, d := range devs {
+		if p, ok := byName[parentName(d.Name)]; ok {
+			d.Parent = p
+			p.Children = append(p.Children, d)
+		}
+	}
+	for _, d := range devs {
+		sort.Slice(d.Children, func(i, j int) bool { return d.Children[i].Port() < d.Children[j].Port() })
+	}
+	return devs, nil
+}
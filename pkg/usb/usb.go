@@ -0,0 +1,110 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package usb enumerates USB devices from sysfs and decodes their
+// descriptors.
+package usb
+
+import "fmt"
+
+// Interface is an interface of the active configuration of a device.
+type Interface struct {
+	// Name is the sysfs name, e.g. 1-1.2:1.0.
+	Name       string
+	Number     uint8
+	AltSetting uint8
+	Class      uint8
+	SubClass   uint8
+	Protocol   uint8
+	Endpoints  int
+	// Driver is the name of the bound driver, if any.
+	Driver string
+}
+
+// Device is a USB device. Root hubs are devices too.
+type Device struct {
+	// Name is the sysfs name, e.g. usb1 or 1-1.2.
+	Name     string
+	Bus      int
+	Dev      int
+	Vendor   uint16
+	Product  uint16
+	BCDDev   uint16
+	Class    uint8
+	SubClass uint8
+	Protocol uint8
+	// Version is the USB version the device implements, e.g. 2.00.
+	Version string
+	// Speed in Mb/s, e.g. 480 or 1.5.
+	Speed        string
+	MaxChild     int
+	Manufacturer string
+	ProductName  string
+	Serial       string
+	Driver       string
+	Interfaces   []*Interface
+	// Descriptors are the raw device and configuration descriptors.
+	Descriptors []byte
+
+	Parent   *Device
+	Children []*Device
+}
+
+// Port returns the port of the device on its parent hub, or 0 for root
+// hubs: the last number of the port chain in the name.
+func (d *Device) Port() int {
+	var p int
+	for i := len(d.Name) - 1; i >= 0; i-- {
+		if c := d.Name[i]; c == '-' || c == '.' {
+			fmt.Sscanf(d.Name[i+1:], "%d", &p)
+			break
+		}
+	}
+	return p
+}
+
+// String returns the device like lsusb does.
+func (d *Device) String() string {
+	s := fmt.Sprintf("Bus %03d Device %03d: ID %04x:%04x", d.Bus, d.Dev, d.Vendor, d.Product)
+	if d.Manufacturer != "" {
+		s += " " + d.Manufacturer
+	}
+	if d.ProductName != "" {
+		s += " " + d.ProductName
+	}
+	return s
+}
+
+var classNames = map[uint8]string{
+	0x00: ">ifc",
+	0x01: "Audio",
+	0x02: "Communications",
+	0x03: "Human Interface Device",
+	0x05: "Physical Interface Device",
+	0x06: "Imaging",
+	0x07: "Printer",
+	0x08: "Mass Storage",
+	0x09: "Hub",
+	0x0a: "CDC Data",
+	0x0b: "Chip/SmartCard",
+	0x0d: "Content Security",
+	0x0e: "Video",
+	0x0f: "Personal Healthcare",
+	0x10: "Audio/Video",
+	0x11: "Billboard",
+	0x12: "Type-C Bridge",
+	0xdc: "Diagnostic",
+	0xe0: "Wireless",
+	0xef: "Miscellaneous Device",
+	0xfe: "Application Specific Interface",
+	0xff: "Vendor Specific Class",
+}
+
+// ClassName returns the name of a device or interface class.
+func ClassName(c uint8) string {
+	if n, ok := classNames[c]; ok {
+		return n
+	}
+	return fmt.Sprintf("[unknown %#02x]", c)
+}
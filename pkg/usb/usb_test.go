@@ -0,0 +1,114 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package usb
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// stick is the descriptor chain of a mass storage stick: device,
+// configuration, interface and two bulk endpoints.
+var stick = []byte{
+	18, 1, 0x00, 0x02, 0, 0, 0, 64, 0x81, 0x07, 0x67, 0x55, 0x26, 0x01, 1, 2, 3, 1,
+	9, 2, 32, 0, 1, 1, 0, 0x80, 100,
+	9, 4, 0, 0, 2, 8, 6, 80, 0,
+	7, 5, 0x81, 2, 0x00, 0x02, 0,
+	7, 5, 0x02, 2, 0x00, 0x02, 0,
+}
+
+func fakeSysfs(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "usb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	write := func(dev string, attrs map[string]string) {
+		d := filepath.Join(dir, dev)
+		if err := os.MkdirAll(d, 0755); err != nil {
+			t.Fatal(err)
+		}
+		for k, v := range attrs {
+			if err := ioutil.WriteFile(filepath.Join(d, k), []byte(v+"\n"), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	write("usb1", map[string]string{"busnum": "1", "devnum": "1", "idVendor": "1d6b", "idProduct": "0002",
+		"bDeviceClass": "09", "speed": "480", "version": " 2.00", "maxchild": "4", "product": "xHCI Host Controller"})
+	write("1-1", map[string]string{"busnum": "1", "devnum": "2", "idVendor": "05e3", "idProduct": "0608",
+		"bDeviceClass": "09", "speed": "480", "maxchild": "4"})
+	write("1-1.2", map[string]string{"busnum": "1", "devnum": "3", "idVendor": "0781", "idProduct": "5567",
+		"bDeviceClass": "00", "speed": "480", "manufacturer": "SanDisk", "product": "Cruzer Blade", "serial": "4C53"})
+	write("1-1.2:1.0", map[string]string{"bInterfaceNumber": "00", "bInterfaceClass": "08",
+		"bInterfaceSubClass": "06", "bInterfaceProtocol": "50", "bNumEndpoints": "02"})
+	write("1-1:1.0", map[string]string{"bInterfaceNumber": "00", "bInterfaceClass": "09", "bNumEndpoints": "01"})
+	if err := ioutil.WriteFile(filepath.Join(dir, "1-1.2", "descriptors"), stick, 0444); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink("../../../bus/usb/drivers/usb-storage", filepath.Join(dir, "1-1.2:1.0", "driver")); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestDevices(t *testing.T) {
+	dir := fakeSysfs(t)
+	defer os.RemoveAll(dir)
+	SysfsPath = dir
+
+	devs, err := Devices()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(devs) != 3 {
+		t.Fatalf("got %d devices, want 3", len(devs))
+	}
+	root, hub, s := devs[0], devs[1], devs[2]
+	if got := s.String(); got != "Bus 001 Device 003: ID 0781:5567 SanDisk Cruzer Blade" {
+		t.Errorf("String() = %q", got)
+	}
+	if root.Parent != nil || hub.Parent != root || s.Parent != hub || len(hub.Children) != 1 {
+		t.Errorf("topology is wrong: %v <- %v <- %v", root.Parent, hub.Parent, s.Parent)
+	}
+	if s.Port() != 2 || hub.Port() != 1 || root.Port() != 0 {
+		t.Errorf("ports = %d, %d, %d, want 0, 1, 2", root.Port(), hub.Port(), s.Port())
+	}
+	if len(s.Interfaces) != 1 || s.Interfaces[0].Class != 8 || s.Interfaces[0].Driver != "usb-storage" {
+		t.Errorf("interfaces = %+v", s.Interfaces)
+	}
+	if s.Serial != "4C53" || root.Version != "2.00" {
+		t.Errorf("serial %q, version %q", s.Serial, root.Version)
+	}
+}
+
+func TestWriteDescriptors(t *testing.T) {
+	var b bytes.Buffer
+	if err := WriteDescriptors(&b, stick); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"Device Descriptor:\n  bLength                18\n",
+		"  bcdUSB               2.00\n",
+		"  idVendor            0x0781\n",
+		"  Configuration Descriptor:\n",
+		"    MaxPower              200mA\n",
+		"    Interface Descriptor:\n",
+		"      bInterfaceClass         8 Mass Storage\n",
+		"      Endpoint Descriptor:\n        bLength                 7\n",
+		"        bEndpointAddress      0x81  EP 1 IN\n",
+		"        bmAttributes            2  Transfer Type Bulk\n",
+	} {
+		if !strings.Contains(b.String(), want) {
+			t.Errorf("output does not contain %q:\n%s", want, b.String())
+		}
+	}
+	if err := WriteDescriptors(&b, []byte{9, 2, 0}); err == nil {
+		t.Errorf("truncated descriptor: got nil, want error")
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package vmtest
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// consoles are the serial consoles of the QEMU machines used for each
+// architecture.
+var consoles = map[string]string{
+	"amd64":   "ttyS0",
+	"arm":     "ttyAMA0",
+	"arm64":   "ttyAMA0",
+	"riscv64": "ttyS0",
+}
+
+// archEnv returns the value of the environment variable name for arch.
+//
+// name_ARCH, like UROOT_KERNEL_ARM64, is used if set. The plain variable,
+// like UROOT_KERNEL, applies to TestArch only.
+func archEnv(name, arch string) (string, bool) {
+	if v, ok := os.LookupEnv(name + "_" + strings.ToUpper(arch)); ok {
+		return v, true
+	}
+	if arch != TestArch() {
+		return "", false
+	}
+	return os.LookupEnv(name)
+}
+
+// QEMUCommand returns the QEMU binary and arguments used for arch, from
+// UROOT_QEMU_<ARCH> or, for TestArch, UROOT_QEMU.
+func QEMUCommand(arch string) ([]string, bool) {
+	v, ok := archEnv("UROOT_QEMU", arch)
+	if !ok || len(strings.Fields(v)) == 0 {
+		return nil, false
+	}
+	return strings.Fields(v), true
+}
+
+// KernelPath returns the kernel used for arch.
+//
+// If version is set, the kernel is $UROOT_KERNEL_DIR/<arch>/<version>, like
+// $UROOT_KERNEL_DIR/arm64/5.10. Otherwise it comes from UROOT_KERNEL_<ARCH>
+// or, for TestArch, UROOT_KERNEL.
+func KernelPath(arch, version string) (string, bool) {
+	if len(version) == 0 {
+		return archEnv("UROOT_KERNEL", arch)
+	}
+	dir, ok := os.LookupEnv("UROOT_KERNEL_DIR")
+	if !ok {
+		return "", false
+	}
+	k := filepath.Join(dir, arch, version)
+	if _, err := os.Stat(k); err != nil {
+		return "", false
+	}
+	return k, true
+}
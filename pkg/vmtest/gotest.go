@@ -5,6 +5,7 @@
 package vmtest
 
 import (
+	"bytes"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -12,24 +13,38 @@ import (
 	"os/exec"
 	"path"
 	"path/filepath"
+	"strings"
 	"testing"
 
-	"github.com/u-root/u-root/pkg/golang"
+	"github.com/u-root/u-root/pkg/cp"
 	"github.com/u-root/u-root/pkg/uio"
 	"github.com/u-root/u-root/pkg/uroot"
 	"github.com/u-root/u-root/pkg/vmtest/internal/json2test"
 )
 
 // GolangTest compiles the unit tests found in pkgs and runs them in a QEMU VM.
+//
+// If coverage is requested by o.CoverProfile or UROOT_QEMU_COVERPROFILE, the
+// tests are built with -cover and the profiles from the VM are merged into
+// that file.
 func GolangTest(t *testing.T, pkgs []string, o *Options) {
-	SkipWithoutQEMU(t)
+	if o == nil {
+		o = &Options{}
+	}
+	arch := o.arch()
+	if arch == TestArch() && len(o.KernelVersion) == 0 {
+		SkipWithoutQEMU(t)
+	} else {
+		SkipWithoutArch(t, o)
+	}
 	// TODO: support arm
-	if TestArch() != "amd64" && TestArch() != "arm64" {
-		t.Skipf("test not supported on %s", TestArch())
+	if arch != "amd64" && arch != "arm64" && arch != "riscv64" {
+		t.Skipf("test not supported on %s", arch)
 	}
 
-	if o == nil {
-		o = &Options{}
+	coverProfile := o.CoverProfile
+	if len(coverProfile) == 0 {
+		coverProfile = os.Getenv("UROOT_QEMU_COVERPROFILE")
 	}
 
 	// Create a temporary directory.
@@ -42,15 +57,13 @@ func GolangTest(t *testing.T, pkgs []string, o *Options) {
 	}
 
 	// Set up u-root build options.
-	env := golang.Default()
-	env.CgoEnabled = false
-	env.GOARCH = TestArch()
-	o.BuildOpts.Env = env
+	o.BuildOpts.Env = buildEnv(arch)
+	o.DontSetEnv = true
 
 	// Statically build tests and add them to the temporary directory.
 	var tests []string
 	os.Setenv("CGO_ENABLED", "0")
-	os.Setenv("GOARCH", TestArch())
+	os.Setenv("GOARCH", arch)
 	testDir := filepath.Join(o.TmpDir, "tests")
 	for _, pkg := range pkgs {
 		pkgDir := filepath.Join(testDir, pkg)
@@ -60,12 +73,16 @@ func GolangTest(t *testing.T, pkgs []string, o *Options) {
 
 		testFile := filepath.Join(pkgDir, fmt.Sprintf("%s.test", path.Base(pkg)))
 
-		cmd := exec.Command("go", "test",
+		args := []string{"test",
 			"-gcflags=all=-l",
 			"-ldflags", "-s -w",
 			"-c", pkg,
 			"-o", testFile,
-		)
+		}
+		if len(coverProfile) != 0 {
+			args = append(args, "-covermode=atomic", "-coverpkg", pkg)
+		}
+		cmd := exec.Command("go", args...)
 		if stderr, err := cmd.CombinedOutput(); err != nil {
 			t.Fatalf("could not build %s: %v\n%s", pkg, err, string(stderr))
 		}
@@ -94,6 +111,10 @@ func GolangTest(t *testing.T, pkgs []string, o *Options) {
 
 	// Specify the custom gotest uinit.
 	o.Uinit = "github.com/u-root/u-root/integration/testcmd/gotest/uinit"
+	if len(coverProfile) != 0 {
+		// Makes the uinit write coverage.out next to each test.
+		o.QEMUOpts.KernelArgs += " uroot.gotest.cover"
+	}
 
 	tc := json2test.NewTestCollector()
 	serial := []io.Writer{
@@ -127,35 +148,55 @@ func GolangTest(t *testing.T, pkgs []string, o *Options) {
 			t.Errorf("Test %v left in state %v:\n%v", pkg, test.State, test.FullOutput)
 		}
 	}
+
+	if len(coverProfile) != 0 {
+		if err := mergeCoverProfiles(coverProfile, testDir); err != nil {
+			t.Errorf("Could not write coverage profile: %v", err)
+		}
+	}
 }
 
-func copyRelativeFiles(src string, dst string) error {
-	return filepath.Walk(src, func(path string, fi os.FileInfo, err error) error {
-		if err != nil {
+// mergeCoverProfiles concatenates the coverage.out profiles found under dir
+// into the profile dst, keeping one mode line.
+func mergeCoverProfiles(dst, dir string) error {
+	var out bytes.Buffer
+	err := filepath.Walk(dir, func(path string, fi os.FileInfo, err error) error {
+		if err != nil || fi.Name() != "coverage.out" {
 			return err
 		}
-
-		rel, err := filepath.Rel(src, path)
+		b, err := ioutil.ReadFile(path)
 		if err != nil {
 			return err
 		}
-
-		if fi.Mode().IsDir() {
-			return os.MkdirAll(filepath.Join(dst, rel), fi.Mode().Perm())
-		} else if fi.Mode().IsRegular() {
-			srcf, err := os.Open(path)
-			if err != nil {
-				return err
+		for i, line := range strings.SplitAfter(string(b), "\n") {
+			if i == 0 && strings.HasPrefix(line, "mode:") {
+				if out.Len() != 0 {
+					continue
+				}
 			}
-			defer srcf.Close()
-			dstf, err := os.Create(filepath.Join(dst, rel))
-			if err != nil {
-				return err
-			}
-			defer dstf.Close()
-			_, err = io.Copy(dstf, srcf)
-			return err
+			out.WriteString(line)
 		}
 		return nil
 	})
+	if err != nil {
+		return err
+	}
+	if out.Len() == 0 {
+		return fmt.Errorf("no coverage profiles in %s", dir)
+	}
+	return ioutil.WriteFile(dst, out.Bytes(), 0644)
+}
+
+func copyRelativeFiles(src string, dst string) error {
+	o := cp.Options{
+		NoFollowSymlinks: true,
+		// Only directories and regular files are copied.
+		PreCallback: func(src, dst string, fi os.FileInfo) error {
+			if !fi.Mode().IsDir() && !fi.Mode().IsRegular() {
+				return cp.ErrSkip
+			}
+			return nil
+		},
+	}
+	return o.CopyTree(src, dst)
 }
@@ -12,6 +12,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"sort"
 	"strings"
 	"testing"
 
@@ -75,6 +76,39 @@ type Options struct {
 
 	// Use virtual vfat rather than 9pfs
 	UseVVFAT bool
+
+	// Arch is the architecture of the VM. If not specified, TestArch is
+	// used.
+	//
+	// The QEMU binary and kernel for Arch are taken from the
+	// UROOT_QEMU_<ARCH> and UROOT_KERNEL_<ARCH> environment variables,
+	// like UROOT_QEMU_ARM64, and for TestArch from UROOT_QEMU and
+	// UROOT_KERNEL.
+	Arch string
+
+	// KernelVersion, if set, selects the kernel
+	// $UROOT_KERNEL_DIR/<arch>/<version>. The test is skipped if there is
+	// no such kernel.
+	KernelVersion string
+
+	// SharedDirs are host directories shared read-write with the VM over
+	// 9p, by tag. Mount them in the VM with e.g.
+	// 'mount -t 9p -o trans=virtio <tag> <dir>'. The tag "tmpdir" is
+	// taken by TmpDir.
+	SharedDirs map[string]string
+
+	// CoverProfile, if set, makes GolangTest build the tests with coverage
+	// and write the coverage profile of the tests run in the VM to this
+	// file. If not set, UROOT_QEMU_COVERPROFILE is used.
+	CoverProfile string
+}
+
+// arch returns the architecture of the VM.
+func (o *Options) arch() string {
+	if len(o.Arch) != 0 {
+		return o.Arch
+	}
+	return TestArch()
 }
 
 func last(s string) string {
@@ -154,8 +188,34 @@ func SkipWithoutQEMU(t *testing.T) {
 	}
 }
 
+// SkipWithoutArch skips the test when there is no QEMU or kernel for the
+// architecture and kernel version of o.
+func SkipWithoutArch(t *testing.T, o *Options) {
+	arch := o.arch()
+	if _, ok := consoles[arch]; !ok {
+		t.Skipf("QEMU test is not supported on %s", arch)
+	}
+	if len(o.QEMUOpts.QEMUPath) == 0 {
+		if _, ok := QEMUCommand(arch); !ok {
+			t.Skipf("QEMU test is skipped unless UROOT_QEMU_%s is set", strings.ToUpper(arch))
+		}
+	}
+	if len(o.QEMUOpts.Kernel) == 0 {
+		if _, ok := KernelPath(arch, o.KernelVersion); !ok {
+			if len(o.KernelVersion) != 0 {
+				t.Skipf("QEMU test is skipped without $UROOT_KERNEL_DIR/%s/%s", arch, o.KernelVersion)
+			}
+			t.Skipf("QEMU test is skipped unless UROOT_KERNEL_%s is set", strings.ToUpper(arch))
+		}
+	}
+}
+
 func QEMUTest(t *testing.T, o *Options) (*qemu.VM, func()) {
-	SkipWithoutQEMU(t)
+	if o.arch() == TestArch() && len(o.KernelVersion) == 0 {
+		SkipWithoutQEMU(t)
+	} else {
+		SkipWithoutArch(t, o)
+	}
 
 	if len(o.Name) == 0 {
 		o.Name = callerName(2)
@@ -222,28 +282,48 @@ func QEMU(o *Options) (*qemu.Options, error) {
 		}
 	}
 
+	arch := o.arch()
+
 	// Set the initramfs.
 	if len(o.QEMUOpts.Initramfs) == 0 {
+		if !o.DontSetEnv {
+			o.BuildOpts.Env = buildEnv(arch)
+		}
 		o.QEMUOpts.Initramfs = filepath.Join(o.TmpDir, "initramfs.cpio")
-		if err := ChooseTestInitramfs(o.DontSetEnv, o.BuildOpts, o.Uinit, o.QEMUOpts.Initramfs); err != nil {
+		if err := ChooseTestInitramfs(true, o.BuildOpts, o.Uinit, o.QEMUOpts.Initramfs); err != nil {
 			return nil, err
 		}
 	}
 
 	if len(o.QEMUOpts.Kernel) == 0 {
+		k, ok := KernelPath(arch, o.KernelVersion)
+		if !ok {
+			return nil, fmt.Errorf("no kernel for %s (version %q)", arch, o.KernelVersion)
+		}
 		// Copy kernel to o.TmpDir for tests involving kexec.
 		kernel := filepath.Join(o.TmpDir, "kernel")
-		if err := cp.Copy(os.Getenv("UROOT_KERNEL"), kernel); err != nil {
+		if err := cp.Copy(k, kernel); err != nil {
 			return nil, err
 		}
 		o.QEMUOpts.Kernel = kernel
 	}
 
-	switch TestArch() {
-	case "amd64":
-		o.QEMUOpts.KernelArgs += " console=ttyS0 earlyprintk=ttyS0"
-	case "arm":
-		o.QEMUOpts.KernelArgs += " console=ttyAMA0"
+	if len(o.QEMUOpts.QEMUPath) == 0 {
+		q, ok := QEMUCommand(arch)
+		if !ok {
+			return nil, fmt.Errorf("no QEMU for %s", arch)
+		}
+		o.QEMUOpts.QEMUPath = q[0]
+		if len(q) > 1 {
+			o.QEMUOpts.Devices = append(o.QEMUOpts.Devices, qemu.ArbitraryArgs(q[1:]))
+		}
+	}
+
+	if console, ok := consoles[arch]; ok {
+		o.QEMUOpts.KernelArgs += " console=" + console
+		if arch == "amd64" {
+			o.QEMUOpts.KernelArgs += " earlyprintk=" + console
+		}
 	}
 	o.QEMUOpts.KernelArgs += " uroot.vmtest"
 
@@ -251,10 +331,22 @@ func QEMU(o *Options) (*qemu.Options, error) {
 	if o.UseVVFAT {
 		dir = qemu.ReadOnlyDirectory{Dir: o.TmpDir}
 	} else {
-		dir = qemu.P9Directory{Dir: o.TmpDir, Arch: TestArch()}
+		dir = qemu.P9Directory{Dir: o.TmpDir, Arch: arch}
 	}
 	o.QEMUOpts.Devices = append(o.QEMUOpts.Devices, qemu.VirtioRandom{}, dir)
 
+	tags := make([]string, 0, len(o.SharedDirs))
+	for tag := range o.SharedDirs {
+		if tag == "tmpdir" {
+			return nil, fmt.Errorf("shared directory tag %q is reserved for TmpDir", tag)
+		}
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	for _, tag := range tags {
+		o.QEMUOpts.Devices = append(o.QEMUOpts.Devices, qemu.P9Directory{Dir: o.SharedDirs[tag], Tag: tag, Arch: arch})
+	}
+
 	return &o.QEMUOpts, nil
 }
 
@@ -279,6 +371,14 @@ func ChooseTestInitramfs(dontSetEnv bool, o uroot.Opts, uinit, outputFile string
 	return err
 }
 
+// buildEnv returns the Go environment building programs for arch.
+func buildEnv(arch string) golang.Environ {
+	env := golang.Default()
+	env.CgoEnabled = false
+	env.GOARCH = arch
+	return env
+}
+
 // CreateTestInitramfs creates an initramfs with the given build options and
 // uinit, and writes it to the given output file. If no output file is provided,
 // one will be created.
@@ -286,10 +386,7 @@ func ChooseTestInitramfs(dontSetEnv bool, o uroot.Opts, uinit, outputFile string
 // the initramfs file after use.
 func CreateTestInitramfs(dontSetEnv bool, o uroot.Opts, uinit, outputFile string) (string, error) {
 	if !dontSetEnv {
-		env := golang.Default()
-		env.CgoEnabled = false
-		env.GOARCH = TestArch()
-		o.Env = env
+		o.Env = buildEnv(TestArch())
 	}
 
 	logger := log.New(os.Stderr, "", 0)
@@ -0,0 +1,107 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package vmtest
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/insomniacslk/dhcp/dhcpv4"
+	"github.com/insomniacslk/dhcp/dhcpv4/server4"
+	"github.com/u-root/u-root/pkg/qemu"
+)
+
+// ServeHTTP starts an HTTP server on the host serving h to VMs on a
+// qemu.UserNetwork.
+//
+// It returns the URL of the server as seen from the VM, like
+// "http://10.0.2.2:34567", and a function stopping the server.
+func ServeHTTP(t testing.TB, h http.Handler) (string, func()) {
+	s := httptest.NewServer(h)
+	_, port, err := net.SplitHostPort(s.Listener.Addr().String())
+	if err != nil {
+		s.Close()
+		t.Fatalf("HTTP server address %v: %v", s.Listener.Addr(), err)
+	}
+	return fmt.Sprintf("http://%s", net.JoinHostPort(qemu.UserNetworkHostIP.String(), port)), s.Close
+}
+
+// DHCPLease is what ServeDHCP offers to VMs.
+type DHCPLease struct {
+	// IP is the address, with its netmask, given to the VM.
+	IP net.IPNet
+
+	// ServerIP is the address of the host's end of the network. It must be
+	// configured on the tap interface.
+	ServerIP net.IP
+
+	// Router, if set, is offered as the default gateway.
+	Router net.IP
+
+	// BootFile is the boot file name, e.g. a kernel or iPXE script URL.
+	BootFile string
+
+	// LeaseTime is the lease duration. If not set, it is an hour.
+	LeaseTime time.Duration
+}
+
+// ServeDHCP starts a DHCPv4 server on the host's ifname, typically the tap
+// interface of a qemu.TapNetwork, offering lease to any client.
+//
+// It needs the privileges to bind port 67 on ifname. It returns a function
+// stopping the server.
+func ServeDHCP(t testing.TB, ifname string, lease DHCPLease) func() {
+	if lease.LeaseTime == 0 {
+		lease.LeaseTime = time.Hour
+	}
+	handler := func(conn net.PacketConn, peer net.Addr, m *dhcpv4.DHCPv4) {
+		var typ dhcpv4.MessageType
+		switch m.MessageType() {
+		case dhcpv4.MessageTypeDiscover:
+			typ = dhcpv4.MessageTypeOffer
+		case dhcpv4.MessageTypeRequest:
+			typ = dhcpv4.MessageTypeAck
+		default:
+			return
+		}
+		mods := []dhcpv4.Modifier{
+			dhcpv4.WithMessageType(typ),
+			dhcpv4.WithServerIP(lease.ServerIP),
+			dhcpv4.WithYourIP(lease.IP.IP),
+			dhcpv4.WithNetmask(lease.IP.Mask),
+			dhcpv4.WithLeaseTime(uint32(lease.LeaseTime / time.Second)),
+			dhcpv4.WithOption(dhcpv4.OptServerIdentifier(lease.ServerIP)),
+		}
+		if lease.Router != nil {
+			mods = append(mods, dhcpv4.WithRouter(lease.Router))
+		}
+		reply, err := dhcpv4.NewReplyFromRequest(m, mods...)
+		if err != nil {
+			t.Logf("DHCP reply to %v: %v", peer, err)
+			return
+		}
+		if len(lease.BootFile) != 0 {
+			reply.BootFileName = lease.BootFile
+		}
+		if _, err := conn.WriteTo(reply.ToBytes(), peer); err != nil {
+			t.Logf("DHCP reply to %v: %v", peer, err)
+		}
+	}
+
+	s, err := server4.NewServer(ifname, nil, handler)
+	if err != nil {
+		t.Fatalf("DHCP server on %s: %v", ifname, err)
+	}
+	go func() {
+		_ = s.Serve()
+	}()
+	return func() {
+		s.Close()
+	}
+}
@@ -0,0 +1,189 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package vpd
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// Entry types of the VPD 2.0 format.
+const (
+	typeTerminator         = 0x00
+	typeString             = 0x01
+	typeInfo               = 0xfe
+	typeImplicitTerminator = 0xff
+)
+
+// infoMagic starts the google_vpd_info header: an entry of type info with
+// a 9 byte key, version 1 and "gVpdInfo", and a 4 byte value, the size of
+// the entries that follow.
+var infoMagic = []byte("\xfe\x09\x01gVpdInfo\x04")
+
+// infoLen is the length of the header, magic and size.
+const infoLen = 16
+
+// ErrNoSpace is returned by Marshal when the entries do not fit.
+var ErrNoSpace = errors.New("VPD entries do not fit in partition")
+
+// Entry is a key and its value.
+type Entry struct {
+	Key   string
+	Value []byte
+}
+
+// Partition is the content of an RO_VPD or RW_VPD flash region in the
+// VPD 2.0 format: a google_vpd_info header and a list of key/value
+// entries, which keep their order.
+type Partition struct {
+	Entries []Entry
+}
+
+// decodeLen decodes a length of 7 bit groups, most significant first,
+// with the top bit set on all but the last byte. It returns the length
+// and the number of bytes it took.
+func decodeLen(b []byte) (int, int, error) {
+	var l int
+	for i, c := range b {
+		if i == 4 {
+			break
+		}
+		l = l<<7 | int(c&0x7f)
+		if c&0x80 == 0 {
+			return l, i + 1, nil
+		}
+	}
+	return 0, 0, fmt.Errorf("bad length encoding")
+}
+
+func encodeLen(b *bytes.Buffer, l int) {
+	var tmp [5]byte
+	i := len(tmp) - 1
+	tmp[i] = byte(l & 0x7f)
+	for l >>= 7; l > 0; l >>= 7 {
+		i--
+		tmp[i] = byte(l&0x7f) | 0x80
+	}
+	b.Write(tmp[i:])
+}
+
+// field decodes a length and the bytes following it.
+func field(b []byte) ([]byte, int, error) {
+	l, n, err := decodeLen(b)
+	if err != nil {
+		return nil, 0, err
+	}
+	if n+l > len(b) {
+		return nil, 0, fmt.Errorf("field of %d bytes exceeds the %d bytes left", l, len(b)-n)
+	}
+	return b[n : n+l], n + l, nil
+}
+
+// ParsePartition decodes the content of a VPD partition. Partitions
+// without the google_vpd_info header, as written by old firmware, are
+// decoded from their first byte.
+func ParsePartition(b []byte) (*Partition, error) {
+	if bytes.HasPrefix(b, infoMagic) {
+		if len(b) < infoLen {
+			return nil, fmt.Errorf("VPD header truncated")
+		}
+		size := int(binary.LittleEndian.Uint32(b[len(infoMagic):]))
+		b = b[infoLen:]
+		if size < len(b) {
+			b = b[:size]
+		}
+	}
+	p := &Partition{}
+	for off := 0; off < len(b); {
+		switch b[off] {
+		case typeTerminator, typeImplicitTerminator:
+			return p, nil
+		case typeString, typeInfo:
+		default:
+			return nil, fmt.Errorf("unknown VPD entry type %#02x at offset %d", b[off], off)
+		}
+		typ := b[off]
+		off++
+		k, n, err := field(b[off:])
+		if err != nil {
+			return nil, fmt.Errorf("key at offset %d: %v", off, err)
+		}
+		off += n
+		v, n, err := field(b[off:])
+		if err != nil {
+			return nil, fmt.Errorf("value of %q: %v", k, err)
+		}
+		off += n
+		if typ == typeString {
+			p.Entries = append(p.Entries, Entry{Key: string(k), Value: append([]byte{}, v...)})
+		}
+	}
+	return p, nil
+}
+
+// Get returns the value of key.
+func (p *Partition) Get(key string) ([]byte, bool) {
+	for _, e := range p.Entries {
+		if e.Key == key {
+			return e.Value, true
+		}
+	}
+	return nil, false
+}
+
+// Set sets key to value, replacing it in place if it exists and adding it
+// at the end otherwise.
+func (p *Partition) Set(key string, value []byte) {
+	for i, e := range p.Entries {
+		if e.Key == key {
+			p.Entries[i].Value = value
+			return
+		}
+	}
+	p.Entries = append(p.Entries, Entry{Key: key, Value: value})
+}
+
+// Delete removes key and returns whether it existed.
+func (p *Partition) Delete(key string) bool {
+	for i, e := range p.Entries {
+		if e.Key == key {
+			p.Entries = append(p.Entries[:i], p.Entries[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// Marshal encodes the partition with a google_vpd_info header whose size
+// covers the entries and their terminator, and pads it to size bytes with
+// 0xff, the value of erased flash.
+func (p *Partition) Marshal(size int) ([]byte, error) {
+	var body bytes.Buffer
+	for _, e := range p.Entries {
+		if e.Key == "" {
+			return nil, fmt.Errorf("empty VPD key")
+		}
+		body.WriteByte(typeString)
+		encodeLen(&body, len(e.Key))
+		body.WriteString(e.Key)
+		encodeLen(&body, len(e.Value))
+		body.Write(e.Value)
+	}
+	body.WriteByte(typeTerminator)
+
+	if infoLen+body.Len() > size {
+		return nil, fmt.Errorf("%w: need %d bytes, have %d", ErrNoSpace, infoLen+body.Len(), size)
+	}
+	b := make([]byte, size)
+	copy(b, infoMagic)
+	binary.LittleEndian.PutUint32(b[len(infoMagic):], uint32(body.Len()))
+	copy(b[infoLen:], body.Bytes())
+	for i := infoLen + body.Len(); i < size; i++ {
+		b[i] = 0xff
+	}
+	return b, nil
+}
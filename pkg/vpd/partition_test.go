@@ -0,0 +1,70 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package vpd
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPartitionRoundTrip(t *testing.T) {
+	p := &Partition{}
+	p.Set("serial_number", []byte("SN1234"))
+	p.Set("ethernet_mac0", []byte("00:11:22:33:44:55"))
+	p.Set("blob", bytes.Repeat([]byte{0, 0xff}, 100))
+	p.Set("serial_number", []byte("SN5678"))
+
+	b, err := p.Marshal(1024)
+	require.NoError(t, err)
+	require.Len(t, b, 1024)
+	require.Equal(t, infoMagic, b[:len(infoMagic)])
+	require.Equal(t, byte(0xff), b[1023])
+
+	q, err := ParsePartition(b)
+	require.NoError(t, err)
+	require.Equal(t, p.Entries, q.Entries)
+	v, ok := q.Get("serial_number")
+	require.True(t, ok)
+	require.Equal(t, []byte("SN5678"), v)
+	require.Equal(t, "serial_number", q.Entries[0].Key)
+
+	require.True(t, q.Delete("ethernet_mac0"))
+	require.False(t, q.Delete("ethernet_mac0"))
+	_, ok = q.Get("ethernet_mac0")
+	require.False(t, ok)
+	require.Len(t, q.Entries, 2)
+}
+
+func TestPartitionEncoding(t *testing.T) {
+	p := &Partition{Entries: []Entry{{Key: "a", Value: bytes.Repeat([]byte("x"), 200)}}}
+	b, err := p.Marshal(infoLen + 206)
+	require.NoError(t, err)
+	// 200 is 0x81 0x48 in 7 bit groups.
+	require.Equal(t, []byte{typeString, 1, 'a', 0x81, 0x48}, b[infoLen:infoLen+5])
+	require.Equal(t, []byte{206, 0, 0, 0}, b[len(infoMagic):infoLen])
+	require.Equal(t, byte(typeTerminator), b[infoLen+205])
+
+	_, err = p.Marshal(infoLen + 205)
+	require.True(t, errors.Is(err, ErrNoSpace))
+}
+
+func TestParsePartition(t *testing.T) {
+	// Without a header, as written by old firmware, ended by erased flash.
+	p, err := ParsePartition([]byte("\x01\x03key\x05value\xfe\x01i\x01v\xff\xff"))
+	require.NoError(t, err)
+	require.Equal(t, []Entry{{Key: "key", Value: []byte("value")}}, p.Entries)
+
+	for _, b := range []string{
+		"\x01\x03key\x09value",
+		"\x01\x83",
+		"\x02\x01k\x01v",
+	} {
+		_, err := ParsePartition([]byte(b))
+		require.Error(t, err, "%q", b)
+	}
+}
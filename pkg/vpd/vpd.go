@@ -43,8 +43,9 @@ func Get(key string, readOnly bool) ([]byte, error) {
 // value. The `readOnly` flag specifies whether the variable is read-only or
 // read-write.
 // NOTE Unfortunately Set doesn't currently work, because the sysfs interface
-// does not support writing. To write, this library needs a backend able to
-// write to flash chips, like the command line tool flashrom or flashtools.
+// does not support writing. To write, edit the content of the RO_VPD or
+// RW_VPD flash region with ParsePartition and Partition.Marshal, and write
+// it back with a tool able to write to flash chips, like flashrom.
 func Set(key string, value []byte, readOnly bool) error {
 	// NOTE this is not implemented yet in the kernel interface, and will always
 	// return a permission denied error
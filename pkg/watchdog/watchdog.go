@@ -22,6 +22,7 @@ package watchdog
 import (
 	"fmt"
 	"os"
+	"strings"
 	"time"
 	"unsafe"
 
@@ -81,6 +82,45 @@ const (
 	StatusKeepAlivePing Status = 0x8000
 )
 
+var statusNames = []struct {
+	s    Status
+	name string
+}{
+	{StatusOverheat, "overheat"},
+	{StatusFanFault, "fanfault"},
+	{StatusExtern1, "extern1"},
+	{StatusExtern2, "extern2"},
+	{StatusPowerUnder, "powerunder"},
+	{StatusCardReset, "cardreset"},
+	{StatusPowerOver, "powerover"},
+	{StatusSetTimeout, "settimeout"},
+	{StatusMagicClose, "magicclose"},
+	{StatusPreTimeout, "pretimeout"},
+	{StatusAlarmOnly, "alarmonly"},
+	{StatusKeepAlivePing, "keepaliveping"},
+}
+
+// String returns the names of the flags in s, separated by commas.
+func (s Status) String() string {
+	if s == StatusUnknown {
+		return "unknown"
+	}
+	var names []string
+	for _, n := range statusNames {
+		if s&n.s != 0 {
+			names = append(names, n.name)
+			s &^= n.s
+		}
+	}
+	if s != 0 {
+		names = append(names, fmt.Sprintf("%#x", int32(s)))
+	}
+	if len(names) == 0 {
+		return "none"
+	}
+	return strings.Join(names, ",")
+}
+
 // Option are options passed to SetOptions().
 type Option int32
 
@@ -160,6 +200,26 @@ func (w *Watchdog) SetOptions(options Option) error {
 	return nil
 }
 
+// Enable starts the watchdog timer if it was stopped with Disable.
+func (w *Watchdog) Enable() error {
+	return w.SetOptions(OptionEnableCard)
+}
+
+// Disable stops the watchdog timer while the device stays open, for
+// drivers supporting it. Unlike MagicClose, this works with nowayout.
+func (w *Watchdog) Disable() error {
+	return w.SetOptions(OptionDisableCard)
+}
+
+// Identity returns the name of the watchdog driver.
+func (w *Watchdog) Identity() (string, error) {
+	wi, err := w.Support()
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(wi.Identity[:]), "\x00"), nil
+}
+
 // KeepAlive pets the watchdog.
 func (w *Watchdog) KeepAlive() error {
 	_, err := w.f.WriteString("1")
@@ -170,13 +230,22 @@ func (w *Watchdog) KeepAlive() error {
 // timeout gets set to the wrong value. timeout must be a multiple of seconds;
 // otherwise, an error is returned.
 func (w *Watchdog) SetTimeout(timeout time.Duration) error {
-	timeoutSecs := timeout / time.Second
-	if _, _, err := unix.Syscall(unix.SYS_IOCTL, w.f.Fd(), wdiocSetTimeout, uintptr(unsafe.Pointer(&timeout))); err != 0 {
+	return w.setTimeout(wdiocSetTimeout, "timeout", timeout)
+}
+
+// setTimeout issues one of the set timeout ioctls. The driver rounds the
+// timeout to what the hardware supports and writes back the result.
+func (w *Watchdog) setTimeout(req uintptr, name string, timeout time.Duration) error {
+	secs := int32(timeout / time.Second)
+	if time.Duration(secs)*time.Second != timeout {
+		return fmt.Errorf("watchdog %s %v is not a multiple of seconds", name, timeout)
+	}
+	want := secs
+	if _, _, err := unix.Syscall(unix.SYS_IOCTL, w.f.Fd(), req, uintptr(unsafe.Pointer(&secs))); err != 0 {
 		return err
 	}
-	gotTimeout := timeoutSecs * time.Second
-	if gotTimeout != timeout {
-		return fmt.Errorf("Watchdog timeout set to %v, wanted %v", gotTimeout, timeout)
+	if secs != want {
+		return fmt.Errorf("Watchdog %s set to %v, wanted %v", name, time.Duration(secs)*time.Second, timeout)
 	}
 	return nil
 }
@@ -194,15 +263,7 @@ func (w *Watchdog) Timeout() (time.Duration, error) {
 // duration before triggering the preaction (such as an NMI, interrupt, ...).
 // timeout must be a multiple of seconds; otherwise, an error is returned.
 func (w *Watchdog) SetPreTimeout(timeout time.Duration) error {
-	timeoutSecs := timeout / time.Second
-	if _, _, err := unix.Syscall(unix.SYS_IOCTL, w.f.Fd(), wdiocSetPreTimeout, uintptr(unsafe.Pointer(&timeout))); err != 0 {
-		return err
-	}
-	gotTimeout := timeoutSecs * time.Second
-	if gotTimeout != timeout {
-		return fmt.Errorf("Watchdog pretimeout set to %v, wanted %v", gotTimeout, timeout)
-	}
-	return nil
+	return w.setTimeout(wdiocSetPreTimeout, "pretimeout", timeout)
 }
 
 // PreTimeout returns the current watchdog pretimeout.
@@ -0,0 +1,98 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package watchdogd
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	"github.com/u-root/u-root/pkg/watchdog"
+)
+
+// Keeper pets a watchdog from a goroutine of the calling process. Unlike
+// Run, it does not take over any signals, so it can be used from init.
+type Keeper struct {
+	wd   *watchdog.Watchdog
+	stop chan bool
+	done chan error
+	once sync.Once
+	err  error
+}
+
+// Keep arms the watchdog and pets it every opts.KeepAlive until Stop or
+// Disarm is called. If a monitor fails, petting stops and the machine is
+// reset when the watchdog times out.
+func Keep(opts *DaemonOpts) (*Keeper, error) {
+	wd, err := arm(opts)
+	if err != nil {
+		return nil, err
+	}
+	k := &Keeper{
+		wd:   wd,
+		stop: make(chan bool),
+		done: make(chan error),
+	}
+	go k.run(opts)
+	return k, nil
+}
+
+func (k *Keeper) run(opts *DaemonOpts) {
+	t := time.NewTicker(opts.KeepAlive)
+	defer t.Stop()
+	petting := true
+	for {
+		select {
+		case <-t.C:
+			if !petting {
+				continue
+			}
+			for _, m := range opts.Monitors {
+				if err := m(); err != nil {
+					log.Printf("watchdog: Stopping keepalive due to: %v", err)
+					petting = false
+					break
+				}
+			}
+			if !petting {
+				continue
+			}
+			if err := k.wd.KeepAlive(); err != nil {
+				log.Printf("watchdog: Failed to keepalive: %v", err)
+			}
+		case disarm := <-k.stop:
+			if disarm {
+				err := k.wd.MagicClose()
+				if err == nil {
+					log.Println("watchdog: Disarmed")
+				}
+				k.done <- err
+			} else {
+				k.done <- k.wd.Close()
+			}
+			return
+		}
+	}
+}
+
+func (k *Keeper) finish(disarm bool) error {
+	k.once.Do(func() {
+		k.stop <- disarm
+		k.err = <-k.done
+	})
+	return k.err
+}
+
+// Stop stops petting and leaves the watchdog armed, so the machine is reset
+// when it times out.
+func (k *Keeper) Stop() error {
+	return k.finish(false)
+}
+
+// Disarm stops petting and disarms the watchdog. If the kernel was built
+// with CONFIG_WATCHDOG_NOWAYOUT=y, the watchdog stays armed.
+func (k *Keeper) Disarm() error {
+	return k.finish(true)
+}
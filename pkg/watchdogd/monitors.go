@@ -0,0 +1,145 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package watchdogd
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// loadavg is where MonitorLoad reads the load average from.
+var loadavg = "/proc/loadavg"
+
+// MonitorStaleFile returns a monitor which fails if the file at path was
+// not modified for maxAge, or is missing, e.g. if the service which
+// touches it hangs.
+func MonitorStaleFile(path string, maxAge time.Duration) func() error {
+	return func() error {
+		fi, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+		if age := time.Since(fi.ModTime()); age > maxAge {
+			return fmt.Errorf("%s is stale: modified %v ago, more than %v", path, age.Round(time.Second), maxAge)
+		}
+		return nil
+	}
+}
+
+// MonitorLoad returns a monitor which fails if the load average of the
+// last minute is more than max.
+func MonitorLoad(max float64) func() error {
+	return func() error {
+		b, err := ioutil.ReadFile(loadavg)
+		if err != nil {
+			return err
+		}
+		f := strings.Fields(string(b))
+		if len(f) == 0 {
+			return fmt.Errorf("%s: no load average", loadavg)
+		}
+		l, err := strconv.ParseFloat(f[0], 64)
+		if err != nil {
+			return fmt.Errorf("%s: %v", loadavg, err)
+		}
+		if l > max {
+			return fmt.Errorf("load average %.2f is more than %.2f", l, max)
+		}
+		return nil
+	}
+}
+
+// pingTries is how many echo requests MonitorPing sends before it fails.
+const pingTries = 3
+
+// MonitorPing returns a monitor which fails if host does not answer any
+// of a few ICMP echo requests within timeout each, e.g. when the network
+// is gone. It needs CAP_NET_RAW.
+func MonitorPing(host string, timeout time.Duration) func() error {
+	var seq uint16
+	return func() error {
+		var err error
+		for i := 0; i < pingTries; i++ {
+			seq++
+			if err = ping(host, seq, timeout); err == nil {
+				return nil
+			}
+		}
+		return fmt.Errorf("ping %s: %v", host, err)
+	}
+}
+
+// ping sends an ICMP echo request to host and waits for the reply.
+func ping(host string, seq uint16, timeout time.Duration) error {
+	ip, err := net.ResolveIPAddr("ip", host)
+	if err != nil {
+		return err
+	}
+	network, request, reply := "ip4:icmp", byte(8), byte(0)
+	if ip.IP.To4() == nil {
+		network, request, reply = "ip6:ipv6-icmp", 128, 129
+	}
+	c, err := net.ListenPacket(network, "")
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+	if err := c.SetDeadline(time.Now().Add(timeout)); err != nil {
+		return err
+	}
+
+	id := uint16(os.Getpid())
+	msg := make([]byte, 8)
+	msg[0] = request
+	binary.BigEndian.PutUint16(msg[4:], id)
+	binary.BigEndian.PutUint16(msg[6:], seq)
+	// The kernel computes the checksum of ICMPv6.
+	if request == 8 {
+		binary.BigEndian.PutUint16(msg[2:], checksum(msg))
+	}
+	if _, err := c.WriteTo(msg, ip); err != nil {
+		return err
+	}
+
+	b := make([]byte, 1500)
+	for {
+		n, from, err := c.ReadFrom(b)
+		if err != nil {
+			return err
+		}
+		m := b[:n]
+		// Raw IPv4 sockets get the IP header too.
+		if request == 8 && len(m) > 0 && m[0]>>4 == 4 {
+			if hl := int(m[0]&0xf) * 4; hl <= len(m) {
+				m = m[hl:]
+			}
+		}
+		if len(m) >= 8 && m[0] == reply && from.(*net.IPAddr).IP.Equal(ip.IP) &&
+			binary.BigEndian.Uint16(m[4:]) == id && binary.BigEndian.Uint16(m[6:]) == seq {
+			return nil
+		}
+	}
+}
+
+// checksum is the Internet checksum of RFC 1071.
+func checksum(b []byte) uint16 {
+	var sum uint32
+	for i := 0; i+1 < len(b); i += 2 {
+		sum += uint32(b[i])<<8 | uint32(b[i+1])
+	}
+	if len(b)%2 == 1 {
+		sum += uint32(b[len(b)-1]) << 8
+	}
+	for sum>>16 != 0 {
+		sum = sum>>16 + sum&0xffff
+	}
+	return ^uint16(sum)
+}
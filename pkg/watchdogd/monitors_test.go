@@ -0,0 +1,70 @@
+// Copyright 2021 the u-root Authors. All rights reserved
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package watchdogd
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestMonitorStaleFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "watchdogd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	f := filepath.Join(dir, "heartbeat")
+	m := MonitorStaleFile(f, time.Minute)
+	if err := m(); err == nil {
+		t.Errorf("Monitor of a missing file = nil, want error")
+	}
+	if err := ioutil.WriteFile(f, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := m(); err != nil {
+		t.Errorf("Monitor of a fresh file = %v, want nil", err)
+	}
+	old := time.Now().Add(-2 * time.Minute)
+	if err := os.Chtimes(f, old, old); err != nil {
+		t.Fatal(err)
+	}
+	if err := m(); err == nil {
+		t.Errorf("Monitor of a stale file = nil, want error")
+	}
+}
+
+func TestMonitorLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "watchdogd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	defer func(l string) { loadavg = l }(loadavg)
+	loadavg = filepath.Join(dir, "loadavg")
+	if err := ioutil.WriteFile(loadavg, []byte("2.50 1.00 0.50 1/100 1234\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := MonitorLoad(4)(); err != nil {
+		t.Errorf("MonitorLoad(4) = %v, want nil", err)
+	}
+	if err := MonitorLoad(2)(); err == nil {
+		t.Errorf("MonitorLoad(2) = nil, want error")
+	}
+}
+
+func TestMonitorPing(t *testing.T) {
+	if os.Getuid() != 0 {
+		t.Skip("Raw ICMP sockets need root")
+	}
+	if err := MonitorPing("127.0.0.1", time.Second)(); err != nil {
+		t.Errorf("Ping of localhost = %v, want nil", err)
+	}
+	if err := MonitorPing("host.invalid", 10*time.Millisecond)(); err == nil {
+		t.Errorf("Ping of host.invalid = nil, want error")
+	}
+}
@@ -71,6 +71,31 @@ func MonitorOops() error {
 	return nil
 }
 
+// arm opens and configures the watchdog.
+func arm(opts *DaemonOpts) (*watchdog.Watchdog, error) {
+	wd, err := watchdog.Open(opts.Dev)
+	if err != nil {
+		// Most likely cause is /dev/watchdog does not exist.
+		// Second most likely cause is another process (perhaps
+		// another watchdogd?) has the file open.
+		return nil, fmt.Errorf("watchdog: Failed to arm: %v", err)
+	}
+	if opts.Timeout != nil {
+		if err := wd.SetTimeout(*opts.Timeout); err != nil {
+			wd.Close()
+			return nil, fmt.Errorf("watchdog: Failed to set timeout: %v", err)
+		}
+	}
+	if opts.PreTimeout != nil {
+		if err := wd.SetPreTimeout(*opts.PreTimeout); err != nil {
+			wd.Close()
+			return nil, fmt.Errorf("watchdog: Failed to set pretimeout: %v", err)
+		}
+	}
+	log.Println("watchdog: Armed")
+	return wd, nil
+}
+
 // Run runs the watchdog on the current goroutine. The USR1, USR2, STOP and
 // CONT signals are used to control, so consider using a dedicated process.
 // Consider using the watchdogd command in u-root. Cancelling the context will
@@ -83,26 +108,10 @@ func Run(ctx context.Context, opts *DaemonOpts) error {
 	defer signal.Stop(signals)
 
 	for {
-		wd, err := watchdog.Open(opts.Dev)
+		wd, err := arm(opts)
 		if err != nil {
-			// Most likely cause is /dev/watchdog does not exist.
-			// Second most likely cause is another process (perhaps
-			// another watchdogd?) has the file open.
-			return fmt.Errorf("watchdog: Failed to arm: %v", err)
-		}
-		if opts.Timeout != nil {
-			if err := wd.SetTimeout(*opts.Timeout); err != nil {
-				wd.Close()
-				return fmt.Errorf("watchdog: Failed to set timeout: %v", err)
-			}
-		}
-		if opts.PreTimeout != nil {
-			if err := wd.SetPreTimeout(*opts.PreTimeout); err != nil {
-				wd.Close()
-				return fmt.Errorf("watchdog: Failed to set pretimeout: %v", err)
-			}
+			return err
 		}
-		log.Println("watchdog: Armed")
 
 	armed: // Loop while armed. SIGUSR1 to break.
 		for {
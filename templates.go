@@ -64,10 +64,12 @@ var templates = map[string][]string{
 		"github.com/u-root/u-root/cmds/core/mv",
 		"github.com/u-root/u-root/cmds/core/pci",
 		"github.com/u-root/u-root/cmds/core/ping",
+		"github.com/u-root/u-root/cmds/core/poweroff",
 		"github.com/u-root/u-root/cmds/core/printenv",
 		"github.com/u-root/u-root/cmds/core/ps",
 		"github.com/u-root/u-root/cmds/core/pwd",
 		"github.com/u-root/u-root/cmds/core/readlink",
+		"github.com/u-root/u-root/cmds/core/reboot",
 		"github.com/u-root/u-root/cmds/core/rm",
 		"github.com/u-root/u-root/cmds/core/rmmod",
 		"github.com/u-root/u-root/cmds/core/seq",
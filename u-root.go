@@ -12,11 +12,15 @@ import (
 	"log"
 	"os"
 	"path"
+	"path/filepath"
 	"runtime"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/u-root/u-root/pkg/compress"
+	"github.com/u-root/u-root/pkg/cpio"
 	"github.com/u-root/u-root/pkg/golang"
 	"github.com/u-root/u-root/pkg/shlex"
 	"github.com/u-root/u-root/pkg/uroot"
@@ -39,9 +43,13 @@ func (m *multiFlag) Set(value string) error {
 // Flags for u-root builder.
 var (
 	build, format, tmpDir, base, outputPath *string
+	archs                                   *string
 	uinitCmd, initCmd                       *string
 	defaultShell                            *string
 	useExistingInit                         *bool
+	reproducible                            *bool
+	compression                             *string
+	compressionLevel                        *int
 	fourbins                                *bool
 	noCommands                              *bool
 	extraFiles                              multiFlag
@@ -67,8 +75,12 @@ func init() {
 	tmpDir = flag.String("tmpdir", "", "Temporary directory to put binaries in.")
 
 	base = flag.String("base", "", "Base archive to add files to. By default, this is a couple of directories like /bin, /etc, etc. u-root has a default internally supplied set of files; use base=/dev/null if you don't want any base files.")
+	reproducible = flag.Bool("reproducible", false, "Sort the archive, drop timestamps and hard link identical files, so the same files always give the same archive (cpio format only).")
 	useExistingInit = flag.Bool("useinit", false, "Use existing init from base archive (only if --base was specified).")
-	outputPath = flag.String("o", "", "Path to output initramfs file.")
+	outputPath = flag.String("o", "", "Path to output initramfs file. With several -arch, the architecture is added to its name, e.g. initramfs_arm64.cpio.")
+	archs = flag.String("arch", "", "Comma-separated architectures to build initramfs files for at once, e.g. amd64,arm64,riscv64. By default, this is $GOARCH.")
+	compression = flag.String("compress", "", "Compress the cpio archive with gzip, xz or zstd. By default, the archive is compressed if the -o path has the extension of one of them, e.g. .cpio.zst.")
+	compressionLevel = flag.Int("compress-level", compress.DefaultLevel, "Compression level, from 1 (fastest) to 9 (smallest).")
 
 	initCmd = flag.String("initcmd", "init", "Symlink target for /init. Can be an absolute path or a u-root command name. Use initcmd=\"\" if you don't want the symlink.")
 	uinitCmd = flag.String("uinitcmd", "", "Symlink target and arguments for /bin/uinit. Can be an absolute path or a u-root command name. Use uinitcmd=\"\" if you don't want the symlink. E.g. -uinitcmd=\"echo foobar\"")
@@ -122,9 +134,8 @@ func writeBuildStats(stats buildStats, path string) error {
 	return nil
 }
 
-func generateLabel() string {
+func generateLabel(arch string) string {
 	var baseCmds []string
-	env := golang.Default()
 	if len(flag.Args()) > 0 {
 		// Use the last component of the name to keep the label short
 		for _, e := range flag.Args() {
@@ -133,9 +144,17 @@ func generateLabel() string {
 	} else {
 		baseCmds = []string{"core"}
 	}
-	return fmt.Sprintf("%s-%s-%s-%s", *build, env.GOOS, env.GOARCH, strings.Join(baseCmds, "_"))
+	return fmt.Sprintf("%s-%s-%s-%s", *build, golang.Default().GOOS, arch, strings.Join(baseCmds, "_"))
+}
+
+// output is an initramfs file built by Main.
+type output struct {
+	arch, path string
 }
 
+// outputs are the initramfs files Main builds, one for each architecture.
+var outputs []output
+
 func main() {
 	flag.Parse()
 
@@ -148,27 +167,65 @@ func main() {
 
 	elapsed := time.Now().Sub(start)
 
-	stats := buildStats{
-		Label:    *statsLabel,
-		Time:     start.Unix(),
-		Duration: float64(elapsed.Milliseconds()) / 1000,
-	}
-	if stats.Label == "" {
-		stats.Label = generateLabel()
-	}
-	if stat, err := os.Stat(*outputPath); err == nil && stat.ModTime().After(start) {
-		log.Printf("Successfully built %q (size %d).", *outputPath, stat.Size())
-		stats.OutputSize = stat.Size()
-		if *statsOutputPath != "" {
-			if err := writeBuildStats(stats, *statsOutputPath); err == nil {
-				log.Printf("Wrote stats to %q (label %q)", *statsOutputPath, stats.Label)
-			} else {
-				log.Printf("Failed to write stats to %s: %v", *statsOutputPath, err)
+	for _, o := range outputs {
+		stats := buildStats{
+			Label:    *statsLabel,
+			Time:     start.Unix(),
+			Duration: float64(elapsed.Milliseconds()) / 1000,
+		}
+		switch {
+		case stats.Label == "":
+			stats.Label = generateLabel(o.arch)
+		case len(outputs) > 1:
+			stats.Label += "-" + o.arch
+		}
+		if stat, err := os.Stat(o.path); err == nil && stat.ModTime().After(start) {
+			log.Printf("Successfully built %q (size %d).", o.path, stat.Size())
+			stats.OutputSize = stat.Size()
+			if *statsOutputPath != "" {
+				if err := writeBuildStats(stats, *statsOutputPath); err == nil {
+					log.Printf("Wrote stats to %q (label %q)", *statsOutputPath, stats.Label)
+				} else {
+					log.Printf("Failed to write stats to %s: %v", *statsOutputPath, err)
+				}
 			}
 		}
 	}
 }
 
+// archList returns the architectures of the comma-separated list s, without
+// duplicates, or def if there are none.
+func archList(s string, def string) []string {
+	var l []string
+	seen := make(map[string]bool)
+	for _, a := range strings.Split(s, ",") {
+		a = strings.TrimSpace(a)
+		if a == "" || seen[a] {
+			continue
+		}
+		seen[a] = true
+		l = append(l, a)
+	}
+	if len(l) == 0 {
+		return []string{def}
+	}
+	return l
+}
+
+// archPath returns path with _arch added to the file name, before its
+// extensions, e.g. /tmp/initramfs_arm64.cpio.xz for /tmp/initramfs.cpio.xz.
+func archPath(path string, arch string) string {
+	dir, file := filepath.Split(path)
+	name, ext := file, ""
+	// A leading dot is part of the name, not an extension.
+	if len(file) > 1 {
+		if i := strings.IndexByte(file[1:], '.'); i >= 0 {
+			name, ext = file[:i+1], file[i+1:]
+		}
+	}
+	return dir + name + "_" + arch + ext
+}
+
 var recommendedVersions = []string{
 	"go1.13",
 	"go1.14",
@@ -217,30 +274,63 @@ func Main() error {
 	if err != nil {
 		return err
 	}
-
-	logger := log.New(os.Stderr, "", log.LstdFlags)
-	// Open the target initramfs file.
-	if *outputPath == "" {
-		if len(env.GOOS) == 0 && len(env.GOARCH) == 0 {
-			return fmt.Errorf("passed no path, GOOS, and GOARCH to CPIOArchiver.OpenWriter")
+	if *reproducible && *format != "cpio" {
+		return fmt.Errorf("-reproducible needs the cpio format, not %q", *format)
+	}
+	var comp *compress.Format
+	switch {
+	case *compression != "":
+		if comp, err = compress.ByName(*compression); err != nil {
+			return err
+		}
+		if !comp.CanWrite() {
+			return fmt.Errorf("cannot compress with %s", comp)
+		}
+	case *format == "cpio":
+		if comp = compress.ByExtension(*outputPath); comp != nil && !comp.CanWrite() {
+			return fmt.Errorf("cannot compress with %s, the compression of %s", comp, *outputPath)
 		}
-		*outputPath = fmt.Sprintf("/tmp/initramfs.%s_%s.cpio", env.GOOS, env.GOARCH)
 	}
-	w, err := archiver.OpenWriter(logger, *outputPath)
-	if err != nil {
-		return err
+	if comp != nil && *format != "cpio" {
+		return fmt.Errorf("-compress needs the cpio format, not %q", *format)
 	}
 
-	var baseFile initramfs.Reader
+	if len(env.GOOS) == 0 && len(env.GOARCH) == 0 && *outputPath == "" {
+		return fmt.Errorf("passed no path, GOOS, and GOARCH to CPIOArchiver.OpenWriter")
+	}
+	arches := archList(*archs, env.GOARCH)
+	outputs = nil
+	for _, arch := range arches {
+		o := output{arch: arch, path: *outputPath}
+		switch {
+		case o.path == "":
+			o.path = fmt.Sprintf("/tmp/initramfs.%s_%s.cpio", env.GOOS, arch)
+			if comp != nil {
+				o.path += comp.Extensions[0]
+			}
+		case len(arches) > 1:
+			o.path = archPath(o.path, arch)
+		}
+		outputs = append(outputs, o)
+	}
+
+	// The base archive is read once, and the same files added to the
+	// initramfs of each architecture.
+	var baseArchive *cpio.Archive
 	if *base != "" {
 		bf, err := os.Open(*base)
 		if err != nil {
 			return err
 		}
 		defer bf.Close()
-		baseFile = archiver.Reader(bf)
+		// The base archive may be compressed as well.
+		if r := archiver.Reader(compress.TryDecompress(bf)); r != nil {
+			if baseArchive, err = cpio.ArchiveFromReader(r); err != nil {
+				return fmt.Errorf("reading base archive %s: %v", *base, err)
+			}
+		}
 	} else {
-		baseFile = uroot.DefaultRamfs().Reader()
+		baseArchive = uroot.DefaultRamfs()
 	}
 
 	tempDir := *tmpDir
@@ -311,9 +401,8 @@ func Main() error {
 		Commands:        c,
 		TempDir:         tempDir,
 		ExtraFiles:      extraFiles,
-		OutputFile:      w,
-		BaseArchive:     baseFile,
 		UseExistingInit: *useExistingInit,
+		Reproducible:    *reproducible,
 		InitCmd:         initCommand,
 		DefaultShell:    *defaultShell,
 		NoStrip:         *noStrip,
@@ -325,5 +414,50 @@ func Main() error {
 	if len(uinitArgs) > 1 {
 		opts.UinitArgs = uinitArgs[1:]
 	}
+
+	if len(outputs) == 1 {
+		logger := log.New(os.Stderr, "", log.LstdFlags)
+		return buildArch(logger, opts, outputs[0], archiver, comp, baseArchive)
+	}
+	var (
+		errs = make([]error, len(outputs))
+		wg   sync.WaitGroup
+	)
+	for i, o := range outputs {
+		wg.Add(1)
+		go func(i int, o output) {
+			defer wg.Done()
+			logger := log.New(os.Stderr, o.arch+": ", log.LstdFlags)
+			errs[i] = buildArch(logger, opts, o, archiver, comp, baseArchive)
+		}(i, o)
+	}
+	wg.Wait()
+	for i, err := range errs {
+		if err != nil {
+			return fmt.Errorf("%s: %v", outputs[i].arch, err)
+		}
+	}
+	return nil
+}
+
+// buildArch builds the initramfs o for its architecture, with the commands,
+// files and base archive of opts, which are the same for all of them.
+func buildArch(logger *log.Logger, opts uroot.Opts, o output, archiver initramfs.Archiver, comp *compress.Format, baseArchive *cpio.Archive) error {
+	opts.Env.GOARCH = o.arch
+	// CreateInitramfs expands the packages of the commands in place.
+	opts.Commands = append([]uroot.Commands(nil), opts.Commands...)
+	if baseArchive != nil {
+		opts.BaseArchive = baseArchive.Reader()
+	}
+
+	var err error
+	if comp != nil {
+		opts.OutputFile, err = initramfs.CPIO.OpenCompressedWriter(logger, o.path, comp, *compressionLevel)
+	} else {
+		opts.OutputFile, err = archiver.OpenWriter(logger, o.path)
+	}
+	if err != nil {
+		return err
+	}
 	return uroot.CreateInitramfs(logger, opts)
 }
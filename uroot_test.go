@@ -14,6 +14,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -365,6 +366,37 @@ func TestUrootCmdline(t *testing.T) {
 	}
 }
 
+func TestArchList(t *testing.T) {
+	for _, tt := range []struct {
+		s    string
+		want []string
+	}{
+		{"", []string{"amd64"}},
+		{" , ", []string{"amd64"}},
+		{"arm64", []string{"arm64"}},
+		{"amd64, arm64,riscv64,arm64", []string{"amd64", "arm64", "riscv64"}},
+	} {
+		if got := archList(tt.s, "amd64"); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("archList(%q, amd64) = %q, want %q", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestArchPath(t *testing.T) {
+	for _, tt := range []struct {
+		path, want string
+	}{
+		{"initramfs.cpio", "initramfs_arm64.cpio"},
+		{"/tmp/initramfs.cpio.xz", "/tmp/initramfs_arm64.cpio.xz"},
+		{"/tmp/a.b/initramfs", "/tmp/a.b/initramfs_arm64"},
+		{"/tmp/.initramfs.cpio", "/tmp/.initramfs_arm64.cpio"},
+	} {
+		if got := archPath(tt.path, "arm64"); got != tt.want {
+			t.Errorf("archPath(%q, arm64) = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+}
+
 func buildIt(t *testing.T, args, env []string, want error) (*os.File, []byte) {
 	f, err := ioutil.TempFile("", "u-root-")
 	if err != nil {